@@ -2,6 +2,7 @@ package gfspapp
 
 import (
 	"context"
+	"io"
 	"syscall"
 
 	"google.golang.org/grpc"
@@ -34,6 +35,7 @@ type GfSpBaseApp struct {
 	gfSpDB       spdb.SPDB
 	gfBsDB       bsdb.BSDB
 	gfBsDBMaster bsdb.BSDB
+	gfBsDBBackup bsdb.BSDB
 
 	pieceStore piecestore.PieceStore
 	pieceOp    piecestore.PieceOp
@@ -175,11 +177,26 @@ func (g *GfSpBaseApp) GfBsDBMaster() bsdb.BSDB {
 	return g.gfBsDBMaster
 }
 
+// GfBsDBBackup returns the backup block syncer db client, it's nil if the backup db is not configured.
+func (g *GfSpBaseApp) GfBsDBBackup() bsdb.BSDB {
+	return g.gfBsDBBackup
+}
+
 // SetGfBsDB sets the block syncer db client.
 func (g *GfSpBaseApp) SetGfBsDB(setDB bsdb.BSDB) {
 	g.gfBsDB = setDB
 }
 
+// SetGfBsDBMaster sets the master block syncer db client.
+func (g *GfSpBaseApp) SetGfBsDBMaster(setDB bsdb.BSDB) {
+	g.gfBsDBMaster = setDB
+}
+
+// SetGfBsDBBackup sets the backup block syncer db client.
+func (g *GfSpBaseApp) SetGfBsDBBackup(setDB bsdb.BSDB) {
+	g.gfBsDBBackup = setDB
+}
+
 // ServerForRegister returns the Grpc server for module register own service.
 func (g *GfSpBaseApp) ServerForRegister() *grpc.Server {
 	return g.server
@@ -225,6 +242,9 @@ func (g *GfSpBaseApp) close(ctx context.Context) error {
 	_ = g.GfSpClient().Close()
 	_ = g.rcmgr.Close()
 	_ = g.chain.Close()
+	if closer, ok := g.pieceStore.(io.Closer); ok {
+		_ = closer.Close()
+	}
 	return nil
 }
 
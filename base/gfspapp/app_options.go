@@ -1,6 +1,7 @@
 package gfspapp
 
 import (
+	"fmt"
 	"math"
 	"os"
 	"strings"
@@ -18,6 +19,7 @@ import (
 	corercmgr "github.com/bnb-chain/greenfield-storage-provider/core/rcmgr"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
+	mwhttp "github.com/bnb-chain/greenfield-storage-provider/pkg/middleware/http"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/pprof"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/probe"
 	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
@@ -173,6 +175,8 @@ const (
 	SignerFailureDelegateUpdateObjectContent = "signer_delegate_update_object_content_failure"
 	SignerSuccessDelegateCreateObject        = "signer_delegate_create_object_success"
 	SignerFailureDelegateCreateObject        = "signer_delegate_create_object_failure"
+	SignerSuccessDelegateDeleteObject        = "signer_delegate_delete_object_success"
+	SignerFailureDelegateDeleteObject        = "signer_delegate_delete_object_failure"
 
 	UploaderSuccessPutObject = "uploader_put_object_success"
 	UploaderFailurePutObject = "uploader_put_object_failure"
@@ -278,7 +282,9 @@ func DefaultGfSpDBOption(app *GfSpBaseApp, cfg *gfspconfig.GfSpConfig) error {
 		return nil
 	}
 	for _, v := range cfg.Server {
-		if v == coremodule.BlockSyncerModularName || v == coremodule.SignModularName || v == coremodule.GateModularName {
+		if v == coremodule.BlockSyncerModularName ||
+			(v == coremodule.GateModularName && !strings.EqualFold(cfg.APIRateLimiter.Store, mwhttp.SQLLimiterStore)) ||
+			(v == coremodule.SignModularName && !cfg.Chain.TxTracker.Enable) {
 			log.Infof("[%s] module doesn't need sp db", v)
 			continue
 		}
@@ -364,13 +370,40 @@ func DefaultGfBsDBOption(app *GfSpBaseApp, cfg *gfspconfig.GfSpConfig) error {
 
 			defaultGfBsDB(&cfg.BsDB)
 
-			bsDBBlockSyncerMaster, err := bsdb.NewBsDB(cfg)
+			bsDBBlockSyncerMaster, err := bsdb.NewBsDB(cfg, false)
 			if err != nil {
 				log.Panicw("failed to new bsdb", "error", err)
 				return
 			}
 
 			app.gfBsDBMaster = bsDBBlockSyncerMaster
+
+			// the backup db is switched to by the master flag, e.g. after a re-index into it
+			if val, ok := os.LookupEnv(bsdb.BsDBSwitchedAddress); ok {
+				cfg.BsDBBackup.Address = val
+			}
+			if cfg.BsDBBackup.Address == "" {
+				return
+			}
+			if val, ok := os.LookupEnv(bsdb.BsDBSwitchedUser); ok {
+				cfg.BsDBBackup.User = val
+			}
+			if val, ok := os.LookupEnv(bsdb.BsDBSwitchedPasswd); ok {
+				cfg.BsDBBackup.Passwd = val
+			}
+			if val, ok := os.LookupEnv(bsdb.BsDBSwitchedDatabase); ok {
+				cfg.BsDBBackup.Database = val
+			}
+
+			defaultGfBsDB(&cfg.BsDBBackup)
+
+			bsDBBlockSyncerBackup, err := bsdb.NewBsDB(cfg, true)
+			if err != nil {
+				log.Panicw("failed to new backup bsdb", "error", err)
+				return
+			}
+
+			app.gfBsDBBackup = bsDBBlockSyncerBackup
 		})
 	}
 	return nil
@@ -458,7 +491,25 @@ func DefaultGfSpTQueueOption(app *GfSpBaseApp, cfg *gfspconfig.GfSpConfig) error
 		cfg.Customize.NewStrategyTQueueFunc = gfsptqueue.NewGfSpTQueue
 	}
 	if cfg.Customize.NewStrategyTQueueWithLimitFunc == nil {
-		cfg.Customize.NewStrategyTQueueWithLimitFunc = gfsptqueue.NewGfSpTQueueWithLimit
+		if len(cfg.Manager.PersistentTaskQueues) != 0 && app.gfSpDB != nil {
+			cfg.Customize.NewStrategyTQueueWithLimitFunc = gfsptqueue.NewGfSpPersistentTQueueWithLimitFactory(
+				app.gfSpDB, cfg.Manager.PersistentTaskQueues)
+		} else {
+			cfg.Customize.NewStrategyTQueueWithLimitFunc = gfsptqueue.NewGfSpTQueueWithLimit
+		}
+		if len(cfg.Manager.PriorityTaskQueues) != 0 {
+			switch cfg.Manager.TaskFairShare {
+			case gfsptqueue.FairShareNone, gfsptqueue.FairShareByBucket, gfsptqueue.FairShareByUser:
+			default:
+				return fmt.Errorf("invalid task fair share: %s", cfg.Manager.TaskFairShare)
+			}
+			if cfg.Manager.TaskPriorityAgingSecond == 0 {
+				cfg.Manager.TaskPriorityAgingSecond = gfsptqueue.DefaultPriorityAgingSecond
+			}
+			cfg.Customize.NewStrategyTQueueWithLimitFunc = gfsptqueue.NewGfSpPriorityTQueueWithLimitFactory(
+				cfg.Customize.NewStrategyTQueueWithLimitFunc, cfg.Manager.PriorityTaskQueues,
+				cfg.Manager.TaskPriorityAgingSecond, cfg.Manager.TaskFairShare)
+		}
 	}
 	if cfg.Customize.NewVirtualGroupManagerFunc == nil {
 		cfg.Customize.NewVirtualGroupManagerFunc = gfspvgmgr.NewVirtualGroupManager
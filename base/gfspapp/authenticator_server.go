@@ -221,3 +221,39 @@ func (g *GfSpBaseApp) DeleteAuthKeysV2(ctx context.Context, req *gfspserver.Dele
 		Result: result,
 	}, nil
 }
+
+func (g *GfSpBaseApp) CreateS3AccessKey(ctx context.Context, req *gfspserver.CreateS3AccessKeyRequest) (*gfspserver.CreateS3AccessKeyResponse, error) {
+	if req == nil {
+		log.Error("failed to CreateS3AccessKey due to pointer dangling")
+		return &gfspserver.CreateS3AccessKeyResponse{
+			Err: ErrAuthenticatorTaskDangling,
+		}, nil
+	}
+	log.CtxDebugw(ctx, "begin to CreateS3AccessKey", "user", req.GetAccountId(), "domain", req.GetDomain(), "public_key", req.GetUserPublicKey())
+	accessKey, err := g.authenticator.CreateS3AccessKey(ctx, req.GetAccountId(), req.GetDomain(), req.GetUserPublicKey())
+	log.CtxDebugw(ctx, "finish to CreateS3AccessKey", "user", req.GetAccountId(), "domain", req.GetDomain(), "error", err)
+	if err != nil {
+		return &gfspserver.CreateS3AccessKeyResponse{
+			Err: gfsperrors.MakeGfSpError(err),
+		}, nil
+	}
+	return &gfspserver.CreateS3AccessKeyResponse{
+		AccessKeyId:     accessKey.AccessKeyID,
+		SecretAccessKey: accessKey.SecretKey,
+	}, nil
+}
+
+func (g *GfSpBaseApp) VerifyS3Signature(ctx context.Context, req *gfspserver.VerifyS3SignatureRequest) (*gfspserver.VerifyS3SignatureResponse, error) {
+	if req == nil {
+		log.Error("failed to VerifyS3Signature due to pointer dangling")
+		return &gfspserver.VerifyS3SignatureResponse{
+			Err: ErrAuthenticatorTaskDangling,
+		}, nil
+	}
+	account, err := g.authenticator.VerifyS3Signature(ctx, req.GetAccessKeyId(), req.GetStringToSign(), req.GetSignature())
+	log.CtxDebugw(ctx, "finish to verify s3 signature", "access_key_id", req.GetAccessKeyId(), "user", account, "error", err)
+	return &gfspserver.VerifyS3SignatureResponse{
+		Err:       gfsperrors.MakeGfSpError(err),
+		AccountId: account,
+	}, nil
+}
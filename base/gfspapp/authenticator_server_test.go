@@ -363,3 +363,64 @@ func TestGfSpBaseApp_DeleteAuthKeysV2Failure2(t *testing.T) {
 	assert.Nil(t, err)
 	assert.NotNil(t, result.Err)
 }
+
+func TestGfSpBaseApp_CreateS3AccessKeySuccess(t *testing.T) {
+	g := setup(t)
+	ctrl := gomock.NewController(t)
+	m := module.NewMockAuthenticator(ctrl)
+	g.authenticator = m
+	m.EXPECT().CreateS3AccessKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&corespdb.S3AccessKey{AccessKeyID: "id", SecretKey: "secret"}, nil).Times(1)
+	req := &gfspserver.CreateS3AccessKeyRequest{
+		AccountId:     "mockAccountID",
+		Domain:        "mockDomain",
+		UserPublicKey: "test",
+	}
+	result, err := g.CreateS3AccessKey(context.TODO(), req)
+	assert.Nil(t, err)
+	assert.Equal(t, "id", result.GetAccessKeyId())
+	assert.Equal(t, "secret", result.GetSecretAccessKey())
+}
+
+func TestGfSpBaseApp_CreateS3AccessKeyFailure1(t *testing.T) {
+	g := setup(t)
+	result, err := g.CreateS3AccessKey(context.TODO(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, ErrAuthenticatorTaskDangling, result.Err)
+}
+
+func TestGfSpBaseApp_CreateS3AccessKeyFailure2(t *testing.T) {
+	g := setup(t)
+	ctrl := gomock.NewController(t)
+	m := module.NewMockAuthenticator(ctrl)
+	g.authenticator = m
+	m.EXPECT().CreateS3AccessKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, mockErr).Times(1)
+	result, err := g.CreateS3AccessKey(context.TODO(), &gfspserver.CreateS3AccessKeyRequest{})
+	assert.Nil(t, err)
+	assert.NotNil(t, result.Err)
+	assert.Equal(t, "", result.GetAccessKeyId())
+}
+
+func TestGfSpBaseApp_VerifyS3SignatureSuccess(t *testing.T) {
+	g := setup(t)
+	ctrl := gomock.NewController(t)
+	m := module.NewMockAuthenticator(ctrl)
+	g.authenticator = m
+	m.EXPECT().VerifyS3Signature(gomock.Any(), "id", gomock.Any(), gomock.Any()).Return("mockAccountID", nil).Times(1)
+	req := &gfspserver.VerifyS3SignatureRequest{
+		AccessKeyId:  "id",
+		StringToSign: []byte("string to sign"),
+		Signature:    "signature",
+	}
+	result, err := g.VerifyS3Signature(context.TODO(), req)
+	assert.Nil(t, err)
+	assert.Nil(t, result.Err)
+	assert.Equal(t, "mockAccountID", result.GetAccountId())
+}
+
+func TestGfSpBaseApp_VerifyS3SignatureFailure(t *testing.T) {
+	g := setup(t)
+	result, err := g.VerifyS3Signature(context.TODO(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, ErrAuthenticatorTaskDangling, result.Err)
+}
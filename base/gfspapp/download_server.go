@@ -3,11 +3,14 @@ package gfspapp
 import (
 	"context"
 	"errors"
+	"io"
 	"net/http"
 	"time"
 
 	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsperrors"
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsplimit"
 	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfspserver"
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
 	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
 	"github.com/bnb-chain/greenfield-storage-provider/core/task"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
@@ -20,6 +23,9 @@ var (
 	ErrDownloadExhaustResource = gfsperrors.Register(BaseCodeSpace, http.StatusBadRequest, 990302, "server overload, try again later")
 )
 
+// DownloadStreamBufSize defines the max data size of one response in the download object stream.
+const DownloadStreamBufSize = 1024 * 1024
+
 var _ gfspserver.GfSpDownloadServiceServer = &GfSpBaseApp{}
 
 func (g *GfSpBaseApp) GfSpDownloadObject(ctx context.Context, req *gfspserver.GfSpDownloadObjectRequest) (
@@ -64,6 +70,78 @@ func (g *GfSpBaseApp) OnDownloadObjectTask(ctx context.Context, downloadObjectTa
 	return data, nil
 }
 
+// GfSpDownloadObjectStream streams the object data to the client, at most DownloadStreamBufSize data is
+// buffered, and the send blocks by the gRPC flow control if the client reads slowly.
+func (g *GfSpBaseApp) GfSpDownloadObjectStream(req *gfspserver.GfSpDownloadObjectRequest,
+	stream gfspserver.GfSpDownloadService_GfSpDownloadObjectStreamServer) error {
+	downloadObjectTask := req.GetDownloadObjectTask()
+	if downloadObjectTask == nil {
+		log.Error("failed to download object stream due to task pointer dangling")
+		return stream.Send(&gfspserver.GfSpDownloadObjectResponse{Err: ErrDownloadTaskDangling})
+	}
+	ctx := log.WithValue(stream.Context(), log.CtxKeyTask, downloadObjectTask.Key().String())
+	limit := &gfsplimit.GfSpLimit{Memory: DownloadStreamBufSize}
+	if downloadObjectTask.GetSize() < DownloadStreamBufSize {
+		limit.Memory = downloadObjectTask.GetSize()
+	}
+	limit.Add(gfsptask.LimitEstimateByPriority(downloadObjectTask.GetPriority()))
+	span, err := g.downloader.ReserveResource(ctx, limit.ScopeStat())
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to reserve download object stream resource", "error", err)
+		return stream.Send(&gfspserver.GfSpDownloadObjectResponse{Err: ErrDownloadExhaustResource})
+	}
+	defer span.Done()
+	sendSize, err := g.OnDownloadObjectTaskStream(ctx, downloadObjectTask, stream)
+	log.CtxDebugw(ctx, "finished to download object stream", "send_size", sendSize, "error", err)
+	if err != nil {
+		return stream.Send(&gfspserver.GfSpDownloadObjectResponse{Err: gfsperrors.MakeGfSpError(err)})
+	}
+	return nil
+}
+
+func (g *GfSpBaseApp) OnDownloadObjectTaskStream(ctx context.Context, downloadObjectTask task.DownloadObjectTask,
+	stream gfspserver.GfSpDownloadService_GfSpDownloadObjectStreamServer) (int64, error) {
+	if downloadObjectTask == nil || downloadObjectTask.GetObjectInfo() == nil {
+		log.CtxError(ctx, "failed to download object stream due to task pointer dangling")
+		return 0, ErrDownloadTaskDangling
+	}
+	err := g.downloader.PreDownloadObject(ctx, downloadObjectTask)
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to pre download object", "task_info", downloadObjectTask.Info(), "error", err)
+		return 0, err
+	}
+	reader, err := g.downloader.HandleDownloadObjectTaskStream(ctx, downloadObjectTask)
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to download object stream", "error", err)
+		return 0, err
+	}
+	defer reader.Close()
+	var (
+		sendSize int64
+		buf      = make([]byte, DownloadStreamBufSize)
+	)
+	for {
+		n, readErr := io.ReadFull(reader, buf)
+		if n > 0 {
+			if err = stream.Send(&gfspserver.GfSpDownloadObjectResponse{Data: buf[:n]}); err != nil {
+				log.CtxErrorw(ctx, "failed to send download object stream data", "error", err)
+				return sendSize, ErrExceptionsStream
+			}
+			sendSize += int64(n)
+		}
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			break
+		}
+		if readErr != nil {
+			log.CtxErrorw(ctx, "failed to read object data", "send_size", sendSize, "error", readErr)
+			return sendSize, readErr
+		}
+	}
+	g.downloader.PostDownloadObject(ctx, downloadObjectTask)
+	log.CtxDebugw(ctx, "succeed to download object stream")
+	return sendSize, nil
+}
+
 func (g *GfSpBaseApp) GfSpDownloadPiece(ctx context.Context, req *gfspserver.GfSpDownloadPieceRequest) (
 	*gfspserver.GfSpDownloadPieceResponse, error) {
 	downloadPieceTask := req.GetDownloadPieceTask()
@@ -243,3 +321,13 @@ func (g *GfSpBaseApp) GfSpDeductQuotaForBucketMigrate(ctx context.Context, deduc
 		Err: gfsperrors.MakeGfSpError(nil),
 	}, nil
 }
+
+// gRPCDownloadStream for mock use
+// Note: gRPCDownloadStream interface is forbidden to be used in non-UT code
+//
+// nolint:unused
+//
+//go:generate mockgen -source=./download_server.go -destination=./download_server_mock.go -package=gfspapp
+type gRPCDownloadStream interface {
+	gfspserver.GfSpDownloadService_GfSpDownloadObjectStreamServer
+}
@@ -0,0 +1,138 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./download_server.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./download_server.go -destination=./download_server_mock.go -package=gfspapp
+//
+
+// Package gfspapp is a generated GoMock package.
+package gfspapp
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gfspserver "github.com/bnb-chain/greenfield-storage-provider/base/types/gfspserver"
+	gomock "go.uber.org/mock/gomock"
+	metadata "google.golang.org/grpc/metadata"
+)
+
+// MockgRPCDownloadStream is a mock of gRPCDownloadStream interface.
+type MockgRPCDownloadStream struct {
+	ctrl     *gomock.Controller
+	recorder *MockgRPCDownloadStreamMockRecorder
+}
+
+// MockgRPCDownloadStreamMockRecorder is the mock recorder for MockgRPCDownloadStream.
+type MockgRPCDownloadStreamMockRecorder struct {
+	mock *MockgRPCDownloadStream
+}
+
+// NewMockgRPCDownloadStream creates a new mock instance.
+func NewMockgRPCDownloadStream(ctrl *gomock.Controller) *MockgRPCDownloadStream {
+	mock := &MockgRPCDownloadStream{ctrl: ctrl}
+	mock.recorder = &MockgRPCDownloadStreamMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockgRPCDownloadStream) EXPECT() *MockgRPCDownloadStreamMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockgRPCDownloadStream) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockgRPCDownloadStreamMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockgRPCDownloadStream)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockgRPCDownloadStream) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockgRPCDownloadStreamMockRecorder) RecvMsg(m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockgRPCDownloadStream)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockgRPCDownloadStream) Send(arg0 *gfspserver.GfSpDownloadObjectResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockgRPCDownloadStreamMockRecorder) Send(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockgRPCDownloadStream)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockgRPCDownloadStream) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockgRPCDownloadStreamMockRecorder) SendHeader(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockgRPCDownloadStream)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockgRPCDownloadStream) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockgRPCDownloadStreamMockRecorder) SendMsg(m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockgRPCDownloadStream)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockgRPCDownloadStream) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockgRPCDownloadStreamMockRecorder) SetHeader(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockgRPCDownloadStream)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockgRPCDownloadStream) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockgRPCDownloadStreamMockRecorder) SetTrailer(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockgRPCDownloadStream)(nil).SetTrailer), arg0)
+}
@@ -1,12 +1,15 @@
 package gfspapp
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsperrors"
 	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfspserver"
 	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
 	"github.com/bnb-chain/greenfield-storage-provider/core/module"
@@ -130,6 +133,139 @@ func TestGfSpBaseApp_OnDownloadObjectTaskFailure3(t *testing.T) {
 	assert.Nil(t, result)
 }
 
+func mockDownloadStream(ctrl *gomock.Controller, sendErr error) (*MockgRPCDownloadStream,
+	*[]*gfspserver.GfSpDownloadObjectResponse) {
+	var sent []*gfspserver.GfSpDownloadObjectResponse
+	stream := NewMockgRPCDownloadStream(ctrl)
+	stream.EXPECT().Context().Return(context.TODO()).AnyTimes()
+	stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *gfspserver.GfSpDownloadObjectResponse) error {
+		// the buffer is reused by the server
+		sent = append(sent, &gfspserver.GfSpDownloadObjectResponse{Err: resp.GetErr(), Data: bytes.Clone(resp.GetData())})
+		return sendErr
+	}).AnyTimes()
+	return stream, &sent
+}
+
+func TestGfSpBaseApp_GfSpDownloadObjectStreamSuccess(t *testing.T) {
+	g := setup(t)
+	ctrl := gomock.NewController(t)
+	m := module.NewMockDownloader(ctrl)
+	g.downloader = m
+	data := bytes.Repeat([]byte("a"), DownloadStreamBufSize+1)
+	m1 := rcmgr.NewMockResourceScopeSpan(ctrl)
+	m1.EXPECT().Done().AnyTimes()
+	m.EXPECT().ReserveResource(gomock.Any(), gomock.Any()).Return(m1, nil).Times(1)
+	m.EXPECT().PreDownloadObject(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	m.EXPECT().HandleDownloadObjectTaskStream(gomock.Any(), gomock.Any()).Return(
+		io.NopCloser(bytes.NewReader(data)), nil).Times(1)
+	m.EXPECT().PostDownloadObject(gomock.Any(), gomock.Any()).Return().Times(1)
+	stream, sent := mockDownloadStream(ctrl, nil)
+	req := &gfspserver.GfSpDownloadObjectRequest{DownloadObjectTask: &gfsptask.GfSpDownloadObjectTask{
+		Task:       &gfsptask.GfSpTask{Address: "mockAddress"},
+		ObjectInfo: mockObjectInfo,
+	}}
+	err := g.GfSpDownloadObjectStream(req, stream)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(*sent))
+	assert.Equal(t, DownloadStreamBufSize, len((*sent)[0].GetData()))
+	assert.Equal(t, 1, len((*sent)[1].GetData()))
+	assert.Nil(t, (*sent)[1].GetErr())
+}
+
+func TestGfSpBaseApp_GfSpDownloadObjectStreamFailure(t *testing.T) {
+	req := &gfspserver.GfSpDownloadObjectRequest{DownloadObjectTask: &gfsptask.GfSpDownloadObjectTask{
+		Task:       &gfsptask.GfSpTask{Address: "mockAddress"},
+		ObjectInfo: mockObjectInfo,
+	}}
+	cases := []struct {
+		name        string
+		req         *gfspserver.GfSpDownloadObjectRequest
+		fn          func(m *module.MockDownloader, span rcmgr.ResourceScopeSpan)
+		expectedErr error
+		wantedData  int
+	}{
+		{
+			name:        "download task dangling",
+			req:         &gfspserver.GfSpDownloadObjectRequest{},
+			fn:          func(m *module.MockDownloader, span rcmgr.ResourceScopeSpan) {},
+			expectedErr: ErrDownloadTaskDangling,
+		},
+		{
+			name: "failed to reserve resource",
+			req:  req,
+			fn: func(m *module.MockDownloader, span rcmgr.ResourceScopeSpan) {
+				m.EXPECT().ReserveResource(gomock.Any(), gomock.Any()).Return(nil, mockErr).Times(1)
+			},
+			expectedErr: ErrDownloadExhaustResource,
+		},
+		{
+			name: "failed to pre download object",
+			req:  req,
+			fn: func(m *module.MockDownloader, span rcmgr.ResourceScopeSpan) {
+				m.EXPECT().ReserveResource(gomock.Any(), gomock.Any()).Return(span, nil).Times(1)
+				m.EXPECT().PreDownloadObject(gomock.Any(), gomock.Any()).Return(mockErr).Times(1)
+			},
+			expectedErr: mockErr,
+		},
+		{
+			name: "failed to read object data",
+			req:  req,
+			fn: func(m *module.MockDownloader, span rcmgr.ResourceScopeSpan) {
+				m.EXPECT().ReserveResource(gomock.Any(), gomock.Any()).Return(span, nil).Times(1)
+				m.EXPECT().PreDownloadObject(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				m.EXPECT().HandleDownloadObjectTaskStream(gomock.Any(), gomock.Any()).Return(io.NopCloser(
+					io.MultiReader(bytes.NewReader([]byte("mockData")), iotestErrReader{})), nil).Times(1)
+			},
+			expectedErr: mockErr,
+			wantedData:  len("mockData"),
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			g := setup(t)
+			ctrl := gomock.NewController(t)
+			m := module.NewMockDownloader(ctrl)
+			g.downloader = m
+			span := rcmgr.NewMockResourceScopeSpan(ctrl)
+			span.EXPECT().Done().AnyTimes()
+			tt.fn(m, span)
+			stream, sent := mockDownloadStream(ctrl, nil)
+			err := g.GfSpDownloadObjectStream(tt.req, stream)
+			assert.Nil(t, err)
+			last := (*sent)[len(*sent)-1]
+			assert.Equal(t, gfsperrors.MakeGfSpError(tt.expectedErr).GetDescription(), last.GetErr().GetDescription())
+			var size int
+			for _, resp := range *sent {
+				size += len(resp.GetData())
+			}
+			assert.Equal(t, tt.wantedData, size)
+		})
+	}
+}
+
+func TestGfSpBaseApp_OnDownloadObjectTaskStreamSendFailure(t *testing.T) {
+	g := setup(t)
+	ctrl := gomock.NewController(t)
+	m := module.NewMockDownloader(ctrl)
+	g.downloader = m
+	m.EXPECT().PreDownloadObject(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	m.EXPECT().HandleDownloadObjectTaskStream(gomock.Any(), gomock.Any()).Return(
+		io.NopCloser(bytes.NewReader([]byte("mockData"))), nil).Times(1)
+	stream, _ := mockDownloadStream(ctrl, mockErr)
+	downloadTask := &gfsptask.GfSpDownloadObjectTask{
+		Task:       &gfsptask.GfSpTask{Address: "mockAddress"},
+		ObjectInfo: mockObjectInfo,
+	}
+	sendSize, err := g.OnDownloadObjectTaskStream(context.TODO(), downloadTask, stream)
+	assert.Equal(t, ErrExceptionsStream, err)
+	assert.Equal(t, int64(0), sendSize)
+}
+
+// iotestErrReader always returns the mock error.
+type iotestErrReader struct{}
+
+func (iotestErrReader) Read([]byte) (int, error) { return 0, mockErr }
+
 func TestGfSpBaseApp_GfSpDownloadPieceSuccess(t *testing.T) {
 	g := setup(t)
 	ctrl := gomock.NewController(t)
@@ -343,6 +343,20 @@ func (g *GfSpBaseApp) GfSpPickVirtualGroupFamily(ctx context.Context, req *gfsps
 	}, nil
 }
 
+func (g *GfSpBaseApp) GfSpPickGlobalVirtualGroup(ctx context.Context, req *gfspserver.GfSpPickGlobalVirtualGroupRequest) (
+	*gfspserver.GfSpPickGlobalVirtualGroupResponse, error) {
+	if req.GetUploadObjectTask() == nil || req.GetUploadObjectTask().GetStorageParams() == nil {
+		log.CtxError(ctx, "failed to pick global virtual group due to pointer dangling")
+		return &gfspserver.GfSpPickGlobalVirtualGroupResponse{Err: ErrUploadTaskDangling}, nil
+	}
+	resp, err := g.manager.PickGlobalVirtualGroup(ctx, req.GetUploadObjectTask())
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to pick global virtual group", "error", err)
+		return &gfspserver.GfSpPickGlobalVirtualGroupResponse{Err: gfsperrors.MakeGfSpError(err)}, nil
+	}
+	return resp, nil
+}
+
 func (g *GfSpBaseApp) GfSpNotifyMigrateSwapOut(ctx context.Context, req *gfspserver.GfSpNotifyMigrateSwapOutRequest) (
 	*gfspserver.GfSpNotifyMigrateSwapOutResponse, error) {
 	if err := g.manager.NotifyMigrateSwapOut(ctx, req.GetSwapOut()); err != nil {
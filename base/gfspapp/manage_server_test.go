@@ -13,6 +13,7 @@ import (
 	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
 	"github.com/bnb-chain/greenfield-storage-provider/core/module"
 	corespdb "github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
 	virtual_types "github.com/bnb-chain/greenfield/x/virtualgroup/types"
 )
 
@@ -605,6 +606,41 @@ func TestGfSpBaseApp_GfSpPickVirtualGroupFamilyFailure(t *testing.T) {
 	assert.Nil(t, result)
 }
 
+func TestGfSpBaseApp_GfSpPickGlobalVirtualGroupSuccess(t *testing.T) {
+	g := setup(t)
+	ctrl := gomock.NewController(t)
+	m := module.NewMockManager(ctrl)
+	g.manager = m
+	m.EXPECT().PickGlobalVirtualGroup(gomock.Any(), gomock.Any()).Return(
+		&gfspserver.GfSpPickGlobalVirtualGroupResponse{GlobalVirtualGroupId: 1}, nil).Times(1)
+	req := &gfspserver.GfSpPickGlobalVirtualGroupRequest{UploadObjectTask: &gfsptask.GfSpUploadObjectTask{
+		Task:          &gfsptask.GfSpTask{Retry: 0},
+		StorageParams: &storagetypes.Params{},
+	}}
+	result, err := g.GfSpPickGlobalVirtualGroup(context.TODO(), req)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), result.GetGlobalVirtualGroupId())
+}
+
+func TestGfSpBaseApp_GfSpPickGlobalVirtualGroupFailure(t *testing.T) {
+	g := setup(t)
+	ctrl := gomock.NewController(t)
+	m := module.NewMockManager(ctrl)
+	g.manager = m
+	m.EXPECT().PickGlobalVirtualGroup(gomock.Any(), gomock.Any()).Return(nil, mockErr).Times(1)
+	req := &gfspserver.GfSpPickGlobalVirtualGroupRequest{UploadObjectTask: &gfsptask.GfSpUploadObjectTask{
+		Task:          &gfsptask.GfSpTask{Retry: 0},
+		StorageParams: &storagetypes.Params{},
+	}}
+	result, err := g.GfSpPickGlobalVirtualGroup(context.TODO(), req)
+	assert.Nil(t, err)
+	assert.Equal(t, mockErr.Error(), result.GetErr().GetDescription())
+
+	result, err = g.GfSpPickGlobalVirtualGroup(context.TODO(), &gfspserver.GfSpPickGlobalVirtualGroupRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, ErrUploadTaskDangling, result.GetErr())
+}
+
 func TestGfSpBaseApp_GfSpNotifyMigrateSwapOutSuccess(t *testing.T) {
 	g := setup(t)
 	ctrl := gomock.NewController(t)
@@ -361,6 +361,16 @@ func (g *GfSpBaseApp) GfSpSign(ctx context.Context, req *gfspserver.GfSpSignRequ
 			metrics.ReqCounter.WithLabelValues(SignerSuccessDelegateUpdateObjectContent).Inc()
 			metrics.ReqTime.WithLabelValues(SignerFailureDelegateUpdateObjectContent).Observe(time.Since(startTime).Seconds())
 		}
+	case *gfspserver.GfSpSignRequest_DelegateDeleteObject:
+		txHash, err = g.signer.DelegateDeleteObject(ctx, t.DelegateDeleteObject)
+		if err != nil {
+			log.CtxErrorw(ctx, "failed to delegate delete object", "error", err)
+			metrics.ReqCounter.WithLabelValues(SignerFailureDelegateDeleteObject).Inc()
+			metrics.ReqTime.WithLabelValues(SignerFailureDelegateDeleteObject).Observe(time.Since(startTime).Seconds())
+		} else {
+			metrics.ReqCounter.WithLabelValues(SignerSuccessDelegateDeleteObject).Inc()
+			metrics.ReqTime.WithLabelValues(SignerSuccessDelegateDeleteObject).Observe(time.Since(startTime).Seconds())
+		}
 	case *gfspserver.GfSpSignRequest_SealObjectInfoV2:
 		txHash, err = g.signer.SealObjectV2(ctx, t.SealObjectInfoV2)
 		if err != nil {
@@ -245,3 +245,50 @@ func (s *GfSpClient) DeleteAuthKeysV2(ctx context.Context, account string, domai
 	}
 	return resp.Result, nil
 }
+
+func (s *GfSpClient) CreateS3AccessKey(ctx context.Context, account string, domain string, userPublicKey string, opts ...grpc.DialOption) (string, string, error) {
+	conn, connErr := s.Connection(ctx, s.authenticatorEndpoint, opts...)
+	if connErr != nil {
+		log.CtxErrorw(ctx, "client failed to connect authenticator", "error", connErr)
+		return "", "", ErrRPCUnknownWithDetail("client failed to connect authenticator, error: ", connErr)
+	}
+	defer conn.Close()
+	req := &gfspserver.CreateS3AccessKeyRequest{
+		AccountId:     account,
+		Domain:        domain,
+		UserPublicKey: userPublicKey,
+	}
+	resp, err := gfspserver.NewGfSpAuthenticationServiceClient(conn).CreateS3AccessKey(ctx, req)
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to CreateS3AccessKey rpc", "error", err)
+		return "", "", err
+	}
+	if resp.GetErr() != nil {
+		return "", "", resp.GetErr()
+	}
+	return resp.GetAccessKeyId(), resp.GetSecretAccessKey(), nil
+}
+
+func (s *GfSpClient) VerifyS3Signature(ctx context.Context, accessKeyID string, stringToSign []byte, signature string, opts ...grpc.DialOption) (string, error) {
+	conn, connErr := s.Connection(ctx, s.authenticatorEndpoint, opts...)
+	if connErr != nil {
+		log.CtxErrorw(ctx, "client failed to connect authenticator", "error", connErr)
+		return "", ErrRPCUnknownWithDetail("client failed to connect authenticator, error: ", connErr)
+	}
+	defer conn.Close()
+	req := &gfspserver.VerifyS3SignatureRequest{
+		AccessKeyId:  accessKeyID,
+		StringToSign: stringToSign,
+		Signature:    signature,
+	}
+	resp, err := gfspserver.NewGfSpAuthenticationServiceClient(conn).VerifyS3Signature(ctx, req)
+	ctx = log.Context(ctx, resp)
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to VerifyS3Signature rpc", "error", err)
+		return "", err
+	}
+	if resp.GetErr() != nil {
+		return "", resp.GetErr()
+	}
+	return resp.GetAccountId(), nil
+}
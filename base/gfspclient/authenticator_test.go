@@ -538,3 +538,112 @@ func TestGfSpClient_ListAuthKeysV2Failure(t *testing.T) {
 	assert.Contains(t, err.Error(), context.Canceled.Error())
 	assert.Equal(t, []string(nil), result)
 }
+
+func TestGfSpClient_CreateS3AccessKey(t *testing.T) {
+	cases := []struct {
+		name         string
+		account      string
+		wantedID     string
+		wantedSecret string
+		wantedIsErr  bool
+		wantedErr    error
+	}{
+		{
+			name:         "success",
+			account:      mockObjectName3,
+			wantedID:     "id",
+			wantedSecret: "secret",
+			wantedIsErr:  false,
+		},
+		{
+			name:        "mock rpc error",
+			account:     mockObjectName1,
+			wantedIsErr: true,
+			wantedErr:   mockRPCErr,
+		},
+		{
+			name:        "mock response returns error",
+			account:     mockObjectName2,
+			wantedIsErr: true,
+			wantedErr:   ErrExceptionsStream,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			s := mockBufClient()
+			ctx := context.Background()
+			id, secret, err := s.CreateS3AccessKey(ctx, tt.account, emptyString, emptyString, grpc.WithContextDialer(bufDialer), grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if tt.wantedIsErr {
+				assert.Contains(t, err.Error(), tt.wantedErr.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, tt.wantedID, id)
+			assert.Equal(t, tt.wantedSecret, secret)
+		})
+	}
+}
+
+func TestGfSpClient_CreateS3AccessKeyFailure(t *testing.T) {
+	t.Log("Failure case description: client failed to connect authenticator")
+	ctx, cancel := context.WithCancel(context.Background())
+	s := mockBufClient()
+	defer s.Close()
+	cancel()
+	id, _, err := s.CreateS3AccessKey(ctx, emptyString, emptyString, emptyString)
+	assert.Contains(t, err.Error(), context.Canceled.Error())
+	assert.Equal(t, emptyString, id)
+}
+
+func TestGfSpClient_VerifyS3Signature(t *testing.T) {
+	cases := []struct {
+		name          string
+		accessKeyID   string
+		wantedAccount string
+		wantedIsErr   bool
+		wantedErr     error
+	}{
+		{
+			name:          "success",
+			accessKeyID:   mockObjectName3,
+			wantedAccount: "account",
+			wantedIsErr:   false,
+		},
+		{
+			name:        "mock rpc error",
+			accessKeyID: mockObjectName1,
+			wantedIsErr: true,
+			wantedErr:   mockRPCErr,
+		},
+		{
+			name:        "mock response returns error",
+			accessKeyID: mockObjectName2,
+			wantedIsErr: true,
+			wantedErr:   ErrExceptionsStream,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			s := mockBufClient()
+			ctx := context.Background()
+			account, err := s.VerifyS3Signature(ctx, tt.accessKeyID, nil, emptyString, grpc.WithContextDialer(bufDialer), grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if tt.wantedIsErr {
+				assert.Contains(t, err.Error(), tt.wantedErr.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, tt.wantedAccount, account)
+		})
+	}
+}
+
+func TestGfSpClient_VerifyS3SignatureFailure(t *testing.T) {
+	t.Log("Failure case description: client failed to connect authenticator")
+	ctx, cancel := context.WithCancel(context.Background())
+	s := mockBufClient()
+	defer s.Close()
+	cancel()
+	account, err := s.VerifyS3Signature(ctx, emptyString, nil, emptyString)
+	assert.Contains(t, err.Error(), context.Canceled.Error())
+	assert.Equal(t, emptyString, account)
+}
@@ -2,6 +2,7 @@ package gfspclient
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"google.golang.org/grpc"
@@ -35,6 +36,79 @@ func (s *GfSpClient) GetObject(ctx context.Context, downloadObjectTask coretask.
 	return resp.GetData(), nil
 }
 
+// GetObjectStream returns the reader of the object data streamed from the downloader. The first response is
+// received before returning, so the errors of preparing the download such as quota exceeded are returned
+// immediately. The caller should close the reader to release the connection.
+func (s *GfSpClient) GetObjectStream(ctx context.Context, downloadObjectTask coretask.DownloadObjectTask,
+	opts ...grpc.DialOption) (io.ReadCloser, error) {
+	conn, connErr := s.Connection(ctx, s.downloaderEndpoint, opts...)
+	if connErr != nil {
+		log.CtxErrorw(ctx, "client failed to connect downloader", "error", connErr)
+		return nil, ErrRPCUnknownWithDetail("client failed to connect downloader, error: ", connErr)
+	}
+	req := &gfspserver.GfSpDownloadObjectRequest{
+		DownloadObjectTask: downloadObjectTask.(*gfsptask.GfSpDownloadObjectTask),
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := gfspserver.NewGfSpDownloadServiceClient(conn).GfSpDownloadObjectStream(ctx, req)
+	if err != nil {
+		cancel()
+		_ = conn.Close()
+		log.CtxErrorw(ctx, "client failed to download object stream", "error", err)
+		return nil, ErrRPCUnknownWithDetail("client failed to download object stream, error: ", err)
+	}
+	reader := &downloadStreamReader{stream: stream, conn: conn, cancel: cancel}
+	if err = reader.recv(); err != nil && err != io.EOF {
+		_ = reader.Close()
+		log.CtxErrorw(ctx, "client failed to download object stream", "error", err)
+		return nil, err
+	}
+	return reader, nil
+}
+
+// downloadStreamReader reads the data of the download object stream, only the data of one response is
+// buffered, and the downloader is blocked by the gRPC flow control until the data is read.
+type downloadStreamReader struct {
+	stream gfspserver.GfSpDownloadService_GfSpDownloadObjectStreamClient
+	conn   *grpc.ClientConn
+	cancel context.CancelFunc
+	data   []byte
+	err    error
+}
+
+// recv receives the next response, the error is kept and returned by the following reads.
+func (r *downloadStreamReader) recv() error {
+	resp, err := r.stream.Recv()
+	switch {
+	case err == io.EOF:
+		r.err = io.EOF
+	case err != nil:
+		r.err = ErrRPCUnknownWithDetail("client failed to receive object stream, error: ", err)
+	case resp.GetErr() != nil:
+		r.err = resp.GetErr()
+	default:
+		r.data = resp.GetData()
+	}
+	return r.err
+}
+
+func (r *downloadStreamReader) Read(p []byte) (int, error) {
+	for len(r.data) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		_ = r.recv()
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+func (r *downloadStreamReader) Close() error {
+	r.cancel()
+	return r.conn.Close()
+}
+
 func (s *GfSpClient) GetPiece(ctx context.Context, downloadPieceTask coretask.DownloadPieceTask, opts ...grpc.DialOption) (
 	[]byte, error) {
 	conn, connErr := s.Connection(ctx, s.downloaderEndpoint, opts...)
@@ -2,6 +2,7 @@ package gfspclient
 
 import (
 	"context"
+	"io"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -79,6 +80,74 @@ func TestGfSpClient_GetObjectFailure(t *testing.T) {
 	assert.Nil(t, result)
 }
 
+func TestGfSpClient_GetObjectStream(t *testing.T) {
+	cases := []struct {
+		name          string
+		objectName    string
+		wantedResult  []byte
+		wantedOpenErr error
+		wantedReadErr error
+	}{
+		{
+			name:         "success",
+			objectName:   mockObjectName3,
+			wantedResult: []byte(mockBufNet + mockBufNet),
+		},
+		{
+			name:          "mock rpc error",
+			objectName:    mockObjectName1,
+			wantedOpenErr: mockRPCErr,
+		},
+		{
+			name:          "mock response returns error",
+			objectName:    mockObjectName2,
+			wantedOpenErr: ErrExceptionsStream,
+		},
+		{
+			name:          "mock response returns error after data",
+			objectName:    mockObjectName4,
+			wantedResult:  []byte(mockBufNet),
+			wantedReadErr: ErrExceptionsStream,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			s := mockBufClient()
+			task := &gfsptask.GfSpDownloadObjectTask{
+				Task:       &gfsptask.GfSpTask{},
+				ObjectInfo: &storagetypes.ObjectInfo{ObjectName: tt.objectName},
+			}
+			reader, err := s.GetObjectStream(context.Background(), task, grpc.WithContextDialer(bufDialer),
+				grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if tt.wantedOpenErr != nil {
+				assert.Contains(t, err.Error(), tt.wantedOpenErr.Error())
+				assert.Nil(t, reader)
+				return
+			}
+			assert.Nil(t, err)
+			defer reader.Close()
+			result, err := io.ReadAll(reader)
+			assert.Equal(t, tt.wantedResult, result)
+			if tt.wantedReadErr != nil {
+				assert.Contains(t, err.Error(), tt.wantedReadErr.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestGfSpClient_GetObjectStreamFailure(t *testing.T) {
+	t.Log("Failure case description: client failed to connect downloader")
+	ctx, cancel := context.WithCancel(context.Background())
+	s := mockBufClient()
+	defer s.Close()
+	cancel()
+	result, err := s.GetObjectStream(ctx, &gfsptask.GfSpDownloadObjectTask{})
+	assert.Contains(t, err.Error(), context.Canceled.Error())
+	assert.Nil(t, result)
+}
+
 func TestGfSpClient_GetPiece(t *testing.T) {
 	cases := []struct {
 		name         string
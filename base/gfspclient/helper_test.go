@@ -30,6 +30,7 @@ const (
 	mockObjectName1 = "mockObjectName1"
 	mockObjectName2 = "mockObjectName2"
 	mockObjectName3 = "mockObjectName3"
+	mockObjectName4 = "mockObjectName4"
 	mockTxHash      = "txHash"
 )
 
@@ -239,6 +240,28 @@ func (mockAuthenticatorServer) VerifyGNFD1EddsaSignature(ctx context.Context, re
 	}
 }
 
+func (mockAuthenticatorServer) CreateS3AccessKey(ctx context.Context, req *gfspserver.CreateS3AccessKeyRequest) (
+	*gfspserver.CreateS3AccessKeyResponse, error) {
+	if req.GetAccountId() == mockObjectName1 {
+		return nil, mockRPCErr
+	} else if req.GetAccountId() == mockObjectName2 {
+		return &gfspserver.CreateS3AccessKeyResponse{Err: ErrExceptionsStream}, nil
+	} else {
+		return &gfspserver.CreateS3AccessKeyResponse{AccessKeyId: "id", SecretAccessKey: "secret"}, nil
+	}
+}
+
+func (mockAuthenticatorServer) VerifyS3Signature(ctx context.Context, req *gfspserver.VerifyS3SignatureRequest) (
+	*gfspserver.VerifyS3SignatureResponse, error) {
+	if req.GetAccessKeyId() == mockObjectName1 {
+		return nil, mockRPCErr
+	} else if req.GetAccessKeyId() == mockObjectName2 {
+		return &gfspserver.VerifyS3SignatureResponse{Err: ErrExceptionsStream}, nil
+	} else {
+		return &gfspserver.VerifyS3SignatureResponse{AccountId: "account"}, nil
+	}
+}
+
 type mockDownloaderServer struct{}
 
 func (mockDownloaderServer) GfSpDownloadObject(ctx context.Context, req *gfspserver.GfSpDownloadObjectRequest) (
@@ -252,6 +275,28 @@ func (mockDownloaderServer) GfSpDownloadObject(ctx context.Context, req *gfspser
 	}
 }
 
+func (mockDownloaderServer) GfSpDownloadObjectStream(req *gfspserver.GfSpDownloadObjectRequest,
+	stream gfspserver.GfSpDownloadService_GfSpDownloadObjectStreamServer) error {
+	switch req.GetDownloadObjectTask().GetObjectInfo().GetObjectName() {
+	case mockObjectName1:
+		return mockRPCErr
+	case mockObjectName2:
+		return stream.Send(&gfspserver.GfSpDownloadObjectResponse{Err: ErrExceptionsStream})
+	case mockObjectName4:
+		if err := stream.Send(&gfspserver.GfSpDownloadObjectResponse{Data: []byte(mockBufNet)}); err != nil {
+			return err
+		}
+		return stream.Send(&gfspserver.GfSpDownloadObjectResponse{Err: ErrExceptionsStream})
+	default:
+		for _, data := range []string{mockBufNet, "", mockBufNet} {
+			if err := stream.Send(&gfspserver.GfSpDownloadObjectResponse{Data: []byte(data)}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
 func (mockDownloaderServer) GfSpDownloadPiece(ctx context.Context, req *gfspserver.GfSpDownloadPieceRequest) (
 	*gfspserver.GfSpDownloadPieceResponse, error) {
 	if req.GetDownloadPieceTask().GetObjectInfo().GetObjectName() == mockObjectName1 {
@@ -386,6 +431,17 @@ func (mockManagerServer) GfSpPickVirtualGroupFamily(ctx context.Context, req *gf
 	}
 }
 
+func (mockManagerServer) GfSpPickGlobalVirtualGroup(ctx context.Context, req *gfspserver.GfSpPickGlobalVirtualGroupRequest) (
+	*gfspserver.GfSpPickGlobalVirtualGroupResponse, error) {
+	if req.GetUploadObjectTask().GetVirtualGroupFamilyId() == 0 {
+		return nil, mockRPCErr
+	} else if req.GetUploadObjectTask().GetVirtualGroupFamilyId() == 1 {
+		return &gfspserver.GfSpPickGlobalVirtualGroupResponse{Err: ErrExceptionsStream}, nil
+	} else {
+		return &gfspserver.GfSpPickGlobalVirtualGroupResponse{GlobalVirtualGroupId: 1}, nil
+	}
+}
+
 func (mockManagerServer) GfSpNotifyMigrateSwapOut(ctx context.Context, req *gfspserver.GfSpNotifyMigrateSwapOutRequest) (
 	*gfspserver.GfSpNotifyMigrateSwapOutResponse, error) {
 	if req.GetSwapOut().GlobalVirtualGroupFamilyId == 0 {
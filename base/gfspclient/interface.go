@@ -59,11 +59,14 @@ type AuthenticatorAPI interface {
 	VerifyGNFD2EddsaSignature(ctx context.Context, account string, domain string, userPublicKey string, offChainSig string, realMsgToSign []byte, opts ...grpc.DialOption) (bool, error)
 	ListAuthKeysV2(ctx context.Context, account string, domain string, opts ...grpc.DialOption) ([]string, error)
 	DeleteAuthKeysV2(ctx context.Context, account string, domain string, userPublicKeys []string, opts ...grpc.DialOption) (bool, error)
+	CreateS3AccessKey(ctx context.Context, account string, domain string, userPublicKey string, opts ...grpc.DialOption) (string, string, error)
+	VerifyS3Signature(ctx context.Context, accessKeyID string, stringToSign []byte, signature string, opts ...grpc.DialOption) (string, error)
 }
 
 // DownloaderAPI for mock use
 type DownloaderAPI interface {
 	GetObject(ctx context.Context, downloadObjectTask coretask.DownloadObjectTask, opts ...grpc.DialOption) ([]byte, error)
+	GetObjectStream(ctx context.Context, downloadObjectTask coretask.DownloadObjectTask, opts ...grpc.DialOption) (io.ReadCloser, error)
 	GetPiece(ctx context.Context, downloadPieceTask coretask.DownloadPieceTask, opts ...grpc.DialOption) ([]byte, error)
 	GetChallengeInfo(ctx context.Context, challengePieceTask coretask.ChallengePieceTask, opts ...grpc.DialOption) ([]byte, [][]byte, []byte, error)
 	RecoupQuota(ctx context.Context, bucketID, extraQuota uint64, yearMonth string, opts ...grpc.DialOption) error
@@ -92,6 +95,7 @@ type ManagerAPI interface {
 	AskTask(ctx context.Context, limit corercmgr.Limit) (coretask.Task, error)
 	ReportTask(ctx context.Context, report coretask.Task) error
 	PickVirtualGroupFamilyID(ctx context.Context, task coretask.ApprovalCreateBucketTask) (uint32, error)
+	PickGlobalVirtualGroup(ctx context.Context, task coretask.UploadObjectTask) (*gfspserver.GfSpPickGlobalVirtualGroupResponse, error)
 	NotifyMigrateSwapOut(ctx context.Context, swapOut *virtualgrouptypes.MsgSwapOut) error
 	GetTasksStats(ctx context.Context) (*gfspserver.TasksStats, error)
 	GetMigrateBucketProgress(ctx context.Context, bucketID uint64) (*gfspserver.MigrateBucketProgressMeta, error)
@@ -106,10 +110,13 @@ type MetadataAPI interface {
 	GetUserBuckets(ctx context.Context, account string, includeRemoved bool, opts ...grpc.DialOption) ([]*types.VGFInfoBucket, error)
 	ListObjectsByBucketName(ctx context.Context, bucketName string, accountID string, maxKeys uint64, startAfter string, continuationToken string, delimiter string, prefix string, includeRemoved bool,
 		opts ...grpc.DialOption) (objects []*types.Object, keyCount, maxKeysRe uint64, isTruncated bool, nextContinuationToken, name, prefixRe, delimiterRe string, commonPrefixes []string, continuationTokenRe string, err error)
+	ListObjectsByBucketNameAtHeight(ctx context.Context, bucketName string, maxKeys uint64, continuationToken string, prefix string, atHeight int64, opts ...grpc.DialOption) (*types.GfSpListObjectsByBucketNameResponse, error)
 	GetBucketByBucketName(ctx context.Context, bucketName string, includePrivate bool, opts ...grpc.DialOption) (*types.Bucket, error)
+	GetBucketByBucketNameAtHeight(ctx context.Context, bucketName string, includePrivate bool, atHeight int64, opts ...grpc.DialOption) (*types.Bucket, error)
 	GetBucketByBucketID(ctx context.Context, bucketID int64, includePrivate bool, opts ...grpc.DialOption) (*types.Bucket, error)
 	ListExpiredBucketsBySp(ctx context.Context, createAt int64, primarySpID uint32, limit int64, opts ...grpc.DialOption) ([]*types.Bucket, error)
 	GetObjectMeta(ctx context.Context, objectName string, bucketName string, includePrivate bool, opts ...grpc.DialOption) (*types.Object, error)
+	GetObjectMetaAtHeight(ctx context.Context, objectName string, bucketName string, includePrivate bool, atHeight int64, opts ...grpc.DialOption) (*types.Object, error)
 	GetLatestObjectID(ctx context.Context, opts ...grpc.DialOption) (uint64, error)
 	GetPaymentByBucketName(ctx context.Context, bucketName string, includePrivate bool, opts ...grpc.DialOption) (*payment_types.StreamRecord, error)
 	GetPaymentByBucketID(ctx context.Context, bucketID int64, includePrivate bool, opts ...grpc.DialOption) (*payment_types.StreamRecord, error)
@@ -145,8 +152,10 @@ type MetadataAPI interface {
 	GetStatus(ctx context.Context, opts ...grpc.DialOption) (*types.Status, error)
 	GetUserGroups(ctx context.Context, accountID string, startAfter uint64, limit uint32, opts ...grpc.DialOption) ([]*types.GroupMember, error)
 	GetGroupMembers(ctx context.Context, groupID uint64, startAfter string, limit uint32, opts ...grpc.DialOption) ([]*types.GroupMember, error)
+	GetGroupMembersAtHeight(ctx context.Context, groupID uint64, startAfter string, limit uint32, atHeight int64, opts ...grpc.DialOption) ([]*types.GroupMember, error)
 	GetUserOwnedGroups(ctx context.Context, accountID string, startAfter uint64, limit uint32, opts ...grpc.DialOption) ([]*types.GroupMember, error)
 	ListObjectPolicies(ctx context.Context, objectName, bucketName string, startAfter uint64, actionType int32, limit uint32, opts ...grpc.DialOption) ([]*types.Policy, error)
+	ListObjectPoliciesAtHeight(ctx context.Context, objectName, bucketName string, startAfter uint64, actionType int32, limit uint32, atHeight int64, opts ...grpc.DialOption) ([]*types.Policy, error)
 	ListPaymentAccountStreams(ctx context.Context, paymentAccount string, opts ...grpc.DialOption) ([]*types.Bucket, error)
 	ListUserPaymentAccounts(ctx context.Context, accountID string, opts ...grpc.DialOption) ([]*types.PaymentAccountMeta, error)
 	ListGroupsByIDs(ctx context.Context, groupIDs []uint64, opts ...grpc.DialOption) (map[uint64]*types.Group, error)
@@ -157,6 +166,9 @@ type MetadataAPI interface {
 	GetBucketSize(ctx context.Context, bucketID uint64, opts ...grpc.DialOption) (string, error)
 	GetBucketInfoByBucketName(ctx context.Context, bucketName string, opts ...grpc.DialOption) (*types.Bucket, error)
 	GetBsDBInfo(ctx context.Context, blockHeight uint64, opts ...grpc.DialOption) (*types.GfSpGetBsDBInfoResponse, error)
+	PutBucketNotification(ctx context.Context, bucketName string, bucketID uint64, rules []*types.NotificationRule, opts ...grpc.DialOption) error
+	GetBucketNotification(ctx context.Context, bucketName string, opts ...grpc.DialOption) ([]*types.NotificationRule, error)
+	SearchObjects(ctx context.Context, req *types.GfSpSearchObjectsRequest, opts ...grpc.DialOption) (*types.GfSpSearchObjectsResponse, error)
 }
 
 // P2PAPI for mock use
@@ -185,6 +197,7 @@ type SignerAPI interface {
 	SignCreateObjectApproval(ctx context.Context, object *storagetypes.MsgCreateObject) ([]byte, error)
 	DelegateCreateObject(ctx context.Context, object *storagetypes.MsgDelegateCreateObject) (string, error)
 	DelegateUpdateObjectContent(ctx context.Context, object *storagetypes.MsgDelegateUpdateObjectContent) (string, error)
+	DelegateDeleteObject(ctx context.Context, object *storagetypes.MsgDeleteObject) (string, error)
 	SealObject(ctx context.Context, object *storagetypes.MsgSealObject) (string, error)
 	SealObjectV2(ctx context.Context, object *storagetypes.MsgSealObjectV2) (string, error)
 	UpdateSPPrice(ctx context.Context, price *sptypes.MsgUpdateSpStoragePrice) (string, error)
@@ -291,6 +291,27 @@ func (mr *MockGfSpClientAPIMockRecorder) CreateResumableUploadObject(ctx, task a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResumableUploadObject", reflect.TypeOf((*MockGfSpClientAPI)(nil).CreateResumableUploadObject), ctx, task)
 }
 
+// CreateS3AccessKey mocks base method.
+func (m *MockGfSpClientAPI) CreateS3AccessKey(ctx context.Context, account, domain, userPublicKey string, opts ...grpc.DialOption) (string, string, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, account, domain, userPublicKey}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateS3AccessKey", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateS3AccessKey indicates an expected call of CreateS3AccessKey.
+func (mr *MockGfSpClientAPIMockRecorder) CreateS3AccessKey(ctx, account, domain, userPublicKey any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, account, domain, userPublicKey}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateS3AccessKey", reflect.TypeOf((*MockGfSpClientAPI)(nil).CreateS3AccessKey), varargs...)
+}
+
 // CreateUploadObject mocks base method.
 func (m *MockGfSpClientAPI) CreateUploadObject(ctx context.Context, task task.UploadObjectTask) error {
 	m.ctrl.T.Helper()
@@ -339,6 +360,21 @@ func (mr *MockGfSpClientAPIMockRecorder) DelegateCreateObject(ctx, object any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelegateCreateObject", reflect.TypeOf((*MockGfSpClientAPI)(nil).DelegateCreateObject), ctx, object)
 }
 
+// DelegateDeleteObject mocks base method.
+func (m *MockGfSpClientAPI) DelegateDeleteObject(ctx context.Context, object *types3.MsgDeleteObject) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DelegateDeleteObject", ctx, object)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DelegateDeleteObject indicates an expected call of DelegateDeleteObject.
+func (mr *MockGfSpClientAPIMockRecorder) DelegateDeleteObject(ctx, object any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelegateDeleteObject", reflect.TypeOf((*MockGfSpClientAPI)(nil).DelegateDeleteObject), ctx, object)
+}
+
 // DelegateUpdateObjectContent mocks base method.
 func (m *MockGfSpClientAPI) DelegateUpdateObjectContent(ctx context.Context, object *types3.MsgDelegateUpdateObjectContent) (string, error) {
 	m.ctrl.T.Helper()
@@ -559,6 +595,26 @@ func (mr *MockGfSpClientAPIMockRecorder) GetBucketByBucketName(ctx, bucketName,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketByBucketName", reflect.TypeOf((*MockGfSpClientAPI)(nil).GetBucketByBucketName), varargs...)
 }
 
+// GetBucketByBucketNameAtHeight mocks base method.
+func (m *MockGfSpClientAPI) GetBucketByBucketNameAtHeight(ctx context.Context, bucketName string, includePrivate bool, atHeight int64, opts ...grpc.DialOption) (*types.Bucket, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, bucketName, includePrivate, atHeight}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBucketByBucketNameAtHeight", varargs...)
+	ret0, _ := ret[0].(*types.Bucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBucketByBucketNameAtHeight indicates an expected call of GetBucketByBucketNameAtHeight.
+func (mr *MockGfSpClientAPIMockRecorder) GetBucketByBucketNameAtHeight(ctx, bucketName, includePrivate, atHeight any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, bucketName, includePrivate, atHeight}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketByBucketNameAtHeight", reflect.TypeOf((*MockGfSpClientAPI)(nil).GetBucketByBucketNameAtHeight), varargs...)
+}
+
 // GetBucketInfoByBucketName mocks base method.
 func (m *MockGfSpClientAPI) GetBucketInfoByBucketName(ctx context.Context, bucketName string, opts ...grpc.DialOption) (*types.Bucket, error) {
 	m.ctrl.T.Helper()
@@ -600,6 +656,26 @@ func (mr *MockGfSpClientAPIMockRecorder) GetBucketMeta(ctx, bucketName, includeP
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketMeta", reflect.TypeOf((*MockGfSpClientAPI)(nil).GetBucketMeta), varargs...)
 }
 
+// GetBucketNotification mocks base method.
+func (m *MockGfSpClientAPI) GetBucketNotification(ctx context.Context, bucketName string, opts ...grpc.DialOption) ([]*types.NotificationRule, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, bucketName}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBucketNotification", varargs...)
+	ret0, _ := ret[0].([]*types.NotificationRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBucketNotification indicates an expected call of GetBucketNotification.
+func (mr *MockGfSpClientAPIMockRecorder) GetBucketNotification(ctx, bucketName any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, bucketName}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketNotification", reflect.TypeOf((*MockGfSpClientAPI)(nil).GetBucketNotification), varargs...)
+}
+
 // GetBucketReadQuota mocks base method.
 func (m *MockGfSpClientAPI) GetBucketReadQuota(ctx context.Context, bucket *types3.BucketInfo, yearMonth string, opts ...grpc.DialOption) (uint64, uint64, uint64, uint64, uint64, uint64, error) {
 	m.ctrl.T.Helper()
@@ -788,6 +864,26 @@ func (mr *MockGfSpClientAPIMockRecorder) GetGroupMembers(ctx, groupID, startAfte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembers", reflect.TypeOf((*MockGfSpClientAPI)(nil).GetGroupMembers), varargs...)
 }
 
+// GetGroupMembersAtHeight mocks base method.
+func (m *MockGfSpClientAPI) GetGroupMembersAtHeight(ctx context.Context, groupID uint64, startAfter string, limit uint32, atHeight int64, opts ...grpc.DialOption) ([]*types.GroupMember, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, groupID, startAfter, limit, atHeight}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetGroupMembersAtHeight", varargs...)
+	ret0, _ := ret[0].([]*types.GroupMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupMembersAtHeight indicates an expected call of GetGroupMembersAtHeight.
+func (mr *MockGfSpClientAPIMockRecorder) GetGroupMembersAtHeight(ctx, groupID, startAfter, limit, atHeight any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, groupID, startAfter, limit, atHeight}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembersAtHeight", reflect.TypeOf((*MockGfSpClientAPI)(nil).GetGroupMembersAtHeight), varargs...)
+}
+
 // GetLatestBucketReadQuota mocks base method.
 func (m *MockGfSpClientAPI) GetLatestBucketReadQuota(ctx context.Context, bucketID uint64, opts ...grpc.DialOption) (*gfsptask.GfSpBucketQuotaInfo, error) {
 	m.ctrl.T.Helper()
@@ -903,6 +999,46 @@ func (mr *MockGfSpClientAPIMockRecorder) GetObjectMeta(ctx, objectName, bucketNa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectMeta", reflect.TypeOf((*MockGfSpClientAPI)(nil).GetObjectMeta), varargs...)
 }
 
+// GetObjectMetaAtHeight mocks base method.
+func (m *MockGfSpClientAPI) GetObjectMetaAtHeight(ctx context.Context, objectName, bucketName string, includePrivate bool, atHeight int64, opts ...grpc.DialOption) (*types.Object, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, objectName, bucketName, includePrivate, atHeight}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetObjectMetaAtHeight", varargs...)
+	ret0, _ := ret[0].(*types.Object)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetObjectMetaAtHeight indicates an expected call of GetObjectMetaAtHeight.
+func (mr *MockGfSpClientAPIMockRecorder) GetObjectMetaAtHeight(ctx, objectName, bucketName, includePrivate, atHeight any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, objectName, bucketName, includePrivate, atHeight}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectMetaAtHeight", reflect.TypeOf((*MockGfSpClientAPI)(nil).GetObjectMetaAtHeight), varargs...)
+}
+
+// GetObjectStream mocks base method.
+func (m *MockGfSpClientAPI) GetObjectStream(ctx context.Context, downloadObjectTask task.DownloadObjectTask, opts ...grpc.DialOption) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, downloadObjectTask}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetObjectStream", varargs...)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetObjectStream indicates an expected call of GetObjectStream.
+func (mr *MockGfSpClientAPIMockRecorder) GetObjectStream(ctx, downloadObjectTask any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, downloadObjectTask}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectStream", reflect.TypeOf((*MockGfSpClientAPI)(nil).GetObjectStream), varargs...)
+}
+
 // GetPaymentByBucketID mocks base method.
 func (m *MockGfSpClientAPI) GetPaymentByBucketID(ctx context.Context, bucketID int64, includePrivate bool, opts ...grpc.DialOption) (*types0.StreamRecord, error) {
 	m.ctrl.T.Helper()
@@ -1480,6 +1616,26 @@ func (mr *MockGfSpClientAPIMockRecorder) ListObjectPolicies(ctx, objectName, buc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectPolicies", reflect.TypeOf((*MockGfSpClientAPI)(nil).ListObjectPolicies), varargs...)
 }
 
+// ListObjectPoliciesAtHeight mocks base method.
+func (m *MockGfSpClientAPI) ListObjectPoliciesAtHeight(ctx context.Context, objectName, bucketName string, startAfter uint64, actionType int32, limit uint32, atHeight int64, opts ...grpc.DialOption) ([]*types.Policy, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, objectName, bucketName, startAfter, actionType, limit, atHeight}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListObjectPoliciesAtHeight", varargs...)
+	ret0, _ := ret[0].([]*types.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjectPoliciesAtHeight indicates an expected call of ListObjectPoliciesAtHeight.
+func (mr *MockGfSpClientAPIMockRecorder) ListObjectPoliciesAtHeight(ctx, objectName, bucketName, startAfter, actionType, limit, atHeight any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, objectName, bucketName, startAfter, actionType, limit, atHeight}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectPoliciesAtHeight", reflect.TypeOf((*MockGfSpClientAPI)(nil).ListObjectPoliciesAtHeight), varargs...)
+}
+
 // ListObjectsByBucketName mocks base method.
 func (m *MockGfSpClientAPI) ListObjectsByBucketName(ctx context.Context, bucketName, accountID string, maxKeys uint64, startAfter, continuationToken, delimiter, prefix string, includeRemoved bool, opts ...grpc.DialOption) ([]*types.Object, uint64, uint64, bool, string, string, string, string, []string, string, error) {
 	m.ctrl.T.Helper()
@@ -1509,6 +1665,26 @@ func (mr *MockGfSpClientAPIMockRecorder) ListObjectsByBucketName(ctx, bucketName
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectsByBucketName", reflect.TypeOf((*MockGfSpClientAPI)(nil).ListObjectsByBucketName), varargs...)
 }
 
+// ListObjectsByBucketNameAtHeight mocks base method.
+func (m *MockGfSpClientAPI) ListObjectsByBucketNameAtHeight(ctx context.Context, bucketName string, maxKeys uint64, continuationToken, prefix string, atHeight int64, opts ...grpc.DialOption) (*types.GfSpListObjectsByBucketNameResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, bucketName, maxKeys, continuationToken, prefix, atHeight}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListObjectsByBucketNameAtHeight", varargs...)
+	ret0, _ := ret[0].(*types.GfSpListObjectsByBucketNameResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjectsByBucketNameAtHeight indicates an expected call of ListObjectsByBucketNameAtHeight.
+func (mr *MockGfSpClientAPIMockRecorder) ListObjectsByBucketNameAtHeight(ctx, bucketName, maxKeys, continuationToken, prefix, atHeight any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, bucketName, maxKeys, continuationToken, prefix, atHeight}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectsByBucketNameAtHeight", reflect.TypeOf((*MockGfSpClientAPI)(nil).ListObjectsByBucketNameAtHeight), varargs...)
+}
+
 // ListObjectsByGVGAndBucketForGC mocks base method.
 func (m *MockGfSpClientAPI) ListObjectsByGVGAndBucketForGC(ctx context.Context, gvgID uint32, bucketID, startAfter uint64, limit uint32, opts ...grpc.DialOption) ([]*types.ObjectDetails, error) {
 	m.ctrl.T.Helper()
@@ -1802,6 +1978,21 @@ func (mr *MockGfSpClientAPIMockRecorder) P2PConn(ctx any, opts ...any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "P2PConn", reflect.TypeOf((*MockGfSpClientAPI)(nil).P2PConn), varargs...)
 }
 
+// PickGlobalVirtualGroup mocks base method.
+func (m *MockGfSpClientAPI) PickGlobalVirtualGroup(ctx context.Context, task task.UploadObjectTask) (*gfspserver.GfSpPickGlobalVirtualGroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PickGlobalVirtualGroup", ctx, task)
+	ret0, _ := ret[0].(*gfspserver.GfSpPickGlobalVirtualGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PickGlobalVirtualGroup indicates an expected call of PickGlobalVirtualGroup.
+func (mr *MockGfSpClientAPIMockRecorder) PickGlobalVirtualGroup(ctx, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PickGlobalVirtualGroup", reflect.TypeOf((*MockGfSpClientAPI)(nil).PickGlobalVirtualGroup), ctx, task)
+}
+
 // PickVirtualGroupFamilyID mocks base method.
 func (m *MockGfSpClientAPI) PickVirtualGroupFamilyID(ctx context.Context, task task.ApprovalCreateBucketTask) (uint32, error) {
 	m.ctrl.T.Helper()
@@ -1868,6 +2059,25 @@ func (mr *MockGfSpClientAPIMockRecorder) PrimarySpIncomeDetails(ctx, spID any, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrimarySpIncomeDetails", reflect.TypeOf((*MockGfSpClientAPI)(nil).PrimarySpIncomeDetails), varargs...)
 }
 
+// PutBucketNotification mocks base method.
+func (m *MockGfSpClientAPI) PutBucketNotification(ctx context.Context, bucketName string, bucketID uint64, rules []*types.NotificationRule, opts ...grpc.DialOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, bucketName, bucketID, rules}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutBucketNotification", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutBucketNotification indicates an expected call of PutBucketNotification.
+func (mr *MockGfSpClientAPIMockRecorder) PutBucketNotification(ctx, bucketName, bucketID, rules any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, bucketName, bucketID, rules}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutBucketNotification", reflect.TypeOf((*MockGfSpClientAPI)(nil).PutBucketNotification), varargs...)
+}
+
 // QueryBucketMigrate mocks base method.
 func (m *MockGfSpClientAPI) QueryBucketMigrate(ctx context.Context, endpoint string, opts ...grpc.DialOption) (string, error) {
 	m.ctrl.T.Helper()
@@ -2147,6 +2357,26 @@ func (mr *MockGfSpClientAPIMockRecorder) SealObjectV2(ctx, object any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SealObjectV2", reflect.TypeOf((*MockGfSpClientAPI)(nil).SealObjectV2), ctx, object)
 }
 
+// SearchObjects mocks base method.
+func (m *MockGfSpClientAPI) SearchObjects(ctx context.Context, req *types.GfSpSearchObjectsRequest, opts ...grpc.DialOption) (*types.GfSpSearchObjectsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchObjects", varargs...)
+	ret0, _ := ret[0].(*types.GfSpSearchObjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchObjects indicates an expected call of SearchObjects.
+func (mr *MockGfSpClientAPIMockRecorder) SearchObjects(ctx, req any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, req}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchObjects", reflect.TypeOf((*MockGfSpClientAPI)(nil).SearchObjects), varargs...)
+}
+
 // SecondarySpIncomeDetails mocks base method.
 func (m *MockGfSpClientAPI) SecondarySpIncomeDetails(ctx context.Context, spID uint32, opts ...grpc.DialOption) (int64, []*types.SecondarySpIncomeDetail, error) {
 	m.ctrl.T.Helper()
@@ -2592,6 +2822,26 @@ func (mr *MockGfSpClientAPIMockRecorder) VerifyPermissionByID(ctx, Operator, res
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyPermissionByID", reflect.TypeOf((*MockGfSpClientAPI)(nil).VerifyPermissionByID), varargs...)
 }
 
+// VerifyS3Signature mocks base method.
+func (m *MockGfSpClientAPI) VerifyS3Signature(ctx context.Context, accessKeyID string, stringToSign []byte, signature string, opts ...grpc.DialOption) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, accessKeyID, stringToSign, signature}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VerifyS3Signature", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyS3Signature indicates an expected call of VerifyS3Signature.
+func (mr *MockGfSpClientAPIMockRecorder) VerifyS3Signature(ctx, accessKeyID, stringToSign, signature any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, accessKeyID, stringToSign, signature}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyS3Signature", reflect.TypeOf((*MockGfSpClientAPI)(nil).VerifyS3Signature), varargs...)
+}
+
 // MockApproverAPI is a mock of ApproverAPI interface.
 type MockApproverAPI struct {
 	ctrl     *gomock.Controller
@@ -2702,6 +2952,27 @@ func (m *MockAuthenticatorAPI) EXPECT() *MockAuthenticatorAPIMockRecorder {
 	return m.recorder
 }
 
+// CreateS3AccessKey mocks base method.
+func (m *MockAuthenticatorAPI) CreateS3AccessKey(ctx context.Context, account, domain, userPublicKey string, opts ...grpc.DialOption) (string, string, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, account, domain, userPublicKey}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateS3AccessKey", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateS3AccessKey indicates an expected call of CreateS3AccessKey.
+func (mr *MockAuthenticatorAPIMockRecorder) CreateS3AccessKey(ctx, account, domain, userPublicKey any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, account, domain, userPublicKey}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateS3AccessKey", reflect.TypeOf((*MockAuthenticatorAPI)(nil).CreateS3AccessKey), varargs...)
+}
+
 // DeleteAuthKeysV2 mocks base method.
 func (m *MockAuthenticatorAPI) DeleteAuthKeysV2(ctx context.Context, account, domain string, userPublicKeys []string, opts ...grpc.DialOption) (bool, error) {
 	m.ctrl.T.Helper()
@@ -2886,6 +3157,26 @@ func (mr *MockAuthenticatorAPIMockRecorder) VerifyGNFD2EddsaSignature(ctx, accou
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyGNFD2EddsaSignature", reflect.TypeOf((*MockAuthenticatorAPI)(nil).VerifyGNFD2EddsaSignature), varargs...)
 }
 
+// VerifyS3Signature mocks base method.
+func (m *MockAuthenticatorAPI) VerifyS3Signature(ctx context.Context, accessKeyID string, stringToSign []byte, signature string, opts ...grpc.DialOption) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, accessKeyID, stringToSign, signature}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VerifyS3Signature", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyS3Signature indicates an expected call of VerifyS3Signature.
+func (mr *MockAuthenticatorAPIMockRecorder) VerifyS3Signature(ctx, accessKeyID, stringToSign, signature any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, accessKeyID, stringToSign, signature}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyS3Signature", reflect.TypeOf((*MockAuthenticatorAPI)(nil).VerifyS3Signature), varargs...)
+}
+
 // MockDownloaderAPI is a mock of DownloaderAPI interface.
 type MockDownloaderAPI struct {
 	ctrl     *gomock.Controller
@@ -2970,6 +3261,26 @@ func (mr *MockDownloaderAPIMockRecorder) GetObject(ctx, downloadObjectTask any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObject", reflect.TypeOf((*MockDownloaderAPI)(nil).GetObject), varargs...)
 }
 
+// GetObjectStream mocks base method.
+func (m *MockDownloaderAPI) GetObjectStream(ctx context.Context, downloadObjectTask task.DownloadObjectTask, opts ...grpc.DialOption) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, downloadObjectTask}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetObjectStream", varargs...)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetObjectStream indicates an expected call of GetObjectStream.
+func (mr *MockDownloaderAPIMockRecorder) GetObjectStream(ctx, downloadObjectTask any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, downloadObjectTask}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectStream", reflect.TypeOf((*MockDownloaderAPI)(nil).GetObjectStream), varargs...)
+}
+
 // GetPiece mocks base method.
 func (m *MockDownloaderAPI) GetPiece(ctx context.Context, downloadPieceTask task.DownloadPieceTask, opts ...grpc.DialOption) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -3334,6 +3645,21 @@ func (mr *MockManagerAPIMockRecorder) NotifyPreMigrateBucketAndDeductQuota(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyPreMigrateBucketAndDeductQuota", reflect.TypeOf((*MockManagerAPI)(nil).NotifyPreMigrateBucketAndDeductQuota), ctx, bucketID)
 }
 
+// PickGlobalVirtualGroup mocks base method.
+func (m *MockManagerAPI) PickGlobalVirtualGroup(ctx context.Context, task task.UploadObjectTask) (*gfspserver.GfSpPickGlobalVirtualGroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PickGlobalVirtualGroup", ctx, task)
+	ret0, _ := ret[0].(*gfspserver.GfSpPickGlobalVirtualGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PickGlobalVirtualGroup indicates an expected call of PickGlobalVirtualGroup.
+func (mr *MockManagerAPIMockRecorder) PickGlobalVirtualGroup(ctx, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PickGlobalVirtualGroup", reflect.TypeOf((*MockManagerAPI)(nil).PickGlobalVirtualGroup), ctx, task)
+}
+
 // PickVirtualGroupFamilyID mocks base method.
 func (m *MockManagerAPI) PickVirtualGroupFamilyID(ctx context.Context, task task.ApprovalCreateBucketTask) (uint32, error) {
 	m.ctrl.T.Helper()
@@ -3446,6 +3772,26 @@ func (mr *MockMetadataAPIMockRecorder) GetBucketByBucketName(ctx, bucketName, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketByBucketName", reflect.TypeOf((*MockMetadataAPI)(nil).GetBucketByBucketName), varargs...)
 }
 
+// GetBucketByBucketNameAtHeight mocks base method.
+func (m *MockMetadataAPI) GetBucketByBucketNameAtHeight(ctx context.Context, bucketName string, includePrivate bool, atHeight int64, opts ...grpc.DialOption) (*types.Bucket, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, bucketName, includePrivate, atHeight}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBucketByBucketNameAtHeight", varargs...)
+	ret0, _ := ret[0].(*types.Bucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBucketByBucketNameAtHeight indicates an expected call of GetBucketByBucketNameAtHeight.
+func (mr *MockMetadataAPIMockRecorder) GetBucketByBucketNameAtHeight(ctx, bucketName, includePrivate, atHeight any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, bucketName, includePrivate, atHeight}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketByBucketNameAtHeight", reflect.TypeOf((*MockMetadataAPI)(nil).GetBucketByBucketNameAtHeight), varargs...)
+}
+
 // GetBucketInfoByBucketName mocks base method.
 func (m *MockMetadataAPI) GetBucketInfoByBucketName(ctx context.Context, bucketName string, opts ...grpc.DialOption) (*types.Bucket, error) {
 	m.ctrl.T.Helper()
@@ -3487,6 +3833,26 @@ func (mr *MockMetadataAPIMockRecorder) GetBucketMeta(ctx, bucketName, includePri
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketMeta", reflect.TypeOf((*MockMetadataAPI)(nil).GetBucketMeta), varargs...)
 }
 
+// GetBucketNotification mocks base method.
+func (m *MockMetadataAPI) GetBucketNotification(ctx context.Context, bucketName string, opts ...grpc.DialOption) ([]*types.NotificationRule, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, bucketName}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBucketNotification", varargs...)
+	ret0, _ := ret[0].([]*types.NotificationRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBucketNotification indicates an expected call of GetBucketNotification.
+func (mr *MockMetadataAPIMockRecorder) GetBucketNotification(ctx, bucketName any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, bucketName}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketNotification", reflect.TypeOf((*MockMetadataAPI)(nil).GetBucketNotification), varargs...)
+}
+
 // GetBucketReadQuota mocks base method.
 func (m *MockMetadataAPI) GetBucketReadQuota(ctx context.Context, bucket *types3.BucketInfo, yearMonth string, opts ...grpc.DialOption) (uint64, uint64, uint64, uint64, uint64, uint64, error) {
 	m.ctrl.T.Helper()
@@ -3653,6 +4019,26 @@ func (mr *MockMetadataAPIMockRecorder) GetGroupMembers(ctx, groupID, startAfter,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembers", reflect.TypeOf((*MockMetadataAPI)(nil).GetGroupMembers), varargs...)
 }
 
+// GetGroupMembersAtHeight mocks base method.
+func (m *MockMetadataAPI) GetGroupMembersAtHeight(ctx context.Context, groupID uint64, startAfter string, limit uint32, atHeight int64, opts ...grpc.DialOption) ([]*types.GroupMember, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, groupID, startAfter, limit, atHeight}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetGroupMembersAtHeight", varargs...)
+	ret0, _ := ret[0].([]*types.GroupMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupMembersAtHeight indicates an expected call of GetGroupMembersAtHeight.
+func (mr *MockMetadataAPIMockRecorder) GetGroupMembersAtHeight(ctx, groupID, startAfter, limit, atHeight any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, groupID, startAfter, limit, atHeight}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembersAtHeight", reflect.TypeOf((*MockMetadataAPI)(nil).GetGroupMembersAtHeight), varargs...)
+}
+
 // GetLatestBucketReadQuota mocks base method.
 func (m *MockMetadataAPI) GetLatestBucketReadQuota(ctx context.Context, bucketID uint64, opts ...grpc.DialOption) (*gfsptask.GfSpBucketQuotaInfo, error) {
 	m.ctrl.T.Helper()
@@ -3733,6 +4119,26 @@ func (mr *MockMetadataAPIMockRecorder) GetObjectMeta(ctx, objectName, bucketName
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectMeta", reflect.TypeOf((*MockMetadataAPI)(nil).GetObjectMeta), varargs...)
 }
 
+// GetObjectMetaAtHeight mocks base method.
+func (m *MockMetadataAPI) GetObjectMetaAtHeight(ctx context.Context, objectName, bucketName string, includePrivate bool, atHeight int64, opts ...grpc.DialOption) (*types.Object, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, objectName, bucketName, includePrivate, atHeight}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetObjectMetaAtHeight", varargs...)
+	ret0, _ := ret[0].(*types.Object)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetObjectMetaAtHeight indicates an expected call of GetObjectMetaAtHeight.
+func (mr *MockMetadataAPIMockRecorder) GetObjectMetaAtHeight(ctx, objectName, bucketName, includePrivate, atHeight any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, objectName, bucketName, includePrivate, atHeight}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectMetaAtHeight", reflect.TypeOf((*MockMetadataAPI)(nil).GetObjectMetaAtHeight), varargs...)
+}
+
 // GetPaymentByBucketID mocks base method.
 func (m *MockMetadataAPI) GetPaymentByBucketID(ctx context.Context, bucketID int64, includePrivate bool, opts ...grpc.DialOption) (*types0.StreamRecord, error) {
 	m.ctrl.T.Helper()
@@ -4196,6 +4602,26 @@ func (mr *MockMetadataAPIMockRecorder) ListObjectPolicies(ctx, objectName, bucke
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectPolicies", reflect.TypeOf((*MockMetadataAPI)(nil).ListObjectPolicies), varargs...)
 }
 
+// ListObjectPoliciesAtHeight mocks base method.
+func (m *MockMetadataAPI) ListObjectPoliciesAtHeight(ctx context.Context, objectName, bucketName string, startAfter uint64, actionType int32, limit uint32, atHeight int64, opts ...grpc.DialOption) ([]*types.Policy, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, objectName, bucketName, startAfter, actionType, limit, atHeight}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListObjectPoliciesAtHeight", varargs...)
+	ret0, _ := ret[0].([]*types.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjectPoliciesAtHeight indicates an expected call of ListObjectPoliciesAtHeight.
+func (mr *MockMetadataAPIMockRecorder) ListObjectPoliciesAtHeight(ctx, objectName, bucketName, startAfter, actionType, limit, atHeight any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, objectName, bucketName, startAfter, actionType, limit, atHeight}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectPoliciesAtHeight", reflect.TypeOf((*MockMetadataAPI)(nil).ListObjectPoliciesAtHeight), varargs...)
+}
+
 // ListObjectsByBucketName mocks base method.
 func (m *MockMetadataAPI) ListObjectsByBucketName(ctx context.Context, bucketName, accountID string, maxKeys uint64, startAfter, continuationToken, delimiter, prefix string, includeRemoved bool, opts ...grpc.DialOption) ([]*types.Object, uint64, uint64, bool, string, string, string, string, []string, string, error) {
 	m.ctrl.T.Helper()
@@ -4225,6 +4651,26 @@ func (mr *MockMetadataAPIMockRecorder) ListObjectsByBucketName(ctx, bucketName,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectsByBucketName", reflect.TypeOf((*MockMetadataAPI)(nil).ListObjectsByBucketName), varargs...)
 }
 
+// ListObjectsByBucketNameAtHeight mocks base method.
+func (m *MockMetadataAPI) ListObjectsByBucketNameAtHeight(ctx context.Context, bucketName string, maxKeys uint64, continuationToken, prefix string, atHeight int64, opts ...grpc.DialOption) (*types.GfSpListObjectsByBucketNameResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, bucketName, maxKeys, continuationToken, prefix, atHeight}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListObjectsByBucketNameAtHeight", varargs...)
+	ret0, _ := ret[0].(*types.GfSpListObjectsByBucketNameResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjectsByBucketNameAtHeight indicates an expected call of ListObjectsByBucketNameAtHeight.
+func (mr *MockMetadataAPIMockRecorder) ListObjectsByBucketNameAtHeight(ctx, bucketName, maxKeys, continuationToken, prefix, atHeight any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, bucketName, maxKeys, continuationToken, prefix, atHeight}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectsByBucketNameAtHeight", reflect.TypeOf((*MockMetadataAPI)(nil).ListObjectsByBucketNameAtHeight), varargs...)
+}
+
 // ListObjectsByGVGAndBucketForGC mocks base method.
 func (m *MockMetadataAPI) ListObjectsByGVGAndBucketForGC(ctx context.Context, gvgID uint32, bucketID, startAfter uint64, limit uint32, opts ...grpc.DialOption) ([]*types.ObjectDetails, error) {
 	m.ctrl.T.Helper()
@@ -4426,6 +4872,45 @@ func (mr *MockMetadataAPIMockRecorder) PrimarySpIncomeDetails(ctx, spID any, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrimarySpIncomeDetails", reflect.TypeOf((*MockMetadataAPI)(nil).PrimarySpIncomeDetails), varargs...)
 }
 
+// PutBucketNotification mocks base method.
+func (m *MockMetadataAPI) PutBucketNotification(ctx context.Context, bucketName string, bucketID uint64, rules []*types.NotificationRule, opts ...grpc.DialOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, bucketName, bucketID, rules}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutBucketNotification", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutBucketNotification indicates an expected call of PutBucketNotification.
+func (mr *MockMetadataAPIMockRecorder) PutBucketNotification(ctx, bucketName, bucketID, rules any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, bucketName, bucketID, rules}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutBucketNotification", reflect.TypeOf((*MockMetadataAPI)(nil).PutBucketNotification), varargs...)
+}
+
+// SearchObjects mocks base method.
+func (m *MockMetadataAPI) SearchObjects(ctx context.Context, req *types.GfSpSearchObjectsRequest, opts ...grpc.DialOption) (*types.GfSpSearchObjectsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchObjects", varargs...)
+	ret0, _ := ret[0].(*types.GfSpSearchObjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchObjects indicates an expected call of SearchObjects.
+func (mr *MockMetadataAPIMockRecorder) SearchObjects(ctx, req any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, req}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchObjects", reflect.TypeOf((*MockMetadataAPI)(nil).SearchObjects), varargs...)
+}
+
 // SecondarySpIncomeDetails mocks base method.
 func (m *MockMetadataAPI) SecondarySpIncomeDetails(ctx context.Context, spID uint32, opts ...grpc.DialOption) (int64, []*types.SecondarySpIncomeDetail, error) {
 	m.ctrl.T.Helper()
@@ -4818,6 +5303,21 @@ func (mr *MockSignerAPIMockRecorder) DelegateCreateObject(ctx, object any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelegateCreateObject", reflect.TypeOf((*MockSignerAPI)(nil).DelegateCreateObject), ctx, object)
 }
 
+// DelegateDeleteObject mocks base method.
+func (m *MockSignerAPI) DelegateDeleteObject(ctx context.Context, object *types3.MsgDeleteObject) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DelegateDeleteObject", ctx, object)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DelegateDeleteObject indicates an expected call of DelegateDeleteObject.
+func (mr *MockSignerAPIMockRecorder) DelegateDeleteObject(ctx, object any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelegateDeleteObject", reflect.TypeOf((*MockSignerAPI)(nil).DelegateDeleteObject), ctx, object)
+}
+
 // DelegateUpdateObjectContent mocks base method.
 func (m *MockSignerAPI) DelegateUpdateObjectContent(ctx context.Context, object *types3.MsgDelegateUpdateObjectContent) (string, error) {
 	m.ctrl.T.Helper()
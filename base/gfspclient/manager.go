@@ -170,6 +170,27 @@ func (s *GfSpClient) PickVirtualGroupFamilyID(ctx context.Context, task coretask
 	return resp.VgfId, nil
 }
 
+func (s *GfSpClient) PickGlobalVirtualGroup(ctx context.Context, task coretask.UploadObjectTask) (
+	*gfspserver.GfSpPickGlobalVirtualGroupResponse, error) {
+	conn, connErr := s.ManagerConn(ctx)
+	if connErr != nil {
+		log.CtxErrorw(ctx, "client failed to connect manager", "error", connErr)
+		return nil, ErrRPCUnknownWithDetail("client failed to connect manager, error: ", connErr)
+	}
+	req := &gfspserver.GfSpPickGlobalVirtualGroupRequest{
+		UploadObjectTask: task.(*gfsptask.GfSpUploadObjectTask),
+	}
+	resp, err := gfspserver.NewGfSpManageServiceClient(conn).GfSpPickGlobalVirtualGroup(ctx, req)
+	if err != nil {
+		log.CtxErrorw(ctx, "client failed to pick global virtual group", "error", err)
+		return nil, ErrRPCUnknownWithDetail("client failed to pick global virtual group, error: ", err)
+	}
+	if resp.GetErr() != nil {
+		return nil, resp.GetErr()
+	}
+	return resp, nil
+}
+
 func (s *GfSpClient) NotifyMigrateSwapOut(ctx context.Context, swapOut *virtualgrouptypes.MsgSwapOut) error {
 	conn, connErr := s.ManagerConn(ctx)
 	if connErr != nil {
@@ -418,6 +418,47 @@ func TestGfSpClient_PickVirtualGroupFamilyIDFailure(t *testing.T) {
 	assert.Equal(t, uint32(0), result)
 }
 
+func TestGfSpClient_PickGlobalVirtualGroup(t *testing.T) {
+	cases := []struct {
+		name        string
+		task        coretask.UploadObjectTask
+		wantedIsErr bool
+		wantedErr   error
+	}{
+		{
+			name:        "success",
+			task:        &gfsptask.GfSpUploadObjectTask{Task: &gfsptask.GfSpTask{}, VirtualGroupFamilyId: 2},
+			wantedIsErr: false,
+		},
+		{
+			name:        "mock rpc error",
+			task:        &gfsptask.GfSpUploadObjectTask{Task: &gfsptask.GfSpTask{}, VirtualGroupFamilyId: 0},
+			wantedIsErr: true,
+			wantedErr:   mockRPCErr,
+		},
+		{
+			name:        "mock response returns error",
+			task:        &gfsptask.GfSpUploadObjectTask{Task: &gfsptask.GfSpTask{}, VirtualGroupFamilyId: 1},
+			wantedIsErr: true,
+			wantedErr:   ErrExceptionsStream,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := setup(t, ctx)
+			result, err := s.PickGlobalVirtualGroup(ctx, tt.task)
+			if tt.wantedIsErr {
+				assert.Contains(t, err.Error(), tt.wantedErr.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, uint32(1), result.GetGlobalVirtualGroupId())
+			}
+		})
+	}
+}
+
 func TestGfSpClient_NotifyMigrateSwapOut(t *testing.T) {
 	cases := []struct {
 		name        string
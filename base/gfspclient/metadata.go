@@ -104,6 +104,33 @@ func (s *GfSpClient) ListObjectsByBucketName(ctx context.Context, bucketName str
 		resp.GetName(), resp.GetPrefix(), resp.GetDelimiter(), resp.GetCommonPrefixes(), resp.GetContinuationToken(), nil
 }
 
+// ListObjectsByBucketNameAtHeight list objects info by a bucket name at the block height
+func (s *GfSpClient) ListObjectsByBucketNameAtHeight(ctx context.Context, bucketName string, maxKeys uint64,
+	continuationToken string, prefix string, atHeight int64, opts ...grpc.DialOption) (
+	*types.GfSpListObjectsByBucketNameResponse, error) {
+	conn, err := s.Connection(ctx, s.metadataEndpoint, opts...)
+	if err != nil {
+		return nil, ErrRPCUnknownWithDetail("client failed to connect metadata, error: ", err)
+	}
+	defer conn.Close()
+
+	req := &types.GfSpListObjectsByBucketNameRequest{
+		BucketName:        bucketName,
+		MaxKeys:           maxKeys,
+		ContinuationToken: continuationToken,
+		Prefix:            prefix,
+		AtHeight:          atHeight,
+	}
+
+	resp, err := types.NewGfSpMetadataServiceClient(conn).GfSpListObjectsByBucketName(ctx, req)
+	ctx = log.Context(ctx, resp)
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to send list objects by bucket name at height rpc", "error", err)
+		return nil, ErrRPCUnknownWithDetail("failed to send list objects by bucket name at height rpc, error: ", err)
+	}
+	return resp, nil
+}
+
 // GetBucketByBucketName get bucket info by a bucket name
 func (s *GfSpClient) GetBucketByBucketName(ctx context.Context, bucketName string, includePrivate bool,
 	opts ...grpc.DialOption) (*types.Bucket, error) {
@@ -127,6 +154,30 @@ func (s *GfSpClient) GetBucketByBucketName(ctx context.Context, bucketName strin
 	return resp.GetBucket(), nil
 }
 
+// GetBucketByBucketNameAtHeight get bucket info by a bucket name at the block height
+func (s *GfSpClient) GetBucketByBucketNameAtHeight(ctx context.Context, bucketName string, includePrivate bool,
+	atHeight int64, opts ...grpc.DialOption) (*types.Bucket, error) {
+	conn, err := s.Connection(ctx, s.metadataEndpoint, opts...)
+	if err != nil {
+		return nil, ErrRPCUnknownWithDetail("client failed to connect metadata, error: ", err)
+	}
+	defer conn.Close()
+
+	req := &types.GfSpGetBucketByBucketNameRequest{
+		BucketName:     bucketName,
+		IncludePrivate: includePrivate,
+		AtHeight:       atHeight,
+	}
+
+	resp, err := types.NewGfSpMetadataServiceClient(conn).GfSpGetBucketByBucketName(ctx, req)
+	ctx = log.Context(ctx, resp)
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to send get bucket rpc by bucket name at height", "error", err)
+		return nil, ErrRPCUnknownWithDetail("failed to send get bucket rpc by bucket name at height, error: ", err)
+	}
+	return resp.GetBucket(), nil
+}
+
 // GetBucketByBucketID get bucket info by a bucket id
 func (s *GfSpClient) GetBucketByBucketID(ctx context.Context, bucketID int64, includePrivate bool,
 	opts ...grpc.DialOption) (*types.Bucket, error) {
@@ -198,6 +249,31 @@ func (s *GfSpClient) GetObjectMeta(ctx context.Context, objectName string, bucke
 	return resp.GetObject(), nil
 }
 
+// GetObjectMetaAtHeight get object metadata at the block height
+func (s *GfSpClient) GetObjectMetaAtHeight(ctx context.Context, objectName string, bucketName string,
+	includePrivate bool, atHeight int64, opts ...grpc.DialOption) (*types.Object, error) {
+	conn, err := s.Connection(ctx, s.metadataEndpoint, opts...)
+	if err != nil {
+		return nil, ErrRPCUnknownWithDetail("client failed to connect metadata, error: ", err)
+	}
+	defer conn.Close()
+
+	req := &types.GfSpGetObjectMetaRequest{
+		ObjectName:     objectName,
+		BucketName:     bucketName,
+		IncludePrivate: includePrivate,
+		AtHeight:       atHeight,
+	}
+
+	resp, err := types.NewGfSpMetadataServiceClient(conn).GfSpGetObjectMeta(ctx, req)
+	ctx = log.Context(ctx, resp)
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to send get object meta at height rpc", "error", err)
+		return nil, ErrRPCUnknownWithDetail("failed to send get object meta at height rpc, error: ", err)
+	}
+	return resp.GetObject(), nil
+}
+
 // GetLatestObjectID get latest object id
 func (s *GfSpClient) GetLatestObjectID(ctx context.Context, opts ...grpc.DialOption) (uint64, error) {
 	conn, err := s.Connection(ctx, s.metadataEndpoint, opts...)
@@ -904,6 +980,27 @@ func (s *GfSpClient) GetGroupMembers(ctx context.Context, groupID uint64, startA
 	return resp.Groups, nil
 }
 
+func (s *GfSpClient) GetGroupMembersAtHeight(ctx context.Context, groupID uint64, startAfter string, limit uint32, atHeight int64, opts ...grpc.DialOption) ([]*types.GroupMember, error) {
+	conn, connErr := s.Connection(ctx, s.metadataEndpoint, opts...)
+	if connErr != nil {
+		log.CtxErrorw(ctx, "client failed to connect metadata", "error", connErr)
+		return nil, ErrRPCUnknownWithDetail("client failed to connect metadata, error: ", connErr)
+	}
+	defer conn.Close()
+	req := &types.GfSpGetGroupMembersRequest{
+		GroupId:    groupID,
+		Limit:      limit,
+		StartAfter: startAfter,
+		AtHeight:   atHeight,
+	}
+	resp, err := types.NewGfSpMetadataServiceClient(conn).GfSpGetGroupMembers(ctx, req)
+	if err != nil {
+		log.CtxErrorw(ctx, "client failed to get group members at height", "error", err)
+		return nil, ErrRPCUnknownWithDetail("client failed to get group members at height, error: ", err)
+	}
+	return resp.Groups, nil
+}
+
 func (s *GfSpClient) GetUserOwnedGroups(ctx context.Context, accountID string, startAfter uint64, limit uint32, opts ...grpc.DialOption) ([]*types.GroupMember, error) {
 	conn, connErr := s.Connection(ctx, s.metadataEndpoint, opts...)
 	if connErr != nil {
@@ -946,6 +1043,29 @@ func (s *GfSpClient) ListObjectPolicies(ctx context.Context, objectName, bucketN
 	return resp.Policies, nil
 }
 
+func (s *GfSpClient) ListObjectPoliciesAtHeight(ctx context.Context, objectName, bucketName string, startAfter uint64, actionType int32, limit uint32, atHeight int64, opts ...grpc.DialOption) ([]*types.Policy, error) {
+	conn, connErr := s.Connection(ctx, s.metadataEndpoint, opts...)
+	if connErr != nil {
+		log.CtxErrorw(ctx, "client failed to connect metadata", "error", connErr)
+		return nil, ErrRPCUnknownWithDetail("client failed to connect metadata, error: ", connErr)
+	}
+	defer conn.Close()
+	req := &types.GfSpListObjectPoliciesRequest{
+		ObjectName: objectName,
+		BucketName: bucketName,
+		ActionType: permission_types.ActionType(actionType),
+		Limit:      limit,
+		StartAfter: startAfter,
+		AtHeight:   atHeight,
+	}
+	resp, err := types.NewGfSpMetadataServiceClient(conn).GfSpListObjectPolicies(ctx, req)
+	if err != nil {
+		log.CtxErrorw(ctx, "client failed to list policies by object info at height", "error", err)
+		return nil, ErrRPCUnknownWithDetail("client failed to list policies by object info at height, error: ", err)
+	}
+	return resp.Policies, nil
+}
+
 func (s *GfSpClient) ListPaymentAccountStreams(ctx context.Context, paymentAccount string, opts ...grpc.DialOption) ([]*types.Bucket, error) {
 	conn, connErr := s.Connection(ctx, s.metadataEndpoint, opts...)
 	if connErr != nil {
@@ -1151,3 +1271,62 @@ func (s *GfSpClient) GetBsDBInfo(ctx context.Context, blockHeight uint64, opts .
 	}
 	return resp, nil
 }
+
+// PutBucketNotification replaces the notification rules of the bucket
+func (s *GfSpClient) PutBucketNotification(ctx context.Context, bucketName string, bucketID uint64,
+	rules []*types.NotificationRule, opts ...grpc.DialOption) error {
+	conn, connErr := s.Connection(ctx, s.metadataEndpoint, opts...)
+	if connErr != nil {
+		log.CtxErrorw(ctx, "client failed to connect metadata", "error", connErr)
+		return ErrRPCUnknownWithDetail("client failed to connect metadata, error: ", connErr)
+	}
+	defer conn.Close()
+	req := &types.GfSpPutBucketNotificationRequest{
+		BucketName: bucketName,
+		BucketId:   bucketID,
+		Rules:      rules,
+	}
+	_, err := types.NewGfSpMetadataServiceClient(conn).GfSpPutBucketNotification(ctx, req)
+	if err != nil {
+		log.CtxErrorw(ctx, "client failed to put bucket notification", "error", err)
+		return ErrRPCUnknownWithDetail("client failed to put bucket notification, error: ", err)
+	}
+	return nil
+}
+
+// GetBucketNotification returns the notification rules of the bucket without the secrets
+func (s *GfSpClient) GetBucketNotification(ctx context.Context, bucketName string, opts ...grpc.DialOption) (
+	[]*types.NotificationRule, error) {
+	conn, connErr := s.Connection(ctx, s.metadataEndpoint, opts...)
+	if connErr != nil {
+		log.CtxErrorw(ctx, "client failed to connect metadata", "error", connErr)
+		return nil, ErrRPCUnknownWithDetail("client failed to connect metadata, error: ", connErr)
+	}
+	defer conn.Close()
+	req := &types.GfSpGetBucketNotificationRequest{
+		BucketName: bucketName,
+	}
+	resp, err := types.NewGfSpMetadataServiceClient(conn).GfSpGetBucketNotification(ctx, req)
+	if err != nil {
+		log.CtxErrorw(ctx, "client failed to get bucket notification", "error", err)
+		return nil, ErrRPCUnknownWithDetail("client failed to get bucket notification, error: ", err)
+	}
+	return resp.GetRules(), nil
+}
+
+// SearchObjects searches the objects of a bucket by the filters in the request
+func (s *GfSpClient) SearchObjects(ctx context.Context, req *types.GfSpSearchObjectsRequest, opts ...grpc.DialOption) (
+	*types.GfSpSearchObjectsResponse, error) {
+	conn, connErr := s.Connection(ctx, s.metadataEndpoint, opts...)
+	if connErr != nil {
+		log.CtxErrorw(ctx, "client failed to connect metadata", "error", connErr)
+		return nil, ErrRPCUnknownWithDetail("client failed to connect metadata, error: ", connErr)
+	}
+	defer conn.Close()
+	resp, err := types.NewGfSpMetadataServiceClient(conn).GfSpSearchObjects(ctx, req)
+	if err != nil {
+		log.CtxErrorw(ctx, "client failed to search objects", "error", err)
+		return nil, ErrRPCUnknownWithDetail("client failed to search objects, error: ", err)
+	}
+	return resp, nil
+}
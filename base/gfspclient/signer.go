@@ -96,7 +96,8 @@ func (s *GfSpClient) SealObject(ctx context.Context, object *storagetypes.MsgSea
 		return "", ErrRPCUnknownWithDetail("client failed to seal object approval, error: ", err)
 	}
 	if resp.GetErr() != nil {
-		return "", resp.GetErr()
+		// the tx hash is returned with the error if the seal tx is broadcast but not committed in time
+		return resp.GetTxHash(), resp.GetErr()
 	}
 	return resp.GetTxHash(), nil
 }
@@ -118,7 +119,7 @@ func (s *GfSpClient) SealObjectV2(ctx context.Context, object *storagetypes.MsgS
 		return "", ErrRPCUnknownWithDetail("client failed to seal object approval, error: ", err)
 	}
 	if resp.GetErr() != nil {
-		return "", resp.GetErr()
+		return resp.GetTxHash(), resp.GetErr()
 	}
 	return resp.GetTxHash(), nil
 }
@@ -744,6 +745,28 @@ func (s *GfSpClient) DelegateCreateObject(ctx context.Context, object *storagety
 	return resp.GetTxHash(), nil
 }
 
+func (s *GfSpClient) DelegateDeleteObject(ctx context.Context, object *storagetypes.MsgDeleteObject) (string, error) {
+	conn, connErr := s.SignerConn(ctx)
+	if connErr != nil {
+		log.CtxErrorw(ctx, "client failed to connect to signer", "error", connErr)
+		return "", ErrRPCUnknownWithDetail("client failed to connect to signer, error: ", connErr)
+	}
+	req := &gfspserver.GfSpSignRequest{
+		Request: &gfspserver.GfSpSignRequest_DelegateDeleteObject{
+			DelegateDeleteObject: object,
+		},
+	}
+	resp, err := gfspserver.NewGfSpSignServiceClient(conn).GfSpSign(ctx, req)
+	if err != nil {
+		log.CtxErrorw(ctx, "client failed to sign delete object", "error", err)
+		return "", ErrRPCUnknownWithDetail("client failed to sign delete object, error: ", err)
+	}
+	if resp.GetErr() != nil {
+		return "", resp.GetErr()
+	}
+	return resp.GetTxHash(), nil
+}
+
 func (s *GfSpClient) DelegateUpdateObjectContent(ctx context.Context, object *storagetypes.MsgDelegateUpdateObjectContent) (string, error) {
 	conn, connErr := s.SignerConn(ctx)
 	if connErr != nil {
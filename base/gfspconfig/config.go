@@ -23,6 +23,7 @@ type Customize struct {
 	GfSpDB                         spdb.SPDB
 	PieceStore                     piecestore.PieceStore
 	PieceOp                        piecestore.PieceOp
+	PieceCache                     piecestore.PieceCache
 	Rcmgr                          corercmgr.ResourceManager
 	RcLimiter                      corercmgr.Limiter
 	Consensus                      consensus.Consensus
@@ -42,6 +43,7 @@ type GfSpConfig struct {
 	Customize      *Customize `comment:"optional"`
 	SpDB           storeconfig.SQLDBConfig
 	BsDB           storeconfig.SQLDBConfig
+	BsDBBackup     storeconfig.SQLDBConfig `comment:"optional"`
 	PieceStore     storage.PieceStoreConfig
 	Chain          ChainConfig
 	SpAccount      SpAccountConfig
@@ -57,10 +59,13 @@ type GfSpConfig struct {
 	Rcmgr          RcmgrConfig `comment:"optional"`
 	Log            LogConfig
 	BlockSyncer    BlockSyncerConfig
+	Metadata       MetadataConfig `comment:"optional"`
 	APIRateLimiter mwhttp.RateLimiterConfig
 	Manager        ManagerConfig
 	GC             GCConfig
 	Quota          QuotaConfig
+	Downloader     DownloaderConfig
+	Uploader       UploaderConfig
 }
 
 // Apply sets the customized implement to the GfSp configuration, it will be called
@@ -99,6 +104,30 @@ type ChainConfig struct {
 	CreateGlobalVirtualGroupFeeAmount uint64   `comment:"optional"`
 	CompleteMigrateBucketGasLimit     uint64   `comment:"optional"`
 	CompleteMigrateBucketFeeAmount    uint64   `comment:"optional"`
+	// SealBatchSize defines the max number of seal msgs coalesced into one tx, the seal msgs are broadcast one
+	// by one if it is not greater than 1.
+	SealBatchSize uint32 `comment:"optional"`
+	// SealBatchWindowMillisecond defines how long the first seal msg of a batch waits for the others.
+	SealBatchWindowMillisecond uint32 `comment:"optional"`
+	// SealBatchMaxGasLimit defines the max gas limit of a seal batch tx, the batch size is reduced to fit it.
+	SealBatchMaxGasLimit uint64          `comment:"optional"`
+	TxTracker            TxTrackerConfig `comment:"optional"`
+}
+
+// TxTrackerConfig defines the tracker of the txs broadcast by the signer accounts, the txs are persisted in SPDB
+// so the stuck txs can be found and re-broadcast after the signer restarts.
+type TxTrackerConfig struct {
+	Enable bool `comment:"optional"`
+	// IntervalSecond defines how often the pending txs are checked.
+	IntervalSecond uint32 `comment:"optional"`
+	// StuckTimeoutSecond defines how long a pending tx waits for inclusion before it is re-broadcast.
+	StuckTimeoutSecond uint32 `comment:"optional"`
+	// MaxRebroadcast defines how many times a stuck tx is re-broadcast before its fee is bumped.
+	MaxRebroadcast uint32 `comment:"optional"`
+	// FeeBumpPercent defines the percentage by which the fee of a stuck tx is bumped.
+	FeeBumpPercent uint32 `comment:"optional"`
+	// RetentionSecond defines how long the finished txs are kept.
+	RetentionSecond uint64 `comment:"optional"`
 }
 
 type SpAccountConfig struct {
@@ -109,6 +138,58 @@ type SpAccountConfig struct {
 	ApprovalPrivateKey string `comment:"required"`
 	GcPrivateKey       string `comment:"required"`
 	BlsPrivateKey      string `comment:"required"`
+	// KeyProvider defines where the signer gets the keys of sp accounts from: local(default) reads the raw hex private
+	// keys above, keystore reads the encrypted keystore files, remote signs by a remote signer, and hsm signs by a
+	// PKCS#11-style token. The private keys above are not needed unless KeyProvider is local.
+	KeyProvider  string             `comment:"optional"`
+	Keystore     KeystoreConfig     `comment:"optional"`
+	RemoteSigner RemoteSignerConfig `comment:"optional"`
+	HSM          HSMConfig          `comment:"optional"`
+	// RemoteSignerServer is only used by the remotesigner.start command, which serves the keys of KeyProvider
+	// to the signers of sp
+	RemoteSignerServer RemoteSignerServerConfig `comment:"optional"`
+}
+
+// KeystoreConfig defines the encrypted keystore files of sp accounts, the files are named by the key name such as
+// operator.json, seal.json, approval.json, gc.json and bls.json in Dir.
+type KeystoreConfig struct {
+	Dir string `comment:"optional"`
+	// PasswordFile is the file which contains the password of keystore files, the password can also be set by the
+	// SIGNER_KEYSTORE_PASSWORD env variable
+	PasswordFile string `comment:"optional"`
+}
+
+// RemoteSignerConfig defines the remote signer which holds the keys of sp accounts, the connection is mutual
+// TLS and all the TLS files are required.
+type RemoteSignerConfig struct {
+	Address string `comment:"optional"`
+	// TLSCAFile is the CA certificate to verify the remote signer
+	TLSCAFile string `comment:"optional"`
+	// TLSCertFile and TLSKeyFile are the client certificate and key which the remote signer verifies
+	TLSCertFile   string `comment:"optional"`
+	TLSKeyFile    string `comment:"optional"`
+	TimeoutSecond int64  `comment:"optional"`
+}
+
+// RemoteSignerServerConfig defines the listen address and TLS files of the remote signer server, the server
+// only accepts the clients whose certificates are signed by TLSClientCAFile.
+type RemoteSignerServerConfig struct {
+	Address         string `comment:"optional"`
+	TLSCertFile     string `comment:"optional"`
+	TLSKeyFile      string `comment:"optional"`
+	TLSClientCAFile string `comment:"optional"`
+}
+
+// HSMConfig defines the PKCS#11-style token which holds the keys of sp accounts, the keys are found by the key
+// name as label.
+type HSMConfig struct {
+	// Token is the name of registered token implementation, soft is the built-in software token
+	Token string `comment:"optional"`
+	// TokenPath is the path used to open the token, it is the keystore directory for the soft token
+	TokenPath string `comment:"optional"`
+	// PinFile is the file which contains the user pin of token, the pin can also be set by the SIGNER_HSM_PIN env
+	// variable
+	PinFile string `comment:"optional"`
 }
 
 type EndpointConfig struct {
@@ -136,8 +217,13 @@ type BucketConfig struct {
 }
 
 type GatewayConfig struct {
-	DomainName  string `comment:"required"`
-	HTTPAddress string `comment:"required"`
+	DomainName    string `comment:"required"`
+	HTTPAddress   string `comment:"required"`
+	S3HTTPAddress string `comment:"optional"`
+	S3Region      string `comment:"optional"`
+	// EnablePresignedURL enables the presigned url of getting and putting objects, the legacy off-chain auth urls of
+	// getting object are not affected
+	EnablePresignedURL bool `comment:"optional"`
 }
 
 type ExecutorConfig struct {
@@ -219,6 +305,8 @@ type ParallelConfig struct {
 	ChallengePieceParallelPerNode       int   `comment:"optional"`
 	AskReplicateApprovalParallelPerNode int   `comment:"optional"`
 	QuerySPParallelPerNode              int64 `comment:"optional"`
+	// UploadSegmentParallelPerObject defines the max number of segment pieces of an object put to the piece store in parallel
+	UploadSegmentParallelPerObject int `comment:"optional"`
 
 	DiscontinueBucketEnabled       bool `comment:"required"`
 	DiscontinueBucketTimeInterval  int  `comment:"optional"`
@@ -274,6 +362,45 @@ type BlockSyncerConfig struct {
 	DataMonitor            bool             `comment:"optional"`
 	DataStatisticsDuration int64            `comment:"optinal"`
 	ChainDataStorage       ChainDataStorage `comment:"optional"`
+	// ReorgDepth defines the max depth of the chain reorg which can be rolled back, the default is 100 blocks
+	ReorgDepth uint64 `comment:"optional"`
+	// Notification defines the delivery policy of the bucket notifications, it works if the notification module is enabled
+	Notification NotificationConfig `comment:"optional"`
+	// EnableHistory defines whether to keep the versions of the buckets, objects, groups and permissions at every block,
+	// so they can be queried at a past block height
+	EnableHistory bool `comment:"optional"`
+	// DataQuality defines the thresholds of comparing bsdb with the chain, it works if DataMonitor is enabled
+	DataQuality DataQualityConfig `comment:"optional"`
+}
+
+type DataQualityConfig struct {
+	// CheckIntervalSec defines the interval of checking the height lag and the data drift
+	CheckIntervalSec int64 `comment:"optional"`
+	// CountIntervalSec defines the interval of counting the buckets and objects by status
+	CountIntervalSec int64 `comment:"optional"`
+	// SampleSize defines the number of the buckets and the objects compared with the chain in a check
+	SampleSize int `comment:"optional"`
+	// MaxHeightLag defines the max number of blocks bsdb is behind the chain before the lag objective is breached
+	MaxHeightLag int64 `comment:"optional"`
+	// MaxDriftRatio defines the max ratio of the sampled rows mismatching the chain before the probe is set unready
+	MaxDriftRatio float64 `comment:"optional"`
+	// CatchUpTimeoutSec defines the time waiting for bsdb to index the blocks of the chain queries in a drift check
+	CatchUpTimeoutSec int64 `comment:"optional"`
+}
+
+type NotificationConfig struct {
+	// MaxAttempts defines the number of the deliveries of a notification before it's moved to the dead letters
+	MaxAttempts uint32 `comment:"optional"`
+	// RetryBackoffSec defines the delay before the first retry, it's doubled after every retry
+	RetryBackoffSec int64 `comment:"optional"`
+	// MaxRetryBackoffSec defines the max delay between two retries
+	MaxRetryBackoffSec int64 `comment:"optional"`
+	// DeliveryTimeoutSec defines the timeout of posting a notification to the webhook
+	DeliveryTimeoutSec int64 `comment:"optional"`
+	// PollIntervalSec defines the interval of polling the queued notifications
+	PollIntervalSec int64 `comment:"optional"`
+	// BatchSize defines the number of the notifications delivered in parallel
+	BatchSize int `comment:"optional"`
 }
 
 type ChainDataStorage struct {
@@ -307,6 +434,70 @@ type ManagerConfig struct {
 
 	// EnableBucketMigrateCache is used to enable bucket migrate's bucket cache.
 	EnableBucketMigrateCache bool `comment:"optional"`
+
+	// PersistentTaskQueues is the names of task queues which are persisted into the SPDB and replayed
+	// after restarting, e.g. manager-replicate-piece, manager-seal-object, manager-gc-object.
+	PersistentTaskQueues []string `comment:"optional"`
+	// PriorityTaskQueues is the names of task queues which schedule tasks by the priority with aging
+	// instead of the create time, e.g. manager-replicate-piece, manager-seal-object.
+	PriorityTaskQueues []string `comment:"optional"`
+	// TaskPriorityAgingSecond is the waiting seconds to raise the task priority by one in the priority
+	// task queues, which prevents the low priority tasks from starving.
+	TaskPriorityAgingSecond int64 `comment:"optional"`
+	// TaskFairShare is used to share the priority task queues among tenants, supports "bucket" and "user",
+	// the default is empty that disables the fair-share.
+	TaskFairShare string `comment:"optional"`
+
+	// EnableIntegrityScrubber is used to enable the background scrubber, which re-hashes the stored pieces and
+	// schedules the recovery tasks for the corrupt or missing pieces.
+	EnableIntegrityScrubber bool `comment:"optional"`
+	// IntegrityScrubPiecesPerSecond limits the number of pieces re-hashed per second by the scrubber.
+	IntegrityScrubPiecesPerSecond uint `comment:"optional"`
+	// IntegrityScrubRoundIntervalSecond is the idle seconds between two scrub rounds over all objects.
+	IntegrityScrubRoundIntervalSecond uint `comment:"optional"`
+
+	// Inventories is the inventory report configurations of the buckets, the manager periodically writes the
+	// manifest files listing all objects of the source bucket into the destination bucket.
+	Inventories []InventoryConfig `comment:"optional"`
+}
+
+// InventoryConfig defines the inventory report of a bucket.
+type InventoryConfig struct {
+	// SourceBucket is the bucket whose objects are listed in the report.
+	SourceBucket string
+	// DestinationBucket is the bucket that the manifest files are written into, it must be owned by the owner of
+	// the source bucket and use this SP as the primary SP.
+	DestinationBucket string
+	// DestinationPrefix is the object name prefix of the manifest files in the destination bucket.
+	DestinationPrefix string `comment:"optional"`
+	// Format is the format of the manifest files, only "CSV" is supported now and the others are rejected.
+	Format string `comment:"optional"`
+	// IntervalSecond is the seconds between the start times of two reports, the default is one day.
+	IntervalSecond uint `comment:"optional"`
+	// ObjectsPerFile is the max number of objects in a manifest file.
+	ObjectsPerFile uint `comment:"optional"`
+}
+
+type DownloaderConfig struct {
+	// DisableReadRepair disables reconstructing the missing segment from the EC pieces of the secondary SPs
+	// when downloading object.
+	DisableReadRepair bool `comment:"optional"`
+	// PieceCacheSize defines the bytes of the pieces cached in memory.
+	PieceCacheSize int64 `comment:"optional"`
+	// PieceCacheSpillDir defines the local directory to keep the pieces evicted from memory, the disk
+	// spill is disabled if it is empty.
+	PieceCacheSpillDir string `comment:"optional"`
+	// PieceCacheSpillSize defines the bytes of the pieces kept in the disk spill.
+	PieceCacheSpillSize int64 `comment:"optional"`
+}
+
+type UploaderConfig struct {
+	// StreamingReplicateEnabled enables replicating the segments to the secondary SPs while the object is uploading,
+	// the object is replicated after uploading if the streaming replication fails.
+	StreamingReplicateEnabled bool `comment:"optional"`
+	// StreamingReplicateQueueSize defines the max number of segments of an object waiting to be replicated, the
+	// streaming replication fails if the secondary SPs fall behind the upload by more segments.
+	StreamingReplicateQueueSize int `comment:"optional"`
 }
 
 type QuotaConfig struct {
@@ -49,6 +49,19 @@ func CustomizePieceOp(op piecestore.PieceOp) Option {
 	}
 }
 
+func CustomizePieceCache(cache piecestore.PieceCache) Option {
+	return func(cfg *GfSpConfig) error {
+		if cfg.Customize == nil {
+			cfg.Customize = &Customize{}
+		}
+		if cfg.Customize.PieceCache != nil {
+			return errors.New("repeated set piece cache")
+		}
+		cfg.Customize.PieceCache = cache
+		return nil
+	}
+}
+
 func CustomizeRcmgr(rcmgr corercmgr.ResourceManager) Option {
 	return func(cfg *GfSpConfig) error {
 		if cfg.Customize == nil {
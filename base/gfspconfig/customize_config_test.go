@@ -68,6 +68,24 @@ func TestCustomizePieceOpFailure(t *testing.T) {
 	assert.Equal(t, errors.New("repeated set piece op"), err)
 }
 
+func TestCustomizePieceCacheSuccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := piecestore.NewMockPieceCache(ctrl)
+	opt := CustomizePieceCache(m)
+	assert.NotNil(t, opt)
+	err := opt(&GfSpConfig{})
+	assert.Nil(t, err)
+}
+
+func TestCustomizePieceCacheFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := piecestore.NewMockPieceCache(ctrl)
+	opt := CustomizePieceCache(m)
+	assert.NotNil(t, opt)
+	err := opt(&GfSpConfig{Customize: &Customize{PieceCache: m}})
+	assert.Equal(t, errors.New("repeated set piece cache"), err)
+}
+
 func TestCustomizeRcmgrSuccess(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	m := corercmgr.NewMockResourceManager(ctrl)
@@ -0,0 +1,232 @@
+package gfsppiececache
+
+import (
+	"container/list"
+	"errors"
+	"sync"
+
+	"github.com/bnb-chain/greenfield-storage-provider/core/piecestore"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
+)
+
+const (
+	// PieceCacheHit defines the metrics label of piece served by memory
+	PieceCacheHit = "piece_cache_hit"
+	// PieceCacheSpillHit defines the metrics label of piece served by disk spill
+	PieceCacheSpillHit = "piece_cache_spill_hit"
+	// PieceCacheMiss defines the metrics label of piece not in cache
+	PieceCacheMiss = "piece_cache_miss"
+	// PieceCacheEvict defines the metrics label of piece evicted from memory
+	PieceCacheEvict = "piece_cache_evict"
+	// PieceCacheReject defines the metrics label of piece rejected by the admission policy
+	PieceCacheReject = "piece_cache_reject"
+	// PieceCacheMemoryUsage defines the metrics label of bytes used by memory
+	PieceCacheMemoryUsage = "piece_cache_memory_usage"
+	// PieceCacheSpillUsage defines the metrics label of bytes used by disk spill
+	PieceCacheSpillUsage = "piece_cache_spill_usage"
+
+	// windowRatio defines the ratio of the capacity used by the admission window, the new pieces
+	// always enter the window, and compete with the pieces in the main space after leaving it.
+	windowRatio = 0.01
+	// averagePieceSize is used to estimate the number of cached pieces to size the frequency sketch.
+	averagePieceSize = 64 * 1024
+	minSketchWidth   = 1024
+)
+
+var _ piecestore.PieceCache = &GfSpPieceCache{}
+
+type cacheEntry struct {
+	key      string
+	value    []byte
+	inWindow bool
+}
+
+// GfSpPieceCache is the byte-size-aware piece cache with the W-TinyLFU policy. The new pieces enter a
+// small LRU window, the pieces leaving the window are admitted to the main LRU space only if they are
+// accessed more frequently than the pieces they would evict, so the one-hit pieces such as a large
+// sequential download can not flush the hot pieces. The evicted and rejected pieces are spilled to the
+// local disk if the spill is enabled.
+type GfSpPieceCache struct {
+	capacity       int64
+	windowCapacity int64
+
+	mu         sync.Mutex
+	window     *list.List
+	main       *list.List
+	entries    map[string]*list.Element
+	windowSize int64
+	mainSize   int64
+	sketch     *countMinSketch
+	spill      *spillStore
+}
+
+// NewGfSpPieceCache returns the piece cache bounded by the capacity bytes in memory, if the spillDir is
+// not empty, the pieces evicted from memory are kept in the spillDir bounded by the spillCapacity bytes.
+func NewGfSpPieceCache(capacity int64, spillDir string, spillCapacity int64) (*GfSpPieceCache, error) {
+	if capacity <= 0 {
+		return nil, errors.New("invalid piece cache capacity")
+	}
+	width := uint64(capacity / averagePieceSize)
+	if width < minSketchWidth {
+		width = minSketchWidth
+	}
+	cache := &GfSpPieceCache{
+		capacity:       capacity,
+		windowCapacity: int64(float64(capacity) * windowRatio),
+		window:         list.New(),
+		main:           list.New(),
+		entries:        make(map[string]*list.Element),
+		sketch:         newCountMinSketch(width),
+	}
+	if spillDir != "" {
+		if spillCapacity <= 0 {
+			return nil, errors.New("invalid piece cache spill capacity")
+		}
+		spill, err := newSpillStore(spillDir, spillCapacity)
+		if err != nil {
+			return nil, err
+		}
+		cache.spill = spill
+	}
+	return cache, nil
+}
+
+// Get returns the piece from memory or disk spill, and records the access for the admission policy.
+// The piece found in disk spill is moved back to memory.
+func (c *GfSpPieceCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	c.sketch.increment(key)
+	if elem, ok := c.entries[key]; ok {
+		entry := elem.Value.(*cacheEntry)
+		if entry.inWindow {
+			c.window.MoveToFront(elem)
+		} else {
+			c.main.MoveToFront(elem)
+		}
+		c.mu.Unlock()
+		metrics.PieceCacheCounter.WithLabelValues(PieceCacheHit).Inc()
+		return entry.value, true
+	}
+	c.mu.Unlock()
+	if c.spill != nil {
+		if value, ok := c.spill.get(key); ok {
+			metrics.PieceCacheCounter.WithLabelValues(PieceCacheSpillHit).Inc()
+			c.spill.remove(key)
+			c.Add(key, value)
+			return value, true
+		}
+	}
+	metrics.PieceCacheCounter.WithLabelValues(PieceCacheMiss).Inc()
+	return nil, false
+}
+
+// Add puts the piece into the admission window, the pieces leaving the window compete with the pieces
+// in the main space by the access frequency.
+func (c *GfSpPieceCache) Add(key string, value []byte) {
+	size := int64(len(value))
+	c.mu.Lock()
+	if elem, ok := c.entries[key]; ok {
+		c.removeElement(elem)
+	}
+	var spilled []*cacheEntry
+	if size > c.capacity {
+		spilled = append(spilled, &cacheEntry{key: key, value: value})
+		metrics.PieceCacheCounter.WithLabelValues(PieceCacheReject).Inc()
+	} else {
+		c.entries[key] = c.window.PushFront(&cacheEntry{key: key, value: value, inWindow: true})
+		c.windowSize += size
+		for c.windowSize > c.windowCapacity {
+			candidate := c.window.Back()
+			c.removeElement(candidate)
+			spilled = append(spilled, c.admit(candidate.Value.(*cacheEntry))...)
+		}
+	}
+	c.updateUsage()
+	c.mu.Unlock()
+	if c.spill != nil {
+		for _, entry := range spilled {
+			c.spill.put(entry.key, entry.value)
+		}
+	}
+}
+
+// admit moves the candidate leaving the window into the main space, it evicts the least recently used
+// pieces to make room only if all of them are accessed less frequently than the candidate, otherwise
+// the candidate is rejected. It returns the evicted or rejected pieces.
+func (c *GfSpPieceCache) admit(candidate *cacheEntry) []*cacheEntry {
+	size := int64(len(candidate.value))
+	mainCapacity := c.capacity - c.windowCapacity
+	var (
+		victims []*list.Element
+		freed   int64
+	)
+	if c.mainSize+size > mainCapacity {
+		frequency := c.sketch.estimate(candidate.key)
+		for elem := c.main.Back(); elem != nil && c.mainSize-freed+size > mainCapacity; elem = elem.Prev() {
+			victim := elem.Value.(*cacheEntry)
+			if c.sketch.estimate(victim.key) >= frequency {
+				metrics.PieceCacheCounter.WithLabelValues(PieceCacheReject).Inc()
+				return []*cacheEntry{candidate}
+			}
+			victims = append(victims, elem)
+			freed += int64(len(victim.value))
+		}
+		if c.mainSize-freed+size > mainCapacity {
+			metrics.PieceCacheCounter.WithLabelValues(PieceCacheReject).Inc()
+			return []*cacheEntry{candidate}
+		}
+	}
+	evicted := make([]*cacheEntry, 0, len(victims))
+	for _, elem := range victims {
+		c.removeElement(elem)
+		evicted = append(evicted, elem.Value.(*cacheEntry))
+		metrics.PieceCacheCounter.WithLabelValues(PieceCacheEvict).Inc()
+	}
+	candidate.inWindow = false
+	c.entries[candidate.key] = c.main.PushFront(candidate)
+	c.mainSize += size
+	return evicted
+}
+
+// Remove removes the piece from memory and disk spill.
+func (c *GfSpPieceCache) Remove(key string) {
+	c.mu.Lock()
+	if elem, ok := c.entries[key]; ok {
+		c.removeElement(elem)
+		c.updateUsage()
+	}
+	c.mu.Unlock()
+	if c.spill != nil {
+		c.spill.remove(key)
+	}
+}
+
+// Len returns the number of the pieces in memory.
+func (c *GfSpPieceCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// Size returns the bytes of the pieces in memory.
+func (c *GfSpPieceCache) Size() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.windowSize + c.mainSize
+}
+
+func (c *GfSpPieceCache) removeElement(elem *list.Element) {
+	entry := elem.Value.(*cacheEntry)
+	if entry.inWindow {
+		c.window.Remove(elem)
+		c.windowSize -= int64(len(entry.value))
+	} else {
+		c.main.Remove(elem)
+		c.mainSize -= int64(len(entry.value))
+	}
+	delete(c.entries, entry.key)
+}
+
+func (c *GfSpPieceCache) updateUsage() {
+	metrics.PieceCacheUsageGauge.WithLabelValues(PieceCacheMemoryUsage).Set(float64(c.windowSize + c.mainSize))
+}
@@ -0,0 +1,157 @@
+package gfsppiececache
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGfSpPieceCache(t *testing.T) {
+	_, err := NewGfSpPieceCache(0, "", 0)
+	assert.NotNil(t, err)
+	_, err = NewGfSpPieceCache(100, t.TempDir(), 0)
+	assert.NotNil(t, err)
+
+	// the stale spilled pieces are cleaned up, and other files are kept
+	dir := t.TempDir()
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "stale"+spillFileSuffix), []byte("a"), 0600))
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "other"), []byte("a"), 0600))
+	_, err = NewGfSpPieceCache(100, dir, 100)
+	assert.Nil(t, err)
+	files, err := os.ReadDir(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(files))
+	assert.Equal(t, "other", files[0].Name())
+}
+
+func TestGfSpPieceCache_AddGet(t *testing.T) {
+	cache, err := NewGfSpPieceCache(1000, "", 0)
+	assert.Nil(t, err)
+	cache.Add("a", bytes.Repeat([]byte("a"), 100))
+	cache.Add("b", bytes.Repeat([]byte("b"), 200))
+	value, ok := cache.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, bytes.Repeat([]byte("a"), 100), value)
+	assert.Equal(t, 2, cache.Len())
+	assert.Equal(t, int64(300), cache.Size())
+
+	// overwrite
+	cache.Add("a", []byte("a"))
+	value, ok = cache.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("a"), value)
+	assert.Equal(t, int64(201), cache.Size())
+
+	cache.Remove("a")
+	_, ok = cache.Get("a")
+	assert.False(t, ok)
+	assert.Equal(t, 1, cache.Len())
+	assert.Equal(t, int64(200), cache.Size())
+
+	// the piece larger than the capacity is rejected
+	cache.Add("c", bytes.Repeat([]byte("c"), 1001))
+	_, ok = cache.Get("c")
+	assert.False(t, ok)
+	assert.Equal(t, int64(200), cache.Size())
+}
+
+func TestGfSpPieceCache_ByteCapacity(t *testing.T) {
+	cache, err := NewGfSpPieceCache(1000, "", 0)
+	assert.Nil(t, err)
+	for i := 0; i < 100; i++ {
+		key := fmt.Sprintf("piece_%d", i)
+		// access before adding as same as the downloader does
+		_, _ = cache.Get(key)
+		_, _ = cache.Get(key)
+		cache.Add(key, bytes.Repeat([]byte("a"), 100+i))
+		assert.LessOrEqual(t, cache.Size(), int64(1000))
+	}
+}
+
+func TestGfSpPieceCache_ScanResistance(t *testing.T) {
+	cache, err := NewGfSpPieceCache(1000, "", 0)
+	assert.Nil(t, err)
+	for i := 0; i < 5; i++ {
+		key := fmt.Sprintf("hot_%d", i)
+		cache.Add(key, bytes.Repeat([]byte("h"), 150))
+		for j := 0; j < 5; j++ {
+			_, ok := cache.Get(key)
+			assert.True(t, ok)
+		}
+	}
+	// the one-hit pieces of a large sequential download can not flush the hot pieces
+	for i := 0; i < 100; i++ {
+		key := fmt.Sprintf("scan_%d", i)
+		_, _ = cache.Get(key)
+		cache.Add(key, bytes.Repeat([]byte("s"), 150))
+	}
+	for i := 0; i < 5; i++ {
+		_, ok := cache.Get(fmt.Sprintf("hot_%d", i))
+		assert.True(t, ok)
+	}
+	assert.LessOrEqual(t, cache.Size(), int64(1000))
+
+	// the piece becomes hot is admitted by evicting the cold pieces
+	for i := 0; i < 10; i++ {
+		_, _ = cache.Get("new_hot")
+	}
+	cache.Add("new_hot", bytes.Repeat([]byte("n"), 150))
+	_, ok := cache.Get("new_hot")
+	assert.True(t, ok)
+}
+
+func TestGfSpPieceCache_Spill(t *testing.T) {
+	dir := t.TempDir()
+	cache, err := NewGfSpPieceCache(200, dir, 1000)
+	assert.Nil(t, err)
+	cache.Add("a", bytes.Repeat([]byte("a"), 150))
+	// b is rejected from memory and spilled to disk
+	cache.Add("b", bytes.Repeat([]byte("b"), 150))
+	assert.Equal(t, 1, cache.Len())
+	value, ok := cache.Get("b")
+	assert.True(t, ok)
+	assert.Equal(t, bytes.Repeat([]byte("b"), 150), value)
+
+	// the piece larger than the capacity is only kept in disk
+	cache.Add("c", bytes.Repeat([]byte("c"), 300))
+	value, ok = cache.Get("c")
+	assert.True(t, ok)
+	assert.Equal(t, bytes.Repeat([]byte("c"), 300), value)
+
+	cache.Remove("c")
+	_, ok = cache.Get("c")
+	assert.False(t, ok)
+
+	// the disk spill is bounded by the spill capacity
+	for i := 0; i < 20; i++ {
+		cache.Add(fmt.Sprintf("spill_%d", i), bytes.Repeat([]byte("s"), 300))
+	}
+	assert.LessOrEqual(t, cache.spill.used, int64(1000))
+	files, err := os.ReadDir(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, len(cache.spill.entries), len(files))
+}
+
+func TestCountMinSketch(t *testing.T) {
+	sketch := newCountMinSketch(10)
+	assert.Equal(t, uint64(16), sketch.width)
+	for i := 0; i < 20; i++ {
+		sketch.increment("a")
+	}
+	// the counter is saturated
+	assert.Equal(t, uint8(sketchMaxCounter), sketch.estimate("a"))
+	for i := 0; i < 3; i++ {
+		sketch.increment("b")
+	}
+	assert.LessOrEqual(t, uint8(3), sketch.estimate("b"))
+
+	// the counters are halved after enough samples
+	for i := uint64(0); i < sketch.width*sketchSampleFactor; i++ {
+		sketch.increment(fmt.Sprintf("noise_%d", i))
+	}
+	assert.Less(t, sketch.estimate("a"), uint8(sketchMaxCounter))
+}
@@ -0,0 +1,78 @@
+package gfsppiececache
+
+import (
+	"hash/fnv"
+)
+
+const (
+	sketchDepth      = 4
+	sketchMaxCounter = 15
+	// sketchSampleFactor defines the number of recorded accesses relative to the width that triggers the
+	// counters to be halved, so the frequency of the stale pieces decays.
+	sketchSampleFactor = 10
+)
+
+// countMinSketch estimates the access frequency of the keys in a bounded memory, it is used by the
+// TinyLFU admission policy to decide whether a new piece is more valuable than the evicted ones.
+type countMinSketch struct {
+	width    uint64
+	counters [sketchDepth][]uint8
+	samples  uint64
+}
+
+func newCountMinSketch(width uint64) *countMinSketch {
+	// round up to the power of two to index by mask
+	w := uint64(1)
+	for w < width {
+		w <<= 1
+	}
+	s := &countMinSketch{width: w}
+	for i := range s.counters {
+		s.counters[i] = make([]uint8, w)
+	}
+	return s
+}
+
+func (s *countMinSketch) indexes(key string) [sketchDepth]uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	sum := h.Sum64()
+	h1, h2 := sum&0xffffffff, sum>>32|1
+	var idx [sketchDepth]uint64
+	for i := range idx {
+		idx[i] = (h1 + uint64(i)*h2) & (s.width - 1)
+	}
+	return idx
+}
+
+// increment records one access of the key.
+func (s *countMinSketch) increment(key string) {
+	for i, idx := range s.indexes(key) {
+		if s.counters[i][idx] < sketchMaxCounter {
+			s.counters[i][idx]++
+		}
+	}
+	if s.samples++; s.samples >= s.width*sketchSampleFactor {
+		s.reset()
+	}
+}
+
+// estimate returns the estimated access frequency of the key.
+func (s *countMinSketch) estimate(key string) uint8 {
+	min := uint8(sketchMaxCounter)
+	for i, idx := range s.indexes(key) {
+		if s.counters[i][idx] < min {
+			min = s.counters[i][idx]
+		}
+	}
+	return min
+}
+
+func (s *countMinSketch) reset() {
+	for i := range s.counters {
+		for j := range s.counters[i] {
+			s.counters[i][j] >>= 1
+		}
+	}
+	s.samples /= 2
+}
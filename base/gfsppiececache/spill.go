@@ -0,0 +1,123 @@
+package gfsppiececache
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
+)
+
+// spillFileSuffix defines the suffix of the spilled piece files, only these files are cleaned up on start.
+const spillFileSuffix = ".piece"
+
+type spillEntry struct {
+	key  string
+	size int64
+}
+
+// spillStore keeps the pieces evicted from memory in the local disk, it is bounded by the capacity
+// bytes and evicted by LRU. The spilled pieces are not kept across restarts.
+type spillStore struct {
+	dir      string
+	capacity int64
+
+	mu      sync.Mutex
+	lru     *list.List
+	entries map[string]*list.Element
+	used    int64
+}
+
+func newSpillStore(dir string, capacity int64) (*spillStore, error) {
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return nil, err
+	}
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		if !file.IsDir() && strings.HasSuffix(file.Name(), spillFileSuffix) {
+			_ = os.Remove(filepath.Join(dir, file.Name()))
+		}
+	}
+	return &spillStore{
+		dir:      dir,
+		capacity: capacity,
+		lru:      list.New(),
+		entries:  make(map[string]*list.Element),
+	}, nil
+}
+
+func (s *spillStore) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(s.dir, hex.EncodeToString(sum[:])+spillFileSuffix)
+}
+
+// put writes the piece to disk, and evicts the least recently used pieces if the capacity is exceeded.
+func (s *spillStore) put(key string, value []byte) {
+	size := int64(len(value))
+	if size > s.capacity {
+		return
+	}
+	if err := os.WriteFile(s.path(key), value, 0600); err != nil {
+		log.Errorw("failed to spill piece to disk", "key", key, "error", err)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if elem, ok := s.entries[key]; ok {
+		s.used -= elem.Value.(*spillEntry).size
+		s.lru.Remove(elem)
+	}
+	s.entries[key] = s.lru.PushFront(&spillEntry{key: key, size: size})
+	s.used += size
+	for s.used > s.capacity {
+		s.removeElement(s.lru.Back())
+	}
+	metrics.PieceCacheUsageGauge.WithLabelValues(PieceCacheSpillUsage).Set(float64(s.used))
+}
+
+// get reads the piece from disk.
+func (s *spillStore) get(key string) ([]byte, bool) {
+	s.mu.Lock()
+	elem, ok := s.entries[key]
+	if ok {
+		s.lru.MoveToFront(elem)
+	}
+	s.mu.Unlock()
+	if !ok {
+		return nil, false
+	}
+	data, err := os.ReadFile(s.path(key))
+	if err != nil {
+		log.Errorw("failed to read spilled piece from disk", "key", key, "error", err)
+		s.remove(key)
+		return nil, false
+	}
+	return data, true
+}
+
+func (s *spillStore) remove(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if elem, ok := s.entries[key]; ok {
+		s.removeElement(elem)
+		metrics.PieceCacheUsageGauge.WithLabelValues(PieceCacheSpillUsage).Set(float64(s.used))
+	}
+}
+
+func (s *spillStore) removeElement(elem *list.Element) {
+	entry := elem.Value.(*spillEntry)
+	s.lru.Remove(elem)
+	delete(s.entries, entry.key)
+	s.used -= entry.size
+	if err := os.Remove(s.path(entry.key)); err != nil && !os.IsNotExist(err) {
+		log.Errorw("failed to remove spilled piece from disk", "key", entry.key, "error", err)
+	}
+}
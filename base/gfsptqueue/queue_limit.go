@@ -25,6 +25,13 @@ type GfSpTQueueWithLimit struct {
 
 	gcFunc     func(task2 coretask.Task) bool
 	filterFunc func(task2 coretask.Task) bool
+
+	// persister is nil for the in-memory queue, otherwise every pushed task is saved into it, and the
+	// task is removed from it after popping by key or retiring.
+	persister taskPersister
+	// scheduler is nil for the default queue which picks tasks by create time in turn, otherwise it
+	// picks the task from the candidates.
+	scheduler taskScheduler
 }
 
 func NewGfSpTQueueWithLimit(name string, cap int) taskqueue.TQueueOnStrategyWithLimit {
@@ -82,6 +89,9 @@ func (t *GfSpTQueueWithLimit) PopByLimit(limit corercmgr.Limit) coretask.Task {
 	task := t.topByLimit(limit)
 	if task != nil {
 		t.delete(task)
+		if t.scheduler != nil {
+			t.scheduler.popped(task)
+		}
 	}
 	return task
 }
@@ -95,6 +105,8 @@ func (t *GfSpTQueueWithLimit) PopByKey(key coretask.TKey) coretask.Task {
 		metrics.QueueTime.WithLabelValues(t.name + "-pop_by_key").Observe(time.Since(startTime).Seconds())
 	}()
 	if !t.has(key) {
+		// the task may be popped by limit and is being dispatched, still removes the persisted one
+		t.unpersist(key)
 		return nil
 	}
 	task, ok := t.tasks[key]
@@ -102,6 +114,7 @@ func (t *GfSpTQueueWithLimit) PopByKey(key coretask.TKey) coretask.Task {
 		return nil
 	}
 	t.delete(task)
+	t.unpersist(key)
 	return task
 }
 
@@ -126,6 +139,7 @@ func (t *GfSpTQueueWithLimit) Push(task coretask.Task) error {
 		for _, key := range keys {
 			if t.gcFunc(t.tasks[key]) {
 				t.delete(t.tasks[key])
+				t.unpersist(key)
 				clear = true
 				// only retire one task
 				break
@@ -153,6 +167,20 @@ func (t *GfSpTQueueWithLimit) add(task coretask.Task) {
 		return
 	}
 	t.tasks[task.Key()] = task
+	if t.persister != nil {
+		if err := t.persister.save(task); err != nil {
+			log.Errorw("failed to persist task", "queue", t.name, "task_key", task.Key(), "error", err)
+		}
+	}
+}
+
+func (t *GfSpTQueueWithLimit) unpersist(key coretask.TKey) {
+	if t.persister == nil {
+		return
+	}
+	if err := t.persister.remove(key); err != nil {
+		log.Errorw("failed to remove persisted task", "queue", t.name, "task_key", key, "error", err)
+	}
 }
 
 func (t *GfSpTQueueWithLimit) delete(task coretask.Task) {
@@ -173,6 +201,7 @@ func (t *GfSpTQueueWithLimit) has(key coretask.TKey) bool {
 	if ok && t.gcFunc != nil {
 		if t.gcFunc(task) {
 			delete(t.tasks, task.Key())
+			t.unpersist(task.Key())
 			return false
 		}
 	}
@@ -188,6 +217,7 @@ func (t *GfSpTQueueWithLimit) topByLimit(limit corercmgr.Limit) coretask.Task {
 	defer func() {
 		for _, task := range gcTasks {
 			delete(t.tasks, task.Key())
+			t.unpersist(task.Key())
 		}
 	}()
 
@@ -208,6 +238,9 @@ func (t *GfSpTQueueWithLimit) topByLimit(limit corercmgr.Limit) coretask.Task {
 	if len(backupTasks) == 0 {
 		return nil
 	}
+	if t.scheduler != nil {
+		return t.scheduler.pick(backupTasks)
+	}
 	sort.Slice(backupTasks, func(i, j int) bool {
 		return backupTasks[i].GetCreateTime() < backupTasks[j].GetCreateTime()
 	})
@@ -0,0 +1,134 @@
+package gfsptqueue
+
+import (
+	"fmt"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
+	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+	coretask "github.com/bnb-chain/greenfield-storage-provider/core/task"
+	"github.com/bnb-chain/greenfield-storage-provider/core/taskqueue"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+)
+
+// taskPersister saves the tasks of the queue, the saved tasks are replayed when the queue is recreated.
+type taskPersister interface {
+	save(task coretask.Task) error
+	remove(key coretask.TKey) error
+}
+
+// persistentTask is the task that can be marshaled, all gfsptask tasks are gogoproto messages.
+type persistentTask interface {
+	coretask.Task
+	Marshal() ([]byte, error)
+	Unmarshal([]byte) error
+}
+
+// newPersistentTask returns an empty task of the task type for unmarshalling, only the task types
+// which are kept in the TQueueOnStrategyWithLimit are supported.
+func newPersistentTask(taskType coretask.TType) (persistentTask, error) {
+	switch taskType {
+	case coretask.TypeTaskReplicatePiece:
+		return &gfsptask.GfSpReplicatePieceTask{}, nil
+	case coretask.TypeTaskSealObject:
+		return &gfsptask.GfSpSealObjectTask{}, nil
+	case coretask.TypeTaskReceivePiece:
+		return &gfsptask.GfSpReceivePieceTask{}, nil
+	case coretask.TypeTaskGCObject:
+		return &gfsptask.GfSpGCObjectTask{}, nil
+	case coretask.TypeTaskGCZombiePiece:
+		return &gfsptask.GfSpGCZombiePieceTask{}, nil
+	case coretask.TypeTaskGCMeta:
+		return &gfsptask.GfSpGCMetaTask{}, nil
+	case coretask.TypeTaskGCBucketMigration:
+		return &gfsptask.GfSpGCBucketMigrationTask{}, nil
+	case coretask.TypeTaskGCStaleVersionObject:
+		return &gfsptask.GfSpGCStaleVersionObjectTask{}, nil
+	case coretask.TypeTaskRecoverPiece:
+		return &gfsptask.GfSpRecoverPieceTask{}, nil
+	case coretask.TypeTaskMigrateGVG:
+		return &gfsptask.GfSpMigrateGVGTask{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported persistent task type: %s", coretask.TaskTypeName(taskType))
+	}
+}
+
+// spdbTaskPersister saves the tasks of one queue into the SPDB.
+type spdbTaskPersister struct {
+	queue string
+	db    spdb.TaskQueueDB
+}
+
+func (p *spdbTaskPersister) save(task coretask.Task) error {
+	t, ok := task.(persistentTask)
+	if !ok {
+		return fmt.Errorf("unsupported persistent task: %T", task)
+	}
+	data, err := t.Marshal()
+	if err != nil {
+		return err
+	}
+	return p.db.InsertQueuedTask(&spdb.QueuedTask{
+		QueueName:  p.queue,
+		TaskKey:    task.Key().String(),
+		TaskType:   int32(task.Type()),
+		Data:       data,
+		CreateTime: task.GetCreateTime(),
+	})
+}
+
+func (p *spdbTaskPersister) remove(key coretask.TKey) error {
+	return p.db.DeleteQueuedTask(p.queue, key.String())
+}
+
+// NewGfSpPersistentTQueueWithLimit returns a durable TQueueOnStrategyWithLimit backed by the SPDB. Every
+// pushed task is saved into the SPDB, and it is removed after popping by key or retiring. Popping by limit
+// keeps the saved task because the popped task is being dispatched, it will be pushed back or popped by
+// key later. The saved tasks are replayed into the queue, and they are retired and filtered lazily by
+// the strategies as same as the pushed tasks.
+func NewGfSpPersistentTQueueWithLimit(db spdb.TaskQueueDB, name string, cap int) (taskqueue.TQueueOnStrategyWithLimit, error) {
+	queue := &GfSpTQueueWithLimit{
+		name:      name,
+		cap:       cap,
+		tasks:     make(map[coretask.TKey]coretask.Task),
+		persister: &spdbTaskPersister{queue: name, db: db},
+	}
+	records, err := db.ListQueuedTasks(name)
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range records {
+		task, err := newPersistentTask(coretask.TType(record.TaskType))
+		if err == nil {
+			err = task.Unmarshal(record.Data)
+		}
+		if err != nil {
+			log.Errorw("failed to replay task, drop it", "queue", name, "task_key", record.TaskKey, "error", err)
+			queue.unpersist(coretask.TKey(record.TaskKey))
+			continue
+		}
+		queue.tasks[task.Key()] = task
+	}
+	log.Infow("succeed to replay persistent queue", "queue", name, "tasks", len(queue.tasks))
+	return queue, nil
+}
+
+// NewGfSpPersistentTQueueWithLimitFactory returns the factory of TQueueOnStrategyWithLimit, the queues
+// whose names are in the persistent list are durable, the others are in-memory. It falls back to the
+// in-memory queue if failed to replay the durable queue.
+func NewGfSpPersistentTQueueWithLimitFactory(db spdb.TaskQueueDB, persistent []string) taskqueue.NewTQueueOnStrategyWithLimit {
+	names := make(map[string]struct{}, len(persistent))
+	for _, name := range persistent {
+		names[name] = struct{}{}
+	}
+	return func(name string, cap int) taskqueue.TQueueOnStrategyWithLimit {
+		if _, ok := names[name]; !ok {
+			return NewGfSpTQueueWithLimit(name, cap)
+		}
+		queue, err := NewGfSpPersistentTQueueWithLimit(db, name, cap)
+		if err != nil {
+			log.Errorw("failed to new persistent queue, use in-memory queue", "queue", name, "error", err)
+			return NewGfSpTQueueWithLimit(name, cap)
+		}
+		return queue
+	}
+}
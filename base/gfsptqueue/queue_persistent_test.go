@@ -0,0 +1,147 @@
+package gfsptqueue
+
+import (
+	"errors"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
+	corercmgr "github.com/bnb-chain/greenfield-storage-provider/core/rcmgr"
+	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+	coretask "github.com/bnb-chain/greenfield-storage-provider/core/task"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// memTaskQueueDB is the in-memory spdb.TaskQueueDB for testing.
+type memTaskQueueDB struct {
+	tasks map[string]map[string]*spdb.QueuedTask
+}
+
+func newMemTaskQueueDB() *memTaskQueueDB {
+	return &memTaskQueueDB{tasks: make(map[string]map[string]*spdb.QueuedTask)}
+}
+
+func (m *memTaskQueueDB) InsertQueuedTask(task *spdb.QueuedTask) error {
+	if m.tasks[task.QueueName] == nil {
+		m.tasks[task.QueueName] = make(map[string]*spdb.QueuedTask)
+	}
+	m.tasks[task.QueueName][task.TaskKey] = task
+	return nil
+}
+
+func (m *memTaskQueueDB) DeleteQueuedTask(queueName string, taskKey string) error {
+	delete(m.tasks[queueName], taskKey)
+	return nil
+}
+
+func (m *memTaskQueueDB) ListQueuedTasks(queueName string) ([]*spdb.QueuedTask, error) {
+	var tasks []*spdb.QueuedTask
+	for _, task := range m.tasks[queueName] {
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+func mockReplicateTask(name string, id uint64, createTime int64) *gfsptask.GfSpReplicatePieceTask {
+	return &gfsptask.GfSpReplicatePieceTask{
+		ObjectInfo:    &storagetypes.ObjectInfo{ObjectName: name, Id: sdkmath.NewUint(id)},
+		StorageParams: &storagetypes.Params{},
+		Task:          &gfsptask.GfSpTask{CreateTime: createTime, MaxRetry: 3},
+	}
+}
+
+func TestGfSpPersistentTQueueWithLimit_Replay(t *testing.T) {
+	db := newMemTaskQueueDB()
+	queue, err := NewGfSpPersistentTQueueWithLimit(db, "mock", 10)
+	assert.Nil(t, err)
+	task1 := mockReplicateTask("task_1", 1, 1)
+	task2 := mockReplicateTask("task_2", 2, 2)
+	assert.Nil(t, queue.Push(task1))
+	assert.Nil(t, queue.Push(task2))
+	assert.Equal(t, 2, len(db.tasks["mock"]))
+
+	// restart
+	queue, err = NewGfSpPersistentTQueueWithLimit(db, "mock", 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, queue.Len())
+	assert.True(t, queue.Has(task1.Key()))
+	result := queue.PopByKey(task2.Key())
+	assert.NotNil(t, result)
+	assert.Equal(t, "task_2", result.(*gfsptask.GfSpReplicatePieceTask).GetObjectInfo().GetObjectName())
+	assert.Equal(t, 1, len(db.tasks["mock"]))
+}
+
+func TestGfSpPersistentTQueueWithLimit_PopByLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := corercmgr.NewMockLimit(ctrl)
+	m.EXPECT().NotLess(gomock.Any()).Return(true).AnyTimes()
+	db := newMemTaskQueueDB()
+	queue, err := NewGfSpPersistentTQueueWithLimit(db, "mock", 10)
+	assert.Nil(t, err)
+	task1 := mockReplicateTask("task_1", 1, 1)
+	assert.Nil(t, queue.Push(task1))
+
+	// the task popped by limit is being dispatched, it is still persisted
+	result := queue.PopByLimit(m)
+	assert.NotNil(t, result)
+	assert.Equal(t, 0, queue.Len())
+	assert.Equal(t, 1, len(db.tasks["mock"]))
+
+	// the task is done
+	assert.Nil(t, queue.PopByKey(task1.Key()))
+	assert.Equal(t, 0, len(db.tasks["mock"]))
+}
+
+func TestGfSpPersistentTQueueWithLimit_RetireReplayedTask(t *testing.T) {
+	db := newMemTaskQueueDB()
+	queue, err := NewGfSpPersistentTQueueWithLimit(db, "mock", 2)
+	assert.Nil(t, err)
+	task1 := mockReplicateTask("task_1", 1, 1)
+	task1.SetRetry(5)
+	task2 := mockReplicateTask("task_2", 2, 2)
+	assert.Nil(t, queue.Push(task1))
+	assert.Nil(t, queue.Push(task2))
+
+	// restart, the retire strategy is set after replaying as same as the manager does
+	queue, err = NewGfSpPersistentTQueueWithLimit(db, "mock", 2)
+	assert.Nil(t, err)
+	queue.SetRetireTaskStrategy(func(qTask coretask.Task) bool {
+		return qTask.ExceedRetry()
+	})
+	assert.Nil(t, queue.Push(mockReplicateTask("task_3", 3, 3)))
+	assert.False(t, queue.Has(task1.Key()))
+	assert.Equal(t, 2, len(db.tasks["mock"]))
+	_, ok := db.tasks["mock"][task1.Key().String()]
+	assert.False(t, ok)
+}
+
+func TestGfSpPersistentTQueueWithLimit_DropInvalidTask(t *testing.T) {
+	db := newMemTaskQueueDB()
+	assert.Nil(t, db.InsertQueuedTask(&spdb.QueuedTask{
+		QueueName: "mock", TaskKey: "invalid", TaskType: int32(coretask.TypeTaskUpload)}))
+	queue, err := NewGfSpPersistentTQueueWithLimit(db, "mock", 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, queue.Len())
+	assert.Equal(t, 0, len(db.tasks["mock"]))
+}
+
+type errTaskQueueDB struct {
+	memTaskQueueDB
+}
+
+func (e *errTaskQueueDB) ListQueuedTasks(string) ([]*spdb.QueuedTask, error) {
+	return nil, errors.New("mock error")
+}
+
+func TestNewGfSpPersistentTQueueWithLimitFactory(t *testing.T) {
+	db := newMemTaskQueueDB()
+	factory := NewGfSpPersistentTQueueWithLimitFactory(db, []string{"persistent"})
+	assert.NotNil(t, factory("persistent", 1).(*GfSpTQueueWithLimit).persister)
+	assert.Nil(t, factory("in-memory", 1).(*GfSpTQueueWithLimit).persister)
+
+	factory = NewGfSpPersistentTQueueWithLimitFactory(&errTaskQueueDB{}, []string{"persistent"})
+	assert.Nil(t, factory("persistent", 1).(*GfSpTQueueWithLimit).persister)
+}
@@ -0,0 +1,172 @@
+package gfsptqueue
+
+import (
+	"math"
+	"time"
+
+	coretask "github.com/bnb-chain/greenfield-storage-provider/core/task"
+	"github.com/bnb-chain/greenfield-storage-provider/core/taskqueue"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+)
+
+const (
+	// FairShareNone disables the fair-share, tasks are only scheduled by priority.
+	FairShareNone = ""
+	// FairShareByBucket shares the queue among buckets.
+	FairShareByBucket = "bucket"
+	// FairShareByUser shares the queue among object owners.
+	FairShareByUser = "user"
+
+	// DefaultPriorityAgingSecond defines the default waiting seconds to raise the task priority by one, it
+	// is on the order of the task timeouts, so a low priority task is promoted by one priority level after
+	// about 40 minutes of waiting and the priority still orders the tasks under sustained load.
+	DefaultPriorityAgingSecond = 30
+)
+
+// taskScheduler picks the task to pop from the candidates of the queue.
+type taskScheduler interface {
+	// pick returns the task to top or pop from the candidates which are not empty.
+	pick(candidates []coretask.Task) coretask.Task
+	// popped is called after the picked task is popped.
+	popped(task coretask.Task)
+}
+
+// priorityScheduler picks the tasks by the effective priority which is the task priority increased by
+// the waiting time, so the low priority tasks are promoted and never starve. The tasks in the same
+// priority level are shared among tenants, the tenant which is served least goes first.
+type priorityScheduler struct {
+	agingSecond int64
+	fairShare   string
+	// served records the virtual time of tenants, it increases by one when a task of the tenant is
+	// popped, clock is the virtual time of the last served tenant.
+	served map[string]float64
+	clock  float64
+}
+
+func newPriorityScheduler(agingSecond int64, fairShare string) *priorityScheduler {
+	return &priorityScheduler{
+		agingSecond: agingSecond,
+		fairShare:   fairShare,
+		served:      make(map[string]float64),
+	}
+}
+
+// effectivePriority returns the task priority increased by one every agingSecond of waiting.
+func (p *priorityScheduler) effectivePriority(task coretask.Task, now int64) int64 {
+	priority := int64(task.GetPriority())
+	if p.agingSecond > 0 && now > task.GetCreateTime() {
+		priority += (now - task.GetCreateTime()) / p.agingSecond
+	}
+	if priority > int64(coretask.MaxTaskPriority) {
+		priority = int64(coretask.MaxTaskPriority)
+	}
+	return priority
+}
+
+// priorityLevel maps the priority into the level as same as the resource limit estimation.
+func priorityLevel(priority int64) coretask.TPriorityLevel {
+	if priority < int64(coretask.DefaultSmallerPriority) {
+		return coretask.TLowPriorityLevel
+	} else if priority > int64(coretask.DefaultLargerTaskPriority) {
+		return coretask.THighPriorityLevel
+	}
+	return coretask.TMediumPriorityLevel
+}
+
+// tenant returns the fair-share unit of the task, the tasks without object share one tenant.
+func (p *priorityScheduler) tenant(task coretask.Task) string {
+	objectTask, ok := task.(coretask.ObjectTask)
+	if !ok || objectTask.GetObjectInfo() == nil {
+		return ""
+	}
+	switch p.fairShare {
+	case FairShareByBucket:
+		return objectTask.GetObjectInfo().GetBucketName()
+	case FairShareByUser:
+		return objectTask.GetObjectInfo().GetOwner()
+	}
+	return ""
+}
+
+func (p *priorityScheduler) pick(candidates []coretask.Task) coretask.Task {
+	now := time.Now().Unix()
+	priorities := make([]int64, len(candidates))
+	topLevel := coretask.TLowPriorityLevel
+	for i, task := range candidates {
+		priorities[i] = p.effectivePriority(task, now)
+		if level := priorityLevel(priorities[i]); level > topLevel {
+			topLevel = level
+		}
+	}
+	var (
+		picked      = -1
+		pickedVTime = math.Inf(1)
+	)
+	for i, task := range candidates {
+		if priorityLevel(priorities[i]) != topLevel {
+			continue
+		}
+		vtime := 0.0
+		if p.fairShare != FairShareNone {
+			vtime = math.Max(p.served[p.tenant(task)], p.clock)
+		}
+		if picked == -1 || vtime < pickedVTime ||
+			(vtime == pickedVTime && priorities[i] > priorities[picked]) ||
+			(vtime == pickedVTime && priorities[i] == priorities[picked] &&
+				task.GetCreateTime() < candidates[picked].GetCreateTime()) {
+			picked, pickedVTime = i, vtime
+		}
+	}
+	return candidates[picked]
+}
+
+func (p *priorityScheduler) popped(task coretask.Task) {
+	if p.fairShare == FairShareNone {
+		return
+	}
+	tenant := p.tenant(task)
+	// the tenant which is inactive for a while restarts from the clock, and can not use the
+	// accumulated credit to monopolize the queue
+	p.clock = math.Max(p.served[tenant], p.clock)
+	p.served[tenant] = p.clock + 1
+	for key, vtime := range p.served {
+		if vtime <= p.clock {
+			delete(p.served, key)
+		}
+	}
+}
+
+// NewGfSpPriorityTQueueWithLimit returns the TQueueOnStrategyWithLimit which schedules the tasks by the
+// priority with aging, and shares the queue among buckets or users by the fairShare.
+func NewGfSpPriorityTQueueWithLimit(name string, cap int, agingSecond int64, fairShare string) taskqueue.TQueueOnStrategyWithLimit {
+	return &GfSpTQueueWithLimit{
+		name:      name,
+		cap:       cap,
+		tasks:     make(map[coretask.TKey]coretask.Task),
+		scheduler: newPriorityScheduler(agingSecond, fairShare),
+	}
+}
+
+// NewGfSpPriorityTQueueWithLimitFactory wraps the factory of TQueueOnStrategyWithLimit, the queues whose
+// names are in the priority list schedule the tasks by the priority with aging and fair-share. It can
+// wrap the persistent factory, so that the queue is both durable and priority-aware.
+func NewGfSpPriorityTQueueWithLimitFactory(newQueue taskqueue.NewTQueueOnStrategyWithLimit, priority []string,
+	agingSecond int64, fairShare string) taskqueue.NewTQueueOnStrategyWithLimit {
+	names := make(map[string]struct{}, len(priority))
+	for _, name := range priority {
+		names[name] = struct{}{}
+	}
+	return func(name string, cap int) taskqueue.TQueueOnStrategyWithLimit {
+		queue := newQueue(name, cap)
+		if _, ok := names[name]; !ok {
+			return queue
+		}
+		limitQueue, ok := queue.(*GfSpTQueueWithLimit)
+		if !ok {
+			log.Warnw("unsupported priority queue, use the original queue", "queue", name)
+			return queue
+		}
+		limitQueue.scheduler = newPriorityScheduler(agingSecond, fairShare)
+		return limitQueue
+	}
+}
@@ -0,0 +1,168 @@
+package gfsptqueue
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
+	corercmgr "github.com/bnb-chain/greenfield-storage-provider/core/rcmgr"
+	coretask "github.com/bnb-chain/greenfield-storage-provider/core/task"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+func mockSealTask(bucket, owner string, id uint64, priority coretask.TPriority, createTime int64) *gfsptask.GfSpSealObjectTask {
+	return &gfsptask.GfSpSealObjectTask{
+		ObjectInfo: &storagetypes.ObjectInfo{
+			BucketName: bucket,
+			Owner:      owner,
+			ObjectName: "object",
+			Id:         sdkmath.NewUint(id),
+		},
+		StorageParams: &storagetypes.Params{},
+		Task:          &gfsptask.GfSpTask{TaskPriority: int32(priority), CreateTime: createTime},
+	}
+}
+
+func mockUnlimited(t *testing.T) corercmgr.Limit {
+	ctrl := gomock.NewController(t)
+	m := corercmgr.NewMockLimit(ctrl)
+	m.EXPECT().NotLess(gomock.Any()).Return(true).AnyTimes()
+	return m
+}
+
+func TestGfSpPriorityTQueueWithLimit_Priority(t *testing.T) {
+	now := time.Now().Unix()
+	queue := NewGfSpPriorityTQueueWithLimit("mock", 10, 0, FairShareNone)
+	low := mockSealTask("bucket", "user", 1, coretask.DefaultSmallerPriority-1, now-10)
+	high := mockSealTask("bucket", "user", 2, coretask.MaxTaskPriority, now)
+	medium1 := mockSealTask("bucket", "user", 3, coretask.DefaultSmallerPriority, now-1)
+	medium2 := mockSealTask("bucket", "user", 4, coretask.DefaultSmallerPriority, now-2)
+	for _, task := range []coretask.Task{low, high, medium1, medium2} {
+		assert.Nil(t, queue.Push(task))
+	}
+	limit := mockUnlimited(t)
+	assert.Equal(t, high.Key(), queue.TopByLimit(limit).Key())
+	assert.Equal(t, high.Key(), queue.PopByLimit(limit).Key())
+	// the older one goes first in the same priority
+	assert.Equal(t, medium2.Key(), queue.PopByLimit(limit).Key())
+	assert.Equal(t, medium1.Key(), queue.PopByLimit(limit).Key())
+	assert.Equal(t, low.Key(), queue.PopByLimit(limit).Key())
+	assert.Nil(t, queue.PopByLimit(limit))
+}
+
+func TestGfSpPriorityTQueueWithLimit_Aging(t *testing.T) {
+	now := time.Now().Unix()
+	queue := NewGfSpPriorityTQueueWithLimit("mock", 10, 1, FairShareNone)
+	// the low priority task waits long enough to be promoted to the high priority level
+	starving := mockSealTask("bucket", "user", 1, 0, now-int64(coretask.MaxTaskPriority))
+	high := mockSealTask("bucket", "user", 2, coretask.DefaultLargerTaskPriority+1, now)
+	assert.Nil(t, queue.Push(starving))
+	assert.Nil(t, queue.Push(high))
+	assert.Equal(t, starving.Key(), queue.PopByLimit(mockUnlimited(t)).Key())
+}
+
+func TestGfSpPriorityTQueueWithLimit_SustainedLoad(t *testing.T) {
+	now := time.Now().Unix()
+	queue := NewGfSpPriorityTQueueWithLimit("mock", 200, DefaultPriorityAgingSecond, FairShareNone)
+	// the low priority tasks have been waiting up to 10 minutes
+	for i := 0; i < 100; i++ {
+		assert.Nil(t, queue.Push(mockSealTask("bucket", "user", uint64(i), coretask.DefaultSmallerPriority-1, now-int64(i*6))))
+	}
+	// the high priority tasks keep coming, and still go before the backlog
+	limit := mockUnlimited(t)
+	for i := 100; i < 200; i++ {
+		high := mockSealTask("bucket", "user", uint64(i), coretask.DefaultLargerTaskPriority+1, now)
+		assert.Nil(t, queue.Push(high))
+		assert.Equal(t, high.Key(), queue.PopByLimit(limit).Key())
+	}
+	assert.Equal(t, 100, queue.Len())
+}
+
+func TestGfSpPriorityTQueueWithLimit_FairShare(t *testing.T) {
+	now := time.Now().Unix()
+	cases := []struct {
+		name      string
+		fairShare string
+		tasks     []*gfsptask.GfSpSealObjectTask
+		popped    []uint64
+	}{
+		{
+			name:      "fair share by bucket",
+			fairShare: FairShareByBucket,
+			tasks: []*gfsptask.GfSpSealObjectTask{
+				mockSealTask("heavy", "user1", 1, coretask.DefaultSmallerPriority, now-10),
+				mockSealTask("heavy", "user1", 2, coretask.DefaultSmallerPriority, now-9),
+				mockSealTask("heavy", "user1", 3, coretask.DefaultSmallerPriority, now-8),
+				mockSealTask("light", "user1", 4, coretask.DefaultSmallerPriority, now-1),
+			},
+			popped: []uint64{1, 4, 2, 3},
+		},
+		{
+			name:      "fair share by user",
+			fairShare: FairShareByUser,
+			tasks: []*gfsptask.GfSpSealObjectTask{
+				mockSealTask("bucket1", "heavy", 1, coretask.DefaultSmallerPriority, now-10),
+				mockSealTask("bucket2", "heavy", 2, coretask.DefaultSmallerPriority, now-9),
+				mockSealTask("bucket3", "light", 3, coretask.DefaultSmallerPriority, now-1),
+			},
+			popped: []uint64{1, 3, 2},
+		},
+		{
+			name:      "no fair share",
+			fairShare: FairShareNone,
+			tasks: []*gfsptask.GfSpSealObjectTask{
+				mockSealTask("heavy", "user1", 1, coretask.DefaultSmallerPriority, now-10),
+				mockSealTask("heavy", "user1", 2, coretask.DefaultSmallerPriority, now-9),
+				mockSealTask("light", "user1", 3, coretask.DefaultSmallerPriority, now-1),
+			},
+			popped: []uint64{1, 2, 3},
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := NewGfSpPriorityTQueueWithLimit("mock", 10, 0, tt.fairShare)
+			for _, task := range tt.tasks {
+				assert.Nil(t, queue.Push(task))
+			}
+			limit := mockUnlimited(t)
+			for _, id := range tt.popped {
+				task := queue.PopByLimit(limit)
+				assert.Equal(t, id, task.(*gfsptask.GfSpSealObjectTask).GetObjectInfo().Id.Uint64())
+			}
+		})
+	}
+}
+
+func TestGfSpPriorityTQueueWithLimit_InactiveTenant(t *testing.T) {
+	now := time.Now().Unix()
+	queue := NewGfSpPriorityTQueueWithLimit("mock", 10, 0, FairShareByBucket)
+	limit := mockUnlimited(t)
+	for i := uint64(1); i <= 3; i++ {
+		assert.Nil(t, queue.Push(mockSealTask("busy", "user", i, coretask.DefaultSmallerPriority, now-10+int64(i))))
+		assert.NotNil(t, queue.PopByLimit(limit))
+	}
+	// the idle tenant goes first because the busy tenant has been served
+	assert.Nil(t, queue.Push(mockSealTask("busy", "user", 4, coretask.DefaultSmallerPriority, now-5)))
+	assert.Nil(t, queue.Push(mockSealTask("idle", "user", 5, coretask.DefaultSmallerPriority, now-1)))
+	task := queue.PopByLimit(limit)
+	assert.Equal(t, uint64(5), task.(*gfsptask.GfSpSealObjectTask).GetObjectInfo().Id.Uint64())
+	task = queue.PopByLimit(limit)
+	assert.Equal(t, uint64(4), task.(*gfsptask.GfSpSealObjectTask).GetObjectInfo().Id.Uint64())
+}
+
+func TestNewGfSpPriorityTQueueWithLimitFactory(t *testing.T) {
+	factory := NewGfSpPriorityTQueueWithLimitFactory(NewGfSpTQueueWithLimit, []string{"priority"}, 1, FairShareByBucket)
+	assert.NotNil(t, factory("priority", 1).(*GfSpTQueueWithLimit).scheduler)
+	assert.Nil(t, factory("default", 1).(*GfSpTQueueWithLimit).scheduler)
+
+	db := newMemTaskQueueDB()
+	factory = NewGfSpPriorityTQueueWithLimitFactory(NewGfSpPersistentTQueueWithLimitFactory(db, []string{"priority"}),
+		[]string{"priority"}, 1, FairShareByBucket)
+	queue := factory("priority", 1).(*GfSpTQueueWithLimit)
+	assert.NotNil(t, queue.scheduler)
+	assert.NotNil(t, queue.persister)
+}
@@ -28,6 +28,9 @@ var (
 	ErrNoSuchBucket        = gfsperrors.Register(GreenFieldChain, http.StatusBadRequest, 500001, "no such bucket")
 	ErrSealTimeout         = gfsperrors.Register(GreenFieldChain, http.StatusBadRequest, 500002, "seal failed")
 	ErrRejectUnSealTimeout = gfsperrors.Register(GreenFieldChain, http.StatusBadRequest, 500003, "reject unseal failed")
+	// ErrNoSuchObject is returned by QueryObjectInfo and QueryObjectInfoByID if the object doesn't exist on chain,
+	// the callers should match it by errors.Is instead of the error message
+	ErrNoSuchObject = gfsperrors.Register(GreenFieldChain, http.StatusNotFound, 500004, "No such object")
 )
 
 // GreenfieldClient the greenfield chain client, only use to query.
@@ -2,6 +2,7 @@ package gnfd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"strconv"
@@ -598,6 +599,9 @@ func (g *Gnfd) QueryObjectInfo(ctx context.Context, bucket, object string) (obje
 	})
 	if err != nil {
 		log.CtxErrorw(ctx, "failed to query object", "bucket_name", bucket, "object_name", object, "error", err)
+		if strings.Contains(err.Error(), storagetypes.ErrNoSuchObject.Error()) {
+			return nil, ErrNoSuchObject
+		}
 		return nil, err
 	}
 	return resp.GetObjectInfo(), nil
@@ -630,6 +634,9 @@ func (g *Gnfd) QueryObjectInfoByID(ctx context.Context, objectID string) (object
 	})
 	if err != nil {
 		log.CtxErrorw(ctx, "failed to query object", "object_id", objectID, "error", err)
+		if strings.Contains(err.Error(), storagetypes.ErrNoSuchObject.Error()) {
+			return nil, ErrNoSuchObject
+		}
 		return nil, err
 	}
 	return resp.GetObjectInfo(), nil
@@ -736,10 +743,8 @@ func (g *Gnfd) ListenRejectUnSealObject(ctx context.Context, objectID uint64, ti
 
 	for i := 0; i < timeoutHeight; i++ {
 		_, err = g.QueryObjectInfoByID(ctx, strconv.FormatUint(objectID, 10))
-		if err != nil {
-			if strings.Contains(err.Error(), "No such object") {
-				return true, nil
-			}
+		if errors.Is(err, ErrNoSuchObject) {
+			return true, nil
 		}
 		time.Sleep(ExpectedOutputBlockInternal * time.Second)
 	}
@@ -1173,6 +1173,251 @@ func (m *VerifyGNFD2EddsaSignatureResponse) GetResult() bool {
 	return false
 }
 
+// CreateS3AccessKeyRequest is request type for the CreateS3AccessKey RPC method.
+type CreateS3AccessKeyRequest struct {
+	// account_id is the account address of user
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// domain is the DApp domain of the off-chain auth key which the access key is bound to
+	Domain string `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	// user_public_key is the public key of the off-chain auth key which the access key is bound to
+	UserPublicKey string `protobuf:"bytes,3,opt,name=user_public_key,json=userPublicKey,proto3" json:"user_public_key,omitempty"`
+}
+
+func (m *CreateS3AccessKeyRequest) Reset()         { *m = CreateS3AccessKeyRequest{} }
+func (m *CreateS3AccessKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateS3AccessKeyRequest) ProtoMessage()    {}
+func (*CreateS3AccessKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30ebf94e0d260942, []int{18}
+}
+func (m *CreateS3AccessKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateS3AccessKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateS3AccessKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateS3AccessKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateS3AccessKeyRequest.Merge(m, src)
+}
+func (m *CreateS3AccessKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateS3AccessKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateS3AccessKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateS3AccessKeyRequest proto.InternalMessageInfo
+
+func (m *CreateS3AccessKeyRequest) GetAccountId() string {
+	if m != nil {
+		return m.AccountId
+	}
+	return ""
+}
+
+func (m *CreateS3AccessKeyRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *CreateS3AccessKeyRequest) GetUserPublicKey() string {
+	if m != nil {
+		return m.UserPublicKey
+	}
+	return ""
+}
+
+// CreateS3AccessKeyResponse is response type for the CreateS3AccessKey RPC method.
+type CreateS3AccessKeyResponse struct {
+	Err *gfsperrors.GfSpError `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+	// access_key_id is the id of the created access key
+	AccessKeyId string `protobuf:"bytes,2,opt,name=access_key_id,json=accessKeyId,proto3" json:"access_key_id,omitempty"`
+	// secret_access_key is the secret of the created access key, it's only returned once
+	SecretAccessKey string `protobuf:"bytes,3,opt,name=secret_access_key,json=secretAccessKey,proto3" json:"secret_access_key,omitempty"`
+}
+
+func (m *CreateS3AccessKeyResponse) Reset()         { *m = CreateS3AccessKeyResponse{} }
+func (m *CreateS3AccessKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateS3AccessKeyResponse) ProtoMessage()    {}
+func (*CreateS3AccessKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30ebf94e0d260942, []int{19}
+}
+func (m *CreateS3AccessKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateS3AccessKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateS3AccessKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateS3AccessKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateS3AccessKeyResponse.Merge(m, src)
+}
+func (m *CreateS3AccessKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateS3AccessKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateS3AccessKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateS3AccessKeyResponse proto.InternalMessageInfo
+
+func (m *CreateS3AccessKeyResponse) GetErr() *gfsperrors.GfSpError {
+	if m != nil {
+		return m.Err
+	}
+	return nil
+}
+
+func (m *CreateS3AccessKeyResponse) GetAccessKeyId() string {
+	if m != nil {
+		return m.AccessKeyId
+	}
+	return ""
+}
+
+func (m *CreateS3AccessKeyResponse) GetSecretAccessKey() string {
+	if m != nil {
+		return m.SecretAccessKey
+	}
+	return ""
+}
+
+// VerifyS3SignatureRequest is request type for the VerifyS3Signature RPC method.
+type VerifyS3SignatureRequest struct {
+	// access_key_id is the access key id in the credential of the request
+	AccessKeyId string `protobuf:"bytes,1,opt,name=access_key_id,json=accessKeyId,proto3" json:"access_key_id,omitempty"`
+	// string_to_sign is the AWS signature version 4 string to sign of the request
+	StringToSign []byte `protobuf:"bytes,2,opt,name=string_to_sign,json=stringToSign,proto3" json:"string_to_sign,omitempty"`
+	// signature is the hex encoded signature of the request
+	Signature string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *VerifyS3SignatureRequest) Reset()         { *m = VerifyS3SignatureRequest{} }
+func (m *VerifyS3SignatureRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyS3SignatureRequest) ProtoMessage()    {}
+func (*VerifyS3SignatureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30ebf94e0d260942, []int{20}
+}
+func (m *VerifyS3SignatureRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyS3SignatureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyS3SignatureRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerifyS3SignatureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyS3SignatureRequest.Merge(m, src)
+}
+func (m *VerifyS3SignatureRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyS3SignatureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyS3SignatureRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyS3SignatureRequest proto.InternalMessageInfo
+
+func (m *VerifyS3SignatureRequest) GetAccessKeyId() string {
+	if m != nil {
+		return m.AccessKeyId
+	}
+	return ""
+}
+
+func (m *VerifyS3SignatureRequest) GetStringToSign() []byte {
+	if m != nil {
+		return m.StringToSign
+	}
+	return nil
+}
+
+func (m *VerifyS3SignatureRequest) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+// VerifyS3SignatureResponse is response type for the VerifyS3Signature RPC method.
+type VerifyS3SignatureResponse struct {
+	Err *gfsperrors.GfSpError `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+	// account_id is the account address which the access key is issued to
+	AccountId string `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+}
+
+func (m *VerifyS3SignatureResponse) Reset()         { *m = VerifyS3SignatureResponse{} }
+func (m *VerifyS3SignatureResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyS3SignatureResponse) ProtoMessage()    {}
+func (*VerifyS3SignatureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30ebf94e0d260942, []int{21}
+}
+func (m *VerifyS3SignatureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyS3SignatureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyS3SignatureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerifyS3SignatureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyS3SignatureResponse.Merge(m, src)
+}
+func (m *VerifyS3SignatureResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyS3SignatureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyS3SignatureResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyS3SignatureResponse proto.InternalMessageInfo
+
+func (m *VerifyS3SignatureResponse) GetErr() *gfsperrors.GfSpError {
+	if m != nil {
+		return m.Err
+	}
+	return nil
+}
+
+func (m *VerifyS3SignatureResponse) GetAccountId() string {
+	if m != nil {
+		return m.AccountId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GfSpAuthenticationRequest)(nil), "base.types.gfspserver.GfSpAuthenticationRequest")
 	proto.RegisterType((*GfSpAuthenticationResponse)(nil), "base.types.gfspserver.GfSpAuthenticationResponse")
@@ -1192,6 +1437,10 @@ func init() {
 	proto.RegisterType((*UpdateUserPublicKeyV2Response)(nil), "base.types.gfspserver.UpdateUserPublicKeyV2Response")
 	proto.RegisterType((*VerifyGNFD2EddsaSignatureRequest)(nil), "base.types.gfspserver.VerifyGNFD2EddsaSignatureRequest")
 	proto.RegisterType((*VerifyGNFD2EddsaSignatureResponse)(nil), "base.types.gfspserver.VerifyGNFD2EddsaSignatureResponse")
+	proto.RegisterType((*CreateS3AccessKeyRequest)(nil), "base.types.gfspserver.CreateS3AccessKeyRequest")
+	proto.RegisterType((*CreateS3AccessKeyResponse)(nil), "base.types.gfspserver.CreateS3AccessKeyResponse")
+	proto.RegisterType((*VerifyS3SignatureRequest)(nil), "base.types.gfspserver.VerifyS3SignatureRequest")
+	proto.RegisterType((*VerifyS3SignatureResponse)(nil), "base.types.gfspserver.VerifyS3SignatureResponse")
 }
 
 func init() {
@@ -1199,67 +1448,76 @@ func init() {
 }
 
 var fileDescriptor_30ebf94e0d260942 = []byte{
-	// 956 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x73, 0xdb, 0xc4,
-	0x1b, 0xb6, 0xea, 0x38, 0xbf, 0xfa, 0x75, 0xf2, 0x23, 0xb3, 0x6d, 0x82, 0xeb, 0x12, 0xd7, 0x11,
-	0x33, 0x10, 0xa0, 0x91, 0xa9, 0xca, 0x0c, 0x5c, 0x0b, 0x69, 0x33, 0x4c, 0x4b, 0x86, 0x51, 0xda,
-	0x1e, 0xb8, 0x08, 0x59, 0x7a, 0xa5, 0x6c, 0x63, 0x6b, 0xd5, 0xdd, 0x55, 0xa8, 0x2f, 0xcc, 0x30,
-	0xc3, 0xa9, 0xa7, 0x7e, 0x01, 0x38, 0xf2, 0x59, 0x38, 0x31, 0xbd, 0xc1, 0x85, 0x19, 0x26, 0xf9,
-	0x22, 0xcc, 0x4a, 0xf2, 0x7f, 0x89, 0xd8, 0xb8, 0xe1, 0x94, 0xec, 0xbb, 0xcf, 0xfb, 0xef, 0xf1,
-	0xb3, 0xef, 0xae, 0xe0, 0x83, 0x8e, 0x23, 0xb0, 0x2d, 0xfb, 0x11, 0x8a, 0x76, 0xe0, 0x8b, 0x48,
-	0x20, 0x3f, 0x45, 0xde, 0x76, 0x62, 0x79, 0x8c, 0xa1, 0xa4, 0xae, 0x23, 0x19, 0x37, 0x22, 0xce,
-	0x24, 0x23, 0x9b, 0x0a, 0x6a, 0x24, 0x50, 0x63, 0x04, 0x6d, 0xec, 0x4c, 0x45, 0x40, 0xce, 0x19,
-	0x17, 0xed, 0xe4, 0x4f, 0xea, 0xa9, 0xff, 0xa4, 0xc1, 0x8d, 0x03, 0xff, 0x28, 0xba, 0x37, 0x8a,
-	0x4a, 0x59, 0x68, 0xe1, 0xf3, 0x18, 0x85, 0x24, 0x37, 0xa1, 0xaa, 0xd2, 0xd9, 0x2a, 0x44, 0x5d,
-	0x6b, 0x69, 0xbb, 0x15, 0xeb, 0xaa, 0x32, 0x3c, 0xee, 0x47, 0x48, 0x76, 0x60, 0x2d, 0x16, 0xc8,
-	0x6d, 0xc7, 0x75, 0x59, 0x1c, 0xca, 0xfa, 0x95, 0x96, 0xb6, 0x5b, 0xb5, 0x6a, 0xca, 0x76, 0x2f,
-	0x35, 0x91, 0x5b, 0x50, 0xeb, 0xc4, 0xee, 0x09, 0x4a, 0x3b, 0x74, 0x7a, 0x58, 0x2f, 0x27, 0x08,
-	0x48, 0x4d, 0x87, 0x4e, 0x0f, 0x15, 0x80, 0x75, 0x9e, 0xa1, 0x9b, 0x01, 0x56, 0x52, 0x40, 0x6a,
-	0x52, 0x00, 0xfd, 0x19, 0x34, 0xf2, 0xca, 0x13, 0x11, 0x0b, 0x05, 0x12, 0x13, 0xca, 0xc8, 0x79,
-	0x52, 0x59, 0xcd, 0x6c, 0x19, 0x53, 0x2c, 0xa4, 0xed, 0x1a, 0xca, 0xff, 0xbe, 0xfa, 0xd7, 0x52,
-	0x60, 0x52, 0x87, 0xff, 0x39, 0xdd, 0x2e, 0xfb, 0x0e, 0xbd, 0xa4, 0xe2, 0xab, 0xd6, 0x60, 0xa9,
-	0x3f, 0x82, 0x6b, 0x07, 0x28, 0x55, 0xaa, 0x43, 0x16, 0xba, 0x38, 0x20, 0x61, 0x1b, 0x20, 0x6b,
-	0xd1, 0xa6, 0x5e, 0x92, 0xab, 0x6a, 0x55, 0x33, 0xcb, 0x97, 0x1e, 0xd9, 0x82, 0x55, 0x8f, 0xf5,
-	0x1c, 0x1a, 0x66, 0x04, 0x64, 0x2b, 0xfd, 0x4f, 0x0d, 0xae, 0x4f, 0x86, 0x5b, 0xa2, 0xe8, 0x77,
-	0x61, 0xdd, 0x8d, 0x39, 0xc7, 0x50, 0xda, 0xa1, 0x0a, 0x96, 0xe4, 0xaa, 0x58, 0x6b, 0x99, 0x31,
-	0x49, 0xa0, 0x0a, 0x0d, 0xf1, 0xc5, 0x00, 0x51, 0x4e, 0x10, 0x55, 0x65, 0x49, 0xb7, 0x6f, 0x03,
-	0x19, 0xc4, 0x88, 0xe2, 0x4e, 0x97, 0xba, 0xf6, 0x09, 0xf6, 0x33, 0xca, 0x37, 0xb2, 0x9d, 0xaf,
-	0x93, 0x8d, 0x87, 0xd8, 0x57, 0xbf, 0x0c, 0xbe, 0x88, 0x28, 0xef, 0xdb, 0x9e, 0x23, 0xb1, 0x5e,
-	0x69, 0x69, 0xbb, 0x65, 0x0b, 0x52, 0xd3, 0xbe, 0x23, 0x51, 0xff, 0x5d, 0x83, 0xc6, 0x93, 0x48,
-	0x6d, 0x3e, 0x11, 0xc8, 0x87, 0x8e, 0xcb, 0xb1, 0x36, 0xdb, 0x68, 0x39, 0xa7, 0xd1, 0xeb, 0x50,
-	0x49, 0x37, 0x57, 0x92, 0xcd, 0x74, 0x41, 0xde, 0x83, 0xb7, 0x12, 0x3d, 0x8e, 0x35, 0x57, 0x49,
-	0x62, 0xaf, 0xc7, 0xe3, 0x05, 0x4e, 0x77, 0xb6, 0x3a, 0xd3, 0x19, 0x85, 0x9b, 0xb9, 0x8d, 0x2d,
-	0xf1, 0xfb, 0x6d, 0xc1, 0x2a, 0x47, 0x11, 0x77, 0x65, 0xa6, 0xb9, 0x6c, 0xa5, 0xff, 0xa2, 0x41,
-	0xeb, 0x29, 0x72, 0xea, 0xf7, 0x0f, 0x0e, 0x1f, 0xec, 0xdf, 0xb9, 0xef, 0x79, 0xc2, 0x39, 0xa2,
-	0x41, 0xe8, 0xc8, 0x98, 0x2f, 0x29, 0x40, 0xa2, 0xc3, 0x3a, 0xf3, 0x7d, 0xdb, 0x3d, 0x76, 0x68,
-	0x68, 0x0b, 0x1a, 0x64, 0xc7, 0xaf, 0xc6, 0x7c, 0xff, 0x0b, 0x65, 0x3b, 0xa2, 0x01, 0x79, 0x1f,
-	0x36, 0x38, 0x3a, 0x5d, 0xbb, 0x27, 0x02, 0x5b, 0x32, 0x85, 0x0a, 0x13, 0x52, 0xd7, 0xac, 0x75,
-	0x65, 0xff, 0x4a, 0x04, 0x8f, 0x99, 0xaa, 0x47, 0x67, 0xb0, 0xf3, 0x0f, 0x75, 0x5e, 0x02, 0x33,
-	0x72, 0x78, 0x18, 0x1f, 0x62, 0xff, 0xa9, 0xb9, 0x24, 0x17, 0x39, 0xda, 0x28, 0xe7, 0x68, 0x43,
-	0x7f, 0x39, 0x3a, 0xb4, 0x59, 0xda, 0x25, 0x5a, 0xdb, 0x06, 0x18, 0xcb, 0x97, 0x16, 0x54, 0x8d,
-	0x8a, 0x74, 0x58, 0x9e, 0xd1, 0xe1, 0x21, 0x6c, 0x3e, 0xa2, 0x62, 0x50, 0x8c, 0x58, 0x96, 0x04,
-	0xbd, 0x07, 0x5b, 0xd3, 0xf1, 0x96, 0xe8, 0xee, 0x16, 0xd4, 0x46, 0xdd, 0x89, 0xfa, 0x95, 0x56,
-	0x59, 0x8d, 0xee, 0x61, 0x7b, 0x42, 0x7f, 0x0e, 0x6f, 0xef, 0x63, 0x17, 0x25, 0xbe, 0xa9, 0x06,
-	0xa6, 0x53, 0x96, 0x67, 0x52, 0xfa, 0x50, 0x9f, 0x4d, 0x79, 0x09, 0xe2, 0xfc, 0x59, 0x83, 0x77,
-	0x72, 0x46, 0xc4, 0x7f, 0x25, 0xd3, 0x69, 0xe9, 0xac, 0xcc, 0x48, 0xe7, 0x04, 0xb6, 0x0b, 0xea,
-	0xbb, 0x04, 0x36, 0x7e, 0x9b, 0x18, 0x62, 0xe6, 0x1b, 0x1d, 0x62, 0xf3, 0x32, 0x32, 0x33, 0xec,
-	0x56, 0xe6, 0x1b, 0x76, 0x95, 0x0b, 0x87, 0x9d, 0x79, 0xf9, 0xc3, 0xce, 0x7c, 0x59, 0xcd, 0x7b,
-	0x85, 0x1d, 0x21, 0x3f, 0xa5, 0x2e, 0x92, 0x1f, 0x34, 0xa8, 0xab, 0xdd, 0xb4, 0xa6, 0x49, 0x0c,
-	0xf9, 0xd8, 0xc8, 0x7d, 0xfb, 0x19, 0x85, 0x8f, 0xba, 0xc6, 0x9d, 0x05, 0x3c, 0xd2, 0x5e, 0xf5,
-	0x12, 0xa1, 0xb0, 0x36, 0xfe, 0x98, 0x21, 0x1f, 0x16, 0x05, 0x99, 0x7d, 0x40, 0x35, 0x3e, 0x9a,
-	0x0b, 0x3b, 0x4c, 0xf5, 0x3d, 0x5c, 0xcb, 0xd1, 0x2e, 0x29, 0x2a, 0xbb, 0xf8, 0x0d, 0xd2, 0x30,
-	0x17, 0x71, 0x19, 0xe6, 0x7f, 0xa5, 0xc1, 0x8d, 0xc2, 0xbb, 0x8e, 0x7c, 0x5a, 0x10, 0xf3, 0xa2,
-	0x5b, 0xbc, 0xf1, 0xd9, 0xe2, 0x8e, 0x39, 0xec, 0x27, 0xa7, 0xf8, 0x22, 0xf6, 0xc7, 0x47, 0xd1,
-	0x45, 0xec, 0x4f, 0x8c, 0x05, 0xbd, 0x44, 0x18, 0xfc, 0x7f, 0xf2, 0x92, 0x20, 0xb7, 0x0b, 0x02,
-	0xe4, 0xde, 0x4d, 0x8d, 0xbd, 0x39, 0xd1, 0xc3, 0x84, 0x31, 0x6c, 0x4c, 0xcf, 0x6c, 0x62, 0x14,
-	0x04, 0x29, 0xb8, 0x4f, 0x1a, 0xed, 0xb9, 0xf1, 0xc3, 0xb4, 0x3f, 0x6a, 0xb0, 0x99, 0x3b, 0x22,
-	0xc9, 0xdd, 0xf9, 0x55, 0x33, 0xaa, 0xe0, 0x93, 0xc5, 0x9c, 0x0a, 0xc4, 0x66, 0x2e, 0x2c, 0x36,
-	0xf3, 0xdf, 0x8a, 0xcd, 0x2c, 0x12, 0xdb, 0xe7, 0xdf, 0xfe, 0x7a, 0xd6, 0xd4, 0x5e, 0x9f, 0x35,
-	0xb5, 0xbf, 0xce, 0x9a, 0xda, 0xab, 0xf3, 0x66, 0xe9, 0xf5, 0x79, 0xb3, 0xf4, 0xc7, 0x79, 0xb3,
-	0xf4, 0xcd, 0x83, 0x80, 0xca, 0xe3, 0xb8, 0x63, 0xb8, 0xac, 0xd7, 0xee, 0x84, 0x9d, 0xbd, 0x64,
-	0xda, 0xb6, 0x03, 0x8e, 0x18, 0xfa, 0x14, 0xbb, 0xde, 0x9e, 0x90, 0x8c, 0x3b, 0x01, 0xee, 0x45,
-	0x9c, 0x9d, 0x52, 0x0f, 0x79, 0x3b, 0xf7, 0x13, 0xb6, 0xb3, 0x9a, 0x7c, 0x7b, 0xde, 0xfd, 0x3b,
-	0x00, 0x00, 0xff, 0xff, 0x79, 0xe3, 0x12, 0xb6, 0xe2, 0x0e, 0x00, 0x00,
+	// 1104 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4f, 0x73, 0xdb, 0x44,
+	0x14, 0x8f, 0xe2, 0x38, 0xd4, 0x2f, 0x49, 0x9b, 0x6e, 0x9b, 0xe0, 0xa8, 0x8d, 0xeb, 0x08, 0x06,
+	0x42, 0xdb, 0xd8, 0xa9, 0xc2, 0x0c, 0x5c, 0x43, 0xd3, 0x66, 0x3a, 0x2d, 0x19, 0xc6, 0x6e, 0x7b,
+	0xe0, 0x22, 0x64, 0xe9, 0xd9, 0x51, 0x63, 0x6b, 0xd5, 0xdd, 0x55, 0x88, 0x2f, 0xcc, 0xc0, 0x70,
+	0xe2, 0xd4, 0x2f, 0x00, 0xdc, 0xf8, 0x2c, 0x9c, 0x98, 0xde, 0xe0, 0xc2, 0x0c, 0x93, 0x7c, 0x0f,
+	0x86, 0x59, 0x49, 0xfe, 0x13, 0x4b, 0x22, 0x4a, 0xd5, 0xf4, 0x94, 0xe8, 0xed, 0x6f, 0xdf, 0xef,
+	0xf7, 0xde, 0x3e, 0xbd, 0x7d, 0x16, 0x7c, 0xd2, 0x32, 0x39, 0xd6, 0x45, 0xdf, 0x43, 0x5e, 0xef,
+	0xb4, 0xb9, 0xc7, 0x91, 0x1d, 0x22, 0xab, 0x9b, 0xbe, 0xd8, 0x47, 0x57, 0x38, 0x96, 0x29, 0x28,
+	0xab, 0x79, 0x8c, 0x0a, 0x4a, 0x96, 0x24, 0xb4, 0x16, 0x40, 0x6b, 0x23, 0xa8, 0xba, 0x36, 0xe1,
+	0x01, 0x19, 0xa3, 0x8c, 0xd7, 0x83, 0x3f, 0xe1, 0x4e, 0xed, 0x67, 0x05, 0x56, 0x76, 0xdb, 0x4d,
+	0x6f, 0x7b, 0xe4, 0xd5, 0xa1, 0x6e, 0x03, 0x5f, 0xfa, 0xc8, 0x05, 0xb9, 0x01, 0x25, 0x49, 0x67,
+	0x48, 0x17, 0x65, 0xa5, 0xaa, 0xac, 0x17, 0x1b, 0x97, 0xa4, 0xe1, 0x69, 0xdf, 0x43, 0xb2, 0x06,
+	0xf3, 0x3e, 0x47, 0x66, 0x98, 0x96, 0x45, 0x7d, 0x57, 0x94, 0xa7, 0xab, 0xca, 0x7a, 0xa9, 0x31,
+	0x27, 0x6d, 0xdb, 0xa1, 0x89, 0xdc, 0x82, 0xb9, 0x96, 0x6f, 0x1d, 0xa0, 0x30, 0x5c, 0xb3, 0x87,
+	0xe5, 0x42, 0x80, 0x80, 0xd0, 0xb4, 0x67, 0xf6, 0x50, 0x02, 0x68, 0xeb, 0x05, 0x5a, 0x11, 0x60,
+	0x26, 0x04, 0x84, 0x26, 0x09, 0xd0, 0x5e, 0x80, 0x9a, 0x24, 0x8f, 0x7b, 0xd4, 0xe5, 0x48, 0x74,
+	0x28, 0x20, 0x63, 0x81, 0xb2, 0x39, 0xbd, 0x5a, 0x9b, 0xc8, 0x42, 0x18, 0x6e, 0x4d, 0xee, 0x7f,
+	0x20, 0xff, 0x6d, 0x48, 0x30, 0x29, 0xc3, 0x7b, 0x66, 0xb7, 0x4b, 0xbf, 0x45, 0x3b, 0x50, 0x7c,
+	0xa9, 0x31, 0x78, 0xd4, 0x9e, 0xc0, 0xb5, 0x5d, 0x14, 0x92, 0x6a, 0x8f, 0xba, 0x16, 0x0e, 0x92,
+	0xb0, 0x0a, 0x10, 0x85, 0x68, 0x38, 0x76, 0xc0, 0x55, 0x6a, 0x94, 0x22, 0xcb, 0x23, 0x9b, 0x2c,
+	0xc3, 0xac, 0x4d, 0x7b, 0xa6, 0xe3, 0x46, 0x09, 0x88, 0x9e, 0xb4, 0xbf, 0x15, 0xb8, 0x7e, 0xda,
+	0x5d, 0x0e, 0xd1, 0x1f, 0xc0, 0x82, 0xe5, 0x33, 0x86, 0xae, 0x30, 0x5c, 0xe9, 0x2c, 0xe0, 0x2a,
+	0x36, 0xe6, 0x23, 0x63, 0x40, 0x20, 0x85, 0xba, 0x78, 0x34, 0x40, 0x14, 0x02, 0x44, 0x49, 0x5a,
+	0xc2, 0xe5, 0xbb, 0x40, 0x06, 0x3e, 0x3c, 0xbf, 0xd5, 0x75, 0x2c, 0xe3, 0x00, 0xfb, 0x51, 0xca,
+	0x17, 0xa3, 0x95, 0xaf, 0x82, 0x85, 0xc7, 0xd8, 0x97, 0x27, 0x83, 0x47, 0x9e, 0xc3, 0xfa, 0x86,
+	0x6d, 0x0a, 0x2c, 0x17, 0xab, 0xca, 0x7a, 0xa1, 0x01, 0xa1, 0x69, 0xc7, 0x14, 0xa8, 0xfd, 0xa9,
+	0x80, 0xfa, 0xcc, 0x93, 0x8b, 0xcf, 0x38, 0xb2, 0xe1, 0xc6, 0x7c, 0x59, 0x8b, 0x07, 0x5a, 0x48,
+	0x08, 0xf4, 0x3a, 0x14, 0xc3, 0xc5, 0x99, 0x60, 0x31, 0x7c, 0x20, 0x1f, 0xc1, 0x95, 0xa0, 0x1e,
+	0xc7, 0x82, 0x2b, 0x06, 0xbe, 0x17, 0xfc, 0x71, 0x81, 0x93, 0x91, 0xcd, 0xc6, 0x22, 0x73, 0xe0,
+	0x46, 0x62, 0x60, 0x39, 0xce, 0x6f, 0x19, 0x66, 0x19, 0x72, 0xbf, 0x2b, 0xa2, 0x9a, 0x8b, 0x9e,
+	0xb4, 0xdf, 0x14, 0xa8, 0x3e, 0x47, 0xe6, 0xb4, 0xfb, 0xbb, 0x7b, 0x0f, 0x77, 0xee, 0x3d, 0xb0,
+	0x6d, 0x6e, 0x36, 0x9d, 0x8e, 0x6b, 0x0a, 0x9f, 0xe5, 0x2c, 0x40, 0xa2, 0xc1, 0x02, 0x6d, 0xb7,
+	0x0d, 0x6b, 0xdf, 0x74, 0x5c, 0x83, 0x3b, 0x9d, 0xe8, 0xf5, 0x9b, 0xa3, 0xed, 0xf6, 0x7d, 0x69,
+	0x6b, 0x3a, 0x1d, 0xf2, 0x31, 0x2c, 0x32, 0x34, 0xbb, 0x46, 0x8f, 0x77, 0x0c, 0x41, 0x25, 0xca,
+	0x0d, 0x92, 0x3a, 0xdf, 0x58, 0x90, 0xf6, 0x2f, 0x79, 0xe7, 0x29, 0x95, 0x7a, 0x34, 0x0a, 0x6b,
+	0xff, 0xa3, 0xf3, 0x02, 0x32, 0x23, 0x86, 0x2f, 0xe3, 0x63, 0xec, 0x3f, 0xd7, 0x73, 0xe6, 0x22,
+	0xa1, 0x36, 0x0a, 0x09, 0xb5, 0xa1, 0xfd, 0x34, 0x7a, 0x69, 0x23, 0xda, 0x1c, 0xa1, 0xad, 0x02,
+	0x8c, 0xf1, 0x85, 0x82, 0x4a, 0x5e, 0x5a, 0x1d, 0x16, 0x62, 0x75, 0xb8, 0x07, 0x4b, 0x4f, 0x1c,
+	0x3e, 0x10, 0xc3, 0xf3, 0x26, 0x41, 0xeb, 0xc1, 0xf2, 0xa4, 0xbf, 0x1c, 0xd1, 0xdd, 0x82, 0xb9,
+	0x51, 0x74, 0xbc, 0x3c, 0x5d, 0x2d, 0xc8, 0xd6, 0x3d, 0x0c, 0x8f, 0x6b, 0x2f, 0xe1, 0xfd, 0x1d,
+	0xec, 0xa2, 0xc0, 0xb7, 0x15, 0xc0, 0x24, 0x65, 0x21, 0x46, 0xd9, 0x86, 0x72, 0x9c, 0xf2, 0x02,
+	0x8a, 0xf3, 0x17, 0x05, 0x6e, 0x26, 0xb4, 0x88, 0x77, 0x55, 0xa6, 0x93, 0xa5, 0x33, 0x13, 0x2b,
+	0x9d, 0x03, 0x58, 0x4d, 0xd1, 0x77, 0x01, 0xd9, 0xf8, 0xe3, 0x54, 0x13, 0xd3, 0xdf, 0x6a, 0x13,
+	0xcb, 0x9a, 0x91, 0x58, 0xb3, 0x9b, 0xc9, 0xd6, 0xec, 0x8a, 0x67, 0x36, 0x3b, 0xfd, 0x1d, 0x34,
+	0xbb, 0x3e, 0x94, 0xef, 0x33, 0x34, 0x05, 0x36, 0xb7, 0xb6, 0x2d, 0x0b, 0x39, 0xcf, 0x7f, 0x91,
+	0x66, 0xed, 0x78, 0xbf, 0x2a, 0xb0, 0x92, 0xc0, 0x9d, 0x23, 0x48, 0x0d, 0x16, 0xcc, 0xc0, 0x91,
+	0x24, 0x95, 0x9a, 0xa3, 0xc1, 0xd0, 0x1c, 0x78, 0x7f, 0x64, 0x93, 0xdb, 0x70, 0x95, 0xa3, 0xc5,
+	0x50, 0x18, 0x23, 0x68, 0xa4, 0xef, 0x4a, 0xb8, 0x30, 0xd4, 0xa2, 0xfd, 0xa0, 0x40, 0x39, 0x3c,
+	0x8e, 0xe6, 0x56, 0xac, 0xac, 0x62, 0x64, 0x4a, 0x9c, 0xec, 0x43, 0xb8, 0xcc, 0x05, 0x73, 0xdc,
+	0xd1, 0xa9, 0x4f, 0x07, 0xa7, 0x3e, 0x1f, 0x5a, 0xc3, 0x43, 0x27, 0x37, 0xa1, 0xc4, 0x07, 0xde,
+	0x23, 0x29, 0x23, 0x83, 0xe6, 0xc2, 0x4a, 0x82, 0x86, 0x7c, 0x97, 0xc3, 0xd8, 0xb1, 0x4e, 0x4f,
+	0x1c, 0xab, 0xfe, 0x2f, 0x24, 0xcd, 0xe5, 0x4d, 0x64, 0x87, 0x8e, 0x85, 0xe4, 0x7b, 0x05, 0xca,
+	0x72, 0x35, 0x94, 0x74, 0x1a, 0x43, 0x36, 0x6b, 0x89, 0xbf, 0x06, 0x6a, 0xa9, 0x63, 0xbe, 0x7a,
+	0xef, 0x1c, 0x3b, 0xc2, 0x90, 0xb5, 0x29, 0xe2, 0xc0, 0xfc, 0xf8, 0x78, 0x4b, 0x6e, 0xa7, 0x39,
+	0x89, 0x8f, 0xd4, 0xea, 0x9d, 0x4c, 0xd8, 0x21, 0xd5, 0x77, 0x70, 0x2d, 0xa1, 0x9b, 0x91, 0x34,
+	0xd9, 0xe9, 0x53, 0xa9, 0xaa, 0x9f, 0x67, 0xcb, 0x90, 0xff, 0x95, 0x02, 0x2b, 0xa3, 0x86, 0x30,
+	0x31, 0xfd, 0x90, 0xcf, 0x52, 0x7c, 0x9e, 0x35, 0xd7, 0xa9, 0x9f, 0x9f, 0x7f, 0x63, 0x42, 0xf6,
+	0x83, 0xbe, 0x7e, 0x56, 0xf6, 0xc7, 0x2f, 0x27, 0xf5, 0x4e, 0x26, 0xec, 0x90, 0x8a, 0xc2, 0xe5,
+	0xd3, 0x63, 0x03, 0xb9, 0x9b, 0xe2, 0x20, 0x71, 0x5a, 0x51, 0x37, 0x32, 0xa2, 0x87, 0x84, 0x3e,
+	0x2c, 0x4e, 0xde, 0xe2, 0xa4, 0x96, 0xe2, 0x24, 0x65, 0xc2, 0x50, 0xeb, 0x99, 0xf1, 0x43, 0xda,
+	0x1f, 0x15, 0x58, 0x4a, 0xbc, 0x34, 0xc9, 0x56, 0xf6, 0xaa, 0x19, 0x29, 0xf8, 0xf4, 0x7c, 0x9b,
+	0x52, 0x8a, 0x4d, 0x3f, 0x77, 0xb1, 0xe9, 0x6f, 0x5a, 0x6c, 0x7a, 0x6a, 0xb1, 0x1d, 0xc1, 0xd5,
+	0xd8, 0x15, 0x41, 0xd2, 0x32, 0x9c, 0x76, 0x91, 0xa9, 0x9b, 0xd9, 0x37, 0x8c, 0x33, 0xc7, 0xda,
+	0x6e, 0x2a, 0x73, 0xda, 0x25, 0xa1, 0x6e, 0x66, 0xdf, 0x30, 0x60, 0xfe, 0xe2, 0x9b, 0xdf, 0x8f,
+	0x2b, 0xca, 0xeb, 0xe3, 0x8a, 0xf2, 0xcf, 0x71, 0x45, 0x79, 0x75, 0x52, 0x99, 0x7a, 0x7d, 0x52,
+	0x99, 0xfa, 0xeb, 0xa4, 0x32, 0xf5, 0xf5, 0xc3, 0x8e, 0x23, 0xf6, 0xfd, 0x56, 0xcd, 0xa2, 0xbd,
+	0x7a, 0xcb, 0x6d, 0x6d, 0x04, 0x33, 0x47, 0xbd, 0xc3, 0x10, 0xdd, 0xb6, 0x83, 0x5d, 0x7b, 0x83,
+	0x0b, 0xca, 0xcc, 0x0e, 0x6e, 0x78, 0x8c, 0x1e, 0x3a, 0x36, 0xb2, 0x7a, 0xe2, 0x87, 0x9c, 0xd6,
+	0x6c, 0xf0, 0x05, 0x66, 0xeb, 0xbf, 0x01, 0x00, 0x2d, 0x10, 0x41, 0x69, 0xe8, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1291,6 +1549,10 @@ type GfSpAuthenticationServiceClient interface {
 	UpdateUserPublicKeyV2(ctx context.Context, in *UpdateUserPublicKeyV2Request, opts ...grpc.CallOption) (*UpdateUserPublicKeyV2Response, error)
 	// VerifyGNFD2EddsaSignature verifies the signature signed by user's EDDSA private key.
 	VerifyGNFD2EddsaSignature(ctx context.Context, in *VerifyGNFD2EddsaSignatureRequest, opts ...grpc.CallOption) (*VerifyGNFD2EddsaSignatureResponse, error)
+	// CreateS3AccessKey creates an access key of the S3 compatible api which is bound to a registered off-chain auth key.
+	CreateS3AccessKey(ctx context.Context, in *CreateS3AccessKeyRequest, opts ...grpc.CallOption) (*CreateS3AccessKeyResponse, error)
+	// VerifyS3Signature verifies the AWS signature version 4 of the S3 compatible api request.
+	VerifyS3Signature(ctx context.Context, in *VerifyS3SignatureRequest, opts ...grpc.CallOption) (*VerifyS3SignatureResponse, error)
 }
 
 type gfSpAuthenticationServiceClient struct {
@@ -1382,6 +1644,24 @@ func (c *gfSpAuthenticationServiceClient) VerifyGNFD2EddsaSignature(ctx context.
 	return out, nil
 }
 
+func (c *gfSpAuthenticationServiceClient) CreateS3AccessKey(ctx context.Context, in *CreateS3AccessKeyRequest, opts ...grpc.CallOption) (*CreateS3AccessKeyResponse, error) {
+	out := new(CreateS3AccessKeyResponse)
+	err := c.cc.Invoke(ctx, "/base.types.gfspserver.GfSpAuthenticationService/CreateS3AccessKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gfSpAuthenticationServiceClient) VerifyS3Signature(ctx context.Context, in *VerifyS3SignatureRequest, opts ...grpc.CallOption) (*VerifyS3SignatureResponse, error) {
+	out := new(VerifyS3SignatureResponse)
+	err := c.cc.Invoke(ctx, "/base.types.gfspserver.GfSpAuthenticationService/VerifyS3Signature", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GfSpAuthenticationServiceServer is the server API for GfSpAuthenticationService service.
 type GfSpAuthenticationServiceServer interface {
 	GfSpVerifyAuthentication(context.Context, *GfSpAuthenticationRequest) (*GfSpAuthenticationResponse, error)
@@ -1401,6 +1681,10 @@ type GfSpAuthenticationServiceServer interface {
 	UpdateUserPublicKeyV2(context.Context, *UpdateUserPublicKeyV2Request) (*UpdateUserPublicKeyV2Response, error)
 	// VerifyGNFD2EddsaSignature verifies the signature signed by user's EDDSA private key.
 	VerifyGNFD2EddsaSignature(context.Context, *VerifyGNFD2EddsaSignatureRequest) (*VerifyGNFD2EddsaSignatureResponse, error)
+	// CreateS3AccessKey creates an access key of the S3 compatible api which is bound to a registered off-chain auth key.
+	CreateS3AccessKey(context.Context, *CreateS3AccessKeyRequest) (*CreateS3AccessKeyResponse, error)
+	// VerifyS3Signature verifies the AWS signature version 4 of the S3 compatible api request.
+	VerifyS3Signature(context.Context, *VerifyS3SignatureRequest) (*VerifyS3SignatureResponse, error)
 }
 
 // UnimplementedGfSpAuthenticationServiceServer can be embedded to have forward compatible implementations.
@@ -1434,6 +1718,12 @@ func (*UnimplementedGfSpAuthenticationServiceServer) UpdateUserPublicKeyV2(ctx c
 func (*UnimplementedGfSpAuthenticationServiceServer) VerifyGNFD2EddsaSignature(ctx context.Context, req *VerifyGNFD2EddsaSignatureRequest) (*VerifyGNFD2EddsaSignatureResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyGNFD2EddsaSignature not implemented")
 }
+func (*UnimplementedGfSpAuthenticationServiceServer) CreateS3AccessKey(ctx context.Context, req *CreateS3AccessKeyRequest) (*CreateS3AccessKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateS3AccessKey not implemented")
+}
+func (*UnimplementedGfSpAuthenticationServiceServer) VerifyS3Signature(ctx context.Context, req *VerifyS3SignatureRequest) (*VerifyS3SignatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyS3Signature not implemented")
+}
 
 func RegisterGfSpAuthenticationServiceServer(s grpc1.Server, srv GfSpAuthenticationServiceServer) {
 	s.RegisterService(&_GfSpAuthenticationService_serviceDesc, srv)
@@ -1601,6 +1891,42 @@ func _GfSpAuthenticationService_VerifyGNFD2EddsaSignature_Handler(srv interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GfSpAuthenticationService_CreateS3AccessKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateS3AccessKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GfSpAuthenticationServiceServer).CreateS3AccessKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.types.gfspserver.GfSpAuthenticationService/CreateS3AccessKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GfSpAuthenticationServiceServer).CreateS3AccessKey(ctx, req.(*CreateS3AccessKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GfSpAuthenticationService_VerifyS3Signature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyS3SignatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GfSpAuthenticationServiceServer).VerifyS3Signature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.types.gfspserver.GfSpAuthenticationService/VerifyS3Signature",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GfSpAuthenticationServiceServer).VerifyS3Signature(ctx, req.(*VerifyS3SignatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _GfSpAuthenticationService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "base.types.gfspserver.GfSpAuthenticationService",
 	HandlerType: (*GfSpAuthenticationServiceServer)(nil),
@@ -1641,6 +1967,14 @@ var _GfSpAuthenticationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VerifyGNFD2EddsaSignature",
 			Handler:    _GfSpAuthenticationService_VerifyGNFD2EddsaSignature_Handler,
 		},
+		{
+			MethodName: "CreateS3AccessKey",
+			Handler:    _GfSpAuthenticationService_CreateS3AccessKey_Handler,
+		},
+		{
+			MethodName: "VerifyS3Signature",
+			Handler:    _GfSpAuthenticationService_VerifyS3Signature_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "base/types/gfspserver/authenticator.proto",
@@ -2494,44 +2828,223 @@ func (m *VerifyGNFD2EddsaSignatureResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAuthenticator(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAuthenticator(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CreateS3AccessKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GfSpAuthenticationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *CreateS3AccessKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateS3AccessKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AuthType != 0 {
-		n += 1 + sovAuthenticator(uint64(m.AuthType))
-	}
-	l = len(m.UserAccount)
-	if l > 0 {
-		n += 1 + l + sovAuthenticator(uint64(l))
+	if len(m.UserPublicKey) > 0 {
+		i -= len(m.UserPublicKey)
+		copy(dAtA[i:], m.UserPublicKey)
+		i = encodeVarintAuthenticator(dAtA, i, uint64(len(m.UserPublicKey)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovAuthenticator(uint64(l))
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintAuthenticator(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovAuthenticator(uint64(l))
+	if len(m.AccountId) > 0 {
+		i -= len(m.AccountId)
+		copy(dAtA[i:], m.AccountId)
+		i = encodeVarintAuthenticator(dAtA, i, uint64(len(m.AccountId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GfSpAuthenticationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreateS3AccessKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateS3AccessKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateS3AccessKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SecretAccessKey) > 0 {
+		i -= len(m.SecretAccessKey)
+		copy(dAtA[i:], m.SecretAccessKey)
+		i = encodeVarintAuthenticator(dAtA, i, uint64(len(m.SecretAccessKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AccessKeyId) > 0 {
+		i -= len(m.AccessKeyId)
+		copy(dAtA[i:], m.AccessKeyId)
+		i = encodeVarintAuthenticator(dAtA, i, uint64(len(m.AccessKeyId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Err != nil {
+		{
+			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthenticator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyS3SignatureRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyS3SignatureRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyS3SignatureRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintAuthenticator(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StringToSign) > 0 {
+		i -= len(m.StringToSign)
+		copy(dAtA[i:], m.StringToSign)
+		i = encodeVarintAuthenticator(dAtA, i, uint64(len(m.StringToSign)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AccessKeyId) > 0 {
+		i -= len(m.AccessKeyId)
+		copy(dAtA[i:], m.AccessKeyId)
+		i = encodeVarintAuthenticator(dAtA, i, uint64(len(m.AccessKeyId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyS3SignatureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyS3SignatureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyS3SignatureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccountId) > 0 {
+		i -= len(m.AccountId)
+		copy(dAtA[i:], m.AccountId)
+		i = encodeVarintAuthenticator(dAtA, i, uint64(len(m.AccountId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Err != nil {
+		{
+			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthenticator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthenticator(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthenticator(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GfSpAuthenticationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuthType != 0 {
+		n += 1 + sovAuthenticator(uint64(m.AuthType))
+	}
+	l = len(m.UserAccount)
+	if l > 0 {
+		n += 1 + l + sovAuthenticator(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovAuthenticator(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovAuthenticator(uint64(l))
+	}
+	return n
+}
+
+func (m *GfSpAuthenticationResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -2876,6 +3389,86 @@ func (m *VerifyGNFD2EddsaSignatureResponse) Size() (n int) {
 	return n
 }
 
+func (m *CreateS3AccessKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AccountId)
+	if l > 0 {
+		n += 1 + l + sovAuthenticator(uint64(l))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovAuthenticator(uint64(l))
+	}
+	l = len(m.UserPublicKey)
+	if l > 0 {
+		n += 1 + l + sovAuthenticator(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateS3AccessKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Err != nil {
+		l = m.Err.Size()
+		n += 1 + l + sovAuthenticator(uint64(l))
+	}
+	l = len(m.AccessKeyId)
+	if l > 0 {
+		n += 1 + l + sovAuthenticator(uint64(l))
+	}
+	l = len(m.SecretAccessKey)
+	if l > 0 {
+		n += 1 + l + sovAuthenticator(uint64(l))
+	}
+	return n
+}
+
+func (m *VerifyS3SignatureRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AccessKeyId)
+	if l > 0 {
+		n += 1 + l + sovAuthenticator(uint64(l))
+	}
+	l = len(m.StringToSign)
+	if l > 0 {
+		n += 1 + l + sovAuthenticator(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovAuthenticator(uint64(l))
+	}
+	return n
+}
+
+func (m *VerifyS3SignatureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Err != nil {
+		l = m.Err.Size()
+		n += 1 + l + sovAuthenticator(uint64(l))
+	}
+	l = len(m.AccountId)
+	if l > 0 {
+		n += 1 + l + sovAuthenticator(uint64(l))
+	}
+	return n
+}
+
 func sovAuthenticator(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5393,6 +5986,568 @@ func (m *VerifyGNFD2EddsaSignatureResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CreateS3AccessKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthenticator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateS3AccessKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateS3AccessKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserPublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserPublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthenticator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateS3AccessKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthenticator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateS3AccessKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateS3AccessKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Err == nil {
+				m.Err = &gfsperrors.GfSpError{}
+			}
+			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessKeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretAccessKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecretAccessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthenticator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyS3SignatureRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthenticator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyS3SignatureRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyS3SignatureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessKeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringToSign", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StringToSign = append(m.StringToSign[:0], dAtA[iNdEx:postIndex]...)
+			if m.StringToSign == nil {
+				m.StringToSign = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthenticator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyS3SignatureResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthenticator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyS3SignatureResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyS3SignatureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Err == nil {
+				m.Err = &gfsperrors.GfSpError{}
+			}
+			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthenticator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAuthenticator(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
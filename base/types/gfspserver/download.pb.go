@@ -565,50 +565,50 @@ func init() {
 }
 
 var fileDescriptor_4e9c5d8fc8df4b20 = []byte{
-	// 679 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x4f, 0xdb, 0x4a,
-	0x14, 0x8d, 0x1f, 0x79, 0x4f, 0x2f, 0x03, 0xef, 0x49, 0x0c, 0x54, 0x4a, 0x0d, 0x35, 0xc1, 0xea,
-	0x07, 0x6a, 0x85, 0x4d, 0xc3, 0xba, 0x5d, 0xd0, 0x16, 0xca, 0x02, 0x95, 0x9a, 0xae, 0x90, 0x50,
-	0x3a, 0xf6, 0xdc, 0xd8, 0x6e, 0x12, 0x8f, 0x99, 0x19, 0xa7, 0xd0, 0x76, 0xd3, 0x55, 0xb7, 0xfd,
-	0x0d, 0xdd, 0x76, 0xd7, 0x5f, 0xd1, 0x25, 0xcb, 0x2e, 0x2b, 0xf8, 0x23, 0x95, 0xc7, 0xf9, 0x22,
-	0x31, 0x09, 0x20, 0x36, 0x89, 0x75, 0xe6, 0xde, 0x73, 0xee, 0xb9, 0x9e, 0x7b, 0x13, 0x74, 0xd7,
-	0x25, 0x02, 0x6c, 0x79, 0x1c, 0x83, 0xb0, 0xfd, 0xba, 0x88, 0x05, 0xf0, 0x36, 0x70, 0x9b, 0xb2,
-	0xf7, 0x51, 0x93, 0x11, 0x6a, 0xc5, 0x9c, 0x49, 0x86, 0x6f, 0xa5, 0x51, 0x96, 0x8a, 0xb2, 0xfa,
-	0x51, 0xfa, 0xf2, 0x50, 0x32, 0x70, 0xce, 0xb8, 0xb0, 0xd5, 0x57, 0x96, 0xa9, 0x1b, 0x43, 0x21,
-	0x92, 0x88, 0x86, 0x9d, 0x7e, 0x64, 0xe7, 0xe6, 0x07, 0x74, 0x7b, 0xab, 0xbe, 0x17, 0x3f, 0xef,
-	0xe8, 0xbd, 0x72, 0xdf, 0x81, 0x27, 0x1d, 0x38, 0x4c, 0x40, 0x48, 0x7c, 0x80, 0xe6, 0xbb, 0x85,
-	0xd4, 0x98, 0x3a, 0xa9, 0xa5, 0xa9, 0x65, 0xad, 0xa2, 0xad, 0x4c, 0x57, 0x1f, 0x59, 0x43, 0x55,
-	0x29, 0xda, 0x51, 0xb6, 0x37, 0x44, 0x34, 0x1c, 0x4c, 0x47, 0x30, 0x93, 0x22, 0x3d, 0x4f, 0x5b,
-	0xc4, 0x2c, 0x12, 0x80, 0xab, 0x68, 0x0a, 0x38, 0xef, 0x68, 0x55, 0x86, 0xb5, 0x32, 0xab, 0x4a,
-	0xed, 0x45, 0xfa, 0xe8, 0xa4, 0xc1, 0x18, 0xa3, 0x22, 0x25, 0x92, 0x94, 0xff, 0xaa, 0x68, 0x2b,
-	0x33, 0x8e, 0x7a, 0x36, 0xdb, 0xa8, 0x3c, 0xa8, 0xb2, 0x1b, 0x82, 0x07, 0x5d, 0x83, 0xfb, 0x68,
-	0xae, 0x67, 0x30, 0x4e, 0x0f, 0x06, 0xfd, 0x3d, 0x9c, 0xe8, 0x4f, 0x71, 0x29, 0x7b, 0xb3, 0x74,
-	0x18, 0x32, 0xbd, 0xf3, 0x9d, 0xed, 0xe8, 0xde, 0xb0, 0xb9, 0x4f, 0x68, 0x21, 0x8d, 0xda, 0x02,
-	0xf9, 0x2c, 0x20, 0xcd, 0x26, 0x44, 0x3e, 0x6c, 0x47, 0x75, 0x36, 0xf0, 0x02, 0xbd, 0x2e, 0x3e,
-	0x6a, 0xf0, 0xe2, 0x17, 0xd8, 0x23, 0xeb, 0x3b, 0xc4, 0xde, 0x08, 0x66, 0x7e, 0xd7, 0xd0, 0x62,
-	0xbe, 0xfc, 0xcd, 0xda, 0xc4, 0xf7, 0xd0, 0xff, 0x61, 0x24, 0xc1, 0xe7, 0xa1, 0x3c, 0xae, 0x05,
-	0x44, 0x04, 0xe5, 0x29, 0x75, 0xfa, 0x5f, 0x0f, 0x7d, 0x49, 0x44, 0x80, 0x17, 0x51, 0xc9, 0x0b,
-	0xc0, 0x6b, 0x88, 0xa4, 0x25, 0xca, 0xc5, 0xca, 0xd4, 0xca, 0x8c, 0xd3, 0x07, 0xcc, 0xa3, 0xec,
-	0x85, 0x38, 0x10, 0xb6, 0xdc, 0x84, 0x0b, 0x78, 0x9d, 0x30, 0x49, 0xba, 0x9d, 0x5a, 0x40, 0x25,
-	0x37, 0xf1, 0x1a, 0x20, 0x6b, 0x21, 0x55, 0xf5, 0x16, 0x9d, 0x7f, 0x33, 0x60, 0x9b, 0xe2, 0x25,
-	0x34, 0x0d, 0x47, 0x92, 0x93, 0xda, 0x61, 0x9a, 0xa2, 0x2a, 0x2b, 0x3a, 0x48, 0x41, 0x8a, 0x04,
-	0xdf, 0x41, 0xe8, 0x18, 0x08, 0xaf, 0xb5, 0x58, 0x24, 0xb3, 0xda, 0x4a, 0x4e, 0x29, 0x45, 0x76,
-	0x52, 0xc0, 0xdc, 0xcd, 0x2e, 0xfa, 0xb0, 0xf2, 0xf5, 0x9b, 0x64, 0x7e, 0xd1, 0xd0, 0x7d, 0x75,
-	0xbb, 0x80, 0x26, 0x9e, 0x54, 0x7c, 0x9b, 0x8c, 0x6f, 0xa8, 0x82, 0x77, 0x42, 0x9f, 0x13, 0x09,
-	0x97, 0x72, 0xb6, 0x8c, 0x66, 0xa8, 0xa2, 0x38, 0x67, 0x6d, 0x9a, 0xf6, 0x69, 0x27, 0x79, 0x3b,
-	0x40, 0x0f, 0x26, 0x16, 0x72, 0x7d, 0xa3, 0xd5, 0x1f, 0x7f, 0xa3, 0xb9, 0xc1, 0x31, 0xda, 0x03,
-	0xde, 0x0e, 0x3d, 0xc0, 0x1f, 0x11, 0x1e, 0xdd, 0x1d, 0x78, 0xcd, 0xca, 0x5d, 0x94, 0xd6, 0x85,
-	0x2b, 0x4e, 0x7f, 0x7c, 0x85, 0x8c, 0xcc, 0x86, 0x59, 0xc0, 0x47, 0x68, 0x76, 0x64, 0xb4, 0xb1,
-	0x7d, 0x09, 0xa6, 0xc1, 0xe5, 0xa3, 0xaf, 0x5d, 0x3e, 0xa1, 0xa7, 0xfc, 0x59, 0x43, 0xf3, 0x79,
-	0x13, 0x87, 0xab, 0x63, 0xc8, 0x2e, 0xd8, 0x0e, 0xfa, 0xfa, 0x95, 0x72, 0x7a, 0x35, 0x74, 0x5a,
-	0x7f, 0xfe, 0x36, 0x8f, 0x6d, 0x7d, 0xee, 0xc8, 0x8d, 0x6d, 0x7d, 0xfe, 0xa8, 0x98, 0x05, 0xfc,
-	0x4d, 0x43, 0x4b, 0x13, 0xee, 0x1b, 0x7e, 0x32, 0xae, 0xb1, 0x13, 0x07, 0x46, 0x7f, 0x7a, 0xdd,
-	0xf4, 0x6e, 0x91, 0x1b, 0x6f, 0x7f, 0x9e, 0x1a, 0xda, 0xc9, 0xa9, 0xa1, 0xfd, 0x3e, 0x35, 0xb4,
-	0xaf, 0x67, 0x46, 0xe1, 0xe4, 0xcc, 0x28, 0xfc, 0x3a, 0x33, 0x0a, 0xfb, 0x9b, 0x7e, 0x28, 0x83,
-	0xc4, 0xb5, 0x3c, 0xd6, 0xb2, 0xdd, 0xc8, 0x5d, 0xf5, 0x02, 0x12, 0x46, 0xb6, 0xcf, 0x01, 0xa2,
-	0x7a, 0x08, 0x4d, 0xba, 0x2a, 0x24, 0xe3, 0xc4, 0x87, 0xd5, 0x98, 0xb3, 0x76, 0x48, 0x81, 0xdb,
-	0xb9, 0xff, 0x0f, 0xdc, 0x7f, 0xd4, 0xaf, 0xf7, 0xfa, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0f,
-	0x14, 0xb3, 0xfa, 0x3f, 0x08, 0x00, 0x00,
+	// 686 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4d, 0x4f, 0xd4, 0x40,
+	0x18, 0xde, 0xca, 0x86, 0xb8, 0x03, 0x9a, 0x30, 0x60, 0xb2, 0x16, 0x2c, 0x4b, 0xe3, 0x07, 0xd1,
+	0xd0, 0xc2, 0x72, 0xd6, 0x03, 0x2a, 0xc8, 0x81, 0x88, 0xc5, 0x13, 0x09, 0x59, 0xa7, 0xed, 0xbb,
+	0x6d, 0xdd, 0xdd, 0x4e, 0x99, 0x99, 0xae, 0xa0, 0x5e, 0x8c, 0x07, 0xaf, 0xfe, 0x06, 0xaf, 0xfe,
+	0x01, 0x7f, 0x82, 0x47, 0x8e, 0x1e, 0x0d, 0xfc, 0x11, 0xd3, 0xe9, 0x7e, 0xb1, 0x2d, 0x2c, 0x10,
+	0xe2, 0x65, 0xb7, 0x79, 0xe6, 0x7d, 0x9f, 0x8f, 0xe9, 0xcc, 0x9b, 0xa2, 0xfb, 0x36, 0xe1, 0x60,
+	0x8a, 0xc3, 0x08, 0xb8, 0xe9, 0xd5, 0x79, 0xc4, 0x81, 0xb5, 0x81, 0x99, 0x2e, 0xfd, 0x10, 0x36,
+	0x29, 0x71, 0x8d, 0x88, 0x51, 0x41, 0xf1, 0x9d, 0xa4, 0xca, 0x90, 0x55, 0x46, 0xbf, 0x4a, 0x5d,
+	0x18, 0x6a, 0x06, 0xc6, 0x28, 0xe3, 0xa6, 0xfc, 0x4b, 0x3b, 0x55, 0x6d, 0xa8, 0x44, 0x10, 0xde,
+	0x30, 0x93, 0x9f, 0x74, 0x5d, 0xff, 0x88, 0xee, 0x6e, 0xd4, 0x77, 0xa2, 0x17, 0x1d, 0xbd, 0xd7,
+	0xf6, 0x7b, 0x70, 0x84, 0x05, 0xfb, 0x31, 0x70, 0x81, 0xf7, 0xd0, 0x4c, 0xd7, 0x48, 0x8d, 0xca,
+	0x95, 0x5a, 0xd2, 0x5a, 0x56, 0x2a, 0xca, 0xe2, 0x44, 0xf5, 0x89, 0x31, 0xe4, 0x4a, 0xd2, 0x66,
+	0xd9, 0xde, 0x12, 0xde, 0xb0, 0xb0, 0x9b, 0xc1, 0x74, 0x17, 0xa9, 0x79, 0xda, 0x3c, 0xa2, 0x21,
+	0x07, 0x5c, 0x45, 0x63, 0xc0, 0x58, 0x47, 0xab, 0x32, 0xac, 0x95, 0x46, 0x95, 0x6a, 0x2f, 0x93,
+	0x47, 0x2b, 0x29, 0xc6, 0x18, 0x15, 0x5d, 0x22, 0x48, 0xf9, 0x46, 0x45, 0x59, 0x9c, 0xb4, 0xe4,
+	0xb3, 0xde, 0x46, 0xe5, 0x41, 0x95, 0xed, 0x00, 0x1c, 0xe8, 0x06, 0xdc, 0x45, 0xd3, 0xbd, 0x80,
+	0x51, 0xb2, 0x30, 0x98, 0xef, 0xf1, 0xc8, 0x7c, 0x92, 0x4b, 0xc6, 0x9b, 0x72, 0x87, 0x21, 0xdd,
+	0x39, 0xbd, 0xb3, 0x1d, 0xdd, 0x6b, 0x0e, 0xf7, 0x19, 0xcd, 0x26, 0x55, 0x1b, 0x20, 0x9e, 0xfb,
+	0xa4, 0xd9, 0x84, 0xd0, 0x83, 0xcd, 0xb0, 0x4e, 0x07, 0x5e, 0xa0, 0xd3, 0xc5, 0xb3, 0x01, 0xcf,
+	0x7e, 0x81, 0x3d, 0xb2, 0x7e, 0x42, 0xec, 0x64, 0x30, 0xfd, 0xa7, 0x82, 0xe6, 0xf2, 0xe5, 0xaf,
+	0x37, 0x26, 0x7e, 0x80, 0x6e, 0x07, 0xa1, 0x00, 0x8f, 0x05, 0xe2, 0xb0, 0xe6, 0x13, 0xee, 0x97,
+	0xc7, 0xe4, 0xea, 0xad, 0x1e, 0xfa, 0x8a, 0x70, 0x1f, 0xcf, 0xa1, 0x92, 0xe3, 0x83, 0xd3, 0xe0,
+	0x71, 0x8b, 0x97, 0x8b, 0x95, 0xb1, 0xc5, 0x49, 0xab, 0x0f, 0xe8, 0x07, 0xe9, 0x0b, 0xb1, 0x20,
+	0x68, 0xd9, 0x31, 0xe3, 0xf0, 0x26, 0xa6, 0x82, 0x74, 0x77, 0x6a, 0x16, 0x95, 0xec, 0xd8, 0x69,
+	0x80, 0xa8, 0x05, 0xae, 0xf4, 0x5b, 0xb4, 0x6e, 0xa6, 0xc0, 0xa6, 0x8b, 0xe7, 0xd1, 0x04, 0x1c,
+	0x08, 0x46, 0x6a, 0xfb, 0x31, 0xed, 0x38, 0x2b, 0x5a, 0x48, 0x42, 0x92, 0x04, 0xdf, 0x43, 0xe8,
+	0x10, 0x08, 0xab, 0xb5, 0x68, 0x28, 0x52, 0x6f, 0x25, 0xab, 0x94, 0x20, 0x5b, 0x09, 0xa0, 0x6f,
+	0x23, 0x35, 0x4f, 0xf9, 0xea, 0x9b, 0xa4, 0x7f, 0x53, 0xd0, 0x43, 0x79, 0xba, 0xc0, 0x8d, 0x1d,
+	0x21, 0xf9, 0xd6, 0x29, 0x5b, 0x93, 0x86, 0xb7, 0x02, 0x8f, 0x11, 0x01, 0x17, 0x4a, 0xb6, 0x80,
+	0x26, 0x5d, 0x49, 0x71, 0x2a, 0xda, 0x84, 0xdb, 0xa7, 0x1d, 0x95, 0x6d, 0x0f, 0x3d, 0x1a, 0x69,
+	0xe4, 0xea, 0x41, 0xab, 0xbf, 0xc6, 0xd1, 0xf4, 0xe0, 0x35, 0xda, 0x01, 0xd6, 0x0e, 0x1c, 0xc0,
+	0x9f, 0x10, 0xce, 0xce, 0x0e, 0xbc, 0x6c, 0xe4, 0x0e, 0x4a, 0xe3, 0xcc, 0x11, 0xa7, 0xae, 0x5c,
+	0xa2, 0x23, 0x8d, 0xa1, 0x17, 0xf0, 0x57, 0x05, 0x95, 0xb3, 0x05, 0x3b, 0x82, 0x01, 0x69, 0xfd,
+	0x27, 0x0f, 0xcb, 0x0a, 0x3e, 0x40, 0x53, 0x99, 0x01, 0x83, 0xcd, 0x0b, 0x70, 0x0d, 0x8e, 0x40,
+	0x75, 0xf9, 0xe2, 0x0d, 0xbd, 0xfc, 0x5f, 0x14, 0x34, 0x93, 0x77, 0xef, 0x71, 0xf5, 0x1c, 0xb2,
+	0x33, 0x66, 0x94, 0xba, 0x7a, 0xa9, 0x9e, 0x9e, 0x87, 0xce, 0x01, 0x38, 0x7d, 0xa7, 0xce, 0xdd,
+	0xfc, 0xdc, 0x8b, 0xaf, 0xae, 0x5c, 0xa2, 0xa3, 0x27, 0xfe, 0x43, 0x41, 0xf3, 0x23, 0x4e, 0x3d,
+	0x7e, 0x7a, 0xde, 0xc6, 0x8e, 0xbc, 0xb6, 0xea, 0xb3, 0xab, 0xb6, 0x77, 0x4d, 0xae, 0xbd, 0xfb,
+	0x7d, 0xac, 0x29, 0x47, 0xc7, 0x9a, 0xf2, 0xf7, 0x58, 0x53, 0xbe, 0x9f, 0x68, 0x85, 0xa3, 0x13,
+	0xad, 0xf0, 0xe7, 0x44, 0x2b, 0xec, 0xae, 0x7b, 0x81, 0xf0, 0x63, 0xdb, 0x70, 0x68, 0xcb, 0xb4,
+	0x43, 0x7b, 0xc9, 0xf1, 0x49, 0x10, 0x9a, 0x1e, 0x03, 0x08, 0xeb, 0x01, 0x34, 0xdd, 0x25, 0x2e,
+	0x28, 0x23, 0x1e, 0x2c, 0x45, 0x8c, 0xb6, 0x03, 0x17, 0x98, 0x99, 0xfb, 0x95, 0x62, 0x8f, 0xcb,
+	0x6f, 0x88, 0xd5, 0x7f, 0x03, 0x00, 0x35, 0xb0, 0xa5, 0x77, 0xc5, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -624,6 +624,9 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type GfSpDownloadServiceClient interface {
 	GfSpDownloadObject(ctx context.Context, in *GfSpDownloadObjectRequest, opts ...grpc.CallOption) (*GfSpDownloadObjectResponse, error)
+	// GfSpDownloadObjectStream streams the object data segment by segment, the data field of each
+	// response carries one chunk, the err field is set in the last response if the download fails.
+	GfSpDownloadObjectStream(ctx context.Context, in *GfSpDownloadObjectRequest, opts ...grpc.CallOption) (GfSpDownloadService_GfSpDownloadObjectStreamClient, error)
 	GfSpDownloadPiece(ctx context.Context, in *GfSpDownloadPieceRequest, opts ...grpc.CallOption) (*GfSpDownloadPieceResponse, error)
 	GfSpGetChallengeInfo(ctx context.Context, in *GfSpGetChallengeInfoRequest, opts ...grpc.CallOption) (*GfSpGetChallengeInfoResponse, error)
 	GfSpReimburseQuota(ctx context.Context, in *GfSpReimburseQuotaRequest, opts ...grpc.CallOption) (*GfSpReimburseQuotaResponse, error)
@@ -647,6 +650,38 @@ func (c *gfSpDownloadServiceClient) GfSpDownloadObject(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *gfSpDownloadServiceClient) GfSpDownloadObjectStream(ctx context.Context, in *GfSpDownloadObjectRequest, opts ...grpc.CallOption) (GfSpDownloadService_GfSpDownloadObjectStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GfSpDownloadService_serviceDesc.Streams[0], "/base.types.gfspserver.GfSpDownloadService/GfSpDownloadObjectStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gfSpDownloadServiceGfSpDownloadObjectStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GfSpDownloadService_GfSpDownloadObjectStreamClient interface {
+	Recv() (*GfSpDownloadObjectResponse, error)
+	grpc.ClientStream
+}
+
+type gfSpDownloadServiceGfSpDownloadObjectStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *gfSpDownloadServiceGfSpDownloadObjectStreamClient) Recv() (*GfSpDownloadObjectResponse, error) {
+	m := new(GfSpDownloadObjectResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *gfSpDownloadServiceClient) GfSpDownloadPiece(ctx context.Context, in *GfSpDownloadPieceRequest, opts ...grpc.CallOption) (*GfSpDownloadPieceResponse, error) {
 	out := new(GfSpDownloadPieceResponse)
 	err := c.cc.Invoke(ctx, "/base.types.gfspserver.GfSpDownloadService/GfSpDownloadPiece", in, out, opts...)
@@ -686,6 +721,9 @@ func (c *gfSpDownloadServiceClient) GfSpDeductQuotaForBucketMigrate(ctx context.
 // GfSpDownloadServiceServer is the server API for GfSpDownloadService service.
 type GfSpDownloadServiceServer interface {
 	GfSpDownloadObject(context.Context, *GfSpDownloadObjectRequest) (*GfSpDownloadObjectResponse, error)
+	// GfSpDownloadObjectStream streams the object data segment by segment, the data field of each
+	// response carries one chunk, the err field is set in the last response if the download fails.
+	GfSpDownloadObjectStream(*GfSpDownloadObjectRequest, GfSpDownloadService_GfSpDownloadObjectStreamServer) error
 	GfSpDownloadPiece(context.Context, *GfSpDownloadPieceRequest) (*GfSpDownloadPieceResponse, error)
 	GfSpGetChallengeInfo(context.Context, *GfSpGetChallengeInfoRequest) (*GfSpGetChallengeInfoResponse, error)
 	GfSpReimburseQuota(context.Context, *GfSpReimburseQuotaRequest) (*GfSpReimburseQuotaResponse, error)
@@ -699,6 +737,9 @@ type UnimplementedGfSpDownloadServiceServer struct {
 func (*UnimplementedGfSpDownloadServiceServer) GfSpDownloadObject(ctx context.Context, req *GfSpDownloadObjectRequest) (*GfSpDownloadObjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GfSpDownloadObject not implemented")
 }
+func (*UnimplementedGfSpDownloadServiceServer) GfSpDownloadObjectStream(req *GfSpDownloadObjectRequest, srv GfSpDownloadService_GfSpDownloadObjectStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GfSpDownloadObjectStream not implemented")
+}
 func (*UnimplementedGfSpDownloadServiceServer) GfSpDownloadPiece(ctx context.Context, req *GfSpDownloadPieceRequest) (*GfSpDownloadPieceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GfSpDownloadPiece not implemented")
 }
@@ -734,6 +775,27 @@ func _GfSpDownloadService_GfSpDownloadObject_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GfSpDownloadService_GfSpDownloadObjectStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GfSpDownloadObjectRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GfSpDownloadServiceServer).GfSpDownloadObjectStream(m, &gfSpDownloadServiceGfSpDownloadObjectStreamServer{stream})
+}
+
+type GfSpDownloadService_GfSpDownloadObjectStreamServer interface {
+	Send(*GfSpDownloadObjectResponse) error
+	grpc.ServerStream
+}
+
+type gfSpDownloadServiceGfSpDownloadObjectStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *gfSpDownloadServiceGfSpDownloadObjectStreamServer) Send(m *GfSpDownloadObjectResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _GfSpDownloadService_GfSpDownloadPiece_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GfSpDownloadPieceRequest)
 	if err := dec(in); err != nil {
@@ -831,7 +893,13 @@ var _GfSpDownloadService_serviceDesc = grpc.ServiceDesc{
 			Handler:    _GfSpDownloadService_GfSpDeductQuotaForBucketMigrate_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GfSpDownloadObjectStream",
+			Handler:       _GfSpDownloadService_GfSpDownloadObjectStream_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "base/types/gfspserver/download.proto",
 }
 
@@ -782,6 +782,118 @@ func (m *GfSpPickVirtualGroupFamilyResponse) GetVgfId() uint32 {
 	return 0
 }
 
+type GfSpPickGlobalVirtualGroupRequest struct {
+	UploadObjectTask *gfsptask.GfSpUploadObjectTask `protobuf:"bytes,1,opt,name=upload_object_task,json=uploadObjectTask,proto3" json:"upload_object_task,omitempty"`
+}
+
+func (m *GfSpPickGlobalVirtualGroupRequest) Reset()         { *m = GfSpPickGlobalVirtualGroupRequest{} }
+func (m *GfSpPickGlobalVirtualGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*GfSpPickGlobalVirtualGroupRequest) ProtoMessage()    {}
+func (*GfSpPickGlobalVirtualGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7801aa704e62bc53, []int{8}
+}
+func (m *GfSpPickGlobalVirtualGroupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GfSpPickGlobalVirtualGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GfSpPickGlobalVirtualGroupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GfSpPickGlobalVirtualGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GfSpPickGlobalVirtualGroupRequest.Merge(m, src)
+}
+func (m *GfSpPickGlobalVirtualGroupRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GfSpPickGlobalVirtualGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GfSpPickGlobalVirtualGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GfSpPickGlobalVirtualGroupRequest proto.InternalMessageInfo
+
+func (m *GfSpPickGlobalVirtualGroupRequest) GetUploadObjectTask() *gfsptask.GfSpUploadObjectTask {
+	if m != nil {
+		return m.UploadObjectTask
+	}
+	return nil
+}
+
+type GfSpPickGlobalVirtualGroupResponse struct {
+	Err                  *gfsperrors.GfSpError `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+	GlobalVirtualGroupId uint32                `protobuf:"varint,2,opt,name=global_virtual_group_id,json=globalVirtualGroupId,proto3" json:"global_virtual_group_id,omitempty"`
+	SecondarySpIds       []uint32              `protobuf:"varint,3,rep,packed,name=secondary_sp_ids,json=secondarySpIds,proto3" json:"secondary_sp_ids,omitempty"`
+	SecondaryEndpoints   []string              `protobuf:"bytes,4,rep,name=secondary_endpoints,json=secondaryEndpoints,proto3" json:"secondary_endpoints,omitempty"`
+}
+
+func (m *GfSpPickGlobalVirtualGroupResponse) Reset()         { *m = GfSpPickGlobalVirtualGroupResponse{} }
+func (m *GfSpPickGlobalVirtualGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*GfSpPickGlobalVirtualGroupResponse) ProtoMessage()    {}
+func (*GfSpPickGlobalVirtualGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7801aa704e62bc53, []int{9}
+}
+func (m *GfSpPickGlobalVirtualGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GfSpPickGlobalVirtualGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GfSpPickGlobalVirtualGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GfSpPickGlobalVirtualGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GfSpPickGlobalVirtualGroupResponse.Merge(m, src)
+}
+func (m *GfSpPickGlobalVirtualGroupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GfSpPickGlobalVirtualGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GfSpPickGlobalVirtualGroupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GfSpPickGlobalVirtualGroupResponse proto.InternalMessageInfo
+
+func (m *GfSpPickGlobalVirtualGroupResponse) GetErr() *gfsperrors.GfSpError {
+	if m != nil {
+		return m.Err
+	}
+	return nil
+}
+
+func (m *GfSpPickGlobalVirtualGroupResponse) GetGlobalVirtualGroupId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupId
+	}
+	return 0
+}
+
+func (m *GfSpPickGlobalVirtualGroupResponse) GetSecondarySpIds() []uint32 {
+	if m != nil {
+		return m.SecondarySpIds
+	}
+	return nil
+}
+
+func (m *GfSpPickGlobalVirtualGroupResponse) GetSecondaryEndpoints() []string {
+	if m != nil {
+		return m.SecondaryEndpoints
+	}
+	return nil
+}
+
 type GfSpNotifyMigrateSwapOutRequest struct {
 	SwapOut *types.MsgSwapOut `protobuf:"bytes,1,opt,name=swap_out,json=swapOut,proto3" json:"swap_out,omitempty"`
 }
@@ -790,7 +902,7 @@ func (m *GfSpNotifyMigrateSwapOutRequest) Reset()         { *m = GfSpNotifyMigra
 func (m *GfSpNotifyMigrateSwapOutRequest) String() string { return proto.CompactTextString(m) }
 func (*GfSpNotifyMigrateSwapOutRequest) ProtoMessage()    {}
 func (*GfSpNotifyMigrateSwapOutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{8}
+	return fileDescriptor_7801aa704e62bc53, []int{10}
 }
 func (m *GfSpNotifyMigrateSwapOutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -834,7 +946,7 @@ func (m *GfSpNotifyMigrateSwapOutResponse) Reset()         { *m = GfSpNotifyMigr
 func (m *GfSpNotifyMigrateSwapOutResponse) String() string { return proto.CompactTextString(m) }
 func (*GfSpNotifyMigrateSwapOutResponse) ProtoMessage()    {}
 func (*GfSpNotifyMigrateSwapOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{9}
+	return fileDescriptor_7801aa704e62bc53, []int{11}
 }
 func (m *GfSpNotifyMigrateSwapOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -879,7 +991,7 @@ func (m *GfSpNotifyPreMigrateBucketRequest) Reset()         { *m = GfSpNotifyPre
 func (m *GfSpNotifyPreMigrateBucketRequest) String() string { return proto.CompactTextString(m) }
 func (*GfSpNotifyPreMigrateBucketRequest) ProtoMessage()    {}
 func (*GfSpNotifyPreMigrateBucketRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{10}
+	return fileDescriptor_7801aa704e62bc53, []int{12}
 }
 func (m *GfSpNotifyPreMigrateBucketRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -924,7 +1036,7 @@ func (m *GfSpNotifyPreMigrateBucketResponse) Reset()         { *m = GfSpNotifyPr
 func (m *GfSpNotifyPreMigrateBucketResponse) String() string { return proto.CompactTextString(m) }
 func (*GfSpNotifyPreMigrateBucketResponse) ProtoMessage()    {}
 func (*GfSpNotifyPreMigrateBucketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{11}
+	return fileDescriptor_7801aa704e62bc53, []int{13}
 }
 func (m *GfSpNotifyPreMigrateBucketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -977,7 +1089,7 @@ func (m *GfSpNotifyPostMigrateBucketRequest) Reset()         { *m = GfSpNotifyPo
 func (m *GfSpNotifyPostMigrateBucketRequest) String() string { return proto.CompactTextString(m) }
 func (*GfSpNotifyPostMigrateBucketRequest) ProtoMessage()    {}
 func (*GfSpNotifyPostMigrateBucketRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{12}
+	return fileDescriptor_7801aa704e62bc53, []int{14}
 }
 func (m *GfSpNotifyPostMigrateBucketRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1029,7 +1141,7 @@ func (m *GfSpNotifyPostMigrateBucketResponse) Reset()         { *m = GfSpNotifyP
 func (m *GfSpNotifyPostMigrateBucketResponse) String() string { return proto.CompactTextString(m) }
 func (*GfSpNotifyPostMigrateBucketResponse) ProtoMessage()    {}
 func (*GfSpNotifyPostMigrateBucketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{13}
+	return fileDescriptor_7801aa704e62bc53, []int{15}
 }
 func (m *GfSpNotifyPostMigrateBucketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1079,7 +1191,7 @@ func (m *GfSpQueryTasksStatsRequest) Reset()         { *m = GfSpQueryTasksStatsR
 func (m *GfSpQueryTasksStatsRequest) String() string { return proto.CompactTextString(m) }
 func (*GfSpQueryTasksStatsRequest) ProtoMessage()    {}
 func (*GfSpQueryTasksStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{14}
+	return fileDescriptor_7801aa704e62bc53, []int{16}
 }
 func (m *GfSpQueryTasksStatsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1116,7 +1228,7 @@ func (m *GfSpQueryTasksStatsResponse) Reset()         { *m = GfSpQueryTasksStats
 func (m *GfSpQueryTasksStatsResponse) String() string { return proto.CompactTextString(m) }
 func (*GfSpQueryTasksStatsResponse) ProtoMessage()    {}
 func (*GfSpQueryTasksStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{15}
+	return fileDescriptor_7801aa704e62bc53, []int{17}
 }
 func (m *GfSpQueryTasksStatsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1167,7 +1279,7 @@ func (m *TasksStats) Reset()         { *m = TasksStats{} }
 func (m *TasksStats) String() string { return proto.CompactTextString(m) }
 func (*TasksStats) ProtoMessage()    {}
 func (*TasksStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{16}
+	return fileDescriptor_7801aa704e62bc53, []int{18}
 }
 func (m *TasksStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1262,7 +1374,7 @@ func (m *GfSpQueryBucketMigrationProgressRequest) Reset() {
 func (m *GfSpQueryBucketMigrationProgressRequest) String() string { return proto.CompactTextString(m) }
 func (*GfSpQueryBucketMigrationProgressRequest) ProtoMessage()    {}
 func (*GfSpQueryBucketMigrationProgressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{17}
+	return fileDescriptor_7801aa704e62bc53, []int{19}
 }
 func (m *GfSpQueryBucketMigrationProgressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1308,7 +1420,7 @@ func (m *GfSpQueryBucketMigrationProgressResponse) Reset() {
 func (m *GfSpQueryBucketMigrationProgressResponse) String() string { return proto.CompactTextString(m) }
 func (*GfSpQueryBucketMigrationProgressResponse) ProtoMessage()    {}
 func (*GfSpQueryBucketMigrationProgressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{18}
+	return fileDescriptor_7801aa704e62bc53, []int{20}
 }
 func (m *GfSpQueryBucketMigrationProgressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1362,7 +1474,7 @@ func (m *MigrateBucketProgressMeta) Reset()         { *m = MigrateBucketProgress
 func (m *MigrateBucketProgressMeta) String() string { return proto.CompactTextString(m) }
 func (*MigrateBucketProgressMeta) ProtoMessage()    {}
 func (*MigrateBucketProgressMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{19}
+	return fileDescriptor_7801aa704e62bc53, []int{21}
 }
 func (m *MigrateBucketProgressMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1475,7 +1587,7 @@ func (m *GfSpResetRecoveryFailedListRequest) Reset()         { *m = GfSpResetRec
 func (m *GfSpResetRecoveryFailedListRequest) String() string { return proto.CompactTextString(m) }
 func (*GfSpResetRecoveryFailedListRequest) ProtoMessage()    {}
 func (*GfSpResetRecoveryFailedListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{20}
+	return fileDescriptor_7801aa704e62bc53, []int{22}
 }
 func (m *GfSpResetRecoveryFailedListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1512,7 +1624,7 @@ func (m *GfSpResetRecoveryFailedListResponse) Reset()         { *m = GfSpResetRe
 func (m *GfSpResetRecoveryFailedListResponse) String() string { return proto.CompactTextString(m) }
 func (*GfSpResetRecoveryFailedListResponse) ProtoMessage()    {}
 func (*GfSpResetRecoveryFailedListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{21}
+	return fileDescriptor_7801aa704e62bc53, []int{23}
 }
 func (m *GfSpResetRecoveryFailedListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1560,7 +1672,7 @@ func (m *GfSpTriggerRecoverForSuccessorSPRequest) Reset() {
 func (m *GfSpTriggerRecoverForSuccessorSPRequest) String() string { return proto.CompactTextString(m) }
 func (*GfSpTriggerRecoverForSuccessorSPRequest) ProtoMessage()    {}
 func (*GfSpTriggerRecoverForSuccessorSPRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{22}
+	return fileDescriptor_7801aa704e62bc53, []int{24}
 }
 func (m *GfSpTriggerRecoverForSuccessorSPRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1620,7 +1732,7 @@ func (m *GfSpTriggerRecoverForSuccessorSPResponse) Reset() {
 func (m *GfSpTriggerRecoverForSuccessorSPResponse) String() string { return proto.CompactTextString(m) }
 func (*GfSpTriggerRecoverForSuccessorSPResponse) ProtoMessage()    {}
 func (*GfSpTriggerRecoverForSuccessorSPResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{23}
+	return fileDescriptor_7801aa704e62bc53, []int{25}
 }
 func (m *GfSpTriggerRecoverForSuccessorSPResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1665,7 +1777,7 @@ func (m *GfSpQueryRecoverProcessRequest) Reset()         { *m = GfSpQueryRecover
 func (m *GfSpQueryRecoverProcessRequest) String() string { return proto.CompactTextString(m) }
 func (*GfSpQueryRecoverProcessRequest) ProtoMessage()    {}
 func (*GfSpQueryRecoverProcessRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{24}
+	return fileDescriptor_7801aa704e62bc53, []int{26}
 }
 func (m *GfSpQueryRecoverProcessRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1719,7 +1831,7 @@ func (m *FailedRecoverObject) Reset()         { *m = FailedRecoverObject{} }
 func (m *FailedRecoverObject) String() string { return proto.CompactTextString(m) }
 func (*FailedRecoverObject) ProtoMessage()    {}
 func (*FailedRecoverObject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{25}
+	return fileDescriptor_7801aa704e62bc53, []int{27}
 }
 func (m *FailedRecoverObject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1792,7 +1904,7 @@ func (m *RecoverProcess) Reset()         { *m = RecoverProcess{} }
 func (m *RecoverProcess) String() string { return proto.CompactTextString(m) }
 func (*RecoverProcess) ProtoMessage()    {}
 func (*RecoverProcess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{26}
+	return fileDescriptor_7801aa704e62bc53, []int{28}
 }
 func (m *RecoverProcess) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1894,7 +2006,7 @@ func (m *GfSpQueryRecoverProcessResponse) Reset()         { *m = GfSpQueryRecove
 func (m *GfSpQueryRecoverProcessResponse) String() string { return proto.CompactTextString(m) }
 func (*GfSpQueryRecoverProcessResponse) ProtoMessage()    {}
 func (*GfSpQueryRecoverProcessResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{27}
+	return fileDescriptor_7801aa704e62bc53, []int{29}
 }
 func (m *GfSpQueryRecoverProcessResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1953,6 +2065,8 @@ func init() {
 	proto.RegisterType((*GfSpReportTaskResponse)(nil), "base.types.gfspserver.GfSpReportTaskResponse")
 	proto.RegisterType((*GfSpPickVirtualGroupFamilyRequest)(nil), "base.types.gfspserver.GfSpPickVirtualGroupFamilyRequest")
 	proto.RegisterType((*GfSpPickVirtualGroupFamilyResponse)(nil), "base.types.gfspserver.GfSpPickVirtualGroupFamilyResponse")
+	proto.RegisterType((*GfSpPickGlobalVirtualGroupRequest)(nil), "base.types.gfspserver.GfSpPickGlobalVirtualGroupRequest")
+	proto.RegisterType((*GfSpPickGlobalVirtualGroupResponse)(nil), "base.types.gfspserver.GfSpPickGlobalVirtualGroupResponse")
 	proto.RegisterType((*GfSpNotifyMigrateSwapOutRequest)(nil), "base.types.gfspserver.GfSpNotifyMigrateSwapOutRequest")
 	proto.RegisterType((*GfSpNotifyMigrateSwapOutResponse)(nil), "base.types.gfspserver.GfSpNotifyMigrateSwapOutResponse")
 	proto.RegisterType((*GfSpNotifyPreMigrateBucketRequest)(nil), "base.types.gfspserver.GfSpNotifyPreMigrateBucketRequest")
@@ -1980,141 +2094,147 @@ func init() {
 }
 
 var fileDescriptor_7801aa704e62bc53 = []byte{
-	// 2137 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x5f, 0x6f, 0x1b, 0x59,
-	0x15, 0xcf, 0x6c, 0xec, 0x24, 0x3e, 0x4e, 0xdc, 0xe4, 0xe6, 0x4f, 0x53, 0xb7, 0xa4, 0xe9, 0x84,
-	0xaa, 0x69, 0xb7, 0xb1, 0x77, 0x03, 0xdb, 0xdd, 0xf2, 0x50, 0x48, 0xba, 0xc4, 0x1b, 0xd1, 0x74,
-	0xb3, 0x93, 0x6e, 0x10, 0x2b, 0xb1, 0xb3, 0xe3, 0x99, 0xeb, 0xc9, 0x90, 0xf1, 0xcc, 0xec, 0xbd,
-	0x33, 0x6e, 0xc2, 0x03, 0xe2, 0x19, 0x09, 0x09, 0x09, 0x81, 0xe0, 0x05, 0x09, 0x21, 0x90, 0xf8,
-	0x0c, 0x7c, 0x00, 0x78, 0x42, 0xfb, 0xc8, 0x23, 0x6a, 0x25, 0x5e, 0x10, 0x4f, 0x7c, 0x01, 0x74,
-	0xff, 0x8c, 0x3d, 0x63, 0x7b, 0xc6, 0xf9, 0x43, 0x16, 0xf1, 0x92, 0xda, 0xe7, 0x9e, 0x73, 0xee,
-	0x6f, 0xce, 0x3d, 0xe7, 0xdc, 0xdf, 0x19, 0x17, 0xd4, 0xa6, 0x41, 0x71, 0x3d, 0x3c, 0x0d, 0x30,
-	0xad, 0xdb, 0x2d, 0x1a, 0x50, 0x4c, 0x3a, 0x98, 0xd4, 0xdb, 0x86, 0x67, 0xd8, 0xb8, 0x16, 0x10,
-	0x3f, 0xf4, 0xd1, 0x22, 0xd3, 0xa9, 0x71, 0x9d, 0x5a, 0x4f, 0xa7, 0x7a, 0xa7, 0xcf, 0x14, 0x13,
-	0xe2, 0x13, 0x5a, 0xe7, 0xff, 0x08, 0xcb, 0xea, 0x6a, 0x9f, 0x8a, 0xeb, 0xb4, 0x9d, 0xb0, 0xce,
-	0xff, 0x4a, 0x8d, 0x95, 0x3e, 0x8d, 0xd0, 0xa0, 0xc7, 0x75, 0xf6, 0x27, 0xf6, 0x60, 0x13, 0x8c,
-	0xbd, 0x96, 0x83, 0x5d, 0xab, 0xde, 0x71, 0x48, 0x18, 0x19, 0xae, 0x4d, 0xfc, 0x28, 0xa8, 0x87,
-	0x27, 0x42, 0x43, 0xfd, 0xb7, 0x02, 0x0b, 0x8d, 0xd6, 0x41, 0xb0, 0x8d, 0x6d, 0xc7, 0x7b, 0x61,
-	0xd0, 0x63, 0x0d, 0x7f, 0x1e, 0x61, 0x1a, 0xa2, 0xef, 0x01, 0x8a, 0x02, 0xd7, 0x37, 0x2c, 0xdd,
-	0x6f, 0xfe, 0x00, 0x9b, 0xa1, 0xce, 0xdc, 0x2e, 0x2b, 0xab, 0xca, 0x7a, 0x79, 0xf3, 0x7e, 0xad,
-	0xef, 0x99, 0xf8, 0x96, 0xcc, 0xcd, 0xc7, 0xdc, 0xe4, 0x43, 0x6e, 0xc1, 0xbc, 0x7d, 0x30, 0xa6,
-	0xcd, 0x46, 0x7d, 0x32, 0x14, 0xc1, 0x2d, 0x82, 0x69, 0xd4, 0x36, 0x9a, 0x2e, 0xd6, 0x87, 0x6c,
-	0xf2, 0x06, 0xdf, 0x64, 0x33, 0x73, 0x13, 0x2d, 0x36, 0x1e, 0xb2, 0xdb, 0x0d, 0x92, 0xb5, 0xb8,
-	0x5d, 0x82, 0x49, 0x22, 0x1e, 0x4e, 0xfd, 0x0e, 0x2c, 0xf6, 0x3d, 0x34, 0x0d, 0x7c, 0x8f, 0x62,
-	0xb4, 0x09, 0xe3, 0x98, 0x10, 0xf9, 0x98, 0xab, 0xfd, 0x08, 0xc4, 0x19, 0x71, 0x0c, 0xdf, 0x66,
-	0x1f, 0x35, 0xa6, 0xac, 0xbe, 0x00, 0xc4, 0x24, 0x5b, 0xf4, 0x38, 0x19, 0xbf, 0x27, 0x00, 0x9e,
-	0x6f, 0x61, 0x9d, 0x1f, 0x97, 0x74, 0x78, 0xbb, 0xdf, 0xa1, 0x38, 0x4b, 0x66, 0xfd, 0x8c, 0x7d,
-	0xd2, 0x4a, 0xcc, 0x84, 0x7f, 0x54, 0xff, 0x35, 0x09, 0xf3, 0x29, 0xb7, 0x17, 0x47, 0x88, 0x74,
-	0x58, 0x20, 0x38, 0x70, 0x1d, 0xd3, 0x08, 0xb1, 0x1e, 0x38, 0xd8, 0xc4, 0xc9, 0x40, 0xbf, 0x99,
-	0x13, 0x68, 0x69, 0xb4, 0xcf, 0x6c, 0x64, 0x84, 0x11, 0x19, 0x90, 0xa2, 0x03, 0x98, 0xa5, 0xd8,
-	0x70, 0x53, 0xa7, 0x38, 0xce, 0x9d, 0xdf, 0xcb, 0x74, 0x7e, 0x80, 0x0d, 0x37, 0x75, 0x74, 0x15,
-	0x9a, 0x92, 0xb0, 0x0c, 0x24, 0xd8, 0xc4, 0x4e, 0x27, 0x85, 0xb9, 0x30, 0x22, 0x03, 0x35, 0x61,
-	0x92, 0x44, 0x3c, 0x4b, 0xfa, 0x64, 0x68, 0x0f, 0x2a, 0xb6, 0x99, 0x42, 0x5b, 0xe4, 0x6e, 0xef,
-	0x66, 0xba, 0x6d, 0x3c, 0x4d, 0x61, 0x9d, 0xb6, 0xcd, 0x04, 0xd2, 0xef, 0xc3, 0x82, 0x6d, 0xea,
-	0x3f, 0xf4, 0xdb, 0x4d, 0x27, 0x85, 0x75, 0x82, 0x3b, 0x7d, 0x90, 0xe3, 0xf4, 0x13, 0x6e, 0x93,
-	0x04, 0x3b, 0x67, 0x9b, 0x7d, 0x42, 0xd4, 0x80, 0x69, 0xdb, 0xd4, 0xdb, 0x38, 0x34, 0x84, 0xdb,
-	0x49, 0xee, 0x76, 0x2d, 0xc7, 0xed, 0x1e, 0x0e, 0x0d, 0xe9, 0x0f, 0x6c, 0x33, 0xfe, 0x26, 0x23,
-	0xea, 0x77, 0x30, 0x49, 0xa2, 0x9c, 0x1a, 0x1d, 0x51, 0x66, 0xd2, 0x1f, 0xd1, 0x94, 0x8c, 0x65,
-	0x40, 0xdb, 0xb1, 0x09, 0x4b, 0x30, 0xbb, 0x63, 0x0b, 0xc7, 0xa5, 0x11, 0x19, 0xb0, 0x27, 0x0c,
-	0x1a, 0x87, 0x8d, 0x38, 0x03, 0xa4, 0x8b, 0x46, 0xc7, 0xe6, 0x4e, 0x1d, 0x58, 0xb6, 0x4d, 0xbd,
-	0x19, 0x99, 0xc7, 0x38, 0xd4, 0xc5, 0x9a, 0xe3, 0x7b, 0xc2, 0x39, 0x70, 0xe7, 0xb5, 0x9c, 0x20,
-	0x6c, 0x73, 0xbb, 0xbd, 0xd8, 0x4c, 0xee, 0xb1, 0x68, 0x9b, 0x43, 0x16, 0x10, 0x85, 0x5b, 0xb6,
-	0xa9, 0xd3, 0xd0, 0x70, 0xb1, 0xde, 0xc1, 0x84, 0xb2, 0x7d, 0x92, 0xf9, 0x51, 0xe6, 0xdb, 0xbd,
-	0x9d, 0xb3, 0xdd, 0x01, 0xb3, 0x3d, 0x14, 0xa6, 0xa9, 0x5c, 0x59, 0xb6, 0xcd, 0xe1, 0x6b, 0xdb,
-	0x00, 0x53, 0x44, 0xd6, 0xb5, 0xfa, 0x57, 0x10, 0x3d, 0x49, 0xc3, 0x81, 0x4f, 0xc2, 0x2f, 0xa9,
-	0x13, 0xff, 0x7f, 0x36, 0x86, 0xc1, 0xea, 0x2d, 0x5c, 0x45, 0xf5, 0x16, 0xaf, 0xa6, 0x7a, 0x27,
-	0x2e, 0x5a, 0xbd, 0x3a, 0x2c, 0x58, 0xfe, 0x4b, 0x6f, 0x20, 0x13, 0x26, 0x47, 0x1c, 0xd6, 0xfb,
-	0xd2, 0x28, 0x15, 0x02, 0x64, 0x0d, 0x48, 0xd9, 0x06, 0xe6, 0x91, 0xe1, 0xba, 0xd8, 0xb3, 0xf1,
-	0x60, 0x83, 0xc8, 0xde, 0xe0, 0x69, 0x6c, 0x94, 0xca, 0x06, 0x73, 0x40, 0x9a, 0xd1, 0xd1, 0x4b,
-	0xff, 0x8d, 0x8e, 0x3e, 0x8a, 0x53, 0xc0, 0x95, 0x70, 0x8a, 0x8c, 0x8e, 0x5a, 0xbe, 0xaa, 0x8e,
-	0x3a, 0x7d, 0x95, 0x1d, 0x75, 0xe6, 0xcb, 0xed, 0xa8, 0x95, 0xab, 0xe8, 0xa8, 0x09, 0x8e, 0xf7,
-	0x0c, 0x96, 0xfa, 0xfb, 0xe9, 0x25, 0x48, 0xde, 0x2f, 0x14, 0xb8, 0xc3, 0x44, 0xfb, 0x8e, 0x79,
-	0x7c, 0x28, 0x98, 0x74, 0x83, 0x31, 0xe9, 0x1d, 0xa3, 0xed, 0xb8, 0xa7, 0x71, 0xab, 0x0e, 0xe0,
-	0xa6, 0x49, 0x30, 0x3b, 0x32, 0x19, 0x62, 0x23, 0x08, 0x88, 0xdf, 0x31, 0xdc, 0x64, 0xcf, 0xce,
-	0x7e, 0xe4, 0xa7, 0xdc, 0x56, 0x04, 0x73, 0x4b, 0x5a, 0x72, 0xe4, 0xcb, 0x66, 0xc6, 0x8a, 0xea,
-	0x83, 0x9a, 0x07, 0xeb, 0x12, 0xa4, 0x71, 0x11, 0x26, 0x3a, 0x76, 0x4b, 0x77, 0x2c, 0x7e, 0x1b,
-	0xcc, 0x68, 0xc5, 0x8e, 0xdd, 0xda, 0xb5, 0x54, 0x03, 0x6e, 0x33, 0xc5, 0xe7, 0x7e, 0xe8, 0xb4,
-	0x4e, 0x65, 0xbe, 0x1d, 0xbc, 0x34, 0x82, 0x0f, 0xa3, 0xb0, 0x47, 0x7d, 0xa7, 0xe8, 0x4b, 0x23,
-	0xd0, 0xfd, 0x28, 0x26, 0xbe, 0x6b, 0xb5, 0xde, 0x20, 0x52, 0x4b, 0x0e, 0x22, 0xb5, 0x3d, 0x6a,
-	0xc7, 0xd6, 0x93, 0x54, 0x7c, 0x50, 0x0f, 0x61, 0x35, 0x7b, 0x8b, 0x4b, 0x9c, 0xe1, 0xb7, 0xc4,
-	0x11, 0x0a, 0xbf, 0xfb, 0x04, 0x4b, 0xd7, 0x22, 0xaa, 0x31, 0xf8, 0x9b, 0x50, 0x92, 0x67, 0xe7,
-	0x58, 0xdc, 0x7d, 0x41, 0x9b, 0x12, 0x82, 0x5d, 0x4b, 0xfd, 0x95, 0x22, 0xc2, 0x9d, 0xe5, 0xe2,
-	0x12, 0xe1, 0x7e, 0x02, 0xc5, 0xcf, 0x23, 0x3f, 0x34, 0xe4, 0xdd, 0xbb, 0x9e, 0x99, 0x24, 0x62,
-	0xaf, 0x8f, 0x98, 0xee, 0xae, 0xd7, 0xf2, 0x35, 0x61, 0xa6, 0xfe, 0x2e, 0x0d, 0xcd, 0xa7, 0xe1,
-	0xb9, 0x1f, 0x0f, 0x7d, 0x06, 0x8b, 0x03, 0xad, 0xc1, 0xf1, 0x5a, 0xbe, 0xc4, 0xf4, 0x70, 0x04,
-	0xa6, 0x6e, 0xfd, 0x73, 0x5c, 0xf3, 0xcd, 0x41, 0xa1, 0xfa, 0x6b, 0x05, 0xd6, 0x72, 0x51, 0xfe,
-	0x0f, 0x23, 0x78, 0x0b, 0xaa, 0x6c, 0xf5, 0xa3, 0x08, 0x93, 0x53, 0x56, 0x5b, 0xf4, 0x20, 0x34,
-	0x42, 0x2a, 0x03, 0xa7, 0x1e, 0xc2, 0xcd, 0xa1, 0xab, 0x12, 0xf0, 0xbb, 0x50, 0xa4, 0x4c, 0x20,
-	0x21, 0xdf, 0xa9, 0x0d, 0x9d, 0xfa, 0x6b, 0x09, 0x4b, 0xa1, 0xaf, 0xfe, 0xe3, 0x0d, 0x80, 0x9e,
-	0x14, 0xdd, 0x81, 0x69, 0x79, 0x7b, 0x99, 0x7e, 0xe4, 0x89, 0xfa, 0x99, 0xd1, 0xca, 0x42, 0xf6,
-	0x94, 0x89, 0xd0, 0x3d, 0xb8, 0xd6, 0x23, 0x6d, 0x42, 0x4b, 0x54, 0x68, 0xa5, 0x2b, 0x16, 0x8a,
-	0x5f, 0x01, 0xe0, 0xe4, 0x4b, 0xe8, 0x8c, 0x73, 0x9d, 0x12, 0x93, 0x88, 0xe5, 0xaf, 0xc3, 0xd2,
-	0xc0, 0x95, 0x29, 0x54, 0x0b, 0x5c, 0x75, 0xa1, 0xef, 0xda, 0x13, 0x56, 0x6b, 0x30, 0xd3, 0x36,
-	0x4e, 0xa4, 0xbe, 0xe3, 0xd9, 0x9c, 0x26, 0xcd, 0x68, 0xd3, 0x6d, 0xe3, 0xe4, 0xe3, 0x58, 0x86,
-	0x1e, 0xc0, 0x5c, 0xf2, 0xee, 0x12, 0x5e, 0x27, 0xb8, 0xe2, 0xb5, 0xde, 0x8d, 0x94, 0x80, 0xc1,
-	0xef, 0xbe, 0x53, 0x3d, 0x20, 0xbe, 0x89, 0x29, 0x95, 0x06, 0x93, 0x31, 0x0c, 0xb1, 0xba, 0x2f,
-	0x16, 0x85, 0xd5, 0x5b, 0xd0, 0x95, 0xeb, 0x2d, 0xc3, 0x71, 0xb1, 0xa5, 0xbb, 0x0e, 0x0d, 0x97,
-	0xa7, 0x56, 0xc7, 0xd7, 0x4b, 0x5a, 0x7c, 0x29, 0x9f, 0xee, 0xf0, 0xa5, 0x67, 0x0e, 0x0d, 0xd5,
-	0x1d, 0xb8, 0xd7, 0x3d, 0xc0, 0xbe, 0x7c, 0xdd, 0x27, 0xbe, 0x4d, 0x30, 0xa5, 0x67, 0xea, 0x01,
-	0x27, 0xb0, 0x3e, 0xda, 0x8f, 0xcc, 0x8a, 0x67, 0x30, 0x15, 0x48, 0x99, 0x4c, 0x8c, 0xb7, 0x32,
-	0x12, 0x23, 0x55, 0x06, 0xb1, 0x1f, 0x46, 0xfd, 0xb4, 0xae, 0x07, 0xf5, 0x9f, 0xe3, 0x70, 0x23,
-	0x53, 0x2f, 0xbf, 0xb2, 0x1f, 0xc1, 0x75, 0x1a, 0x35, 0xa9, 0x49, 0x9c, 0x26, 0xb6, 0xf4, 0xa6,
-	0xeb, 0x9b, 0xc7, 0xfa, 0x11, 0x76, 0xec, 0x23, 0x91, 0x3b, 0x05, 0x6d, 0xb1, 0xb7, 0xbc, 0xcd,
-	0x56, 0x3f, 0xe0, 0x8b, 0xfc, 0xb4, 0xe5, 0x41, 0xb2, 0x74, 0xc5, 0x32, 0x8b, 0xa6, 0xa5, 0x90,
-	0xe5, 0x2c, 0x46, 0x2a, 0xcc, 0x84, 0x7e, 0x68, 0xb8, 0xfc, 0xac, 0xbd, 0xa8, 0x2d, 0xf3, 0xa7,
-	0xcc, 0x85, 0x8d, 0x8e, 0xfd, 0x3c, 0x6a, 0xa3, 0xc7, 0x70, 0x43, 0xda, 0x58, 0x7a, 0xcb, 0xf1,
-	0x1c, 0x7a, 0x84, 0xad, 0xae, 0xbe, 0x48, 0xa1, 0xa5, 0x58, 0x61, 0x47, 0xae, 0x4b, 0xd3, 0x0d,
-	0x98, 0xb7, 0xcd, 0x41, 0x23, 0x91, 0x4e, 0xb3, 0xb6, 0xd9, 0xa7, 0xfe, 0x10, 0x50, 0x40, 0xb0,
-	0x6e, 0x61, 0x2b, 0x32, 0xd9, 0x6e, 0xa2, 0x27, 0x4c, 0xf2, 0xa7, 0x9c, 0x0d, 0x08, 0x7e, 0x5f,
-	0x2e, 0xf0, 0xfa, 0x67, 0xf5, 0xc6, 0x72, 0x25, 0x0a, 0xa4, 0xde, 0x14, 0xd7, 0x2b, 0x0b, 0x99,
-	0x50, 0xb9, 0x0f, 0x73, 0xae, 0x41, 0x43, 0xbd, 0x37, 0x73, 0x38, 0x16, 0x27, 0xad, 0x05, 0xad,
-	0xc2, 0x16, 0x1a, 0x72, 0x98, 0xd8, 0xb5, 0xd0, 0x1a, 0x54, 0x62, 0x55, 0x06, 0xd3, 0xb1, 0x38,
-	0xef, 0x2c, 0x68, 0x65, 0xa1, 0xd7, 0xe8, 0xd8, 0xbb, 0x16, 0xba, 0x0b, 0x95, 0x6e, 0x28, 0x9a,
-	0xa7, 0x21, 0xa6, 0x9c, 0x2f, 0x16, 0xb4, 0x38, 0xd2, 0xd6, 0x36, 0x13, 0xaa, 0x5f, 0x15, 0xfd,
-	0x5c, 0xc3, 0x94, 0xf5, 0xc5, 0xfe, 0x74, 0x8e, 0xdb, 0xd2, 0x77, 0x45, 0x3f, 0xcd, 0xd4, 0x92,
-	0x89, 0x98, 0x55, 0x2e, 0x4a, 0x66, 0xb9, 0xfc, 0x58, 0x11, 0xf5, 0xf2, 0x82, 0x38, 0xb6, 0x8d,
-	0x89, 0xf4, 0xbd, 0xe3, 0x93, 0x83, 0xc8, 0x64, 0x65, 0xe8, 0x93, 0x83, 0xfd, 0xb8, 0x5e, 0x7a,
-	0x54, 0x41, 0x49, 0x50, 0x05, 0x26, 0x96, 0x51, 0x90, 0x0c, 0xc2, 0xe6, 0xcf, 0x9f, 0xea, 0x5f,
-	0x8e, 0x67, 0xe1, 0x13, 0x9e, 0x55, 0xc5, 0x44, 0xff, 0xda, 0x65, 0x52, 0xf5, 0x53, 0x51, 0x69,
-	0xf9, 0x08, 0x2e, 0xc1, 0x07, 0x9e, 0xc3, 0x4a, 0xb7, 0x92, 0x63, 0x4a, 0x2e, 0x7a, 0xcc, 0x85,
-	0x1e, 0x4c, 0xfd, 0x83, 0x02, 0xf3, 0x22, 0x82, 0xd2, 0x9b, 0xc8, 0x0b, 0x56, 0x99, 0xbd, 0xc4,
-	0x91, 0x95, 0xe9, 0xc7, 0x29, 0xb3, 0x0e, 0xb3, 0x92, 0x10, 0xe9, 0x9c, 0x11, 0xf5, 0xbc, 0x56,
-	0x3a, 0x09, 0x42, 0xb7, 0x6b, 0xa1, 0xfb, 0x30, 0x4b, 0xb0, 0x15, 0x79, 0x96, 0xe1, 0x99, 0xa7,
-	0xa9, 0xc0, 0x5d, 0xeb, 0xc9, 0x79, 0xe4, 0x58, 0xe7, 0x27, 0x38, 0x24, 0xa7, 0x7a, 0xe8, 0xb4,
-	0x31, 0x2f, 0xc7, 0xa2, 0x56, 0xe2, 0x92, 0x17, 0x4e, 0x1b, 0xab, 0x7f, 0x1c, 0x87, 0x4a, 0xfa,
-	0x81, 0x87, 0xc2, 0x50, 0x86, 0xc2, 0x78, 0x07, 0xae, 0xa7, 0x35, 0x5b, 0x9c, 0x6b, 0xf6, 0x70,
-	0x2f, 0x74, 0x06, 0x88, 0xe8, 0xf9, 0xd0, 0xdf, 0x86, 0x32, 0x0d, 0x0d, 0x12, 0xea, 0x46, 0x2b,
-	0xc4, 0x84, 0xc3, 0x2f, 0x68, 0xc0, 0x45, 0x5b, 0x4c, 0x82, 0x16, 0xa0, 0x28, 0x5e, 0xab, 0x16,
-	0xf9, 0x92, 0xf8, 0x82, 0x96, 0x60, 0x82, 0xf5, 0xa8, 0x88, 0xf2, 0xd6, 0x50, 0xd4, 0xe4, 0x37,
-	0x56, 0xe2, 0x32, 0xfc, 0xbd, 0x6b, 0xa5, 0xa0, 0x95, 0x85, 0x4c, 0xdc, 0x26, 0x8f, 0xe1, 0x86,
-	0xac, 0x8a, 0x78, 0x42, 0xe1, 0xfd, 0x4c, 0xe8, 0x8b, 0x96, 0xb0, 0x24, 0x14, 0xe4, 0xac, 0xc1,
-	0x96, 0x85, 0xe9, 0xa7, 0xb0, 0x18, 0x4f, 0x80, 0x29, 0x17, 0xcb, 0xa5, 0xd5, 0xf1, 0x61, 0xaf,
-	0x0f, 0x64, 0xbf, 0x1f, 0x92, 0x27, 0xda, 0xbc, 0x74, 0xb4, 0x93, 0xd8, 0x49, 0xfd, 0xb3, 0x22,
-	0x08, 0xf7, 0xd0, 0x2c, 0xbd, 0x04, 0x5b, 0xd2, 0x60, 0xae, 0x3b, 0xb9, 0x0a, 0x77, 0x98, 0x2e,
-	0xbf, 0xc1, 0x31, 0xdf, 0xcd, 0xc0, 0xdc, 0xb7, 0x7b, 0x77, 0x64, 0x8d, 0xcd, 0xd1, 0x2d, 0x28,
-	0xe1, 0x13, 0x6c, 0x46, 0x21, 0xe3, 0x05, 0xec, 0x70, 0xa7, 0xb4, 0x9e, 0x60, 0xf3, 0x4f, 0x33,
-	0x30, 0xc7, 0x87, 0x54, 0xfe, 0xeb, 0xc8, 0x01, 0x26, 0x1d, 0xc7, 0xc4, 0xc8, 0x85, 0x99, 0xd4,
-	0xab, 0x78, 0xf4, 0x66, 0xc6, 0xee, 0xc3, 0x7e, 0xa5, 0xa8, 0x3e, 0x3c, 0x9b, 0xb2, 0x7c, 0xc7,
-	0x36, 0x86, 0x5a, 0x50, 0x4e, 0xbc, 0x54, 0x47, 0xf7, 0x73, 0xcc, 0xd3, 0xef, 0xf3, 0xab, 0x0f,
-	0xce, 0xa2, 0xda, 0xdd, 0xc7, 0x87, 0x4a, 0x7a, 0xf8, 0x44, 0x79, 0x48, 0x07, 0xde, 0xf9, 0x55,
-	0x37, 0xce, 0xa8, 0xdd, 0xdd, 0xf0, 0xe7, 0x8a, 0x60, 0xaf, 0xc3, 0x07, 0x41, 0xf4, 0x5e, 0x8e,
-	0xbf, 0xdc, 0x91, 0xb6, 0xfa, 0xf8, 0x02, 0x96, 0x5d, 0x54, 0x3f, 0x55, 0x60, 0x39, 0x6b, 0x94,
-	0x43, 0x8f, 0x72, 0x3c, 0xe7, 0x8c, 0x97, 0xd5, 0x77, 0xcf, 0x6d, 0xd7, 0xc5, 0xf3, 0x23, 0xf1,
-	0x9b, 0x4a, 0x1f, 0x89, 0x47, 0x6f, 0xe7, 0x78, 0x1c, 0x3e, 0x0e, 0x54, 0x37, 0xcf, 0x63, 0xd2,
-	0xdd, 0xff, 0xf7, 0x8a, 0x18, 0x6d, 0xf3, 0xc8, 0x23, 0x7a, 0x32, 0xca, 0x75, 0x3e, 0x7b, 0xad,
-	0x7e, 0xf3, 0xc2, 0xf6, 0x5d, 0x9c, 0xbf, 0x55, 0xc4, 0xd5, 0x3b, 0x7c, 0xce, 0xdd, 0xf2, 0x2c,
-	0x41, 0xa2, 0x04, 0x3f, 0x7a, 0x6f, 0xe4, 0x79, 0x64, 0xcc, 0xda, 0xb9, 0xb9, 0x95, 0x3f, 0x62,
-	0xab, 0x63, 0xe8, 0x37, 0x59, 0xa3, 0xe4, 0x96, 0xc7, 0xfb, 0x6a, 0x4c, 0xdf, 0xce, 0xb0, 0x49,
-	0xc6, 0xb0, 0x5c, 0xfd, 0xc6, 0x45, 0x4c, 0xbb, 0x00, 0x7f, 0xa9, 0x88, 0x91, 0x31, 0x83, 0x9b,
-	0xe5, 0x02, 0xcb, 0x67, 0x7d, 0xb9, 0xc0, 0x46, 0x50, 0xc1, 0x44, 0x16, 0xe6, 0x11, 0xab, 0xdc,
-	0x2c, 0x3c, 0x03, 0x27, 0xcc, 0xcd, 0xc2, 0xb3, 0x30, 0x3a, 0x75, 0x0c, 0xfd, 0x44, 0x81, 0xeb,
-	0x19, 0x57, 0x1f, 0x7a, 0x67, 0x54, 0x92, 0x0f, 0x25, 0x74, 0xd5, 0x47, 0xe7, 0x35, 0x8b, 0xc1,
-	0x6c, 0x7f, 0xf6, 0x97, 0x57, 0x2b, 0xca, 0x17, 0xaf, 0x56, 0x94, 0xbf, 0xbf, 0x5a, 0x51, 0x7e,
-	0xf6, 0x7a, 0x65, 0xec, 0x8b, 0xd7, 0x2b, 0x63, 0x7f, 0x7b, 0xbd, 0x32, 0xf6, 0xc9, 0x8e, 0xed,
-	0x84, 0x47, 0x51, 0xb3, 0x66, 0xfa, 0xed, 0x7a, 0xd3, 0x6b, 0x6e, 0x98, 0x47, 0x86, 0xe3, 0xd5,
-	0x7b, 0x2f, 0xbc, 0x36, 0x68, 0xe8, 0x13, 0xc3, 0xc6, 0x1b, 0x01, 0xf1, 0x3b, 0x8e, 0x85, 0x49,
-	0x7d, 0xe8, 0xff, 0x1a, 0x68, 0x4e, 0xf0, 0x5f, 0xe4, 0xbf, 0xf6, 0x9f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xe1, 0xb9, 0x29, 0xdc, 0x55, 0x20, 0x00, 0x00,
+	// 2235 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xdf, 0x6f, 0xdb, 0xd6,
+	0xf5, 0x0f, 0x23, 0xc9, 0xb6, 0x8e, 0x6c, 0xc5, 0xa1, 0xed, 0x44, 0x51, 0xf2, 0x75, 0x1c, 0xfa,
+	0x5b, 0xc4, 0x49, 0x13, 0xa9, 0xcd, 0x96, 0xb4, 0xe9, 0x43, 0xba, 0x38, 0xad, 0x55, 0x63, 0x71,
+	0xea, 0x52, 0xa9, 0x8b, 0x15, 0x58, 0x59, 0x8a, 0xbc, 0xa2, 0x39, 0x53, 0x24, 0x7b, 0x2f, 0xa9,
+	0xd8, 0xc3, 0x30, 0xec, 0x79, 0xc0, 0x80, 0x01, 0xc3, 0x86, 0xed, 0x65, 0xc0, 0x30, 0x6c, 0xc0,
+	0xfe, 0x92, 0xed, 0x69, 0xe8, 0xde, 0xf6, 0x38, 0x24, 0xc0, 0x1e, 0x36, 0xec, 0x69, 0xff, 0xc0,
+	0x70, 0x7f, 0x90, 0x22, 0x25, 0x91, 0xb2, 0xe5, 0xba, 0xc3, 0x5e, 0x12, 0xe9, 0xfc, 0xfc, 0xf0,
+	0xdc, 0x73, 0x0e, 0xcf, 0xb9, 0x32, 0x28, 0x1d, 0x9d, 0xa0, 0x66, 0x70, 0xe4, 0x23, 0xd2, 0xb4,
+	0xba, 0xc4, 0x27, 0x08, 0xf7, 0x11, 0x6e, 0xf6, 0x74, 0x57, 0xb7, 0x50, 0xc3, 0xc7, 0x5e, 0xe0,
+	0xc9, 0x2b, 0x54, 0xa6, 0xc1, 0x64, 0x1a, 0x03, 0x99, 0xfa, 0x8d, 0x21, 0x55, 0x84, 0xb1, 0x87,
+	0x49, 0x93, 0xfd, 0xc7, 0x35, 0xeb, 0x6b, 0x43, 0x22, 0x8e, 0xdd, 0xb3, 0x83, 0x26, 0xfb, 0x57,
+	0x48, 0xac, 0x0e, 0x49, 0x04, 0x3a, 0x39, 0x68, 0xd2, 0x7f, 0x22, 0x0b, 0x16, 0x46, 0xc8, 0xed,
+	0xda, 0xc8, 0x31, 0x9b, 0x7d, 0x1b, 0x07, 0xa1, 0xee, 0x58, 0xd8, 0x0b, 0xfd, 0x66, 0x70, 0xc8,
+	0x25, 0x94, 0x7f, 0x4b, 0xb0, 0xdc, 0xea, 0xb6, 0xfd, 0x4d, 0x64, 0xd9, 0xee, 0x73, 0x9d, 0x1c,
+	0xa8, 0xe8, 0x8b, 0x10, 0x91, 0x40, 0xfe, 0x0e, 0xc8, 0xa1, 0xef, 0x78, 0xba, 0xa9, 0x79, 0x9d,
+	0xef, 0x21, 0x23, 0xd0, 0xa8, 0xd9, 0x9a, 0xb4, 0x26, 0x6d, 0x54, 0xee, 0xdd, 0x6a, 0x0c, 0x3d,
+	0x13, 0x73, 0x49, 0xcd, 0x7c, 0xcc, 0x54, 0x3e, 0x64, 0x1a, 0xd4, 0xda, 0x07, 0xe7, 0xd4, 0xc5,
+	0x70, 0x88, 0x26, 0x87, 0x70, 0x0d, 0x23, 0x12, 0xf6, 0xf4, 0x8e, 0x83, 0xb4, 0x31, 0x4e, 0xce,
+	0x33, 0x27, 0xf7, 0x32, 0x9d, 0xa8, 0x91, 0xf2, 0x18, 0x6f, 0x57, 0x70, 0x16, 0x73, 0xb3, 0x0c,
+	0xb3, 0x98, 0x3f, 0x9c, 0xf2, 0x6d, 0x58, 0x19, 0x7a, 0x68, 0xe2, 0x7b, 0x2e, 0x41, 0xf2, 0x3d,
+	0x28, 0x20, 0x8c, 0xc5, 0x63, 0xae, 0x0d, 0x23, 0xe0, 0x67, 0xc4, 0x30, 0xbc, 0x4f, 0x3f, 0xaa,
+	0x54, 0x58, 0x79, 0x0e, 0x32, 0xa5, 0x3c, 0x26, 0x07, 0xc9, 0xf8, 0x3d, 0x02, 0x70, 0x3d, 0x13,
+	0x69, 0xec, 0xb8, 0x84, 0xc1, 0xeb, 0xc3, 0x06, 0xf9, 0x59, 0x52, 0xed, 0xa7, 0xf4, 0x93, 0x5a,
+	0xa6, 0x2a, 0xec, 0xa3, 0xf2, 0xaf, 0x59, 0x58, 0x4a, 0x99, 0x9d, 0x1e, 0xa1, 0xac, 0xc1, 0x32,
+	0x46, 0xbe, 0x63, 0x1b, 0x7a, 0x80, 0x34, 0xdf, 0x46, 0x06, 0x4a, 0x06, 0xfa, 0xf5, 0x9c, 0x40,
+	0x0b, 0xa5, 0x5d, 0xaa, 0x23, 0x22, 0x2c, 0xe3, 0x11, 0xaa, 0xdc, 0x86, 0x45, 0x82, 0x74, 0x27,
+	0x75, 0x8a, 0x05, 0x66, 0xfc, 0x66, 0xa6, 0xf1, 0x36, 0xd2, 0x9d, 0xd4, 0xd1, 0x55, 0x49, 0x8a,
+	0x42, 0x33, 0x10, 0x23, 0x03, 0xd9, 0xfd, 0x14, 0xe6, 0xe2, 0x84, 0x0c, 0x54, 0xb9, 0x4a, 0x12,
+	0xf1, 0x22, 0x1e, 0xa2, 0xc9, 0x3b, 0x50, 0xb5, 0x8c, 0x14, 0xda, 0x12, 0x33, 0xfb, 0x5a, 0xa6,
+	0xd9, 0xd6, 0x93, 0x14, 0xd6, 0x79, 0xcb, 0x48, 0x20, 0xfd, 0x2e, 0x2c, 0x5b, 0x86, 0xf6, 0x7d,
+	0xaf, 0xd7, 0xb1, 0x53, 0x58, 0x67, 0x98, 0xd1, 0xdb, 0x39, 0x46, 0x3f, 0x65, 0x3a, 0x49, 0xb0,
+	0x17, 0x2d, 0x63, 0x88, 0x28, 0xb7, 0x60, 0xde, 0x32, 0xb4, 0x1e, 0x0a, 0x74, 0x6e, 0x76, 0x96,
+	0x99, 0x5d, 0xcf, 0x31, 0xbb, 0x83, 0x02, 0x5d, 0xd8, 0x03, 0xcb, 0x88, 0xbe, 0x89, 0x88, 0x7a,
+	0x7d, 0x84, 0x93, 0x28, 0xe7, 0x26, 0x47, 0x94, 0xaa, 0x0c, 0x47, 0x34, 0x45, 0xa3, 0x19, 0xd0,
+	0xb3, 0x2d, 0x4c, 0x13, 0xcc, 0xea, 0x5b, 0xdc, 0x70, 0x79, 0x42, 0x06, 0xec, 0x70, 0x85, 0xd6,
+	0x5e, 0x2b, 0xca, 0x00, 0x61, 0xa2, 0xd5, 0xb7, 0x98, 0x51, 0x1b, 0x6a, 0x96, 0xa1, 0x75, 0x42,
+	0xe3, 0x00, 0x05, 0x1a, 0xe7, 0xd9, 0x9e, 0xcb, 0x8d, 0x03, 0x33, 0xde, 0xc8, 0x09, 0xc2, 0x26,
+	0xd3, 0xdb, 0x89, 0xd4, 0x84, 0x8f, 0x15, 0xcb, 0x18, 0xc3, 0x90, 0x09, 0x5c, 0xb3, 0x0c, 0x8d,
+	0x04, 0xba, 0x83, 0xb4, 0x3e, 0xc2, 0x84, 0xfa, 0x49, 0xe6, 0x47, 0x85, 0xb9, 0x7b, 0x33, 0xc7,
+	0x5d, 0x9b, 0xea, 0xee, 0x71, 0xd5, 0x54, 0xae, 0xd4, 0x2c, 0x63, 0x3c, 0x6f, 0x13, 0x60, 0x0e,
+	0x8b, 0xba, 0x56, 0xfe, 0x0c, 0xbc, 0x27, 0xa9, 0xc8, 0xf7, 0x70, 0xf0, 0x35, 0x75, 0xe2, 0xff,
+	0xcd, 0xc6, 0x30, 0x5a, 0xbd, 0xc5, 0xb3, 0xa8, 0xde, 0xd2, 0xd9, 0x54, 0xef, 0xcc, 0xb4, 0xd5,
+	0xab, 0xc1, 0xb2, 0xe9, 0xbd, 0x70, 0x47, 0x32, 0x61, 0x76, 0xc2, 0x61, 0xbd, 0x27, 0x94, 0x52,
+	0x21, 0x90, 0xcd, 0x11, 0x2a, 0x75, 0x60, 0xec, 0xeb, 0x8e, 0x83, 0x5c, 0x0b, 0x8d, 0x36, 0x88,
+	0x6c, 0x07, 0x4f, 0x22, 0xa5, 0x54, 0x36, 0x18, 0x23, 0xd4, 0x8c, 0x8e, 0x5e, 0xfe, 0x2a, 0x3a,
+	0xfa, 0xa4, 0x99, 0x02, 0xce, 0x64, 0xa6, 0xc8, 0xe8, 0xa8, 0x95, 0xb3, 0xea, 0xa8, 0xf3, 0x67,
+	0xd9, 0x51, 0x17, 0xbe, 0xde, 0x8e, 0x5a, 0x3d, 0x8b, 0x8e, 0x9a, 0x98, 0xf1, 0x9e, 0xc2, 0xa5,
+	0xe1, 0x7e, 0x7a, 0x8a, 0x21, 0xef, 0xe7, 0x12, 0xdc, 0xa0, 0xa4, 0x5d, 0xdb, 0x38, 0xd8, 0xe3,
+	0x93, 0x74, 0x8b, 0x4e, 0xd2, 0x5b, 0x7a, 0xcf, 0x76, 0x8e, 0xa2, 0x56, 0xed, 0xc3, 0x55, 0x03,
+	0x23, 0x7a, 0x64, 0x22, 0xc4, 0xba, 0xef, 0x63, 0xaf, 0xaf, 0x3b, 0xc9, 0x9e, 0x9d, 0xfd, 0xc8,
+	0x4f, 0x98, 0x2e, 0x0f, 0xe6, 0x63, 0xa1, 0xc9, 0x90, 0xd7, 0x8c, 0x0c, 0x8e, 0xe2, 0x81, 0x92,
+	0x07, 0xeb, 0x14, 0x43, 0xe3, 0x0a, 0xcc, 0xf4, 0xad, 0xae, 0x66, 0x9b, 0xec, 0x6d, 0xb0, 0xa0,
+	0x96, 0xfa, 0x56, 0x77, 0xdb, 0x54, 0x7e, 0x30, 0x88, 0x43, 0xcb, 0xf1, 0x3a, 0xba, 0x93, 0x74,
+	0x1b, 0xc5, 0xe1, 0x93, 0xaf, 0xe4, 0x95, 0x35, 0xfa, 0xc2, 0x52, 0xfe, 0x21, 0x81, 0x92, 0xe7,
+	0xfe, 0x14, 0xcf, 0x7b, 0x1f, 0x2e, 0x5b, 0xcc, 0xa2, 0x26, 0x36, 0x25, 0x8d, 0xad, 0x4a, 0x83,
+	0x00, 0x2c, 0x5b, 0x23, 0x0e, 0xb7, 0x4d, 0x79, 0x83, 0xbe, 0xe1, 0x0c, 0xcf, 0x35, 0x75, 0x7c,
+	0xa4, 0x11, 0x2a, 0x4e, 0x6a, 0x85, 0xb5, 0xc2, 0xc6, 0x82, 0x5a, 0x8d, 0xe9, 0x6d, 0x7f, 0xdb,
+	0x24, 0x72, 0x13, 0x96, 0x06, 0x92, 0xc8, 0x35, 0x7d, 0xcf, 0x76, 0x03, 0x52, 0x2b, 0xae, 0x15,
+	0x36, 0xca, 0xaa, 0x1c, 0xb3, 0xde, 0x8f, 0x38, 0x8a, 0x0e, 0xd7, 0x29, 0xc6, 0x67, 0x5e, 0x60,
+	0x77, 0x8f, 0x44, 0x69, 0xb7, 0x5f, 0xe8, 0xfe, 0x87, 0x61, 0x30, 0xd8, 0x32, 0xe6, 0xc8, 0x0b,
+	0xdd, 0xd7, 0xbc, 0x30, 0xda, 0x31, 0xd6, 0x1b, 0x83, 0x9d, 0xaf, 0x91, 0xdc, 0xf9, 0x1a, 0x3b,
+	0xc4, 0x8a, 0xb4, 0x67, 0x09, 0xff, 0xa0, 0xec, 0xc1, 0x5a, 0xb6, 0x8b, 0x53, 0x94, 0xcb, 0xb7,
+	0xe0, 0xc6, 0xc0, 0xee, 0x2e, 0x46, 0xc2, 0x34, 0x4f, 0xe0, 0x08, 0xfc, 0x55, 0x28, 0x8b, 0x32,
+	0xb1, 0x4d, 0x66, 0xbe, 0xa8, 0xce, 0x71, 0xc2, 0xb6, 0xa9, 0xfc, 0x52, 0x9c, 0x74, 0x96, 0x89,
+	0x53, 0x9c, 0xf4, 0x23, 0x28, 0x7d, 0x11, 0x7a, 0x81, 0x2e, 0xc6, 0x9c, 0x8d, 0xcc, 0x84, 0xe4,
+	0xbe, 0x3e, 0xa2, 0xb2, 0xdb, 0x6e, 0xd7, 0x53, 0xb9, 0x9a, 0xf2, 0xdb, 0x34, 0x34, 0x8f, 0x04,
+	0x27, 0x7e, 0x3c, 0xf9, 0x73, 0x58, 0x19, 0xe9, 0xc2, 0xb6, 0xdb, 0xf5, 0x04, 0xa6, 0x3b, 0x13,
+	0x30, 0xc5, 0xad, 0x96, 0xe1, 0x5a, 0xea, 0x8c, 0x12, 0x95, 0x5f, 0x49, 0xb0, 0x9e, 0x8b, 0xf2,
+	0xbf, 0x18, 0xc1, 0x6b, 0x50, 0xa7, 0xdc, 0x8f, 0x42, 0x84, 0x8f, 0x68, 0x5d, 0x93, 0x76, 0xa0,
+	0x07, 0x44, 0x04, 0x4e, 0xd9, 0x83, 0xab, 0x63, 0xb9, 0x02, 0xf0, 0x5b, 0x50, 0x22, 0x94, 0x20,
+	0x20, 0xdf, 0x68, 0x8c, 0xbd, 0x60, 0x69, 0x24, 0x34, 0xb9, 0xbc, 0xf2, 0xf7, 0xf3, 0x00, 0x03,
+	0xaa, 0x7c, 0x03, 0xe6, 0x45, 0x93, 0x32, 0xbc, 0xd0, 0xe5, 0xf5, 0xb3, 0xa0, 0x56, 0x38, 0xed,
+	0x09, 0x25, 0xc9, 0x37, 0xe1, 0xc2, 0x60, 0x3e, 0xe6, 0x52, 0xbc, 0x17, 0x54, 0x63, 0x32, 0x17,
+	0xfc, 0x3f, 0x00, 0x36, 0xe7, 0x72, 0x99, 0x02, 0x93, 0x29, 0x53, 0x0a, 0x67, 0x7f, 0x13, 0x2e,
+	0x8d, 0x4c, 0x27, 0x5c, 0xb4, 0xc8, 0x5b, 0xcb, 0xd0, 0x84, 0xc1, 0xb5, 0xd6, 0x61, 0xa1, 0xa7,
+	0x1f, 0x0a, 0x79, 0xdb, 0xb5, 0xd8, 0x44, 0xba, 0xa0, 0xce, 0xf7, 0xf4, 0xc3, 0x8f, 0x23, 0x9a,
+	0x7c, 0x1b, 0x2e, 0x26, 0xc7, 0x04, 0x6e, 0x75, 0x86, 0x09, 0x5e, 0x18, 0xbc, 0xfc, 0x13, 0x30,
+	0xd8, 0x98, 0x71, 0xa4, 0xf9, 0xd8, 0x33, 0x10, 0x21, 0x42, 0x61, 0x36, 0x82, 0xc1, 0xb9, 0xbb,
+	0x9c, 0xc9, 0xb5, 0xde, 0x80, 0x98, 0xae, 0x75, 0x75, 0xdb, 0x41, 0xa6, 0xe6, 0xd8, 0x24, 0xa8,
+	0xcd, 0xf1, 0xc6, 0x15, 0xf1, 0xb6, 0x18, 0xeb, 0xa9, 0x4d, 0x02, 0x65, 0x0b, 0x6e, 0xc6, 0x07,
+	0x38, 0x94, 0xaf, 0xbb, 0xd8, 0xb3, 0x30, 0x22, 0xe4, 0x58, 0x3d, 0xe0, 0x10, 0x36, 0x26, 0xdb,
+	0x11, 0x59, 0xf1, 0x14, 0xe6, 0x7c, 0x41, 0x13, 0x89, 0xf1, 0x46, 0x46, 0x62, 0xa4, 0xca, 0x20,
+	0xb2, 0x43, 0xa7, 0x6c, 0x35, 0xb6, 0xa0, 0xfc, 0xb3, 0x00, 0x57, 0x32, 0xe5, 0xf2, 0x2b, 0xfb,
+	0x01, 0x5c, 0x26, 0x61, 0x87, 0x18, 0xd8, 0xee, 0x20, 0x53, 0xeb, 0x38, 0x9e, 0x71, 0xa0, 0xed,
+	0x23, 0xdb, 0xda, 0xe7, 0xb9, 0x53, 0x54, 0x57, 0x06, 0xec, 0x4d, 0xca, 0xfd, 0x80, 0x31, 0xd9,
+	0x69, 0x8b, 0x83, 0xa4, 0xe9, 0x8a, 0x44, 0x16, 0xcd, 0x0b, 0x22, 0xcd, 0x59, 0x24, 0x2b, 0xb0,
+	0x10, 0x78, 0x01, 0x7d, 0x37, 0xf5, 0x2d, 0xcd, 0x0d, 0x7b, 0x22, 0x7f, 0x2a, 0x8c, 0xd8, 0xea,
+	0x5b, 0xcf, 0xc2, 0x9e, 0xfc, 0x10, 0xae, 0x08, 0x1d, 0x53, 0xeb, 0xda, 0xae, 0x4d, 0xf6, 0x91,
+	0x19, 0xcb, 0xf3, 0x14, 0xba, 0x14, 0x09, 0x6c, 0x09, 0xbe, 0x50, 0xbd, 0x0b, 0x4b, 0x96, 0x31,
+	0xaa, 0xc4, 0xd3, 0x69, 0xd1, 0x32, 0x86, 0xc4, 0xef, 0x80, 0xec, 0x63, 0xa4, 0x99, 0xc8, 0x0c,
+	0x0d, 0xea, 0x8d, 0xf7, 0x84, 0x59, 0xf6, 0x94, 0x8b, 0x3e, 0x46, 0xef, 0x09, 0x06, 0xab, 0x7f,
+	0x5a, 0x6f, 0x34, 0x57, 0x42, 0x5f, 0xc8, 0xcd, 0x31, 0xb9, 0x0a, 0xa7, 0x71, 0x91, 0x5b, 0x70,
+	0xd1, 0xd1, 0x49, 0xa0, 0x0d, 0xd6, 0x3b, 0xdb, 0x64, 0xfb, 0x41, 0x51, 0xad, 0x52, 0x46, 0x4b,
+	0xec, 0x6d, 0xdb, 0xa6, 0xbc, 0x0e, 0xd5, 0x48, 0x94, 0xc2, 0xb4, 0x4d, 0x36, 0xe2, 0x17, 0xd5,
+	0x0a, 0x97, 0x6b, 0xf5, 0xad, 0x6d, 0x53, 0x7e, 0x0d, 0xaa, 0x71, 0x28, 0x3a, 0x47, 0x01, 0x22,
+	0x6c, 0x34, 0x2f, 0xaa, 0x51, 0xa4, 0xcd, 0x4d, 0x4a, 0x54, 0xfe, 0x9f, 0xf7, 0x73, 0x15, 0x11,
+	0x14, 0xa8, 0x23, 0xe9, 0x1c, 0xb5, 0xa5, 0x4f, 0x60, 0x3d, 0x57, 0x4a, 0x24, 0x62, 0x56, 0xb9,
+	0x48, 0x99, 0xe5, 0xf2, 0x23, 0x89, 0xd7, 0xcb, 0x73, 0x6c, 0x5b, 0x16, 0xc2, 0xc2, 0xf6, 0x96,
+	0x87, 0xdb, 0xa1, 0x41, 0xcb, 0xd0, 0xc3, 0xed, 0xdd, 0xa8, 0x5e, 0x06, 0x53, 0x99, 0x94, 0x98,
+	0xca, 0x28, 0x59, 0x44, 0x41, 0x0c, 0x6b, 0x16, 0x7b, 0xfe, 0x54, 0xff, 0xb2, 0x5d, 0x13, 0x1d,
+	0xb2, 0xac, 0x2a, 0x25, 0xfa, 0xd7, 0x36, 0xa5, 0x2a, 0x9f, 0xc1, 0xc6, 0x64, 0x04, 0xa7, 0x98,
+	0x07, 0x9e, 0xc1, 0x6a, 0x5c, 0xc9, 0xd1, 0xf6, 0xc3, 0x7b, 0xcc, 0x54, 0x0f, 0xa6, 0xfc, 0x5e,
+	0x82, 0x25, 0x1e, 0x41, 0x61, 0x8d, 0xe7, 0x05, 0xad, 0xcc, 0x41, 0xe2, 0x88, 0xca, 0xf4, 0xa2,
+	0x94, 0xd9, 0x80, 0xc5, 0x8c, 0xd1, 0xae, 0xda, 0x4f, 0x0f, 0x75, 0xb7, 0x60, 0x11, 0x23, 0x33,
+	0x74, 0x4d, 0xdd, 0x35, 0x8e, 0x52, 0x81, 0xbb, 0x30, 0xa0, 0xb3, 0xc8, 0xd1, 0xce, 0x8f, 0x51,
+	0x80, 0x8f, 0xb4, 0xc0, 0xee, 0x21, 0x56, 0x8e, 0x25, 0xb5, 0xcc, 0x28, 0xcf, 0xed, 0x1e, 0x52,
+	0xfe, 0x50, 0x80, 0x6a, 0xfa, 0x81, 0xc7, 0xc2, 0x90, 0xc6, 0xc2, 0xb8, 0x0f, 0x97, 0xd3, 0x92,
+	0x5d, 0x36, 0xd6, 0x27, 0x46, 0xd2, 0xfe, 0xc8, 0xcc, 0x7f, 0x32, 0xf4, 0xd7, 0xa1, 0x42, 0x02,
+	0x1d, 0x07, 0x9a, 0xde, 0x0d, 0x10, 0x66, 0xf0, 0x8b, 0x2a, 0x30, 0xd2, 0x63, 0x4a, 0x91, 0x97,
+	0xa1, 0xc4, 0x6f, 0xb0, 0x4b, 0x8c, 0xc5, 0xbf, 0xc8, 0x97, 0x60, 0x86, 0x04, 0x7a, 0x10, 0x12,
+	0xd6, 0x1a, 0x4a, 0xaa, 0xf8, 0x46, 0x4b, 0x5c, 0x84, 0x7f, 0xf0, 0x5a, 0x29, 0xaa, 0x15, 0x4e,
+	0xe3, 0x6f, 0x93, 0x87, 0x70, 0x45, 0x54, 0x85, 0x90, 0xe4, 0xfd, 0x8c, 0xcb, 0xf3, 0x96, 0x70,
+	0x89, 0x0b, 0x88, 0xb1, 0x9f, 0xb2, 0xb9, 0xea, 0x67, 0xb0, 0x12, 0x2d, 0xdb, 0x29, 0x13, 0xb5,
+	0xf2, 0x5a, 0x61, 0xdc, 0x4d, 0x8d, 0xe8, 0xf7, 0x63, 0xf2, 0x44, 0x5d, 0x12, 0x86, 0xb6, 0x12,
+	0x9e, 0x94, 0x3f, 0x4a, 0x70, 0x3d, 0x33, 0x4b, 0x4f, 0x31, 0x2d, 0xa9, 0x70, 0x31, 0xc2, 0x2d,
+	0xde, 0xba, 0x88, 0xd4, 0xce, 0xaf, 0x15, 0xc6, 0x5d, 0x59, 0x09, 0xcc, 0x43, 0xde, 0x17, 0x71,
+	0xea, 0x3b, 0x22, 0xf2, 0x35, 0x28, 0xa3, 0x43, 0x64, 0x84, 0x01, 0x9d, 0x0b, 0xe8, 0xe1, 0xce,
+	0xa9, 0x03, 0xc2, 0xbd, 0xbf, 0x54, 0xe1, 0x22, 0xbb, 0x0f, 0x60, 0x3f, 0x44, 0xb5, 0x11, 0xee,
+	0xdb, 0x06, 0x92, 0x1d, 0x58, 0x48, 0xfd, 0xea, 0x21, 0xbf, 0x9e, 0xe1, 0x7d, 0xdc, 0x0f, 0x42,
+	0xf5, 0x3b, 0xc7, 0x13, 0x16, 0xd7, 0x99, 0xe7, 0xe4, 0x2e, 0x54, 0x12, 0xbf, 0x5f, 0xc8, 0xb7,
+	0x72, 0xd4, 0xd3, 0x3f, 0x9d, 0xd4, 0x6f, 0x1f, 0x47, 0x34, 0xf6, 0xe3, 0x41, 0x35, 0xbd, 0xe7,
+	0xcb, 0x79, 0x48, 0x47, 0xae, 0x57, 0xeb, 0x77, 0x8f, 0x29, 0x1d, 0x3b, 0xfc, 0x99, 0x04, 0xf5,
+	0x68, 0x07, 0x1d, 0xdd, 0xb9, 0xe5, 0xb7, 0x73, 0xec, 0xe5, 0xde, 0x1e, 0xd4, 0x1f, 0x4e, 0xa1,
+	0x39, 0x16, 0xd5, 0xe8, 0x66, 0x3c, 0x11, 0x55, 0xe6, 0x2e, 0x5f, 0x7f, 0x38, 0x85, 0x66, 0x8c,
+	0xea, 0x27, 0x12, 0xd4, 0xb2, 0x16, 0x4c, 0xf9, 0x41, 0x8e, 0xe5, 0x9c, 0xa5, 0xb7, 0xfe, 0xd6,
+	0x89, 0xf5, 0x62, 0x3c, 0x3f, 0x84, 0xa5, 0xb8, 0xc2, 0x13, 0xab, 0xc0, 0x9b, 0x39, 0x16, 0xc7,
+	0x2f, 0x29, 0xf5, 0x7b, 0x27, 0x51, 0x89, 0xfd, 0xff, 0x4e, 0x82, 0xb5, 0x58, 0x22, 0x63, 0xa4,
+	0x95, 0x1f, 0x4d, 0x32, 0x9d, 0x3f, 0x53, 0xd7, 0xdf, 0x9d, 0x5a, 0x3f, 0xc6, 0xf9, 0x1b, 0x09,
+	0x36, 0x06, 0xe1, 0x1c, 0xde, 0xbe, 0x1f, 0xbb, 0x26, 0x1f, 0xed, 0xf8, 0xd4, 0xf6, 0xf6, 0xc4,
+	0xf3, 0xc8, 0xb8, 0x01, 0xa8, 0x3f, 0x9c, 0x42, 0x33, 0xc6, 0xf8, 0xeb, 0xac, 0x05, 0xf7, 0xb1,
+	0x6b, 0xaa, 0x89, 0xa1, 0xf2, 0x18, 0x4e, 0x32, 0x56, 0xf8, 0xfa, 0x3b, 0xd3, 0xa8, 0xc6, 0x00,
+	0x7f, 0x21, 0xc1, 0xd5, 0x9c, 0x89, 0x31, 0x17, 0x58, 0xfe, 0x2c, 0x5a, 0x7f, 0x67, 0x1a, 0xd5,
+	0x91, 0x2c, 0xcc, 0x1b, 0xf7, 0x72, 0xb3, 0xf0, 0x18, 0x93, 0x6a, 0xfd, 0xdd, 0xa9, 0xf5, 0x63,
+	0x9c, 0x3f, 0x96, 0xe0, 0x72, 0xc6, 0x0b, 0x59, 0xbe, 0x3f, 0x29, 0xc9, 0xc7, 0x8e, 0x99, 0xf5,
+	0x07, 0x27, 0x55, 0x8b, 0xc0, 0x6c, 0x7e, 0xfe, 0xa7, 0x97, 0xab, 0xd2, 0x97, 0x2f, 0x57, 0xa5,
+	0xbf, 0xbd, 0x5c, 0x95, 0x7e, 0xfa, 0x6a, 0xf5, 0xdc, 0x97, 0xaf, 0x56, 0xcf, 0xfd, 0xf5, 0xd5,
+	0xea, 0xb9, 0x4f, 0xb7, 0x2c, 0x3b, 0xd8, 0x0f, 0x3b, 0x0d, 0xc3, 0xeb, 0x35, 0x3b, 0x6e, 0xe7,
+	0xae, 0xb1, 0xaf, 0xdb, 0x6e, 0x73, 0x70, 0x0d, 0x77, 0x97, 0x04, 0x1e, 0xd6, 0x2d, 0x74, 0x97,
+	0xde, 0xdb, 0xda, 0x26, 0xc2, 0xcd, 0xb1, 0x7f, 0x36, 0xd2, 0x99, 0x61, 0x7f, 0x92, 0xf1, 0x8d,
+	0xff, 0x0c, 0x00, 0x4c, 0x15, 0x6f, 0x1e, 0x56, 0x22, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2133,6 +2253,7 @@ type GfSpManageServiceClient interface {
 	GfSpAskTask(ctx context.Context, in *GfSpAskTaskRequest, opts ...grpc.CallOption) (*GfSpAskTaskResponse, error)
 	GfSpReportTask(ctx context.Context, in *GfSpReportTaskRequest, opts ...grpc.CallOption) (*GfSpReportTaskResponse, error)
 	GfSpPickVirtualGroupFamily(ctx context.Context, in *GfSpPickVirtualGroupFamilyRequest, opts ...grpc.CallOption) (*GfSpPickVirtualGroupFamilyResponse, error)
+	GfSpPickGlobalVirtualGroup(ctx context.Context, in *GfSpPickGlobalVirtualGroupRequest, opts ...grpc.CallOption) (*GfSpPickGlobalVirtualGroupResponse, error)
 	GfSpNotifyMigrateSwapOut(ctx context.Context, in *GfSpNotifyMigrateSwapOutRequest, opts ...grpc.CallOption) (*GfSpNotifyMigrateSwapOutResponse, error)
 	GfSpQueryTasksStats(ctx context.Context, in *GfSpQueryTasksStatsRequest, opts ...grpc.CallOption) (*GfSpQueryTasksStatsResponse, error)
 	GfSpQueryBucketMigrationProgress(ctx context.Context, in *GfSpQueryBucketMigrationProgressRequest, opts ...grpc.CallOption) (*GfSpQueryBucketMigrationProgressResponse, error)
@@ -2187,6 +2308,15 @@ func (c *gfSpManageServiceClient) GfSpPickVirtualGroupFamily(ctx context.Context
 	return out, nil
 }
 
+func (c *gfSpManageServiceClient) GfSpPickGlobalVirtualGroup(ctx context.Context, in *GfSpPickGlobalVirtualGroupRequest, opts ...grpc.CallOption) (*GfSpPickGlobalVirtualGroupResponse, error) {
+	out := new(GfSpPickGlobalVirtualGroupResponse)
+	err := c.cc.Invoke(ctx, "/base.types.gfspserver.GfSpManageService/GfSpPickGlobalVirtualGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gfSpManageServiceClient) GfSpNotifyMigrateSwapOut(ctx context.Context, in *GfSpNotifyMigrateSwapOutRequest, opts ...grpc.CallOption) (*GfSpNotifyMigrateSwapOutResponse, error) {
 	out := new(GfSpNotifyMigrateSwapOutResponse)
 	err := c.cc.Invoke(ctx, "/base.types.gfspserver.GfSpManageService/GfSpNotifyMigrateSwapOut", in, out, opts...)
@@ -2265,6 +2395,7 @@ type GfSpManageServiceServer interface {
 	GfSpAskTask(context.Context, *GfSpAskTaskRequest) (*GfSpAskTaskResponse, error)
 	GfSpReportTask(context.Context, *GfSpReportTaskRequest) (*GfSpReportTaskResponse, error)
 	GfSpPickVirtualGroupFamily(context.Context, *GfSpPickVirtualGroupFamilyRequest) (*GfSpPickVirtualGroupFamilyResponse, error)
+	GfSpPickGlobalVirtualGroup(context.Context, *GfSpPickGlobalVirtualGroupRequest) (*GfSpPickGlobalVirtualGroupResponse, error)
 	GfSpNotifyMigrateSwapOut(context.Context, *GfSpNotifyMigrateSwapOutRequest) (*GfSpNotifyMigrateSwapOutResponse, error)
 	GfSpQueryTasksStats(context.Context, *GfSpQueryTasksStatsRequest) (*GfSpQueryTasksStatsResponse, error)
 	GfSpQueryBucketMigrationProgress(context.Context, *GfSpQueryBucketMigrationProgressRequest) (*GfSpQueryBucketMigrationProgressResponse, error)
@@ -2291,6 +2422,9 @@ func (*UnimplementedGfSpManageServiceServer) GfSpReportTask(ctx context.Context,
 func (*UnimplementedGfSpManageServiceServer) GfSpPickVirtualGroupFamily(ctx context.Context, req *GfSpPickVirtualGroupFamilyRequest) (*GfSpPickVirtualGroupFamilyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GfSpPickVirtualGroupFamily not implemented")
 }
+func (*UnimplementedGfSpManageServiceServer) GfSpPickGlobalVirtualGroup(ctx context.Context, req *GfSpPickGlobalVirtualGroupRequest) (*GfSpPickGlobalVirtualGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GfSpPickGlobalVirtualGroup not implemented")
+}
 func (*UnimplementedGfSpManageServiceServer) GfSpNotifyMigrateSwapOut(ctx context.Context, req *GfSpNotifyMigrateSwapOutRequest) (*GfSpNotifyMigrateSwapOutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GfSpNotifyMigrateSwapOut not implemented")
 }
@@ -2392,6 +2526,24 @@ func _GfSpManageService_GfSpPickVirtualGroupFamily_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GfSpManageService_GfSpPickGlobalVirtualGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GfSpPickGlobalVirtualGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GfSpManageServiceServer).GfSpPickGlobalVirtualGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.types.gfspserver.GfSpManageService/GfSpPickGlobalVirtualGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GfSpManageServiceServer).GfSpPickGlobalVirtualGroup(ctx, req.(*GfSpPickGlobalVirtualGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GfSpManageService_GfSpNotifyMigrateSwapOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GfSpNotifyMigrateSwapOutRequest)
 	if err := dec(in); err != nil {
@@ -2556,6 +2708,10 @@ var _GfSpManageService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GfSpPickVirtualGroupFamily",
 			Handler:    _GfSpManageService_GfSpPickVirtualGroupFamily_Handler,
 		},
+		{
+			MethodName: "GfSpPickGlobalVirtualGroup",
+			Handler:    _GfSpManageService_GfSpPickGlobalVirtualGroup_Handler,
+		},
 		{
 			MethodName: "GfSpNotifyMigrateSwapOut",
 			Handler:    _GfSpManageService_GfSpNotifyMigrateSwapOut_Handler,
@@ -3427,6 +3583,108 @@ func (m *GfSpPickVirtualGroupFamilyResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *GfSpPickGlobalVirtualGroupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GfSpPickGlobalVirtualGroupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GfSpPickGlobalVirtualGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UploadObjectTask != nil {
+		{
+			size, err := m.UploadObjectTask.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintManage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GfSpPickGlobalVirtualGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GfSpPickGlobalVirtualGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GfSpPickGlobalVirtualGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SecondaryEndpoints) > 0 {
+		for iNdEx := len(m.SecondaryEndpoints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SecondaryEndpoints[iNdEx])
+			copy(dAtA[i:], m.SecondaryEndpoints[iNdEx])
+			i = encodeVarintManage(dAtA, i, uint64(len(m.SecondaryEndpoints[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SecondarySpIds) > 0 {
+		dAtA35 := make([]byte, len(m.SecondarySpIds)*10)
+		var j34 int
+		for _, num := range m.SecondarySpIds {
+			for num >= 1<<7 {
+				dAtA35[j34] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j34++
+			}
+			dAtA35[j34] = uint8(num)
+			j34++
+		}
+		i -= j34
+		copy(dAtA[i:], dAtA35[:j34])
+		i = encodeVarintManage(dAtA, i, uint64(j34))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintManage(dAtA, i, uint64(m.GlobalVirtualGroupId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Err != nil {
+		{
+			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintManage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GfSpNotifyMigrateSwapOutRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4696,6 +4954,48 @@ func (m *GfSpPickVirtualGroupFamilyResponse) Size() (n int) {
 	return n
 }
 
+func (m *GfSpPickGlobalVirtualGroupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UploadObjectTask != nil {
+		l = m.UploadObjectTask.Size()
+		n += 1 + l + sovManage(uint64(l))
+	}
+	return n
+}
+
+func (m *GfSpPickGlobalVirtualGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Err != nil {
+		l = m.Err.Size()
+		n += 1 + l + sovManage(uint64(l))
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovManage(uint64(m.GlobalVirtualGroupId))
+	}
+	if len(m.SecondarySpIds) > 0 {
+		l = 0
+		for _, e := range m.SecondarySpIds {
+			l += sovManage(uint64(e))
+		}
+		n += 1 + sovManage(uint64(l)) + l
+	}
+	if len(m.SecondaryEndpoints) > 0 {
+		for _, s := range m.SecondaryEndpoints {
+			l = len(s)
+			n += 1 + l + sovManage(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *GfSpNotifyMigrateSwapOutRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6612,6 +6912,305 @@ func (m *GfSpPickVirtualGroupFamilyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GfSpPickGlobalVirtualGroupRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GfSpPickGlobalVirtualGroupRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GfSpPickGlobalVirtualGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadObjectTask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthManage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UploadObjectTask == nil {
+				m.UploadObjectTask = &gfsptask.GfSpUploadObjectTask{}
+			}
+			if err := m.UploadObjectTask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthManage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GfSpPickGlobalVirtualGroupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GfSpPickGlobalVirtualGroupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GfSpPickGlobalVirtualGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthManage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Err == nil {
+				m.Err = &gfsperrors.GfSpError{}
+			}
+			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+			}
+			m.GlobalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowManage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SecondarySpIds = append(m.SecondarySpIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowManage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthManage
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthManage
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SecondarySpIds) == 0 {
+					m.SecondarySpIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowManage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SecondarySpIds = append(m.SecondarySpIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondarySpIds", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryEndpoints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthManage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecondaryEndpoints = append(m.SecondaryEndpoints, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthManage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GfSpNotifyMigrateSwapOutRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -198,6 +198,7 @@ type GfSpSignRequest struct {
 	//	*GfSpSignRequest_DelegateCreateObject
 	//	*GfSpSignRequest_DelegateUpdateObjectContent
 	//	*GfSpSignRequest_SealObjectInfoV2
+	//	*GfSpSignRequest_DelegateDeleteObject
 	Request isGfSpSignRequest_Request `protobuf_oneof:"request"`
 }
 
@@ -339,6 +340,9 @@ type GfSpSignRequest_DelegateUpdateObjectContent struct {
 type GfSpSignRequest_SealObjectInfoV2 struct {
 	SealObjectInfoV2 *types1.MsgSealObjectV2 `protobuf:"bytes,33,opt,name=seal_object_info_v2,json=sealObjectInfoV2,proto3,oneof" json:"seal_object_info_v2,omitempty"`
 }
+type GfSpSignRequest_DelegateDeleteObject struct {
+	DelegateDeleteObject *types1.MsgDeleteObject `protobuf:"bytes,34,opt,name=delegate_delete_object,json=delegateDeleteObject,proto3,oneof" json:"delegate_delete_object,omitempty"`
+}
 
 func (*GfSpSignRequest_CreateBucketInfo) isGfSpSignRequest_Request()               {}
 func (*GfSpSignRequest_MigrateBucketInfo) isGfSpSignRequest_Request()              {}
@@ -373,6 +377,7 @@ func (*GfSpSignRequest_CancelSwapIn) isGfSpSignRequest_Request()
 func (*GfSpSignRequest_DelegateCreateObject) isGfSpSignRequest_Request()           {}
 func (*GfSpSignRequest_DelegateUpdateObjectContent) isGfSpSignRequest_Request()    {}
 func (*GfSpSignRequest_SealObjectInfoV2) isGfSpSignRequest_Request()               {}
+func (*GfSpSignRequest_DelegateDeleteObject) isGfSpSignRequest_Request()           {}
 
 func (m *GfSpSignRequest) GetRequest() isGfSpSignRequest_Request {
 	if m != nil {
@@ -612,6 +617,13 @@ func (m *GfSpSignRequest) GetSealObjectInfoV2() *types1.MsgSealObjectV2 {
 	return nil
 }
 
+func (m *GfSpSignRequest) GetDelegateDeleteObject() *types1.MsgDeleteObject {
+	if x, ok := m.GetRequest().(*GfSpSignRequest_DelegateDeleteObject); ok {
+		return x.DelegateDeleteObject
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*GfSpSignRequest) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -648,6 +660,7 @@ func (*GfSpSignRequest) XXX_OneofWrappers() []interface{} {
 		(*GfSpSignRequest_DelegateCreateObject)(nil),
 		(*GfSpSignRequest_DelegateUpdateObjectContent)(nil),
 		(*GfSpSignRequest_SealObjectInfoV2)(nil),
+		(*GfSpSignRequest_DelegateDeleteObject)(nil),
 	}
 }
 
@@ -721,98 +734,99 @@ func init() {
 func init() { proto.RegisterFile("base/types/gfspserver/sign.proto", fileDescriptor_16c5938400680494) }
 
 var fileDescriptor_16c5938400680494 = []byte{
-	// 1453 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0x49, 0x73, 0x1b, 0x45,
-	0x14, 0x96, 0xe2, 0xe0, 0xa5, 0xbd, 0x29, 0x6d, 0x3b, 0x9e, 0xd8, 0x46, 0x51, 0x0c, 0x95, 0x18,
-	0x48, 0x34, 0x15, 0x85, 0x14, 0xc5, 0x09, 0xe2, 0x2c, 0x96, 0xa9, 0x72, 0xc5, 0x35, 0x0a, 0xa6,
-	0xa0, 0xa0, 0x26, 0xa3, 0x9e, 0xd6, 0xa8, 0xb1, 0x34, 0xdd, 0x74, 0x8f, 0x14, 0xfb, 0xc0, 0x81,
-	0xe2, 0xca, 0x81, 0xbf, 0xc0, 0xbf, 0xe1, 0x98, 0x23, 0x47, 0xca, 0xf9, 0x23, 0x54, 0x2f, 0xb3,
-	0x6a, 0x0b, 0x97, 0x44, 0xf3, 0x96, 0xef, 0xbd, 0x7e, 0xfd, 0xde, 0xd7, 0xdd, 0x06, 0xb5, 0xb6,
-	0x27, 0xb0, 0x1d, 0x5d, 0x32, 0x2c, 0xec, 0xa0, 0x23, 0x98, 0xc0, 0x7c, 0x88, 0xb9, 0x2d, 0x48,
-	0x10, 0xd6, 0x19, 0xa7, 0x11, 0x85, 0x5b, 0xd2, 0xa2, 0xae, 0x2c, 0xea, 0xa9, 0xc5, 0xce, 0x9d,
-	0x82, 0x23, 0xe6, 0x9c, 0x72, 0x61, 0xab, 0xff, 0xb4, 0xe7, 0xce, 0x5e, 0xc1, 0x84, 0x35, 0x98,
-	0xcd, 0x1a, 0xcc, 0x68, 0xab, 0x05, 0x6d, 0xe4, 0x89, 0x73, 0x5b, 0xfe, 0x13, 0xeb, 0x11, 0x15,
-	0x7d, 0x2a, 0x6c, 0x65, 0x36, 0x7c, 0xd8, 0xc6, 0x91, 0xf7, 0xd0, 0x46, 0x94, 0x98, 0xbc, 0x76,
-	0x6e, 0x06, 0x1c, 0xe3, 0xb0, 0x43, 0x70, 0xcf, 0xb7, 0x05, 0xb3, 0xa3, 0x0b, 0x23, 0xbf, 0x9d,
-	0x95, 0x47, 0x94, 0x7b, 0x01, 0xb6, 0x11, 0xed, 0xf7, 0x69, 0xec, 0xb8, 0x3b, 0xc6, 0x20, 0xf1,
-	0xae, 0x65, 0x94, 0x43, 0xc2, 0xa3, 0x81, 0xd7, 0x0b, 0x38, 0x1d, 0xa4, 0xf8, 0xfb, 0x7f, 0x94,
-	0x81, 0x75, 0xd4, 0x69, 0xb1, 0x16, 0x09, 0xc2, 0x16, 0x46, 0x34, 0xf4, 0x3d, 0x7e, 0xd9, 0xc2,
-	0x5e, 0xef, 0xb0, 0x27, 0xe0, 0x2e, 0x58, 0xa2, 0xed, 0x9f, 0x31, 0x8a, 0x5c, 0xe2, 0x5b, 0xe5,
-	0x5a, 0xf9, 0xe0, 0xba, 0xb3, 0xa8, 0x05, 0xc7, 0x3e, 0x7c, 0x0c, 0xb6, 0x83, 0x1e, 0x6d, 0x7b,
-	0x3d, 0xd7, 0x20, 0xbb, 0x0a, 0x5a, 0x9a, 0x5e, 0xab, 0x95, 0x0f, 0x56, 0x9d, 0x4d, 0xad, 0x3e,
-	0xd3, 0xda, 0x23, 0xa9, 0x3c, 0xf6, 0xe1, 0x1e, 0x58, 0x42, 0x5d, 0x8c, 0xce, 0xc5, 0xa0, 0x2f,
-	0xac, 0xb9, 0xda, 0xdc, 0xc1, 0x8a, 0x93, 0x0a, 0xf6, 0xaf, 0xca, 0x60, 0x4f, 0xa6, 0xf3, 0x94,
-	0x63, 0x2f, 0xc2, 0x47, 0x23, 0x00, 0x32, 0x6a, 0x3e, 0x5c, 0xc7, 0xeb, 0x93, 0xde, 0x65, 0x9c,
-	0xe0, 0xaa, 0xb3, 0x39, 0xcc, 0x98, 0xbf, 0x50, 0xca, 0x63, 0x1f, 0xde, 0x07, 0x90, 0x71, 0xd2,
-	0xf7, 0xf8, 0xa5, 0x2b, 0x98, 0xeb, 0xf9, 0x3e, 0xc7, 0x42, 0xa8, 0x3c, 0x97, 0x9c, 0x8a, 0xd1,
-	0xb4, 0xd8, 0x13, 0x2d, 0x87, 0x07, 0xa0, 0x22, 0xe2, 0x5a, 0x48, 0x7b, 0xe2, 0xeb, 0x54, 0x57,
-	0x9d, 0xb5, 0x44, 0xde, 0x62, 0xc7, 0xbe, 0x80, 0x8f, 0xc0, 0x82, 0x8f, 0x19, 0x15, 0x24, 0xb2,
-	0xae, 0xd7, 0xca, 0x07, 0xcb, 0x8d, 0x5b, 0x75, 0xbd, 0xd1, 0x75, 0xd5, 0x67, 0x66, 0xa3, 0xeb,
-	0x4f, 0x29, 0x09, 0x9d, 0xd8, 0x72, 0xff, 0x2f, 0x0b, 0xac, 0xc7, 0x35, 0x77, 0xf0, 0x2f, 0x03,
-	0x2c, 0x22, 0xd8, 0x02, 0x10, 0xa9, 0x35, 0xbb, 0xed, 0x01, 0x3a, 0xc7, 0x91, 0x4b, 0xc2, 0x0e,
-	0x55, 0x4b, 0x5a, 0x6e, 0x7c, 0x54, 0x4f, 0xb7, 0xb1, 0x6e, 0xf6, 0xb8, 0x7e, 0x22, 0x02, 0x5d,
-	0xa4, 0x43, 0x65, 0xdf, 0x2c, 0x39, 0x15, 0x94, 0xf9, 0x3e, 0x0e, 0x3b, 0x14, 0x9e, 0x81, 0x8d,
-	0x3e, 0x09, 0x78, 0x11, 0xf5, 0x9a, 0x42, 0xfd, 0x78, 0x02, 0xea, 0x89, 0xf6, 0x48, 0x60, 0x6f,
-	0xf4, 0xb3, 0x02, 0x85, 0x9b, 0x26, 0x1b, 0xb7, 0x87, 0x84, 0x9d, 0x7b, 0x8f, 0x64, 0x5f, 0x2a,
-	0xfb, 0x34, 0x59, 0xfd, 0xad, 0x40, 0x4f, 0x64, 0xd1, 0xbd, 0x5e, 0x0e, 0x52, 0xd7, 0xf4, 0xce,
-	0x04, 0x48, 0xd9, 0xa6, 0x09, 0xe0, 0x9a, 0x48, 0xbe, 0x14, 0x5c, 0x1b, 0x6c, 0xfb, 0x44, 0x20,
-	0x1a, 0x46, 0x24, 0x1c, 0xe4, 0xd7, 0xff, 0x81, 0x42, 0x3d, 0x98, 0x80, 0xfa, 0x2c, 0xf5, 0x4a,
-	0x6a, 0xb0, 0xe5, 0x17, 0x85, 0x2a, 0x46, 0x17, 0x6c, 0x4b, 0x6a, 0x71, 0x33, 0xcd, 0x22, 0x57,
-	0xd0, 0xee, 0x09, 0x6b, 0x5e, 0xc5, 0xb0, 0xeb, 0x63, 0xe9, 0xa6, 0x3e, 0x69, 0xe2, 0x9a, 0x25,
-	0x67, 0x53, 0x8c, 0x9b, 0xc4, 0x2f, 0xc1, 0x22, 0x23, 0x61, 0xe0, 0xf6, 0x45, 0x60, 0x2d, 0x28,
-	0xe8, 0xbd, 0x22, 0xb4, 0xe4, 0x22, 0x89, 0x7b, 0x4a, 0xc2, 0xa0, 0x59, 0x72, 0x16, 0xa4, 0xfd,
-	0x89, 0x08, 0x94, 0x2b, 0x35, 0xae, 0x8b, 0x33, 0x5c, 0xa9, 0x71, 0xa5, 0xda, 0xf5, 0xb7, 0x32,
-	0xd8, 0x97, 0x7a, 0x97, 0x63, 0xd6, 0x23, 0x48, 0x6e, 0x38, 0x23, 0x18, 0x61, 0xd7, 0x63, 0x8c,
-	0xd3, 0xa1, 0xd7, 0x73, 0x25, 0xc3, 0x59, 0x4b, 0x0a, 0xf5, 0x51, 0x11, 0x55, 0xb1, 0x9f, 0x84,
-	0x75, 0x62, 0xef, 0x53, 0xe9, 0xfc, 0xc4, 0xf8, 0xbe, 0xf2, 0xc4, 0x79, 0xb3, 0xe4, 0x54, 0xa5,
-	0xe9, 0x64, 0x0b, 0xb9, 0x8f, 0x26, 0x05, 0x84, 0xc9, 0x30, 0x4e, 0x40, 0xc5, 0x05, 0x2a, 0xee,
-	0x27, 0x53, 0xe2, 0x2a, 0x17, 0x85, 0x69, 0xa2, 0x6d, 0xea, 0x68, 0x79, 0x79, 0x36, 0x06, 0x1d,
-	0x62, 0x9e, 0x8d, 0xb1, 0x3c, 0x3b, 0x86, 0x74, 0x19, 0x17, 0x23, 0x27, 0x87, 0xdf, 0x01, 0xc8,
-	0xb1, 0xea, 0xec, 0x6c, 0x83, 0xaf, 0x28, 0xf8, 0x7b, 0x13, 0x5a, 0xd1, 0x51, 0x0e, 0xb9, 0x36,
-	0xaf, 0x68, 0x90, 0x4c, 0xa3, 0x47, 0x60, 0xd7, 0x0c, 0xe3, 0x38, 0x3a, 0xb6, 0x56, 0xc7, 0x6f,
-	0x4e, 0xa6, 0x11, 0x27, 0x71, 0x6d, 0xb3, 0xe4, 0x58, 0x68, 0x12, 0x0f, 0xc7, 0x25, 0x8b, 0xf9,
-	0x25, 0x53, 0xb2, 0xb5, 0x19, 0x25, 0x33, 0x04, 0x33, 0x52, 0xb2, 0xa2, 0x1c, 0x76, 0xc0, 0x36,
-	0xa2, 0x7d, 0xd6, 0xc3, 0x11, 0x76, 0xf3, 0x3c, 0x66, 0xad, 0xab, 0x18, 0xf7, 0x27, 0x71, 0x8d,
-	0xf1, 0x2a, 0x52, 0xd9, 0x16, 0x1a, 0xa7, 0x80, 0xbf, 0x97, 0xc1, 0x7e, 0x71, 0x8e, 0xe3, 0x95,
-	0x11, 0x1a, 0xc6, 0x31, 0x2b, 0x2a, 0xe6, 0xe3, 0x71, 0x31, 0xd3, 0x79, 0x35, 0xab, 0x20, 0x34,
-	0xd4, 0xe0, 0x72, 0xca, 0x9f, 0x51, 0x24, 0x1b, 0x3d, 0x3f, 0xd8, 0x45, 0x43, 0xf8, 0x35, 0x58,
-	0x14, 0x6f, 0x3c, 0xe6, 0xd2, 0x41, 0x64, 0xdd, 0x18, 0xa5, 0xd2, 0xec, 0xf1, 0xad, 0xc8, 0xef,
-	0x8d, 0xc7, 0x5e, 0x0e, 0xe4, 0xaa, 0x16, 0x84, 0xfe, 0x09, 0x8f, 0xc1, 0xaa, 0x5e, 0x46, 0x0c,
-	0x03, 0xff, 0x0f, 0xcc, 0xb2, 0xca, 0xcf, 0x40, 0x7d, 0x0f, 0x6e, 0x24, 0xa5, 0x4f, 0xe0, 0x36,
-	0x14, 0xdc, 0x67, 0xd3, 0xe0, 0xe2, 0xca, 0xa7, 0xb0, 0xeb, 0x28, 0x2f, 0x82, 0xdf, 0x80, 0x05,
-	0xc1, 0x5c, 0x7c, 0x41, 0x22, 0x6b, 0xd3, 0x90, 0xe4, 0xb4, 0xfc, 0x74, 0x85, 0x4f, 0x39, 0x1d,
-	0x12, 0x1f, 0xf3, 0xe7, 0x17, 0x44, 0x82, 0xce, 0x0b, 0x26, 0x7f, 0x41, 0x04, 0x2a, 0x69, 0x9a,
-	0x06, 0x74, 0x4b, 0x81, 0x7e, 0xf1, 0x5e, 0x59, 0x8e, 0x05, 0x5f, 0x4b, 0x32, 0xd6, 0x41, 0x1c,
-	0x50, 0x11, 0xcc, 0x35, 0x5b, 0xed, 0x32, 0x4e, 0x10, 0xb6, 0x6e, 0xaa, 0x20, 0x77, 0x73, 0xbd,
-	0xa0, 0xa0, 0xbf, 0x65, 0xbe, 0x27, 0x3d, 0x13, 0x68, 0x82, 0xb0, 0x3a, 0x9d, 0x72, 0x12, 0xf8,
-	0x23, 0xd8, 0xca, 0x8d, 0x4f, 0x30, 0x0c, 0xf4, 0xf0, 0x6c, 0x1b, 0x42, 0x98, 0x31, 0x3c, 0x47,
-	0x67, 0x47, 0x66, 0x74, 0x60, 0x66, 0x74, 0x8e, 0x86, 0x81, 0x1a, 0x9c, 0x00, 0x58, 0x0a, 0xdd,
-	0x1c, 0x7a, 0x71, 0x10, 0xc5, 0x38, 0x96, 0x99, 0x9c, 0x49, 0x01, 0x74, 0x37, 0x26, 0xcd, 0x29,
-	0x29, 0x46, 0x4e, 0x8e, 0xb4, 0xc9, 0xaa, 0xb0, 0xe2, 0x9e, 0xd7, 0x60, 0xcb, 0x90, 0x5a, 0x61,
-	0x3e, 0x6f, 0xa9, 0x28, 0x9f, 0x4e, 0xe5, 0xb5, 0xe2, 0x74, 0x6e, 0xf0, 0x51, 0x31, 0xfc, 0x2a,
-	0xbd, 0x60, 0xed, 0xcc, 0xee, 0xe6, 0x67, 0xda, 0x54, 0x0e, 0x85, 0xf1, 0x82, 0x03, 0xb0, 0xeb,
-	0x63, 0xd5, 0x20, 0x63, 0xe9, 0x71, 0x57, 0x81, 0x7e, 0x3e, 0x1d, 0x54, 0xba, 0x8f, 0xe7, 0x47,
-	0x7f, 0x82, 0x0e, 0xb6, 0xc0, 0x3a, 0xc7, 0x8a, 0x64, 0xf5, 0xfc, 0x90, 0xd0, 0xda, 0x33, 0xbc,
-	0x38, 0x25, 0x94, 0xa3, 0x5d, 0xe4, 0xa8, 0x1c, 0x87, 0xcd, 0x92, 0xb3, 0xca, 0xb3, 0x02, 0x78,
-	0x96, 0x6d, 0x77, 0x83, 0xfa, 0xe1, 0x68, 0xa5, 0xa7, 0x0d, 0xa5, 0x82, 0x5d, 0x43, 0x39, 0x09,
-	0x3c, 0x05, 0x6b, 0xc8, 0x0b, 0x11, 0xee, 0x25, 0xa8, 0xd5, 0xd1, 0x2b, 0xd2, 0x08, 0xaa, 0xf2,
-	0x48, 0x30, 0x57, 0x50, 0xe6, 0x1b, 0x22, 0x70, 0x53, 0x96, 0x26, 0x90, 0x2d, 0x91, 0xbb, 0x2a,
-	0x5a, 0xb7, 0x47, 0x49, 0x24, 0x7b, 0xf9, 0x32, 0x4e, 0x85, 0xdb, 0xe2, 0xa6, 0x3f, 0x46, 0x0e,
-	0x2f, 0x41, 0x35, 0x09, 0x32, 0x50, 0x73, 0x17, 0x9f, 0xad, 0xf2, 0xae, 0x86, 0xc3, 0xc8, 0xaa,
-	0xa9, 0x60, 0x8d, 0x19, 0xc1, 0xf4, 0xcc, 0x6a, 0xd0, 0xa7, 0xda, 0xb3, 0x59, 0x72, 0x76, 0xfd,
-	0xc9, 0x6a, 0xf8, 0x0a, 0x6c, 0x14, 0x2f, 0xab, 0xee, 0xb0, 0x61, 0xdd, 0x99, 0x7a, 0x05, 0x4e,
-	0x0f, 0xf2, 0xb3, 0x86, 0x3c, 0xca, 0xf3, 0x37, 0xd6, 0xb3, 0xc6, 0xe1, 0x12, 0x58, 0xe0, 0xfa,
-	0x3d, 0xb0, 0xff, 0x2b, 0xa8, 0xa4, 0x4f, 0x04, 0xc1, 0x68, 0x28, 0x30, 0x6c, 0x80, 0x39, 0xcc,
-	0xb9, 0x79, 0x14, 0xd4, 0x8a, 0x13, 0xac, 0x9f, 0xac, 0x6a, 0x86, 0x9f, 0xcb, 0x9f, 0x8e, 0x34,
-	0x96, 0xcf, 0x2d, 0xc9, 0xeb, 0x5e, 0x34, 0xe0, 0x58, 0x5d, 0xfc, 0x57, 0x9c, 0x54, 0x00, 0xb7,
-	0xc1, 0x42, 0x74, 0xe1, 0x76, 0x3d, 0xd1, 0x55, 0xb7, 0xf7, 0x25, 0x67, 0x3e, 0xba, 0x68, 0x7a,
-	0xa2, 0xdb, 0x60, 0xe9, 0x0b, 0xa5, 0x85, 0xf9, 0x50, 0x52, 0xd6, 0x4f, 0x60, 0x31, 0x16, 0xc1,
-	0xbb, 0x33, 0xee, 0xb5, 0xe6, 0x55, 0xb3, 0x73, 0x6f, 0xa6, 0x9d, 0x5e, 0xda, 0x7e, 0xe9, 0xf0,
-	0xf5, 0xdf, 0x57, 0xd5, 0xf2, 0xdb, 0xab, 0x6a, 0xf9, 0xdf, 0xab, 0x6a, 0xf9, 0xcf, 0x77, 0xd5,
-	0xd2, 0xdb, 0x77, 0xd5, 0xd2, 0x3f, 0xef, 0xaa, 0xa5, 0x1f, 0x5e, 0x04, 0x24, 0xea, 0x0e, 0xda,
-	0x75, 0x44, 0xfb, 0x76, 0x3b, 0x6c, 0x3f, 0x40, 0x5d, 0x8f, 0x84, 0x76, 0x5a, 0xe2, 0x07, 0xa6,
-	0xc4, 0x0f, 0x98, 0xe1, 0x71, 0x7b, 0xec, 0x5f, 0x01, 0xda, 0xf3, 0xea, 0xc5, 0xfb, 0xe8, 0xbf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x80, 0x49, 0xf9, 0x20, 0x25, 0x10, 0x00, 0x00,
+	// 1467 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0x49, 0x6f, 0x1b, 0xb7,
+	0x17, 0x97, 0xe2, 0xfc, 0xbd, 0xd0, 0x9b, 0x42, 0xdb, 0xf1, 0xc4, 0xf6, 0x5f, 0x51, 0xd4, 0x22,
+	0x71, 0xdb, 0x44, 0x83, 0x28, 0x0d, 0x8a, 0x9e, 0xda, 0x38, 0x8b, 0xa5, 0x02, 0x46, 0x8c, 0x51,
+	0xea, 0xa2, 0x1b, 0x26, 0xa3, 0x19, 0x6a, 0xc4, 0x7a, 0x34, 0x64, 0xc9, 0x91, 0x62, 0x1f, 0x7a,
+	0x28, 0x7a, 0xed, 0xa1, 0x1f, 0xab, 0xc7, 0x1c, 0x7b, 0x2c, 0x9c, 0xef, 0x51, 0x14, 0x5c, 0x66,
+	0xd5, 0x96, 0x5e, 0x12, 0xcd, 0x5b, 0x7e, 0xef, 0xf1, 0xf1, 0xbd, 0x1f, 0x49, 0x83, 0x5a, 0xd7,
+	0xe1, 0xc8, 0x8c, 0x2e, 0x29, 0xe2, 0xa6, 0xdf, 0xe3, 0x94, 0x23, 0x36, 0x42, 0xcc, 0xe4, 0xd8,
+	0x0f, 0x1b, 0x94, 0x91, 0x88, 0xc0, 0x1d, 0x61, 0xd1, 0x90, 0x16, 0x8d, 0xd4, 0x62, 0xef, 0x4e,
+	0xc1, 0x11, 0x31, 0x46, 0x18, 0x37, 0xe5, 0x7f, 0xca, 0x73, 0xef, 0xa0, 0x60, 0x42, 0x9b, 0xd4,
+	0xa4, 0x4d, 0xaa, 0xb5, 0xd5, 0x82, 0x36, 0x72, 0xf8, 0xb9, 0x29, 0xfe, 0x89, 0xf5, 0x2e, 0xe1,
+	0x03, 0xc2, 0x4d, 0x69, 0x36, 0x7a, 0xd8, 0x45, 0x91, 0xf3, 0xd0, 0x74, 0x09, 0xd6, 0x79, 0xed,
+	0xdd, 0xf4, 0x19, 0x42, 0x61, 0x0f, 0xa3, 0xc0, 0x33, 0x39, 0x35, 0xa3, 0x0b, 0x2d, 0xbf, 0x9d,
+	0x95, 0x47, 0x84, 0x39, 0x3e, 0x32, 0x5d, 0x32, 0x18, 0x90, 0xd8, 0x71, 0x7f, 0x82, 0x41, 0xe2,
+	0x5d, 0xcb, 0x28, 0x47, 0x98, 0x45, 0x43, 0x27, 0xf0, 0x19, 0x19, 0xa6, 0xf8, 0xf5, 0xdf, 0xcb,
+	0xc0, 0x38, 0xee, 0x75, 0x68, 0x07, 0xfb, 0x61, 0x07, 0xb9, 0x24, 0xf4, 0x1c, 0x76, 0xd9, 0x41,
+	0x4e, 0x70, 0x14, 0x70, 0xb8, 0x0f, 0x56, 0x48, 0xf7, 0x27, 0xe4, 0x46, 0x36, 0xf6, 0x8c, 0x72,
+	0xad, 0x7c, 0x78, 0xdd, 0x5a, 0x56, 0x82, 0xb6, 0x07, 0x1f, 0x83, 0x5d, 0x3f, 0x20, 0x5d, 0x27,
+	0xb0, 0x35, 0xb2, 0x2d, 0xa1, 0x85, 0xe9, 0xb5, 0x5a, 0xf9, 0x70, 0xdd, 0xda, 0x56, 0xea, 0x33,
+	0xa5, 0x3d, 0x16, 0xca, 0xb6, 0x07, 0x0f, 0xc0, 0x8a, 0xdb, 0x47, 0xee, 0x39, 0x1f, 0x0e, 0xb8,
+	0xb1, 0x50, 0x5b, 0x38, 0x5c, 0xb3, 0x52, 0x41, 0xfd, 0xaa, 0x0c, 0x0e, 0x44, 0x3a, 0x4f, 0x19,
+	0x72, 0x22, 0x74, 0x3c, 0x06, 0x20, 0xa2, 0xe6, 0xc3, 0xf5, 0x9c, 0x01, 0x0e, 0x2e, 0xe3, 0x04,
+	0xd7, 0xad, 0xed, 0x51, 0xc6, 0xfc, 0x85, 0x54, 0xb6, 0x3d, 0x78, 0x1f, 0x40, 0xca, 0xf0, 0xc0,
+	0x61, 0x97, 0x36, 0xa7, 0xb6, 0xe3, 0x79, 0x0c, 0x71, 0x2e, 0xf3, 0x5c, 0xb1, 0x2a, 0x5a, 0xd3,
+	0xa1, 0x4f, 0x94, 0x1c, 0x1e, 0x82, 0x0a, 0x8f, 0x6b, 0x21, 0xec, 0xb1, 0xa7, 0x52, 0x5d, 0xb7,
+	0x36, 0x12, 0x79, 0x87, 0xb6, 0x3d, 0x0e, 0x1f, 0x81, 0x25, 0x0f, 0x51, 0xc2, 0x71, 0x64, 0x5c,
+	0xaf, 0x95, 0x0f, 0x57, 0x9b, 0xb7, 0x1a, 0x6a, 0xa3, 0x1b, 0xb2, 0xcf, 0xf4, 0x46, 0x37, 0x9e,
+	0x12, 0x1c, 0x5a, 0xb1, 0x65, 0xfd, 0x1f, 0x03, 0x6c, 0xc6, 0x35, 0xb7, 0xd0, 0xcf, 0x43, 0xc4,
+	0x23, 0xd8, 0x01, 0xd0, 0x95, 0x6b, 0xb6, 0xbb, 0x43, 0xf7, 0x1c, 0x45, 0x36, 0x0e, 0x7b, 0x44,
+	0x2e, 0x69, 0xb5, 0xf9, 0x41, 0x23, 0xdd, 0xc6, 0x86, 0xde, 0xe3, 0xc6, 0x09, 0xf7, 0x55, 0x91,
+	0x8e, 0xa4, 0x7d, 0xab, 0x64, 0x55, 0xdc, 0xcc, 0x77, 0x3b, 0xec, 0x11, 0x78, 0x06, 0xb6, 0x06,
+	0xd8, 0x67, 0x45, 0xd4, 0x6b, 0x12, 0xf5, 0xc3, 0x29, 0xa8, 0x27, 0xca, 0x23, 0x81, 0xbd, 0x31,
+	0xc8, 0x0a, 0x24, 0x6e, 0x9a, 0x6c, 0xdc, 0x1e, 0x02, 0x76, 0xe1, 0x3d, 0x92, 0x7d, 0x29, 0xed,
+	0xd3, 0x64, 0xd5, 0xb7, 0x04, 0x3d, 0x11, 0x45, 0x77, 0x82, 0x1c, 0xa4, 0xaa, 0xe9, 0x9d, 0x29,
+	0x90, 0xa2, 0x4d, 0x13, 0xc0, 0x0d, 0x9e, 0x7c, 0x49, 0xb8, 0x2e, 0xd8, 0xf5, 0x30, 0x77, 0x49,
+	0x18, 0xe1, 0x70, 0x98, 0x5f, 0xff, 0xff, 0x24, 0xea, 0xe1, 0x14, 0xd4, 0x67, 0xa9, 0x57, 0x52,
+	0x83, 0x1d, 0xaf, 0x28, 0x94, 0x31, 0xfa, 0x60, 0x57, 0x50, 0x8b, 0x9d, 0x69, 0x16, 0xb1, 0x82,
+	0x6e, 0xc0, 0x8d, 0x45, 0x19, 0xc3, 0x6c, 0x4c, 0xa4, 0x9b, 0xc6, 0xb4, 0x89, 0x6b, 0x95, 0xac,
+	0x6d, 0x3e, 0x69, 0x12, 0x3f, 0x07, 0xcb, 0x14, 0x87, 0xbe, 0x3d, 0xe0, 0xbe, 0xb1, 0x24, 0xa1,
+	0x0f, 0x8a, 0xd0, 0x82, 0x8b, 0x04, 0xee, 0x29, 0x0e, 0xfd, 0x56, 0xc9, 0x5a, 0x12, 0xf6, 0x27,
+	0xdc, 0x97, 0xae, 0x44, 0xbb, 0x2e, 0xcf, 0x71, 0x25, 0xda, 0x95, 0x28, 0xd7, 0x5f, 0xcb, 0xa0,
+	0x2e, 0xf4, 0x36, 0x43, 0x34, 0xc0, 0xae, 0xd8, 0x70, 0x8a, 0x91, 0x8b, 0x6c, 0x87, 0x52, 0x46,
+	0x46, 0x4e, 0x60, 0x0b, 0x86, 0x33, 0x56, 0x24, 0xea, 0xa3, 0x22, 0xaa, 0xd0, 0x49, 0x58, 0x2b,
+	0xf6, 0x3e, 0x15, 0xce, 0x4f, 0xb4, 0xef, 0x2b, 0x87, 0x9f, 0xb7, 0x4a, 0x56, 0x55, 0x98, 0x4e,
+	0xb7, 0x10, 0xfb, 0xa8, 0x53, 0x70, 0x11, 0x1e, 0xc5, 0x09, 0xc8, 0xb8, 0x40, 0xc6, 0xfd, 0x68,
+	0x46, 0x5c, 0xe9, 0x22, 0x31, 0x75, 0xb4, 0x6d, 0x15, 0x2d, 0x2f, 0xcf, 0xc6, 0x20, 0x23, 0xc4,
+	0xb2, 0x31, 0x56, 0xe7, 0xc7, 0x10, 0x2e, 0x93, 0x62, 0xe4, 0xe4, 0xf0, 0x1b, 0x00, 0x19, 0x92,
+	0x9d, 0x9d, 0x6d, 0xf0, 0x35, 0x09, 0x7f, 0x6f, 0x4a, 0x2b, 0x5a, 0xd2, 0x21, 0xd7, 0xe6, 0x15,
+	0x05, 0x92, 0x69, 0xf4, 0x08, 0xec, 0xeb, 0x61, 0x9c, 0x44, 0xc7, 0xc6, 0xfa, 0xe4, 0xcd, 0xc9,
+	0x34, 0xe2, 0x34, 0xae, 0x6d, 0x95, 0x2c, 0xc3, 0x9d, 0xa2, 0x4b, 0x4a, 0x16, 0xf3, 0x4b, 0xa6,
+	0x64, 0x1b, 0x73, 0x4a, 0xa6, 0x09, 0x66, 0xac, 0x64, 0x45, 0x39, 0xec, 0x81, 0x5d, 0x97, 0x0c,
+	0x68, 0x80, 0x22, 0x64, 0xe7, 0x79, 0xcc, 0xd8, 0x94, 0x31, 0xee, 0x4f, 0xe3, 0x1a, 0xed, 0x55,
+	0xa4, 0xb2, 0x1d, 0x77, 0x92, 0x02, 0xfe, 0x56, 0x06, 0xf5, 0xe2, 0x1c, 0xc7, 0x2b, 0xc3, 0x24,
+	0x8c, 0x63, 0x56, 0x64, 0xcc, 0xc7, 0x93, 0x62, 0xa6, 0xf3, 0xaa, 0x57, 0x81, 0x49, 0xa8, 0xc0,
+	0xc5, 0x94, 0x3f, 0x23, 0xae, 0x68, 0xf4, 0xfc, 0x60, 0x17, 0x0d, 0xe1, 0x97, 0x60, 0x99, 0xbf,
+	0x71, 0xa8, 0x4d, 0x86, 0x91, 0x71, 0x63, 0x9c, 0x4a, 0xb3, 0xc7, 0xb7, 0x24, 0xbf, 0x37, 0x0e,
+	0x7d, 0x39, 0x14, 0xab, 0x5a, 0xe2, 0xea, 0x27, 0x6c, 0x83, 0x75, 0xb5, 0x8c, 0x18, 0x06, 0xfe,
+	0x17, 0x98, 0x55, 0x99, 0x9f, 0x86, 0xfa, 0x16, 0xdc, 0x48, 0x4a, 0x9f, 0xc0, 0x6d, 0x49, 0xb8,
+	0x4f, 0x66, 0xc1, 0xc5, 0x95, 0x4f, 0x61, 0x37, 0xdd, 0xbc, 0x08, 0x7e, 0x05, 0x96, 0x38, 0xb5,
+	0xd1, 0x05, 0x8e, 0x8c, 0x6d, 0x4d, 0x92, 0xb3, 0xf2, 0x53, 0x15, 0x3e, 0x65, 0x64, 0x84, 0x3d,
+	0xc4, 0x9e, 0x5f, 0x60, 0x01, 0xba, 0xc8, 0xa9, 0xf8, 0x05, 0x5d, 0x50, 0x49, 0xd3, 0xd4, 0xa0,
+	0x3b, 0x12, 0xf4, 0xb3, 0xf7, 0xca, 0x72, 0x22, 0xf8, 0x46, 0x92, 0xb1, 0x0a, 0x62, 0x81, 0x0a,
+	0xa7, 0xb6, 0xde, 0x6a, 0x9b, 0x32, 0xec, 0x22, 0xe3, 0xa6, 0x0c, 0x72, 0x37, 0xd7, 0x0b, 0x12,
+	0xfa, 0x6b, 0xea, 0x39, 0xc2, 0x33, 0x81, 0xc6, 0x2e, 0x12, 0x98, 0x3c, 0x27, 0x81, 0x3f, 0x80,
+	0x9d, 0xdc, 0xf8, 0xf8, 0x23, 0x5f, 0x0d, 0xcf, 0xae, 0x26, 0x84, 0x39, 0xc3, 0x73, 0x7c, 0x76,
+	0xac, 0x47, 0x07, 0x66, 0x46, 0xe7, 0x78, 0xe4, 0xcb, 0xc1, 0xf1, 0x81, 0x21, 0xd1, 0xf5, 0xa1,
+	0x17, 0x07, 0x91, 0x8c, 0x63, 0xe8, 0xc9, 0x99, 0x16, 0x40, 0x75, 0x63, 0xd2, 0x9c, 0x82, 0x62,
+	0xc4, 0xe4, 0x08, 0x9b, 0xac, 0x0a, 0x09, 0x05, 0x7c, 0x0d, 0x76, 0x34, 0xa9, 0x15, 0xe6, 0xf3,
+	0x96, 0x8c, 0xf2, 0xf1, 0x4c, 0x5e, 0x2b, 0x4e, 0xe7, 0x16, 0x1b, 0x17, 0xc3, 0x2f, 0xd2, 0x0b,
+	0xd6, 0xde, 0xfc, 0x6e, 0x7e, 0xa6, 0x4c, 0xc5, 0x50, 0x68, 0x2f, 0x38, 0x04, 0xfb, 0x1e, 0x0a,
+	0xd0, 0x34, 0x7a, 0xdc, 0x97, 0xa0, 0x9f, 0xce, 0x06, 0x0d, 0xd0, 0x24, 0x0e, 0x14, 0xfc, 0xe8,
+	0x4d, 0xd1, 0xc1, 0x0e, 0xd8, 0x64, 0x48, 0x92, 0xac, 0x9a, 0x1f, 0x1c, 0x1a, 0x07, 0x9a, 0x17,
+	0x67, 0x84, 0xb2, 0x94, 0x8b, 0x18, 0x95, 0x76, 0xd8, 0x2a, 0x59, 0xeb, 0x2c, 0x2b, 0x80, 0x67,
+	0xd9, 0x76, 0xd7, 0xa8, 0xff, 0x1f, 0xaf, 0xf4, 0xac, 0xa1, 0x6c, 0x87, 0xb9, 0x0e, 0x57, 0xb8,
+	0xa7, 0x60, 0xc3, 0x75, 0x42, 0x17, 0x05, 0x09, 0x6a, 0x75, 0xfc, 0x8a, 0x34, 0x86, 0x2a, 0x3d,
+	0x12, 0xcc, 0x35, 0x37, 0xf3, 0x0d, 0x5d, 0x70, 0x53, 0x94, 0xc6, 0x17, 0x2d, 0x91, 0xbb, 0x2a,
+	0x1a, 0xb7, 0xc7, 0x49, 0x24, 0x7b, 0xf9, 0xd2, 0x4e, 0x85, 0xdb, 0xe2, 0xb6, 0x37, 0x41, 0x0e,
+	0x2f, 0x41, 0x35, 0x09, 0x32, 0xa4, 0x5e, 0x1a, 0xc4, 0x16, 0x77, 0x35, 0x14, 0x46, 0x46, 0x4d,
+	0x06, 0x6b, 0xce, 0x09, 0xa6, 0x66, 0x56, 0x81, 0x3e, 0x55, 0x9e, 0xad, 0x92, 0xb5, 0xef, 0x4d,
+	0x57, 0xc3, 0x57, 0x60, 0xab, 0x78, 0x59, 0xb5, 0x47, 0x4d, 0xe3, 0xce, 0xcc, 0x2b, 0x70, 0x7a,
+	0x90, 0x9f, 0x35, 0xc5, 0x51, 0x9e, 0xbf, 0xb1, 0x9e, 0x35, 0xe1, 0xf7, 0x99, 0xaa, 0xe9, 0xa6,
+	0xd5, 0x55, 0xab, 0xcf, 0x04, 0x56, 0x1d, 0x3a, 0x5e, 0xad, 0xac, 0xfc, 0x68, 0x05, 0x2c, 0x31,
+	0xf5, 0xd8, 0xa8, 0xff, 0x02, 0x2a, 0xe9, 0xfb, 0x83, 0x53, 0x12, 0x72, 0x04, 0x9b, 0x60, 0x01,
+	0x31, 0xa6, 0x5f, 0x1c, 0xb5, 0x22, 0x3d, 0xa8, 0xf7, 0xb0, 0x24, 0x88, 0xe7, 0xe2, 0xa7, 0x25,
+	0x8c, 0xc5, 0x5b, 0x4e, 0x1c, 0x1a, 0x4e, 0x34, 0x64, 0x48, 0xbe, 0x2a, 0xd6, 0xac, 0x54, 0x00,
+	0x77, 0xc1, 0x52, 0x74, 0x61, 0xf7, 0x1d, 0xde, 0x97, 0x4f, 0x83, 0x15, 0x6b, 0x31, 0xba, 0x68,
+	0x39, 0xbc, 0xdf, 0xa4, 0xe9, 0xf3, 0xa7, 0x83, 0xd8, 0x48, 0xf0, 0xe1, 0x8f, 0x60, 0x39, 0x16,
+	0xc1, 0xbb, 0x73, 0x2e, 0xcd, 0xfa, 0xc9, 0xb4, 0x77, 0x6f, 0xae, 0x9d, 0x5a, 0x5a, 0xbd, 0x74,
+	0xf4, 0xfa, 0xcf, 0xab, 0x6a, 0xf9, 0xed, 0x55, 0xb5, 0xfc, 0xf7, 0x55, 0xb5, 0xfc, 0xc7, 0xbb,
+	0x6a, 0xe9, 0xed, 0xbb, 0x6a, 0xe9, 0xaf, 0x77, 0xd5, 0xd2, 0x77, 0x2f, 0x7c, 0x1c, 0xf5, 0x87,
+	0xdd, 0x86, 0x4b, 0x06, 0x66, 0x37, 0xec, 0x3e, 0x70, 0xfb, 0x0e, 0x0e, 0xcd, 0xb4, 0xcc, 0x0f,
+	0x74, 0x99, 0x1f, 0x50, 0x7d, 0x48, 0x98, 0x13, 0xff, 0xc4, 0xd0, 0x5d, 0x94, 0xcf, 0xe9, 0x47,
+	0xff, 0x0e, 0x00, 0x16, 0xfd, 0xb7, 0x0c, 0x82, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1763,6 +1777,29 @@ func (m *GfSpSignRequest_SealObjectInfoV2) MarshalToSizedBuffer(dAtA []byte) (in
 	}
 	return len(dAtA) - i, nil
 }
+func (m *GfSpSignRequest_DelegateDeleteObject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GfSpSignRequest_DelegateDeleteObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DelegateDeleteObject != nil {
+		{
+			size, err := m.DelegateDeleteObject.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSign(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x92
+	}
+	return len(dAtA) - i, nil
+}
 func (m *GfSpSignResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2279,6 +2316,18 @@ func (m *GfSpSignRequest_SealObjectInfoV2) Size() (n int) {
 	}
 	return n
 }
+func (m *GfSpSignRequest_DelegateDeleteObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DelegateDeleteObject != nil {
+		l = m.DelegateDeleteObject.Size()
+		n += 2 + l + sovSign(uint64(l))
+	}
+	return n
+}
 func (m *GfSpSignResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3823,6 +3872,41 @@ func (m *GfSpSignRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Request = &GfSpSignRequest_SealObjectInfoV2{v}
 			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateDeleteObject", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSign
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSign
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types1.MsgDeleteObject{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Request = &GfSpSignRequest_DelegateDeleteObject{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSign(dAtA[iNdEx:])
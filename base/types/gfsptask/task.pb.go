@@ -496,6 +496,10 @@ type GfSpUploadObjectTask struct {
 	ObjectInfo           *types.ObjectInfo `protobuf:"bytes,3,opt,name=object_info,json=objectInfo,proto3" json:"object_info,omitempty"`
 	StorageParams        *types.Params     `protobuf:"bytes,4,opt,name=storage_params,json=storageParams,proto3" json:"storage_params,omitempty"`
 	IsAgentUpload        bool              `protobuf:"varint,5,opt,name=is_agent_upload,json=isAgentUpload,proto3" json:"is_agent_upload,omitempty"`
+	// the fields are set if the object is replicated to the secondary SPs while uploading
+	GlobalVirtualGroupId uint32   `protobuf:"varint,6,opt,name=global_virtual_group_id,json=globalVirtualGroupId,proto3" json:"global_virtual_group_id,omitempty"`
+	SecondaryEndpoints   []string `protobuf:"bytes,7,rep,name=secondary_endpoints,json=secondaryEndpoints,proto3" json:"secondary_endpoints,omitempty"`
+	SecondarySignatures  [][]byte `protobuf:"bytes,8,rep,name=secondary_signatures,json=secondarySignatures,proto3" json:"secondary_signatures,omitempty"`
 }
 
 func (m *GfSpUploadObjectTask) Reset()         { *m = GfSpUploadObjectTask{} }
@@ -566,6 +570,27 @@ func (m *GfSpUploadObjectTask) GetIsAgentUpload() bool {
 	return false
 }
 
+func (m *GfSpUploadObjectTask) GetGlobalVirtualGroupId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupId
+	}
+	return 0
+}
+
+func (m *GfSpUploadObjectTask) GetSecondaryEndpoints() []string {
+	if m != nil {
+		return m.SecondaryEndpoints
+	}
+	return nil
+}
+
+func (m *GfSpUploadObjectTask) GetSecondarySignatures() [][]byte {
+	if m != nil {
+		return m.SecondarySignatures
+	}
+	return nil
+}
+
 type GfSpResumableUploadObjectTask struct {
 	Task                 *GfSpTask         `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
 	ObjectInfo           *types.ObjectInfo `protobuf:"bytes,2,opt,name=object_info,json=objectInfo,proto3" json:"object_info,omitempty"`
@@ -2306,156 +2331,156 @@ func init() {
 func init() { proto.RegisterFile("base/types/gfsptask/task.proto", fileDescriptor_0d22df708e229306) }
 
 var fileDescriptor_0d22df708e229306 = []byte{
-	// 2370 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4b, 0x8f, 0x1c, 0x49,
-	0x11, 0xde, 0x9e, 0x7e, 0x47, 0x4f, 0xcf, 0xa3, 0xa6, 0x6d, 0xb7, 0x5f, 0xe3, 0x71, 0xcf, 0xda,
-	0x1a, 0xc3, 0x4e, 0xcf, 0xae, 0x57, 0x16, 0x47, 0x6b, 0x1e, 0xeb, 0x5e, 0x8b, 0xf5, 0x63, 0xab,
-	0x8d, 0x0f, 0x7b, 0xa0, 0x94, 0x5d, 0x95, 0x5d, 0x5d, 0x4c, 0x75, 0x55, 0x91, 0x59, 0xd5, 0x9e,
-	0xf6, 0x95, 0x03, 0x57, 0x84, 0xc4, 0x15, 0xae, 0x08, 0x71, 0x41, 0x20, 0x6e, 0x48, 0x48, 0x48,
-	0xab, 0x15, 0xe2, 0xb0, 0x47, 0x4e, 0x08, 0xd9, 0x27, 0xfe, 0x05, 0xca, 0xc8, 0xac, 0x57, 0xbb,
-	0x67, 0x18, 0xaf, 0x07, 0xb0, 0x11, 0x17, 0xbb, 0x33, 0x22, 0xb2, 0x2a, 0x22, 0xbe, 0x88, 0x2f,
-	0x1f, 0x35, 0xb0, 0x3e, 0x20, 0x9c, 0xee, 0x84, 0xd3, 0x80, 0xf2, 0x1d, 0x7b, 0xc8, 0x83, 0x90,
-	0xf0, 0xc3, 0x1d, 0xf1, 0x4f, 0x37, 0x60, 0x7e, 0xe8, 0x6b, 0x6b, 0x42, 0xdf, 0x45, 0x7d, 0x37,
-	0xd6, 0x5f, 0xba, 0x3e, 0x33, 0x89, 0x32, 0xe6, 0x33, 0xbe, 0x83, 0xff, 0xc9, 0x79, 0x97, 0x2e,
-	0xda, 0x8c, 0x52, 0x6f, 0xe8, 0x50, 0xd7, 0xda, 0xe1, 0x81, 0xb4, 0x55, 0xaa, 0x6b, 0x59, 0x55,
-	0xe8, 0x33, 0x62, 0xd3, 0x9d, 0x80, 0x30, 0x32, 0x8e, 0x0d, 0x2e, 0xcf, 0x31, 0x08, 0x8f, 0x94,
-	0x72, 0x7d, 0x9e, 0x32, 0xf3, 0xf4, 0xcd, 0x8c, 0x7e, 0xe2, 0xb0, 0x30, 0x22, 0xae, 0xcd, 0xfc,
-	0x28, 0xe7, 0x42, 0xe7, 0x4f, 0x0b, 0x50, 0xeb, 0x0d, 0xfb, 0xc1, 0x13, 0xc2, 0x0f, 0xb5, 0x36,
-	0x54, 0x89, 0x65, 0x31, 0xca, 0x79, 0xbb, 0xb0, 0x51, 0xd8, 0xaa, 0xeb, 0xf1, 0x50, 0xbb, 0x06,
-	0x0d, 0x93, 0x51, 0x12, 0x52, 0x23, 0x74, 0xc6, 0xb4, 0xbd, 0xb0, 0x51, 0xd8, 0x2a, 0xea, 0x20,
-	0x45, 0x4f, 0x9c, 0x31, 0x15, 0x06, 0x51, 0x60, 0x25, 0x06, 0x45, 0x69, 0x20, 0x45, 0x68, 0xd0,
-	0x86, 0xaa, 0xd0, 0xf8, 0x51, 0xd8, 0x2e, 0xa1, 0x32, 0x1e, 0x6a, 0x9b, 0xd0, 0x14, 0xb9, 0x34,
-	0x02, 0xe6, 0xf8, 0xcc, 0x09, 0xa7, 0xed, 0xf2, 0x46, 0x61, 0xab, 0xac, 0x2f, 0x0a, 0xe1, 0x63,
-	0x25, 0xd3, 0x5a, 0x50, 0x66, 0x34, 0x64, 0xd3, 0x76, 0x05, 0x27, 0xcb, 0x81, 0x76, 0x19, 0xea,
-	0x63, 0x72, 0x64, 0x48, 0x4d, 0x15, 0x35, 0xb5, 0x31, 0x39, 0xd2, 0x51, 0x79, 0x1d, 0x16, 0x23,
-	0x4e, 0x99, 0x11, 0x87, 0x54, 0xc3, 0x90, 0x1a, 0x42, 0xb6, 0xab, 0xc2, 0xd2, 0xa0, 0xe4, 0xfa,
-	0x36, 0x6f, 0xd7, 0x51, 0x85, 0xbf, 0xb5, 0xdb, 0x50, 0xa4, 0x8c, 0xb5, 0x61, 0xa3, 0xb0, 0xd5,
-	0xb8, 0xbd, 0xd1, 0x9d, 0x41, 0x5d, 0x02, 0xdc, 0x15, 0x29, 0xfb, 0x44, 0xfc, 0xd4, 0x85, 0x71,
-	0xe7, 0xcb, 0x02, 0x5c, 0x11, 0xa2, 0x7d, 0x4c, 0xc8, 0x5e, 0x64, 0x1e, 0xd2, 0x70, 0x37, 0x08,
-	0x98, 0x3f, 0x21, 0x2e, 0x66, 0xf6, 0x23, 0x28, 0x89, 0x70, 0x30, 0xad, 0x8d, 0xdb, 0x57, 0xbb,
-	0x73, 0x6a, 0xa9, 0x1b, 0xc3, 0xa0, 0xa3, 0xa9, 0xf6, 0x39, 0x68, 0x2a, 0xe5, 0x03, 0x7c, 0x9e,
-	0xe1, 0x78, 0x43, 0x1f, 0x33, 0xdf, 0xb8, 0xbd, 0xd9, 0x4d, 0xb1, 0xed, 0x2a, 0xec, 0xbb, 0x0f,
-	0xb8, 0x9d, 0x7d, 0xbf, 0xbe, 0x62, 0x66, 0x46, 0xf7, 0xbd, 0xa1, 0xaf, 0x6d, 0x40, 0x63, 0xe8,
-	0x78, 0x36, 0x65, 0x01, 0x73, 0xbc, 0x10, 0x41, 0x5a, 0xd4, 0xb3, 0xa2, 0xce, 0x2f, 0x0b, 0x70,
-	0x55, 0xf8, 0xf1, 0xc0, 0xb1, 0xd9, 0x99, 0x45, 0xf2, 0x04, 0xd6, 0xc6, 0xf2, 0x79, 0x73, 0x42,
-	0x79, 0xff, 0x98, 0x50, 0x72, 0x1e, 0xe8, 0xab, 0xe3, 0xec, 0x50, 0x04, 0x33, 0x93, 0xf3, 0x47,
-	0x83, 0x1f, 0x50, 0xf3, 0x0c, 0x73, 0xee, 0xe3, 0xf3, 0x4e, 0x9f, 0x73, 0xf9, 0xfe, 0x38, 0xe7,
-	0x72, 0x74, 0xca, 0x9c, 0xff, 0xad, 0x00, 0xef, 0x0b, 0x3f, 0x0e, 0xa8, 0x4b, 0x6d, 0x12, 0xd2,
-	0xb3, 0x0c, 0x88, 0xc0, 0x79, 0x4b, 0x3d, 0xd6, 0xc8, 0x45, 0xa6, 0x82, 0xfa, 0xf6, 0x31, 0x41,
-	0xcd, 0xf3, 0x45, 0x6f, 0x59, 0x73, 0xa4, 0xa7, 0x08, 0xf0, 0x0f, 0x25, 0x58, 0x17, 0x7e, 0xe9,
-	0x34, 0x70, 0x1d, 0x93, 0x84, 0xf4, 0xb1, 0x43, 0x4d, 0xfa, 0xa6, 0xa1, 0xdd, 0x85, 0xc6, 0xab,
-	0x20, 0xad, 0xcf, 0x8b, 0x27, 0x45, 0x43, 0x07, 0x3f, 0x45, 0x66, 0x17, 0x96, 0x94, 0x85, 0x21,
-	0x49, 0x17, 0x7d, 0x6f, 0xdc, 0xbe, 0x34, 0xef, 0x19, 0x8f, 0xd1, 0x42, 0x6f, 0xaa, 0xb1, 0x1c,
-	0x6a, 0x77, 0xe0, 0x82, 0x60, 0x2e, 0x1e, 0x18, 0x7e, 0x40, 0x19, 0x09, 0xfd, 0x94, 0x6d, 0x4a,
-	0x48, 0x29, 0x2d, 0xc2, 0x0f, 0xfb, 0xc1, 0x23, 0xa5, 0x8c, 0x69, 0x67, 0x13, 0x9a, 0x38, 0xcd,
-	0xb1, 0x3d, 0x12, 0x46, 0x8c, 0x22, 0xe3, 0x2d, 0xea, 0x8b, 0xc2, 0x38, 0x96, 0x69, 0x1f, 0x42,
-	0x8b, 0x60, 0x8a, 0xa8, 0x25, 0x5e, 0x40, 0x3d, 0x2b, 0xf0, 0x45, 0x82, 0x2b, 0xf8, 0x60, 0x2d,
-	0xd6, 0xf5, 0x83, 0x4f, 0x94, 0x46, 0xbb, 0x0b, 0x57, 0xb2, 0x33, 0x5e, 0x71, 0xa9, 0x8a, 0x33,
-	0x2f, 0xa6, 0x33, 0x67, 0xfd, 0xda, 0x06, 0x2d, 0x7d, 0x40, 0xe2, 0x5c, 0x0d, 0x9d, 0x5b, 0x4d,
-	0xa6, 0x25, 0x1e, 0xce, 0xbc, 0x8f, 0x28, 0x40, 0x93, 0xf7, 0xd5, 0x67, 0xdf, 0x17, 0x43, 0x1e,
-	0xbf, 0xef, 0x06, 0x2c, 0xd1, 0xa3, 0xc0, 0x61, 0xd4, 0x32, 0x46, 0xd4, 0xb1, 0x47, 0x21, 0xb2,
-	0x6e, 0x49, 0x6f, 0x2a, 0xe9, 0xa7, 0x28, 0xec, 0xfc, 0x7a, 0x01, 0x5a, 0x02, 0xfc, 0xef, 0x05,
-	0xae, 0x4f, 0x2c, 0x89, 0xe6, 0x37, 0xad, 0x9a, 0x3b, 0x70, 0x41, 0xad, 0x85, 0x06, 0x2e, 0x86,
-	0xc6, 0x90, 0x8c, 0x1d, 0x77, 0x6a, 0x38, 0x16, 0x56, 0x50, 0x53, 0x6f, 0x29, 0x75, 0x4f, 0x68,
-	0xef, 0xa1, 0xf2, 0xbe, 0x35, 0x5b, 0x6c, 0xc5, 0x33, 0x28, 0xb6, 0xd2, 0xeb, 0x16, 0xdb, 0x4d,
-	0x58, 0x76, 0xb8, 0x41, 0x6c, 0xea, 0x85, 0x46, 0x84, 0xa9, 0xc0, 0xba, 0xa9, 0xe9, 0x4d, 0x87,
-	0xef, 0x0a, 0xa9, 0xcc, 0x4f, 0xe7, 0x47, 0x45, 0xc9, 0xe1, 0x3a, 0xe5, 0xd1, 0x98, 0x0c, 0x5c,
-	0x7a, 0x16, 0x79, 0x7b, 0x1b, 0xba, 0xed, 0x3c, 0x54, 0xfc, 0xe1, 0x90, 0x53, 0xb9, 0x83, 0x28,
-	0xe9, 0x6a, 0x24, 0xe4, 0x2e, 0xf5, 0xec, 0x70, 0x84, 0xf9, 0x28, 0xe9, 0x6a, 0xa4, 0x5d, 0x81,
-	0xba, 0xe9, 0x8f, 0x03, 0x97, 0x86, 0xd4, 0xc2, 0xb6, 0xa9, 0xe9, 0xa9, 0xe0, 0xa4, 0x4a, 0xa8,
-	0x9e, 0x50, 0x09, 0x73, 0x50, 0xa8, 0xcd, 0x43, 0xe1, 0x67, 0x25, 0x38, 0xff, 0x2a, 0xe9, 0xbd,
-	0xcb, 0xe9, 0xdf, 0x81, 0x35, 0x4e, 0x4d, 0xdf, 0xb3, 0x08, 0x9b, 0xc6, 0x3d, 0x4e, 0x45, 0x1d,
-	0x17, 0x05, 0x1f, 0x25, 0xaa, 0xdd, 0x58, 0xa3, 0x7d, 0x04, 0xad, 0x74, 0x42, 0xc2, 0x27, 0xbc,
-	0x5d, 0xde, 0x28, 0x6e, 0x2d, 0xea, 0xe9, 0xc3, 0x12, 0x46, 0x41, 0x88, 0x39, 0x25, 0x6e, 0x82,
-	0x97, 0x1a, 0x09, 0xb0, 0x6c, 0xd7, 0x1f, 0x10, 0xd7, 0xc8, 0x63, 0x96, 0x82, 0x25, 0xd5, 0x4f,
-	0x33, 0x90, 0xdd, 0xb7, 0xf2, 0x2e, 0xc7, 0x0c, 0x2a, 0x76, 0x82, 0x79, 0x97, 0x63, 0x06, 0x15,
-	0x31, 0xb6, 0x3c, 0x3f, 0x34, 0xc8, 0x84, 0x38, 0xae, 0x68, 0x1d, 0xc1, 0x6b, 0x8e, 0x75, 0x84,
-	0x54, 0x56, 0xd6, 0x57, 0x3d, 0x3f, 0xdc, 0x8d, 0x55, 0xfd, 0xe0, 0xbe, 0x75, 0x24, 0x26, 0xcc,
-	0x94, 0x83, 0x81, 0xd8, 0x02, 0xba, 0xbf, 0x9a, 0xab, 0x09, 0x81, 0x67, 0xe7, 0x17, 0x45, 0x49,
-	0x66, 0x3a, 0x35, 0xfd, 0x09, 0x65, 0xef, 0x7c, 0x55, 0x5c, 0x83, 0x06, 0xa7, 0xf6, 0x58, 0xc4,
-	0x2f, 0x12, 0x55, 0x42, 0x34, 0x40, 0x89, 0x44, 0x86, 0xce, 0x41, 0x85, 0x9a, 0xa8, 0x93, 0xfb,
-	0xfa, 0x32, 0x35, 0x85, 0xf8, 0x2a, 0x40, 0x20, 0x62, 0x37, 0xb8, 0xf3, 0x9c, 0x22, 0xda, 0x25,
-	0xbd, 0x8e, 0x92, 0xbe, 0xf3, 0x9c, 0x8a, 0xde, 0x4d, 0x57, 0xa0, 0x2a, 0xae, 0x40, 0xa9, 0x40,
-	0x68, 0x99, 0xcc, 0x1f, 0x8d, 0xdb, 0x2f, 0x15, 0x88, 0x16, 0x1d, 0x4c, 0x0d, 0x1e, 0x99, 0x26,
-	0xe5, 0xdc, 0x67, 0x06, 0x0f, 0x10, 0xbf, 0x9a, 0xde, 0x1c, 0x4c, 0xfb, 0xb1, 0xb4, 0x1f, 0x08,
-	0xcf, 0xec, 0x89, 0x2d, 0x6a, 0x08, 0xd0, 0xeb, 0xb2, 0x3d, 0xb1, 0xef, 0x5b, 0x9d, 0x3f, 0x96,
-	0x12, 0x84, 0xa8, 0x33, 0xa1, 0xff, 0xfb, 0x08, 0xdd, 0x80, 0x25, 0x46, 0xad, 0xc8, 0xb3, 0x88,
-	0x67, 0x4e, 0x33, 0x48, 0x35, 0x53, 0xe9, 0x7c, 0xc4, 0x8a, 0x59, 0xc4, 0x6e, 0xc0, 0x92, 0x54,
-	0x9b, 0x23, 0x6a, 0x1e, 0xf2, 0x68, 0xac, 0x60, 0x6b, 0xa2, 0x74, 0x5f, 0x09, 0xf3, 0xc0, 0xd6,
-	0x66, 0x81, 0x4d, 0xfb, 0xbf, 0x9e, 0xeb, 0xff, 0x4b, 0x50, 0x1b, 0x3a, 0x9e, 0xc3, 0x47, 0xd4,
-	0x52, 0xad, 0x95, 0x8c, 0x4f, 0xe2, 0x86, 0xc6, 0x09, 0xdc, 0x70, 0x0b, 0x56, 0xd4, 0x69, 0x44,
-	0x9e, 0x2d, 0x1c, 0xdf, 0x6b, 0x2f, 0xe2, 0xa3, 0x97, 0xa5, 0xfc, 0x41, 0x2c, 0x3e, 0xb6, 0xc9,
-	0x9b, 0xc7, 0x35, 0xf9, 0x57, 0x45, 0xd0, 0x44, 0x25, 0xf4, 0x29, 0x71, 0xdf, 0xfd, 0x75, 0xf7,
-	0x3f, 0x41, 0xfc, 0x27, 0x80, 0x58, 0x79, 0x7d, 0x82, 0xaf, 0x9e, 0x44, 0xf0, 0x73, 0xa1, 0xac,
-	0x1d, 0x07, 0xe5, 0xef, 0x17, 0xe4, 0x3a, 0x7e, 0xe0, 0x3f, 0xf3, 0xde, 0x82, 0x6d, 0xd4, 0x5d,
-	0x68, 0x64, 0xcf, 0xd0, 0x27, 0x6c, 0x44, 0xd3, 0xa3, 0xb2, 0x0e, 0x83, 0xf4, 0x0e, 0xe0, 0x0c,
-	0x36, 0xa2, 0x2b, 0x50, 0x74, 0xfd, 0x67, 0x48, 0x12, 0x45, 0x5d, 0xfc, 0xd4, 0x34, 0x28, 0x8d,
-	0x1c, 0x7b, 0xa4, 0x48, 0x01, 0x7f, 0x77, 0x7e, 0x57, 0x84, 0x73, 0xd9, 0xc4, 0xfd, 0x77, 0x79,
-	0xf4, 0x6d, 0xc8, 0xdb, 0x75, 0x58, 0xa4, 0x1e, 0xee, 0x2a, 0x90, 0x22, 0xd5, 0xee, 0xbd, 0x21,
-	0x65, 0x48, 0x90, 0x82, 0x63, 0x43, 0x3f, 0x24, 0x6e, 0x6e, 0x55, 0x44, 0x09, 0x72, 0xec, 0x65,
-	0x90, 0x84, 0x6b, 0x1c, 0xd2, 0xa9, 0x3a, 0xce, 0xd5, 0x50, 0xf0, 0x5d, 0x8a, 0xf7, 0x5d, 0x52,
-	0xa9, 0x36, 0xc9, 0x35, 0x9c, 0xdd, 0x40, 0xd9, 0x23, 0xb9, 0x53, 0x4e, 0x4c, 0xd4, 0x7e, 0xb9,
-	0x9e, 0x31, 0xf9, 0x0c, 0x45, 0x9d, 0x2f, 0x8b, 0xb2, 0xde, 0xf7, 0x47, 0xc4, 0x15, 0x56, 0xf4,
-	0xff, 0xb8, 0xcd, 0x2c, 0xa0, 0xe5, 0x53, 0x2c, 0xa0, 0x95, 0x79, 0x0b, 0xe8, 0x0d, 0x58, 0x72,
-	0xbc, 0x90, 0xda, 0xcc, 0x09, 0xa7, 0xc6, 0x88, 0xf0, 0x51, 0xbc, 0x42, 0x26, 0xd2, 0x4f, 0x09,
-	0x1f, 0xa5, 0xeb, 0x2c, 0x9a, 0xd4, 0x90, 0x33, 0x25, 0xec, 0xa8, 0xbe, 0x09, 0xcb, 0x52, 0x6d,
-	0x91, 0x90, 0xc8, 0x3a, 0xa9, 0x63, 0xdb, 0xc9, 0x85, 0xf6, 0x80, 0x84, 0x44, 0xd4, 0x4a, 0xe7,
-	0xe7, 0x0b, 0xb0, 0x22, 0xd0, 0xe8, 0xed, 0xbf, 0x19, 0x65, 0x7d, 0x00, 0x1a, 0x0f, 0x09, 0x0b,
-	0x8d, 0x81, 0xeb, 0x9b, 0x87, 0x86, 0x17, 0x8d, 0x07, 0x94, 0x21, 0x92, 0x25, 0x7d, 0x05, 0x35,
-	0x7b, 0x42, 0xf1, 0x10, 0xe5, 0xda, 0x16, 0xac, 0x50, 0xcf, 0xca, 0xdb, 0x16, 0xd1, 0x76, 0x89,
-	0x7a, 0x56, 0xd6, 0xf2, 0x43, 0x68, 0x99, 0x11, 0x63, 0x22, 0xab, 0x39, 0x6b, 0x79, 0xb6, 0xd3,
-	0x94, 0x2e, 0x3b, 0xe3, 0x63, 0x38, 0xef, 0x12, 0x1e, 0x1a, 0x16, 0xc5, 0x13, 0x5c, 0x72, 0x47,
-	0x67, 0xa9, 0x73, 0xdf, 0x9a, 0xd0, 0x1e, 0x48, 0xa5, 0x2a, 0x27, 0x4b, 0x6b, 0x43, 0x95, 0x45,
-	0x9e, 0xe7, 0x78, 0xb6, 0x3a, 0x52, 0xc4, 0xc3, 0xce, 0x5f, 0x0a, 0x92, 0xa0, 0x7a, 0xfb, 0x5f,
-	0xf8, 0xe3, 0x81, 0xf3, 0x66, 0x85, 0x9e, 0x79, 0xcd, 0x42, 0xee, 0x35, 0x02, 0x2f, 0x99, 0xbf,
-	0xd4, 0x5d, 0x99, 0x90, 0x26, 0x8a, 0x13, 0x47, 0x3b, 0xd0, 0x14, 0x99, 0x4b, 0xad, 0x64, 0x22,
-	0x1a, 0xd4, 0x4b, 0x83, 0xc9, 0x6e, 0x83, 0xca, 0xf9, 0x6d, 0x50, 0xe7, 0xb7, 0x0b, 0xf2, 0x3e,
-	0xb4, 0xb7, 0xdf, 0x0f, 0x89, 0x4b, 0x9f, 0x52, 0xc6, 0x1d, 0xdf, 0x7b, 0x33, 0xec, 0x2f, 0x43,
-	0x3d, 0xf5, 0x47, 0x42, 0x5e, 0xf3, 0x63, 0x67, 0x6e, 0xc1, 0x4a, 0xb6, 0xea, 0x3d, 0x8b, 0x1e,
-	0x61, 0x64, 0x65, 0x7d, 0x39, 0x53, 0xf7, 0x42, 0x2c, 0xb2, 0x33, 0x91, 0xfe, 0xc4, 0x97, 0xff,
-	0x6a, 0xa8, 0x6d, 0x83, 0x96, 0xf6, 0x44, 0xb2, 0x73, 0x94, 0xf7, 0x61, 0xab, 0x89, 0x26, 0xd9,
-	0x3d, 0x76, 0x61, 0x2d, 0xbf, 0xc9, 0x34, 0x5c, 0x87, 0x87, 0xed, 0x0a, 0x36, 0xc9, 0x6a, 0x6e,
-	0xa7, 0xf9, 0x99, 0xc3, 0x43, 0xd1, 0xba, 0x2a, 0x00, 0x6c, 0x94, 0x2a, 0x86, 0xa0, 0xf8, 0x05,
-	0xbb, 0xe4, 0xc7, 0x05, 0x58, 0x92, 0x59, 0x7b, 0x40, 0x43, 0xf2, 0x4d, 0xf3, 0x74, 0x0d, 0x1a,
-	0x71, 0x2d, 0x8b, 0xee, 0x97, 0x99, 0x02, 0x25, 0x12, 0xad, 0x7f, 0x1d, 0x16, 0x65, 0xd5, 0x1a,
-	0xa6, 0x1f, 0xa9, 0x5b, 0xd2, 0x92, 0xde, 0x90, 0xb2, 0x7d, 0x21, 0xea, 0xfc, 0xb4, 0x24, 0xf7,
-	0x8c, 0xea, 0xe2, 0xbb, 0xf7, 0xb4, 0xf7, 0x06, 0xa8, 0xc5, 0x9c, 0x99, 0xa0, 0xa6, 0x18, 0xd1,
-	0xd2, 0x0e, 0xa0, 0xca, 0x99, 0x69, 0xd8, 0x13, 0x5b, 0x91, 0x69, 0xee, 0x0a, 0x38, 0xfb, 0x9d,
-	0xa8, 0xdb, 0x7b, 0x65, 0xc7, 0xa5, 0x57, 0x38, 0x33, 0x7b, 0x13, 0x5b, 0xbb, 0x07, 0x35, 0x8b,
-	0xf2, 0x10, 0x1f, 0x53, 0x7a, 0xfd, 0xc7, 0x54, 0xc5, 0x64, 0xf1, 0x9c, 0x53, 0x1e, 0x3d, 0xee,
-	0x80, 0x78, 0xb1, 0x38, 0xc8, 0x55, 0xe6, 0x2c, 0x00, 0x41, 0xb7, 0xaf, 0xf8, 0x9a, 0xf9, 0x13,
-	0xc7, 0xa2, 0x4c, 0x2f, 0x73, 0x66, 0xf6, 0x03, 0xb1, 0xa9, 0x44, 0xc2, 0x50, 0x1f, 0x0f, 0xb2,
-	0xcd, 0x25, 0x2b, 0xa1, 0x25, 0xd4, 0x2a, 0xe1, 0xf3, 0xbb, 0xac, 0x36, 0x73, 0xd8, 0xb8, 0x06,
-	0x0d, 0x79, 0x39, 0x29, 0xbf, 0x73, 0x49, 0xe6, 0x05, 0x29, 0xc2, 0xef, 0x5c, 0xb9, 0xf3, 0x0d,
-	0xcc, 0x9e, 0x6f, 0xba, 0xc9, 0xa7, 0x10, 0xcb, 0x18, 0x4c, 0x43, 0xca, 0x65, 0x5d, 0x36, 0xd0,
-	0x9b, 0xf8, 0x23, 0x87, 0xb5, 0x27, 0x34, 0x58, 0x9e, 0xff, 0x50, 0x57, 0x9f, 0xca, 0xc7, 0x77,
-	0xfe, 0x2c, 0x2a, 0xc8, 0x10, 0x81, 0x4c, 0xef, 0xb3, 0xe5, 0x45, 0x79, 0x13, 0x11, 0x4b, 0xae,
-	0xb2, 0xcf, 0x6a, 0xc9, 0xfd, 0x16, 0xac, 0x3a, 0xdc, 0xc8, 0x9d, 0xf3, 0x24, 0x0b, 0xd4, 0xf4,
-	0x65, 0x87, 0xef, 0x65, 0xce, 0x79, 0xb4, 0xf3, 0xab, 0x05, 0xb8, 0x28, 0xa9, 0x60, 0x2f, 0x7f,
-	0xfe, 0xfb, 0xb7, 0xf4, 0xe1, 0x2d, 0x58, 0xc5, 0xda, 0xb4, 0xcd, 0x57, 0x16, 0x86, 0x25, 0xa1,
-	0xe8, 0x99, 0x49, 0x3d, 0x6e, 0xc2, 0x52, 0x6c, 0xaa, 0xee, 0x2b, 0xd4, 0xd2, 0x20, 0xed, 0x7a,
-	0x13, 0xfb, 0xe4, 0xa5, 0x41, 0x2c, 0x2d, 0x72, 0x57, 0x29, 0xa6, 0x7b, 0xd1, 0x58, 0x6d, 0x2c,
-	0x1b, 0x28, 0xec, 0x4d, 0xec, 0x87, 0xd1, 0x58, 0xdb, 0x86, 0x35, 0xdb, 0x34, 0xe2, 0x29, 0x89,
-	0xa5, 0xec, 0x93, 0x15, 0xdb, 0xbc, 0xa7, 0x34, 0xd2, 0xbc, 0xf3, 0x9b, 0x05, 0xb8, 0x20, 0xc2,
-	0x9d, 0x49, 0x15, 0xd6, 0x49, 0x2e, 0xee, 0xc2, 0x4c, 0xdc, 0x59, 0x3f, 0x17, 0x66, 0xfc, 0x3c,
-	0xa6, 0x3b, 0x8a, 0xc7, 0x74, 0x87, 0xf6, 0x1d, 0x40, 0x22, 0x11, 0xbc, 0x50, 0x3a, 0x15, 0x2f,
-	0x54, 0x84, 0x39, 0x12, 0x43, 0xcc, 0x27, 0xe5, 0xd7, 0xe1, 0x93, 0x99, 0xe6, 0xaf, 0x9c, 0xdc,
-	0xfc, 0xb3, 0xb7, 0x56, 0x9d, 0x3f, 0x17, 0x61, 0x2d, 0xcd, 0xd9, 0xe7, 0x91, 0x1f, 0x92, 0x7f,
-	0x9d, 0xaf, 0x16, 0x94, 0xc7, 0xbe, 0x17, 0x8e, 0x30, 0x59, 0x75, 0x5d, 0x0e, 0x84, 0x27, 0x6a,
-	0x8a, 0x47, 0xd4, 0xe7, 0xf6, 0x7a, 0xbc, 0xed, 0x7d, 0x48, 0xc6, 0x54, 0xec, 0xda, 0x18, 0x25,
-	0x96, 0x61, 0xfa, 0x1e, 0x8f, 0xc6, 0xf8, 0x3d, 0xe7, 0x39, 0x55, 0x75, 0xb3, 0x22, 0x34, 0xfb,
-	0x4a, 0xa1, 0x12, 0xd9, 0x1e, 0x32, 0x4a, 0x8d, 0x1f, 0x0a, 0x9f, 0x66, 0xe6, 0xc8, 0xbd, 0xd5,
-	0x39, 0xa1, 0x47, 0x97, 0x73, 0x13, 0x6f, 0xc2, 0x72, 0x66, 0x62, 0xe6, 0xd0, 0xd2, 0x4c, 0xec,
-	0xd1, 0xee, 0x03, 0xd0, 0xcc, 0x11, 0x61, 0x36, 0xb5, 0xb2, 0xa6, 0xaa, 0xb8, 0x94, 0x26, 0xb5,
-	0xde, 0x84, 0x26, 0x71, 0x5d, 0xff, 0x59, 0xd2, 0xb1, 0x92, 0x85, 0x17, 0x51, 0xa8, 0xda, 0x55,
-	0x90, 0x3b, 0xe6, 0xc2, 0x9d, 0x1a, 0xb3, 0x2e, 0xc8, 0x63, 0x4d, 0x4b, 0xa9, 0xef, 0xe5, 0x3c,
-	0xb9, 0x07, 0x1b, 0x73, 0xa6, 0xe5, 0x43, 0x96, 0x5f, 0xa1, 0xae, 0xcc, 0xce, 0xcf, 0x46, 0xbe,
-	0xf7, 0xfd, 0xaf, 0x5e, 0xac, 0x17, 0xbe, 0x7e, 0xb1, 0x5e, 0xf8, 0xfb, 0x8b, 0xf5, 0xc2, 0x4f,
-	0x5e, 0xae, 0xbf, 0xf7, 0xf5, 0xcb, 0xf5, 0xf7, 0xfe, 0xfa, 0x72, 0xfd, 0xbd, 0x2f, 0x0e, 0x6c,
-	0x27, 0x1c, 0x45, 0x83, 0xae, 0xe9, 0x8f, 0x77, 0x06, 0xde, 0x60, 0xdb, 0x1c, 0x11, 0xc7, 0xdb,
-	0x49, 0x0b, 0x6c, 0x5b, 0x51, 0xe2, 0x76, 0xa0, 0xca, 0x6b, 0x67, 0xce, 0x5f, 0x9e, 0x0c, 0x2a,
-	0xf8, 0xf7, 0x19, 0x1f, 0xff, 0x33, 0x00, 0x00, 0xff, 0xff, 0x11, 0xcd, 0x60, 0x46, 0x97, 0x22,
-	0x00, 0x00,
+	// 2381 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4b, 0x6f, 0x1b, 0xc9,
+	0x11, 0x36, 0x45, 0x52, 0x22, 0x8b, 0xa2, 0x1e, 0x23, 0xda, 0xa6, 0x5f, 0xb2, 0x4c, 0xaf, 0x0d,
+	0x39, 0x59, 0x53, 0xbb, 0x5e, 0x18, 0x39, 0x1a, 0x7a, 0xac, 0xb9, 0x46, 0xd6, 0x8f, 0x1d, 0x3a,
+	0x3e, 0xec, 0x21, 0x83, 0xe6, 0x4c, 0x6b, 0x38, 0xd1, 0x70, 0x66, 0x32, 0x3d, 0xa4, 0x25, 0x5f,
+	0x73, 0xc8, 0x35, 0x08, 0x90, 0x6b, 0x72, 0x0d, 0x72, 0x0b, 0x12, 0xe4, 0x16, 0x20, 0x40, 0x80,
+	0xcd, 0x22, 0xc8, 0x61, 0x8f, 0x39, 0x05, 0x81, 0x7d, 0xca, 0xbf, 0x08, 0xaa, 0xba, 0xe7, 0x29,
+	0x4a, 0x91, 0xd7, 0xca, 0xc6, 0x0e, 0xf6, 0x62, 0xb3, 0xab, 0xaa, 0x7b, 0xea, 0xf1, 0x55, 0x75,
+	0x57, 0xb7, 0x60, 0x75, 0xc0, 0x04, 0xdf, 0x88, 0x0e, 0x02, 0x2e, 0x36, 0xec, 0x5d, 0x11, 0x44,
+	0x4c, 0xec, 0x6d, 0xe0, 0x3f, 0xdd, 0x20, 0xf4, 0x23, 0x5f, 0x5b, 0x41, 0x7e, 0x97, 0xf8, 0xdd,
+	0x98, 0x7f, 0xf1, 0x5a, 0x61, 0x12, 0x0f, 0x43, 0x3f, 0x14, 0x1b, 0xf4, 0x9f, 0x9c, 0x77, 0xf1,
+	0x82, 0x1d, 0x72, 0xee, 0xed, 0x3a, 0xdc, 0xb5, 0x36, 0x44, 0x20, 0x65, 0x15, 0xeb, 0x6a, 0x96,
+	0x15, 0xf9, 0x21, 0xb3, 0xf9, 0x46, 0xc0, 0x42, 0x36, 0x8a, 0x05, 0x2e, 0x4d, 0x11, 0x88, 0xf6,
+	0x15, 0x73, 0x75, 0x1a, 0x33, 0xb3, 0xfa, 0xf5, 0x0c, 0x7f, 0xe2, 0x84, 0xd1, 0x98, 0xb9, 0x76,
+	0xe8, 0x8f, 0x73, 0x2a, 0x74, 0xfe, 0x3c, 0x03, 0xb5, 0xde, 0x6e, 0x3f, 0x78, 0xca, 0xc4, 0x9e,
+	0xd6, 0x86, 0x39, 0x66, 0x59, 0x21, 0x17, 0xa2, 0x5d, 0x5a, 0x2b, 0xad, 0xd7, 0xf5, 0x78, 0xa8,
+	0x5d, 0x85, 0x86, 0x19, 0x72, 0x16, 0x71, 0x23, 0x72, 0x46, 0xbc, 0x3d, 0xb3, 0x56, 0x5a, 0x2f,
+	0xeb, 0x20, 0x49, 0x4f, 0x9d, 0x11, 0x47, 0x81, 0x71, 0x60, 0x25, 0x02, 0x65, 0x29, 0x20, 0x49,
+	0x24, 0xd0, 0x86, 0x39, 0xe4, 0xf8, 0xe3, 0xa8, 0x5d, 0x21, 0x66, 0x3c, 0xd4, 0xae, 0x43, 0x13,
+	0x7d, 0x69, 0x04, 0xa1, 0xe3, 0x87, 0x4e, 0x74, 0xd0, 0xae, 0xae, 0x95, 0xd6, 0xab, 0xfa, 0x3c,
+	0x12, 0x9f, 0x28, 0x9a, 0xd6, 0x82, 0x6a, 0xc8, 0xa3, 0xf0, 0xa0, 0x3d, 0x4b, 0x93, 0xe5, 0x40,
+	0xbb, 0x04, 0xf5, 0x11, 0xdb, 0x37, 0x24, 0x67, 0x8e, 0x38, 0xb5, 0x11, 0xdb, 0xd7, 0x89, 0x79,
+	0x0d, 0xe6, 0xc7, 0x82, 0x87, 0x46, 0x6c, 0x52, 0x8d, 0x4c, 0x6a, 0x20, 0x6d, 0x53, 0x99, 0xa5,
+	0x41, 0xc5, 0xf5, 0x6d, 0xd1, 0xae, 0x13, 0x8b, 0x7e, 0x6b, 0x77, 0xa0, 0xcc, 0xc3, 0xb0, 0x0d,
+	0x6b, 0xa5, 0xf5, 0xc6, 0x9d, 0xb5, 0x6e, 0x21, 0xea, 0x32, 0xc0, 0x5d, 0x74, 0xd9, 0xc7, 0xf8,
+	0x53, 0x47, 0xe1, 0xce, 0x17, 0x25, 0xb8, 0x8c, 0xa4, 0x6d, 0x72, 0xc8, 0xd6, 0xd8, 0xdc, 0xe3,
+	0xd1, 0x66, 0x10, 0x84, 0xfe, 0x84, 0xb9, 0xe4, 0xd9, 0x0f, 0xa1, 0x82, 0xe6, 0x90, 0x5b, 0x1b,
+	0x77, 0xae, 0x74, 0xa7, 0x60, 0xa9, 0x1b, 0x87, 0x41, 0x27, 0x51, 0xed, 0x33, 0xd0, 0x94, 0xcb,
+	0x07, 0xb4, 0x9e, 0xe1, 0x78, 0xbb, 0x3e, 0x79, 0xbe, 0x71, 0xe7, 0x7a, 0x37, 0x8d, 0x6d, 0x57,
+	0xc5, 0xbe, 0xfb, 0x50, 0xd8, 0xd9, 0xef, 0xeb, 0x4b, 0x66, 0x66, 0xf4, 0xc0, 0xdb, 0xf5, 0xb5,
+	0x35, 0x68, 0xec, 0x3a, 0x9e, 0xcd, 0xc3, 0x20, 0x74, 0xbc, 0x88, 0x82, 0x34, 0xaf, 0x67, 0x49,
+	0x9d, 0x5f, 0x97, 0xe0, 0x0a, 0xea, 0xf1, 0xd0, 0xb1, 0xc3, 0x53, 0xb3, 0xe4, 0x29, 0xac, 0x8c,
+	0xe4, 0x7a, 0x53, 0x4c, 0x79, 0xef, 0x08, 0x53, 0x72, 0x1a, 0xe8, 0xcb, 0xa3, 0xec, 0x10, 0x8d,
+	0x29, 0xf8, 0xfc, 0xf1, 0xe0, 0x47, 0xdc, 0x3c, 0x45, 0x9f, 0xfb, 0xb4, 0xde, 0xc9, 0x7d, 0x2e,
+	0xbf, 0x1f, 0xfb, 0x5c, 0x8e, 0x4e, 0xe8, 0xf3, 0x7f, 0x94, 0xe0, 0x3d, 0xd4, 0x63, 0x87, 0xbb,
+	0xdc, 0x66, 0x11, 0x3f, 0x4d, 0x83, 0x18, 0x9c, 0xb3, 0xd4, 0xb2, 0x46, 0xce, 0x32, 0x65, 0xd4,
+	0x77, 0x8f, 0x30, 0x6a, 0x9a, 0x2e, 0x7a, 0xcb, 0x9a, 0x42, 0x3d, 0x81, 0x81, 0x7f, 0xac, 0xc0,
+	0x2a, 0xea, 0xa5, 0xf3, 0xc0, 0x75, 0x4c, 0x16, 0xf1, 0x27, 0x0e, 0x37, 0xf9, 0x9b, 0x9a, 0x76,
+	0x0f, 0x1a, 0x87, 0x83, 0xb4, 0x3a, 0xcd, 0x9e, 0x34, 0x1a, 0x3a, 0xf8, 0x69, 0x64, 0x36, 0x61,
+	0x41, 0x49, 0x18, 0xb2, 0xe8, 0x92, 0xee, 0x8d, 0x3b, 0x17, 0xa7, 0xad, 0xf1, 0x84, 0x24, 0xf4,
+	0xa6, 0x1a, 0xcb, 0xa1, 0x76, 0x17, 0xce, 0x63, 0xe5, 0x12, 0x81, 0xe1, 0x07, 0x3c, 0x64, 0x91,
+	0x9f, 0x56, 0x9b, 0x0a, 0x95, 0x94, 0x16, 0x13, 0x7b, 0xfd, 0xe0, 0xb1, 0x62, 0xc6, 0x65, 0xe7,
+	0x3a, 0x34, 0x69, 0x9a, 0x63, 0x7b, 0x2c, 0x1a, 0x87, 0x9c, 0x2a, 0xde, 0xbc, 0x3e, 0x8f, 0xc2,
+	0x31, 0x4d, 0xfb, 0x00, 0x5a, 0x8c, 0x5c, 0xc4, 0x2d, 0xfc, 0x00, 0xf7, 0xac, 0xc0, 0x47, 0x07,
+	0xcf, 0xd2, 0xc2, 0x5a, 0xcc, 0xeb, 0x07, 0x1f, 0x2b, 0x8e, 0x76, 0x0f, 0x2e, 0x67, 0x67, 0x1c,
+	0x52, 0x69, 0x8e, 0x66, 0x5e, 0x48, 0x67, 0x16, 0xf5, 0xba, 0x0d, 0x5a, 0xba, 0x40, 0xa2, 0x5c,
+	0x8d, 0x94, 0x5b, 0x4e, 0xa6, 0x25, 0x1a, 0x16, 0xbe, 0xc7, 0x54, 0x40, 0x93, 0xef, 0xd5, 0x8b,
+	0xdf, 0x8b, 0x43, 0x1e, 0x7f, 0xef, 0x06, 0x2c, 0xf0, 0xfd, 0xc0, 0x09, 0xb9, 0x65, 0x0c, 0xb9,
+	0x63, 0x0f, 0x23, 0xaa, 0xba, 0x15, 0xbd, 0xa9, 0xa8, 0x9f, 0x10, 0xb1, 0xf3, 0x97, 0x32, 0xb4,
+	0x30, 0xf8, 0x3f, 0x08, 0x5c, 0x9f, 0x59, 0x32, 0x9a, 0x5f, 0x17, 0x35, 0x77, 0xe1, 0xbc, 0xda,
+	0x0b, 0x0d, 0xda, 0x0c, 0x8d, 0x5d, 0x36, 0x72, 0xdc, 0x03, 0xc3, 0xb1, 0x08, 0x41, 0x4d, 0xbd,
+	0xa5, 0xd8, 0x3d, 0xe4, 0xde, 0x27, 0xe6, 0x03, 0xab, 0x08, 0xb6, 0xf2, 0x29, 0x80, 0xad, 0xf2,
+	0xba, 0x60, 0xbb, 0x09, 0x8b, 0x8e, 0x30, 0x98, 0xcd, 0xbd, 0xc8, 0x18, 0x93, 0x2b, 0x08, 0x37,
+	0x35, 0xbd, 0xe9, 0x88, 0x4d, 0xa4, 0x4a, 0xff, 0xa0, 0x89, 0xb6, 0xeb, 0x0f, 0x98, 0x6b, 0xe4,
+	0x2d, 0x75, 0x2c, 0xc2, 0x4e, 0x53, 0x6f, 0x49, 0xf6, 0xb3, 0x8c, 0xa1, 0x0f, 0x2c, 0x6d, 0x03,
+	0x56, 0x04, 0x37, 0x7d, 0xcf, 0x62, 0xe1, 0x41, 0x82, 0x36, 0x04, 0x4d, 0x19, 0xe1, 0x96, 0xb0,
+	0x62, 0xb4, 0x09, 0xed, 0x43, 0x68, 0xa5, 0x13, 0x12, 0xb8, 0xe0, 0x3e, 0x5b, 0x5e, 0x9f, 0xd7,
+	0xd3, 0xc5, 0x12, 0xc0, 0x88, 0xce, 0x4f, 0xca, 0x72, 0x7b, 0xd1, 0xb9, 0x18, 0x8f, 0xd8, 0xc0,
+	0xe5, 0xa7, 0x11, 0xd2, 0xb7, 0xa1, 0x10, 0x9c, 0x83, 0x59, 0x7f, 0x77, 0x57, 0x70, 0x79, 0xb8,
+	0xa9, 0xe8, 0x6a, 0x84, 0x74, 0x97, 0x7b, 0x76, 0x34, 0xa4, 0x50, 0x55, 0x74, 0x35, 0xd2, 0x2e,
+	0x43, 0xdd, 0xf4, 0x47, 0x81, 0xcb, 0x23, 0x2e, 0xa3, 0x52, 0xd3, 0x53, 0xc2, 0x71, 0x20, 0x9d,
+	0x3b, 0x06, 0xa4, 0x53, 0x00, 0x52, 0x9b, 0x02, 0x90, 0xce, 0x2f, 0x2a, 0x70, 0xee, 0x70, 0x3d,
+	0x7e, 0x97, 0xdd, 0x9f, 0xc3, 0xae, 0x2a, 0x3f, 0x1c, 0x53, 0x2c, 0x8f, 0xdd, 0xcd, 0x98, 0x73,
+	0x24, 0x76, 0xab, 0x47, 0x62, 0x17, 0x43, 0x29, 0x38, 0x73, 0x93, 0x78, 0xa9, 0xd1, 0x71, 0xe9,
+	0x36, 0xf7, 0xfa, 0xe9, 0x56, 0x3b, 0x32, 0xdd, 0x36, 0xa0, 0xe5, 0xf9, 0x91, 0xc1, 0x26, 0xcc,
+	0x71, 0x31, 0x75, 0xb0, 0xe4, 0x3a, 0xd6, 0x3e, 0x55, 0xd9, 0xaa, 0xbe, 0xec, 0xf9, 0xd1, 0x66,
+	0xcc, 0xea, 0x07, 0x0f, 0xac, 0x7d, 0x9c, 0x50, 0x80, 0x83, 0x41, 0xb1, 0x05, 0x52, 0x7f, 0x39,
+	0x87, 0x09, 0x8c, 0x67, 0xe7, 0x57, 0xaa, 0xce, 0xea, 0xdc, 0xf4, 0x27, 0x3c, 0x7c, 0xe7, 0x51,
+	0x71, 0x15, 0x1a, 0x82, 0xdb, 0x23, 0xb4, 0x1f, 0x1d, 0x55, 0xa1, 0x68, 0x80, 0x22, 0xa1, 0x87,
+	0xce, 0xc2, 0x2c, 0x37, 0x89, 0x27, 0x5b, 0x8e, 0x2a, 0x37, 0x91, 0x7c, 0x05, 0x20, 0x40, 0xdb,
+	0x0d, 0xe1, 0xbc, 0xe0, 0x14, 0xed, 0x8a, 0x5e, 0x27, 0x4a, 0xdf, 0x79, 0xc1, 0x31, 0x77, 0xd3,
+	0xcd, 0x71, 0x8e, 0x36, 0xc7, 0x94, 0x80, 0xdc, 0x50, 0xfa, 0x8f, 0xc7, 0xe9, 0x97, 0x12, 0x30,
+	0x45, 0x07, 0x07, 0x86, 0x18, 0x9b, 0x26, 0x17, 0xc2, 0x0f, 0x0d, 0x11, 0x50, 0xfc, 0x6a, 0x7a,
+	0x73, 0x70, 0xd0, 0x8f, 0xa9, 0xfd, 0x00, 0x35, 0xb3, 0x27, 0x36, 0x62, 0x08, 0x48, 0xeb, 0xaa,
+	0x3d, 0xb1, 0x1f, 0x58, 0x9d, 0x3f, 0x55, 0x92, 0x08, 0x71, 0x67, 0xc2, 0xff, 0xff, 0x23, 0x74,
+	0x03, 0x16, 0x42, 0x6e, 0x8d, 0x3d, 0x8b, 0x79, 0xe6, 0x41, 0x26, 0x52, 0xcd, 0x94, 0x3a, 0x3d,
+	0x62, 0xe5, 0x6c, 0xc4, 0x6e, 0xc0, 0x82, 0x64, 0x9b, 0x43, 0x6e, 0xee, 0x89, 0xf1, 0x48, 0x85,
+	0xad, 0x49, 0xd4, 0x6d, 0x45, 0xcc, 0x07, 0xb6, 0x56, 0x0c, 0x6c, 0x9a, 0xff, 0xf5, 0x5c, 0xfe,
+	0x5f, 0x84, 0xda, 0xae, 0xe3, 0x39, 0x62, 0xc8, 0x2d, 0x95, 0x5a, 0xc9, 0xf8, 0xb8, 0xda, 0xd0,
+	0x38, 0xa6, 0x36, 0xdc, 0x82, 0x25, 0xd5, 0x28, 0xc9, 0xb6, 0xc7, 0xf1, 0xbd, 0xf6, 0x3c, 0x2d,
+	0xbd, 0x28, 0xe9, 0x0f, 0x63, 0xf2, 0x91, 0x49, 0xde, 0x3c, 0x2a, 0xc9, 0xbf, 0x2c, 0x83, 0x86,
+	0x48, 0xe8, 0x73, 0xe6, 0xbe, 0xfb, 0xfb, 0xee, 0x37, 0x51, 0xf8, 0xbf, 0xa9, 0xf3, 0xd4, 0x51,
+	0xa1, 0xac, 0x1d, 0x15, 0xca, 0x3f, 0xcc, 0xc8, 0x7d, 0x7c, 0xc7, 0x7f, 0xee, 0xbd, 0x05, 0xc7,
+	0xa8, 0x7b, 0xd0, 0xc8, 0xb6, 0xf7, 0xc7, 0x9c, 0x91, 0xd3, 0x2e, 0x5e, 0x87, 0x41, 0xf2, 0xfb,
+	0x34, 0xce, 0xc8, 0x4b, 0x50, 0x76, 0xfd, 0xe7, 0x54, 0x24, 0xca, 0x3a, 0xfe, 0xc4, 0x2b, 0x9e,
+	0xa1, 0x63, 0x0f, 0x55, 0x51, 0xa0, 0xdf, 0x9d, 0xdf, 0x97, 0xe1, 0x6c, 0xd6, 0x71, 0xff, 0xdb,
+	0x3a, 0xfa, 0x36, 0xf8, 0xed, 0x1a, 0xcc, 0x73, 0x8f, 0x4e, 0x15, 0x54, 0x22, 0x55, 0x63, 0xd1,
+	0x90, 0x34, 0x2a, 0x90, 0x58, 0x63, 0x23, 0x3f, 0x62, 0x6e, 0x6e, 0x57, 0x24, 0x0a, 0xd5, 0xd8,
+	0x4b, 0x20, 0x0b, 0xae, 0xb1, 0xc7, 0x0f, 0x54, 0xa7, 0x59, 0x23, 0xc2, 0xf7, 0x39, 0x5d, 0xc5,
+	0x49, 0xa6, 0x3a, 0x24, 0xd7, 0x68, 0x76, 0x83, 0x68, 0x8f, 0x89, 0x94, 0x8a, 0xa8, 0xf3, 0x72,
+	0x3d, 0x23, 0xf2, 0x29, 0x91, 0x3a, 0x5f, 0x94, 0x25, 0xde, 0xb7, 0x87, 0xcc, 0x45, 0x29, 0xfe,
+	0x6d, 0xdc, 0x0a, 0x1b, 0x68, 0xf5, 0x04, 0x1b, 0xe8, 0xec, 0xb4, 0x0d, 0xf4, 0x06, 0x2c, 0x38,
+	0x5e, 0xc4, 0x6d, 0xbc, 0x6b, 0x35, 0x86, 0x4c, 0x0c, 0xe3, 0x1d, 0x32, 0xa1, 0x7e, 0xc2, 0xc4,
+	0x30, 0xdd, 0x67, 0x49, 0x44, 0x36, 0x7a, 0x32, 0xec, 0xc4, 0xbe, 0x09, 0x8b, 0x92, 0x6d, 0xb1,
+	0x88, 0x49, 0x9c, 0xd4, 0x29, 0xed, 0xe4, 0x46, 0xbb, 0xc3, 0x22, 0x86, 0x58, 0xe9, 0xfc, 0x72,
+	0x06, 0x96, 0x30, 0x1a, 0xbd, 0xed, 0x37, 0x2b, 0x59, 0xef, 0x83, 0x26, 0x22, 0x16, 0x46, 0xc6,
+	0xc0, 0xf5, 0xcd, 0x3d, 0xc3, 0x1b, 0x8f, 0x06, 0x3c, 0xa4, 0x48, 0x56, 0xf4, 0x25, 0xe2, 0x6c,
+	0x21, 0xe3, 0x11, 0xd1, 0xb5, 0x75, 0x58, 0xe2, 0x9e, 0x95, 0x97, 0x2d, 0x93, 0xec, 0x02, 0xf7,
+	0xac, 0xac, 0xe4, 0x07, 0xd0, 0x32, 0xc7, 0x61, 0x88, 0x5e, 0xcd, 0x49, 0xcb, 0xde, 0x4e, 0x53,
+	0xbc, 0xec, 0x8c, 0x8f, 0xe0, 0x9c, 0xcb, 0x44, 0x64, 0xe0, 0x0d, 0x59, 0xc4, 0xad, 0xe4, 0xfa,
+	0xd0, 0x52, 0x7d, 0xdf, 0x0a, 0x72, 0x77, 0x24, 0x53, 0xc1, 0xc9, 0xc2, 0x2b, 0xf1, 0x70, 0xec,
+	0x79, 0x8e, 0x67, 0xab, 0x96, 0x22, 0x1e, 0x76, 0xfe, 0x56, 0x92, 0x05, 0xaa, 0xb7, 0xfd, 0xb9,
+	0x3f, 0x1a, 0x38, 0x6f, 0x06, 0xf4, 0xcc, 0x67, 0x66, 0x72, 0x9f, 0xc1, 0x78, 0x49, 0xff, 0xa5,
+	0xea, 0x4a, 0x87, 0x34, 0x89, 0x9c, 0x28, 0xda, 0x81, 0x26, 0x7a, 0x2e, 0x95, 0x92, 0x8e, 0x68,
+	0x70, 0x2f, 0x35, 0x26, 0x7b, 0x0c, 0xaa, 0xe6, 0x8f, 0x41, 0x9d, 0xdf, 0xcd, 0xc8, 0xab, 0xda,
+	0xde, 0x76, 0x3f, 0x62, 0x2e, 0x7f, 0xc6, 0x43, 0xe1, 0xf8, 0xde, 0x9b, 0xc5, 0xfe, 0x12, 0xd4,
+	0x53, 0x7d, 0x64, 0xc8, 0x6b, 0x7e, 0xac, 0xcc, 0x2d, 0x58, 0xca, 0xa2, 0xde, 0xb3, 0xf8, 0x3e,
+	0x59, 0x56, 0xd5, 0x17, 0x33, 0xb8, 0x47, 0x32, 0x7a, 0x67, 0x22, 0xf5, 0x89, 0xdf, 0x25, 0xd4,
+	0x10, 0x6f, 0xc3, 0xd2, 0x9c, 0x48, 0x4e, 0x8e, 0xf2, 0xaa, 0x6e, 0x39, 0xe1, 0x24, 0xa7, 0xc7,
+	0x2e, 0xac, 0xe4, 0x0f, 0x99, 0x86, 0xeb, 0x08, 0xbc, 0xae, 0xc3, 0x24, 0x59, 0xce, 0x9d, 0x34,
+	0x3f, 0x75, 0x44, 0x84, 0xa9, 0xab, 0x0c, 0xa0, 0x44, 0x99, 0x23, 0x13, 0x54, 0x7d, 0xa1, 0x2c,
+	0xf9, 0x69, 0x09, 0x16, 0xa4, 0xd7, 0x1e, 0xf2, 0x88, 0x7d, 0x5d, 0x3f, 0xe1, 0xcb, 0x8d, 0xc2,
+	0x32, 0x66, 0xbf, 0xf4, 0x14, 0x28, 0x12, 0xa6, 0xfe, 0x35, 0x98, 0x97, 0xa8, 0x35, 0x4c, 0x7f,
+	0xac, 0x2e, 0x70, 0x2b, 0x7a, 0x43, 0xd2, 0xb6, 0x91, 0xd4, 0xf9, 0x79, 0x45, 0x9e, 0x19, 0xd5,
+	0x9d, 0x7c, 0xef, 0x59, 0xef, 0x0d, 0xa2, 0x16, 0xd7, 0xcc, 0x24, 0x6a, 0xaa, 0x22, 0x5a, 0xda,
+	0x0e, 0xcc, 0x89, 0xd0, 0x34, 0xec, 0x89, 0xdd, 0x2e, 0x1f, 0xbe, 0x9d, 0xce, 0x3e, 0x61, 0x75,
+	0x7b, 0x87, 0x4e, 0x5c, 0xfa, 0xac, 0x08, 0xcd, 0xde, 0xc4, 0xd6, 0xee, 0x43, 0xcd, 0xe2, 0x22,
+	0xa2, 0x65, 0x2a, 0xaf, 0xbf, 0xcc, 0x1c, 0x4e, 0xc6, 0x75, 0x4e, 0xd8, 0x7a, 0xdc, 0x05, 0xfc,
+	0x30, 0x36, 0x72, 0xb3, 0x53, 0x36, 0x80, 0xa0, 0xdb, 0x57, 0xf5, 0x3a, 0xf4, 0x27, 0x8e, 0xc5,
+	0x43, 0xbd, 0x2a, 0x42, 0xb3, 0x1f, 0xe0, 0xa1, 0x92, 0x0a, 0x86, 0x7a, 0xd7, 0xc8, 0x26, 0x97,
+	0x44, 0x42, 0x0b, 0xd9, 0xca, 0xe1, 0xd3, 0xb3, 0xac, 0x56, 0x68, 0x36, 0xae, 0x42, 0x43, 0xde,
+	0x9b, 0xca, 0x27, 0x38, 0x59, 0x79, 0x41, 0x92, 0xe8, 0x09, 0x2e, 0xd7, 0xdf, 0x40, 0xb1, 0xbf,
+	0xe9, 0x26, 0xaf, 0x34, 0x96, 0x31, 0x38, 0x88, 0xb8, 0x90, 0xb8, 0x6c, 0x90, 0x36, 0xf1, 0xfb,
+	0x8b, 0xb5, 0x85, 0x1c, 0x82, 0xe7, 0xbf, 0x66, 0x64, 0x2f, 0xaa, 0x74, 0x7c, 0xe7, 0x7b, 0x51,
+	0x2c, 0x86, 0x14, 0xc8, 0xf4, 0xaa, 0x5d, 0xde, 0xe1, 0x37, 0x29, 0x62, 0xc9, 0x2d, 0xfb, 0x69,
+	0x6d, 0xb9, 0xdf, 0x81, 0x65, 0x47, 0x18, 0xb9, 0x3e, 0x4f, 0x56, 0x81, 0x9a, 0xbe, 0xe8, 0x88,
+	0xad, 0x4c, 0x9f, 0xc7, 0x3b, 0xbf, 0x99, 0x81, 0x0b, 0xb2, 0x14, 0x6c, 0xe5, 0xfb, 0xbf, 0xff,
+	0x4a, 0x1e, 0xde, 0x82, 0x65, 0xc2, 0xa6, 0x6d, 0x1e, 0xda, 0x18, 0x16, 0x90, 0xd1, 0x33, 0x13,
+	0x3c, 0x5e, 0x87, 0x85, 0x58, 0x54, 0xdd, 0x57, 0xa8, 0xad, 0x41, 0xca, 0xf5, 0x26, 0x76, 0x01,
+	0xb4, 0x85, 0xad, 0x01, 0xb7, 0x16, 0x79, 0xaa, 0xc4, 0xe9, 0xde, 0x78, 0xa4, 0x0e, 0x96, 0x0d,
+	0x22, 0xf6, 0x26, 0xf6, 0xa3, 0xf1, 0x48, 0xbb, 0x0d, 0x2b, 0xb6, 0x69, 0xc4, 0x53, 0x12, 0x49,
+	0x99, 0x27, 0x4b, 0xb6, 0x79, 0x5f, 0x71, 0xa4, 0x78, 0xe7, 0xb7, 0x33, 0x70, 0x1e, 0xcd, 0x2d,
+	0xb8, 0x8a, 0x70, 0x92, 0xb3, 0xbb, 0x54, 0xb0, 0x3b, 0xab, 0xe7, 0x4c, 0x41, 0xcf, 0x23, 0xb2,
+	0xa3, 0x7c, 0x44, 0x76, 0x68, 0xdf, 0x03, 0x2a, 0x24, 0x58, 0x17, 0x2a, 0x27, 0xaa, 0x0b, 0xb3,
+	0x28, 0xde, 0x0f, 0x32, 0xf5, 0xa4, 0xfa, 0x3a, 0xf5, 0xa4, 0x90, 0xfc, 0xb3, 0xc7, 0x27, 0x7f,
+	0xf1, 0xd6, 0xaa, 0xf3, 0xd7, 0x32, 0xac, 0xa4, 0x3e, 0xfb, 0x6c, 0xec, 0x47, 0xec, 0x3f, 0xfb,
+	0xab, 0x05, 0xd5, 0x91, 0xef, 0x45, 0x43, 0x72, 0x56, 0x5d, 0x97, 0x03, 0xd4, 0x44, 0x4d, 0xf1,
+	0x98, 0xfa, 0x4b, 0x80, 0x7a, 0x7c, 0xec, 0x7d, 0xc4, 0x46, 0x1c, 0x4f, 0x6d, 0x21, 0x67, 0x96,
+	0x61, 0xfa, 0x9e, 0x18, 0x8f, 0xe8, 0xa9, 0xe9, 0x05, 0x57, 0xb8, 0x59, 0x42, 0xce, 0xb6, 0x62,
+	0x28, 0x47, 0xb6, 0x77, 0x43, 0xce, 0x8d, 0x1f, 0xa3, 0x4e, 0x85, 0x39, 0xf2, 0x6c, 0x75, 0x16,
+	0xf9, 0xa4, 0x72, 0x6e, 0xe2, 0x4d, 0x58, 0xcc, 0x4c, 0xcc, 0x34, 0x2d, 0xcd, 0x44, 0x9e, 0xe4,
+	0xde, 0x07, 0xcd, 0x1c, 0xb2, 0xd0, 0xe6, 0x56, 0x56, 0x54, 0x81, 0x4b, 0x71, 0x52, 0x69, 0x7c,
+	0xba, 0x73, 0x5d, 0xff, 0x79, 0x92, 0xb1, 0xb2, 0x0a, 0xcf, 0x13, 0x51, 0xa5, 0x2b, 0x16, 0x77,
+	0xf2, 0x85, 0x7b, 0x60, 0x14, 0x55, 0x90, 0x6d, 0x4d, 0x4b, 0xb1, 0xef, 0xe7, 0x34, 0xb9, 0x0f,
+	0x6b, 0x53, 0xa6, 0xe5, 0x4d, 0x96, 0x0f, 0x64, 0x97, 0x8b, 0xf3, 0xb3, 0x96, 0x6f, 0xfd, 0xf0,
+	0xcb, 0x97, 0xab, 0xa5, 0xaf, 0x5e, 0xae, 0x96, 0xfe, 0xf9, 0x72, 0xb5, 0xf4, 0xb3, 0x57, 0xab,
+	0x67, 0xbe, 0x7a, 0xb5, 0x7a, 0xe6, 0xef, 0xaf, 0x56, 0xcf, 0x7c, 0xbe, 0x63, 0x3b, 0xd1, 0x70,
+	0x3c, 0xe8, 0x9a, 0xfe, 0x68, 0x63, 0xe0, 0x0d, 0x6e, 0x9b, 0x43, 0xe6, 0x78, 0x1b, 0x29, 0xc0,
+	0x6e, 0xab, 0x92, 0x78, 0x3b, 0x50, 0xf0, 0xda, 0x98, 0xf2, 0x47, 0x31, 0x83, 0x59, 0xfa, 0xd3,
+	0x91, 0x8f, 0xfe, 0x3d, 0x00, 0xe7, 0x70, 0x80, 0xdc, 0x32, 0x23, 0x00, 0x00,
 }
 
 func (m *GfSpTask) Marshal() (dAtA []byte, err error) {
@@ -2879,6 +2904,29 @@ func (m *GfSpUploadObjectTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SecondarySignatures) > 0 {
+		for iNdEx := len(m.SecondarySignatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SecondarySignatures[iNdEx])
+			copy(dAtA[i:], m.SecondarySignatures[iNdEx])
+			i = encodeVarintTask(dAtA, i, uint64(len(m.SecondarySignatures[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.SecondaryEndpoints) > 0 {
+		for iNdEx := len(m.SecondaryEndpoints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SecondaryEndpoints[iNdEx])
+			copy(dAtA[i:], m.SecondaryEndpoints[iNdEx])
+			i = encodeVarintTask(dAtA, i, uint64(len(m.SecondaryEndpoints[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.GlobalVirtualGroupId))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.IsAgentUpload {
 		i--
 		if m.IsAgentUpload {
@@ -4651,6 +4699,21 @@ func (m *GfSpUploadObjectTask) Size() (n int) {
 	if m.IsAgentUpload {
 		n += 2
 	}
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovTask(uint64(m.GlobalVirtualGroupId))
+	}
+	if len(m.SecondaryEndpoints) > 0 {
+		for _, s := range m.SecondaryEndpoints {
+			l = len(s)
+			n += 1 + l + sovTask(uint64(l))
+		}
+	}
+	if len(m.SecondarySignatures) > 0 {
+		for _, b := range m.SecondarySignatures {
+			l = len(b)
+			n += 1 + l + sovTask(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -6732,6 +6795,89 @@ func (m *GfSpUploadObjectTask) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsAgentUpload = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+			}
+			m.GlobalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryEndpoints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecondaryEndpoints = append(m.SecondaryEndpoints, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondarySignatures", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecondarySignatures = append(m.SecondarySignatures, make([]byte, postIndex-iNdEx))
+			copy(m.SecondarySignatures[len(m.SecondarySignatures)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -169,6 +169,18 @@ func (m *GfSpUploadObjectTask) SetStorageParams(param *storagetypes.Params) {
 	m.StorageParams = param
 }
 
+func (m *GfSpUploadObjectTask) SetGlobalVirtualGroupID(gvgID uint32) {
+	m.GlobalVirtualGroupId = gvgID
+}
+
+func (m *GfSpUploadObjectTask) SetSecondaryEndpoints(endpoints []string) {
+	m.SecondaryEndpoints = endpoints
+}
+
+func (m *GfSpUploadObjectTask) SetSecondarySignatures(signatures [][]byte) {
+	m.SecondarySignatures = signatures
+}
+
 func (m *GfSpResumableUploadObjectTask) InitResumableUploadObjectTask(vgfID uint32, object *storagetypes.ObjectInfo, params *storagetypes.Params,
 	timeout int64, complete bool, offset uint64, isAgentUpload bool) {
 	m.Reset()
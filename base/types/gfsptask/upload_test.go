@@ -305,6 +305,16 @@ func TestGfSpUploadObjectTask_SetStorageParams(t *testing.T) {
 	m.SetStorageParams(mockStorageParams)
 }
 
+func TestGfSpUploadObjectTask_SetSecondaryReplicateResult(t *testing.T) {
+	m := &GfSpUploadObjectTask{Task: &GfSpTask{}}
+	m.SetGlobalVirtualGroupID(1)
+	m.SetSecondaryEndpoints([]string{"mock"})
+	m.SetSecondarySignatures([][]byte{[]byte("mock")})
+	assert.Equal(t, uint32(1), m.GetGlobalVirtualGroupId())
+	assert.Equal(t, []string{"mock"}, m.GetSecondaryEndpoints())
+	assert.Equal(t, [][]byte{[]byte("mock")}, m.GetSecondarySignatures())
+}
+
 func TestInitResumableUploadObjectTask(t *testing.T) {
 	m := &GfSpResumableUploadObjectTask{}
 	m.InitResumableUploadObjectTask(1, mockObjectInfo, mockStorageParams, 0, true, 1, false)
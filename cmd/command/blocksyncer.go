@@ -0,0 +1,88 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/bnb-chain/greenfield-storage-provider/cmd/utils"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer"
+)
+
+const blockSyncerCommands = "BLOCK SYNCER COMMANDS"
+
+var fromHeightFlag = &cli.Uint64Flag{
+	Name:  "from",
+	Usage: "The first height to re-index, defaults to the height after the shadow db epoch",
+}
+
+var toHeightFlag = &cli.Uint64Flag{
+	Name:  "to",
+	Usage: "The last height to re-index, defaults to the latest height of the chain",
+}
+
+var reindexModulesFlag = &cli.StringFlag{
+	Name: "modules",
+	Usage: "The modules to re-index separated by comma, supported modules: " +
+		strings.Join(blocksyncer.ReindexableModules, ","),
+	Required: true,
+}
+
+var reindexWorkersFlag = &cli.UintFlag{
+	Name:  "workers",
+	Usage: "The number of blocks fetched from the chain in parallel, defaults to BlockSyncer.Workers",
+}
+
+var BlockSyncerCmd = &cli.Command{
+	Name:     "blocksyncer",
+	Usage:    "Block syncer commands",
+	Category: blockSyncerCommands,
+	Subcommands: []*cli.Command{
+		BlockSyncerReindexCmd,
+	},
+}
+
+var BlockSyncerReindexCmd = &cli.Command{
+	Action: reindexAction,
+	Name:   "reindex",
+	Usage:  "Re-index a height range into the shadow block syncer db and switch to it",
+	Flags: []cli.Flag{
+		utils.ConfigFileFlag,
+		fromHeightFlag,
+		toHeightFlag,
+		reindexModulesFlag,
+		reindexWorkersFlag,
+	},
+	Category: blockSyncerCommands,
+	Description: `The blocksyncer reindex command re-processes the blocks of the height range for the given modules ` +
+		`into the shadow db, which is the one of BsDB and BsDBBackup that is not the current master. After the ` +
+		`range is indexed, the master db flag is switched, so the metadata service never reads a half-built index.`,
+}
+
+func reindexAction(ctx *cli.Context) error {
+	cfg, err := utils.MakeConfig(ctx)
+	if err != nil {
+		return err
+	}
+	if len(cfg.Chain.ChainAddress) == 0 {
+		return fmt.Errorf("chain address is not configured")
+	}
+
+	reindexCfg := &blocksyncer.ReindexConfig{
+		FromHeight: ctx.Uint64(fromHeightFlag.Name),
+		ToHeight:   ctx.Uint64(toHeightFlag.Name),
+		Workers:    ctx.Uint(reindexWorkersFlag.Name),
+	}
+	for _, module := range strings.Split(ctx.String(reindexModulesFlag.Name), ",") {
+		if module = strings.TrimSpace(module); module != "" {
+			reindexCfg.Modules = append(reindexCfg.Modules, module)
+		}
+	}
+	if err = blocksyncer.Reindex(context.Background(), cfg, reindexCfg); err != nil {
+		return err
+	}
+	fmt.Printf("succeed to re-index and switch the master block syncer db, restart the block syncer to keep syncing it\n")
+	return nil
+}
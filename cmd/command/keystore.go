@@ -0,0 +1,80 @@
+package command
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/bnb-chain/greenfield-storage-provider/modular/signer"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/signer/types"
+)
+
+var keyTypeFlag = &cli.StringFlag{
+	Name:  "type",
+	Usage: "The type of private key, secp256k1 or bls",
+	Value: "secp256k1",
+}
+
+var passwordFileFlag = &cli.StringFlag{
+	Name:     "password.file",
+	Usage:    "The file which contains the password of keystore file",
+	Required: true,
+}
+
+var outputFlag = &cli.StringFlag{
+	Name:     "out",
+	Usage:    "The path of output keystore file, e.g. /keystore/operator.json",
+	Required: true,
+}
+
+var KeystoreImportCmd = &cli.Command{
+	Action: keystoreImportAction,
+	Name:   "keystore.import",
+	Usage:  "Encrypt a hex private key read from stdin to a keystore file",
+	Flags: []cli.Flag{
+		keyTypeFlag,
+		passwordFileFlag,
+		outputFlag,
+	},
+	Category: "KEYSTORE COMMANDS",
+	Description: `The keystore.import command reads a hex private key from stdin and encrypts it by the password ` +
+		`to a keystore file, which is used by the keystore key provider of signer. The keystore files are named by ` +
+		`the account, such as operator.json, seal.json, approval.json, gc.json and bls.json.`,
+}
+
+func keystoreImportAction(ctx *cli.Context) error {
+	var keyType types.KeyType
+	switch ctx.String(keyTypeFlag.Name) {
+	case "secp256k1":
+		keyType = types.KeyType_KEY_TYPE_ETH_SECP256K1
+	case "bls":
+		keyType = types.KeyType_KEY_TYPE_ETH_BLS
+	default:
+		return fmt.Errorf("invalid key type: %s", ctx.String(keyTypeFlag.Name))
+	}
+	password, err := os.ReadFile(ctx.String(passwordFileFlag.Name))
+	if err != nil {
+		return err
+	}
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && line == "" {
+		return fmt.Errorf("failed to read private key from stdin: %w", err)
+	}
+	privKey, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(line), "0x"))
+	if err != nil {
+		return fmt.Errorf("invalid hex private key: %w", err)
+	}
+	data, err := signer.EncryptKeystore(privKey, keyType, strings.TrimRight(string(password), "\r\n"))
+	if err != nil {
+		return err
+	}
+	if err = os.WriteFile(ctx.String(outputFlag.Name), data, 0600); err != nil {
+		return err
+	}
+	fmt.Printf("succeed to write keystore file: %s\n", ctx.String(outputFlag.Name))
+	return nil
+}
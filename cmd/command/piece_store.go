@@ -0,0 +1,77 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/bnb-chain/greenfield-storage-provider/cmd/utils"
+	"github.com/bnb-chain/greenfield-storage-provider/store/piecestore/piece"
+)
+
+const pieceStoreCommands = "PIECE STORE COMMANDS"
+
+var PieceStoreRebalanceCmd = &cli.Command{
+	Action: pieceStoreRebalanceAction,
+	Name:   "piecestore.rebalance",
+	Usage:  "Move the pieces to the shard picked by the current shard layout",
+	Flags: []cli.Flag{
+		utils.ConfigFileFlag,
+	},
+	Category: pieceStoreCommands,
+	Description: `The piecestore.rebalance command moves the pieces which are still on the shard picked by ` +
+		`PieceStore.ShardRing.PreviousShards to the shard picked by the current layout, and records the ` +
+		`completion in the first shard. It should be run from one host only, the SP services keep serving ` +
+		`the pieces from the previous shard until they are moved.`,
+}
+
+func pieceStoreRebalanceAction(ctx *cli.Context) error {
+	cfg, err := utils.MakeConfig(ctx)
+	if err != nil {
+		return err
+	}
+	store, err := piece.NewPieceStore(&cfg.PieceStore)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+	moved, err := store.Rebalance(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("failed to rebalance piece store after moving %d pieces: %w", moved, err)
+	}
+	fmt.Printf("succeed to rebalance piece store, moved %d pieces\n", moved)
+	return nil
+}
+
+var PieceStoreRotateKeysCmd = &cli.Command{
+	Action: pieceStoreRotateKeysAction,
+	Name:   "piecestore.rotate.keys",
+	Usage:  "Re-wrap the data keys of pieces by the active master key and encrypt the plaintext pieces",
+	Flags: []cli.Flag{
+		utils.ConfigFileFlag,
+	},
+	Category: pieceStoreCommands,
+	Description: `The piecestore.rotate.keys command re-wraps the data keys of the pieces which are not wrapped ` +
+		`by the active master key of PieceStore.Encryption, and encrypts the pieces written before encryption ` +
+		`is enabled. Once every piece is encrypted, it records the completion in the piece store and the ` +
+		`plaintext pieces are no longer accepted. It should be run from one host only, after every SP service ` +
+		`has enabled encryption and the shard rebalance has finished.`,
+}
+
+func pieceStoreRotateKeysAction(ctx *cli.Context) error {
+	cfg, err := utils.MakeConfig(ctx)
+	if err != nil {
+		return err
+	}
+	store, err := piece.NewPieceStore(&cfg.PieceStore)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+	rotated, err := store.RotateKeys(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("failed to rotate keys of piece store after rotating %d pieces: %w", rotated, err)
+	}
+	fmt.Printf("succeed to rotate keys of piece store, rotated %d pieces\n", rotated)
+	return nil
+}
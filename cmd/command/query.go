@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"text/tabwriter"
 	"time"
 
 	"github.com/urfave/cli/v2"
@@ -18,6 +19,7 @@ import (
 	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
 	"github.com/bnb-chain/greenfield-storage-provider/cmd/utils"
 	"github.com/bnb-chain/greenfield-storage-provider/core/piecestore"
+	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
 	coretask "github.com/bnb-chain/greenfield-storage-provider/core/task"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
 	"github.com/bnb-chain/greenfield-storage-provider/util"
@@ -65,6 +67,23 @@ var segmentIdxFlag = &cli.Uint64Flag{
 	Required: true,
 }
 
+var txAccountFlag = &cli.StringFlag{
+	Name:  "account",
+	Usage: "The signer account of txs, e.g. operator, seal and gc, all accounts if it is not set",
+}
+
+var txStatusFlag = &cli.StringFlag{
+	Name:  "status",
+	Usage: "The status of txs, e.g. pending, included, failed, replaced and dropped, all statuses if it is not set",
+	Value: "pending",
+}
+
+var txLimitFlag = &cli.IntFlag{
+	Name:  "limit",
+	Usage: "The max number of txs",
+	Value: 100,
+}
+
 var ListModulesCmd = &cli.Command{
 	Action:      listModulesAction,
 	Name:        "list.modules",
@@ -180,6 +199,21 @@ var QuerySecondarySPIncomeCmd = &cli.Command{
 	Description: `The query.secondary.sp.income command send rpc request to metadata, get the secondary sp incomes details for the current timestamp`,
 }
 
+var QueryTxsCmd = &cli.Command{
+	Action: CW.queryTxsAction,
+	Name:   "query.txs",
+	Usage:  "Query the txs broadcast by the signer accounts",
+	Flags: []cli.Flag{
+		utils.ConfigFileFlag,
+		txAccountFlag,
+		txStatusFlag,
+		txLimitFlag,
+	},
+	Category: queryCommands,
+	Description: `The query.txs command reads the txs recorded by the tx tracker of signer from spdb, it shows the ` +
+		`pending txs by default, so the stuck seal, reject seal and discontinue bucket txs can be found.`,
+}
+
 func listModulesAction(ctx *cli.Context) error {
 	fmt.Println(gfspapp.GetRegisterModuleDescription())
 	return nil
@@ -481,3 +515,33 @@ func (w *CMDWrapper) getSecondarySPIncomeAction(ctx *cli.Context) error {
 	fmt.Println("query results:", string(details[:]))
 	return nil
 }
+
+func (w *CMDWrapper) queryTxsAction(ctx *cli.Context) error {
+	err := w.init(ctx)
+	if err != nil {
+		return err
+	}
+	if w.spDBAPI == nil {
+		return fmt.Errorf("failed to connect spdb")
+	}
+	var statuses []spdb.TxStatus
+	if name := ctx.String(txStatusFlag.Name); name != "" {
+		status, ok := spdb.ParseTxStatus(name)
+		if !ok {
+			return fmt.Errorf("invalid tx status: %s", name)
+		}
+		statuses = append(statuses, status)
+	}
+	txs, err := w.spDBAPI.ListTxs(ctx.String(txAccountFlag.Name), statuses, ctx.Int(txLimitFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to query txs, error: %v", err)
+	}
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "TX_HASH\tACCOUNT\tNONCE\tSTATUS\tHEIGHT\tGAS_USED\tBROADCAST\tLAST_BROADCAST\tMSGS\tERROR")
+	for _, tx := range txs {
+		fmt.Fprintf(writer, "%s\t%s\t%d\t%s\t%d\t%d\t%d\t%s\t%s\t%s\n", tx.TxHash, tx.Account, tx.Nonce,
+			tx.Status, tx.Height, tx.GasUsed, tx.BroadcastCount, time.Unix(tx.BroadcastTime, 0).Format(time.RFC3339),
+			tx.MsgTypes, tx.ErrorMsg)
+	}
+	return writer.Flush()
+}
@@ -255,3 +255,30 @@ func TestGetSecondarySPIncome(t *testing.T) {
 	// clear temp config file
 	os.Remove(DefaultConfigFile)
 }
+
+func TestQueryTxs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	CW.config = &gfspconfig.GfSpConfig{}
+	CW.grpcAPI = gfspclient.NewMockGfSpClientAPI(ctrl)
+	mockDBAPI := spdb.NewMockSPDB(ctrl)
+	CW.spDBAPI = mockDBAPI
+
+	o1 := mockDBAPI.EXPECT().ListTxs("seal", []spdb.TxStatus{spdb.TxStatusPending}, 100).Return(
+		[]*spdb.TxRecord{{TxHash: "mockTxHash", Account: "seal", Status: spdb.TxStatusPending}}, nil)
+	o2 := mockDBAPI.EXPECT().ListTxs("", nil, 10).Return(nil, fmt.Errorf("failed to list txs"))
+	gomock.InOrder(o1, o2)
+
+	app := cli.NewApp()
+	app.Commands = []*cli.Command{
+		QueryTxsCmd,
+	}
+	err := app.Run([]string{"./gnfd-sp", "query.txs", "--account", "seal"})
+	assert.Nil(t, err)
+
+	err = app.Run([]string{"./gnfd-sp", "query.txs", "--status", "", "--limit", "10"})
+	assert.NotNil(t, err)
+
+	err = app.Run([]string{"./gnfd-sp", "query.txs", "--status", "unknown"})
+	assert.NotNil(t, err)
+}
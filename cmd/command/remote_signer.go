@@ -0,0 +1,36 @@
+package command
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/bnb-chain/greenfield-storage-provider/cmd/utils"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/signer"
+)
+
+var RemoteSignerStartCmd = &cli.Command{
+	Action: remoteSignerStartAction,
+	Name:   "remotesigner.start",
+	Usage:  "Serve the keys of the keystore or hsm key provider as remote signer",
+	Flags: []cli.Flag{
+		utils.ConfigFileFlag,
+	},
+	Category: "REMOTE SIGNER COMMANDS",
+	Description: `The remotesigner.start command serves the keys of SpAccount.KeyProvider, which must be keystore ` +
+		`or hsm, by the RemoteSignerService protocol on SpAccount.RemoteSignerServer.Address. The connection is ` +
+		`mutual TLS, only the signers which present a certificate signed by TLSClientCAFile are served. It runs ` +
+		`until it is interrupted.`,
+}
+
+func remoteSignerStartAction(ctx *cli.Context) error {
+	cfg, err := utils.MakeConfig(ctx)
+	if err != nil {
+		return err
+	}
+	runCtx, stop := signal.NotifyContext(ctx.Context, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	return signer.RunRemoteSigner(runCtx, &cfg.SpAccount)
+}
@@ -113,8 +113,16 @@ func init() {
 		// query primary and secondary SP income details
 		command.QueryPrimarySPIncomeCmd,
 		command.QuerySecondarySPIncomeCmd,
+		command.QueryTxsCmd,
 		// p2p category commands
 		command.P2PCreateKeysCmd,
+		// piece store category commands
+		command.PieceStoreRebalanceCmd,
+		command.PieceStoreRotateKeysCmd,
+		// keystore category commands
+		command.KeystoreImportCmd,
+		// remote signer category commands
+		command.RemoteSignerStartCmd,
 		// debug commands
 		command.DebugCreateBucketApprovalCmd,
 		command.DebugCreateObjectApprovalCmd,
@@ -131,6 +139,7 @@ func init() {
 		command.SetQuotaCmd,
 		// block syncer
 		bs_data_migration.BsDataMigrationCmd,
+		command.BlockSyncerCmd,
 		// be related to sp exit
 		command.SpExitCmd,
 		command.CompleteSpExitCmd,
@@ -61,6 +61,8 @@ const (
 	AuthOpTypeAgentPutObject
 	// AuthOpTypeAgentUpdateObject  defines the agent UpdateObject operator
 	AuthOpTypeAgentUpdateObject
+	// AuthOpTypeManageBucketNotification defines the PutBucketNotification and GetBucketNotification operator
+	AuthOpTypeManageBucketNotification
 )
 
 // Authenticator is an abstract interface to verify users authentication.
@@ -86,6 +88,11 @@ type Authenticator interface {
 	UpdateUserPublicKeyV2(ctx context.Context, account string, domain string, publicKey string, expiryDate int64) (bool, error)
 	// VerifyGNFD2EddsaSignature verifies the signature signed by user's EDDSA private key.
 	VerifyGNFD2EddsaSignature(ctx context.Context, account string, domain string, publicKey string, offChainSig string, realMsgToSign []byte) (bool, error)
+	// CreateS3AccessKey creates an access key of the S3 compatible api which is bound to a registered off-chain auth key.
+	CreateS3AccessKey(ctx context.Context, account string, domain string, publicKey string) (*spdb.S3AccessKey, error)
+	// VerifyS3Signature verifies the AWS signature version 4 of the S3 compatible api request, and returns the
+	// account which the access key is issued to.
+	VerifyS3Signature(ctx context.Context, accessKeyID string, stringToSign []byte, signature string) (string, error)
 }
 
 // Approver is an abstract interface to handle asking approval requests.
@@ -132,6 +139,9 @@ type Downloader interface {
 	PreDownloadObject(ctx context.Context, task task.DownloadObjectTask) error
 	// HandleDownloadObjectTask handles the DownloadObject and get data from piece store.
 	HandleDownloadObjectTask(ctx context.Context, task task.DownloadObjectTask) ([]byte, error)
+	// HandleDownloadObjectTaskStream handles the DownloadObject and returns the reader of the data, the
+	// data is read from piece store segment by segment, the caller should close the reader after reading.
+	HandleDownloadObjectTaskStream(ctx context.Context, task task.DownloadObjectTask) (io.ReadCloser, error)
 	// PostDownloadObject is called after HandleDownloadObjectTask, it can recycle
 	// resources, make statistics and do some other operations..
 	PostDownloadObject(ctx context.Context, task task.DownloadObjectTask)
@@ -236,6 +246,9 @@ type Manager interface {
 	HandleChallengePieceTask(ctx context.Context, task task.ChallengePieceTask) error
 	// PickVirtualGroupFamily is used to pick vgf for the new bucket.
 	PickVirtualGroupFamily(ctx context.Context, task task.ApprovalCreateBucketTask) (uint32, error)
+	// PickGlobalVirtualGroup is used to pick gvg for replicating the object while uploading, the request comes
+	// from Uploader.
+	PickGlobalVirtualGroup(ctx context.Context, task task.UploadObjectTask) (*gfspserver.GfSpPickGlobalVirtualGroupResponse, error)
 	// HandleRecoverPieceTask handles the result of recovering piece task, the request comes from TaskExecutor.
 	HandleRecoverPieceTask(ctx context.Context, task task.RecoveryPieceTask) error
 	// NotifyMigrateSwapOut is used to notify dest sp migrate swap out.
@@ -352,6 +365,8 @@ type Signer interface {
 	DelegateCreateObject(ctx context.Context, msg *storagetypes.MsgDelegateCreateObject) (string, error)
 	// DelegateUpdateObjectContent  send the delegate update object content tx
 	DelegateUpdateObjectContent(ctx context.Context, msg *storagetypes.MsgDelegateUpdateObjectContent) (string, error)
+	// DelegateDeleteObject broadcast the delete object tx on behalf of the user, the SP is the operator of the tx.
+	DelegateDeleteObject(ctx context.Context, msg *storagetypes.MsgDeleteObject) (string, error)
 }
 
 // Uploader is an abstract interface to handle putting object requests from users' account and store
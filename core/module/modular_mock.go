@@ -141,6 +141,21 @@ func (m *MockAuthenticator) EXPECT() *MockAuthenticatorMockRecorder {
 	return m.recorder
 }
 
+// CreateS3AccessKey mocks base method.
+func (m *MockAuthenticator) CreateS3AccessKey(ctx context.Context, account, domain, publicKey string) (*spdb.S3AccessKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateS3AccessKey", ctx, account, domain, publicKey)
+	ret0, _ := ret[0].(*spdb.S3AccessKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateS3AccessKey indicates an expected call of CreateS3AccessKey.
+func (mr *MockAuthenticatorMockRecorder) CreateS3AccessKey(ctx, account, domain, publicKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateS3AccessKey", reflect.TypeOf((*MockAuthenticator)(nil).CreateS3AccessKey), ctx, account, domain, publicKey)
+}
+
 // DeleteAuthKeysV2 mocks base method.
 func (m *MockAuthenticator) DeleteAuthKeysV2(ctx context.Context, account, domain string, publicKeys []string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -345,6 +360,21 @@ func (mr *MockAuthenticatorMockRecorder) VerifyGNFD2EddsaSignature(ctx, account,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyGNFD2EddsaSignature", reflect.TypeOf((*MockAuthenticator)(nil).VerifyGNFD2EddsaSignature), ctx, account, domain, publicKey, offChainSig, realMsgToSign)
 }
 
+// VerifyS3Signature mocks base method.
+func (m *MockAuthenticator) VerifyS3Signature(ctx context.Context, accessKeyID string, stringToSign []byte, signature string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyS3Signature", ctx, accessKeyID, stringToSign, signature)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyS3Signature indicates an expected call of VerifyS3Signature.
+func (mr *MockAuthenticatorMockRecorder) VerifyS3Signature(ctx, accessKeyID, stringToSign, signature any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyS3Signature", reflect.TypeOf((*MockAuthenticator)(nil).VerifyS3Signature), ctx, accessKeyID, stringToSign, signature)
+}
+
 // MockApprover is a mock of Approver interface.
 type MockApprover struct {
 	ctrl     *gomock.Controller
@@ -645,6 +675,21 @@ func (mr *MockDownloaderMockRecorder) HandleDownloadObjectTask(ctx, task any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleDownloadObjectTask", reflect.TypeOf((*MockDownloader)(nil).HandleDownloadObjectTask), ctx, task)
 }
 
+// HandleDownloadObjectTaskStream mocks base method.
+func (m *MockDownloader) HandleDownloadObjectTaskStream(ctx context.Context, task task.DownloadObjectTask) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleDownloadObjectTaskStream", ctx, task)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HandleDownloadObjectTaskStream indicates an expected call of HandleDownloadObjectTaskStream.
+func (mr *MockDownloaderMockRecorder) HandleDownloadObjectTaskStream(ctx, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleDownloadObjectTaskStream", reflect.TypeOf((*MockDownloader)(nil).HandleDownloadObjectTaskStream), ctx, task)
+}
+
 // HandleDownloadPieceTask mocks base method.
 func (m *MockDownloader) HandleDownloadPieceTask(ctx context.Context, task task.DownloadPieceTask) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -1346,6 +1391,21 @@ func (mr *MockManagerMockRecorder) NotifyPreMigrateBucketAndDeductQuota(ctx, buc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyPreMigrateBucketAndDeductQuota", reflect.TypeOf((*MockManager)(nil).NotifyPreMigrateBucketAndDeductQuota), ctx, bucketID)
 }
 
+// PickGlobalVirtualGroup mocks base method.
+func (m *MockManager) PickGlobalVirtualGroup(ctx context.Context, task task.UploadObjectTask) (*gfspserver.GfSpPickGlobalVirtualGroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PickGlobalVirtualGroup", ctx, task)
+	ret0, _ := ret[0].(*gfspserver.GfSpPickGlobalVirtualGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PickGlobalVirtualGroup indicates an expected call of PickGlobalVirtualGroup.
+func (mr *MockManagerMockRecorder) PickGlobalVirtualGroup(ctx, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PickGlobalVirtualGroup", reflect.TypeOf((*MockManager)(nil).PickGlobalVirtualGroup), ctx, task)
+}
+
 // PickVirtualGroupFamily mocks base method.
 func (m *MockManager) PickVirtualGroupFamily(ctx context.Context, task task.ApprovalCreateBucketTask) (uint32, error) {
 	m.ctrl.T.Helper()
@@ -1942,6 +2002,21 @@ func (mr *MockSignerMockRecorder) DelegateCreateObject(ctx, msg any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelegateCreateObject", reflect.TypeOf((*MockSigner)(nil).DelegateCreateObject), ctx, msg)
 }
 
+// DelegateDeleteObject mocks base method.
+func (m *MockSigner) DelegateDeleteObject(ctx context.Context, msg *types0.MsgDeleteObject) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DelegateDeleteObject", ctx, msg)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DelegateDeleteObject indicates an expected call of DelegateDeleteObject.
+func (mr *MockSignerMockRecorder) DelegateDeleteObject(ctx, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelegateDeleteObject", reflect.TypeOf((*MockSigner)(nil).DelegateDeleteObject), ctx, msg)
+}
+
 // DelegateUpdateObjectContent mocks base method.
 func (m *MockSigner) DelegateUpdateObjectContent(ctx context.Context, msg *types0.MsgDelegateUpdateObjectContent) (string, error) {
 	m.ctrl.T.Helper()
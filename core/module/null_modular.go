@@ -62,6 +62,9 @@ func (*NullModular) PostMigrateBucketApproval(context.Context, task.ApprovalMigr
 func (*NullModular) PickVirtualGroupFamily(context.Context, task.ApprovalCreateBucketTask) (uint32, error) {
 	return 0, ErrNilModular
 }
+func (*NullModular) PickGlobalVirtualGroup(context.Context, task.UploadObjectTask) (*gfspserver.GfSpPickGlobalVirtualGroupResponse, error) {
+	return nil, ErrNilModular
+}
 func (*NullModular) NotifyMigrateSwapOut(context.Context, *virtualgrouptypes.MsgSwapOut) error {
 	return ErrNilModular
 }
@@ -198,6 +201,14 @@ func (m *NullModular) VerifyGNFD2EddsaSignature(ctx context.Context, account str
 	return false, ErrNilModular
 }
 
+func (m *NullModular) CreateS3AccessKey(ctx context.Context, account string, domain string, publicKey string) (*corespdb.S3AccessKey, error) {
+	return nil, ErrNilModular
+}
+
+func (m *NullModular) VerifyS3Signature(ctx context.Context, accessKeyID string, stringToSign []byte, signature string) (string, error) {
+	return "", ErrNilModular
+}
+
 func (m *NullModular) ListAuthKeysV2(ctx context.Context, account string, domain string) ([]string, error) {
 	return nil, ErrNilModular
 }
@@ -229,6 +240,9 @@ func (*NilModular) PreDownloadObject(context.Context, task.DownloadObjectTask) e
 func (*NilModular) HandleDownloadObjectTask(context.Context, task.DownloadObjectTask) ([]byte, error) {
 	return nil, ErrNilModular
 }
+func (*NilModular) HandleDownloadObjectTaskStream(context.Context, task.DownloadObjectTask) (io.ReadCloser, error) {
+	return nil, ErrNilModular
+}
 func (*NilModular) PostDownloadObject(context.Context, task.DownloadObjectTask) {}
 
 func (*NilModular) PreDownloadPiece(context.Context, task.DownloadPieceTask) error {
@@ -380,6 +394,9 @@ func (m *NilModular) DelegateCreateObject(ctx context.Context, msg *storagetypes
 func (m *NilModular) DelegateUpdateObjectContent(ctx context.Context, msg *storagetypes.MsgDelegateUpdateObjectContent) (string, error) {
 	return "", nil
 }
+func (m *NilModular) DelegateDeleteObject(ctx context.Context, msg *storagetypes.MsgDeleteObject) (string, error) {
+	return "", nil
+}
 func (m *NilModular) SealObjectV2(ctx context.Context, object *storagetypes.MsgSealObjectV2) (string, error) {
 	return "", nil
 }
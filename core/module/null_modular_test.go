@@ -22,6 +22,7 @@ func TestNullModular(t *testing.T) {
 	_, _ = n.HandleMigrateBucketApprovalTask(context.TODO(), nil)
 	n.PostMigrateBucketApproval(context.TODO(), nil)
 	_, _ = n.PickVirtualGroupFamily(context.TODO(), nil)
+	_, _ = n.PickGlobalVirtualGroup(context.TODO(), nil)
 	_ = n.NotifyMigrateSwapOut(context.TODO(), nil)
 	_ = n.PreCreateObjectApproval(context.TODO(), nil)
 	_, _ = n.HandleCreateObjectApprovalTask(context.TODO(), nil)
@@ -68,6 +69,7 @@ func TestNilModular(t *testing.T) {
 	_, _ = n.QueryTasks(context.TODO(), "")
 	_ = n.PreDownloadObject(context.TODO(), nil)
 	_, _ = n.HandleDownloadObjectTask(context.TODO(), nil)
+	_, _ = n.HandleDownloadObjectTaskStream(context.TODO(), nil)
 	n.PostDownloadObject(context.TODO(), nil)
 	_ = n.PreDownloadPiece(context.TODO(), nil)
 	_, _ = n.HandleDownloadPieceTask(context.TODO(), nil)
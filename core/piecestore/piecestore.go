@@ -2,6 +2,7 @@ package piecestore
 
 import (
 	"context"
+	"io"
 )
 
 const (
@@ -42,6 +43,9 @@ type PieceStore interface {
 	// GetPiece returns the piece data from piece store by piece key.
 	// the piece can segment or ec piece key.
 	GetPiece(ctx context.Context, key string, offset, limit int64) ([]byte, error)
+	// GetPieceReader returns the reader of the piece data from piece store by piece key,
+	// the caller should close the reader after reading.
+	GetPieceReader(ctx context.Context, key string, offset, limit int64) (io.ReadCloser, error)
 	// PutPiece puts the piece data to piece store, it can put segment
 	// or ec piece data.
 	PutPiece(ctx context.Context, key string, value []byte) error
@@ -52,3 +56,19 @@ type PieceStore interface {
 	// segment or ec piece data.
 	DeletePiecesByPrefix(ctx context.Context, key string) (uint64, error)
 }
+
+// PieceCache is an abstract interface to cache the piece data in memory, it is shared by the paths of
+// downloading object, downloading piece and challenging piece.
+type PieceCache interface {
+	// Get returns the cached piece data by the cache key.
+	Get(key string) ([]byte, bool)
+	// Add adds the piece data to the cache, the piece may be rejected by the admission policy
+	// of the cache, the caller should not modify the data after adding.
+	Add(key string, value []byte)
+	// Remove removes the piece data from the cache.
+	Remove(key string)
+	// Len returns the number of the cached pieces in memory.
+	Len() int
+	// Size returns the total bytes of the cached pieces in memory.
+	Size() int64
+}
@@ -11,6 +11,7 @@ package piecestore
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -235,6 +236,21 @@ func (mr *MockPieceStoreMockRecorder) GetPiece(ctx, key, offset, limit any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPiece", reflect.TypeOf((*MockPieceStore)(nil).GetPiece), ctx, key, offset, limit)
 }
 
+// GetPieceReader mocks base method.
+func (m *MockPieceStore) GetPieceReader(ctx context.Context, key string, offset, limit int64) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPieceReader", ctx, key, offset, limit)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPieceReader indicates an expected call of GetPieceReader.
+func (mr *MockPieceStoreMockRecorder) GetPieceReader(ctx, key, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPieceReader", reflect.TypeOf((*MockPieceStore)(nil).GetPieceReader), ctx, key, offset, limit)
+}
+
 // PutPiece mocks base method.
 func (m *MockPieceStore) PutPiece(ctx context.Context, key string, value []byte) error {
 	m.ctrl.T.Helper()
@@ -248,3 +264,93 @@ func (mr *MockPieceStoreMockRecorder) PutPiece(ctx, key, value any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutPiece", reflect.TypeOf((*MockPieceStore)(nil).PutPiece), ctx, key, value)
 }
+
+// MockPieceCache is a mock of PieceCache interface.
+type MockPieceCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockPieceCacheMockRecorder
+}
+
+// MockPieceCacheMockRecorder is the mock recorder for MockPieceCache.
+type MockPieceCacheMockRecorder struct {
+	mock *MockPieceCache
+}
+
+// NewMockPieceCache creates a new mock instance.
+func NewMockPieceCache(ctrl *gomock.Controller) *MockPieceCache {
+	mock := &MockPieceCache{ctrl: ctrl}
+	mock.recorder = &MockPieceCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPieceCache) EXPECT() *MockPieceCacheMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockPieceCache) Add(key string, value []byte) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Add", key, value)
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockPieceCacheMockRecorder) Add(key, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockPieceCache)(nil).Add), key, value)
+}
+
+// Get mocks base method.
+func (m *MockPieceCache) Get(key string) ([]byte, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockPieceCacheMockRecorder) Get(key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPieceCache)(nil).Get), key)
+}
+
+// Len mocks base method.
+func (m *MockPieceCache) Len() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Len")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// Len indicates an expected call of Len.
+func (mr *MockPieceCacheMockRecorder) Len() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Len", reflect.TypeOf((*MockPieceCache)(nil).Len))
+}
+
+// Remove mocks base method.
+func (m *MockPieceCache) Remove(key string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Remove", key)
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockPieceCacheMockRecorder) Remove(key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockPieceCache)(nil).Remove), key)
+}
+
+// Size mocks base method.
+func (m *MockPieceCache) Size() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Size")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// Size indicates an expected call of Size.
+func (mr *MockPieceCacheMockRecorder) Size() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockPieceCache)(nil).Size))
+}
@@ -135,6 +135,17 @@ type OffChainAuthKeyV2 struct {
 	ModifiedTime time.Time
 }
 
+// S3AccessKey is the access key of the S3 compatible api, it's issued to the account which signs the request with
+// a registered off-chain auth key, and becomes invalid after the off-chain auth key is deleted or expired.
+type S3AccessKey struct {
+	AccessKeyID string // as primary key
+	SecretKey   string
+	UserAddress string
+	Domain      string // the domain of the bound off-chain auth key
+	PublicKey   string // the public key of the bound off-chain auth key
+	CreatedTime time.Time
+}
+
 // MigrateGVGUnitMeta is used to record migrate type/meta/status/progress.
 type MigrateGVGUnitMeta struct {
 	MigrateGVGKey            string // as primary key
@@ -202,3 +213,97 @@ type MigrateBucketProgressMeta struct {
 	LastGcObjectID uint64 // After bucket migration is complete, the progress of GC, up to which object is GC performed.
 	LastGcGvgID    uint64 // which GVG is GC performed.
 }
+
+// QueuedTask is used to record a task of the durable task queue.
+type QueuedTask struct {
+	QueueName  string // together with TaskKey as primary key
+	TaskKey    string
+	TaskType   int32  // the coretask.TType of the task, used to unmarshal the data
+	Data       []byte // the marshaled task
+	CreateTime int64
+}
+
+// ScrubProgress is used to record the progress of the piece integrity scrubber, the scrubber resumes from it
+// after restarting.
+type ScrubProgress struct {
+	ScrubKey      string // as primary key
+	NextObjectID  uint64 // the start object id of the next range to scrub
+	Round         uint64 // the number of finished rounds over all objects
+	ScannedPieces uint64 // the number of scanned pieces in the current round
+	CorruptPieces uint64 // the number of corrupt or missing pieces in the current round
+	UpdateTime    int64
+}
+
+// InventoryProgress is used to record the progress of the inventory report of a bucket, the inventory job resumes
+// from it after restarting.
+type InventoryProgress struct {
+	BucketName     string // the source bucket name, as primary key
+	ReportTime     int64  // the start time of the running report, zero means no report is running
+	StartAfter     string // the last object name in the uploaded manifest files of the running report
+	FileCount      uint32 // the number of the uploaded manifest files of the running report
+	ObjectCount    uint64 // the number of the objects in the uploaded manifest files of the running report
+	LastReportTime int64  // the start time of the last finished report
+	UpdateTime     int64
+}
+
+// TxStatus is the lifecycle status of the tx broadcast by the signer accounts.
+type TxStatus int32
+
+const (
+	// TxStatusPending means the tx is broadcast and waiting for inclusion.
+	TxStatusPending TxStatus = iota + 1
+	// TxStatusIncluded means the tx is included in a block and succeeded.
+	TxStatusIncluded
+	// TxStatusFailed means the tx is included in a block but failed, or rejected by the chain.
+	TxStatusFailed
+	// TxStatusReplaced means the tx is replaced by a tx with the same nonce and a higher fee.
+	TxStatusReplaced
+	// TxStatusDropped means the nonce of the tx is used by another tx which is not tracked.
+	TxStatusDropped
+)
+
+var txStatusNames = map[TxStatus]string{
+	TxStatusPending:  "pending",
+	TxStatusIncluded: "included",
+	TxStatusFailed:   "failed",
+	TxStatusReplaced: "replaced",
+	TxStatusDropped:  "dropped",
+}
+
+// String returns the name of tx status.
+func (s TxStatus) String() string {
+	if name, ok := txStatusNames[s]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ParseTxStatus returns the tx status by name.
+func ParseTxStatus(name string) (TxStatus, bool) {
+	for status, statusName := range txStatusNames {
+		if statusName == name {
+			return status, true
+		}
+	}
+	return 0, false
+}
+
+// TxRecord is used to record the lifecycle of a tx broadcast by the signer accounts.
+type TxRecord struct {
+	TxHash         string // as primary key
+	Account        string // the signer account which signs the tx, such as operator, seal and gc
+	Address        string
+	Nonce          uint64
+	MsgTypes       string // the comma separated type urls of msgs
+	TxBytes        []byte // the signed tx, it is used to re-broadcast the tx
+	GasLimit       uint64
+	FeeAmount      string
+	Status         TxStatus
+	Height         int64 // the block height which includes the tx
+	GasUsed        int64
+	BroadcastCount uint32
+	ErrorMsg       string
+	BroadcastTime  int64 // the time of the last broadcast
+	CreateTime     int64
+	UpdateTime     int64
+}
@@ -19,8 +19,14 @@ type SPDB interface {
 	SPInfoDB
 	OffChainAuthKeyDB
 	OffChainAuthKeyV2DB
+	S3AccessKeyDB
 	MigrateDB
 	ExitRecoverDB
+	TaskQueueDB
+	ScrubDB
+	TxDB
+	InventoryDB
+	RateLimitDB
 }
 
 // UploadObjectProgressDB interface which records upload object related progress(includes foreground and background) and state.
@@ -198,6 +204,14 @@ type OffChainAuthKeyV2DB interface {
 	DeleteAuthKeysV2(userAddress string, domain string, publicKey []string) (bool, error)
 }
 
+// S3AccessKeyDB is used to persist the access keys of the S3 compatible api.
+type S3AccessKeyDB interface {
+	// InsertS3AccessKey inserts a new access key.
+	InsertS3AccessKey(accessKey *S3AccessKey) error
+	// GetS3AccessKey returns the access key by the access key id, returns nil if it is not found.
+	GetS3AccessKey(accessKeyID string) (*S3AccessKey, error)
+}
+
 // MigrateDB is used to support sp exit and bucket migrate.
 type MigrateDB interface {
 	// UpdateSPExitSubscribeProgress includes insert and update.
@@ -291,3 +305,57 @@ type ExitRecoverDB interface {
 	// CountRecoverFailedObject return the failed object total count
 	CountRecoverFailedObject() (int64, error)
 }
+
+// TaskQueueDB is used to persist the tasks of the durable task queues, the manager replays them at startup.
+type TaskQueueDB interface {
+	// InsertQueuedTask inserts a task into the queue, overwrites it if the task already exists.
+	InsertQueuedTask(task *QueuedTask) error
+	// DeleteQueuedTask deletes the task from the queue.
+	DeleteQueuedTask(queueName string, taskKey string) error
+	// ListQueuedTasks returns all tasks of the queue, it is only used in startup.
+	ListQueuedTasks(queueName string) ([]*QueuedTask, error)
+}
+
+// ScrubDB is used to persist the checkpoints of the piece integrity scrubber.
+type ScrubDB interface {
+	// UpdateScrubProgress includes insert and update.
+	UpdateScrubProgress(progress *ScrubProgress) error
+	// QueryScrubProgress returns the scrub progress which is called at startup, returns an empty progress
+	// if it is not found.
+	QueryScrubProgress(scrubKey string) (*ScrubProgress, error)
+}
+
+// TxDB is used to persist the lifecycle of the txs broadcast by the signer accounts.
+type TxDB interface {
+	// InsertTx inserts the broadcast tx.
+	InsertTx(tx *TxRecord) error
+	// UpdateTx updates the status, inclusion and broadcast info of the tx.
+	UpdateTx(tx *TxRecord) error
+	// ListTxs returns the txs of the account with the statuses ordered by account and nonce, the txs of all
+	// accounts or all statuses are returned if account or statuses is empty.
+	ListTxs(account string, statuses []TxStatus, limit int) ([]*TxRecord, error)
+	// DeleteFinishedTxs deletes the txs which are not pending and updated before the time.
+	DeleteFinishedTxs(updateTimeBefore int64) error
+}
+
+// InventoryDB is used to persist the checkpoints of the bucket inventory reports.
+type InventoryDB interface {
+	// UpdateInventoryProgress includes insert and update.
+	UpdateInventoryProgress(progress *InventoryProgress) error
+	// QueryInventoryProgress returns the inventory progress of the bucket, returns an empty progress if it is
+	// not found.
+	QueryInventoryProgress(bucketName string) (*InventoryProgress, error)
+}
+
+// RateLimitDB is used to share the fixed window counters of the api rate limiter among the gateway instances.
+type RateLimitDB interface {
+	// IncrRateLimitCounter increases the counter of the key in the window and returns the increased counter, the
+	// counter is reset if it belongs to the previous window.
+	IncrRateLimitCounter(key string, windowStart int64, count int64, expireTime int64) (int64, error)
+	// GetRateLimitCounter returns the counter of the key in the window, returns 0 if it is not found.
+	GetRateLimitCounter(key string, windowStart int64) (int64, error)
+	// DeleteRateLimitCounter deletes the counter of the key in the window.
+	DeleteRateLimitCounter(key string, windowStart int64) error
+	// DeleteExpiredRateLimitCounters deletes the counters which are expired before the time.
+	DeleteExpiredRateLimitCounters(expireTimeBefore int64) error
+}
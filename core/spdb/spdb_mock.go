@@ -157,6 +157,20 @@ func (mr *MockSPDBMockRecorder) DeleteExpiredBucketTraffic(yearMonth any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredBucketTraffic", reflect.TypeOf((*MockSPDB)(nil).DeleteExpiredBucketTraffic), yearMonth)
 }
 
+// DeleteExpiredRateLimitCounters mocks base method.
+func (m *MockSPDB) DeleteExpiredRateLimitCounters(expireTimeBefore int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredRateLimitCounters", expireTimeBefore)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpiredRateLimitCounters indicates an expected call of DeleteExpiredRateLimitCounters.
+func (mr *MockSPDBMockRecorder) DeleteExpiredRateLimitCounters(expireTimeBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredRateLimitCounters", reflect.TypeOf((*MockSPDB)(nil).DeleteExpiredRateLimitCounters), expireTimeBefore)
+}
+
 // DeleteExpiredReadRecord mocks base method.
 func (m *MockSPDB) DeleteExpiredReadRecord(ts, limit uint64) error {
 	m.ctrl.T.Helper()
@@ -171,6 +185,20 @@ func (mr *MockSPDBMockRecorder) DeleteExpiredReadRecord(ts, limit any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredReadRecord", reflect.TypeOf((*MockSPDB)(nil).DeleteExpiredReadRecord), ts, limit)
 }
 
+// DeleteFinishedTxs mocks base method.
+func (m *MockSPDB) DeleteFinishedTxs(updateTimeBefore int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFinishedTxs", updateTimeBefore)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFinishedTxs indicates an expected call of DeleteFinishedTxs.
+func (mr *MockSPDBMockRecorder) DeleteFinishedTxs(updateTimeBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFinishedTxs", reflect.TypeOf((*MockSPDB)(nil).DeleteFinishedTxs), updateTimeBefore)
+}
+
 // DeleteGCObjectProgress mocks base method.
 func (m *MockSPDB) DeleteGCObjectProgress(taskKey string) error {
 	m.ctrl.T.Helper()
@@ -241,6 +269,34 @@ func (mr *MockSPDBMockRecorder) DeleteObjectIntegrity(objectID, redundancyIndex
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObjectIntegrity", reflect.TypeOf((*MockSPDB)(nil).DeleteObjectIntegrity), objectID, redundancyIndex)
 }
 
+// DeleteQueuedTask mocks base method.
+func (m *MockSPDB) DeleteQueuedTask(queueName, taskKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQueuedTask", queueName, taskKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteQueuedTask indicates an expected call of DeleteQueuedTask.
+func (mr *MockSPDBMockRecorder) DeleteQueuedTask(queueName, taskKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQueuedTask", reflect.TypeOf((*MockSPDB)(nil).DeleteQueuedTask), queueName, taskKey)
+}
+
+// DeleteRateLimitCounter mocks base method.
+func (m *MockSPDB) DeleteRateLimitCounter(key string, windowStart int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRateLimitCounter", key, windowStart)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRateLimitCounter indicates an expected call of DeleteRateLimitCounter.
+func (mr *MockSPDBMockRecorder) DeleteRateLimitCounter(key, windowStart any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRateLimitCounter", reflect.TypeOf((*MockSPDB)(nil).DeleteRateLimitCounter), key, windowStart)
+}
+
 // DeleteRecoverFailedObject mocks base method.
 func (m *MockSPDB) DeleteRecoverFailedObject(objectID uint64) error {
 	m.ctrl.T.Helper()
@@ -543,6 +599,21 @@ func (mr *MockSPDBMockRecorder) GetOwnSpInfo() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwnSpInfo", reflect.TypeOf((*MockSPDB)(nil).GetOwnSpInfo))
 }
 
+// GetRateLimitCounter mocks base method.
+func (m *MockSPDB) GetRateLimitCounter(key string, windowStart int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRateLimitCounter", key, windowStart)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRateLimitCounter indicates an expected call of GetRateLimitCounter.
+func (mr *MockSPDBMockRecorder) GetRateLimitCounter(key, windowStart any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRateLimitCounter", reflect.TypeOf((*MockSPDB)(nil).GetRateLimitCounter), key, windowStart)
+}
+
 // GetReadRecord mocks base method.
 func (m *MockSPDB) GetReadRecord(timeRange *TrafficTimeRange) ([]*ReadRecord, error) {
 	m.ctrl.T.Helper()
@@ -633,6 +704,21 @@ func (mr *MockSPDBMockRecorder) GetReplicatePieceChecksum(objectID, segmentIdx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicatePieceChecksum", reflect.TypeOf((*MockSPDB)(nil).GetReplicatePieceChecksum), objectID, segmentIdx, redundancyIdx)
 }
 
+// GetS3AccessKey mocks base method.
+func (m *MockSPDB) GetS3AccessKey(accessKeyID string) (*S3AccessKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetS3AccessKey", accessKeyID)
+	ret0, _ := ret[0].(*S3AccessKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetS3AccessKey indicates an expected call of GetS3AccessKey.
+func (mr *MockSPDBMockRecorder) GetS3AccessKey(accessKeyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetS3AccessKey", reflect.TypeOf((*MockSPDB)(nil).GetS3AccessKey), accessKeyID)
+}
+
 // GetShadowObjectIntegrity mocks base method.
 func (m *MockSPDB) GetShadowObjectIntegrity(objectID uint64, redundancyIndex int32) (*ShadowIntegrityMeta, error) {
 	m.ctrl.T.Helper()
@@ -799,6 +885,21 @@ func (mr *MockSPDBMockRecorder) GetUserReadRecord(userAddress, timeRange any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserReadRecord", reflect.TypeOf((*MockSPDB)(nil).GetUserReadRecord), userAddress, timeRange)
 }
 
+// IncrRateLimitCounter mocks base method.
+func (m *MockSPDB) IncrRateLimitCounter(key string, windowStart, count, expireTime int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrRateLimitCounter", key, windowStart, count, expireTime)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrRateLimitCounter indicates an expected call of IncrRateLimitCounter.
+func (mr *MockSPDBMockRecorder) IncrRateLimitCounter(key, windowStart, count, expireTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrRateLimitCounter", reflect.TypeOf((*MockSPDB)(nil).IncrRateLimitCounter), key, windowStart, count, expireTime)
+}
+
 // InitBucketTraffic mocks base method.
 func (m *MockSPDB) InitBucketTraffic(record *ReadRecord, quota *BucketQuota) error {
 	m.ctrl.T.Helper()
@@ -883,6 +984,20 @@ func (mr *MockSPDBMockRecorder) InsertPutEvent(task any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertPutEvent", reflect.TypeOf((*MockSPDB)(nil).InsertPutEvent), task)
 }
 
+// InsertQueuedTask mocks base method.
+func (m *MockSPDB) InsertQueuedTask(task *QueuedTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertQueuedTask", task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertQueuedTask indicates an expected call of InsertQueuedTask.
+func (mr *MockSPDBMockRecorder) InsertQueuedTask(task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertQueuedTask", reflect.TypeOf((*MockSPDB)(nil).InsertQueuedTask), task)
+}
+
 // InsertRecoverFailedObject mocks base method.
 func (m *MockSPDB) InsertRecoverFailedObject(object *RecoverFailedObject) error {
 	m.ctrl.T.Helper()
@@ -897,6 +1012,20 @@ func (mr *MockSPDBMockRecorder) InsertRecoverFailedObject(object any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertRecoverFailedObject", reflect.TypeOf((*MockSPDB)(nil).InsertRecoverFailedObject), object)
 }
 
+// InsertS3AccessKey mocks base method.
+func (m *MockSPDB) InsertS3AccessKey(accessKey *S3AccessKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertS3AccessKey", accessKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertS3AccessKey indicates an expected call of InsertS3AccessKey.
+func (mr *MockSPDBMockRecorder) InsertS3AccessKey(accessKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertS3AccessKey", reflect.TypeOf((*MockSPDB)(nil).InsertS3AccessKey), accessKey)
+}
+
 // InsertSwapOutUnit mocks base method.
 func (m *MockSPDB) InsertSwapOutUnit(meta *SwapOutMeta) error {
 	m.ctrl.T.Helper()
@@ -911,6 +1040,20 @@ func (mr *MockSPDBMockRecorder) InsertSwapOutUnit(meta any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertSwapOutUnit", reflect.TypeOf((*MockSPDB)(nil).InsertSwapOutUnit), meta)
 }
 
+// InsertTx mocks base method.
+func (m *MockSPDB) InsertTx(tx *TxRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertTx", tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertTx indicates an expected call of InsertTx.
+func (mr *MockSPDBMockRecorder) InsertTx(tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertTx", reflect.TypeOf((*MockSPDB)(nil).InsertTx), tx)
+}
+
 // InsertUploadProgress mocks base method.
 func (m *MockSPDB) InsertUploadProgress(objectID uint64, isAgentUpload bool) error {
 	m.ctrl.T.Helper()
@@ -1015,6 +1158,21 @@ func (mr *MockSPDBMockRecorder) ListMigrateGVGUnitsByBucketID(bucketID any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMigrateGVGUnitsByBucketID", reflect.TypeOf((*MockSPDB)(nil).ListMigrateGVGUnitsByBucketID), bucketID)
 }
 
+// ListQueuedTasks mocks base method.
+func (m *MockSPDB) ListQueuedTasks(queueName string) ([]*QueuedTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQueuedTasks", queueName)
+	ret0, _ := ret[0].([]*QueuedTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQueuedTasks indicates an expected call of ListQueuedTasks.
+func (mr *MockSPDBMockRecorder) ListQueuedTasks(queueName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueuedTasks", reflect.TypeOf((*MockSPDB)(nil).ListQueuedTasks), queueName)
+}
+
 // ListReplicatePieceChecksumByObjectIDRange mocks base method.
 func (m *MockSPDB) ListReplicatePieceChecksumByObjectIDRange(startObjectID, endObjectID int64) ([]*GCPieceMeta, error) {
 	m.ctrl.T.Helper()
@@ -1045,6 +1203,21 @@ func (mr *MockSPDBMockRecorder) ListShadowIntegrityMeta() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListShadowIntegrityMeta", reflect.TypeOf((*MockSPDB)(nil).ListShadowIntegrityMeta))
 }
 
+// ListTxs mocks base method.
+func (m *MockSPDB) ListTxs(account string, statuses []TxStatus, limit int) ([]*TxRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTxs", account, statuses, limit)
+	ret0, _ := ret[0].([]*TxRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTxs indicates an expected call of ListTxs.
+func (mr *MockSPDBMockRecorder) ListTxs(account, statuses, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTxs", reflect.TypeOf((*MockSPDB)(nil).ListTxs), account, statuses, limit)
+}
+
 // QueryBucketMigrateSubscribeProgress mocks base method.
 func (m *MockSPDB) QueryBucketMigrateSubscribeProgress() (uint64, error) {
 	m.ctrl.T.Helper()
@@ -1060,6 +1233,21 @@ func (mr *MockSPDBMockRecorder) QueryBucketMigrateSubscribeProgress() *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryBucketMigrateSubscribeProgress", reflect.TypeOf((*MockSPDB)(nil).QueryBucketMigrateSubscribeProgress))
 }
 
+// QueryInventoryProgress mocks base method.
+func (m *MockSPDB) QueryInventoryProgress(bucketName string) (*InventoryProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryInventoryProgress", bucketName)
+	ret0, _ := ret[0].(*InventoryProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryInventoryProgress indicates an expected call of QueryInventoryProgress.
+func (mr *MockSPDBMockRecorder) QueryInventoryProgress(bucketName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryInventoryProgress", reflect.TypeOf((*MockSPDB)(nil).QueryInventoryProgress), bucketName)
+}
+
 // QueryMigrateBucketProgress mocks base method.
 func (m *MockSPDB) QueryMigrateBucketProgress(bucketID uint64) (*MigrateBucketProgressMeta, error) {
 	m.ctrl.T.Helper()
@@ -1120,6 +1308,21 @@ func (mr *MockSPDBMockRecorder) QuerySPExitSubscribeProgress() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuerySPExitSubscribeProgress", reflect.TypeOf((*MockSPDB)(nil).QuerySPExitSubscribeProgress))
 }
 
+// QueryScrubProgress mocks base method.
+func (m *MockSPDB) QueryScrubProgress(scrubKey string) (*ScrubProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryScrubProgress", scrubKey)
+	ret0, _ := ret[0].(*ScrubProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryScrubProgress indicates an expected call of QueryScrubProgress.
+func (mr *MockSPDBMockRecorder) QueryScrubProgress(scrubKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryScrubProgress", reflect.TypeOf((*MockSPDB)(nil).QueryScrubProgress), scrubKey)
+}
+
 // QuerySwapOutSubscribeProgress mocks base method.
 func (m *MockSPDB) QuerySwapOutSubscribeProgress() (uint64, error) {
 	m.ctrl.T.Helper()
@@ -1416,6 +1619,20 @@ func (mr *MockSPDBMockRecorder) UpdateIntegrityMeta(integrity any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIntegrityMeta", reflect.TypeOf((*MockSPDB)(nil).UpdateIntegrityMeta), integrity)
 }
 
+// UpdateInventoryProgress mocks base method.
+func (m *MockSPDB) UpdateInventoryProgress(progress *InventoryProgress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInventoryProgress", progress)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateInventoryProgress indicates an expected call of UpdateInventoryProgress.
+func (mr *MockSPDBMockRecorder) UpdateInventoryProgress(progress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInventoryProgress", reflect.TypeOf((*MockSPDB)(nil).UpdateInventoryProgress), progress)
+}
+
 // UpdateMigrateGVGMigratedBytesSize mocks base method.
 func (m *MockSPDB) UpdateMigrateGVGMigratedBytesSize(migrateKey string, migratedBytes uint64) error {
 	m.ctrl.T.Helper()
@@ -1528,6 +1745,20 @@ func (mr *MockSPDBMockRecorder) UpdateSPExitSubscribeProgress(blockHeight any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSPExitSubscribeProgress", reflect.TypeOf((*MockSPDB)(nil).UpdateSPExitSubscribeProgress), blockHeight)
 }
 
+// UpdateScrubProgress mocks base method.
+func (m *MockSPDB) UpdateScrubProgress(progress *ScrubProgress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateScrubProgress", progress)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateScrubProgress indicates an expected call of UpdateScrubProgress.
+func (mr *MockSPDBMockRecorder) UpdateScrubProgress(progress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScrubProgress", reflect.TypeOf((*MockSPDB)(nil).UpdateScrubProgress), progress)
+}
+
 // UpdateShadowIntegrityChecksum mocks base method.
 func (m *MockSPDB) UpdateShadowIntegrityChecksum(integrity *ShadowIntegrityMeta) error {
 	m.ctrl.T.Helper()
@@ -1584,6 +1815,20 @@ func (mr *MockSPDBMockRecorder) UpdateSwapOutUnitCompletedGVGList(swapOutKey, co
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSwapOutUnitCompletedGVGList", reflect.TypeOf((*MockSPDB)(nil).UpdateSwapOutUnitCompletedGVGList), swapOutKey, completedGVGList)
 }
 
+// UpdateTx mocks base method.
+func (m *MockSPDB) UpdateTx(tx *TxRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTx", tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTx indicates an expected call of UpdateTx.
+func (mr *MockSPDBMockRecorder) UpdateTx(tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTx", reflect.TypeOf((*MockSPDB)(nil).UpdateTx), tx)
+}
+
 // UpdateUploadProgress mocks base method.
 func (m *MockSPDB) UpdateUploadProgress(uploadMeta *UploadObjectMeta) error {
 	m.ctrl.T.Helper()
@@ -2725,6 +2970,58 @@ func (mr *MockOffChainAuthKeyV2DBMockRecorder) ListAuthKeysV2(userAddress, domai
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuthKeysV2", reflect.TypeOf((*MockOffChainAuthKeyV2DB)(nil).ListAuthKeysV2), userAddress, domain)
 }
 
+// MockS3AccessKeyDB is a mock of S3AccessKeyDB interface.
+type MockS3AccessKeyDB struct {
+	ctrl     *gomock.Controller
+	recorder *MockS3AccessKeyDBMockRecorder
+}
+
+// MockS3AccessKeyDBMockRecorder is the mock recorder for MockS3AccessKeyDB.
+type MockS3AccessKeyDBMockRecorder struct {
+	mock *MockS3AccessKeyDB
+}
+
+// NewMockS3AccessKeyDB creates a new mock instance.
+func NewMockS3AccessKeyDB(ctrl *gomock.Controller) *MockS3AccessKeyDB {
+	mock := &MockS3AccessKeyDB{ctrl: ctrl}
+	mock.recorder = &MockS3AccessKeyDBMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockS3AccessKeyDB) EXPECT() *MockS3AccessKeyDBMockRecorder {
+	return m.recorder
+}
+
+// GetS3AccessKey mocks base method.
+func (m *MockS3AccessKeyDB) GetS3AccessKey(accessKeyID string) (*S3AccessKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetS3AccessKey", accessKeyID)
+	ret0, _ := ret[0].(*S3AccessKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetS3AccessKey indicates an expected call of GetS3AccessKey.
+func (mr *MockS3AccessKeyDBMockRecorder) GetS3AccessKey(accessKeyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetS3AccessKey", reflect.TypeOf((*MockS3AccessKeyDB)(nil).GetS3AccessKey), accessKeyID)
+}
+
+// InsertS3AccessKey mocks base method.
+func (m *MockS3AccessKeyDB) InsertS3AccessKey(accessKey *S3AccessKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertS3AccessKey", accessKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertS3AccessKey indicates an expected call of InsertS3AccessKey.
+func (mr *MockS3AccessKeyDBMockRecorder) InsertS3AccessKey(accessKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertS3AccessKey", reflect.TypeOf((*MockS3AccessKeyDB)(nil).InsertS3AccessKey), accessKey)
+}
+
 // MockMigrateDB is a mock of MigrateDB interface.
 type MockMigrateDB struct {
 	ctrl     *gomock.Controller
@@ -3360,3 +3657,334 @@ func (mr *MockExitRecoverDBMockRecorder) UpdateRecoverGVGStats(stats any) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRecoverGVGStats", reflect.TypeOf((*MockExitRecoverDB)(nil).UpdateRecoverGVGStats), stats)
 }
+
+// MockTaskQueueDB is a mock of TaskQueueDB interface.
+type MockTaskQueueDB struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskQueueDBMockRecorder
+}
+
+// MockTaskQueueDBMockRecorder is the mock recorder for MockTaskQueueDB.
+type MockTaskQueueDBMockRecorder struct {
+	mock *MockTaskQueueDB
+}
+
+// NewMockTaskQueueDB creates a new mock instance.
+func NewMockTaskQueueDB(ctrl *gomock.Controller) *MockTaskQueueDB {
+	mock := &MockTaskQueueDB{ctrl: ctrl}
+	mock.recorder = &MockTaskQueueDBMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskQueueDB) EXPECT() *MockTaskQueueDBMockRecorder {
+	return m.recorder
+}
+
+// DeleteQueuedTask mocks base method.
+func (m *MockTaskQueueDB) DeleteQueuedTask(queueName, taskKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQueuedTask", queueName, taskKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteQueuedTask indicates an expected call of DeleteQueuedTask.
+func (mr *MockTaskQueueDBMockRecorder) DeleteQueuedTask(queueName, taskKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQueuedTask", reflect.TypeOf((*MockTaskQueueDB)(nil).DeleteQueuedTask), queueName, taskKey)
+}
+
+// InsertQueuedTask mocks base method.
+func (m *MockTaskQueueDB) InsertQueuedTask(task *QueuedTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertQueuedTask", task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertQueuedTask indicates an expected call of InsertQueuedTask.
+func (mr *MockTaskQueueDBMockRecorder) InsertQueuedTask(task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertQueuedTask", reflect.TypeOf((*MockTaskQueueDB)(nil).InsertQueuedTask), task)
+}
+
+// ListQueuedTasks mocks base method.
+func (m *MockTaskQueueDB) ListQueuedTasks(queueName string) ([]*QueuedTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQueuedTasks", queueName)
+	ret0, _ := ret[0].([]*QueuedTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQueuedTasks indicates an expected call of ListQueuedTasks.
+func (mr *MockTaskQueueDBMockRecorder) ListQueuedTasks(queueName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueuedTasks", reflect.TypeOf((*MockTaskQueueDB)(nil).ListQueuedTasks), queueName)
+}
+
+// MockScrubDB is a mock of ScrubDB interface.
+type MockScrubDB struct {
+	ctrl     *gomock.Controller
+	recorder *MockScrubDBMockRecorder
+}
+
+// MockScrubDBMockRecorder is the mock recorder for MockScrubDB.
+type MockScrubDBMockRecorder struct {
+	mock *MockScrubDB
+}
+
+// NewMockScrubDB creates a new mock instance.
+func NewMockScrubDB(ctrl *gomock.Controller) *MockScrubDB {
+	mock := &MockScrubDB{ctrl: ctrl}
+	mock.recorder = &MockScrubDBMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScrubDB) EXPECT() *MockScrubDBMockRecorder {
+	return m.recorder
+}
+
+// QueryScrubProgress mocks base method.
+func (m *MockScrubDB) QueryScrubProgress(scrubKey string) (*ScrubProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryScrubProgress", scrubKey)
+	ret0, _ := ret[0].(*ScrubProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryScrubProgress indicates an expected call of QueryScrubProgress.
+func (mr *MockScrubDBMockRecorder) QueryScrubProgress(scrubKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryScrubProgress", reflect.TypeOf((*MockScrubDB)(nil).QueryScrubProgress), scrubKey)
+}
+
+// UpdateScrubProgress mocks base method.
+func (m *MockScrubDB) UpdateScrubProgress(progress *ScrubProgress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateScrubProgress", progress)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateScrubProgress indicates an expected call of UpdateScrubProgress.
+func (mr *MockScrubDBMockRecorder) UpdateScrubProgress(progress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScrubProgress", reflect.TypeOf((*MockScrubDB)(nil).UpdateScrubProgress), progress)
+}
+
+// MockTxDB is a mock of TxDB interface.
+type MockTxDB struct {
+	ctrl     *gomock.Controller
+	recorder *MockTxDBMockRecorder
+}
+
+// MockTxDBMockRecorder is the mock recorder for MockTxDB.
+type MockTxDBMockRecorder struct {
+	mock *MockTxDB
+}
+
+// NewMockTxDB creates a new mock instance.
+func NewMockTxDB(ctrl *gomock.Controller) *MockTxDB {
+	mock := &MockTxDB{ctrl: ctrl}
+	mock.recorder = &MockTxDBMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTxDB) EXPECT() *MockTxDBMockRecorder {
+	return m.recorder
+}
+
+// DeleteFinishedTxs mocks base method.
+func (m *MockTxDB) DeleteFinishedTxs(updateTimeBefore int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFinishedTxs", updateTimeBefore)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFinishedTxs indicates an expected call of DeleteFinishedTxs.
+func (mr *MockTxDBMockRecorder) DeleteFinishedTxs(updateTimeBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFinishedTxs", reflect.TypeOf((*MockTxDB)(nil).DeleteFinishedTxs), updateTimeBefore)
+}
+
+// InsertTx mocks base method.
+func (m *MockTxDB) InsertTx(tx *TxRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertTx", tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertTx indicates an expected call of InsertTx.
+func (mr *MockTxDBMockRecorder) InsertTx(tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertTx", reflect.TypeOf((*MockTxDB)(nil).InsertTx), tx)
+}
+
+// ListTxs mocks base method.
+func (m *MockTxDB) ListTxs(account string, statuses []TxStatus, limit int) ([]*TxRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTxs", account, statuses, limit)
+	ret0, _ := ret[0].([]*TxRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTxs indicates an expected call of ListTxs.
+func (mr *MockTxDBMockRecorder) ListTxs(account, statuses, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTxs", reflect.TypeOf((*MockTxDB)(nil).ListTxs), account, statuses, limit)
+}
+
+// UpdateTx mocks base method.
+func (m *MockTxDB) UpdateTx(tx *TxRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTx", tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTx indicates an expected call of UpdateTx.
+func (mr *MockTxDBMockRecorder) UpdateTx(tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTx", reflect.TypeOf((*MockTxDB)(nil).UpdateTx), tx)
+}
+
+// MockInventoryDB is a mock of InventoryDB interface.
+type MockInventoryDB struct {
+	ctrl     *gomock.Controller
+	recorder *MockInventoryDBMockRecorder
+}
+
+// MockInventoryDBMockRecorder is the mock recorder for MockInventoryDB.
+type MockInventoryDBMockRecorder struct {
+	mock *MockInventoryDB
+}
+
+// NewMockInventoryDB creates a new mock instance.
+func NewMockInventoryDB(ctrl *gomock.Controller) *MockInventoryDB {
+	mock := &MockInventoryDB{ctrl: ctrl}
+	mock.recorder = &MockInventoryDBMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInventoryDB) EXPECT() *MockInventoryDBMockRecorder {
+	return m.recorder
+}
+
+// QueryInventoryProgress mocks base method.
+func (m *MockInventoryDB) QueryInventoryProgress(bucketName string) (*InventoryProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryInventoryProgress", bucketName)
+	ret0, _ := ret[0].(*InventoryProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryInventoryProgress indicates an expected call of QueryInventoryProgress.
+func (mr *MockInventoryDBMockRecorder) QueryInventoryProgress(bucketName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryInventoryProgress", reflect.TypeOf((*MockInventoryDB)(nil).QueryInventoryProgress), bucketName)
+}
+
+// UpdateInventoryProgress mocks base method.
+func (m *MockInventoryDB) UpdateInventoryProgress(progress *InventoryProgress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInventoryProgress", progress)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateInventoryProgress indicates an expected call of UpdateInventoryProgress.
+func (mr *MockInventoryDBMockRecorder) UpdateInventoryProgress(progress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInventoryProgress", reflect.TypeOf((*MockInventoryDB)(nil).UpdateInventoryProgress), progress)
+}
+
+// MockRateLimitDB is a mock of RateLimitDB interface.
+type MockRateLimitDB struct {
+	ctrl     *gomock.Controller
+	recorder *MockRateLimitDBMockRecorder
+}
+
+// MockRateLimitDBMockRecorder is the mock recorder for MockRateLimitDB.
+type MockRateLimitDBMockRecorder struct {
+	mock *MockRateLimitDB
+}
+
+// NewMockRateLimitDB creates a new mock instance.
+func NewMockRateLimitDB(ctrl *gomock.Controller) *MockRateLimitDB {
+	mock := &MockRateLimitDB{ctrl: ctrl}
+	mock.recorder = &MockRateLimitDBMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRateLimitDB) EXPECT() *MockRateLimitDBMockRecorder {
+	return m.recorder
+}
+
+// DeleteExpiredRateLimitCounters mocks base method.
+func (m *MockRateLimitDB) DeleteExpiredRateLimitCounters(expireTimeBefore int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredRateLimitCounters", expireTimeBefore)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpiredRateLimitCounters indicates an expected call of DeleteExpiredRateLimitCounters.
+func (mr *MockRateLimitDBMockRecorder) DeleteExpiredRateLimitCounters(expireTimeBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredRateLimitCounters", reflect.TypeOf((*MockRateLimitDB)(nil).DeleteExpiredRateLimitCounters), expireTimeBefore)
+}
+
+// DeleteRateLimitCounter mocks base method.
+func (m *MockRateLimitDB) DeleteRateLimitCounter(key string, windowStart int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRateLimitCounter", key, windowStart)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRateLimitCounter indicates an expected call of DeleteRateLimitCounter.
+func (mr *MockRateLimitDBMockRecorder) DeleteRateLimitCounter(key, windowStart any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRateLimitCounter", reflect.TypeOf((*MockRateLimitDB)(nil).DeleteRateLimitCounter), key, windowStart)
+}
+
+// GetRateLimitCounter mocks base method.
+func (m *MockRateLimitDB) GetRateLimitCounter(key string, windowStart int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRateLimitCounter", key, windowStart)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRateLimitCounter indicates an expected call of GetRateLimitCounter.
+func (mr *MockRateLimitDBMockRecorder) GetRateLimitCounter(key, windowStart any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRateLimitCounter", reflect.TypeOf((*MockRateLimitDB)(nil).GetRateLimitCounter), key, windowStart)
+}
+
+// IncrRateLimitCounter mocks base method.
+func (m *MockRateLimitDB) IncrRateLimitCounter(key string, windowStart, count, expireTime int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrRateLimitCounter", key, windowStart, count, expireTime)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrRateLimitCounter indicates an expected call of IncrRateLimitCounter.
+func (mr *MockRateLimitDBMockRecorder) IncrRateLimitCounter(key, windowStart, count, expireTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrRateLimitCounter", reflect.TypeOf((*MockRateLimitDB)(nil).IncrRateLimitCounter), key, windowStart, count, expireTime)
+}
@@ -73,6 +73,7 @@ func TestNullTask(t *testing.T) {
 	n.SetSecondarySignatures(nil)
 	n.SetSecondaryAddresses(nil)
 	n.GetSecondaryEndpoints()
+	n.SetSecondaryEndpoints(nil)
 	n.InitSealObjectTask(0, nil, nil, 0, nil, nil, 0, 0, false)
 	n.InitReceivePieceTask(0, nil, nil, 0, 0, 0, 0, false)
 	n.GetReplicateIdx()
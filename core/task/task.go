@@ -239,6 +239,20 @@ type UploadObjectTask interface {
 	GetVirtualGroupFamilyId() uint32
 	// GetIsAgentUpload returns Whether the task is a agent upload
 	GetIsAgentUpload() bool
+	// GetGlobalVirtualGroupId returns the global virtual group which the object is replicated to while
+	// uploading, it is zero if the object is not replicated while uploading.
+	GetGlobalVirtualGroupId() uint32
+	// SetGlobalVirtualGroupID sets the global virtual group which the object is replicated to.
+	SetGlobalVirtualGroupID(uint32)
+	// GetSecondaryEndpoints returns the endpoints of the secondary SPs which the object is replicated to.
+	GetSecondaryEndpoints() []string
+	// SetSecondaryEndpoints sets the endpoints of the secondary SPs which the object is replicated to.
+	SetSecondaryEndpoints([]string)
+	// GetSecondarySignatures returns the secondary SP's signatures. It is used to generate MsgSealObject
+	// if the object is replicated while uploading.
+	GetSecondarySignatures() [][]byte
+	// SetSecondarySignatures sets the secondary SP's signatures.
+	SetSecondarySignatures([][]byte)
 }
 
 // The ResumableUploadObjectTask is the interface to record the information for uploading object
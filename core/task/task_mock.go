@@ -3517,6 +3517,20 @@ func (mr *MockUploadObjectTaskMockRecorder) GetCreateTime() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCreateTime", reflect.TypeOf((*MockUploadObjectTask)(nil).GetCreateTime))
 }
 
+// GetGlobalVirtualGroupId mocks base method.
+func (m *MockUploadObjectTask) GetGlobalVirtualGroupId() uint32 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGlobalVirtualGroupId")
+	ret0, _ := ret[0].(uint32)
+	return ret0
+}
+
+// GetGlobalVirtualGroupId indicates an expected call of GetGlobalVirtualGroupId.
+func (mr *MockUploadObjectTaskMockRecorder) GetGlobalVirtualGroupId() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGlobalVirtualGroupId", reflect.TypeOf((*MockUploadObjectTask)(nil).GetGlobalVirtualGroupId))
+}
+
 // GetIsAgentUpload mocks base method.
 func (m *MockUploadObjectTask) GetIsAgentUpload() bool {
 	m.ctrl.T.Helper()
@@ -3601,6 +3615,34 @@ func (mr *MockUploadObjectTaskMockRecorder) GetRetry() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRetry", reflect.TypeOf((*MockUploadObjectTask)(nil).GetRetry))
 }
 
+// GetSecondaryEndpoints mocks base method.
+func (m *MockUploadObjectTask) GetSecondaryEndpoints() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecondaryEndpoints")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// GetSecondaryEndpoints indicates an expected call of GetSecondaryEndpoints.
+func (mr *MockUploadObjectTaskMockRecorder) GetSecondaryEndpoints() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecondaryEndpoints", reflect.TypeOf((*MockUploadObjectTask)(nil).GetSecondaryEndpoints))
+}
+
+// GetSecondarySignatures mocks base method.
+func (m *MockUploadObjectTask) GetSecondarySignatures() [][]byte {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecondarySignatures")
+	ret0, _ := ret[0].([][]byte)
+	return ret0
+}
+
+// GetSecondarySignatures indicates an expected call of GetSecondarySignatures.
+func (mr *MockUploadObjectTaskMockRecorder) GetSecondarySignatures() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecondarySignatures", reflect.TypeOf((*MockUploadObjectTask)(nil).GetSecondarySignatures))
+}
+
 // GetStorageParams mocks base method.
 func (m *MockUploadObjectTask) GetStorageParams() *types0.Params {
 	m.ctrl.T.Helper()
@@ -3759,6 +3801,18 @@ func (mr *MockUploadObjectTaskMockRecorder) SetError(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetError", reflect.TypeOf((*MockUploadObjectTask)(nil).SetError), arg0)
 }
 
+// SetGlobalVirtualGroupID mocks base method.
+func (m *MockUploadObjectTask) SetGlobalVirtualGroupID(arg0 uint32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetGlobalVirtualGroupID", arg0)
+}
+
+// SetGlobalVirtualGroupID indicates an expected call of SetGlobalVirtualGroupID.
+func (mr *MockUploadObjectTaskMockRecorder) SetGlobalVirtualGroupID(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGlobalVirtualGroupID", reflect.TypeOf((*MockUploadObjectTask)(nil).SetGlobalVirtualGroupID), arg0)
+}
+
 // SetLogs mocks base method.
 func (m *MockUploadObjectTask) SetLogs(logs string) {
 	m.ctrl.T.Helper()
@@ -3819,6 +3873,30 @@ func (mr *MockUploadObjectTaskMockRecorder) SetRetry(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRetry", reflect.TypeOf((*MockUploadObjectTask)(nil).SetRetry), arg0)
 }
 
+// SetSecondaryEndpoints mocks base method.
+func (m *MockUploadObjectTask) SetSecondaryEndpoints(arg0 []string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSecondaryEndpoints", arg0)
+}
+
+// SetSecondaryEndpoints indicates an expected call of SetSecondaryEndpoints.
+func (mr *MockUploadObjectTaskMockRecorder) SetSecondaryEndpoints(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSecondaryEndpoints", reflect.TypeOf((*MockUploadObjectTask)(nil).SetSecondaryEndpoints), arg0)
+}
+
+// SetSecondarySignatures mocks base method.
+func (m *MockUploadObjectTask) SetSecondarySignatures(arg0 [][]byte) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSecondarySignatures", arg0)
+}
+
+// SetSecondarySignatures indicates an expected call of SetSecondarySignatures.
+func (mr *MockUploadObjectTaskMockRecorder) SetSecondarySignatures(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSecondarySignatures", reflect.TypeOf((*MockUploadObjectTask)(nil).SetSecondarySignatures), arg0)
+}
+
 // SetStorageParams mocks base method.
 func (m *MockUploadObjectTask) SetStorageParams(arg0 *types0.Params) {
 	m.ctrl.T.Helper()
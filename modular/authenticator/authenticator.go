@@ -3,6 +3,7 @@ package authenticator
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -11,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspapp"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gnfd"
 	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsperrors"
 	"github.com/bnb-chain/greenfield-storage-provider/core/module"
 	coremodule "github.com/bnb-chain/greenfield-storage-provider/core/module"
@@ -312,6 +314,30 @@ func (a *AuthenticationModular) VerifyAuthentication(
 			return false, ErrNoSuchBucket
 		}
 		return true, nil
+	case coremodule.AuthOpTypeManageBucketNotification:
+		queryTime := time.Now()
+		bucketInfo, _ := a.baseApp.Consensus().QueryBucketInfo(ctx, bucket)
+		metrics.PerfAuthTimeHistogram.WithLabelValues("auth_server_manage_bucket_notification_query_bucket_time").Observe(time.Since(queryTime).Seconds())
+		if bucketInfo == nil {
+			log.CtxErrorw(ctx, "failed to verify authentication of managing bucket notification, bucket not existed",
+				"bucket", bucket)
+			return false, ErrNoSuchBucket
+		}
+		spID, err := a.getSPID()
+		if err != nil {
+			return false, ErrConsensusWithDetail("getSPID error: " + err.Error())
+		}
+		bucketSPID, err := util.GetBucketPrimarySPID(ctx, a.baseApp.Consensus(), bucketInfo)
+		if err != nil {
+			return false, ErrConsensusWithDetail("GetBucketPrimarySPID error: " + err.Error())
+		}
+		if bucketSPID != spID {
+			log.CtxErrorw(ctx, "sp operator address mismatch", "actual_sp_id", spID,
+				"expected_sp_id", bucketSPID)
+			return false, ErrMismatchSp
+		}
+		// only the bucket owner can manage the notification, the webhooks receive the events of all the objects
+		return strings.EqualFold(bucketInfo.GetOwner(), account), nil
 	case coremodule.AuthOpAskCreateObjectApproval:
 		queryTime := time.Now()
 		bucketInfo, objectInfo, _ := a.baseApp.Consensus().QueryBucketInfoAndObjectInfo(ctx, bucket, object)
@@ -355,7 +381,7 @@ func (a *AuthenticationModular) VerifyAuthentication(
 			if strings.Contains(err.Error(), "No such bucket") {
 				return false, ErrNoSuchBucket
 			}
-			if strings.Contains(err.Error(), "No such object") {
+			if errors.Is(err, gnfd.ErrNoSuchObject) {
 				return false, ErrNoSuchObject
 			}
 			return false, ErrConsensusWithDetail("failed to get bucket and object info from consensus, error: " + err.Error())
@@ -408,7 +434,7 @@ func (a *AuthenticationModular) VerifyAuthentication(
 			if strings.Contains(err.Error(), "No such bucket") {
 				return false, ErrNoSuchBucket
 			}
-			if strings.Contains(err.Error(), "No such object") {
+			if errors.Is(err, gnfd.ErrNoSuchObject) {
 				return false, ErrNoSuchObject
 			}
 			return false, ErrConsensusWithDetail("failed to get bucket and object info from consensus, error: " + err.Error())
@@ -437,7 +463,7 @@ func (a *AuthenticationModular) VerifyAuthentication(
 			if strings.Contains(err.Error(), "No such bucket") {
 				return false, ErrNoSuchBucket
 			}
-			if strings.Contains(err.Error(), "No such object") {
+			if errors.Is(err, gnfd.ErrNoSuchObject) {
 				return false, ErrNoSuchObject
 			}
 			return false, ErrConsensusWithDetail("failed to get bucket and object info from consensus, error: " + err.Error())
@@ -504,7 +530,7 @@ func (a *AuthenticationModular) VerifyAuthentication(
 			if strings.Contains(err.Error(), "No such bucket") {
 				return false, ErrNoSuchBucket
 			}
-			if strings.Contains(err.Error(), "No such object") {
+			if errors.Is(err, gnfd.ErrNoSuchObject) {
 				return false, ErrNoSuchObject
 			}
 			return false, ErrConsensusWithDetail("failed to get bucket and object info from consensus, error: " + err.Error())
@@ -566,7 +592,7 @@ func (a *AuthenticationModular) VerifyAuthentication(
 			if strings.Contains(err.Error(), "No such bucket") {
 				return false, ErrNoSuchBucket
 			}
-			if strings.Contains(err.Error(), "No such object") {
+			if errors.Is(err, gnfd.ErrNoSuchObject) {
 				return false, ErrNoSuchObject
 			}
 			return false, ErrConsensusWithDetail("failed to get object info from consensus, error: " + err.Error())
@@ -21,6 +21,7 @@ import (
 
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspapp"
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspclient"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gnfd"
 	"github.com/bnb-chain/greenfield-storage-provider/core/consensus"
 	"github.com/bnb-chain/greenfield-storage-provider/core/module"
 	coremodule "github.com/bnb-chain/greenfield-storage-provider/core/module"
@@ -830,7 +831,7 @@ func VerifyObjectAndBucketAndSPID(t *testing.T, authType coremodule.AuthOpType)
 
 	// object does not exist
 	mockedConsensus = consensus.NewMockConsensus(ctrl)
-	mockedConsensus.EXPECT().QueryBucketInfoAndObjectInfo(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil, errors.New("error: No such object")).Times(1)
+	mockedConsensus.EXPECT().QueryBucketInfoAndObjectInfo(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil, gnfd.ErrNoSuchObject).Times(1)
 	a.baseApp.SetConsensus(mockedConsensus)
 	_, err = a.VerifyAuthentication(context.Background(), authType, userAddress, "test_bucket", "test_object")
 	assert.Equal(t, ErrNoSuchObject, err)
@@ -972,6 +973,50 @@ func Test_VerifyAuth_GetUploadingState(t *testing.T) {
 	assert.Equal(t, true, verifyResult)
 }
 
+func Test_VerifyAuth_ManageBucketNotification(t *testing.T) {
+	authType := coremodule.AuthOpTypeManageBucketNotification
+	privateKey, _ := crypto.GenerateKey()
+	ownerAddress := crypto.PubkeyToAddress(privateKey.PublicKey).Hex()
+	privateKey, _ = crypto.GenerateKey()
+	userAddress := crypto.PubkeyToAddress(privateKey.PublicKey).Hex()
+
+	// bucket not existed
+	a := setup(t)
+	ctrl := gomock.NewController(t)
+	mockedConsensus := consensus.NewMockConsensus(ctrl)
+	mockedConsensus.EXPECT().QueryBucketInfo(gomock.Any(), gomock.Any()).Return(nil, errors.New("error")).Times(1)
+	a.baseApp.SetConsensus(mockedConsensus)
+	_, err := a.VerifyAuthentication(context.Background(), authType, ownerAddress, "test_bucket", "")
+	assert.Equal(t, ErrNoSuchBucket, err)
+
+	// sp mismatch
+	a = setup(t)
+	mockedConsensus = consensus.NewMockConsensus(ctrl)
+	mockedConsensus.EXPECT().QueryBucketInfo(gomock.Any(), gomock.Any()).Return(&storagetypes.BucketInfo{Owner: ownerAddress}, nil).Times(1)
+	mockedConsensus.EXPECT().QuerySP(gomock.Any(), gomock.Any()).Return(&sptypes.StorageProvider{Id: 1}, nil).Times(1)
+	mockedConsensus.EXPECT().QueryVirtualGroupFamily(gomock.Any(), gomock.Any()).Return(&virtualgrouptypes.GlobalVirtualGroupFamily{
+		PrimarySpId: 2,
+	}, nil).Times(1)
+	a.baseApp.SetConsensus(mockedConsensus)
+	_, err = a.VerifyAuthentication(context.Background(), authType, ownerAddress, "test_bucket", "")
+	assert.Equal(t, ErrMismatchSp, err)
+
+	// only the owner is allowed
+	for _, account := range []string{ownerAddress, userAddress} {
+		a = setup(t)
+		mockedConsensus = consensus.NewMockConsensus(ctrl)
+		mockedConsensus.EXPECT().QueryBucketInfo(gomock.Any(), gomock.Any()).Return(&storagetypes.BucketInfo{Owner: ownerAddress}, nil).Times(1)
+		mockedConsensus.EXPECT().QuerySP(gomock.Any(), gomock.Any()).Return(&sptypes.StorageProvider{Id: 1}, nil).Times(1)
+		mockedConsensus.EXPECT().QueryVirtualGroupFamily(gomock.Any(), gomock.Any()).Return(&virtualgrouptypes.GlobalVirtualGroupFamily{
+			PrimarySpId: 1,
+		}, nil).Times(1)
+		a.baseApp.SetConsensus(mockedConsensus)
+		verifyResult, err := a.VerifyAuthentication(context.Background(), authType, account, "test_bucket", "")
+		assert.Nil(t, err)
+		assert.Equal(t, account == ownerAddress, verifyResult)
+	}
+}
+
 func Test_VerifyAuth_GetObject(t *testing.T) {
 	VerifyObjectAndBucketAndSPID(t, coremodule.AuthOpTypeGetObject)
 
@@ -1111,7 +1156,7 @@ func Test_VerifyAuth_RecoveryPiece(t *testing.T) {
 
 	// object does not exist
 	mockedConsensus = consensus.NewMockConsensus(ctrl)
-	mockedConsensus.EXPECT().QueryBucketInfoAndObjectInfo(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil, errors.New("error: No such object")).Times(1)
+	mockedConsensus.EXPECT().QueryBucketInfoAndObjectInfo(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil, gnfd.ErrNoSuchObject).Times(1)
 	a.baseApp.SetConsensus(mockedConsensus)
 	_, err = a.VerifyAuthentication(context.Background(), authType, userAddress, "test_bucket", "test_object")
 	assert.Equal(t, ErrNoSuchObject, err)
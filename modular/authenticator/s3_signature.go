@@ -0,0 +1,135 @@
+package authenticator
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsperrors"
+	"github.com/bnb-chain/greenfield-storage-provider/core/module"
+	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+)
+
+const (
+	// S3SignAlgorithm is the algorithm of the AWS signature version 4.
+	S3SignAlgorithm = "AWS4-HMAC-SHA256"
+	// S3AccessKeyIDPrefix is the prefix of the access key ids issued by the SP.
+	S3AccessKeyIDPrefix = "GNFD"
+	// s3AccessKeyIDRandomLength is the length of the random part of the access key id, the access key id has the
+	// same length as the AWS one.
+	s3AccessKeyIDRandomLength = 16
+	// s3SecretKeyLength is the bytes of the random secret key.
+	s3SecretKeyLength = 30
+	// S3ScopeTerminator is the last part of the credential scope.
+	S3ScopeTerminator = "aws4_request"
+)
+
+var (
+	ErrS3AccessKeyNotExist   = gfsperrors.Register(module.AuthenticationModularName, http.StatusForbidden, 20021, "The AWS access key Id you provided does not exist in our records")
+	ErrS3SignatureMismatch   = gfsperrors.Register(module.AuthenticationModularName, http.StatusForbidden, 20022, "The request signature we calculated does not match the signature you provided")
+	ErrInvalidS3StringToSign = gfsperrors.Register(module.AuthenticationModularName, http.StatusBadRequest, 20023, "invalid AWS signature version 4 string to sign")
+)
+
+var s3AccessKeyIDAlphabet = []byte("ABCDEFGHIJKLMNOPQRSTUVWXYZ234567")
+
+// CreateS3AccessKey creates an access key of the S3 compatible api, the access key is bound to the registered
+// off-chain auth key, and it becomes invalid after the off-chain auth key is deleted or expired.
+func (a *AuthenticationModular) CreateS3AccessKey(ctx context.Context, account string, domain string, publicKey string) (*spdb.S3AccessKey, error) {
+	if err := a.checkAuthKeyV2(ctx, account, domain, publicKey); err != nil {
+		return nil, err
+	}
+	accessKeyID := make([]byte, s3AccessKeyIDRandomLength)
+	for i := range accessKeyID {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(s3AccessKeyIDAlphabet))))
+		if err != nil {
+			return nil, err
+		}
+		accessKeyID[i] = s3AccessKeyIDAlphabet[n.Int64()]
+	}
+	secretKey := make([]byte, s3SecretKeyLength)
+	if _, err := rand.Read(secretKey); err != nil {
+		return nil, err
+	}
+	accessKey := &spdb.S3AccessKey{
+		AccessKeyID: S3AccessKeyIDPrefix + string(accessKeyID),
+		SecretKey:   base64.StdEncoding.EncodeToString(secretKey),
+		UserAddress: account,
+		Domain:      domain,
+		PublicKey:   publicKey,
+		CreatedTime: time.Now(),
+	}
+	if err := a.baseApp.GfSpDB().InsertS3AccessKey(accessKey); err != nil {
+		log.CtxErrorw(ctx, "failed to insert s3 access key", "error", err)
+		return nil, err
+	}
+	log.CtxInfow(ctx, "succeed to create s3 access key", "access_key_id", accessKey.AccessKeyID)
+	return accessKey, nil
+}
+
+// VerifyS3Signature verifies the AWS signature version 4 of the request which is signed by the access key, and
+// returns the account which the access key is issued to. The credential scope is parsed from the string to sign.
+func (a *AuthenticationModular) VerifyS3Signature(ctx context.Context, accessKeyID string, stringToSign []byte, signature string) (string, error) {
+	// the string to sign is "AWS4-HMAC-SHA256\n<time>\n<date>/<region>/<service>/aws4_request\n<canonical request hash>"
+	lines := strings.Split(string(stringToSign), "\n")
+	if len(lines) != 4 || lines[0] != S3SignAlgorithm {
+		return "", ErrInvalidS3StringToSign
+	}
+	scope := strings.Split(lines[2], "/")
+	if len(scope) != 4 || scope[3] != S3ScopeTerminator {
+		return "", ErrInvalidS3StringToSign
+	}
+	accessKey, err := a.baseApp.GfSpDB().GetS3AccessKey(accessKeyID)
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to get s3 access key", "error", err)
+		return "", err
+	}
+	if accessKey == nil {
+		return "", ErrS3AccessKeyNotExist
+	}
+	if err = a.checkAuthKeyV2(ctx, accessKey.UserAddress, accessKey.Domain, accessKey.PublicKey); err != nil {
+		return "", err
+	}
+	expected := S3Signature(accessKey.SecretKey, scope[0], scope[1], scope[2], stringToSign)
+	if !hmac.Equal([]byte(expected), []byte(strings.ToLower(signature))) {
+		return "", ErrS3SignatureMismatch
+	}
+	return accessKey.UserAddress, nil
+}
+
+// checkAuthKeyV2 checks the off-chain auth key is registered and not expired.
+func (a *AuthenticationModular) checkAuthKeyV2(ctx context.Context, account string, domain string, publicKey string) error {
+	authKey, err := a.GetAuthKeyV2(ctx, account, domain, publicKey)
+	if err != nil {
+		return err
+	}
+	if authKey == nil {
+		return ErrPublicKeyNotExist
+	}
+	if time.Until(authKey.ExpiryDate) < 0 {
+		return ErrPublicKeyExpired
+	}
+	return nil
+}
+
+// S3Signature returns the hex encoded AWS signature version 4 of the string to sign.
+func S3Signature(secretKey, date, region, service string, stringToSign []byte) string {
+	signingKey := hmacSHA256([]byte("AWS4"+secretKey), []byte(date))
+	signingKey = hmacSHA256(signingKey, []byte(region))
+	signingKey = hmacSHA256(signingKey, []byte(service))
+	signingKey = hmacSHA256(signingKey, []byte(S3ScopeTerminator))
+	return hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+}
+
+func hmacSHA256(key, data []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write(data)
+	return h.Sum(nil)
+}
@@ -0,0 +1,205 @@
+package authenticator
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+)
+
+// the example of the GET object request in the AWS signature version 4 document
+const (
+	mockS3SecretKey    = "wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY"
+	mockS3StringToSign = "AWS4-HMAC-SHA256\n20130524T000000Z\n20130524/us-east-1/s3/aws4_request\n" +
+		"7344ae5b7ee6c3e7e6b0fe0640412a37625d1fbfff95c48bbb2dc43964946972"
+	mockS3Signature = "f0e8bdb87c964420e857bd35b5d6ed310bd44f0170aba48dd91039c6036bdb41"
+)
+
+func mockS3AccessKey() *spdb.S3AccessKey {
+	return &spdb.S3AccessKey{
+		AccessKeyID: "GNFDAAAAAAAAAAAAAAAA",
+		SecretKey:   mockS3SecretKey,
+		UserAddress: "user",
+		Domain:      "domain",
+		PublicKey:   "key",
+	}
+}
+
+func TestS3Signature(t *testing.T) {
+	result := S3Signature(mockS3SecretKey, "20130524", "us-east-1", "s3", []byte(mockS3StringToSign))
+	assert.Equal(t, mockS3Signature, result)
+}
+
+func TestAuthenticationModular_CreateS3AccessKeySuccess(t *testing.T) {
+	a := setup(t)
+	ctrl := gomock.NewController(t)
+	m := spdb.NewMockSPDB(ctrl)
+	a.baseApp.SetGfSpDB(m)
+	m.EXPECT().GetAuthKeyV2("user", "domain", "key").Return(&spdb.OffChainAuthKeyV2{
+		ExpiryDate: time.Now().Add(time.Hour)}, nil).Times(1)
+	m.EXPECT().InsertS3AccessKey(gomock.Any()).Return(nil).Times(1)
+	result, err := a.CreateS3AccessKey(context.TODO(), "user", "domain", "key")
+	assert.Nil(t, err)
+	assert.Equal(t, 20, len(result.AccessKeyID))
+	assert.True(t, strings.HasPrefix(result.AccessKeyID, S3AccessKeyIDPrefix))
+	assert.Equal(t, 40, len(result.SecretKey))
+	assert.Equal(t, "user", result.UserAddress)
+}
+
+func TestAuthenticationModular_CreateS3AccessKeyFailure(t *testing.T) {
+	cases := []struct {
+		name      string
+		fn        func() *AuthenticationModular
+		wantedErr error
+	}{
+		{
+			name: "public key not exist",
+			fn: func() *AuthenticationModular {
+				a := setup(t)
+				ctrl := gomock.NewController(t)
+				m := spdb.NewMockSPDB(ctrl)
+				a.baseApp.SetGfSpDB(m)
+				m.EXPECT().GetAuthKeyV2(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+				return a
+			},
+			wantedErr: ErrPublicKeyNotExist,
+		},
+		{
+			name: "public key expired",
+			fn: func() *AuthenticationModular {
+				a := setup(t)
+				ctrl := gomock.NewController(t)
+				m := spdb.NewMockSPDB(ctrl)
+				a.baseApp.SetGfSpDB(m)
+				m.EXPECT().GetAuthKeyV2(gomock.Any(), gomock.Any(), gomock.Any()).Return(&spdb.OffChainAuthKeyV2{
+					ExpiryDate: time.Now().Add(-time.Hour)}, nil).Times(1)
+				return a
+			},
+			wantedErr: ErrPublicKeyExpired,
+		},
+		{
+			name: "failed to insert s3 access key",
+			fn: func() *AuthenticationModular {
+				a := setup(t)
+				ctrl := gomock.NewController(t)
+				m := spdb.NewMockSPDB(ctrl)
+				a.baseApp.SetGfSpDB(m)
+				m.EXPECT().GetAuthKeyV2(gomock.Any(), gomock.Any(), gomock.Any()).Return(&spdb.OffChainAuthKeyV2{
+					ExpiryDate: time.Now().Add(time.Hour)}, nil).Times(1)
+				m.EXPECT().InsertS3AccessKey(gomock.Any()).Return(mockErr).Times(1)
+				return a
+			},
+			wantedErr: mockErr,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.fn().CreateS3AccessKey(context.TODO(), "user", "domain", "key")
+			assert.Equal(t, tt.wantedErr, err)
+			assert.Nil(t, result)
+		})
+	}
+}
+
+func TestAuthenticationModular_VerifyS3Signature(t *testing.T) {
+	cases := []struct {
+		name          string
+		fn            func() *AuthenticationModular
+		stringToSign  string
+		signature     string
+		wantedAccount string
+		wantedErr     error
+	}{
+		{
+			name: "success",
+			fn: func() *AuthenticationModular {
+				a := setup(t)
+				ctrl := gomock.NewController(t)
+				m := spdb.NewMockSPDB(ctrl)
+				a.baseApp.SetGfSpDB(m)
+				m.EXPECT().GetS3AccessKey("GNFDAAAAAAAAAAAAAAAA").Return(mockS3AccessKey(), nil).Times(1)
+				m.EXPECT().GetAuthKeyV2("user", "domain", "key").Return(&spdb.OffChainAuthKeyV2{
+					ExpiryDate: time.Now().Add(time.Hour)}, nil).Times(1)
+				return a
+			},
+			stringToSign:  mockS3StringToSign,
+			signature:     mockS3Signature,
+			wantedAccount: "user",
+		},
+		{
+			name:         "invalid string to sign",
+			fn:           func() *AuthenticationModular { return setup(t) },
+			stringToSign: "AWS4-HMAC-SHA256\n20130524T000000Z\n20130524/us-east-1/s3\nhash",
+			wantedErr:    ErrInvalidS3StringToSign,
+		},
+		{
+			name: "failed to get s3 access key",
+			fn: func() *AuthenticationModular {
+				a := setup(t)
+				ctrl := gomock.NewController(t)
+				m := spdb.NewMockSPDB(ctrl)
+				a.baseApp.SetGfSpDB(m)
+				m.EXPECT().GetS3AccessKey(gomock.Any()).Return(nil, mockErr).Times(1)
+				return a
+			},
+			stringToSign: mockS3StringToSign,
+			wantedErr:    mockErr,
+		},
+		{
+			name: "s3 access key not exist",
+			fn: func() *AuthenticationModular {
+				a := setup(t)
+				ctrl := gomock.NewController(t)
+				m := spdb.NewMockSPDB(ctrl)
+				a.baseApp.SetGfSpDB(m)
+				m.EXPECT().GetS3AccessKey(gomock.Any()).Return(nil, nil).Times(1)
+				return a
+			},
+			stringToSign: mockS3StringToSign,
+			wantedErr:    ErrS3AccessKeyNotExist,
+		},
+		{
+			name: "off-chain auth key is deleted",
+			fn: func() *AuthenticationModular {
+				a := setup(t)
+				ctrl := gomock.NewController(t)
+				m := spdb.NewMockSPDB(ctrl)
+				a.baseApp.SetGfSpDB(m)
+				m.EXPECT().GetS3AccessKey(gomock.Any()).Return(mockS3AccessKey(), nil).Times(1)
+				m.EXPECT().GetAuthKeyV2(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+				return a
+			},
+			stringToSign: mockS3StringToSign,
+			signature:    mockS3Signature,
+			wantedErr:    ErrPublicKeyNotExist,
+		},
+		{
+			name: "signature mismatch",
+			fn: func() *AuthenticationModular {
+				a := setup(t)
+				ctrl := gomock.NewController(t)
+				m := spdb.NewMockSPDB(ctrl)
+				a.baseApp.SetGfSpDB(m)
+				m.EXPECT().GetS3AccessKey(gomock.Any()).Return(mockS3AccessKey(), nil).Times(1)
+				m.EXPECT().GetAuthKeyV2(gomock.Any(), gomock.Any(), gomock.Any()).Return(&spdb.OffChainAuthKeyV2{
+					ExpiryDate: time.Now().Add(time.Hour)}, nil).Times(1)
+				return a
+			},
+			stringToSign: mockS3StringToSign,
+			signature:    strings.Repeat("0", 64),
+			wantedErr:    ErrS3SignatureMismatch,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			account, err := tt.fn().VerifyS3Signature(context.TODO(), "GNFDAAAAAAAAAAAAAAAA", []byte(tt.stringToSign), tt.signature)
+			assert.Equal(t, tt.wantedErr, err)
+			assert.Equal(t, tt.wantedAccount, account)
+		})
+	}
+}
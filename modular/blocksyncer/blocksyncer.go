@@ -14,10 +14,14 @@ import (
 	coremodule "github.com/bnb-chain/greenfield-storage-provider/core/module"
 	"github.com/bnb-chain/greenfield-storage-provider/core/rcmgr"
 	db "github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/database"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/dataquality"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/modules/notification"
 )
 
 var (
 	BlockSyncerModularBackupName = strings.ToLower("BlockSyncerBackup")
+	// BlockSyncerModularReindexName defines the name of the block syncer which re-indexes into the shadow db
+	BlockSyncerModularReindexName = strings.ToLower("BlockSyncerReindex")
 	// DsnBlockSyncer defines env variable name for block syncer dsn
 	DsnBlockSyncer = "BLOCK_SYNCER_DSN"
 	// DsnBlockSyncerSwitched defines env variable name for block syncer backup dsn
@@ -26,6 +30,10 @@ var (
 	ErrBlockNotFound       = errors.New("failed to get block from map need retry")
 	ErrHandleEvent         = errors.New("failed to handle event")
 	ErrEventNotFound       = errors.New("failed to get event from tx map")
+	ErrReorgTooDeep        = errors.New("chain reorg is deeper than the rollback journal, block syncer needs to reindex")
+	ErrNotReindexable      = errors.New("module can not be re-indexed")
+	ErrShadowDBNotSet      = errors.New("backup bs db is not configured as the shadow db")
+	ErrShadowDBHeight      = errors.New("shadow db is not indexed to the height before the re-index range")
 )
 
 const (
@@ -40,6 +48,8 @@ const (
 	ObjectsNumberOfShards = 64
 	MinChargeSize         = 128000
 	CommitNumber          = 2000
+	// DefaultReorgDepth defines the default max depth of the chain reorg which can be rolled back
+	DefaultReorgDepth = 100
 )
 
 type MigrateDBKey struct{}
@@ -57,6 +67,13 @@ type BlockSyncerModular struct {
 	DataStatisticsDuration int64
 	BlockResultStorage     bool
 	MaxBlockNum            int64
+	ReorgDepth             uint64
+	NotificationConfig     notification.DispatcherConfig
+	// HistoryEnable defines whether to maintain the history tables
+	HistoryEnable bool
+	// DataQualityEnable defines whether to compare the db with the chain and export the results as metrics
+	DataQualityEnable bool
+	DataQualityConfig dataquality.MonitorConfig
 }
 
 // Read concurrency required global variables
@@ -96,6 +113,9 @@ func (b *BlockSyncerModular) Start(ctx context.Context) error {
 	b.scope = scope
 
 	determineMainService()
+	if BackupService != nil {
+		BackupService.stopNotification()
+	}
 
 	CtxMain, CancelMain = context.WithCancel(context.Background())
 	if !NeedBackup {
@@ -103,6 +123,8 @@ func (b *BlockSyncerModular) Start(ctx context.Context) error {
 	}
 
 	go MainService.serve(CtxMain)
+	MainService.startNotification(CtxMain)
+	MainService.startDataQuality(CtxMain)
 
 	// create backup blocksyncer
 	if NeedBackup {
@@ -0,0 +1,43 @@
+package blocksyncer
+
+import (
+	"context"
+	"time"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+	db "github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/database"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/dataquality"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+)
+
+// makeDataQualityConfig makes the thresholds of the data quality checks, the zero fields use the defaults.
+func makeDataQualityConfig(cfg *gfspconfig.GfSpConfig) dataquality.MonitorConfig {
+	dataQualityCfg := cfg.BlockSyncer.DataQuality
+	return dataquality.MonitorConfig{
+		CheckInterval:  time.Duration(dataQualityCfg.CheckIntervalSec) * time.Second,
+		CountInterval:  time.Duration(dataQualityCfg.CountIntervalSec) * time.Second,
+		SampleSize:     dataQualityCfg.SampleSize,
+		MaxHeightLag:   dataQualityCfg.MaxHeightLag,
+		MaxDriftRatio:  dataQualityCfg.MaxDriftRatio,
+		CatchUpTimeout: time.Duration(dataQualityCfg.CatchUpTimeoutSec) * time.Second,
+	}
+}
+
+// startDataQuality compares the db of the block syncer with the chain until the context is done. It's only called
+// for the block syncer which indexes the master db, which is the one serving the metadata queries.
+func (b *BlockSyncerModular) startDataQuality(ctx context.Context) {
+	if !b.DataQualityEnable || b.baseApp == nil || b.parserCtx == nil {
+		return
+	}
+	monitor := dataquality.NewMonitor(db.Cast(b.parserCtx.Database), b.baseApp.Consensus(), b.baseApp.GetProbe(),
+		b.chainLatestHeight, b.DataQualityConfig)
+	go monitor.Run(ctx)
+	log.Infow("succeed to start data quality monitor", "service", b.name)
+}
+
+// chainLatestHeight returns the latest block height of the chain fetched by the block syncer, it's 0 before the
+// first fetch.
+func (b *BlockSyncerModular) chainLatestHeight() int64 {
+	height, _ := Cast(b.parserCtx.Indexer).GetLatestBlockHeight().Load().(int64)
+	return height
+}
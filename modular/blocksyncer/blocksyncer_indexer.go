@@ -25,9 +25,10 @@ import (
 	localDB "github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/database"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
 )
 
-func NewIndexer(codec codec.Codec, proxy node.Node, db database.Database, modules []modules.Module, serviceName string, commitNumber uint64, blockResultStorageEnable bool) parser.Indexer {
+func NewIndexer(codec codec.Codec, proxy node.Node, db database.Database, modules []modules.Module, serviceName string, commitNumber uint64, blockResultStorageEnable bool, reorgDepth uint64, historyEnable bool) parser.Indexer {
 	return &Impl{
 		codec:                    codec,
 		Node:                     proxy,
@@ -37,6 +38,8 @@ func NewIndexer(codec codec.Codec, proxy node.Node, db database.Database, module
 		ProcessedHeight:          0,
 		CommitNumber:             commitNumber,
 		BlockResultStorageEnable: blockResultStorageEnable,
+		ReorgDepth:               reorgDepth,
+		HistoryEnable:            historyEnable,
 	}
 }
 
@@ -52,6 +55,16 @@ type Impl struct {
 	CommitNumber             uint64
 	BlockResultStorageEnable bool
 
+	// ReorgDepth defines the max depth of the chain reorg which can be rolled back
+	ReorgDepth uint64
+	// reindexHeight defines the next height to index again after the indexed blocks are rolled back
+	reindexHeight uint64
+	// HistoryEnable defines whether to record the versions of the changed rows in the history tables
+	HistoryEnable bool
+	// keepEpoch defines whether to keep the epoch, the block hashes and the journals unchanged, it's set by a
+	// partial reindex, so the syncer still handles the blocks for the modules which are not reindexed
+	keepEpoch bool
+
 	ServiceName string
 }
 
@@ -87,63 +100,97 @@ func (i *Impl) ExtractEvent(ctx context.Context, block *coretypes.ResultBlock, t
 	return allSQL, nil
 }
 
+// blockData defines a block and its results to be indexed
+type blockData struct {
+	block  *coretypes.ResultBlock
+	events *coretypes.ResultBlockResults
+	txs    map[common.Hash][]abci.Event
+	txHash tmtypes.Txs
+}
+
 // Process fetches a block for a given height and associated metadata and export it to a database.
 // It returns an error if any export process fails.
 func (i *Impl) Process(height uint64) error {
 	heightKey := fmt.Sprintf("%s-%d", i.GetServiceName(), height)
 
-	var block *coretypes.ResultBlock
-	var events *coretypes.ResultBlockResults
-	var txs map[common.Hash][]abci.Event
-	var txHash tmtypes.Txs
+	var data *blockData
 	var err error
 
 	realTimeMode := RealTimeStart.Load()
 	catchEndBLock := CatchEndBlock.Load()
 
 	if realTimeMode && catchEndBLock < int64(height) {
-		rpcStartTime := time.Now()
-		block, err = i.Node.Block(int64(height))
-		if err != nil {
-			log.Warnf("failed to get block from node: %s", err)
-			return err
-		}
-		metrics.ChainRPCTime.Set(float64(time.Since(rpcStartTime).Milliseconds()))
-		rpcStartTime = time.Now()
-		events, err = i.Node.BlockResults(int64(height))
+		data, err = i.fetchBlock(height)
 		if err != nil {
-			log.Warnf("failed to get block results from node: %s", err)
 			return err
 		}
-		metrics.ChainRPCTime.Set(float64(time.Since(rpcStartTime).Milliseconds()))
 		if i.BlockResultStorageEnable {
-			go i.SaveBlockResult(height, events)
-		}
-		txHash = block.Block.Data.Txs
-		txs = make(map[common.Hash][]cometbfttypes.Event)
-		for idx := 0; idx < len(events.TxsResults); idx++ {
-			k := block.Block.Data.Txs[idx]
-			v := events.TxsResults[idx].GetEvents()
-			txs[common.BytesToHash(k.Hash())] = v
+			go i.SaveBlockResult(height, data.events)
 		}
 	} else {
 		blockAny, okb := blockMap.Load(heightKey)
 		eventsAny, oke := eventMap.Load(heightKey)
 		txsAny, okt := txMap.Load(heightKey)
 		txHashAny, okth := txHashMap.Load(heightKey)
-		block, _ = blockAny.(*coretypes.ResultBlock)
-		events, _ = eventsAny.(*coretypes.ResultBlockResults)
-		txs, _ = txsAny.(map[common.Hash][]abci.Event)
-		txHash, _ = txHashAny.(tmtypes.Txs)
+		data = &blockData{}
+		data.block, _ = blockAny.(*coretypes.ResultBlock)
+		data.events, _ = eventsAny.(*coretypes.ResultBlockResults)
+		data.txs, _ = txsAny.(map[common.Hash][]abci.Event)
+		data.txHash, _ = txHashAny.(tmtypes.Txs)
 		if !okb || !oke || !okt || !okth {
 			log.Warnf("failed to get map data height: %d", height)
 			return ErrBlockNotFound
 		}
 		if i.BlockResultStorageEnable {
-			go i.SaveBlockResult(height, events)
+			go i.SaveBlockResult(height, data.events)
 		}
 	}
 
+	if data, err = i.checkReorg(height, data); err != nil {
+		return err
+	}
+	if err = i.export(height, data); err != nil {
+		return err
+	}
+
+	i.ProcessedHeight = height
+	if !realTimeMode || catchEndBLock < int64(height) {
+		blockMap.Delete(heightKey)
+		eventMap.Delete(heightKey)
+		txMap.Delete(heightKey)
+		txHashMap.Delete(heightKey)
+	}
+	return nil
+}
+
+// fetchBlock fetches the block and its results of the given height from the chain.
+func (i *Impl) fetchBlock(height uint64) (*blockData, error) {
+	rpcStartTime := time.Now()
+	block, err := i.Node.Block(int64(height))
+	if err != nil {
+		log.Warnf("failed to get block from node: %s", err)
+		return nil, err
+	}
+	metrics.ChainRPCTime.Set(float64(time.Since(rpcStartTime).Milliseconds()))
+	rpcStartTime = time.Now()
+	events, err := i.Node.BlockResults(int64(height))
+	if err != nil {
+		log.Warnf("failed to get block results from node: %s", err)
+		return nil, err
+	}
+	metrics.ChainRPCTime.Set(float64(time.Since(rpcStartTime).Milliseconds()))
+	txs := make(map[common.Hash][]cometbfttypes.Event)
+	for idx := 0; idx < len(events.TxsResults); idx++ {
+		k := block.Block.Data.Txs[idx]
+		v := events.TxsResults[idx].GetEvents()
+		txs[common.BytesToHash(k.Hash())] = v
+	}
+	return &blockData{block: block, events: events, txs: txs, txHash: block.Block.Data.Txs}, nil
+}
+
+// export handles the events of the block and writes the statements into the database.
+func (i *Impl) export(height uint64, data *blockData) error {
+	block, events, txs, txHash := data.block, data.events, data.txs, data.txHash
 	startTime := time.Now()
 
 	beginBlockEvents := events.BeginBlockEvents
@@ -190,10 +237,50 @@ func (i *Impl) Process(height uint64) error {
 		}
 	}
 
-	sql, val := i.SaveEpoch(block)
-	allSQL = append(allSQL, map[string][]interface{}{
-		sql: val,
-	})
+	// 4. journal the rows to be changed by the block, so they can be rolled back after a chain reorg
+	if !i.keepEpoch && i.journalEnabled(height) {
+		sql, val, err := localDB.Cast(i.DB).JournalToSQL(ctx, int64(height), allSQL)
+		if err != nil {
+			log.Errorf("failed to journal block: %s", err)
+			return err
+		}
+		if sql != "" {
+			allSQL = append(allSQL, map[string][]interface{}{
+				sql: val,
+			})
+		}
+	}
+
+	// 5. record the versions of the rows changed by the block, they are snapshotted after the statements are executed
+	if i.HistoryEnable {
+		sqls, err = localDB.Cast(i.DB).HistoryToSQL(ctx, int64(height), allSQL)
+		if err != nil {
+			log.Errorf("failed to record history of block: %s", err)
+			return err
+		}
+		allSQL = append(allSQL, sqls...)
+	}
+
+	if !i.keepEpoch {
+		sql, val := i.SaveEpoch(block)
+		allSQL = append(allSQL, map[string][]interface{}{
+			sql: val,
+		})
+		sql, val = i.SaveBlockHash(block)
+		allSQL = append(allSQL, map[string][]interface{}{
+			sql: val,
+		})
+		if prunedHeight := int64(height) - int64(i.ReorgDepth); prunedHeight > 0 {
+			sql, val = localDB.Cast(i.DB).DeleteBlockHashesToSQL(ctx, prunedHeight)
+			allSQL = append(allSQL, map[string][]interface{}{
+				sql: val,
+			})
+			sql, val = localDB.Cast(i.DB).DeleteRollbackJournalsToSQL(ctx, prunedHeight)
+			allSQL = append(allSQL, map[string][]interface{}{
+				sql: val,
+			})
+		}
+	}
 
 	sqlCount := len(allSQL)
 	log.Infof("height :%d tx count:%d sql count:%d", height, txCount, sqlCount)
@@ -239,14 +326,6 @@ func (i *Impl) Process(height uint64) error {
 	metrics.BlockHeightLagGauge.WithLabelValues("blocksyncer").Set(float64(block.Block.Height))
 	metrics.BlocksyncerCatchTime.Set(float64(time.Since(startTime).Milliseconds()))
 
-	i.ProcessedHeight = height
-	if !realTimeMode || catchEndBLock < int64(height) {
-		blockMap.Delete(heightKey)
-		eventMap.Delete(heightKey)
-		txMap.Delete(heightKey)
-		txHashMap.Delete(heightKey)
-	}
-
 	// after each block height ends, clear the corresponding key value in ctx
 	for _, module := range i.Modules {
 		if eventModule, ok := module.(modules.EventModule); ok {
@@ -267,6 +346,15 @@ func (i *Impl) SaveEpoch(block *coretypes.ResultBlock) (string, []interface{}) {
 	})
 }
 
+// SaveBlockHash accept a block result data and record its hash to detect the chain reorg
+func (i *Impl) SaveBlockHash(block *coretypes.ResultBlock) (string, []interface{}) {
+	return localDB.Cast(i.DB).SaveBlockHashToSQL(context.Background(), &bsdb.BlockHash{
+		Height:     block.Block.Height,
+		BlockHash:  common.BytesToHash(block.BlockID.Hash),
+		ParentHash: common.BytesToHash(block.Block.LastBlockID.Hash),
+	})
+}
+
 // ExportTxs accepts a slice of transactions and persists then inside the database.
 // An error is returned if write fails.
 func (i *Impl) ExportTxs(block *coretypes.ResultBlock, txs []*types.Tx) error {
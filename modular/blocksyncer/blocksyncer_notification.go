@@ -0,0 +1,57 @@
+package blocksyncer
+
+import (
+	"context"
+	"time"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+	db "github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/database"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/modules/notification"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+)
+
+// makeNotificationConfig makes the delivery policy of the bucket notifications, the zero fields use the defaults.
+func makeNotificationConfig(cfg *gfspconfig.GfSpConfig) notification.DispatcherConfig {
+	notificationCfg := cfg.BlockSyncer.Notification
+	return notification.DispatcherConfig{
+		MaxAttempts:     notificationCfg.MaxAttempts,
+		RetryBackoff:    time.Duration(notificationCfg.RetryBackoffSec) * time.Second,
+		MaxRetryBackoff: time.Duration(notificationCfg.MaxRetryBackoffSec) * time.Second,
+		DeliveryTimeout: time.Duration(notificationCfg.DeliveryTimeoutSec) * time.Second,
+		PollInterval:    time.Duration(notificationCfg.PollIntervalSec) * time.Second,
+		BatchSize:       notificationCfg.BatchSize,
+	}
+}
+
+// notificationModule returns the notification module of the block syncer, it's nil if the module is not enabled.
+func (b *BlockSyncerModular) notificationModule() *notification.Module {
+	if b == nil || b.parserCtx == nil {
+		return nil
+	}
+	for _, module := range b.parserCtx.Modules {
+		if notificationModule, ok := module.(*notification.Module); ok {
+			return notificationModule
+		}
+	}
+	return nil
+}
+
+// startNotification queues the bucket notifications into the db of the block syncer and delivers them until the
+// context is done. It's only called for the block syncer which indexes the master db, so every notification is
+// delivered from one of the dbs.
+func (b *BlockSyncerModular) startNotification(ctx context.Context) {
+	notificationModule := b.notificationModule()
+	if notificationModule == nil {
+		return
+	}
+	notificationModule.SetEnabled(true)
+	go notification.NewDispatcher(db.Cast(b.parserCtx.Database), b.NotificationConfig).Run(ctx)
+	log.Infow("succeed to start bucket notification dispatcher", "service", b.name)
+}
+
+// stopNotification stops queueing the bucket notifications into the db of the block syncer.
+func (b *BlockSyncerModular) stopNotification() {
+	if notificationModule := b.notificationModule(); notificationModule != nil {
+		notificationModule.SetEnabled(false)
+	}
+}
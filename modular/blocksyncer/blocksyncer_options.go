@@ -51,6 +51,14 @@ func NewBlockSyncerModular(app *gfspapp.GfSpBaseApp, cfg *gfspconfig.GfSpConfig)
 		DataStatisticsDuration: cfg.BlockSyncer.DataStatisticsDuration,
 		BlockResultStorage:     cfg.BlockSyncer.ChainDataStorage.EnableStorage,
 		MaxBlockNum:            int64(cfg.BlockSyncer.ChainDataStorage.MaximumStorageCount),
+		ReorgDepth:             cfg.BlockSyncer.ReorgDepth,
+		NotificationConfig:     makeNotificationConfig(cfg),
+		HistoryEnable:          cfg.BlockSyncer.EnableHistory,
+		DataQualityEnable:      cfg.BlockSyncer.DataMonitor,
+		DataQualityConfig:      makeDataQualityConfig(cfg),
+	}
+	if MainService.ReorgDepth == 0 {
+		MainService.ReorgDepth = DefaultReorgDepth
 	}
 	blockMap = new(sync.Map)
 	eventMap = new(sync.Map)
@@ -86,6 +94,11 @@ func NewBlockSyncerModular(app *gfspapp.GfSpBaseApp, cfg *gfspconfig.GfSpConfig)
 			return nil, err
 		} else {
 			BackupService = blockSyncerBackup
+			BackupService.NotificationConfig = MainService.NotificationConfig
+			// the backup service checks the data quality after it's switched to the master db
+			BackupService.baseApp = app
+			BackupService.DataQualityEnable = MainService.DataQualityEnable
+			BackupService.DataQualityConfig = MainService.DataQualityConfig
 		}
 	}
 
@@ -110,17 +123,10 @@ func (b *BlockSyncerModular) initClient(cfg *gfspconfig.GfSpConfig) error {
 		return readErr
 	}
 
-	username, password, envErr := getDBConfigFromEnv(bsdb.BsDBUser, bsdb.BsDBPasswd)
-	if envErr != nil {
-		log.Infof("failed to get username and password err:%v", envErr)
-		username = cfg.BsDB.User
-		password = cfg.BsDB.Passwd
+	// the backup service has no gfsp config, its dsn is switched in the toml config
+	if cfg != nil {
+		config.Cfg.Database.DSN = makeBsDBDSN(cfg, false)
 	}
-	dbAddress := cfg.BsDB.Address
-	if cfg.BlockSyncer.BsDBWriteAddress != "" {
-		dbAddress = cfg.BlockSyncer.BsDBWriteAddress
-	}
-	config.Cfg.Database.DSN = fmt.Sprintf("%s:%s@tcp(%s)/%s?parseTime=true&multiStatements=true&loc=Local&interpolateParams=true", username, password, dbAddress, cfg.BsDB.Database)
 
 	var ctx *parser.Context
 	ctx, err := parsecmdtypes.GetParserContext(config.Cfg, cmdCfg)
@@ -131,14 +137,14 @@ func (b *BlockSyncerModular) initClient(cfg *gfspconfig.GfSpConfig) error {
 	b.parserCtx = ctx
 	log.Infof("blocksyncer dsn : %s", config.Cfg.Database.DSN)
 	commitNumber := uint64(CommitNumber)
-	if cfg.BlockSyncer.CommitNumber != 0 {
+	if cfg != nil && cfg.BlockSyncer.CommitNumber != 0 {
 		commitNumber = cfg.BlockSyncer.CommitNumber
 	}
 	b.parserCtx.Indexer = NewIndexer(ctx.EncodingConfig.Marshaler,
 		ctx.Node,
 		ctx.Database,
 		ctx.Modules,
-		b.Name(), commitNumber, b.BlockResultStorage)
+		b.Name(), commitNumber, b.BlockResultStorage, b.ReorgDepth, b.HistoryEnable)
 	return nil
 }
 
@@ -170,6 +176,17 @@ func (b *BlockSyncerModular) initDB(useMigrate bool) error {
 		log.Errorw("failed to PrepareTables/AutoMigrate tables", "error", err)
 		return err
 	}
+	err = db.Cast(b.parserCtx.Database).PrepareTables(context.TODO(), []schema.Tabler{&bsdb.BlockHash{}, &bsdb.RollbackJournal{}})
+	if err != nil {
+		log.Errorw("failed to PrepareTables/AutoMigrate tables", "error", err)
+		return err
+	}
+	if b.HistoryEnable {
+		if err = db.Cast(b.parserCtx.Database).PrepareHistory(context.TODO()); err != nil {
+			log.Errorw("failed to prepare history tables", "error", err)
+			return err
+		}
+	}
 
 	return nil
 }
@@ -467,10 +484,35 @@ func (b *BlockSyncerModular) dbStatistics(ctx context.Context) {
 	}
 }
 
+// makeBsDBDSN makes the dsn of the block syncer db or the backup block syncer db, the user and password
+// in the env vars take precedence over the config
+func makeBsDBDSN(cfg *gfspconfig.GfSpConfig, isBackup bool) string {
+	dbConfig, userKey, passwdKey := cfg.BsDB, bsdb.BsDBUser, bsdb.BsDBPasswd
+	if isBackup {
+		dbConfig, userKey, passwdKey = cfg.BsDBBackup, bsdb.BsDBSwitchedUser, bsdb.BsDBSwitchedPasswd
+	}
+	username, password, envErr := getDBConfigFromEnv(userKey, passwdKey)
+	if envErr != nil {
+		log.Infof("failed to get username and password err:%v", envErr)
+		username = dbConfig.User
+		password = dbConfig.Passwd
+	}
+	dbAddress := dbConfig.Address
+	if !isBackup && cfg.BlockSyncer.BsDBWriteAddress != "" {
+		dbAddress = cfg.BlockSyncer.BsDBWriteAddress
+	}
+	return fmt.Sprintf("%s:%s@tcp(%s)/%s?parseTime=true&multiStatements=true&loc=Local&interpolateParams=true", username, password, dbAddress, dbConfig.Database)
+}
+
 // makeBlockSyncerConfig make block syncer service config from StorageProviderConfig
 func makeBlockSyncerConfig(cfg *gfspconfig.GfSpConfig) *config.TomlConfig {
 	rpcAddress := cfg.Chain.ChainAddress[0]
 
+	var dsnSwitched string
+	if cfg.BsDBBackup.Address != "" {
+		dsnSwitched = makeBsDBDSN(cfg, true)
+	}
+
 	return &config.TomlConfig{
 		Chain: config.ChainConfig{
 			Bech32Prefix: "cosmos",
@@ -495,6 +537,7 @@ func makeBlockSyncerConfig(cfg *gfspconfig.GfSpConfig) *config.TomlConfig {
 		Logging: loggingconfig.Config{
 			Level: "debug",
 		},
+		DsnSwitched: dsnSwitched,
 	}
 }
 
@@ -505,8 +548,10 @@ func newBackupBlockSyncerService(cfg *config.TomlConfig, mainDBIsMaster bool) (*
 	}
 
 	BackupService = &BlockSyncerModular{
-		config: backUpConfig,
-		name:   BlockSyncerModularBackupName,
+		config:        backUpConfig,
+		name:          BlockSyncerModularBackupName,
+		ReorgDepth:    MainService.ReorgDepth,
+		HistoryEnable: MainService.HistoryEnable,
 	}
 
 	if err = BackupService.initClient(nil); err != nil {
@@ -573,6 +618,9 @@ func CheckProgress() {
 		if epochMaster.BlockHeight-epochSlave.BlockHeight < DefaultBlockHeightDiff {
 			SwitchMasterDBFlag()
 			StopMainService()
+			MainService.stopNotification()
+			BackupService.startNotification(BackupService.context)
+			BackupService.startDataQuality(BackupService.context)
 			break
 		}
 		time.Sleep(time.Minute * DefaultCheckDiffPeriod)
@@ -0,0 +1,250 @@
+package blocksyncer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/forbole/juno/v4/database"
+	databaseconfig "github.com/forbole/juno/v4/database/config"
+	"github.com/forbole/juno/v4/modules/epoch"
+	"github.com/forbole/juno/v4/types/config"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+	db "github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/database"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/modules/bucket"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/modules/group"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/modules/object"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/modules/objectidmap"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/modules/permission"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/modules/prefixtree"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+)
+
+// ReindexableModules defines the modules whose tables are built only from the chain events, so handling their
+// events again into the shadow db gives the same index.
+var ReindexableModules = []string{
+	bucket.ModuleName,
+	object.ModuleName,
+	permission.ModuleName,
+	group.ModuleName,
+	prefixtree.ModuleName,
+	objectidmap.ModuleName,
+}
+
+// ReindexConfig defines the height range and the modules to re-index.
+type ReindexConfig struct {
+	// FromHeight defines the first height to re-index, the shadow db must be indexed to the height before it.
+	// The height after the shadow db epoch is used if it's 0.
+	FromHeight uint64
+	// ToHeight defines the last height to re-index, the latest height of the chain is used if it's 0.
+	ToHeight uint64
+	// Modules defines the modules to re-index, all of them must be in ReindexableModules.
+	Modules []string
+	// Workers defines the number of blocks fetched from the chain in parallel, BlockSyncer.Workers is used if it's 0.
+	Workers uint
+}
+
+// Reindex re-processes the blocks of the height range into the shadow db, which is the block syncer db that is not
+// the current master. The blocks are fetched from the chain in parallel, and their events are handled in height
+// order because the events of a bucket or an object depend on the earlier ones. If all the modules of the block
+// syncer are re-indexed, the master db flag is switched after the whole range is indexed, so the metadata service
+// which follows the switch db signal never reads a half-built index. The block syncer should be restarted after
+// the switch to keep syncing the new master db. Otherwise the epoch of the shadow db is kept and the flag is not
+// switched, since the tables of the other modules miss the range, the block syncer syncs the shadow db from
+// its epoch again.
+func Reindex(ctx context.Context, cfg *gfspconfig.GfSpConfig, reindexCfg *ReindexConfig) error {
+	if err := checkReindexModules(reindexCfg.Modules); err != nil {
+		return err
+	}
+	if cfg.BsDBBackup.Address == "" {
+		return ErrShadowDBNotSet
+	}
+
+	// the master db flag is kept in the main db
+	flagDB, err := db.BlockSyncerDBBuilder(database.NewContext(databaseconfig.Config{
+		Type: databaseconfig.MySQL,
+		DSN:  makeBsDBDSN(cfg, false),
+	}, nil))
+	if err != nil {
+		log.Errorw("failed to connect master flag db", "error", err)
+		return err
+	}
+	FlagDB = db.Cast(flagDB)
+
+	reorgDepth := cfg.BlockSyncer.ReorgDepth
+	if reorgDepth == 0 {
+		reorgDepth = DefaultReorgDepth
+	}
+	reindexer := &BlockSyncerModular{
+		config:        makeReindexConfig(cfg, reindexCfg),
+		name:          BlockSyncerModularReindexName,
+		ReorgDepth:    reorgDepth,
+		HistoryEnable: cfg.BlockSyncer.EnableHistory,
+	}
+	if err = reindexer.prepareMasterFlagTable(); err != nil {
+		log.Errorw("failed to prepare master flag table", "error", err)
+		return err
+	}
+	masterFlag, err := FlagDB.GetMasterDB(ctx)
+	if err != nil {
+		log.Errorw("failed to get master db flag", "error", err)
+		return err
+	}
+	if masterFlag.IsMaster {
+		reindexer.config.Database.DSN = makeBsDBDSN(cfg, true)
+	} else {
+		reindexer.config.Database.DSN = makeBsDBDSN(cfg, false)
+	}
+
+	if err = reindexer.initClient(nil); err != nil {
+		return err
+	}
+	if err = reindexer.initDB(false); err != nil {
+		return err
+	}
+	full := isFullReindex(cfg.BlockSyncer.Modules, reindexCfg.Modules)
+	Cast(reindexer.parserCtx.Indexer).keepEpoch = !full
+	if err = reindexer.reindex(ctx, reindexCfg.FromHeight, reindexCfg.ToHeight); err != nil {
+		return err
+	}
+	if !full {
+		log.Infow("partial re-index is done, the shadow db epoch is kept and the master db flag is not switched",
+			"modules", reindexCfg.Modules)
+		return nil
+	}
+	return SwitchMasterDBFlag()
+}
+
+// isFullReindex returns whether all the modules of the block syncer are re-indexed.
+func isFullReindex(syncerModules []string, modules []string) bool {
+	for _, syncerModule := range syncerModules {
+		if syncerModule == epoch.ModuleName {
+			continue
+		}
+		found := false
+		for _, module := range modules {
+			if module == syncerModule {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// makeReindexConfig makes the block syncer config which only handles the events of the re-indexed modules.
+func makeReindexConfig(cfg *gfspconfig.GfSpConfig, reindexCfg *ReindexConfig) *config.TomlConfig {
+	junoCfg := makeBlockSyncerConfig(cfg)
+	junoCfg.Chain.Modules = append([]string{epoch.ModuleName}, reindexCfg.Modules...)
+	if reindexCfg.Workers != 0 {
+		junoCfg.Parser.Workers = int64(reindexCfg.Workers)
+	}
+	if junoCfg.Parser.Workers <= 0 {
+		junoCfg.Parser.Workers = 1
+	}
+	return junoCfg
+}
+
+func checkReindexModules(modules []string) error {
+	if len(modules) == 0 {
+		return fmt.Errorf("%w: no module is specified", ErrNotReindexable)
+	}
+	for _, module := range modules {
+		reindexable := false
+		for _, name := range ReindexableModules {
+			if module == name {
+				reindexable = true
+				break
+			}
+		}
+		if !reindexable {
+			return fmt.Errorf("%w: %s", ErrNotReindexable, module)
+		}
+	}
+	return nil
+}
+
+// reindex handles the blocks of the height range and writes the statements into the db of the block syncer.
+func (b *BlockSyncerModular) reindex(ctx context.Context, from, to uint64) error {
+	shadowEpoch, err := b.parserCtx.Database.GetEpoch(ctx)
+	if err != nil {
+		log.Errorw("failed to get epoch of shadow db", "error", err)
+		return err
+	}
+	if from == 0 {
+		from = uint64(shadowEpoch.BlockHeight) + 1
+	}
+	if uint64(shadowEpoch.BlockHeight)+1 != from {
+		return fmt.Errorf("%w: shadow db is indexed to %d, re-index from %d", ErrShadowDBHeight, shadowEpoch.BlockHeight, from)
+	}
+	if to == 0 {
+		to = mustGetLatestHeight(b.parserCtx)
+	}
+	if from > to {
+		return fmt.Errorf("invalid re-index range from %d to %d", from, to)
+	}
+
+	indexer := Cast(b.parserCtx.Indexer)
+	// only the blocks within the reorg depth of the last height are journaled
+	indexer.GetLatestBlockHeight().Store(int64(to))
+	workers := uint64(b.config.Parser.Workers)
+	startTime := time.Now()
+	for start := from; start <= to; start += workers {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		end := start + workers - 1
+		if end > to {
+			end = to
+		}
+		blocks, err := indexer.fetchBlocks(start, end)
+		if err != nil {
+			return err
+		}
+		for height := start; height <= end; height++ {
+			if err = indexer.export(height, blocks[height-start]); err != nil {
+				log.Errorw("failed to re-index block", "height", height, "error", err)
+				return err
+			}
+			indexer.ProcessedHeight = height
+		}
+		log.Infow("succeed to re-index blocks", "start", start, "end", end, "to", to)
+	}
+	log.Infow("succeed to re-index", "from", from, "to", to, "cost", time.Since(startTime).String())
+	return nil
+}
+
+// fetchBlocks fetches the blocks of the height range from the chain in parallel.
+func (i *Impl) fetchBlocks(start, end uint64) ([]*blockData, error) {
+	blocks := make([]*blockData, end-start+1)
+	errs := make([]error, end-start+1)
+	wg := &sync.WaitGroup{}
+	for height := start; height <= end; height++ {
+		wg.Add(1)
+		go func(height uint64) {
+			defer wg.Done()
+			for retry := 0; retry < MaxRetryCount; retry++ {
+				blocks[height-start], errs[height-start] = i.fetchBlock(height)
+				if errs[height-start] == nil {
+					return
+				}
+				time.Sleep(config.GetAvgBlockTime())
+			}
+		}(height)
+	}
+	wg.Wait()
+	for idx, err := range errs {
+		if err != nil {
+			log.Errorw("failed to fetch block", "height", start+uint64(idx), "error", err)
+			return nil, err
+		}
+	}
+	return blocks, nil
+}
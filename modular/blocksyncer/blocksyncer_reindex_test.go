@@ -0,0 +1,86 @@
+package blocksyncer
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/forbole/juno/v4/modules/epoch"
+	"github.com/forbole/juno/v4/parser"
+	parserconfig "github.com/forbole/juno/v4/parser/config"
+	"github.com/forbole/juno/v4/types/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/modules/object"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/modules/payment"
+)
+
+func setupReindexer(t *testing.T) (*BlockSyncerModular, *mockNode, sqlmock.Sqlmock) {
+	t.Helper()
+	indexer, chain, mock := setupReorgIndexer(t, 10)
+	reindexer := &BlockSyncerModular{
+		config:    &config.TomlConfig{Parser: parserconfig.Config{Workers: 2}},
+		name:      BlockSyncerModularReindexName,
+		parserCtx: &parser.Context{Node: chain, Database: indexer.DB, Indexer: indexer},
+	}
+	return reindexer, chain, mock
+}
+
+func expectGetEpoch(mock sqlmock.Sqlmock, height int64) {
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `epoch`")).
+		WillReturnRows(sqlmock.NewRows([]string{"one_row_id", "block_height"}).AddRow(true, height))
+}
+
+func TestCheckReindexModules(t *testing.T) {
+	assert.NoError(t, checkReindexModules([]string{object.ModuleName}))
+	assert.ErrorIs(t, checkReindexModules(nil), ErrNotReindexable)
+	assert.ErrorIs(t, checkReindexModules([]string{object.ModuleName, payment.ModuleName}), ErrNotReindexable)
+}
+
+func TestReindex(t *testing.T) {
+	reindexer, chain, mock := setupReindexer(t)
+	expectGetEpoch(mock, 2)
+	// the blocks are fetched in batches of 2 workers, and exported in height order
+	expectExport(mock, chain, 3)
+	expectExport(mock, chain, 4)
+	expectExport(mock, chain, 5)
+
+	require.NoError(t, reindexer.reindex(context.Background(), 0, 5))
+	assert.Equal(t, uint64(5), Cast(reindexer.parserCtx.Indexer).ProcessedHeight)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestIsFullReindex(t *testing.T) {
+	syncerModules := []string{epoch.ModuleName, object.ModuleName, payment.ModuleName}
+	assert.True(t, isFullReindex(syncerModules, []string{object.ModuleName, payment.ModuleName}))
+	assert.False(t, isFullReindex(syncerModules, []string{object.ModuleName}))
+}
+
+func TestReindex_Partial(t *testing.T) {
+	reindexer, _, mock := setupReindexer(t)
+	Cast(reindexer.parserCtx.Indexer).keepEpoch = true
+	expectGetEpoch(mock, 2)
+	// no epoch or block hash is written for the empty blocks, any statement fails the sqlmock expectations
+
+	require.NoError(t, reindexer.reindex(context.Background(), 0, 5))
+	assert.Equal(t, uint64(5), Cast(reindexer.parserCtx.Indexer).ProcessedHeight)
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	// the tables of the payment module, which is not re-indexed, miss the blocks 3-5, so the shadow db keeps the
+	// epoch 2 and the block syncer handles the blocks again
+	expectGetEpoch(mock, 2)
+	shadowEpoch, err := reindexer.parserCtx.Database.GetEpoch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), shadowEpoch.BlockHeight)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReindex_HeightMismatch(t *testing.T) {
+	reindexer, _, mock := setupReindexer(t)
+	expectGetEpoch(mock, 3)
+
+	assert.ErrorIs(t, reindexer.reindex(context.Background(), 2, 5), ErrShadowDBHeight)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,96 @@
+package blocksyncer
+
+import (
+	"bytes"
+	"context"
+
+	localDB "github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/database"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+)
+
+// checkReorg compares the parent hash of the block with the hash of the indexed previous block. If they mismatch,
+// the indexed blocks are rolled back to the last common height with the chain and indexed again, and the block
+// is fetched from the chain again because it may belong to the stale fork.
+func (i *Impl) checkReorg(height uint64, data *blockData) (*blockData, error) {
+	ctx := context.Background()
+	if i.reindexHeight == 0 {
+		if height <= 1 {
+			return data, nil
+		}
+		parent, err := localDB.Cast(i.DB).GetBlockHash(ctx, int64(height-1))
+		if err != nil {
+			log.Errorw("failed to get indexed block hash", "height", height-1, "error", err)
+			return nil, err
+		}
+		if parent == nil || bytes.Equal(parent.BlockHash.Bytes(), data.block.Block.LastBlockID.Hash) {
+			return data, nil
+		}
+		log.Warnw("chain reorg is detected", "height", height, "indexed_parent_hash", parent.BlockHash.String(),
+			"parent_hash", data.block.Block.LastBlockID.Hash.String())
+
+		commonBlock, err := i.findCommonBlock(ctx, int64(height-1))
+		if err != nil {
+			return nil, err
+		}
+		if commonBlock.Height < int64(height-1) {
+			if err = localDB.Cast(i.DB).RollbackToHeight(ctx, commonBlock.Height, commonBlock.BlockHash); err != nil {
+				log.Errorw("failed to roll back indexed blocks", "height", commonBlock.Height, "error", err)
+				return nil, err
+			}
+			metrics.BlocksyncerReorgCounter.Inc()
+			log.Infow("succeed to roll back indexed blocks", "common_height", commonBlock.Height, "height", height)
+			i.ProcessedHeight = uint64(commonBlock.Height)
+		}
+		i.reindexHeight = uint64(commonBlock.Height) + 1
+	}
+
+	for ; i.reindexHeight < height; i.reindexHeight++ {
+		reindexData, err := i.fetchBlock(i.reindexHeight)
+		if err != nil {
+			return nil, err
+		}
+		if err = i.export(i.reindexHeight, reindexData); err != nil {
+			log.Errorw("failed to index rolled back block again", "height", i.reindexHeight, "error", err)
+			return nil, err
+		}
+		i.ProcessedHeight = i.reindexHeight
+	}
+	i.reindexHeight = 0
+	return i.fetchBlock(height)
+}
+
+// findCommonBlock walks back from the given height until the indexed block hash matches the chain.
+func (i *Impl) findCommonBlock(ctx context.Context, height int64) (*bsdb.BlockHash, error) {
+	for h := height; h > 0 && height-h < int64(i.ReorgDepth); h-- {
+		indexed, err := localDB.Cast(i.DB).GetBlockHash(ctx, h)
+		if err != nil {
+			log.Errorw("failed to get indexed block hash", "height", h, "error", err)
+			return nil, err
+		}
+		if indexed == nil {
+			break
+		}
+		block, err := i.Node.Block(h)
+		if err != nil {
+			log.Warnf("failed to get block from node: %s", err)
+			return nil, err
+		}
+		if bytes.Equal(indexed.BlockHash.Bytes(), block.BlockID.Hash) {
+			return indexed, nil
+		}
+	}
+	log.Errorw("failed to find common block of chain reorg", "height", height, "reorg_depth", i.ReorgDepth)
+	return nil, ErrReorgTooDeep
+}
+
+// journalEnabled returns whether the rows changed by the block are journaled. The blocks which are deeper than
+// the reorg depth below the chain head are final, they are not journaled to speed up catching up.
+func (i *Impl) journalEnabled(height uint64) bool {
+	latestHeight, ok := i.LatestBlockHeight.Load().(int64)
+	if !ok {
+		return true
+	}
+	return latestHeight-int64(height) < int64(i.ReorgDepth)
+}
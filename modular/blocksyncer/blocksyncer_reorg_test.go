@@ -0,0 +1,181 @@
+package blocksyncer
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/gob"
+	"fmt"
+	"regexp"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/forbole/juno/v4/common"
+	"github.com/forbole/juno/v4/database"
+	"github.com/forbole/juno/v4/database/mysql"
+	"github.com/forbole/juno/v4/node"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gormmysql "gorm.io/driver/mysql"
+	"gorm.io/gorm"
+
+	localDB "github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/database"
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+)
+
+const mainFork = "main"
+
+func blockHashOf(fork string, height int64) []byte {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s-%d", fork, height)))
+	return hash[:]
+}
+
+// mockNode serves the blocks of the main fork, and the blocks of another fork from the fork height after a reorg.
+type mockNode struct {
+	node.Node
+	fork       string
+	forkHeight int64
+}
+
+func (n *mockNode) reorg(fork string, forkHeight int64) {
+	n.fork = fork
+	n.forkHeight = forkHeight
+}
+
+func (n *mockNode) blockHash(height int64) []byte {
+	if n.fork != "" && height >= n.forkHeight {
+		return blockHashOf(n.fork, height)
+	}
+	return blockHashOf(mainFork, height)
+}
+
+func (n *mockNode) Block(height int64) (*coretypes.ResultBlock, error) {
+	return &coretypes.ResultBlock{
+		BlockID: tmtypes.BlockID{Hash: n.blockHash(height)},
+		Block: &tmtypes.Block{Header: tmtypes.Header{
+			Height:      height,
+			Time:        time.Unix(height, 0),
+			LastBlockID: tmtypes.BlockID{Hash: n.blockHash(height - 1)},
+		}},
+	}, nil
+}
+
+func (n *mockNode) BlockResults(height int64) (*coretypes.ResultBlockResults, error) {
+	return &coretypes.ResultBlockResults{Height: height}, nil
+}
+
+func setupReorgIndexer(t *testing.T, reorgDepth uint64) (*Impl, *mockNode, sqlmock.Sqlmock) {
+	t.Helper()
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	db, err := gorm.Open(gormmysql.New(gormmysql.Config{Conn: mockDB, SkipInitializeWithVersion: true}),
+		&gorm.Config{SkipDefaultTransaction: true})
+	require.NoError(t, err)
+
+	blockMap, eventMap, txMap, txHashMap = new(sync.Map), new(sync.Map), new(sync.Map), new(sync.Map)
+	RealTimeStart = &atomic.Bool{}
+	RealTimeStart.Store(true)
+	CatchEndBlock = &atomic.Int64{}
+
+	chain := &mockNode{}
+	indexer := NewIndexer(nil, chain, &localDB.DB{Database: &mysql.Database{Impl: database.Impl{Db: db}}}, nil,
+		"blocksyncer", uint64(CommitNumber), false, reorgDepth, false)
+	Cast(indexer).LatestBlockHeight.Store(int64(6))
+	return Cast(indexer), chain, mock
+}
+
+func expectGetBlockHash(mock sqlmock.Sqlmock, height int64, fork string) {
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `block_hashes` WHERE height = ?")).WithArgs(height).
+		WillReturnRows(sqlmock.NewRows([]string{"height", "block_hash", "parent_hash"}).
+			AddRow(height, blockHashOf(fork, height), blockHashOf(fork, height-1)))
+}
+
+func expectExport(mock sqlmock.Sqlmock, chain *mockNode, height int64) {
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `epoch` .*INSERT INTO `block_hashes` ").
+		WithArgs(true, height, common.BytesToHash(chain.blockHash(height)), height,
+			height, common.BytesToHash(chain.blockHash(height)), common.BytesToHash(chain.blockHash(height-1))).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+	mock.ExpectCommit()
+}
+
+func TestProcess_NoReorg(t *testing.T) {
+	indexer, chain, mock := setupReorgIndexer(t, 10)
+	expectGetBlockHash(mock, 5, mainFork)
+	expectExport(mock, chain, 6)
+
+	require.NoError(t, indexer.Process(6))
+	assert.Equal(t, uint64(6), indexer.ProcessedHeight)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestProcess_Reorg(t *testing.T) {
+	indexer, chain, mock := setupReorgIndexer(t, 10)
+	// the blocks 1-5 of the main fork are indexed, then the blocks from 4 are replaced by another fork
+	chain.reorg("other", 4)
+
+	expectGetBlockHash(mock, 5, mainFork)
+	// walk back to the common height 3
+	expectGetBlockHash(mock, 5, mainFork)
+	expectGetBlockHash(mock, 4, mainFork)
+	expectGetBlockHash(mock, 3, mainFork)
+
+	table := bsdb.GetObjectsTableName("bucket")
+	args, preImage := encodeJournal(t, []interface{}{"0x1"}), encodeJournal(t,
+		[]map[string]interface{}{{"id": int64(1), "object_name": "object"}})
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `rollback_journal` WHERE height > ?")).WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "height", "table_name", "where_clause", "args", "pre_image"}).
+			AddRow(1, 4, table, "object_id = ?", args, preImage))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `" + table + "` WHERE object_id = ?")).WithArgs("0x1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM information_schema.STATISTICS")).WithArgs(table).
+		WillReturnRows(sqlmock.NewRows([]string{"INDEX_NAME", "COLUMN_NAME"}).AddRow("PRIMARY", "id"))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `" + table + "` WHERE `id` = ?")).WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `"+table+"`")).WithArgs(1, "object").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `block_result` WHERE block_height > ?")).WithArgs(3).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `block_hashes` WHERE height > ?")).WithArgs(3).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `rollback_journal` WHERE height > ?")).WithArgs(3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `epoch` SET `block_hash`=?,`block_height`=? WHERE one_row_id = ?")).
+		WithArgs(common.BytesToHash(blockHashOf(mainFork, 3)), 3, true).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// index the rolled back heights of the new fork again before the block
+	expectExport(mock, chain, 4)
+	expectExport(mock, chain, 5)
+	expectExport(mock, chain, 6)
+
+	require.NoError(t, indexer.Process(6))
+	assert.Equal(t, uint64(6), indexer.ProcessedHeight)
+	assert.Equal(t, uint64(0), indexer.reindexHeight)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestProcess_ReorgTooDeep(t *testing.T) {
+	indexer, chain, mock := setupReorgIndexer(t, 2)
+	chain.reorg("other", 2)
+
+	expectGetBlockHash(mock, 5, mainFork)
+	expectGetBlockHash(mock, 5, mainFork)
+	expectGetBlockHash(mock, 4, mainFork)
+
+	assert.ErrorIs(t, indexer.Process(6), ErrReorgTooDeep)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func encodeJournal(t *testing.T, v interface{}) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buf).Encode(v))
+	return buf.Bytes()
+}
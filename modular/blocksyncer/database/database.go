@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/forbole/juno/v4/database"
 	"github.com/forbole/juno/v4/database/mysql"
@@ -22,6 +23,12 @@ var _ database.Database = &DB{}
 // so that it can properly store custom BigDipper-related data.
 type DB struct {
 	*mysql.Database
+	// tableKeys caches the primary key and unique keys of the journaled tables
+	tableKeys sync.Map
+	// historyColumns caches the columns of the history tables
+	historyColumns sync.Map
+	// historyEnabled defines whether the history tables are maintained, it's set when the history tables are prepared
+	historyEnabled bool
 }
 
 // BlockSyncerDBBuilder allows to create a new DB instance implementing the db.Builder type
@@ -58,7 +65,8 @@ func (db *DB) AutoMigrate(ctx context.Context, tables []schema.Tabler) error {
 	q := db.Db.WithContext(ctx)
 	m := db.Db.Migrator()
 	for _, t := range tables {
-		if t.TableName() == bsdb.PrefixTreeTableName || t.TableName() == bsdb.ObjectTableName {
+		if t.TableName() == bsdb.PrefixTreeTableName || t.TableName() == bsdb.ObjectTableName ||
+			t.TableName() == bsdb.ObjectHistoryTableName {
 			for i := 0; i < bsdb.ObjectsNumberOfShards; i++ {
 				shardTableName := fmt.Sprintf(t.TableName()+"_%02d", i)
 				if err := q.Table(shardTableName).AutoMigrate(t); err != nil {
@@ -81,7 +89,8 @@ func (db *DB) PrepareTables(ctx context.Context, tables []schema.Tabler) error {
 	m := db.Db.Migrator()
 
 	for _, t := range tables {
-		if t.TableName() == bsdb.PrefixTreeTableName || t.TableName() == bsdb.ObjectTableName {
+		if t.TableName() == bsdb.PrefixTreeTableName || t.TableName() == bsdb.ObjectTableName ||
+			t.TableName() == bsdb.ObjectHistoryTableName {
 			for i := 0; i < bsdb.ObjectsNumberOfShards; i++ {
 				shardTableName := fmt.Sprintf(t.TableName()+"_%02d", i)
 				if m.HasTable(shardTableName) {
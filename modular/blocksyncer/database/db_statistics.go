@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/forbole/juno/v4/common"
 	"github.com/forbole/juno/v4/models"
 	"gorm.io/gorm/clause"
 
@@ -59,3 +60,90 @@ func (db *DB) SaveBlockResult(ctx context.Context, br *models.BlockResult) error
 func (db *DB) DeleteBlockResult(ctx context.Context, blockHeight int64) error {
 	return db.Db.Table((&models.BlockResult{}).TableName()).Where("block_height < ?", blockHeight).Delete(&models.BlockResult{}).Error
 }
+
+// statusCount is the number of the rows of a status
+type statusCount struct {
+	Status string
+	Count  int64
+}
+
+// CountBucketsByStatus returns the number of the buckets of every status, the removed buckets are not counted
+func (db *DB) CountBucketsByStatus(ctx context.Context) (map[string]int64, error) {
+	var counts []*statusCount
+	if err := db.Db.WithContext(ctx).Table((&models.Bucket{}).TableName()).
+		Select("status, COUNT(*) AS count").
+		Where("removed = false").
+		Group("status").
+		Find(&counts).Error; err != nil {
+		return nil, err
+	}
+	result := make(map[string]int64, len(counts))
+	for _, count := range counts {
+		result[count.Status] += count.Count
+	}
+	return result, nil
+}
+
+// CountObjectsByStatus returns the number of the objects of every status in all the shards, the removed objects are
+// not counted
+func (db *DB) CountObjectsByStatus(ctx context.Context) (map[string]int64, error) {
+	result := make(map[string]int64)
+	for i := 0; i < bsdb.ObjectsNumberOfShards; i++ {
+		var counts []*statusCount
+		if err := db.Db.WithContext(ctx).Table(bsdb.GetObjectsTableNameByShardNumber(i)).
+			Select("status, COUNT(*) AS count").
+			Where("removed = false").
+			Group("status").
+			Find(&counts).Error; err != nil {
+			return nil, err
+		}
+		for _, count := range counts {
+			result[count.Status] += count.Count
+		}
+	}
+	return result, nil
+}
+
+// SampleBuckets returns the buckets from the position of the buckets table in the order of id, the position is a
+// fraction of the max id in [0, 1)
+func (db *DB) SampleBuckets(ctx context.Context, position float64, limit int) ([]*models.Bucket, error) {
+	var buckets []*models.Bucket
+	table := (&models.Bucket{}).TableName()
+	err := db.Db.WithContext(ctx).Table(table).
+		Where("id >= (SELECT FLOOR(MAX(id) * ?) FROM "+table+")", position).
+		Order("id").
+		Limit(limit).
+		Find(&buckets).Error
+	return buckets, err
+}
+
+// GetBucketsByIDs returns the buckets by the bucket ids
+func (db *DB) GetBucketsByIDs(ctx context.Context, bucketIDs []common.Hash) ([]*models.Bucket, error) {
+	var buckets []*models.Bucket
+	err := db.Db.WithContext(ctx).Table((&models.Bucket{}).TableName()).
+		Where("bucket_id IN ?", bucketIDs).
+		Find(&buckets).Error
+	return buckets, err
+}
+
+// SampleObjects returns the objects from the position of the objects table shard in the order of id, the position
+// is a fraction of the max id in [0, 1)
+func (db *DB) SampleObjects(ctx context.Context, shard int, position float64, limit int) ([]*models.Object, error) {
+	var objects []*models.Object
+	table := bsdb.GetObjectsTableNameByShardNumber(shard)
+	err := db.Db.WithContext(ctx).Table(table).
+		Where("id >= (SELECT FLOOR(MAX(id) * ?) FROM "+table+")", position).
+		Order("id").
+		Limit(limit).
+		Find(&objects).Error
+	return objects, err
+}
+
+// GetObjectsByIDs returns the objects of the objects table shard by the object ids
+func (db *DB) GetObjectsByIDs(ctx context.Context, shard int, objectIDs []common.Hash) ([]*models.Object, error) {
+	var objects []*models.Object
+	err := db.Db.WithContext(ctx).Table(bsdb.GetObjectsTableNameByShardNumber(shard)).
+		Where("object_id IN ?", objectIDs).
+		Find(&objects).Error
+	return objects, err
+}
@@ -0,0 +1,227 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/forbole/juno/v4/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+)
+
+var (
+	objectShardRegex = regexp.MustCompile("^" + bsdb.ObjectTableName + "_(\\d{2})$")
+
+	// historyModels defines the models of the history tables, the object history table is sharded like the objects table
+	historyModels = map[string]schema.Tabler{
+		(&models.Bucket{}).TableName():     &bsdb.BucketHistory{},
+		bsdb.ObjectTableName:               &bsdb.ObjectHistory{},
+		(&models.Group{}).TableName():      &bsdb.GroupHistory{},
+		(&models.Permission{}).TableName(): &bsdb.PermissionHistory{},
+		(&models.Statements{}).TableName(): &bsdb.StatementHistory{},
+	}
+)
+
+// historyTableOf returns the history table and its model of the versioned table, returns false if the table is not
+// versioned
+func historyTableOf(table string) (string, schema.Tabler, bool) {
+	if match := objectShardRegex.FindStringSubmatch(table); match != nil {
+		return bsdb.ObjectHistoryTableName + "_" + match[1], historyModels[bsdb.ObjectTableName], true
+	}
+	if table == bsdb.ObjectTableName {
+		return "", nil, false
+	}
+	model, ok := historyModels[table]
+	if !ok {
+		return "", nil, false
+	}
+	return model.TableName(), model, true
+}
+
+// versionedTables returns all the versioned tables including the shards of the objects table
+func versionedTables() []string {
+	tables := []string{
+		(&models.Bucket{}).TableName(),
+		(&models.Group{}).TableName(),
+		(&models.Permission{}).TableName(),
+		(&models.Statements{}).TableName(),
+	}
+	for i := 0; i < bsdb.ObjectsNumberOfShards; i++ {
+		tables = append(tables, bsdb.GetObjectsTableNameByShardNumber(i))
+	}
+	return tables
+}
+
+// PrepareHistory creates the history tables and enables the history of the db. If the history does not cover the
+// current block, e.g. the history is just enabled or was disabled for some blocks, it snapshots all the rows of the
+// versioned tables at the current block and the history starts from it.
+func (db *DB) PrepareHistory(ctx context.Context) error {
+	if err := db.PrepareTables(ctx, []schema.Tabler{&bsdb.HistoryEpoch{}, &bsdb.BucketHistory{}, &bsdb.ObjectHistory{},
+		&bsdb.GroupHistory{}, &bsdb.PermissionHistory{}, &bsdb.StatementHistory{}}); err != nil {
+		return err
+	}
+	db.historyEnabled = true
+
+	epoch, err := db.GetEpoch(ctx)
+	if err != nil {
+		return err
+	}
+	var historyEpoch *bsdb.HistoryEpoch
+	err = db.Db.WithContext(ctx).Table(bsdb.HistoryEpochTableName).Take(&historyEpoch).Error
+	if err != nil && !errIsNotFound(err) {
+		return err
+	}
+	if historyEpoch != nil && historyEpoch.BlockHeight == epoch.BlockHeight {
+		return nil
+	}
+
+	// the history epoch is written at last, so the snapshot is taken again if it's interrupted
+	q := db.Db.WithContext(ctx)
+	for _, table := range versionedTables() {
+		historyTable, model, _ := historyTableOf(table)
+		// the versions above the current block are left by the blocks rolled back when the history was disabled
+		if err = q.Exec("DELETE FROM "+quoteIdentifier(historyTable)+" WHERE `height` > ?", epoch.BlockHeight).Error; err != nil {
+			return fmt.Errorf("failed to clean %s: %w", historyTable, err)
+		}
+		sql, err := db.snapshotSQL(table, historyTable, model, "")
+		if err != nil {
+			return err
+		}
+		if err = q.Exec(sql, epoch.BlockHeight).Error; err != nil {
+			return fmt.Errorf("failed to snapshot %s: %w", table, err)
+		}
+	}
+	return q.Table(bsdb.HistoryEpochTableName).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "one_row_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"start_height", "block_height"}),
+	}).Create(&bsdb.HistoryEpoch{OneRowID: true, StartHeight: epoch.BlockHeight, BlockHeight: epoch.BlockHeight}).Error
+}
+
+// HistoryToSQL returns the statements which record the rows changed by the statements of the block as their versions
+// at the block height, and move the history to the block. It must be called before the statements are executed, and
+// the returned statements must be executed after them.
+func (db *DB) HistoryToSQL(ctx context.Context, height int64, statements []map[string][]interface{}) ([]map[string][]interface{}, error) {
+	var (
+		tables     []string
+		conditions = make(map[string][]string)
+		args       = make(map[string][]interface{})
+	)
+	for _, m := range statements {
+		for statement, vars := range m {
+			if _, _, ok := historyTableOf(statementTable(statement)); !ok {
+				continue
+			}
+			query, err := db.parseJournalQuery(ctx, statement, vars)
+			if err != nil {
+				return nil, err
+			}
+			if query == nil {
+				continue
+			}
+			// the rows may no longer match the where clause after they are changed, select them by primary key too
+			keys, err := db.getTableKeys(ctx, query.table)
+			if err != nil {
+				return nil, err
+			}
+			var ids []interface{}
+			if len(keys.primary) == 1 {
+				if err = db.Db.WithContext(ctx).Table(query.table).Where(query.where, query.args...).
+					Pluck(keys.primary[0], &ids).Error; err != nil {
+					return nil, fmt.Errorf("failed to read changed rows of %s: %w", query.table, err)
+				}
+			}
+			if _, ok := conditions[query.table]; !ok {
+				tables = append(tables, query.table)
+			}
+			conditions[query.table] = append(conditions[query.table], "("+query.where+")")
+			args[query.table] = append(args[query.table], query.args...)
+			if len(ids) > 0 {
+				conditions[query.table] = append(conditions[query.table], quoteIdentifier(keys.primary[0])+" IN ?")
+				args[query.table] = append(args[query.table], ids)
+			}
+		}
+	}
+
+	sqls := make([]map[string][]interface{}, 0, len(tables)+1)
+	for _, table := range tables {
+		historyTable, model, _ := historyTableOf(table)
+		sql, err := db.snapshotSQL(table, historyTable, model, strings.Join(conditions[table], " OR "))
+		if err != nil {
+			return nil, err
+		}
+		// expand the primary key lists by the dry run session
+		stat := db.Db.Session(&gorm.Session{DryRun: true}).Exec(sql, append([]interface{}{height}, args[table]...)...).Statement
+		sqls = append(sqls, map[string][]interface{}{stat.SQL.String(): stat.Vars})
+	}
+	stat := db.Db.Session(&gorm.Session{DryRun: true}).Table(bsdb.HistoryEpochTableName).
+		Where("one_row_id = ?", true).Update("block_height", height).Statement
+	sqls = append(sqls, map[string][]interface{}{stat.SQL.String(): stat.Vars})
+	return sqls, nil
+}
+
+// snapshotSQL returns the statement which copies the rows of the table selected by the where clause into the history
+// table as their versions at the block height, it takes the block height as the first argument.
+func (db *DB) snapshotSQL(table, historyTable string, model schema.Tabler, where string) (string, error) {
+	columns, err := db.getHistoryColumns(model)
+	if err != nil {
+		return "", err
+	}
+	sql := fmt.Sprintf("REPLACE INTO %s (`height`,%s) SELECT ?,%s FROM %s", quoteIdentifier(historyTable), columns,
+		columns, quoteIdentifier(table))
+	if where != "" {
+		sql += " WHERE " + where
+	}
+	return sql, nil
+}
+
+// getHistoryColumns returns the quoted columns of the history model except the block height
+func (db *DB) getHistoryColumns(model schema.Tabler) (string, error) {
+	if columns, ok := db.historyColumns.Load(model.TableName()); ok {
+		return columns.(string), nil
+	}
+	s, err := schema.Parse(model, &sync.Map{}, db.Db.NamingStrategy)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse %s: %w", model.TableName(), err)
+	}
+	columns := make([]string, 0, len(s.DBNames))
+	for _, name := range s.DBNames {
+		if name != "height" {
+			columns = append(columns, quoteIdentifier(name))
+		}
+	}
+	db.historyColumns.Store(model.TableName(), strings.Join(columns, ","))
+	return strings.Join(columns, ","), nil
+}
+
+// rollbackHistory removes the versions of the blocks above the given height. The history is dropped if it starts
+// above the height, and it's snapshotted again at the next start.
+func (db *DB) rollbackHistory(tx *gorm.DB, height int64) error {
+	for _, table := range versionedTables() {
+		historyTable, _, _ := historyTableOf(table)
+		if err := tx.Exec("DELETE FROM "+quoteIdentifier(historyTable)+" WHERE `height` > ?", height).Error; err != nil {
+			return err
+		}
+	}
+	if err := tx.Table(bsdb.HistoryEpochTableName).Where("start_height > ?", height).
+		Delete(&bsdb.HistoryEpoch{}).Error; err != nil {
+		return err
+	}
+	return tx.Table(bsdb.HistoryEpochTableName).Where("one_row_id = ?", true).
+		Update("block_height", height).Error
+}
+
+// statementTable returns the table changed by the insert, update or delete statement
+func statementTable(statement string) string {
+	for _, regex := range []*regexp.Regexp{insertStatementRegex, updateStatementRegex, deleteStatementRegex} {
+		if match := regex.FindStringSubmatch(statement); match != nil {
+			return match[1]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,77 @@
+package database
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/forbole/juno/v4/common"
+	"github.com/forbole/juno/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+)
+
+func TestHistoryTableOf(t *testing.T) {
+	table, model, ok := historyTableOf("objects_05")
+	assert.True(t, ok)
+	assert.Equal(t, "object_histories_05", table)
+	assert.Equal(t, bsdb.ObjectHistoryTableName, model.TableName())
+
+	table, _, ok = historyTableOf("buckets")
+	assert.True(t, ok)
+	assert.Equal(t, bsdb.BucketHistoryTableName, table)
+
+	for _, name := range []string{"objects", "object_id_map", "epoch", "rollback_journal"} {
+		_, _, ok = historyTableOf(name)
+		assert.False(t, ok, name)
+	}
+	assert.Len(t, versionedTables(), bsdb.ObjectsNumberOfShards+4)
+}
+
+func TestHistoryToSQL(t *testing.T) {
+	db, mock := setupDB(t)
+	object := &models.Object{BucketName: "bucket", ObjectID: common.HexToHash("0x1"), ObjectName: "object"}
+	updateSQL, updateVars := db.UpdateObjectByBucketNameAndObjectNameToSQL(context.Background(), object)
+	epochSQL, epochVars := db.SaveEpochToSQL(context.Background(), &models.Epoch{OneRowId: true, BlockHeight: 10})
+	table := bsdb.GetObjectsTableName("bucket")
+
+	expectTableKeys(mock, table, []string{"PRIMARY", "id"}, []string{"idx_object_id", "object_id"})
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id` FROM `"+table+"` WHERE bucket_name = ? AND object_name =? AND removed=false")).
+		WithArgs("bucket", "object").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+	sqls, err := db.HistoryToSQL(context.Background(), 10, []map[string][]interface{}{
+		{updateSQL: updateVars},
+		{epochSQL: epochVars},
+	})
+	require.NoError(t, err)
+	require.Len(t, sqls, 2)
+	for sql, vars := range sqls[0] {
+		assert.Regexp(t, "^REPLACE INTO `object_histories_\\d{2}` \\(`height`,`object_id`,.*\\) SELECT \\?,`object_id`,", sql)
+		assert.Contains(t, sql, " FROM `"+table+"` WHERE (bucket_name = ? AND object_name =? AND removed=false) OR `id` IN (?)")
+		assert.Equal(t, []interface{}{int64(10), "bucket", "object", int64(3)}, vars)
+	}
+	for sql, vars := range sqls[1] {
+		assert.Equal(t, "UPDATE `history_epoch` SET `block_height`=? WHERE one_row_id = ?", sql)
+		assert.Equal(t, []interface{}{int64(10), true}, vars)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRollbackHistory(t *testing.T) {
+	db, mock := setupDB(t)
+	for _, table := range versionedTables() {
+		historyTable, _, _ := historyTableOf(table)
+		mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `" + historyTable + "` WHERE `height` > ?")).WithArgs(5).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `history_epoch` WHERE start_height > ?")).WithArgs(5).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `history_epoch` SET `block_height`=? WHERE one_row_id = ?")).WithArgs(5, true).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, db.rollbackHistory(db.Db, 5))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
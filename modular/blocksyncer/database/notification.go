@@ -0,0 +1,74 @@
+package database
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+)
+
+// ListNotificationRules lists the notification rules of the bucket
+func (db *DB) ListNotificationRules(ctx context.Context, bucketName string) ([]*bsdb.NotificationRule, error) {
+	var rules []*bsdb.NotificationRule
+	err := db.Db.WithContext(ctx).Table((&bsdb.NotificationRule{}).TableName()).
+		Where("bucket_name = ?", bucketName).Find(&rules).Error
+	return rules, err
+}
+
+// CreateNotificationsToSQL queues the notifications of the block, they are rolled back with the block after a reorg
+func (db *DB) CreateNotificationsToSQL(ctx context.Context, notifications []*bsdb.Notification) (string, []interface{}) {
+	stat := db.Db.Session(&gorm.Session{DryRun: true}).Table((&bsdb.Notification{}).TableName()).
+		Create(&notifications).Statement
+	return stat.SQL.String(), stat.Vars
+}
+
+// ListDueNotifications lists the queued notifications whose next retry time is not after the given time
+func (db *DB) ListDueNotifications(ctx context.Context, now int64, limit int) ([]*bsdb.Notification, error) {
+	var notifications []*bsdb.Notification
+	err := db.Db.WithContext(ctx).Table((&bsdb.Notification{}).TableName()).
+		Where("next_retry_time <= ?", now).Order("id").Limit(limit).Find(&notifications).Error
+	return notifications, err
+}
+
+// DeleteNotification removes the delivered notification from the queue
+func (db *DB) DeleteNotification(ctx context.Context, id uint64) error {
+	return db.Db.WithContext(ctx).Table((&bsdb.Notification{}).TableName()).
+		Where("id = ?", id).Delete(&bsdb.Notification{}).Error
+}
+
+// UpdateNotificationRetry records the failed delivery and the next retry time of the notification
+func (db *DB) UpdateNotificationRetry(ctx context.Context, notification *bsdb.Notification) error {
+	return db.Db.WithContext(ctx).Table((&bsdb.Notification{}).TableName()).
+		Where("id = ?", notification.ID).
+		Updates(map[string]interface{}{
+			"attempts":        notification.Attempts,
+			"next_retry_time": notification.NextRetryTime,
+			"last_error":      notification.LastError,
+		}).Error
+}
+
+// MoveNotificationToDeadLetter moves the notification which failed to be delivered after the max attempts from the
+// queue to the dead letters
+func (db *DB) MoveNotificationToDeadLetter(ctx context.Context, notification *bsdb.Notification, failedTime int64) error {
+	return db.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		deadLetter := &bsdb.NotificationDeadLetter{
+			NotificationID: notification.NotificationID,
+			BucketName:     notification.BucketName,
+			RuleID:         notification.RuleID,
+			EventType:      notification.EventType,
+			Height:         notification.Height,
+			WebhookURL:     notification.WebhookURL,
+			Payload:        notification.Payload,
+			Attempts:       notification.Attempts,
+			LastError:      notification.LastError,
+			CreateTime:     notification.CreateTime,
+			FailedTime:     failedTime,
+		}
+		if err := tx.Table(deadLetter.TableName()).Create(deadLetter).Error; err != nil {
+			return err
+		}
+		return tx.Table((&bsdb.Notification{}).TableName()).
+			Where("id = ?", notification.ID).Delete(&bsdb.Notification{}).Error
+	})
+}
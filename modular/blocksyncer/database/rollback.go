@@ -0,0 +1,459 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"encoding/gob"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/forbole/juno/v4/common"
+	"github.com/forbole/juno/v4/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+)
+
+var (
+	insertStatementRegex = regexp.MustCompile("(?is)^\\s*insert\\s+(?:ignore\\s+)?into\\s+`?(\\w+)`?\\s*\\(([^)]*)\\)\\s*values\\s*(.*?)(?:\\s+on\\s+duplicate\\s+key\\s+update\\s+.*)?$")
+	updateStatementRegex = regexp.MustCompile("(?is)^\\s*update\\s+`?(\\w+)`?\\s+set\\s+")
+	deleteStatementRegex = regexp.MustCompile("(?is)^\\s*delete\\s+from\\s+`?(\\w+)`?")
+	whereRegex           = regexp.MustCompile(`(?is)\swhere\s`)
+
+	// notJournaledTables defines the tables which are rolled back explicitly
+	notJournaledTables = map[string]bool{
+		bsdb.EpochTableName:                 true,
+		bsdb.BlockHashTableName:             true,
+		bsdb.RollbackJournalTableName:       true,
+		bsdb.HistoryEpochTableName:          true,
+		bsdb.BucketHistoryTableName:         true,
+		bsdb.GroupHistoryTableName:          true,
+		bsdb.PermissionHistoryTableName:     true,
+		bsdb.StatementHistoryTableName:      true,
+		(&models.BlockResult{}).TableName(): true,
+	}
+)
+
+func init() {
+	gob.Register(time.Time{})
+}
+
+// tableKeys defines the primary key and unique keys of a table
+type tableKeys struct {
+	primary []string
+	uniques [][]string
+}
+
+// journalQuery selects the rows which may be changed by a statement
+type journalQuery struct {
+	table string
+	where string
+	args  []interface{}
+}
+
+// SaveBlockHashToSQL records the hash of the indexed block
+func (db *DB) SaveBlockHashToSQL(ctx context.Context, blockHash *bsdb.BlockHash) (string, []interface{}) {
+	stat := db.Db.Session(&gorm.Session{DryRun: true}).Table((&bsdb.BlockHash{}).TableName()).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "height"}},
+		DoUpdates: clause.AssignmentColumns([]string{"block_hash", "parent_hash"}),
+	}).Create(blockHash).Statement
+	return stat.SQL.String(), stat.Vars
+}
+
+// GetBlockHash gets the hash of the indexed block, returns nil if the block is not recorded
+func (db *DB) GetBlockHash(ctx context.Context, height int64) (*bsdb.BlockHash, error) {
+	var blockHash *bsdb.BlockHash
+	err := db.Db.WithContext(ctx).Table((&bsdb.BlockHash{}).TableName()).
+		Where("height = ?", height).Take(&blockHash).Error
+	if errIsNotFound(err) {
+		return nil, nil
+	}
+	return blockHash, err
+}
+
+// DeleteBlockHashesToSQL deletes the hashes of the blocks below the given height
+func (db *DB) DeleteBlockHashesToSQL(ctx context.Context, height int64) (string, []interface{}) {
+	stat := db.Db.Session(&gorm.Session{DryRun: true}).Table((&bsdb.BlockHash{}).TableName()).
+		Where("height < ?", height).Delete(&bsdb.BlockHash{}).Statement
+	return stat.SQL.String(), stat.Vars
+}
+
+// DeleteRollbackJournalsToSQL deletes the journals of the blocks below the given height
+func (db *DB) DeleteRollbackJournalsToSQL(ctx context.Context, height int64) (string, []interface{}) {
+	stat := db.Db.Session(&gorm.Session{DryRun: true}).Table((&bsdb.RollbackJournal{}).TableName()).
+		Where("height < ?", height).Delete(&bsdb.RollbackJournal{}).Statement
+	return stat.SQL.String(), stat.Vars
+}
+
+// JournalToSQL reads the pre-images of the rows which will be changed by the statements of the block, and returns
+// the statement which records them in the rollback journal. It must be called before the statements are executed.
+func (db *DB) JournalToSQL(ctx context.Context, height int64, statements []map[string][]interface{}) (string, []interface{}, error) {
+	journals := make([]*bsdb.RollbackJournal, 0)
+	for _, m := range statements {
+		for statement, vars := range m {
+			query, err := db.parseJournalQuery(ctx, statement, vars)
+			if err != nil {
+				return "", nil, err
+			}
+			if query == nil {
+				continue
+			}
+			var rows []map[string]interface{}
+			if err = db.Db.WithContext(ctx).Table(query.table).Where(query.where, query.args...).Find(&rows).Error; err != nil {
+				return "", nil, fmt.Errorf("failed to read pre-image of %s: %w", query.table, err)
+			}
+			args, err := encodeGob(query.args)
+			if err != nil {
+				return "", nil, err
+			}
+			preImage, err := encodeGob(rows)
+			if err != nil {
+				return "", nil, err
+			}
+			journals = append(journals, &bsdb.RollbackJournal{
+				Height:      height,
+				Table:       query.table,
+				WhereClause: query.where,
+				Args:        args,
+				PreImage:    preImage,
+			})
+		}
+	}
+	if len(journals) == 0 {
+		return "", nil, nil
+	}
+	stat := db.Db.Session(&gorm.Session{DryRun: true}).Table((&bsdb.RollbackJournal{}).TableName()).Create(&journals).Statement
+	return stat.SQL.String(), stat.Vars, nil
+}
+
+// RollbackToHeight restores the rows changed by the blocks above the given height from the rollback journal in
+// reverse order, removes their versions from the history tables, and resets the epoch to the given block.
+func (db *DB) RollbackToHeight(ctx context.Context, height int64, blockHash common.Hash) error {
+	return db.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var journals []*bsdb.RollbackJournal
+		if err := tx.Table((&bsdb.RollbackJournal{}).TableName()).Where("height > ?", height).
+			Order("height DESC, id DESC").Find(&journals).Error; err != nil {
+			return err
+		}
+		for _, journal := range journals {
+			if err := db.rollbackJournal(ctx, tx, journal); err != nil {
+				return fmt.Errorf("failed to roll back journal %d of height %d: %w", journal.ID, journal.Height, err)
+			}
+		}
+		if err := tx.Table((&models.BlockResult{}).TableName()).Where("block_height > ?", height).
+			Delete(&models.BlockResult{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Table((&bsdb.BlockHash{}).TableName()).Where("height > ?", height).
+			Delete(&bsdb.BlockHash{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Table((&bsdb.RollbackJournal{}).TableName()).Where("height > ?", height).
+			Delete(&bsdb.RollbackJournal{}).Error; err != nil {
+			return err
+		}
+		if db.historyEnabled {
+			if err := db.rollbackHistory(tx, height); err != nil {
+				return fmt.Errorf("failed to roll back history: %w", err)
+			}
+		}
+		return tx.Table((&models.Epoch{}).TableName()).Where("one_row_id = ?", true).
+			Updates(map[string]interface{}{"block_height": height, "block_hash": blockHash}).Error
+	})
+}
+
+// rollbackJournal removes the rows selected by the journal and restores their pre-images
+func (db *DB) rollbackJournal(ctx context.Context, tx *gorm.DB, journal *bsdb.RollbackJournal) error {
+	var (
+		args []interface{}
+		rows []map[string]interface{}
+	)
+	if err := decodeGob(journal.Args, &args); err != nil {
+		return err
+	}
+	if err := decodeGob(journal.PreImage, &rows); err != nil {
+		return err
+	}
+	table := quoteIdentifier(journal.Table)
+	if err := tx.Exec("DELETE FROM "+table+" WHERE "+journal.WhereClause, args...).Error; err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	keys, err := db.getTableKeys(ctx, journal.Table)
+	if err != nil {
+		return err
+	}
+	// the rows may no longer match the where clause after they are changed
+	for _, row := range rows {
+		where, keyArgs := keyCondition(keys.primary, func(column string) interface{} { return row[column] })
+		if err = tx.Exec("DELETE FROM "+table+" WHERE "+where, keyArgs...).Error; err != nil {
+			return err
+		}
+	}
+	return tx.Table(journal.Table).Create(&rows).Error
+}
+
+// parseJournalQuery parses the statement and returns the query which selects the rows it may change, returns nil if
+// the statement does not change the journaled tables.
+func (db *DB) parseJournalQuery(ctx context.Context, statement string, vars []interface{}) (*journalQuery, error) {
+	if match := insertStatementRegex.FindStringSubmatch(statement); match != nil {
+		table := match[1]
+		if notJournaledTables[table] {
+			return nil, nil
+		}
+		keys, err := db.getTableKeys(ctx, table)
+		if err != nil {
+			return nil, err
+		}
+		query, err := parseInsert(table, match[2], match[3], vars, keys)
+		if err != nil || query != nil {
+			return query, err
+		}
+		// the appended rows have no unique key, remove the rows whose auto increment key is above the current max
+		var maxID uint64
+		if err = db.Db.WithContext(ctx).Table(table).Select("COALESCE(MAX(" + quoteIdentifier(keys.primary[0]) + "), 0)").
+			Scan(&maxID).Error; err != nil {
+			return nil, err
+		}
+		return &journalQuery{table: table, where: quoteIdentifier(keys.primary[0]) + " > ?", args: []interface{}{maxID}}, nil
+	}
+
+	var table string
+	if match := updateStatementRegex.FindStringSubmatch(statement); match != nil {
+		table = match[1]
+	} else if match = deleteStatementRegex.FindStringSubmatch(statement); match != nil {
+		table = match[1]
+	} else {
+		return nil, nil
+	}
+	if notJournaledTables[table] {
+		return nil, nil
+	}
+	return parseWhere(table, statement, vars)
+}
+
+// getTableKeys returns the primary key and the unique keys of the table
+func (db *DB) getTableKeys(ctx context.Context, table string) (*tableKeys, error) {
+	if keys, ok := db.tableKeys.Load(table); ok {
+		return keys.(*tableKeys), nil
+	}
+	var columns []struct {
+		IndexName  string `gorm:"column:INDEX_NAME"`
+		ColumnName string `gorm:"column:COLUMN_NAME"`
+	}
+	if err := db.Db.WithContext(ctx).Raw("SELECT INDEX_NAME, COLUMN_NAME FROM information_schema.STATISTICS "+
+		"WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND NON_UNIQUE = 0 ORDER BY INDEX_NAME, SEQ_IN_INDEX",
+		table).Scan(&columns).Error; err != nil {
+		return nil, fmt.Errorf("failed to get keys of %s: %w", table, err)
+	}
+	keys := &tableKeys{}
+	indexes := make(map[string][]string)
+	var names []string
+	for _, column := range columns {
+		if column.IndexName == "PRIMARY" {
+			keys.primary = append(keys.primary, column.ColumnName)
+			continue
+		}
+		if _, ok := indexes[column.IndexName]; !ok {
+			names = append(names, column.IndexName)
+		}
+		indexes[column.IndexName] = append(indexes[column.IndexName], column.ColumnName)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		keys.uniques = append(keys.uniques, indexes[name])
+	}
+	if len(keys.primary) == 0 {
+		return nil, fmt.Errorf("table %s has no primary key", table)
+	}
+	db.tableKeys.Store(table, keys)
+	return keys, nil
+}
+
+// parseInsert returns the query which selects the inserted rows by the first unique key, or the primary key if
+// no unique key is inserted. It returns nil if the primary key is auto increment and no unique key is inserted.
+func parseInsert(table, columnList, values string, vars []interface{}, keys *tableKeys) (*journalQuery, error) {
+	columns := strings.Split(columnList, ",")
+	position := make(map[string]int, len(columns))
+	for idx, column := range columns {
+		position[strings.Trim(strings.TrimSpace(column), "`")] = idx
+	}
+
+	var key []string
+	for _, candidate := range append(keys.uniques, keys.primary) {
+		inserted := true
+		for _, column := range candidate {
+			if _, ok := position[column]; !ok {
+				inserted = false
+				break
+			}
+		}
+		if inserted {
+			key = candidate
+			break
+		}
+	}
+	if key == nil {
+		if len(keys.primary) != 1 {
+			return nil, fmt.Errorf("no key of %s is inserted", table)
+		}
+		return nil, nil
+	}
+
+	rows := splitValues(values)
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("failed to parse values of insert statement into %s", table)
+	}
+	var (
+		conditions []string
+		args       []interface{}
+		cursor     int
+	)
+	for _, row := range rows {
+		if len(row) != len(columns) {
+			return nil, fmt.Errorf("failed to parse values of insert statement into %s", table)
+		}
+		// the values are either placeholders or literals such as NULL
+		rowVars := make(map[int]interface{}, len(row))
+		for idx, item := range row {
+			placeholders := strings.Count(item, "?")
+			if cursor+placeholders > len(vars) {
+				return nil, fmt.Errorf("failed to parse values of insert statement into %s", table)
+			}
+			if item == "?" {
+				rowVars[idx] = vars[cursor]
+			}
+			cursor += placeholders
+		}
+		for _, column := range key {
+			if _, ok := rowVars[position[column]]; !ok {
+				return nil, fmt.Errorf("key %s of %s is not a placeholder", column, table)
+			}
+		}
+		condition, rowArgs := keyCondition(key, func(column string) interface{} {
+			return rowVars[position[column]]
+		})
+		conditions = append(conditions, "("+condition+")")
+		args = append(args, rowArgs...)
+	}
+	args, err := driverValues(args)
+	if err != nil {
+		return nil, err
+	}
+	return &journalQuery{table: table, where: strings.Join(conditions, " OR "), args: args}, nil
+}
+
+// splitValues splits the values of the insert statement into rows of items, e.g. "(?,NULL),(?,?)"
+func splitValues(values string) [][]string {
+	var (
+		rows   [][]string
+		row    []string
+		item   strings.Builder
+		depth  int
+		quoted bool
+	)
+	for _, c := range values {
+		switch {
+		case c == '\'' && depth > 0:
+			quoted = !quoted
+		case quoted:
+		case c == '(':
+			depth++
+			if depth == 1 {
+				row = nil
+				item.Reset()
+				continue
+			}
+		case c == ')':
+			depth--
+			if depth == 0 {
+				row = append(row, strings.TrimSpace(item.String()))
+				rows = append(rows, row)
+				continue
+			}
+		case c == ',' && depth == 1:
+			row = append(row, strings.TrimSpace(item.String()))
+			item.Reset()
+			continue
+		}
+		if depth > 0 {
+			item.WriteRune(c)
+		}
+	}
+	return rows
+}
+
+// parseWhere returns the query which selects the rows by the where clause of the update or delete statement
+func parseWhere(table, statement string, vars []interface{}) (*journalQuery, error) {
+	locations := whereRegex.FindAllStringIndex(statement, -1)
+	if len(locations) == 0 {
+		return nil, fmt.Errorf("statement on %s has no where clause", table)
+	}
+	where := strings.TrimSpace(statement[locations[len(locations)-1][1]:])
+	where = strings.TrimSuffix(where, ";")
+	placeholders := strings.Count(where, "?")
+	if placeholders > len(vars) {
+		return nil, fmt.Errorf("failed to parse where clause of statement on %s", table)
+	}
+	args, err := driverValues(vars[len(vars)-placeholders:])
+	if err != nil {
+		return nil, err
+	}
+	return &journalQuery{table: table, where: where, args: args}, nil
+}
+
+func keyCondition(key []string, value func(column string) interface{}) (string, []interface{}) {
+	conditions := make([]string, len(key))
+	args := make([]interface{}, len(key))
+	for idx, column := range key {
+		conditions[idx] = quoteIdentifier(column) + " = ?"
+		args[idx] = value(column)
+	}
+	return strings.Join(conditions, " AND "), args
+}
+
+// driverValues converts the statement arguments into the basic types which can be gob encoded
+func driverValues(vars []interface{}) ([]interface{}, error) {
+	values := make([]interface{}, len(vars))
+	for idx, v := range vars {
+		if _, ok := v.(driver.Valuer); !ok && v != nil {
+			if kind := reflect.ValueOf(v).Kind(); kind == reflect.Uint64 || kind == reflect.Uint {
+				values[idx] = reflect.ValueOf(v).Uint()
+				continue
+			}
+		}
+		value, err := driver.DefaultParameterConverter.ConvertValue(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert argument %v: %w", v, err)
+		}
+		values[idx] = value
+	}
+	return values, nil
+}
+
+func quoteIdentifier(name string) string {
+	return "`" + name + "`"
+}
+
+func encodeGob(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, fmt.Errorf("failed to encode journal: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeGob(data []byte, v interface{}) error {
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode journal: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package database
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/forbole/juno/v4/common"
+	"github.com/forbole/juno/v4/database"
+	"github.com/forbole/juno/v4/database/mysql"
+	"github.com/forbole/juno/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gormmysql "gorm.io/driver/mysql"
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+)
+
+func setupDB(t *testing.T) (*DB, sqlmock.Sqlmock) {
+	t.Helper()
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	db, err := gorm.Open(gormmysql.New(gormmysql.Config{Conn: mockDB, SkipInitializeWithVersion: true}),
+		&gorm.Config{SkipDefaultTransaction: true})
+	require.NoError(t, err)
+	return &DB{Database: &mysql.Database{Impl: database.Impl{Db: db}}}, mock
+}
+
+func expectTableKeys(mock sqlmock.Sqlmock, table string, indexes ...[]string) {
+	rows := sqlmock.NewRows([]string{"INDEX_NAME", "COLUMN_NAME"})
+	for _, index := range indexes {
+		rows.AddRow(index[0], index[1])
+	}
+	mock.ExpectQuery(regexp.QuoteMeta("FROM information_schema.STATISTICS")).WithArgs(table).WillReturnRows(rows)
+}
+
+func TestParseInsert(t *testing.T) {
+	keys := &tableKeys{primary: []string{"id"}, uniques: [][]string{{"object_id"}}}
+	objectID1, objectID2 := common.HexToHash("0x1"), common.HexToHash("0x2")
+	query, err := parseInsert("objects_00", "`bucket_name`,`object_id`", "(?,?),(?,?)",
+		[]interface{}{"bucket", objectID1, "bucket", objectID2}, keys)
+	require.NoError(t, err)
+	assert.Equal(t, "objects_00", query.table)
+	assert.Equal(t, "(`object_id` = ?) OR (`object_id` = ?)", query.where)
+	assert.Equal(t, []interface{}{objectID1.Bytes(), objectID2.Bytes()}, query.args)
+
+	// the nil values are inlined as NULL
+	query, err = parseInsert("objects_00", "`bucket_name`,`tags`,`object_id`", "(?,NULL,?)",
+		[]interface{}{"bucket", objectID1}, keys)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{objectID1.Bytes()}, query.args)
+
+	// no unique key is inserted
+	query, err = parseInsert("statements", "`policy_id`,`effect`", "(?,?)", []interface{}{objectID1, "allow"},
+		&tableKeys{primary: []string{"id"}})
+	require.NoError(t, err)
+	assert.Nil(t, query)
+
+	_, err = parseInsert("groups", "`group_id`", "(?)", []interface{}{objectID1},
+		&tableKeys{primary: []string{"group_id", "account_id"}})
+	assert.Error(t, err)
+	_, err = parseInsert("objects_00", "`bucket_name`,`object_id`", "(?,?),(?)", []interface{}{"bucket", objectID1, "bucket"}, keys)
+	assert.Error(t, err)
+}
+
+func TestParseWhere(t *testing.T) {
+	statement := "UPDATE buckets SET storage_size = storage_size + CONVERT((SELECT payload_size FROM objects_00 " +
+		"WHERE object_id = ?), DECIMAL(65,0)) WHERE bucket_name = ?"
+	query, err := parseWhere("buckets", statement, []interface{}{common.HexToHash("0x1"), "bucket"})
+	require.NoError(t, err)
+	assert.Equal(t, "bucket_name = ?", query.where)
+	assert.Equal(t, []interface{}{"bucket"}, query.args)
+
+	_, err = parseWhere("buckets", "UPDATE buckets SET removed = ?", []interface{}{true})
+	assert.Error(t, err)
+}
+
+func TestJournalToSQL(t *testing.T) {
+	db, mock := setupDB(t)
+	object := &models.Object{BucketName: "bucket", ObjectID: common.HexToHash("0x1"), ObjectName: "object"}
+	insertSQL, insertVars := db.SaveObjectToSQL(context.Background(), object)
+	updateSQL, updateVars := db.UpdateObjectToSQL(context.Background(), object)
+	epochSQL, epochVars := db.SaveEpochToSQL(context.Background(), &models.Epoch{OneRowId: true, BlockHeight: 10})
+	table := bsdb.GetObjectsTableName("bucket")
+
+	expectTableKeys(mock, table, []string{"PRIMARY", "id"}, []string{"idx_object_id", "object_id"})
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `" + table + "` WHERE (`object_id` = ?)")).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `" + table + "` WHERE object_id = ?")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "object_name"}).AddRow(1, "object"))
+	sql, vars, err := db.JournalToSQL(context.Background(), 10, []map[string][]interface{}{
+		{insertSQL: insertVars},
+		{updateSQL: updateVars},
+		{epochSQL: epochVars},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, sql, "INSERT INTO `rollback_journal`")
+	// 5 columns of 2 journals
+	assert.Len(t, vars, 10)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRollbackToHeight(t *testing.T) {
+	db, mock := setupDB(t)
+	table := bsdb.GetObjectsTableName("bucket")
+	args, err := encodeGob([]interface{}{"0x1"})
+	require.NoError(t, err)
+	preImage, err := encodeGob([]map[string]interface{}{{"id": int64(1), "object_name": "object"}})
+	require.NoError(t, err)
+	emptyImage, err := encodeGob([]map[string]interface{}{})
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `rollback_journal` WHERE height > ? ORDER BY height DESC, id DESC")).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "height", "table_name", "where_clause", "args", "pre_image"}).
+			AddRow(2, 7, table, "object_id = ?", args, preImage).
+			AddRow(1, 6, table, "(`object_id` = ?)", args, emptyImage))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `" + table + "` WHERE object_id = ?")).WithArgs("0x1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectTableKeys(mock, table, []string{"PRIMARY", "id"})
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `" + table + "` WHERE `id` = ?")).WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `"+table+"`")).WithArgs(1, "object").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `" + table + "` WHERE (`object_id` = ?)")).WithArgs("0x1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `block_result` WHERE block_height > ?")).WithArgs(5).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `block_hashes` WHERE height > ?")).WithArgs(5).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `rollback_journal` WHERE height > ?")).WithArgs(5).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `epoch` SET `block_hash`=?,`block_height`=? WHERE one_row_id = ?")).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	require.NoError(t, db.RollbackToHeight(context.Background(), 5, common.HexToHash("0x5")))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
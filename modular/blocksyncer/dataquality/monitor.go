@@ -0,0 +1,356 @@
+package dataquality
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/forbole/juno/v4/common"
+	"github.com/forbole/juno/v4/log"
+	"github.com/forbole/juno/v4/models"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gnfd"
+	"github.com/bnb-chain/greenfield-storage-provider/core/consensus"
+	coreprober "github.com/bnb-chain/greenfield-storage-provider/core/prober"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/database"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/probe"
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+const (
+	// DefaultCheckInterval defines the default interval of checking the height lag and the data drift
+	DefaultCheckInterval = time.Minute
+	// DefaultCountInterval defines the default interval of counting the buckets and objects
+	DefaultCountInterval = 30 * time.Minute
+	// DefaultSampleSize defines the default number of the buckets and the objects compared with the chain in a check
+	DefaultSampleSize = 20
+	// DefaultMaxHeightLag defines the default max number of blocks which bsdb is behind the chain
+	DefaultMaxHeightLag = 100
+	// DefaultMaxDriftRatio defines the default max ratio of the sampled rows which mismatch the chain
+	DefaultMaxDriftRatio = 0.05
+	// DefaultCatchUpTimeout defines the default time waiting for bsdb to index the blocks of the chain queries
+	DefaultCatchUpTimeout = 30 * time.Second
+
+	// HeightLagSLO defines the name of the objective of the height lag
+	HeightLagSLO = "bsdb_height_lag"
+	// DataDriftSLO defines the name of the objective of the data drift
+	DataDriftSLO = "bsdb_data_drift"
+
+	// BucketResource defines the drift metrics label of the buckets
+	BucketResource = "bucket"
+	// ObjectResource defines the drift metrics label of the objects
+	ObjectResource = "object"
+)
+
+// MonitorConfig defines the thresholds and the intervals of the data quality checks
+type MonitorConfig struct {
+	CheckInterval  time.Duration
+	CountInterval  time.Duration
+	SampleSize     int
+	MaxHeightLag   int64
+	MaxDriftRatio  float64
+	CatchUpTimeout time.Duration
+}
+
+// Monitor periodically compares bsdb with the chain and exports the results as metrics. It counts the buckets and
+// objects by status, measures the number of blocks bsdb is behind the chain, and compares the status of the sampled
+// buckets and objects with the chain. The probe is unready while the drift exceeds the threshold. The lag is only
+// exported as a metric and a breached objective to alert on, since the block syncer catches up after a restart.
+type Monitor struct {
+	db    *database.DB
+	chain consensus.Consensus
+	// slo defines the objectives which are a readiness condition of the probe
+	slo *probe.SLOProbe
+	// lagSLO defines the objective of the height lag, which doesn't change the readiness
+	lagSLO       *probe.SLOProbe
+	latestHeight func() int64
+	config       MonitorConfig
+	rand         *rand.Rand
+}
+
+// NewMonitor returns a Monitor instance, the zero fields of the config are set to the defaults. The latestHeight
+// returns the latest block height of the chain.
+func NewMonitor(db *database.DB, chain consensus.Consensus, prober coreprober.Prober, latestHeight func() int64,
+	cfg MonitorConfig) *Monitor {
+	if cfg.CheckInterval == 0 {
+		cfg.CheckInterval = DefaultCheckInterval
+	}
+	if cfg.CountInterval == 0 {
+		cfg.CountInterval = DefaultCountInterval
+	}
+	if cfg.SampleSize == 0 {
+		cfg.SampleSize = DefaultSampleSize
+	}
+	if cfg.MaxHeightLag == 0 {
+		cfg.MaxHeightLag = DefaultMaxHeightLag
+	}
+	if cfg.MaxDriftRatio == 0 {
+		cfg.MaxDriftRatio = DefaultMaxDriftRatio
+	}
+	if cfg.CatchUpTimeout == 0 {
+		cfg.CatchUpTimeout = DefaultCatchUpTimeout
+	}
+	return &Monitor{
+		db:           db,
+		chain:        chain,
+		slo:          probe.NewSLOProbe(prober),
+		lagSLO:       probe.NewSLOProbe(nil),
+		latestHeight: latestHeight,
+		config:       cfg,
+		rand:         rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// Run checks the data quality until the context is done
+func (m *Monitor) Run(ctx context.Context) {
+	checkTicker := time.NewTicker(m.config.CheckInterval)
+	defer checkTicker.Stop()
+	countTicker := time.NewTicker(m.config.CountInterval)
+	defer countTicker.Stop()
+	m.count(ctx)
+	m.check(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-checkTicker.C:
+			m.check(ctx)
+		case <-countTicker.C:
+			m.count(ctx)
+		}
+	}
+}
+
+func (m *Monitor) check(ctx context.Context) {
+	if err := m.checkHeightLag(ctx); err != nil {
+		log.Errorw("failed to check the height lag of bsdb", "error", err)
+		metrics.DataStatisticsErr.Inc()
+	}
+	if err := m.checkDrift(ctx); err != nil {
+		log.Errorw("failed to check the data drift of bsdb", "error", err)
+		metrics.DataStatisticsErr.Inc()
+	}
+}
+
+func (m *Monitor) count(ctx context.Context) {
+	if err := m.countRows(ctx); err != nil {
+		log.Errorw("failed to count the rows of bsdb", "error", err)
+		metrics.DataStatisticsErr.Inc()
+	}
+}
+
+// checkHeightLag compares the height of bsdb with the latest height of the chain
+func (m *Monitor) checkHeightLag(ctx context.Context) error {
+	epoch, err := m.db.GetEpoch(ctx)
+	if err != nil {
+		return err
+	}
+	lag := m.latestHeight() - epoch.BlockHeight
+	if lag < 0 {
+		lag = 0
+	}
+	metrics.BsDBHeightLagGauge.Set(float64(lag))
+	_ = m.lagSLO.Check(HeightLagSLO, float64(lag), float64(m.config.MaxHeightLag))
+	return nil
+}
+
+// countRows exports the number of the buckets and the objects by status
+func (m *Monitor) countRows(ctx context.Context) error {
+	bucketCounts, err := m.db.CountBucketsByStatus(ctx)
+	if err != nil {
+		return err
+	}
+	objectCounts, err := m.db.CountObjectsByStatus(ctx)
+	if err != nil {
+		return err
+	}
+	metrics.BsDBBucketCountGauge.Reset()
+	for status, count := range bucketCounts {
+		metrics.BsDBBucketCountGauge.WithLabelValues(status).Set(float64(count))
+	}
+	metrics.BsDBObjectCountGauge.Reset()
+	for status, count := range objectCounts {
+		metrics.BsDBObjectCountGauge.WithLabelValues(status).Set(float64(count))
+	}
+	return nil
+}
+
+// chainState is the state of a bucket or an object on chain
+type chainState struct {
+	exist  bool
+	status string
+}
+
+// checkDrift compares the sampled buckets and objects with the chain. The chain is queried at its latest height, so
+// the rows are compared after bsdb indexes the height, and the rows changed in the meantime are skipped.
+func (m *Monitor) checkDrift(ctx context.Context) error {
+	buckets, err := m.db.SampleBuckets(ctx, m.rand.Float64(), m.config.SampleSize)
+	if err != nil {
+		return err
+	}
+	shard := m.rand.Intn(bsdb.ObjectsNumberOfShards)
+	objects, err := m.db.SampleObjects(ctx, shard, m.rand.Float64(), m.config.SampleSize)
+	if err != nil {
+		return err
+	}
+	if len(buckets) == 0 && len(objects) == 0 {
+		return nil
+	}
+
+	bucketStates := make(map[common.Hash]*chainState, len(buckets))
+	for _, bucket := range buckets {
+		if state, queryErr := m.queryBucket(ctx, bucket.BucketID); queryErr != nil {
+			log.Errorw("failed to query bucket from chain", "bucket_id", bucket.BucketID.Big().String(), "error", queryErr)
+		} else {
+			bucketStates[bucket.BucketID] = state
+		}
+	}
+	objectStates := make(map[common.Hash]*chainState, len(objects))
+	for _, object := range objects {
+		if state, queryErr := m.queryObject(ctx, object.ObjectID); queryErr != nil {
+			log.Errorw("failed to query object from chain", "object_id", object.ObjectID.Big().String(), "error", queryErr)
+		} else {
+			objectStates[object.ObjectID] = state
+		}
+	}
+
+	caughtUp, err := m.waitForHeight(ctx, m.latestHeight())
+	if err != nil || !caughtUp {
+		return err
+	}
+	bucketIDs := make([]common.Hash, 0, len(bucketStates))
+	for bucketID := range bucketStates {
+		bucketIDs = append(bucketIDs, bucketID)
+	}
+	objectIDs := make([]common.Hash, 0, len(objectStates))
+	for objectID := range objectStates {
+		objectIDs = append(objectIDs, objectID)
+	}
+	var (
+		currentBuckets []*models.Bucket
+		currentObjects []*models.Object
+	)
+	if len(bucketIDs) > 0 {
+		if currentBuckets, err = m.db.GetBucketsByIDs(ctx, bucketIDs); err != nil {
+			return err
+		}
+	}
+	if len(objectIDs) > 0 {
+		if currentObjects, err = m.db.GetObjectsByIDs(ctx, shard, objectIDs); err != nil {
+			return err
+		}
+	}
+
+	sampledBuckets := make(map[common.Hash]*models.Bucket, len(buckets))
+	for _, bucket := range buckets {
+		sampledBuckets[bucket.BucketID] = bucket
+	}
+	var compared, mismatched int
+	for _, bucket := range currentBuckets {
+		sampled, state := sampledBuckets[bucket.BucketID], bucketStates[bucket.BucketID]
+		if sampled == nil || state == nil || sampled.UpdateAt != bucket.UpdateAt || sampled.Removed != bucket.Removed {
+			continue
+		}
+		compared++
+		if !bucket.Removed != state.exist || (state.exist && bucket.Status != state.status) {
+			mismatched++
+			log.Warnw("bucket of bsdb mismatches the chain", "bucket_id", bucket.BucketID.Big().String(),
+				"removed", bucket.Removed, "status", bucket.Status, "chain_exist", state.exist, "chain_status", state.status)
+		}
+	}
+	bucketDrift := driftRatio(mismatched, compared)
+
+	sampledObjects := make(map[common.Hash]*models.Object, len(objects))
+	for _, object := range objects {
+		sampledObjects[object.ObjectID] = object
+	}
+	compared, mismatched = 0, 0
+	for _, object := range currentObjects {
+		sampled, state := sampledObjects[object.ObjectID], objectStates[object.ObjectID]
+		if sampled == nil || state == nil || sampled.UpdateAt != object.UpdateAt || sampled.Removed != object.Removed {
+			continue
+		}
+		compared++
+		if !object.Removed != state.exist || (state.exist && object.Status != state.status) {
+			mismatched++
+			log.Warnw("object of bsdb mismatches the chain", "object_id", object.ObjectID.Big().String(),
+				"removed", object.Removed, "status", object.Status, "chain_exist", state.exist, "chain_status", state.status)
+		}
+	}
+	objectDrift := driftRatio(mismatched, compared)
+
+	metrics.BsDBDataDriftGauge.WithLabelValues(BucketResource).Set(bucketDrift)
+	metrics.BsDBDataDriftGauge.WithLabelValues(ObjectResource).Set(objectDrift)
+	drift := bucketDrift
+	if objectDrift > drift {
+		drift = objectDrift
+	}
+	_ = m.slo.Check(DataDriftSLO, drift, m.config.MaxDriftRatio)
+	return nil
+}
+
+// waitForHeight waits for bsdb to index the block height, it returns false if bsdb doesn't reach the height in time
+func (m *Monitor) waitForHeight(ctx context.Context, height int64) (bool, error) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	timeout := time.NewTimer(m.config.CatchUpTimeout)
+	defer timeout.Stop()
+	for {
+		epoch, err := m.db.GetEpoch(ctx)
+		if err != nil {
+			return false, err
+		}
+		if epoch.BlockHeight >= height {
+			return true, nil
+		}
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		case <-timeout.C:
+			log.Infow("skip the data drift check, bsdb is behind the chain", "height", epoch.BlockHeight,
+				"chain_height", height)
+			return false, nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *Monitor) queryBucket(ctx context.Context, bucketID common.Hash) (*chainState, error) {
+	bucketInfo, err := m.chain.QueryBucketInfoById(ctx, bucketID.Big().Uint64())
+	if err != nil {
+		if strings.Contains(err.Error(), storagetypes.ErrNoSuchBucket.Error()) {
+			return &chainState{}, nil
+		}
+		return nil, err
+	}
+	if bucketInfo == nil {
+		return nil, fmt.Errorf("empty bucket info")
+	}
+	return &chainState{exist: true, status: bucketInfo.GetBucketStatus().String()}, nil
+}
+
+func (m *Monitor) queryObject(ctx context.Context, objectID common.Hash) (*chainState, error) {
+	objectInfo, err := m.chain.QueryObjectInfoByID(ctx, objectID.Big().String())
+	if err != nil {
+		if errors.Is(err, gnfd.ErrNoSuchObject) {
+			return &chainState{}, nil
+		}
+		return nil, err
+	}
+	if objectInfo == nil {
+		return nil, fmt.Errorf("empty object info")
+	}
+	return &chainState{exist: true, status: objectInfo.GetObjectStatus().String()}, nil
+}
+
+func driftRatio(mismatched, compared int) float64 {
+	if compared == 0 {
+		return 0
+	}
+	return float64(mismatched) / float64(compared)
+}
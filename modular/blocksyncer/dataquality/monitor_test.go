@@ -0,0 +1,136 @@
+package dataquality
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"math/rand"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/forbole/juno/v4/common"
+	junodatabase "github.com/forbole/juno/v4/database"
+	"github.com/forbole/juno/v4/database/mysql"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	gormmysql "gorm.io/driver/mysql"
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/greenfield-storage-provider/core/consensus"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/database"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/probe"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+func setupMonitor(t *testing.T, latestHeight int64) (*Monitor, sqlmock.Sqlmock, *consensus.MockConsensus, *probe.HTTPProbe) {
+	t.Helper()
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	db, err := gorm.Open(gormmysql.New(gormmysql.Config{Conn: mockDB, SkipInitializeWithVersion: true}),
+		&gorm.Config{SkipDefaultTransaction: true})
+	require.NoError(t, err)
+	ctrl := gomock.NewController(t)
+	chain := consensus.NewMockConsensus(ctrl)
+	prober := probe.NewHTTPProbe()
+	prober.Ready()
+	monitor := NewMonitor(&database.DB{Database: &mysql.Database{Impl: junodatabase.Impl{Db: db}}}, chain, prober,
+		func() int64 { return latestHeight }, MonitorConfig{SampleSize: 2})
+	monitor.rand = rand.New(rand.NewSource(1))
+	return monitor, mock, chain, prober
+}
+
+func expectEpoch(mock sqlmock.Sqlmock, height int64) {
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `epoch`")).
+		WillReturnRows(sqlmock.NewRows([]string{"one_row_id", "block_height"}).AddRow(true, height))
+}
+
+func TestMonitor_CheckHeightLag(t *testing.T) {
+	monitor, mock, _, prober := setupMonitor(t, 300)
+	expectEpoch(mock, 100)
+	require.NoError(t, monitor.checkHeightLag(context.Background()))
+	assert.Equal(t, []string{HeightLagSLO}, monitor.lagSLO.Breached())
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.SLOBreachGauge.WithLabelValues(HeightLagSLO)))
+	// the lag is alerted on but doesn't make the probe unready
+	assert.True(t, prober.IsReady())
+
+	expectEpoch(mock, 250)
+	require.NoError(t, monitor.checkHeightLag(context.Background()))
+	assert.Empty(t, monitor.lagSLO.Breached())
+	assert.Equal(t, float64(0), testutil.ToFloat64(metrics.SLOBreachGauge.WithLabelValues(HeightLagSLO)))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMonitor_CountRows(t *testing.T) {
+	monitor, mock, _, _ := setupMonitor(t, 0)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT status, COUNT(*) AS count FROM `buckets` WHERE removed = false GROUP BY `status`")).
+		WillReturnRows(sqlmock.NewRows([]string{"status", "count"}).AddRow("BUCKET_STATUS_CREATED", 3))
+	for i := 0; i < 64; i++ {
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT status, COUNT(*) AS count FROM `objects_")).
+			WillReturnRows(sqlmock.NewRows([]string{"status", "count"}).AddRow("OBJECT_STATUS_SEALED", 2))
+	}
+	require.NoError(t, monitor.countRows(context.Background()))
+	assert.Equal(t, float64(3), testutil.ToFloat64(metrics.BsDBBucketCountGauge.WithLabelValues("BUCKET_STATUS_CREATED")))
+	assert.Equal(t, float64(128), testutil.ToFloat64(metrics.BsDBObjectCountGauge.WithLabelValues("OBJECT_STATUS_SEALED")))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMonitor_CheckDrift(t *testing.T) {
+	monitor, mock, chain, prober := setupMonitor(t, 200)
+	bucketID1, bucketID2 := common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(2))
+	objectID1, objectID2 := common.BigToHash(big.NewInt(3)), common.BigToHash(big.NewInt(4))
+	bucketColumns := []string{"bucket_id", "status", "removed", "update_at"}
+	objectColumns := []string{"object_id", "status", "removed", "update_at"}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `buckets` WHERE id >= (SELECT FLOOR(MAX(id) * ?) FROM buckets) ORDER BY id LIMIT 2")).
+		WillReturnRows(sqlmock.NewRows(bucketColumns).
+			AddRow(bucketID1, "BUCKET_STATUS_CREATED", false, 10).
+			AddRow(bucketID2, "BUCKET_STATUS_CREATED", false, 10))
+	mock.ExpectQuery(regexp.QuoteMeta("ORDER BY id LIMIT 2")).
+		WillReturnRows(sqlmock.NewRows(objectColumns).
+			AddRow(objectID1, "OBJECT_STATUS_SEALED", false, 10).
+			AddRow(objectID2, "OBJECT_STATUS_CREATED", false, 10))
+	chain.EXPECT().QueryBucketInfoById(gomock.Any(), uint64(1)).
+		Return(&storagetypes.BucketInfo{BucketStatus: storagetypes.BUCKET_STATUS_CREATED}, nil)
+	// the bucket is deleted on chain but not in the db
+	chain.EXPECT().QueryBucketInfoById(gomock.Any(), uint64(2)).Return(nil, storagetypes.ErrNoSuchBucket)
+	chain.EXPECT().QueryObjectInfoByID(gomock.Any(), "3").
+		Return(&storagetypes.ObjectInfo{ObjectStatus: storagetypes.OBJECT_STATUS_SEALED}, nil)
+	// the object is sealed after it's sampled, so it's not compared
+	chain.EXPECT().QueryObjectInfoByID(gomock.Any(), "4").
+		Return(&storagetypes.ObjectInfo{ObjectStatus: storagetypes.OBJECT_STATUS_SEALED}, nil)
+	expectEpoch(mock, 200)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `buckets` WHERE bucket_id IN")).
+		WillReturnRows(sqlmock.NewRows(bucketColumns).
+			AddRow(bucketID1, "BUCKET_STATUS_CREATED", false, 10).
+			AddRow(bucketID2, "BUCKET_STATUS_CREATED", false, 10))
+	mock.ExpectQuery(regexp.QuoteMeta("WHERE object_id IN")).
+		WillReturnRows(sqlmock.NewRows(objectColumns).
+			AddRow(objectID1, "OBJECT_STATUS_SEALED", false, 10).
+			AddRow(objectID2, "OBJECT_STATUS_SEALED", false, 150))
+
+	require.NoError(t, monitor.checkDrift(context.Background()))
+	assert.Equal(t, []string{DataDriftSLO}, monitor.slo.Breached())
+	assert.False(t, prober.IsReady())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMonitor_CheckDriftBehindChain(t *testing.T) {
+	monitor, mock, chain, _ := setupMonitor(t, 200)
+	monitor.config.CatchUpTimeout = 1
+	bucketID := common.BigToHash(big.NewInt(1))
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM `buckets`")).
+		WillReturnRows(sqlmock.NewRows([]string{"bucket_id", "status", "removed", "update_at"}).
+			AddRow(bucketID, "BUCKET_STATUS_CREATED", true, 10))
+	mock.ExpectQuery(regexp.QuoteMeta("ORDER BY id LIMIT 2")).WillReturnRows(sqlmock.NewRows([]string{"object_id"}))
+	chain.EXPECT().QueryBucketInfoById(gomock.Any(), uint64(1)).Return(nil, errors.New("timeout"))
+	expectEpoch(mock, 100)
+
+	require.NoError(t, monitor.checkDrift(context.Background()))
+	assert.Empty(t, monitor.slo.Breached())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,245 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/forbole/juno/v4/log"
+
+	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/database"
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+	"github.com/bnb-chain/greenfield-storage-provider/util"
+)
+
+const (
+	// DefaultMaxAttempts defines the default number of the deliveries of a notification before it's dead lettered
+	DefaultMaxAttempts = 10
+	// DefaultRetryBackoff defines the default delay before the first retry, it's doubled after every retry
+	DefaultRetryBackoff = 10 * time.Second
+	// DefaultMaxRetryBackoff defines the default max delay between two retries
+	DefaultMaxRetryBackoff = time.Hour
+	// DefaultDeliveryTimeout defines the default timeout of posting a notification to the webhook
+	DefaultDeliveryTimeout = 10 * time.Second
+	// DefaultPollInterval defines the default interval of polling the queued notifications
+	DefaultPollInterval = 2 * time.Second
+	// DefaultBatchSize defines the default number of the notifications delivered in a poll
+	DefaultBatchSize = 100
+	// MaxLastErrorLength defines the max length of the error recorded for a failed delivery
+	MaxLastErrorLength = 1024
+
+	// SignatureHeader defines the header of the hex encoded HMAC-SHA256 signature of "<timestamp>.<payload>",
+	// keyed by the secret of the rule
+	SignatureHeader = "X-Gnfd-Signature"
+	// SignatureTimestampHeader defines the header of the unix time when the notification is signed
+	SignatureTimestampHeader = "X-Gnfd-Timestamp"
+	// NotificationIDHeader defines the header of the notification id
+	NotificationIDHeader = "X-Gnfd-Notification-Id"
+	// EventTypeHeader defines the header of the event type
+	EventTypeHeader = "X-Gnfd-Event-Type"
+	// SignaturePrefix defines the prefix of the signature header value
+	SignaturePrefix = "sha256="
+)
+
+// ErrWebhookRedirect is returned if the webhook redirects the notification, the redirect is not followed
+var ErrWebhookRedirect = errors.New("webhook redirect is not allowed")
+
+// DispatcherConfig defines the delivery policy of the notifications
+type DispatcherConfig struct {
+	MaxAttempts     uint32
+	RetryBackoff    time.Duration
+	MaxRetryBackoff time.Duration
+	DeliveryTimeout time.Duration
+	PollInterval    time.Duration
+	BatchSize       int
+}
+
+// Dispatcher delivers the queued notifications to the webhooks. A notification is removed from the queue after the
+// webhook responds 2xx, otherwise it's retried with exponential backoff and moved to the dead letters after the max
+// attempts.
+type Dispatcher struct {
+	db     *database.DB
+	config DispatcherConfig
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewDispatcher returns a Dispatcher instance, the zero fields of the config are set to the defaults
+func NewDispatcher(db *database.DB, cfg DispatcherConfig) *Dispatcher {
+	if cfg.MaxAttempts == 0 {
+		cfg.MaxAttempts = DefaultMaxAttempts
+	}
+	if cfg.RetryBackoff == 0 {
+		cfg.RetryBackoff = DefaultRetryBackoff
+	}
+	if cfg.MaxRetryBackoff == 0 {
+		cfg.MaxRetryBackoff = DefaultMaxRetryBackoff
+	}
+	if cfg.DeliveryTimeout == 0 {
+		cfg.DeliveryTimeout = DefaultDeliveryTimeout
+	}
+	if cfg.PollInterval == 0 {
+		cfg.PollInterval = DefaultPollInterval
+	}
+	if cfg.BatchSize == 0 {
+		cfg.BatchSize = DefaultBatchSize
+	}
+	return &Dispatcher{
+		db:     db,
+		config: cfg,
+		client: newWebhookClient(cfg.DeliveryTimeout),
+		now:    time.Now,
+	}
+}
+
+// newWebhookClient returns the http client which only connects to the public addresses, the webhook urls are set
+// by the bucket owners, so they must not reach the services in the SP network. The address is checked when the
+// connection is dialed, and the proxy and the redirects are disabled, so the check can't be bypassed.
+func newWebhookClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout, Control: util.PublicIPControl}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return ErrWebhookRedirect
+		},
+	}
+}
+
+// Run delivers the queued notifications until the context is done
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.config.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// keep delivering while the queue is full of due notifications
+			for {
+				delivered, err := d.dispatch(ctx)
+				if err != nil {
+					log.Errorw("failed to dispatch notifications", "error", err)
+					break
+				}
+				if delivered < d.config.BatchSize {
+					break
+				}
+			}
+		}
+	}
+}
+
+// dispatch delivers a batch of the due notifications in parallel, so a slow webhook doesn't hold up the others,
+// and returns the number of them.
+func (d *Dispatcher) dispatch(ctx context.Context) (int, error) {
+	notifications, err := d.db.ListDueNotifications(ctx, d.now().Unix(), d.config.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	errs := make([]error, len(notifications))
+	wg := &sync.WaitGroup{}
+	for idx, notification := range notifications {
+		wg.Add(1)
+		go func(idx int, notification *bsdb.Notification) {
+			defer wg.Done()
+			errs[idx] = d.handle(ctx, notification)
+		}(idx, notification)
+	}
+	wg.Wait()
+	for _, err = range errs {
+		if err != nil {
+			return 0, err
+		}
+	}
+	return len(notifications), nil
+}
+
+// handle delivers the notification and updates the queue by the result
+func (d *Dispatcher) handle(ctx context.Context, notification *bsdb.Notification) error {
+	deliverErr := d.deliver(ctx, notification)
+	if deliverErr == nil {
+		log.Debugw("succeed to deliver notification", "id", notification.NotificationID, "rule", notification.RuleID)
+		return d.db.DeleteNotification(ctx, notification.ID)
+	}
+
+	notification.Attempts++
+	notification.LastError = deliverErr.Error()
+	if len(notification.LastError) > MaxLastErrorLength {
+		notification.LastError = notification.LastError[:MaxLastErrorLength]
+	}
+	if notification.Attempts >= d.config.MaxAttempts {
+		log.Errorw("failed to deliver notification, move it to dead letters", "id", notification.NotificationID,
+			"bucket", notification.BucketName, "rule", notification.RuleID, "attempts", notification.Attempts,
+			"error", deliverErr)
+		return d.db.MoveNotificationToDeadLetter(ctx, notification, d.now().Unix())
+	}
+	notification.NextRetryTime = d.now().Add(d.backoff(notification.Attempts)).Unix()
+	log.Warnw("failed to deliver notification, retry later", "id", notification.NotificationID,
+		"rule", notification.RuleID, "attempts", notification.Attempts, "next_retry_time", notification.NextRetryTime,
+		"error", deliverErr)
+	return d.db.UpdateNotificationRetry(ctx, notification)
+}
+
+// backoff returns the delay before the next retry after the given number of failed deliveries
+func (d *Dispatcher) backoff(attempts uint32) time.Duration {
+	delay := d.config.RetryBackoff
+	for i := uint32(1); i < attempts; i++ {
+		delay *= 2
+		if delay >= d.config.MaxRetryBackoff {
+			return d.config.MaxRetryBackoff
+		}
+	}
+	return delay
+}
+
+// deliver posts the signed notification to the webhook
+func (d *Dispatcher) deliver(ctx context.Context, notification *bsdb.Notification) error {
+	timestamp := strconv.FormatInt(d.now().Unix(), 10)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, notification.WebhookURL,
+		bytes.NewReader([]byte(notification.Payload)))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(NotificationIDHeader, notification.NotificationID)
+	req.Header.Set(EventTypeHeader, notification.EventType)
+	req.Header.Set(SignatureTimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, SignaturePrefix+Sign(notification.Secret, timestamp, []byte(notification.Payload)))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, MaxLastErrorLength))
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("webhook responds %s", resp.Status)
+	}
+	return nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of "<timestamp>.<payload>" keyed by the secret, the webhook verifies
+// the notification by computing it again. The rules only store the hash of the secrets given by the bucket owners,
+// which is the key here, see bsdb.HashNotificationSecret.
+func Sign(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
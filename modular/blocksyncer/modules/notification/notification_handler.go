@@ -0,0 +1,261 @@
+package notification
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/forbole/juno/v4/common"
+	"github.com/forbole/juno/v4/log"
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+var (
+	EventCreateObject               = proto.MessageName(&storagetypes.EventCreateObject{})
+	EventCopyObject                 = proto.MessageName(&storagetypes.EventCopyObject{})
+	EventSealObject                 = proto.MessageName(&storagetypes.EventSealObject{})
+	EventUpdateObjectContentSuccess = proto.MessageName(&storagetypes.EventUpdateObjectContentSuccess{})
+	EventUpdateObjectInfo           = proto.MessageName(&storagetypes.EventUpdateObjectInfo{})
+	EventDeleteObject               = proto.MessageName(&storagetypes.EventDeleteObject{})
+	EventCancelCreateObject         = proto.MessageName(&storagetypes.EventCancelCreateObject{})
+	EventRejectSealObject           = proto.MessageName(&storagetypes.EventRejectSealObject{})
+	EventDiscontinueObject          = proto.MessageName(&storagetypes.EventDiscontinueObject{})
+	EventCreateBucket               = proto.MessageName(&storagetypes.EventCreateBucket{})
+	EventUpdateBucketInfo           = proto.MessageName(&storagetypes.EventUpdateBucketInfo{})
+	EventCompleteMigrationBucket    = proto.MessageName(&storagetypes.EventCompleteMigrationBucket{})
+	EventDeleteBucket               = proto.MessageName(&storagetypes.EventDeleteBucket{})
+)
+
+// NotificationEvents maps the storage events to the event types of the bucket notification
+var NotificationEvents = map[string]string{
+	EventCreateObject:               bsdb.NotificationObjectCreated,
+	EventCopyObject:                 bsdb.NotificationObjectCopied,
+	EventSealObject:                 bsdb.NotificationObjectSealed,
+	EventUpdateObjectContentSuccess: bsdb.NotificationObjectContentUpdate,
+	EventUpdateObjectInfo:           bsdb.NotificationObjectInfoUpdate,
+	EventDeleteObject:               bsdb.NotificationObjectDeleted,
+	EventCancelCreateObject:         bsdb.NotificationObjectCreateCancel,
+	EventRejectSealObject:           bsdb.NotificationObjectSealRejected,
+	EventDiscontinueObject:          bsdb.NotificationObjectDiscontinued,
+	EventCreateBucket:               bsdb.NotificationBucketCreated,
+	EventUpdateBucketInfo:           bsdb.NotificationBucketInfoUpdate,
+	EventCompleteMigrationBucket:    bsdb.NotificationBucketMigrated,
+	EventDeleteBucket:               bsdb.NotificationBucketDeleted,
+}
+
+// Event defines the json payload which is posted to the webhooks
+type Event struct {
+	// NotificationID defines the unique identification of the notification, the webhook may receive a notification
+	// more than once and can use it to drop the duplicated ones
+	NotificationID string `json:"notificationId"`
+	// RuleID defines the rule which matches the event
+	RuleID string `json:"ruleId"`
+	// EventType defines the event type of the bucket notification
+	EventType string `json:"eventType"`
+	// EventTime defines the time of the block which emits the event
+	EventTime time.Time `json:"eventTime"`
+	// Height defines the block number which emits the event
+	Height int64 `json:"height"`
+	// TxHash defines the hash of the transaction which emits the event
+	TxHash string `json:"txHash"`
+	// Operator defines the account which emits the event, it's empty for the events emitted by the chain
+	Operator string `json:"operator,omitempty"`
+	// Bucket defines the bucket of the event
+	Bucket EventBucket `json:"bucket"`
+	// Object defines the object of the event, it's nil for the bucket events
+	Object *EventObject `json:"object,omitempty"`
+}
+
+// EventBucket defines the bucket of the notification event
+type EventBucket struct {
+	Name string `json:"name"`
+	ID   string `json:"id"`
+}
+
+// EventObject defines the object of the notification event, the name is empty for the discontinued objects
+type EventObject struct {
+	Name string `json:"name,omitempty"`
+	ID   string `json:"id"`
+}
+
+// HandleEvent implements modules.EventModule
+func (m *Module) HandleEvent(ctx context.Context, block *tmctypes.ResultBlock, txHash common.Hash, event sdk.Event) error {
+	return nil
+}
+
+// ExtractEventStatements returns the statement which queues the notifications of the rules matching the event, the
+// notifications are committed and rolled back together with the block.
+func (m *Module) ExtractEventStatements(ctx context.Context, block *tmctypes.ResultBlock, txHash common.Hash, event sdk.Event) (map[string][]interface{}, error) {
+	eventType, ok := NotificationEvents[event.Type]
+	if !ok || m.disabled.Load() {
+		return nil, nil
+	}
+
+	typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+	if err != nil {
+		log.Errorw("parse typed events error", "module", m.Name(), "event", event, "err", err)
+		return nil, err
+	}
+	notificationEvent, bucketID, err := toEvent(typedEvent)
+	if err != nil {
+		log.Errorw("type assert error", "type", event.Type, "event", typedEvent)
+		return nil, err
+	}
+	notificationEvent.EventType = eventType
+	notificationEvent.EventTime = block.Block.Time.UTC()
+	notificationEvent.Height = block.Block.Height
+	notificationEvent.TxHash = txHash.String()
+
+	rules, err := m.db.ListNotificationRules(ctx, notificationEvent.Bucket.Name)
+	if err != nil {
+		log.Errorw("failed to list notification rules", "bucket", notificationEvent.Bucket.Name, "error", err)
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	if bucketID == (common.Hash{}) {
+		bucket, err := m.db.GetBucketByBucketName(ctx, notificationEvent.Bucket.Name)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		if err != nil {
+			log.Errorw("failed to get bucket", "bucket", notificationEvent.Bucket.Name, "error", err)
+			return nil, err
+		}
+		bucketID = bucket.BucketID
+	}
+	notificationEvent.Bucket.ID = bucketID.Big().String()
+
+	notifications, err := m.matchRules(rules, bucketID, notificationEvent)
+	if err != nil || len(notifications) == 0 {
+		return nil, err
+	}
+	k, v := m.db.CreateNotificationsToSQL(ctx, notifications)
+	return map[string][]interface{}{
+		k: v,
+	}, nil
+}
+
+// matchRules returns the notifications of the rules which match the event. The rules put before the block or for
+// a deleted bucket with the same name never match the event.
+func (m *Module) matchRules(rules []*bsdb.NotificationRule, bucketID common.Hash, event *Event) ([]*bsdb.Notification, error) {
+	var objectName string
+	if event.Object != nil {
+		objectName = event.Object.Name
+	}
+	notifications := make([]*bsdb.Notification, 0)
+	for _, rule := range rules {
+		if rule.BucketID != bucketID || rule.CreateTime > event.EventTime.Unix() ||
+			!rule.Match(event.EventType, objectName) {
+			continue
+		}
+		ruleEvent := *event
+		ruleEvent.RuleID = rule.RuleID
+		ruleEvent.NotificationID = makeNotificationID(&ruleEvent)
+		payload, err := json.Marshal(&ruleEvent)
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, &bsdb.Notification{
+			NotificationID: ruleEvent.NotificationID,
+			BucketName:     event.Bucket.Name,
+			RuleID:         rule.RuleID,
+			EventType:      event.EventType,
+			Height:         event.Height,
+			WebhookURL:     rule.WebhookURL,
+			Secret:         rule.Secret,
+			Payload:        string(payload),
+			NextRetryTime:  event.EventTime.Unix(),
+			CreateTime:     event.EventTime.Unix(),
+		})
+	}
+	return notifications, nil
+}
+
+// makeNotificationID makes the id from the event and the rule, so it's the same when the block is indexed again
+func makeNotificationID(event *Event) string {
+	var objectID string
+	if event.Object != nil {
+		objectID = event.Object.ID
+	}
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%d/%s/%s/%s/%s/%s", event.Height, event.TxHash, event.EventType,
+		event.Bucket.ID, objectID, event.RuleID)))
+	return hex.EncodeToString(hash[:])
+}
+
+// toEvent extracts the bucket and object of the storage event, the bucket id is empty if the event doesn't have it
+func toEvent(typedEvent proto.Message) (*Event, common.Hash, error) {
+	var (
+		event    = &Event{}
+		bucketID common.Hash
+	)
+	switch e := typedEvent.(type) {
+	case *storagetypes.EventCreateObject:
+		event.Operator = e.Creator
+		event.Bucket = EventBucket{Name: e.BucketName}
+		bucketID = common.BigToHash(e.BucketId.BigInt())
+		event.Object = &EventObject{Name: e.ObjectName, ID: e.ObjectId.String()}
+	case *storagetypes.EventCopyObject:
+		event.Operator = e.Operator
+		event.Bucket = EventBucket{Name: e.DstBucketName}
+		event.Object = &EventObject{Name: e.DstObjectName, ID: e.DstObjectId.String()}
+	case *storagetypes.EventSealObject:
+		event.Operator = e.Operator
+		event.Bucket = EventBucket{Name: e.BucketName}
+		event.Object = &EventObject{Name: e.ObjectName, ID: e.ObjectId.String()}
+	case *storagetypes.EventUpdateObjectContentSuccess:
+		event.Operator = e.Operator
+		event.Bucket = EventBucket{Name: e.BucketName}
+		event.Object = &EventObject{Name: e.ObjectName, ID: e.ObjectId.String()}
+	case *storagetypes.EventUpdateObjectInfo:
+		event.Operator = e.Operator
+		event.Bucket = EventBucket{Name: e.BucketName}
+		event.Object = &EventObject{Name: e.ObjectName, ID: e.ObjectId.String()}
+	case *storagetypes.EventDeleteObject:
+		event.Operator = e.Operator
+		event.Bucket = EventBucket{Name: e.BucketName}
+		event.Object = &EventObject{Name: e.ObjectName, ID: e.ObjectId.String()}
+	case *storagetypes.EventCancelCreateObject:
+		event.Operator = e.Operator
+		event.Bucket = EventBucket{Name: e.BucketName}
+		event.Object = &EventObject{Name: e.ObjectName, ID: e.ObjectId.String()}
+	case *storagetypes.EventRejectSealObject:
+		event.Operator = e.Operator
+		event.Bucket = EventBucket{Name: e.BucketName}
+		event.Object = &EventObject{Name: e.ObjectName, ID: e.ObjectId.String()}
+	case *storagetypes.EventDiscontinueObject:
+		event.Bucket = EventBucket{Name: e.BucketName}
+		event.Object = &EventObject{ID: e.ObjectId.String()}
+	case *storagetypes.EventCreateBucket:
+		event.Operator = e.Owner
+		event.Bucket = EventBucket{Name: e.BucketName}
+		bucketID = common.BigToHash(e.BucketId.BigInt())
+	case *storagetypes.EventUpdateBucketInfo:
+		event.Operator = e.Operator
+		event.Bucket = EventBucket{Name: e.BucketName}
+		bucketID = common.BigToHash(e.BucketId.BigInt())
+	case *storagetypes.EventCompleteMigrationBucket:
+		event.Operator = e.Operator
+		event.Bucket = EventBucket{Name: e.BucketName}
+		bucketID = common.BigToHash(e.BucketId.BigInt())
+	case *storagetypes.EventDeleteBucket:
+		event.Operator = e.Operator
+		event.Bucket = EventBucket{Name: e.BucketName}
+		bucketID = common.BigToHash(e.BucketId.BigInt())
+	default:
+		return nil, bucketID, fmt.Errorf("unexpected notification event %s", proto.MessageName(typedEvent))
+	}
+	return event, bucketID, nil
+}
@@ -0,0 +1,169 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/forbole/juno/v4/common"
+	junodatabase "github.com/forbole/juno/v4/database"
+	"github.com/forbole/juno/v4/database/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gormmysql "gorm.io/driver/mysql"
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/database"
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+	"github.com/bnb-chain/greenfield-storage-provider/util"
+)
+
+func setupDB(t *testing.T) (*database.DB, sqlmock.Sqlmock) {
+	t.Helper()
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	db, err := gorm.Open(gormmysql.New(gormmysql.Config{Conn: mockDB, SkipInitializeWithVersion: true}),
+		&gorm.Config{SkipDefaultTransaction: true})
+	require.NoError(t, err)
+	return &database.DB{Database: &mysql.Database{Impl: junodatabase.Impl{Db: db}}}, mock
+}
+
+func setupDispatcher(t *testing.T, handler http.HandlerFunc) (*Dispatcher, sqlmock.Sqlmock, *bsdb.Notification) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	db, mock := setupDB(t)
+	dispatcher := NewDispatcher(db, DispatcherConfig{MaxAttempts: 3})
+	// the test server listens on the loopback address, which is refused by the webhook client
+	dispatcher.client = server.Client()
+	dispatcher.now = func() time.Time { return time.Unix(1000, 0) }
+	notification := &bsdb.Notification{
+		ID:             1,
+		NotificationID: "id",
+		BucketName:     "bucket",
+		RuleID:         "rule",
+		EventType:      bsdb.NotificationObjectSealed,
+		WebhookURL:     server.URL,
+		Secret:         "secret",
+		Payload:        `{"notificationId":"id"}`,
+	}
+	return dispatcher, mock, notification
+}
+
+func TestMatchRules(t *testing.T) {
+	bucketID := common.BigToHash(big.NewInt(1))
+	event := &Event{
+		EventType: bsdb.NotificationObjectSealed,
+		EventTime: time.Unix(100, 0),
+		Height:    10,
+		TxHash:    common.HexToHash("0x1").String(),
+		Bucket:    EventBucket{Name: "bucket", ID: "1"},
+		Object:    &EventObject{Name: "images/a.jpg", ID: "2"},
+	}
+	rules := []*bsdb.NotificationRule{
+		{RuleID: "match", BucketID: bucketID, Events: "ObjectCreated:*", Prefix: "images/", WebhookURL: "url", CreateTime: 100},
+		{RuleID: "other-event", BucketID: bucketID, Events: bsdb.NotificationObjectDeleted},
+		{RuleID: "other-prefix", BucketID: bucketID, Events: bsdb.NotificationObjectSealed, Prefix: "docs/"},
+		{RuleID: "recreated-bucket", BucketID: common.BigToHash(big.NewInt(2)), Events: bsdb.NotificationObjectSealed},
+		{RuleID: "later-rule", BucketID: bucketID, Events: bsdb.NotificationObjectSealed, CreateTime: 101},
+	}
+
+	notifications, err := (&Module{}).matchRules(rules, bucketID, event)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(notifications))
+	assert.Equal(t, "match", notifications[0].RuleID)
+	assert.Equal(t, "url", notifications[0].WebhookURL)
+	assert.Equal(t, int64(100), notifications[0].NextRetryTime)
+
+	var payload Event
+	require.NoError(t, json.Unmarshal([]byte(notifications[0].Payload), &payload))
+	assert.Equal(t, "match", payload.RuleID)
+	assert.Equal(t, notifications[0].NotificationID, payload.NotificationID)
+	assert.Equal(t, "images/a.jpg", payload.Object.Name)
+
+	// the id is the same when the block is indexed again
+	again, err := (&Module{}).matchRules(rules, bucketID, event)
+	require.NoError(t, err)
+	assert.Equal(t, notifications[0].NotificationID, again[0].NotificationID)
+}
+
+func TestDispatcher_Deliver(t *testing.T) {
+	dispatcher, mock, notification := setupDispatcher(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, "id", r.Header.Get(NotificationIDHeader))
+		assert.Equal(t, bsdb.NotificationObjectSealed, r.Header.Get(EventTypeHeader))
+		assert.Equal(t, "1000", r.Header.Get(SignatureTimestampHeader))
+		assert.Equal(t, SignaturePrefix+Sign("secret", "1000", body), r.Header.Get(SignatureHeader))
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `notifications` WHERE id = ?")).WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, dispatcher.handle(context.Background(), notification))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDispatcher_Retry(t *testing.T) {
+	dispatcher, mock, notification := setupDispatcher(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	notification.Attempts = 1
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `notifications` SET `attempts`=?,`last_error`=?,`next_retry_time`=? WHERE id = ?")).
+		WithArgs(2, "webhook responds 500 Internal Server Error", 1000+2*int64(DefaultRetryBackoff/time.Second), 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, dispatcher.handle(context.Background(), notification))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDispatcher_DeadLetter(t *testing.T) {
+	dispatcher, mock, notification := setupDispatcher(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+	notification.Attempts = 2
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `notification_dead_letters`")).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `notifications` WHERE id = ?")).WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	require.NoError(t, dispatcher.handle(context.Background(), notification))
+	assert.Equal(t, uint32(3), notification.Attempts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDispatcher_RefuseNonPublicAddress(t *testing.T) {
+	dispatcher, _, notification := setupDispatcher(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("the webhook on the loopback address must not be called")
+	})
+	dispatcher.client = newWebhookClient(time.Second)
+
+	assert.ErrorIs(t, dispatcher.deliver(context.Background(), notification), util.ErrNonPublicIP)
+}
+
+func TestDispatcher_RefuseRedirect(t *testing.T) {
+	dispatcher, _, notification := setupDispatcher(t, func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "http://169.254.169.254/latest/meta-data", http.StatusFound)
+	})
+	dispatcher.client = newWebhookClient(time.Second)
+	// skip the address check to reach the test server
+	dispatcher.client.Transport = http.DefaultTransport
+
+	assert.ErrorIs(t, dispatcher.deliver(context.Background(), notification), ErrWebhookRedirect)
+}
+
+func TestDispatcher_Backoff(t *testing.T) {
+	dispatcher := NewDispatcher(nil, DispatcherConfig{RetryBackoff: time.Second, MaxRetryBackoff: 5 * time.Second})
+	assert.Equal(t, time.Second, dispatcher.backoff(1))
+	assert.Equal(t, 2*time.Second, dispatcher.backoff(2))
+	assert.Equal(t, 4*time.Second, dispatcher.backoff(3))
+	assert.Equal(t, 5*time.Second, dispatcher.backoff(4))
+}
@@ -0,0 +1,161 @@
+package downloader
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync/atomic"
+
+	"github.com/bnb-chain/greenfield-storage-provider/core/task"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/store/piecestore/storage"
+)
+
+// HandleDownloadObjectTaskStream returns the reader of the object data in the range of the download task.
+// The segment pieces are read from piece store one by one when the caller reads, so the memory is bounded
+// by the caller's buffer instead of the range size. The first segment piece is opened before returning,
+// so the piece store errors such as no such key are returned immediately.
+func (d *DownloadModular) HandleDownloadObjectTaskStream(ctx context.Context, downloadObjectTask task.DownloadObjectTask) (
+	io.ReadCloser, error) {
+	var err error
+	defer func() {
+		if err != nil {
+			downloadObjectTask.SetError(err)
+			atomic.AddInt64(&d.downloading, -1)
+		}
+		log.CtxDebugw(ctx, downloadObjectTask.Info())
+	}()
+	if atomic.AddInt64(&d.downloading, 1) >= atomic.LoadInt64(&d.downloadParallel) {
+		log.CtxErrorw(ctx, "failed to download object due to max download concurrent",
+			"current_download_concurrent", d.downloading, "max_download_concurrent", d.downloadParallel,
+			"task_info", downloadObjectTask.Info())
+		err = ErrExceedRequest
+		return nil, err
+	}
+
+	pieceInfos, err := SplitToSegmentPieceInfos(downloadObjectTask, d.baseApp.PieceOp())
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to generate piece info to download", "error", err)
+		return nil, err
+	}
+	reader := &segmentPieceReader{
+		ctx:        ctx,
+		downloader: d,
+		task:       downloadObjectTask,
+		pieceInfos: pieceInfos,
+	}
+	if err = reader.next(); err != nil {
+		return nil, err
+	}
+	return reader, nil
+}
+
+// openSegmentPiece returns the reader of the segment piece, the piece in the cache is returned directly, and
+// the missing segment is reconstructed from the secondary SPs if read repair is enabled. The streamed piece
+// is not added to the cache, because it would be buffered in memory.
+func (d *DownloadModular) openSegmentPiece(ctx context.Context, downloadObjectTask task.DownloadObjectTask,
+	pInfo *SegmentPieceInfo) (io.ReadCloser, error) {
+	key := cacheKey(pInfo.SegmentPieceKey, int64(pInfo.Offset), int64(pInfo.Length))
+	if pieceData, has := d.pieceCache.Get(key); has {
+		return io.NopCloser(bytes.NewReader(pieceData)), nil
+	}
+	reader, err := d.baseApp.PieceStore().GetPieceReader(ctx, pInfo.SegmentPieceKey,
+		int64(pInfo.Offset), int64(pInfo.Length))
+	if err != nil && d.readRepair && storage.IsErrNoSuchObject(err) {
+		segment, repairErr := d.repairSegment(ctx, downloadObjectTask, pInfo.SegmentIdx)
+		if repairErr == nil {
+			piece := segment[pInfo.Offset : pInfo.Offset+pInfo.Length]
+			d.pieceCache.Add(key, piece)
+			return io.NopCloser(bytes.NewReader(piece)), nil
+		}
+		log.CtxErrorw(ctx, "failed to repair segment from secondary SPs", "task_info", downloadObjectTask.Info(),
+			"piece_info", pInfo, "error", repairErr)
+	}
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to get piece reader from piece store", "task_info", downloadObjectTask.Info(),
+			"piece_info", pInfo, "error", err)
+		return nil, makePieceStoreErr(downloadObjectTask, err)
+	}
+	return reader, nil
+}
+
+// segmentPieceReader reads the segment pieces of the download task in order.
+type segmentPieceReader struct {
+	ctx        context.Context
+	downloader *DownloadModular
+	task       task.DownloadObjectTask
+	pieceInfos []*SegmentPieceInfo
+	current    io.ReadCloser
+	// remaining is the unread length of the current segment piece
+	remaining uint64
+	closed    bool
+}
+
+// next closes the current segment piece and opens the next one.
+func (r *segmentPieceReader) next() error {
+	if r.current != nil {
+		_ = r.current.Close()
+		r.current = nil
+	}
+	if len(r.pieceInfos) == 0 {
+		return io.EOF
+	}
+	pInfo := r.pieceInfos[0]
+	current, err := r.downloader.openSegmentPiece(r.ctx, r.task, pInfo)
+	if err != nil {
+		return err
+	}
+	r.current, r.remaining, r.pieceInfos = current, pInfo.Length, r.pieceInfos[1:]
+	return nil
+}
+
+func (r *segmentPieceReader) Read(p []byte) (int, error) {
+	if r.closed {
+		return 0, io.ErrClosedPipe
+	}
+	for {
+		if r.current == nil {
+			return 0, io.EOF
+		}
+		if r.remaining == 0 {
+			if err := r.next(); err != nil {
+				if err != io.EOF {
+					r.task.SetError(err)
+				}
+				return 0, err
+			}
+			continue
+		}
+		if uint64(len(p)) > r.remaining {
+			p = p[:r.remaining]
+		}
+		n, err := r.current.Read(p)
+		r.remaining -= uint64(n)
+		if err == io.EOF {
+			if r.remaining != 0 {
+				err = makePieceStoreErr(r.task, io.ErrUnexpectedEOF)
+				r.task.SetError(err)
+				return n, err
+			}
+			err = nil
+		} else if err != nil {
+			err = makePieceStoreErr(r.task, err)
+			r.task.SetError(err)
+		}
+		if n > 0 || err != nil {
+			return n, err
+		}
+	}
+}
+
+func (r *segmentPieceReader) Close() error {
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+	atomic.AddInt64(&r.downloader.downloading, -1)
+	if r.current != nil {
+		return r.current.Close()
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package downloader
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"testing/iotest"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/bnb-chain/greenfield-common/go/hash"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfsppiececache"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfsppieceop"
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsperrors"
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
+	"github.com/bnb-chain/greenfield-storage-provider/core/piecestore"
+)
+
+func setupDownloadStreamTest(t *testing.T, payload []byte, segmentSize uint64, low, high int64) (
+	*DownloadModular, *piecestore.MockPieceStore, *gfsptask.GfSpDownloadObjectTask) {
+	d := setup(t)
+	d.downloadParallel = 100
+	d.pieceCache, _ = gfsppiececache.NewGfSpPieceCache(1024*1024, "", 0)
+	d.baseApp.SetPieceOp(&gfsppieceop.GfSpPieceOp{})
+	mockPieceStore := piecestore.NewMockPieceStore(gomock.NewController(t))
+	d.baseApp.SetPieceStore(mockPieceStore)
+	downloadTask := &gfsptask.GfSpDownloadObjectTask{
+		Task:       &gfsptask.GfSpTask{},
+		BucketInfo: &storagetypes.BucketInfo{Id: sdkmath.NewUint(100), BucketName: "mock_bucket"},
+		ObjectInfo: &storagetypes.ObjectInfo{
+			Id:           sdkmath.NewUint(100),
+			ObjectStatus: storagetypes.OBJECT_STATUS_SEALED,
+			PayloadSize:  uint64(len(payload)),
+		},
+		StorageParams: &storagetypes.Params{
+			VersionedParams: storagetypes.VersionedParams{MaxSegmentSize: segmentSize},
+		},
+		Low:  low,
+		High: high,
+	}
+	return d, mockPieceStore, downloadTask
+}
+
+func TestHandleDownloadObjectTaskStream(t *testing.T) {
+	payload := []byte("0123456789abcdefghij")
+	d, mockPieceStore, downloadTask := setupDownloadStreamTest(t, payload, 8, 3, 17)
+	op := &gfsppieceop.GfSpPieceOp{}
+	mockPieceStore.EXPECT().GetPieceReader(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, key string, offset, limit int64) (io.ReadCloser, error) {
+			segmentIdx, err := op.ParseSegmentIdx(key)
+			assert.Nil(t, err)
+			start := int64(segmentIdx)*8 + offset
+			return io.NopCloser(bytes.NewReader(payload[start : start+limit])), nil
+		}).Times(3)
+
+	reader, err := d.HandleDownloadObjectTaskStream(context.TODO(), downloadTask)
+	assert.Nil(t, err)
+	// read by one byte to cross the segment boundaries
+	data, err := io.ReadAll(iotest.OneByteReader(reader))
+	assert.Nil(t, err)
+	assert.Equal(t, payload[3:18], data)
+	assert.Equal(t, int64(1), d.downloading)
+	assert.Nil(t, reader.Close())
+	assert.Equal(t, int64(0), d.downloading)
+	_, err = reader.Read(make([]byte, 1))
+	assert.Equal(t, io.ErrClosedPipe, err)
+}
+
+func TestHandleDownloadObjectTaskStream_Failure(t *testing.T) {
+	payload := []byte("0123456789abcdefghij")
+	cases := []struct {
+		name        string
+		fn          func(*piecestore.MockPieceStore)
+		openErr     bool
+		innerCode   int32
+		expectedLen int
+	}{
+		{
+			name: "no such key",
+			fn: func(m *piecestore.MockPieceStore) {
+				m.EXPECT().GetPieceReader(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(
+					nil, errors.New("NoSuchKey: the specified key does not exist")).Times(1)
+			},
+			openErr:   true,
+			innerCode: 85102,
+		},
+		{
+			name: "truncated segment",
+			fn: func(m *piecestore.MockPieceStore) {
+				m.EXPECT().GetPieceReader(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(
+					io.NopCloser(bytes.NewReader(payload[:4])), nil).Times(1)
+			},
+			innerCode:   85101,
+			expectedLen: 4,
+		},
+		{
+			name: "failed to open next segment",
+			fn: func(m *piecestore.MockPieceStore) {
+				gomock.InOrder(
+					m.EXPECT().GetPieceReader(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(
+						io.NopCloser(bytes.NewReader(payload[:8])), nil),
+					m.EXPECT().GetPieceReader(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(
+						nil, errors.New("mock error")),
+				)
+			},
+			innerCode:   85101,
+			expectedLen: 8,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			d, mockPieceStore, downloadTask := setupDownloadStreamTest(t, payload, 8, 0, 19)
+			tt.fn(mockPieceStore)
+			reader, err := d.HandleDownloadObjectTaskStream(context.TODO(), downloadTask)
+			if tt.openErr {
+				assert.Nil(t, reader)
+				assert.Equal(t, tt.innerCode, gfsperrors.MakeGfSpError(err).GetInnerCode())
+				assert.Equal(t, int64(0), d.downloading)
+				return
+			}
+			assert.Nil(t, err)
+			data, err := io.ReadAll(reader)
+			assert.Equal(t, tt.expectedLen, len(data))
+			assert.Equal(t, tt.innerCode, gfsperrors.MakeGfSpError(err).GetInnerCode())
+			assert.NotNil(t, downloadTask.Error())
+			assert.Nil(t, reader.Close())
+			assert.Equal(t, int64(0), d.downloading)
+		})
+	}
+}
+
+func TestHandleDownloadObjectTaskStream_ExceedRequest(t *testing.T) {
+	d, _, downloadTask := setupDownloadStreamTest(t, []byte("0123456789"), 8, 0, 9)
+	d.downloadParallel = 0
+	reader, err := d.HandleDownloadObjectTaskStream(context.TODO(), downloadTask)
+	assert.Nil(t, reader)
+	assert.Equal(t, ErrExceedRequest, err)
+	assert.Equal(t, int64(0), d.downloading)
+}
+
+func TestHandleDownloadObjectTaskStream_ReadRepair(t *testing.T) {
+	segment := bytes.Repeat([]byte("0123456789"), 10)
+	d, downloadTask, reported := setupReadRepairTest(t, segment, hash.GenerateChecksum(segment), 2)
+	reader, err := d.HandleDownloadObjectTaskStream(context.TODO(), downloadTask)
+	assert.Nil(t, err)
+	data, err := io.ReadAll(reader)
+	assert.Nil(t, err)
+	assert.Nil(t, reader.Close())
+	assert.Equal(t, segment[10:20], data)
+	<-reported
+}
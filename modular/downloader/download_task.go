@@ -4,11 +4,9 @@ import (
 	"context"
 	"errors"
 	"net/http"
-	"strings"
 	"sync/atomic"
 	"time"
 
-	"github.com/aws/aws-sdk-go/service/s3"
 	"gorm.io/gorm"
 
 	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsperrors"
@@ -19,6 +17,7 @@ import (
 	"github.com/bnb-chain/greenfield-storage-provider/core/task"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
+	"github.com/bnb-chain/greenfield-storage-provider/store/piecestore/storage"
 	"github.com/bnb-chain/greenfield-storage-provider/store/sqldb"
 	"github.com/bnb-chain/greenfield-storage-provider/util"
 	payment_types "github.com/bnb-chain/greenfield/x/payment/types"
@@ -174,28 +173,43 @@ func (d *DownloadModular) HandleDownloadObjectTask(ctx context.Context, download
 		key := cacheKey(pInfo.SegmentPieceKey, int64(pInfo.Offset), int64(pInfo.Length))
 		pieceData, has := d.pieceCache.Get(key)
 		if has {
-			data = append(data, pieceData.([]byte)...)
+			data = append(data, pieceData...)
 			continue
 		}
 		piece, getPieceErr := d.baseApp.PieceStore().GetPiece(ctx, pInfo.SegmentPieceKey,
 			int64(pInfo.Offset), int64(pInfo.Length))
-		if getPieceErr != nil {
-			log.CtxErrorw(ctx, "failed to get piece data from piece store", "task_info", downloadObjectTask.Info(), "piece_info", pInfo, "error", getPieceErr)
-			pieceStoreErrDetail := "failed to get piece data from piece store, task_info: " + downloadObjectTask.Info() + ", error: " + err.Error()
-			if isErrNoSuchKey(err) {
-				return nil, ErrPieceStoreNoSuchKeyWithDetail(pieceStoreErrDetail)
+		if getPieceErr != nil && d.readRepair && storage.IsErrNoSuchObject(getPieceErr) {
+			segment, repairErr := d.repairSegment(ctx, downloadObjectTask, pInfo.SegmentIdx)
+			if repairErr == nil {
+				piece, getPieceErr = segment[pInfo.Offset:pInfo.Offset+pInfo.Length], nil
 			} else {
-				return nil, ErrPieceStoreWithDetail(pieceStoreErrDetail)
+				log.CtxErrorw(ctx, "failed to repair segment from secondary SPs", "task_info", downloadObjectTask.Info(),
+					"piece_info", pInfo, "error", repairErr)
 			}
 		}
+		if getPieceErr != nil {
+			log.CtxErrorw(ctx, "failed to get piece data from piece store", "task_info", downloadObjectTask.Info(), "piece_info", pInfo, "error", getPieceErr)
+			err = makePieceStoreErr(downloadObjectTask, getPieceErr)
+			return nil, err
+		}
 		d.pieceCache.Add(key, piece)
 		data = append(data, piece...)
 	}
 	return data, nil
 }
 
+// makePieceStoreErr converts the piece store error into the GfSpError returned to the client.
+func makePieceStoreErr(downloadObjectTask task.DownloadObjectTask, err error) error {
+	pieceStoreErrDetail := "failed to get piece data from piece store, task_info: " + downloadObjectTask.Info() + ", error: " + err.Error()
+	if storage.IsErrNoSuchObject(err) {
+		return ErrPieceStoreNoSuchKeyWithDetail(pieceStoreErrDetail)
+	}
+	return ErrPieceStoreWithDetail(pieceStoreErrDetail)
+}
+
 type SegmentPieceInfo struct {
 	SegmentPieceKey string
+	SegmentIdx      uint32
 	Offset          uint64
 	Length          uint64
 }
@@ -234,6 +248,7 @@ func SplitToSegmentPieceInfos(downloadObjectTask task.DownloadObjectTask, op pie
 			lengthInPiece := currentEnd - currentStart + 1
 			pieceInfos = append(pieceInfos, &SegmentPieceInfo{
 				SegmentPieceKey: op.SegmentPieceKey(downloadObjectTask.GetObjectInfo().Id.Uint64(), uint32(segmentPieceIndex), objectVersion),
+				SegmentIdx:      uint32(segmentPieceIndex),
 				Offset:          offsetInPiece,
 				Length:          lengthInPiece,
 			})
@@ -244,6 +259,7 @@ func SplitToSegmentPieceInfos(downloadObjectTask task.DownloadObjectTask, op pie
 			lengthInPiece := currentEnd - currentStart + 1
 			pieceInfos = append(pieceInfos, &SegmentPieceInfo{
 				SegmentPieceKey: op.SegmentPieceKey(downloadObjectTask.GetObjectInfo().Id.Uint64(), uint32(segmentPieceIndex), objectVersion),
+				SegmentIdx:      uint32(segmentPieceIndex),
 				Offset:          offsetInPiece,
 				Length:          lengthInPiece,
 			})
@@ -391,7 +407,7 @@ func (d *DownloadModular) HandleDownloadPieceTask(ctx context.Context, downloadP
 		int64(downloadPieceTask.GetPieceLength()))
 	data, has := d.pieceCache.Get(key)
 	if has {
-		return data.([]byte), nil
+		return data, nil
 	}
 
 	putPieceTime := time.Now()
@@ -400,7 +416,7 @@ func (d *DownloadModular) HandleDownloadPieceTask(ctx context.Context, downloadP
 		metrics.PerfGetObjectTimeHistogram.WithLabelValues("get_object_put_piece_time").Observe(time.Since(putPieceTime).Seconds())
 		log.CtxErrorw(ctx, "failed to get piece data from piece store", "task_info", downloadPieceTask.Info(), "error", err)
 		pieceStoreErrDetail := "failed to get piece data from piece store, task_info: " + downloadPieceTask.Info() + ", error: " + err.Error()
-		if isErrNoSuchKey(err) {
+		if storage.IsErrNoSuchObject(err) {
 			return nil, ErrPieceStoreNoSuchKeyWithDetail(pieceStoreErrDetail)
 		}
 		return nil, ErrPieceStoreWithDetail(pieceStoreErrDetail)
@@ -468,7 +484,7 @@ func (d *DownloadModular) HandleChallengePiece(ctx context.Context, challengePie
 	key := cacheKey(pieceKey, int64(0), int64(-1))
 	piece, has := d.pieceCache.Get(key)
 	if has {
-		return integrity.IntegrityChecksum, integrity.PieceChecksumList, piece.([]byte), nil
+		return integrity.IntegrityChecksum, integrity.PieceChecksumList, piece, nil
 	}
 
 	getPieceTime := time.Now()
@@ -477,7 +493,7 @@ func (d *DownloadModular) HandleChallengePiece(ctx context.Context, challengePie
 	if err != nil {
 		log.CtxErrorw(ctx, "failed to get piece data", "task", challengePieceTask, "error", err)
 		pieceStoreErrDetail := "failed to get piece data, task: " + challengePieceTask.Info() + ", error: " + err.Error()
-		if isErrNoSuchKey(err) {
+		if storage.IsErrNoSuchObject(err) {
 			return nil, nil, nil, ErrPieceStoreNoSuchKeyWithDetail(pieceStoreErrDetail)
 		} else {
 			return nil, nil, nil, ErrPieceStoreWithDetail(pieceStoreErrDetail)
@@ -493,8 +509,3 @@ func (d *DownloadModular) PostChallengePiece(context.Context, task.ChallengePiec
 func (d *DownloadModular) QueryTasks(context.Context, task.TKey) ([]task.Task, error) {
 	return nil, nil
 }
-
-func isErrNoSuchKey(err error) bool {
-	msg := err.Error()
-	return strings.Contains(msg, s3.ErrCodeNoSuchKey)
-}
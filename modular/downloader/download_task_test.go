@@ -7,6 +7,7 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspclient"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfsppiececache"
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfsppieceop"
 	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
 	"github.com/bnb-chain/greenfield-storage-provider/core/consensus"
@@ -16,7 +17,6 @@ import (
 	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
 	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
 	virtualgrouptypes "github.com/bnb-chain/greenfield/x/virtualgroup/types"
-	lru "github.com/hashicorp/golang-lru"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -243,7 +243,7 @@ func TestHandleDownloadObjectTask(t *testing.T) {
 
 	// succeed
 	d.baseApp.SetPieceOp(&gfsppieceop.GfSpPieceOp{})
-	d.pieceCache, _ = lru.New(100)
+	d.pieceCache, _ = gfsppiececache.NewGfSpPieceCache(1024*1024, "", 0)
 	mockTask2 := &gfsptask.GfSpDownloadObjectTask{
 		Task: &gfsptask.GfSpTask{},
 		BucketInfo: &storagetypes.BucketInfo{
@@ -366,7 +366,7 @@ func TestHandleDownloadPieceTask(t *testing.T) {
 	// succeed
 	d.downloading = 1
 	d.downloadParallel = 100
-	d.pieceCache, _ = lru.New(100)
+	d.pieceCache, _ = gfsppiececache.NewGfSpPieceCache(1024*1024, "", 0)
 	d.baseApp.SetPieceOp(&gfsppieceop.GfSpPieceOp{})
 	mockTask2 := &gfsptask.GfSpDownloadPieceTask{
 		Task: &gfsptask.GfSpTask{},
@@ -463,7 +463,7 @@ func TestHandleChallengePiece(t *testing.T) {
 	// succeed
 	d.challenging = 1
 	d.challengeParallel = 100
-	d.pieceCache, _ = lru.New(100)
+	d.pieceCache, _ = gfsppiececache.NewGfSpPieceCache(1024*1024, "", 0)
 	d.baseApp.SetPieceOp(&gfsppieceop.GfSpPieceOp{})
 	mockPieceStoreAPI := piecestore.NewMockPieceStore(ctrl)
 	d.baseApp.SetPieceStore(mockPieceStoreAPI)
@@ -4,10 +4,9 @@ import (
 	"context"
 	"fmt"
 
-	lru "github.com/hashicorp/golang-lru"
-
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspapp"
 	"github.com/bnb-chain/greenfield-storage-provider/core/module"
+	"github.com/bnb-chain/greenfield-storage-provider/core/piecestore"
 	"github.com/bnb-chain/greenfield-storage-provider/core/rcmgr"
 )
 
@@ -16,12 +15,14 @@ var _ module.Downloader = &DownloadModular{}
 type DownloadModular struct {
 	baseApp           *gfspapp.GfSpBaseApp
 	scope             rcmgr.ResourceScope
-	pieceCache        *lru.Cache
+	pieceCache        piecestore.PieceCache
 	downloading       int64
 	downloadParallel  int64
 	challenging       int64
 	challengeParallel int64
 	monthlyFreeQuota  uint64
+	// readRepair indicates whether to reconstruct the missing segment from the secondary SPs
+	readRepair bool
 }
 
 func (d *DownloadModular) Name() string {
@@ -1,10 +1,9 @@
 package downloader
 
 import (
-	lru "github.com/hashicorp/golang-lru"
-
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspapp"
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfsppiececache"
 	coremodule "github.com/bnb-chain/greenfield-storage-provider/core/module"
 )
 
@@ -17,6 +16,10 @@ const (
 	DefaultChallengePieceParallelPerNode = 10240
 	// DefaultBucketFreeQuota defines the default free read quota per bucket
 	DefaultBucketFreeQuota = 10 * 1024 * 1024 * 1024
+	// DefaultPieceCacheSize defines the default bytes of the pieces cached in memory
+	DefaultPieceCacheSize = 1024 * 1024 * 1024
+	// DefaultPieceCacheSpillSize defines the default bytes of the pieces kept in the disk spill
+	DefaultPieceCacheSpillSize = 10 * 1024 * 1024 * 1024
 )
 
 func NewDownloadModular(app *gfspapp.GfSpBaseApp, cfg *gfspconfig.GfSpConfig) (coremodule.Modular, error) {
@@ -35,14 +38,25 @@ func DefaultDownloaderOptions(downloader *DownloadModular, cfg *gfspconfig.GfSpC
 		cfg.Parallel.ChallengePieceParallelPerNode = DefaultChallengePieceParallelPerNode
 	}
 
-	cache, err := lru.New(cfg.Parallel.DownloadObjectParallelPerNode)
-	if err != nil {
-		return err
+	if cfg.Customize != nil && cfg.Customize.PieceCache != nil {
+		downloader.pieceCache = cfg.Customize.PieceCache
+	} else {
+		if cfg.Downloader.PieceCacheSize == 0 {
+			cfg.Downloader.PieceCacheSize = DefaultPieceCacheSize
+		}
+		if cfg.Downloader.PieceCacheSpillDir != "" && cfg.Downloader.PieceCacheSpillSize == 0 {
+			cfg.Downloader.PieceCacheSpillSize = DefaultPieceCacheSpillSize
+		}
+		cache, err := gfsppiececache.NewGfSpPieceCache(cfg.Downloader.PieceCacheSize,
+			cfg.Downloader.PieceCacheSpillDir, cfg.Downloader.PieceCacheSpillSize)
+		if err != nil {
+			return err
+		}
+		downloader.pieceCache = cache
 	}
-
-	downloader.pieceCache = cache
 	downloader.downloadParallel = int64(cfg.Parallel.DownloadObjectParallelPerNode)
 	downloader.challengeParallel = int64(cfg.Parallel.ChallengePieceParallelPerNode)
+	downloader.readRepair = !cfg.Downloader.DisableReadRepair
 	if cfg.Quota.MonthlyFreeQuota == 0 {
 		downloader.monthlyFreeQuota = gfspapp.DefaultSpMonthlyFreeQuota
 	} else {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspapp"
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfsppiececache"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -15,3 +16,23 @@ func TestNewDownloadModular(t *testing.T) {
 	assert.Nil(t, err)
 	assert.NotNil(t, result)
 }
+
+func TestDefaultDownloaderOptions(t *testing.T) {
+	d := &DownloadModular{}
+	cfg := &gfspconfig.GfSpConfig{}
+	cfg.Downloader.PieceCacheSpillDir = t.TempDir()
+	err := DefaultDownloaderOptions(d, cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(DefaultPieceCacheSize), cfg.Downloader.PieceCacheSize)
+	assert.Equal(t, int64(DefaultPieceCacheSpillSize), cfg.Downloader.PieceCacheSpillSize)
+	assert.NotNil(t, d.pieceCache)
+
+	// customized piece cache
+	cache, err := gfsppiececache.NewGfSpPieceCache(100, "", 0)
+	assert.Nil(t, err)
+	d = &DownloadModular{}
+	cfg = &gfspconfig.GfSpConfig{Customize: &gfspconfig.Customize{PieceCache: cache}}
+	err = DefaultDownloaderOptions(d, cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, cache, d.pieceCache)
+}
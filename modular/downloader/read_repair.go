@@ -0,0 +1,167 @@
+package downloader
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/bnb-chain/greenfield-common/go/hash"
+	"github.com/bnb-chain/greenfield-common/go/redundancy"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
+	"github.com/bnb-chain/greenfield-storage-provider/core/task"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+)
+
+const (
+	// readRepairPieceTimeout defines the timeout of getting one EC piece from the secondary SP.
+	readRepairPieceTimeout = 10 * time.Second
+	// readRepairRecoveryTime defines the timeout of the background recovery task.
+	readRepairRecoveryTime = 50
+	// readRepairRecoveryRetry defines the max retry of the background recovery task.
+	readRepairRecoveryRetry = 5
+)
+
+// repairSegment reconstructs the missing segment piece of the primary SP from the EC pieces of the secondary
+// SPs, and it generates a background recovery task to heal the local copy.
+func (d *DownloadModular) repairSegment(ctx context.Context, downloadObjectTask task.DownloadObjectTask,
+	segmentIdx uint32) ([]byte, error) {
+	objectInfo := downloadObjectTask.GetObjectInfo()
+	params := downloadObjectTask.GetStorageParams()
+	if objectInfo.GetRedundancyType() != storagetypes.REDUNDANCY_EC_TYPE {
+		return nil, fmt.Errorf("unsupported redundancy type: %s", objectInfo.GetRedundancyType())
+	}
+	var (
+		dataShards     = params.VersionedParams.GetRedundantDataChunkNum()
+		parityShards   = params.VersionedParams.GetRedundantParityChunkNum()
+		maxSegmentSize = params.VersionedParams.GetMaxSegmentSize()
+	)
+	endpoints, err := d.getSecondaryEndpoints(ctx, downloadObjectTask)
+	if err != nil {
+		return nil, err
+	}
+	if uint32(len(endpoints)) != dataShards+parityShards {
+		return nil, fmt.Errorf("mismatched secondary SPs: %d, expected: %d", len(endpoints), dataShards+parityShards)
+	}
+
+	recoveryTask := &gfsptask.GfSpRecoverPieceTask{}
+	recoveryTask.InitRecoverPieceTask(objectInfo, params, task.DefaultSmallerPriority, segmentIdx, int32(-1),
+		maxSegmentSize, readRepairRecoveryTime, readRepairRecoveryRetry)
+	signature, err := d.baseApp.GfSpClient().SignRecoveryTask(ctx, recoveryTask)
+	if err != nil {
+		return nil, err
+	}
+	recoveryTask.SetSignature(signature)
+
+	type ecPiece struct {
+		index int
+		data  []byte
+	}
+	childCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	pieceCh := make(chan *ecPiece, len(endpoints))
+	for idx, endpoint := range endpoints {
+		go func(idx int, endpoint string) {
+			data, getErr := d.getECPiece(childCtx, recoveryTask, endpoint)
+			if getErr != nil {
+				log.CtxErrorw(ctx, "failed to get ec piece to repair segment", "endpoint", endpoint,
+					"segment_idx", segmentIdx, "error", getErr)
+				data = nil
+			}
+			pieceCh <- &ecPiece{index: idx, data: data}
+		}(idx, endpoint)
+	}
+	var (
+		sources = make([][]byte, len(endpoints))
+		done    uint32
+	)
+	for range endpoints {
+		piece := <-pieceCh
+		if piece.data == nil {
+			continue
+		}
+		sources[piece.index] = piece.data
+		if done++; done >= dataShards {
+			break
+		}
+	}
+	cancel()
+	if done < dataShards {
+		return nil, fmt.Errorf("not enough ec pieces: %d, expected: %d", done, dataShards)
+	}
+
+	segmentSize := d.baseApp.PieceOp().SegmentPieceSize(objectInfo.GetPayloadSize(), segmentIdx, maxSegmentSize)
+	segment, err := redundancy.DecodeRawSegment(sources, segmentSize, int(dataShards), int(parityShards))
+	if err != nil {
+		return nil, err
+	}
+	integrity, err := d.baseApp.GfSpDB().GetObjectIntegrity(objectInfo.Id.Uint64(), -1)
+	if err != nil {
+		return nil, err
+	}
+	if int(segmentIdx) >= len(integrity.PieceChecksumList) {
+		return nil, fmt.Errorf("invalid segment index: %d", segmentIdx)
+	}
+	if checksum := hash.GenerateChecksum(segment); !bytes.Equal(checksum, integrity.PieceChecksumList[segmentIdx]) {
+		return nil, fmt.Errorf("mismatched segment checksum, expected: %s, actual: %s",
+			hex.EncodeToString(integrity.PieceChecksumList[segmentIdx]), hex.EncodeToString(checksum))
+	}
+
+	// heal the local copy in background, the manager dispatches it to the executor, the task is signed as
+	// same as the other tasks reported by the downloader
+	go func() {
+		healTask := &gfsptask.GfSpRecoverPieceTask{}
+		healTask.InitRecoverPieceTask(objectInfo, params, task.DefaultSmallerPriority, segmentIdx, int32(-1),
+			maxSegmentSize, readRepairRecoveryTime, readRepairRecoveryRetry)
+		healSignature, signErr := d.baseApp.GfSpClient().SignRecoveryTask(context.Background(), healTask)
+		if signErr != nil {
+			log.Errorw("failed to sign recovery task after read repair", "task_info", healTask.Info(), "error", signErr)
+			return
+		}
+		healTask.SetSignature(healSignature)
+		if reportErr := d.baseApp.GfSpClient().ReportTask(context.Background(), healTask); reportErr != nil {
+			log.Errorw("failed to report recovery task after read repair", "task_info", healTask.Info(), "error", reportErr)
+		}
+	}()
+	log.CtxInfow(ctx, "succeed to repair segment from secondary SPs", "object_id", objectInfo.Id.String(),
+		"segment_idx", segmentIdx, "ec_pieces", done)
+	return segment, nil
+}
+
+func (d *DownloadModular) getECPiece(ctx context.Context, recoveryTask task.RecoveryPieceTask, endpoint string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, readRepairPieceTimeout)
+	defer cancel()
+	body, err := d.baseApp.GfSpClient().GetPieceFromECChunks(ctx, endpoint, recoveryTask)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	return io.ReadAll(body)
+}
+
+// getSecondaryEndpoints returns the secondary SP endpoints of the object in the order of the redundancy index.
+func (d *DownloadModular) getSecondaryEndpoints(ctx context.Context, downloadObjectTask task.DownloadObjectTask) ([]string, error) {
+	gvg, err := d.baseApp.GfSpClient().GetGlobalVirtualGroup(ctx, downloadObjectTask.GetBucketInfo().Id.Uint64(),
+		downloadObjectTask.GetObjectInfo().GetLocalVirtualGroupId())
+	if err != nil {
+		return nil, err
+	}
+	spList, err := d.baseApp.Consensus().ListSPs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	endpoints := make([]string, 0, len(gvg.GetSecondarySpIds()))
+	for _, spID := range gvg.GetSecondarySpIds() {
+		for _, sp := range spList {
+			if sp.GetId() == spID {
+				endpoints = append(endpoints, sp.GetEndpoint())
+				break
+			}
+		}
+	}
+	return endpoints, nil
+}
@@ -0,0 +1,155 @@
+package downloader
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/bnb-chain/greenfield-common/go/hash"
+	"github.com/bnb-chain/greenfield-common/go/redundancy"
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+	virtualgrouptypes "github.com/bnb-chain/greenfield/x/virtualgroup/types"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspclient"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfsppiececache"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfsppieceop"
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
+	"github.com/bnb-chain/greenfield-storage-provider/core/consensus"
+	"github.com/bnb-chain/greenfield-storage-provider/core/piecestore"
+	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+	coretask "github.com/bnb-chain/greenfield-storage-provider/core/task"
+)
+
+func setupReadRepairTest(t *testing.T, segment []byte, checksum []byte, failedEndpoints int) (
+	*DownloadModular, *gfsptask.GfSpDownloadObjectTask, chan coretask.Task) {
+	d := setup(t)
+	d.downloadParallel = 100
+	d.readRepair = true
+	d.pieceCache, _ = gfsppiececache.NewGfSpPieceCache(1024*1024, "", 0)
+	d.baseApp.SetPieceOp(&gfsppieceop.GfSpPieceOp{})
+
+	ctrl := gomock.NewController(t)
+	mockPieceStore := piecestore.NewMockPieceStore(ctrl)
+	mockPieceStore.EXPECT().GetPiece(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		nil, errors.New("NoSuchKey: the specified key does not exist")).AnyTimes()
+	mockPieceStore.EXPECT().GetPieceReader(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		nil, errors.New("NoSuchKey: the specified key does not exist")).AnyTimes()
+	d.baseApp.SetPieceStore(mockPieceStore)
+
+	pieces, err := redundancy.EncodeRawSegment(segment, 4, 2)
+	assert.Nil(t, err)
+	mockClient := gfspclient.NewMockGfSpClientAPI(ctrl)
+	mockClient.EXPECT().GetGlobalVirtualGroup(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&virtualgrouptypes.GlobalVirtualGroup{SecondarySpIds: []uint32{1, 2, 3, 4, 5, 6}}, nil).AnyTimes()
+	mockClient.EXPECT().SignRecoveryTask(gomock.Any(), gomock.Any()).Return([]byte("mockSig"), nil).AnyTimes()
+	mockClient.EXPECT().GetPieceFromECChunks(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, endpoint string, task coretask.RecoveryPieceTask) (io.ReadCloser, error) {
+			assert.Equal(t, []byte("mockSig"), task.GetSignature())
+			var idx int
+			_, _ = fmt.Sscanf(endpoint, "sp%d", &idx)
+			if idx <= failedEndpoints {
+				return nil, errors.New("mock error")
+			}
+			return io.NopCloser(bytes.NewReader(pieces[idx-1])), nil
+		}).AnyTimes()
+	reported := make(chan coretask.Task, 1)
+	mockClient.EXPECT().ReportTask(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, task coretask.Task) error {
+			reported <- task
+			return nil
+		}).AnyTimes()
+	d.baseApp.SetGfSpClient(mockClient)
+
+	mockConsensus := consensus.NewMockConsensus(ctrl)
+	var spList []*sptypes.StorageProvider
+	for i := uint32(1); i <= 6; i++ {
+		spList = append(spList, &sptypes.StorageProvider{Id: i, Endpoint: fmt.Sprintf("sp%d", i)})
+	}
+	mockConsensus.EXPECT().ListSPs(gomock.Any()).Return(spList, nil).AnyTimes()
+	d.baseApp.SetConsensus(mockConsensus)
+
+	mockSPDB := spdb.NewMockSPDB(ctrl)
+	mockSPDB.EXPECT().GetObjectIntegrity(gomock.Any(), int32(-1)).Return(
+		&spdb.IntegrityMeta{PieceChecksumList: [][]byte{checksum}}, nil).AnyTimes()
+	d.baseApp.SetGfSpDB(mockSPDB)
+
+	downloadTask := &gfsptask.GfSpDownloadObjectTask{
+		Task:       &gfsptask.GfSpTask{},
+		BucketInfo: &storagetypes.BucketInfo{Id: sdkmath.NewUint(100), BucketName: "mock_bucket"},
+		ObjectInfo: &storagetypes.ObjectInfo{
+			Id:             sdkmath.NewUint(100),
+			ObjectStatus:   storagetypes.OBJECT_STATUS_SEALED,
+			PayloadSize:    uint64(len(segment)),
+			RedundancyType: storagetypes.REDUNDANCY_EC_TYPE,
+		},
+		StorageParams: &storagetypes.Params{
+			VersionedParams: storagetypes.VersionedParams{
+				MaxSegmentSize:          16 * 1024 * 1024,
+				RedundantDataChunkNum:   4,
+				RedundantParityChunkNum: 2,
+			},
+		},
+		Low:  10,
+		High: 19,
+	}
+	return d, downloadTask, reported
+}
+
+func TestHandleDownloadObjectTask_ReadRepair(t *testing.T) {
+	segment := bytes.Repeat([]byte("0123456789"), 10)
+	d, downloadTask, reported := setupReadRepairTest(t, segment, hash.GenerateChecksum(segment), 2)
+	data, err := d.HandleDownloadObjectTask(context.TODO(), downloadTask)
+	assert.Nil(t, err)
+	assert.Equal(t, segment[10:20], data)
+
+	// the background recovery task heals the local segment
+	task := (<-reported).(*gfsptask.GfSpRecoverPieceTask)
+	assert.Equal(t, uint32(0), task.GetSegmentIdx())
+	assert.Equal(t, int32(-1), task.GetEcIdx())
+	assert.Equal(t, []byte("mockSig"), task.GetSignature())
+}
+
+func TestHandleDownloadObjectTask_ReadRepairFailure(t *testing.T) {
+	segment := bytes.Repeat([]byte("0123456789"), 10)
+	cases := []struct {
+		name            string
+		checksum        []byte
+		failedEndpoints int
+		readRepair      bool
+	}{
+		{
+			name:            "not enough ec pieces",
+			checksum:        hash.GenerateChecksum(segment),
+			failedEndpoints: 3,
+			readRepair:      true,
+		},
+		{
+			name:            "mismatched checksum",
+			checksum:        []byte("mockChecksum"),
+			failedEndpoints: 0,
+			readRepair:      true,
+		},
+		{
+			name:            "read repair disabled",
+			checksum:        hash.GenerateChecksum(segment),
+			failedEndpoints: 0,
+			readRepair:      false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			d, downloadTask, _ := setupReadRepairTest(t, segment, tt.checksum, tt.failedEndpoints)
+			d.readRepair = tt.readRepair
+			data, err := d.HandleDownloadObjectTask(context.TODO(), downloadTask)
+			assert.Nil(t, data)
+			assert.Contains(t, err.Error(), "NoSuchKey")
+		})
+	}
+}
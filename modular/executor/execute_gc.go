@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/bnb-chain/greenfield-storage-provider/base/gnfd"
 	"github.com/bnb-chain/greenfield-storage-provider/core/piecestore"
 	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
 	corespdb "github.com/bnb-chain/greenfield-storage-provider/core/spdb"
@@ -575,7 +576,7 @@ func (e *ExecuteModular) gcZombiePieceFromPieceHash(ctx context.Context, task co
 			// If the object doesn't exist in metadata, recheck from the chain before proceeding with the deletion.
 			if strings.Contains(err.Error(), "no such object from metadata") {
 				if objInfoFromChain, err = e.baseApp.Consensus().QueryObjectInfoByID(ctx, strconv.FormatUint(objID, 10)); err != nil {
-					if strings.Contains(err.Error(), "No such object") {
+					if errors.Is(err, gnfd.ErrNoSuchObject) {
 						// 1) This object does not exist on the chain
 						log.Infof("the object doesn't exist in metadata and chain, the zombie piece should be deleted", "piece", piece)
 						e.gcWorker.deletePieceAndPieceChecksum(ctx, piece)
@@ -670,7 +671,7 @@ func (e *ExecuteModular) gcStaleVersionObjectFromShadowIntegrityMeta(ctx context
 	if err != nil {
 		log.Errorw("failed to query object info", "object_id", task.GetObjectId(), "error", err)
 
-		if strings.Contains(err.Error(), "No such object") {
+		if errors.Is(err, gnfd.ErrNoSuchObject) {
 			// if the object is deleted, can gc the piece according to the shadow integrity meta
 			err = gcStaleVersionPieces(task.GetObjectId(), metaSegmentCount, task.GetVersion(), task.GetRedundancyIndex())
 			if err != nil {
@@ -119,7 +119,12 @@ func (e *ExecuteModular) sealObject(ctx context.Context, task coretask.ObjectTas
 	}()
 	for retry := int64(0); retry <= task.GetMaxRetry(); retry++ {
 		txHash, err = e.baseApp.GfSpClient().SealObject(ctx, sealMsg)
-		if err != nil {
+		if err != nil && txHash != "" {
+			// the seal tx is broadcast but not committed in time, listen for it instead of sending another one
+			task.AppendLog(fmt.Sprintf("executor-seal-tx-pending-retry:%d-txHash:%s", retry, txHash))
+			log.CtxWarnw(ctx, "seal object tx is pending", "tx_hash", txHash, "error", err)
+			break
+		} else if err != nil {
 			task.AppendLog(fmt.Sprintf("executor-seal-tx-failed-error:%s-retry:%d", err.Error(), retry))
 			log.CtxErrorw(ctx, "failed to seal object", "retry", retry, "max_retry", task.GetMaxRetry(),
 				"error", err)
@@ -154,7 +159,12 @@ func (e *ExecuteModular) sealObjectV2(ctx context.Context, task coretask.ObjectT
 	}()
 	for retry := int64(0); retry <= task.GetMaxRetry(); retry++ {
 		txHash, err = e.baseApp.GfSpClient().SealObjectV2(ctx, sealMsg)
-		if err != nil {
+		if err != nil && txHash != "" {
+			// the seal tx is broadcast but not committed in time, listen for it instead of sending another one
+			task.AppendLog(fmt.Sprintf("executor-seal-tx-pending-retry:%d-txHash:%s", retry, txHash))
+			log.CtxWarnw(ctx, "seal object tx is pending", "tx_hash", txHash, "error", err)
+			break
+		} else if err != nil {
 			task.AppendLog(fmt.Sprintf("executor-seal-tx-failed-error:%s-retry:%d", err.Error(), retry))
 			log.CtxErrorw(ctx, "failed to seal object", "retry", retry, "max_retry", task.GetMaxRetry(),
 				"error", err)
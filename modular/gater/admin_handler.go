@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -16,6 +17,7 @@ import (
 
 	commonhash "github.com/bnb-chain/greenfield-common/go/hash"
 	"github.com/bnb-chain/greenfield-common/go/redundancy"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gnfd"
 	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsperrors"
 	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
 	coremodule "github.com/bnb-chain/greenfield-storage-provider/core/module"
@@ -317,7 +319,7 @@ func (g *GateModular) getChallengeInfoHandler(w http.ResponseWriter, r *http.Req
 	metrics.PerfChallengeTimeHistogram.WithLabelValues("challenge_get_object_time").Observe(time.Since(getObjectTime).Seconds())
 	if err != nil {
 		log.CtxErrorw(reqCtx.Context(), "failed to get object info from consensus", "error", err)
-		if strings.Contains(err.Error(), "No such object") {
+		if errors.Is(err, gnfd.ErrNoSuchObject) {
 			err = ErrNoSuchObject
 		} else {
 			err = ErrConsensusWithDetail("failed to get object info from consensus, object_id: " + fmt.Sprint(objectID) + "error: " + err.Error())
@@ -458,7 +460,7 @@ func (g *GateModular) getChallengeInfoV2Handler(w http.ResponseWriter, r *http.R
 	if err != nil {
 		log.CtxErrorw(reqCtx.Context(), "failed to get object info from consensus",
 			"object_id", reqCtx.request.Header.Get(GnfdObjectIDHeader), "error", err)
-		if strings.Contains(err.Error(), "No such object") {
+		if errors.Is(err, gnfd.ErrNoSuchObject) {
 			err = ErrNoSuchObject
 		} else {
 			err = ErrConsensusWithDetail("failed to get object info from consensus, object_id:" + reqCtx.request.Header.Get(GnfdObjectIDHeader) + ", error: " + err.Error())
@@ -697,17 +699,16 @@ func (g *GateModular) checkReplicatePermission(ctx context.Context, receiveTask
 	for retry := 0; retry < checkPermissionRetry; retry++ {
 		objectInfo, err = g.baseApp.Consensus().QueryObjectInfo(ctx, receiveTask.ObjectInfo.BucketName, receiveTask.ObjectInfo.ObjectName)
 		if err != nil {
-			err = ErrConsensusWithDetail("failed to get object info from consensus, object_name: " + receiveTask.ObjectInfo.ObjectName + "bucket_name: " + receiveTask.ObjectInfo.BucketName + ", error:" + err.Error())
 			time.Sleep(checkPermissionSleepTime)
 			continue
 		}
 		break
 	}
 	if err != nil {
-		if strings.Contains(err.Error(), "No such object") {
-			err = ErrNoSuchObject
+		if errors.Is(err, gnfd.ErrNoSuchObject) {
+			return ErrNoSuchObject
 		}
-		return err
+		return ErrConsensusWithDetail("failed to get object info from consensus, object_name: " + receiveTask.ObjectInfo.ObjectName + "bucket_name: " + receiveTask.ObjectInfo.BucketName + ", error:" + err.Error())
 	}
 	if receiveTask.BucketMigration {
 		// if it is bucket migration, the status should be sealed
@@ -2,7 +2,6 @@ package gater
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -18,6 +17,7 @@ import (
 
 	commonhttp "github.com/bnb-chain/greenfield-common/go/http"
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspclient"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gnfd"
 	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
 	"github.com/bnb-chain/greenfield-storage-provider/core/consensus"
 	"github.com/bnb-chain/greenfield-storage-provider/core/piecestore"
@@ -919,7 +919,7 @@ func TestGateModular_getChallengeInfoHandler(t *testing.T) {
 
 				consensusMock := consensus.NewMockConsensus(ctrl)
 				consensusMock.EXPECT().QueryObjectInfoByID(gomock.Any(), gomock.Any()).Return(nil,
-					errors.New("No such object")).Times(1)
+					gnfd.ErrNoSuchObject).Times(1)
 				g.baseApp.SetConsensus(consensusMock)
 				return g
 			},
@@ -1285,7 +1285,7 @@ func TestGateModular_getChallengeInfoHandler(t *testing.T) {
 
 				consensusMock := consensus.NewMockConsensus(ctrl)
 				consensusMock.EXPECT().QueryObjectInfoByID(gomock.Any(), gomock.Any()).Return(nil,
-					errors.New("No such object")).Times(1)
+					gnfd.ErrNoSuchObject).Times(1)
 				g.baseApp.SetConsensus(consensusMock)
 				return g
 			},
@@ -50,6 +50,12 @@ type ListUserPublicKeyV2Resp struct {
 	PublicKeys []string `xml:"Result"`
 }
 
+type CreateS3AccessKeyResp struct {
+	XMLName         xml.Name `xml:"CreateS3AccessKeyResult"`
+	AccessKeyID     string   `xml:"AccessKeyId"`
+	SecretAccessKey string   `xml:"SecretAccessKey"`
+}
+
 // requestNonceHandler handle requestNonce request
 func (g *GateModular) requestNonceHandler(w http.ResponseWriter, r *http.Request) {
 	var (
@@ -73,7 +79,9 @@ func (g *GateModular) requestNonceHandler(w http.ResponseWriter, r *http.Request
 	}()
 
 	// ignore the error, because the requestNonce does not need signature
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	account := reqCtx.request.Header.Get(GnfdUserAddressHeader)
 	domain := reqCtx.request.Header.Get(GnfdOffChainAuthAppDomainHeader)
@@ -143,7 +151,9 @@ func (g *GateModular) updateUserPublicKeyHandler(w http.ResponseWriter, r *http.
 		}
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 	// verify personal sign signature
 	personalSignSignaturePrefix := commonhttp.Gnfd1EthPersonalSign + ","
 	requestSignature := reqCtx.request.Header.Get(GnfdAuthorizationHeader)
@@ -264,7 +274,9 @@ func (g *GateModular) updateUserPublicKeyV2Handler(w http.ResponseWriter, r *htt
 		}
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 	// verify personal sign signature
 	personalSignSignaturePrefix := commonhttp.Gnfd1EthPersonalSign + ","
 	requestSignature := reqCtx.request.Header.Get(GnfdAuthorizationHeader)
@@ -365,7 +377,9 @@ func (g *GateModular) listUserPublicKeyV2Handler(w http.ResponseWriter, r *http.
 	}()
 
 	// ignore the error, because the listUserPublicKeyV2 does not need signature
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	account := reqCtx.request.Header.Get(GnfdUserAddressHeader)
 	domain := reqCtx.request.Header.Get(GnfdOffChainAuthAppDomainHeader)
@@ -480,6 +494,72 @@ func (g *GateModular) deleteUserPublicKeyV2Handler(w http.ResponseWriter, r *htt
 	w.Write(b)
 }
 
+// createS3AccessKeyHandler issues an access key for the S3 compatible api, the access key is bound to the off-chain
+// auth public key which signs the request, so it becomes invalid once the public key expires or is deleted.
+func (g *GateModular) createS3AccessKeyHandler(w http.ResponseWriter, r *http.Request) {
+	var (
+		err    error
+		b      []byte
+		reqCtx *RequestContext
+	)
+	startTime := time.Now()
+	defer func() {
+		reqCtx.Cancel()
+		if err != nil {
+			reqCtx.SetError(gfsperrors.MakeGfSpError(err))
+			log.CtxErrorw(reqCtx.Context(), "failed to create s3 access key", "req_info", reqCtx.String())
+			modelgateway.MakeErrorResponse(w, gfsperrors.MakeGfSpError(err))
+			metrics.ReqCounter.WithLabelValues(GatewayTotalFailure).Inc()
+			metrics.ReqTime.WithLabelValues(GatewayTotalFailure).Observe(time.Since(startTime).Seconds())
+		} else {
+			metrics.ReqCounter.WithLabelValues(GatewayTotalSuccess).Inc()
+			metrics.ReqTime.WithLabelValues(GatewayTotalSuccess).Observe(time.Since(startTime).Seconds())
+		}
+	}()
+
+	// createS3AccessKeyHandler requires the GNFD2-EDDSA authentication, the signing public key is bound to the access key
+	reqCtx, err = NewRequestContext(r, g)
+	if err != nil {
+		return
+	}
+	if !strings.HasPrefix(r.Header.Get(GnfdAuthorizationHeader), commonhttp.Gnfd2Eddsa+",") {
+		err = ErrUnsupportedSignType
+		return
+	}
+
+	account := reqCtx.request.Header.Get(GnfdUserAddressHeader)
+	domain := reqCtx.request.Header.Get(GnfdOffChainAuthAppDomainHeader)
+	publicKey := reqCtx.request.Header.Get(GnfdOffChainAuthAppRegPublicKeyHeader)
+	if ok := common.IsHexAddress(account); !ok {
+		err = ErrInvalidHeader
+		log.Errorw("failed to check account address", "account_address", account, "error", err)
+		return
+	}
+	if !strings.EqualFold(account, reqCtx.account) {
+		err = ErrNoPermission
+		return
+	}
+
+	accessKeyID, secretAccessKey, err := g.baseApp.GfSpClient().CreateS3AccessKey(reqCtx.Context(), reqCtx.account, domain, publicKey)
+	if err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to create s3 access key", "error", err)
+		return
+	}
+
+	var resp = &CreateS3AccessKeyResp{
+		AccessKeyID:     accessKeyID,
+		SecretAccessKey: secretAccessKey,
+	}
+	b, err = xml.Marshal(resp)
+	if err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to marshal CreateS3AccessKeyResp", "error", err)
+		err = ErrEncodeResponseWithDetail("failed to marshal CreateS3AccessKeyResp, error: " + err.Error())
+		return
+	}
+	w.Header().Set(ContentTypeHeader, ContentTypeXMLHeaderValue)
+	w.Write(b)
+}
+
 // parseSignedMsgAndSigFromRequest get sig for personal auth, it expects the auth string should look like "Signature=xxxxx,SignedMsg=xxx".
 func parseSignedMsgAndSigFromRequest(requestSignature string) (*string, *string, error) {
 	var (
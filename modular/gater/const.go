@@ -73,8 +73,42 @@ const (
 	ListObjectsPrefixQuery = "prefix"
 	// ListObjectsIncludeRemovedQuery defines whether include removed objects
 	ListObjectsIncludeRemovedQuery = "include-removed"
+	// AtHeightQuery defines the block height to query the buckets, objects, group members and policies at
+	AtHeightQuery = "at-height"
 	// GetBucketMetaQuery defines get bucket metadata query, which is used to route request
 	GetBucketMetaQuery = "bucket-meta"
+	// BucketNotificationQuery defines put and get bucket notification query, which is used to route request
+	BucketNotificationQuery = "notification"
+	// MaxBucketNotificationRules defines the max number of the notification rules of a bucket
+	MaxBucketNotificationRules = 100
+	// MaxBucketNotificationRuleIDLength defines the max length of the id of a notification rule
+	MaxBucketNotificationRuleIDLength = 64
+	// MaxBucketNotificationURLLength defines the max length of the webhook url of a notification rule
+	MaxBucketNotificationURLLength = 2048
+	// MaxBucketNotificationSecretLength defines the max length of the secret of a notification rule
+	MaxBucketNotificationSecretLength = 256
+	// MaxBucketNotificationFieldLength defines the max length of the prefix, the suffix and the joined events of a
+	// notification rule
+	MaxBucketNotificationFieldLength = 1024
+	// MaxBucketNotificationConfigurationSize defines the max size of the bucket notification configuration
+	MaxBucketNotificationConfigurationSize = 64 * 1024
+	// SearchObjectsQuery defines search objects query, which is used to route request
+	SearchObjectsQuery = "search"
+	// SearchObjectsNamePatternQuery defines the glob pattern of the object name, "*" matches any characters and "?"
+	// matches a single character
+	SearchObjectsNamePatternQuery = "name-pattern"
+	// SearchObjectsMinSizeQuery defines the min payload size of the objects in bytes
+	SearchObjectsMinSizeQuery = "min-size"
+	// SearchObjectsMaxSizeQuery defines the max payload size of the objects in bytes
+	SearchObjectsMaxSizeQuery = "max-size"
+	// SearchObjectsContentTypeQuery defines the content type of the objects, e.g. "video/mp4" or "video/*"
+	SearchObjectsContentTypeQuery = "content-type"
+	// SearchObjectsCreatedAfterQuery defines the unix time in seconds that the objects are created at or after
+	SearchObjectsCreatedAfterQuery = "created-after"
+	// SearchObjectsCreatedBeforeQuery defines the unix time in seconds that the objects are created before
+	SearchObjectsCreatedBeforeQuery = "created-before"
+	// MaxSearchObjectsPatternLength defines the max length of the name pattern and the content type of the search
+	MaxSearchObjectsPatternLength = 1024
 	// GetBucketMigrationProgressQuery defines get bucket metadata query, which is used to route request
 	GetBucketMigrationProgressQuery = "bucket-migration-progress"
 	// GetObjectMetaQuery defines get object metadata query, which is used to route request
@@ -204,6 +238,15 @@ const (
 	OffChainAuthAppDomainQuery = "app-domain"
 	// OffChainAuthViewQuery defines the view query used by offchain-auth
 	OffChainAuthViewQuery = "view"
+	// PresignedMethodQuery defines the http method which the presigned url is allowed to be requested with
+	PresignedMethodQuery = "X-Gnfd-Presigned-Method"
+	// PresignedRangeQuery defines the range of the object which the presigned url is allowed to download
+	PresignedRangeQuery = "X-Gnfd-Presigned-Range"
+	// PresignedContentLengthQuery defines the content length of the object which the presigned url is allowed to put
+	PresignedContentLengthQuery = "X-Gnfd-Presigned-Content-Length"
+	// PresignedURLSignScope defines the first line of the msg to sign of the presigned url, it distinguishes the
+	// signature of the presigned url from the signatures of the headers
+	PresignedURLSignScope = "GNFD-PRESIGNED-URL"
 	// GetChallengeInfoPath defines get challenge info path style suffix
 	GetChallengeInfoPath = "/greenfield/admin/v1/challenge"
 	// GetChallengeInfoV2Path defines get challenge info path style suffix
@@ -222,6 +265,8 @@ const (
 	ListAuthKeyV2Path = "/auth/keys_v2"
 	// AuthDeleteKeysV2Path defines path to delete user public keys from off_chain_auth_key_v2 table
 	AuthDeleteKeysV2Path = "/auth/delete_keys_v2"
+	// AuthS3AccessKeyPath defines path to issue an access key of the S3 compatible api
+	AuthS3AccessKeyPath = "/auth/s3_access_key"
 	// GnfdRequestIDHeader defines trace-id, trace request in sp
 	GnfdRequestIDHeader = "X-Gnfd-Request-ID"
 	// GnfdAuthorizationHeader defines authorization, verify signature and check authorization
@@ -325,6 +370,9 @@ const (
 	objectSpecialSuffixUrlReplacement = "?" + UniversalEndpointSpecialSuffixQuery + "="
 	// StatusPath defines the path for sp status
 	StatusPath = "/status"
+
+	// ReplyObjectBufSize defines the size of buffer which pipes the object stream from the downloader to the client
+	ReplyObjectBufSize = 1024 * 1024
 )
 
 const (
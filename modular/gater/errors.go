@@ -61,6 +61,21 @@ var (
 	// 3. Contains "\": May indicate an attempt at illegal path or file operations, especially in Windows systems.
 	// 4. Fails SQL Injection Test (util.IsSQLInjection): Object name contains patterns that might be used for SQL injection, like ';select', 'xxx;insert', etc., or SQL comment patterns.
 	ErrInvalidObjectName = gfsperrors.Register(module.GateModularName, http.StatusBadRequest, 50044, "invalid object name")
+
+	ErrS3AuthorizationHeader    = gfsperrors.Register(module.GateModularName, http.StatusBadRequest, 50046, "the s3 authorization header is malformed")
+	ErrS3RequestTimeTooSkewed   = gfsperrors.Register(module.GateModularName, http.StatusForbidden, 50047, "the difference between the request time and the server's time is too large")
+	ErrS3ContentSHA256Mismatch  = gfsperrors.Register(module.GateModularName, http.StatusBadRequest, 50048, "the provided x-amz-content-sha256 header does not match the payload")
+	ErrS3NotImplemented         = gfsperrors.Register(module.GateModularName, http.StatusNotImplemented, 50049, "the s3 api is not implemented")
+	ErrS3InvalidPart            = gfsperrors.Register(module.GateModularName, http.StatusBadRequest, 50050, "the part is not uploaded or does not match the multipart upload, the parts must be uploaded in order with the same size except the last one")
+	ErrS3MissingContentLength   = gfsperrors.Register(module.GateModularName, http.StatusLengthRequired, 50051, "the content length header is required")
+	ErrS3NoSuchUpload           = gfsperrors.Register(module.GateModularName, http.StatusNotFound, 50052, "the multipart upload does not exist")
+	ErrS3DelegateDeleteNotAllow = gfsperrors.Register(module.GateModularName, http.StatusForbidden, 50053, "the SP is not allowed to delete the object, grant the SP the delete object permission first")
+
+	ErrInvalidPresignedURL            = gfsperrors.Register(module.GateModularName, http.StatusBadRequest, 50054, "the presigned url is invalid, the "+PresignedMethodQuery+", "+GnfdUserAddressHeader+", "+commonhttp.HTTPHeaderExpiryTimestamp+" and "+commonhttp.HTTPHeaderAuthorization+" params are required")
+	ErrPresignedURLNotAllow           = gfsperrors.Register(module.GateModularName, http.StatusForbidden, 50055, "the presigned url is only allowed to get or put objects")
+	ErrPresignedMethodMismatch        = gfsperrors.Register(module.GateModularName, http.StatusForbidden, 50056, "the request method does not match the "+PresignedMethodQuery+" param")
+	ErrPresignedRangeMismatch         = gfsperrors.Register(module.GateModularName, http.StatusForbidden, 50057, "the request range is out of the "+PresignedRangeQuery+" param")
+	ErrPresignedContentLengthMismatch = gfsperrors.Register(module.GateModularName, http.StatusForbidden, 50058, "the content length does not match the "+PresignedContentLengthQuery+" param")
 )
 
 func ErrEncodeResponseWithDetail(detail string) *gfsperrors.GfSpError {
@@ -75,6 +90,10 @@ func ErrNotifySwapOutWithDetail(detail string) *gfsperrors.GfSpError {
 	return gfsperrors.Register(module.GateModularName, http.StatusInternalServerError, 50034, detail)
 }
 
+func ErrInvalidNotificationWithDetail(detail string) *gfsperrors.GfSpError {
+	return gfsperrors.Register(module.GateModularName, http.StatusBadRequest, 50045, detail)
+}
+
 func ErrConsensusWithDetail(detail string) *gfsperrors.GfSpError {
 	return gfsperrors.Register(module.GateModularName, http.StatusInternalServerError, 55001, detail)
 }
@@ -26,6 +26,14 @@ type GateModular struct {
 	scope       rcmgr.ResourceScope
 	httpServer  *http.Server
 
+	// s3HTTPAddress is the listen address of the S3 compatible api, it is disabled if empty
+	s3HTTPAddress string
+	s3Region      string
+	s3HTTPServer  *http.Server
+
+	// enablePresignedURL enables authorizing getting and putting objects by the presigned url
+	enablePresignedURL bool
+
 	maxListReadQuota int64
 	maxPayloadSize   uint64
 
@@ -44,6 +52,9 @@ func (g *GateModular) Start(ctx context.Context) error {
 	}
 	g.scope = scope
 	go g.server(ctx)
+	if g.s3HTTPAddress != "" {
+		go g.s3Server(ctx)
+	}
 	g.spCachePool = NewSPCachePool(g.baseApp.Consensus())
 	return nil
 }
@@ -65,9 +76,29 @@ func (g *GateModular) server(ctx context.Context) {
 	}
 }
 
+func (g *GateModular) s3Server(ctx context.Context) {
+	router := mux.NewRouter().SkipClean(true)
+	if g.baseApp.EnableMetrics() {
+		router.Use(metrics.DefaultHTTPServerMetrics.InstrumentationHandler)
+	}
+	g.RegisterS3Handler(router)
+	g.s3HTTPServer = &http.Server{
+		Addr:              g.s3HTTPAddress,
+		Handler:           router,
+		ReadHeaderTimeout: ReadHeaderTimeout,
+	}
+	if err := g.s3HTTPServer.ListenAndServe(); err != nil {
+		log.Errorw("failed to listen s3 api", "error", err)
+		return
+	}
+}
+
 func (g *GateModular) Stop(ctx context.Context) error {
 	g.scope.Release()
 	_ = g.httpServer.Shutdown(ctx)
+	if g.s3HTTPServer != nil {
+		_ = g.s3HTTPServer.Shutdown(ctx)
+	}
 	return nil
 }
 
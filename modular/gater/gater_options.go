@@ -14,6 +14,7 @@ const (
 	DefaultGatewayDomainName = "localhost:9133"
 	DefaultMaxListReadQuota  = 100
 	DefaultMaxPayloadSize    = 2 * 1024 * 1024 * 1024
+	DefaultS3Region          = "us-east-1"
 )
 
 func NewGateModular(app *gfspapp.GfSpBaseApp, cfg *gfspconfig.GfSpConfig) (coremodule.Modular, error) {
@@ -31,6 +32,9 @@ func defaultGaterOptions(gater *GateModular, cfg *gfspconfig.GfSpConfig) error {
 	if cfg.Gateway.HTTPAddress == "" {
 		cfg.Gateway.HTTPAddress = DefaultGatewayDomainName
 	}
+	if cfg.Gateway.S3Region == "" {
+		cfg.Gateway.S3Region = DefaultS3Region
+	}
 	if cfg.Bucket.MaxListReadQuotaNumber == 0 {
 		cfg.Bucket.MaxListReadQuotaNumber = DefaultMaxListReadQuota
 	}
@@ -41,9 +45,13 @@ func defaultGaterOptions(gater *GateModular, cfg *gfspconfig.GfSpConfig) error {
 	gater.env = cfg.Env
 	gater.domain = cfg.Gateway.DomainName
 	gater.httpAddress = cfg.Gateway.HTTPAddress
+	gater.s3HTTPAddress = cfg.Gateway.S3HTTPAddress
+	gater.s3Region = cfg.Gateway.S3Region
+	gater.enablePresignedURL = cfg.Gateway.EnablePresignedURL
 	gater.maxListReadQuota = cfg.Bucket.MaxListReadQuotaNumber
 	rateCfg := makeAPIRateLimitCfg(cfg.APIRateLimiter)
-	if err := mwhttp.NewAPILimiter(rateCfg); err != nil {
+	// the sp db is only required by the sql store, which shares the counters among the gateway instances
+	if err := mwhttp.NewAPILimiter(rateCfg, gater.baseApp.GfSpDB()); err != nil {
 		log.Errorw("failed to new api limiter", "err", err)
 		return err
 	}
@@ -91,11 +99,16 @@ func makeAPIRateLimitCfg(cfg mwhttp.RateLimiterConfig) *mwhttp.APILimiterConfig
 		}
 	}
 	return &mwhttp.APILimiterConfig{
-		PathPattern:  pathPatternMap,
-		PathSequence: pathSequence,
-		HostPattern:  patternMap,
-		HostSequence: hostSequence,
-		APILimits:    apiLimitsMap,
-		IPLimitCfg:   cfg.IPLimitCfg,
+		Store:             cfg.Store,
+		RedisCfg:          cfg.RedisCfg,
+		PathPattern:       pathPatternMap,
+		PathSequence:      pathSequence,
+		HostPattern:       patternMap,
+		HostSequence:      hostSequence,
+		APILimits:         apiLimitsMap,
+		IPLimitCfg:        cfg.IPLimitCfg,
+		AccountLimitCfg:   cfg.AccountLimitCfg,
+		BucketLimitCfg:    cfg.BucketLimitCfg,
+		BandwidthLimitCfg: cfg.BandwidthLimitCfg,
 	}
 }
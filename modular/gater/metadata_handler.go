@@ -81,7 +81,9 @@ func (g *GateModular) getUserBucketsHandler(w http.ResponseWriter, r *http.Reque
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	queryParams = reqCtx.request.URL.Query()
 	requestIncludeRemoved = queryParams.Get(ListObjectsIncludeRemovedQuery)
@@ -140,6 +142,7 @@ func (g *GateModular) listObjectsByBucketNameHandler(w http.ResponseWriter, r *h
 		includedRemoved          bool
 		decodedContinuationToken []byte
 		queryParams              url.Values
+		atHeight                 int64
 	)
 	startTime := time.Now()
 	defer func() {
@@ -155,7 +158,9 @@ func (g *GateModular) listObjectsByBucketNameHandler(w http.ResponseWriter, r *h
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	queryParams = reqCtx.request.URL.Query()
 	requestBucketName = reqCtx.bucketName
@@ -235,6 +240,33 @@ func (g *GateModular) listObjectsByBucketNameHandler(w http.ResponseWriter, r *h
 		continuationToken = requestStartAfter
 	}
 
+	if atHeight, err = parseAtHeightQuery(queryParams); err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to parse at height", "at_height", queryParams.Get(AtHeightQuery), "error", err)
+		return
+	}
+	if atHeight != 0 {
+		// the folders and the removed objects are not kept in the history
+		if requestDelimiter != "" || includedRemoved {
+			log.CtxErrorw(reqCtx.Context(), "failed to list objects at height with delimiter or removed objects")
+			err = ErrInvalidQuery
+			return
+		}
+		var grpcResponse *types.GfSpListObjectsByBucketNameResponse
+		grpcResponse, err = g.baseApp.GfSpClient().ListObjectsByBucketNameAtHeight(reqCtx.Context(), requestBucketName,
+			maxKeys, continuationToken, requestPrefix, atHeight)
+		if err != nil {
+			log.CtxErrorw(reqCtx.Context(), "failed to list objects by bucket name at height", "error", err)
+			return
+		}
+		if respBytes, err = xml.Marshal(grpcResponse); err != nil {
+			log.CtxErrorw(reqCtx.Context(), "failed to list objects by given bucket name at height", "error", err)
+			return
+		}
+		w.Header().Set(ContentTypeHeader, ContentTypeXMLHeaderValue)
+		w.Write(respBytes)
+		return
+	}
+
 	objects,
 		keyCount,
 		maxKeys,
@@ -291,6 +323,7 @@ func (g *GateModular) getObjectMetaHandler(w http.ResponseWriter, r *http.Reques
 		err       error
 		respBytes []byte
 		reqCtx    *RequestContext
+		atHeight  int64
 	)
 
 	startTime := time.Now()
@@ -307,7 +340,9 @@ func (g *GateModular) getObjectMetaHandler(w http.ResponseWriter, r *http.Reques
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	if err = s3util.CheckValidBucketName(reqCtx.bucketName); err != nil {
 		log.Errorw("failed to check bucket name", "bucket_name", reqCtx.bucketName, "error", err)
@@ -321,7 +356,17 @@ func (g *GateModular) getObjectMetaHandler(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	resp, err := g.baseApp.GfSpClient().GetObjectMeta(reqCtx.Context(), reqCtx.objectName, reqCtx.bucketName, true)
+	if atHeight, err = parseAtHeightQuery(reqCtx.request.URL.Query()); err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to parse at height", "at_height", reqCtx.request.URL.Query().Get(AtHeightQuery), "error", err)
+		return
+	}
+
+	var resp *types.Object
+	if atHeight != 0 {
+		resp, err = g.baseApp.GfSpClient().GetObjectMetaAtHeight(reqCtx.Context(), reqCtx.objectName, reqCtx.bucketName, true, atHeight)
+	} else {
+		resp, err = g.baseApp.GfSpClient().GetObjectMeta(reqCtx.Context(), reqCtx.objectName, reqCtx.bucketName, true)
+	}
 	if err != nil {
 		log.Errorf("failed to get object meta", "error", err)
 
@@ -363,7 +408,9 @@ func (g *GateModular) getBucketMetaHandler(w http.ResponseWriter, r *http.Reques
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	if err = s3util.CheckValidBucketName(reqCtx.bucketName); err != nil {
 		log.Errorw("failed to check bucket name", "bucket_name", reqCtx.bucketName, "error", err)
@@ -419,7 +466,9 @@ func (g *GateModular) verifyPermissionHandler(w http.ResponseWriter, r *http.Req
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	queryParams = reqCtx.request.URL.Query()
 	objectName = queryParams.Get(VerifyPermissionObjectQuery)
@@ -508,7 +557,9 @@ func (g *GateModular) getGroupListHandler(w http.ResponseWriter, r *http.Request
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	queryParams = reqCtx.request.URL.Query()
 	sourceType = queryParams.Get(GetGroupListSourceTypeQuery)
@@ -610,7 +661,9 @@ func (g *GateModular) listObjectsByIDsHandler(w http.ResponseWriter, r *http.Req
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 	queryParams = reqCtx.request.URL.Query()
 	requestObjectIDs = queryParams.Get(IDsQuery)
 
@@ -690,7 +743,9 @@ func (g *GateModular) listBucketsByIDsHandler(w http.ResponseWriter, r *http.Req
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 	queryParams = reqCtx.request.URL.Query()
 	requestBucketIDs = queryParams.Get(IDsQuery)
 
@@ -766,7 +821,9 @@ func (g *GateModular) getPaymentByBucketIDHandler(w http.ResponseWriter, r *http
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	queryParams = reqCtx.request.URL.Query()
 	bucketIDStr = queryParams.Get(BucketIDQuery)
@@ -818,7 +875,9 @@ func (g *GateModular) getPaymentByBucketNameHandler(w http.ResponseWriter, r *ht
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	bucketName = reqCtx.bucketName
 
@@ -854,6 +913,7 @@ func (g *GateModular) getBucketByBucketNameHandler(w http.ResponseWriter, r *htt
 		reqCtx     *RequestContext
 		bucketName string
 		bucket     *types.Bucket
+		atHeight   int64
 	)
 	startTime := time.Now()
 	defer func() {
@@ -869,7 +929,9 @@ func (g *GateModular) getBucketByBucketNameHandler(w http.ResponseWriter, r *htt
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	bucketName = reqCtx.bucketName
 
@@ -879,7 +941,16 @@ func (g *GateModular) getBucketByBucketNameHandler(w http.ResponseWriter, r *htt
 		return
 	}
 
-	bucket, err = g.baseApp.GfSpClient().GetBucketByBucketName(reqCtx.Context(), bucketName, false)
+	if atHeight, err = parseAtHeightQuery(reqCtx.request.URL.Query()); err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to parse at height", "at_height", reqCtx.request.URL.Query().Get(AtHeightQuery), "error", err)
+		return
+	}
+
+	if atHeight != 0 {
+		bucket, err = g.baseApp.GfSpClient().GetBucketByBucketNameAtHeight(reqCtx.Context(), bucketName, false, atHeight)
+	} else {
+		bucket, err = g.baseApp.GfSpClient().GetBucketByBucketName(reqCtx.Context(), bucketName, false)
+	}
 	if err != nil {
 		log.Errorf("failed to get bucket by bucket name", "error", err)
 		return
@@ -922,7 +993,9 @@ func (g *GateModular) getBucketByBucketIDHandler(w http.ResponseWriter, r *http.
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	queryParams = reqCtx.request.URL.Query()
 	bucketIDStr = queryParams.Get(BucketIDQuery)
@@ -980,7 +1053,9 @@ func (g *GateModular) listDeletedObjectsByBlockNumberRangeHandler(w http.Respons
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	queryParams = reqCtx.request.URL.Query()
 	requestSpOperatorAddress = queryParams.Get(SpOperatorAddressQuery)
@@ -1048,7 +1123,9 @@ func (g *GateModular) getUserBucketsCountHandler(w http.ResponseWriter, r *http.
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	if ok := common.IsHexAddress(r.Header.Get(GnfdUserAddressHeader)); !ok {
 		log.Errorw("failed to check X-Gnfd-User-Address", "X-Gnfd-User-Address", reqCtx.account, "error", err)
@@ -1103,7 +1180,9 @@ func (g *GateModular) listExpiredBucketsBySpHandler(w http.ResponseWriter, r *ht
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	queryParams = reqCtx.request.URL.Query()
 	requestLimit = queryParams.Get(LimitQuery)
@@ -1181,7 +1260,9 @@ func (g *GateModular) verifyPermissionByIDHandler(w http.ResponseWriter, r *http
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	queryParams = reqCtx.request.URL.Query()
 	requestResourceID = queryParams.Get(ResourceIDQuery)
@@ -1269,7 +1350,9 @@ func (g *GateModular) listVirtualGroupFamiliesBySpIDHandler(w http.ResponseWrite
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	queryParams = reqCtx.request.URL.Query()
 	requestSpID = queryParams.Get(SpIDQuery)
@@ -1323,7 +1406,9 @@ func (g *GateModular) getVirtualGroupFamilyHandler(w http.ResponseWriter, r *htt
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	queryParams = reqCtx.request.URL.Query()
 	requestVgfID = queryParams.Get(VgfIDQuery)
@@ -1377,7 +1462,9 @@ func (g *GateModular) getGlobalVirtualGroupByGvgIDHandler(w http.ResponseWriter,
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	queryParams = reqCtx.request.URL.Query()
 	requestGvgID = queryParams.Get(GvgIDQuery)
@@ -1433,7 +1520,9 @@ func (g *GateModular) getGlobalVirtualGroupHandler(w http.ResponseWriter, r *htt
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	queryParams = reqCtx.request.URL.Query()
 	requestLvgID = queryParams.Get(LvgIDQuery)
@@ -1494,7 +1583,9 @@ func (g *GateModular) listGlobalVirtualGroupsBySecondarySPHandler(w http.Respons
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	queryParams = reqCtx.request.URL.Query()
 	requestSpID = queryParams.Get(SpIDQuery)
@@ -1548,7 +1639,9 @@ func (g *GateModular) listGlobalVirtualGroupsByBucketHandler(w http.ResponseWrit
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	queryParams = reqCtx.request.URL.Query()
 	bucketIDStr = queryParams.Get(BucketIDQuery)
@@ -1608,7 +1701,9 @@ func (g *GateModular) listObjectsInGVGAndBucketHandler(w http.ResponseWriter, r
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	queryParams = reqCtx.request.URL.Query()
 	requestGvgID = queryParams.Get(GvgIDQuery)
@@ -1693,7 +1788,9 @@ func (g *GateModular) listObjectsByGVGAndBucketForGCHandler(w http.ResponseWrite
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	queryParams = reqCtx.request.URL.Query()
 	requestGvgID = queryParams.Get(GvgIDQuery)
@@ -1776,7 +1873,9 @@ func (g *GateModular) listObjectsInGVGHandler(w http.ResponseWriter, r *http.Req
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	queryParams = reqCtx.request.URL.Query()
 	requestGvgID = queryParams.Get(GvgIDQuery)
@@ -1850,7 +1949,9 @@ func (g *GateModular) listMigrateBucketEventsHandler(w http.ResponseWriter, r *h
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	queryParams = reqCtx.request.URL.Query()
 	requestSpID = queryParams.Get(SpIDQuery)
@@ -1913,7 +2014,9 @@ func (g *GateModular) listSwapOutEventsHandler(w http.ResponseWriter, r *http.Re
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	queryParams = reqCtx.request.URL.Query()
 	requestSpID = queryParams.Get(SpIDQuery)
@@ -1975,7 +2078,9 @@ func (g *GateModular) listSpExitEventsHandler(w http.ResponseWriter, r *http.Req
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	queryParams = reqCtx.request.URL.Query()
 	requestSpID = queryParams.Get(SpIDQuery)
@@ -2036,7 +2141,9 @@ func (g *GateModular) getSPInfoHandler(w http.ResponseWriter, r *http.Request) {
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	queryParams = reqCtx.request.URL.Query()
 	requestOperatorAddress = queryParams.Get(OperatorAddressQuery)
@@ -2087,7 +2194,9 @@ func (g *GateModular) getStatusHandler(w http.ResponseWriter, r *http.Request) {
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	status, err = g.baseApp.GfSpClient().GetStatus(reqCtx.Context())
 	if err != nil {
@@ -2134,7 +2243,9 @@ func (g *GateModular) getUserGroupsHandler(w http.ResponseWriter, r *http.Reques
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	queryParams = reqCtx.request.URL.Query()
 	requestStartAfter = queryParams.Get(ListObjectsStartAfterQuery)
@@ -2193,6 +2304,7 @@ func (g *GateModular) getGroupMembersHandler(w http.ResponseWriter, r *http.Requ
 		requestGroupID    string
 		requestStartAfter string
 		queryParams       url.Values
+		atHeight          int64
 	)
 	startTime := time.Now()
 	defer func() {
@@ -2208,7 +2320,9 @@ func (g *GateModular) getGroupMembersHandler(w http.ResponseWriter, r *http.Requ
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	queryParams = reqCtx.request.URL.Query()
 	requestGroupID = queryParams.Get(GroupIDQuery)
@@ -2237,7 +2351,16 @@ func (g *GateModular) getGroupMembersHandler(w http.ResponseWriter, r *http.Requ
 		}
 	}
 
-	groups, err = g.baseApp.GfSpClient().GetGroupMembers(reqCtx.Context(), groupID, requestStartAfter, limit)
+	if atHeight, err = parseAtHeightQuery(queryParams); err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to parse at height", "at_height", queryParams.Get(AtHeightQuery), "error", err)
+		return
+	}
+
+	if atHeight != 0 {
+		groups, err = g.baseApp.GfSpClient().GetGroupMembersAtHeight(reqCtx.Context(), groupID, requestStartAfter, limit, atHeight)
+	} else {
+		groups, err = g.baseApp.GfSpClient().GetGroupMembers(reqCtx.Context(), groupID, requestStartAfter, limit)
+	}
 	if err != nil {
 		log.CtxErrorw(reqCtx.Context(), "failed to get group members by group id", "error", err)
 		return
@@ -2282,7 +2405,9 @@ func (g *GateModular) getUserOwnedGroupsHandler(w http.ResponseWriter, r *http.R
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	queryParams = reqCtx.request.URL.Query()
 	requestStartAfter = queryParams.Get(ListObjectsStartAfterQuery)
@@ -2343,6 +2468,7 @@ func (g *GateModular) listObjectPoliciesHandler(w http.ResponseWriter, r *http.R
 		startAfter        uint64
 		ok                bool
 		queryParams       url.Values
+		atHeight          int64
 	)
 	startTime := time.Now()
 	defer func() {
@@ -2358,7 +2484,9 @@ func (g *GateModular) listObjectPoliciesHandler(w http.ResponseWriter, r *http.R
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 	queryParams = reqCtx.request.URL.Query()
 	requestStartAfter = queryParams.Get(ListObjectsStartAfterQuery)
 	requestLimit = queryParams.Get(GetGroupListLimitQuery)
@@ -2404,7 +2532,16 @@ func (g *GateModular) listObjectPoliciesHandler(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	policies, err = g.baseApp.GfSpClient().ListObjectPolicies(reqCtx.Context(), reqCtx.objectName, reqCtx.bucketName, startAfter, actionType, limit)
+	if atHeight, err = parseAtHeightQuery(queryParams); err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to parse at height", "at_height", queryParams.Get(AtHeightQuery), "error", err)
+		return
+	}
+
+	if atHeight != 0 {
+		policies, err = g.baseApp.GfSpClient().ListObjectPoliciesAtHeight(reqCtx.Context(), reqCtx.objectName, reqCtx.bucketName, startAfter, actionType, limit, atHeight)
+	} else {
+		policies, err = g.baseApp.GfSpClient().ListObjectPolicies(reqCtx.Context(), reqCtx.objectName, reqCtx.bucketName, startAfter, actionType, limit)
+	}
 	if err != nil {
 		log.CtxErrorw(reqCtx.Context(), "failed to list policies by object info", "error", err)
 		return
@@ -2446,7 +2583,9 @@ func (g *GateModular) listPaymentAccountStreamsHandler(w http.ResponseWriter, r
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	queryParams = reqCtx.request.URL.Query()
 	paymentAccount = queryParams.Get(PaymentAccountQuery)
@@ -2497,7 +2636,9 @@ func (g *GateModular) listUserPaymentAccountsHandler(w http.ResponseWriter, r *h
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	if ok := common.IsHexAddress(r.Header.Get(GnfdUserAddressHeader)); !ok {
 		log.Errorw("failed to check X-Gnfd-User-Address", "X-Gnfd-User-Address", reqCtx.account, "error", err)
@@ -2552,7 +2693,9 @@ func (g *GateModular) listGroupsByIDsHandler(w http.ResponseWriter, r *http.Requ
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 	queryParams = reqCtx.request.URL.Query()
 	requestGroupIDs = queryParams.Get(IDsQuery)
 
@@ -2628,7 +2771,9 @@ func (g *GateModular) getSPMigratingBucketNumberHandler(w http.ResponseWriter, r
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	queryParams = reqCtx.request.URL.Query()
 	requestSpID = queryParams.Get(SpIDQuery)
@@ -2686,7 +2831,9 @@ func (g *GateModular) verifyMigrateGVGPermissionHandler(w http.ResponseWriter, r
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	queryParams = reqCtx.request.URL.Query()
 	requestGvgID = queryParams.Get(GvgIDQuery)
@@ -2754,7 +2901,9 @@ func (g *GateModular) getBucketSizeHandler(w http.ResponseWriter, r *http.Reques
 		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
 	}()
 
-	reqCtx, _ = NewRequestContext(r, g)
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	queryParams = reqCtx.request.URL.Query()
 	bucketIDStr = queryParams.Get(BucketIDQuery)
@@ -2825,3 +2974,16 @@ func (g *GateModular) getBsDBDataInfoHandler(w http.ResponseWriter, r *http.Requ
 	w.Header().Set(ContentTypeHeader, ContentTypeXMLHeaderValue)
 	w.Write(respBytes)
 }
+
+// parseAtHeightQuery parses the block height to query the history at, it returns 0 if the latest block is queried
+func parseAtHeightQuery(queryParams url.Values) (int64, error) {
+	requestAtHeight := queryParams.Get(AtHeightQuery)
+	if requestAtHeight == "" {
+		return 0, nil
+	}
+	atHeight, err := util.StringToInt64(requestAtHeight)
+	if err != nil || atHeight <= 0 {
+		return 0, ErrInvalidQuery
+	}
+	return atHeight, nil
+}
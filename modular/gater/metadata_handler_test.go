@@ -529,6 +529,64 @@ func TestGateModular_ListObjectsByBucketNameHandler(t *testing.T) {
 				return true
 			},
 		},
+		{
+			name: "xml response at height",
+			fn: func() *GateModular {
+				g := setup(t)
+				ctrl := gomock.NewController(t)
+				clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
+				clientMock.EXPECT().ListObjectsByBucketNameAtHeight(gomock.Any(), mockBucketName, uint64(1000), "", "",
+					int64(100)).Return(&types.GfSpListObjectsByBucketNameResponse{Objects: mockData}, nil).Times(1)
+				g.baseApp.SetGfSpClient(clientMock)
+				return g
+			},
+			request: func() *http.Request {
+				path := fmt.Sprintf("%s%s.%s/?max-keys=1000&at-height=100", scheme, mockBucketName, testDomain)
+				req := httptest.NewRequest(http.MethodGet, path, strings.NewReader(""))
+				return req
+			},
+			wantedResultFn: func(body string) bool {
+				var res types.GfSpListObjectsByBucketNameResponse
+				err := xml.Unmarshal([]byte(body), &res)
+				if err != nil {
+					return false
+				}
+				assert.Equal(t, len(mockData), len(res.Objects))
+				return true
+			},
+		},
+		{
+			name: "invalid at height",
+			fn: func() *GateModular {
+				g := setup(t)
+				ctrl := gomock.NewController(t)
+				clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
+				g.baseApp.SetGfSpClient(clientMock)
+				return g
+			},
+			request: func() *http.Request {
+				path := fmt.Sprintf("%s%s.%s/?max-keys=1000&at-height=-1", scheme, mockBucketName, testDomain)
+				req := httptest.NewRequest(http.MethodGet, path, strings.NewReader(""))
+				return req
+			},
+			wantedResult: "invalid request params for query",
+		},
+		{
+			name: "delimiter at height",
+			fn: func() *GateModular {
+				g := setup(t)
+				ctrl := gomock.NewController(t)
+				clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
+				g.baseApp.SetGfSpClient(clientMock)
+				return g
+			},
+			request: func() *http.Request {
+				path := fmt.Sprintf("%s%s.%s/?delimiter=%%2F&at-height=100", scheme, mockBucketName, testDomain)
+				req := httptest.NewRequest(http.MethodGet, path, strings.NewReader(""))
+				return req
+			},
+			wantedResult: "invalid request params for query",
+		},
 	}
 	for _, tt := range cases {
 		t.Run(tt.name, func(t *testing.T) {
@@ -668,6 +726,24 @@ func TestGateModular_GetObjectMetaHandler(t *testing.T) {
 				return true
 			},
 		},
+		{
+			name: "xml response at height",
+			fn: func() *GateModular {
+				g := setup(t)
+				ctrl := gomock.NewController(t)
+				clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
+				clientMock.EXPECT().GetObjectMetaAtHeight(gomock.Any(), mockObjectName, mockBucketName, true,
+					int64(100)).Return(getOneTestObjectResponse(), nil).Times(1)
+				g.baseApp.SetGfSpClient(clientMock)
+				return g
+			},
+			request: func() *http.Request {
+				path := fmt.Sprintf("%s%s.%s/%s?%s&at-height=100", scheme, mockBucketName, testDomain, mockObjectName, GetObjectMetaQuery)
+				req := httptest.NewRequest(http.MethodGet, path, strings.NewReader(""))
+				return req
+			},
+			wantedResult: "<ObjectName>mock-object-name</ObjectName>",
+		},
 	}
 	for _, tt := range cases {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1793,6 +1869,24 @@ func TestGateModular_GetGroupMembersHandler(t *testing.T) {
 				return true
 			},
 		},
+		{
+			name: "group members at height",
+			fn: func() *GateModular {
+				g := setup(t)
+				ctrl := gomock.NewController(t)
+				clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
+				clientMock.EXPECT().GetGroupMembersAtHeight(gomock.Any(), uint64(2), "", uint32(10), int64(100)).
+					Return([]*types.GroupMember{{AccountId: "0x76d32704A1f415a0a8139997Bb40978b9EEf031f"}}, nil).Times(1)
+				g.baseApp.SetGfSpClient(clientMock)
+				return g
+			},
+			request: func() *http.Request {
+				path := fmt.Sprintf("%s%s/?group-members&group-id=2&limit=10&at-height=100", scheme, testDomain)
+				req := httptest.NewRequest(http.MethodGet, path, strings.NewReader(""))
+				return req
+			},
+			wantedResult: "<AccountId>0x76d32704A1f415a0a8139997Bb40978b9EEf031f</AccountId>",
+		},
 	}
 	for _, tt := range cases {
 		t.Run(tt.name, func(t *testing.T) {
@@ -2304,6 +2398,25 @@ func TestGateModular_listObjectPoliciesHandler(t *testing.T) {
 			},
 			wantedCode: 404,
 		},
+		{
+			name: "policies at height",
+			fn: func() *GateModular {
+				g := setup(t)
+				ctrl := gomock.NewController(t)
+				clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
+				clientMock.EXPECT().ListObjectPoliciesAtHeight(gomock.Any(), mockObjectName, mockBucketName, uint64(0),
+					int32(6), uint32(10), int64(100)).Return(nil, nil).Times(1)
+				g.baseApp.SetGfSpClient(clientMock)
+				return g
+			},
+			request: func() *http.Request {
+				path := fmt.Sprintf("%s%s.%s/%s?%s&%s", scheme, mockBucketName, testDomain, mockObjectName, ListObjectPoliciesQuery,
+					"limit=10&action-type=6&at-height=100")
+				req := httptest.NewRequest(http.MethodGet, path, strings.NewReader(""))
+				return req
+			},
+			wantedCode: 200,
+		},
 	}
 	for _, tt := range cases {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,324 @@
+package gater
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/xml"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsperrors"
+	coremodule "github.com/bnb-chain/greenfield-storage-provider/core/module"
+	modelgateway "github.com/bnb-chain/greenfield-storage-provider/model/gateway"
+	metadatatypes "github.com/bnb-chain/greenfield-storage-provider/modular/metadata/types"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+	"github.com/bnb-chain/greenfield-storage-provider/util"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// notificationSecretSize defines the number of the random bytes of a generated notification secret
+const notificationSecretSize = 32
+
+// lookupIPAddr resolves the hosts of the webhook urls
+var lookupIPAddr = net.DefaultResolver.LookupIPAddr
+
+// NotificationConfiguration defines the notification rules of a bucket in the style of the s3 bucket notification
+type NotificationConfiguration struct {
+	XMLName               xml.Name                `xml:"NotificationConfiguration"`
+	Version               string                  `xml:"version,attr,omitempty"`
+	WebhookConfigurations []*WebhookConfiguration `xml:"WebhookConfiguration"`
+}
+
+// WebhookConfiguration defines a notification rule which posts the matched events to the webhook
+type WebhookConfiguration struct {
+	ID         string              `xml:"Id"`
+	URL        string              `xml:"Url"`
+	Secret     string              `xml:"Secret,omitempty"`
+	Events     []string            `xml:"Event"`
+	Filter     *NotificationFilter `xml:"Filter,omitempty"`
+	CreateTime int64               `xml:"CreateTime,omitempty"`
+}
+
+// NotificationFilter defines the object name filter of a notification rule
+type NotificationFilter struct {
+	Prefix string `xml:"Prefix,omitempty"`
+	Suffix string `xml:"Suffix,omitempty"`
+}
+
+// putBucketNotificationHandler handles the put bucket notification request, the rules in the request replace all
+// the notification rules of the bucket. Only the hashes of the secrets are stored, so the response is the only
+// place where the secrets, including the ones generated by the SP, are returned.
+func (g *GateModular) putBucketNotificationHandler(w http.ResponseWriter, r *http.Request) {
+	var (
+		err           error
+		reqCtx        *RequestContext
+		authenticated bool
+		body          []byte
+		rules         []*metadatatypes.NotificationRule
+		secrets       *NotificationConfiguration
+		bucketInfo    *storagetypes.BucketInfo
+	)
+	startTime := time.Now()
+	defer func() {
+		reqCtx.Cancel()
+		if err != nil {
+			reqCtx.SetError(gfsperrors.MakeGfSpError(err))
+			reqCtx.SetHTTPCode(int(gfsperrors.MakeGfSpError(err).GetHttpStatusCode()))
+			modelgateway.MakeErrorResponse(w, gfsperrors.MakeGfSpError(err))
+			metrics.ReqCounter.WithLabelValues(GatewayTotalFailure).Inc()
+			metrics.ReqTime.WithLabelValues(GatewayTotalFailure).Observe(time.Since(startTime).Seconds())
+		} else {
+			reqCtx.SetHTTPCode(http.StatusOK)
+			metrics.ReqCounter.WithLabelValues(GatewayTotalSuccess).Inc()
+			metrics.ReqTime.WithLabelValues(GatewayTotalSuccess).Observe(time.Since(startTime).Seconds())
+		}
+		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
+	}()
+
+	if reqCtx, err = NewRequestContext(r, g); err != nil {
+		return
+	}
+	authenticated, err = g.baseApp.GfSpClient().VerifyAuthentication(reqCtx.Context(),
+		coremodule.AuthOpTypeManageBucketNotification, reqCtx.Account(), reqCtx.bucketName, "")
+	if err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to verify authentication", "error", err)
+		return
+	}
+	if !authenticated {
+		log.CtxErrorw(reqCtx.Context(), "no permission to operate")
+		err = ErrNoPermission
+		return
+	}
+
+	if body, err = io.ReadAll(io.LimitReader(r.Body, MaxBucketNotificationConfigurationSize+1)); err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to read bucket notification configuration", "error", err)
+		err = ErrExceptionStream
+		return
+	}
+	if rules, secrets, err = parseNotificationConfiguration(reqCtx.Context(), body); err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to parse bucket notification configuration", "error", err)
+		return
+	}
+
+	if bucketInfo, err = g.baseApp.Consensus().QueryBucketInfo(reqCtx.Context(), reqCtx.bucketName); err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to get bucket info from consensus", "bucket_name", reqCtx.bucketName, "error", err)
+		err = ErrConsensusWithDetail("failed to get bucket info from consensus, bucket_name: " + reqCtx.bucketName + " ,error: " + err.Error())
+		return
+	}
+	if err = g.baseApp.GfSpClient().PutBucketNotification(reqCtx.Context(), reqCtx.bucketName,
+		bucketInfo.Id.Uint64(), rules); err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to put bucket notification", "error", err)
+		return
+	}
+
+	xmlBody, err := xml.Marshal(secrets)
+	if err != nil {
+		log.Errorw("failed to marshal xml", "error", err)
+		err = ErrEncodeResponseWithDetail("failed to marshal xml for put bucket notification, bucket_name: " + reqCtx.bucketName + " ,error: " + err.Error())
+		return
+	}
+	w.Header().Set(ContentTypeHeader, ContentTypeXMLHeaderValue)
+	if _, err = w.Write(xmlBody); err != nil {
+		log.Errorw("failed to write body", "error", err)
+		err = ErrEncodeResponseWithDetail("failed to write body for put bucket notification, bucket_name: " + reqCtx.bucketName + " ,error: " + err.Error())
+		return
+	}
+	log.CtxInfow(reqCtx.Context(), "succeed to put bucket notification", "rules", len(rules))
+}
+
+// getBucketNotificationHandler handles the get bucket notification request, the secrets of the rules are not returned.
+func (g *GateModular) getBucketNotificationHandler(w http.ResponseWriter, r *http.Request) {
+	var (
+		err           error
+		reqCtx        *RequestContext
+		authenticated bool
+		rules         []*metadatatypes.NotificationRule
+	)
+	startTime := time.Now()
+	defer func() {
+		reqCtx.Cancel()
+		if err != nil {
+			reqCtx.SetError(gfsperrors.MakeGfSpError(err))
+			reqCtx.SetHTTPCode(int(gfsperrors.MakeGfSpError(err).GetHttpStatusCode()))
+			modelgateway.MakeErrorResponse(w, gfsperrors.MakeGfSpError(err))
+			metrics.ReqCounter.WithLabelValues(GatewayTotalFailure).Inc()
+			metrics.ReqTime.WithLabelValues(GatewayTotalFailure).Observe(time.Since(startTime).Seconds())
+		} else {
+			reqCtx.SetHTTPCode(http.StatusOK)
+			metrics.ReqCounter.WithLabelValues(GatewayTotalSuccess).Inc()
+			metrics.ReqTime.WithLabelValues(GatewayTotalSuccess).Observe(time.Since(startTime).Seconds())
+		}
+		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
+	}()
+
+	if reqCtx, err = NewRequestContext(r, g); err != nil {
+		return
+	}
+	authenticated, err = g.baseApp.GfSpClient().VerifyAuthentication(reqCtx.Context(),
+		coremodule.AuthOpTypeManageBucketNotification, reqCtx.Account(), reqCtx.bucketName, "")
+	if err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to verify authentication", "error", err)
+		return
+	}
+	if !authenticated {
+		log.CtxErrorw(reqCtx.Context(), "no permission to operate")
+		err = ErrNoPermission
+		return
+	}
+
+	if rules, err = g.baseApp.GfSpClient().GetBucketNotification(reqCtx.Context(), reqCtx.bucketName); err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to get bucket notification", "error", err)
+		return
+	}
+
+	xmlInfo := &NotificationConfiguration{
+		Version:               GnfdResponseXMLVersion,
+		WebhookConfigurations: make([]*WebhookConfiguration, len(rules)),
+	}
+	for idx, rule := range rules {
+		webhook := &WebhookConfiguration{
+			ID:         rule.GetRuleId(),
+			URL:        rule.GetWebhookUrl(),
+			Events:     rule.GetEvents(),
+			CreateTime: rule.GetCreateTime(),
+		}
+		if rule.GetPrefix() != "" || rule.GetSuffix() != "" {
+			webhook.Filter = &NotificationFilter{Prefix: rule.GetPrefix(), Suffix: rule.GetSuffix()}
+		}
+		xmlInfo.WebhookConfigurations[idx] = webhook
+	}
+	xmlBody, err := xml.Marshal(xmlInfo)
+	if err != nil {
+		log.Errorw("failed to marshal xml", "error", err)
+		err = ErrEncodeResponseWithDetail("failed to marshal xml for get bucket notification, bucket_name: " + reqCtx.bucketName + " ,error: " + err.Error())
+		return
+	}
+	w.Header().Set(ContentTypeHeader, ContentTypeXMLHeaderValue)
+	if _, err = w.Write(xmlBody); err != nil {
+		log.Errorw("failed to write body", "error", err)
+		err = ErrEncodeResponseWithDetail("failed to write body for get bucket notification, bucket_name: " + reqCtx.bucketName + " ,error: " + err.Error())
+		return
+	}
+	log.CtxDebugw(reqCtx.Context(), "succeed to get bucket notification", "rules", len(rules))
+}
+
+// parseNotificationConfiguration decodes and validates the bucket notification configuration, the empty
+// configuration removes all the notification rules of the bucket. A secret is generated for the rule without one,
+// the rules keep the hashes of the secrets, and the secrets of the rules are returned in a configuration.
+func parseNotificationConfiguration(ctx context.Context, body []byte) ([]*metadatatypes.NotificationRule,
+	*NotificationConfiguration, error) {
+	if len(body) > MaxBucketNotificationConfigurationSize {
+		return nil, nil, ErrInvalidNotificationWithDetail("the notification configuration exceeds " +
+			strconv.Itoa(MaxBucketNotificationConfigurationSize) + " bytes")
+	}
+	config := &NotificationConfiguration{}
+	if err := xml.Unmarshal(body, config); err != nil {
+		return nil, nil, ErrInvalidNotificationWithDetail("failed to decode the notification configuration, error: " + err.Error())
+	}
+	if len(config.WebhookConfigurations) > MaxBucketNotificationRules {
+		return nil, nil, ErrInvalidNotificationWithDetail("the number of the webhook configurations exceeds " +
+			strconv.Itoa(MaxBucketNotificationRules))
+	}
+
+	ruleIDs := make(map[string]struct{}, len(config.WebhookConfigurations))
+	rules := make([]*metadatatypes.NotificationRule, len(config.WebhookConfigurations))
+	secrets := &NotificationConfiguration{
+		Version:               GnfdResponseXMLVersion,
+		WebhookConfigurations: make([]*WebhookConfiguration, len(config.WebhookConfigurations)),
+	}
+	for idx, webhook := range config.WebhookConfigurations {
+		if webhook.ID == "" || len(webhook.ID) > MaxBucketNotificationRuleIDLength {
+			return nil, nil, ErrInvalidNotificationWithDetail("the id of the webhook configuration must be 1 to " +
+				strconv.Itoa(MaxBucketNotificationRuleIDLength) + " characters")
+		}
+		if _, ok := ruleIDs[webhook.ID]; ok {
+			return nil, nil, ErrInvalidNotificationWithDetail("the id of the webhook configuration is repeated: " + webhook.ID)
+		}
+		ruleIDs[webhook.ID] = struct{}{}
+
+		webhookURL, err := url.Parse(webhook.URL)
+		if err != nil || (webhookURL.Scheme != "http" && webhookURL.Scheme != "https") || webhookURL.Host == "" ||
+			len(webhook.URL) > MaxBucketNotificationURLLength {
+			return nil, nil, ErrInvalidNotificationWithDetail("the url of the webhook configuration is invalid: " + webhook.URL)
+		}
+		if err = checkWebhookHost(ctx, webhookURL.Hostname()); err != nil {
+			return nil, nil, err
+		}
+		if len(webhook.Secret) > MaxBucketNotificationSecretLength {
+			return nil, nil, ErrInvalidNotificationWithDetail("the secret of the webhook configuration exceeds " +
+				strconv.Itoa(MaxBucketNotificationSecretLength) + " characters: " + webhook.ID)
+		}
+		if webhook.Secret == "" {
+			if webhook.Secret, err = generateNotificationSecret(); err != nil {
+				return nil, nil, err
+			}
+		}
+		if len(webhook.Events) == 0 ||
+			len(strings.Join(webhook.Events, bsdb.NotificationEventSeparator)) > MaxBucketNotificationFieldLength {
+			return nil, nil, ErrInvalidNotificationWithDetail("the events of the webhook configuration must be 1 to " +
+				strconv.Itoa(MaxBucketNotificationFieldLength) + " characters: " + webhook.ID)
+		}
+		for _, event := range webhook.Events {
+			if !bsdb.IsValidNotificationEvent(event) {
+				return nil, nil, ErrInvalidNotificationWithDetail("the event of the webhook configuration is invalid: " + event)
+			}
+		}
+
+		rule := &metadatatypes.NotificationRule{
+			RuleId:     webhook.ID,
+			Events:     webhook.Events,
+			WebhookUrl: webhook.URL,
+			Secret:     bsdb.HashNotificationSecret(webhook.Secret),
+		}
+		if webhook.Filter != nil {
+			if len(webhook.Filter.Prefix) > MaxBucketNotificationFieldLength ||
+				len(webhook.Filter.Suffix) > MaxBucketNotificationFieldLength {
+				return nil, nil, ErrInvalidNotificationWithDetail("the filter of the webhook configuration exceeds " +
+					strconv.Itoa(MaxBucketNotificationFieldLength) + " characters: " + webhook.ID)
+			}
+			rule.Prefix = webhook.Filter.Prefix
+			rule.Suffix = webhook.Filter.Suffix
+		}
+		rules[idx] = rule
+		secrets.WebhookConfigurations[idx] = &WebhookConfiguration{ID: webhook.ID, URL: webhook.URL, Secret: webhook.Secret}
+	}
+	return rules, secrets, nil
+}
+
+// checkWebhookHost checks that the webhook host only resolves to the public addresses, so the notifications can't
+// reach the services in the SP network. The dispatcher checks the address again when it connects to the webhook.
+func checkWebhookHost(ctx context.Context, host string) error {
+	if ip := net.ParseIP(host); ip != nil {
+		if !util.IsPublicIP(ip) {
+			return ErrInvalidNotificationWithDetail("the url of the webhook configuration points to a non-public address: " + host)
+		}
+		return nil
+	}
+	addrs, err := lookupIPAddr(ctx, host)
+	if err != nil || len(addrs) == 0 {
+		return ErrInvalidNotificationWithDetail("the host of the webhook configuration can't be resolved: " + host)
+	}
+	for _, addr := range addrs {
+		if !util.IsPublicIP(addr.IP) {
+			return ErrInvalidNotificationWithDetail("the url of the webhook configuration points to a non-public address: " + host)
+		}
+	}
+	return nil
+}
+
+// generateNotificationSecret returns a random hex encoded secret
+func generateNotificationSecret() (string, error) {
+	secret := make([]byte, notificationSecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", ErrInvalidNotificationWithDetail("failed to generate the secret of the webhook configuration, error: " + err.Error())
+	}
+	return hex.EncodeToString(secret), nil
+}
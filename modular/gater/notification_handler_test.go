@@ -0,0 +1,400 @@
+package gater
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	commonhttp "github.com/bnb-chain/greenfield-common/go/http"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspclient"
+	"github.com/bnb-chain/greenfield-storage-provider/core/consensus"
+	metadatatypes "github.com/bnb-chain/greenfield-storage-provider/modular/metadata/types"
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+const mockNotificationConfiguration = `<NotificationConfiguration>
+	<WebhookConfiguration>
+		<Id>images</Id>
+		<Url>https://example.com/hook</Url>
+		<Secret>secret</Secret>
+		<Event>ObjectCreated:*</Event>
+		<Event>ObjectRemoved:Delete</Event>
+		<Filter><Prefix>images/</Prefix><Suffix>.jpg</Suffix></Filter>
+	</WebhookConfiguration>
+</NotificationConfiguration>`
+
+// mockLookupIPAddr resolves example.com to a public address and internal.example.com to a private one
+func mockLookupIPAddr(t *testing.T) {
+	t.Helper()
+	lookup := lookupIPAddr
+	t.Cleanup(func() { lookupIPAddr = lookup })
+	lookupIPAddr = func(ctx context.Context, host string) ([]net.IPAddr, error) {
+		switch host {
+		case "example.com":
+			return []net.IPAddr{{IP: net.ParseIP("93.184.216.34")}}, nil
+		case "internal.example.com":
+			return []net.IPAddr{{IP: net.ParseIP("93.184.216.34")}, {IP: net.ParseIP("10.0.0.1")}}, nil
+		default:
+			return nil, errors.New("no such host")
+		}
+	}
+}
+
+func mockBucketNotificationRoute(t *testing.T, g *GateModular) *mux.Router {
+	t.Helper()
+	router := mux.NewRouter().SkipClean(true)
+	var routers []*mux.Router
+	routers = append(routers, router.Host("{bucket:.+}."+g.domain).Subrouter())
+	routers = append(routers, router.PathPrefix("/{bucket}").Subrouter())
+	for _, r := range routers {
+		r.NewRoute().Name(putBucketNotificationRouterName).Methods(http.MethodPut).Queries(BucketNotificationQuery, "").
+			HandlerFunc(g.putBucketNotificationHandler)
+		r.NewRoute().Name(getBucketNotificationRouterName).Methods(http.MethodGet).Queries(BucketNotificationQuery, "").
+			HandlerFunc(g.getBucketNotificationHandler)
+	}
+	return router
+}
+
+func mockBucketNotificationRequest(method, body string) *http.Request {
+	path := fmt.Sprintf("%s%s.%s?%s", scheme, mockBucketName, testDomain, BucketNotificationQuery)
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	validExpiryDateStr := time.Now().Add(time.Hour * 60).Format(ExpiryDateFormat)
+	req.Header.Set(commonhttp.HTTPHeaderExpiryTimestamp, validExpiryDateStr)
+	req.Header.Set(GnfdAuthorizationHeader, "GNFD1-EDDSA,Signature=48656c6c6f20476f7068657221")
+	return req
+}
+
+func TestGateModular_putBucketNotificationHandler(t *testing.T) {
+	mockLookupIPAddr(t)
+	cases := []struct {
+		name         string
+		fn           func() *GateModular
+		body         string
+		wantedResult string
+	}{
+		{
+			name: "failed to verify authentication",
+			fn: func() *GateModular {
+				g := setup(t)
+				ctrl := gomock.NewController(t)
+				clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
+				clientMock.EXPECT().VerifyGNFD1EddsaSignature(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any()).Return(false, nil).Times(1)
+				clientMock.EXPECT().VerifyAuthentication(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any()).Return(false, mockErr).Times(1)
+				g.baseApp.SetGfSpClient(clientMock)
+				return g
+			},
+			body:         mockNotificationConfiguration,
+			wantedResult: "mock error",
+		},
+		{
+			name: "no permission to operate",
+			fn: func() *GateModular {
+				g := setup(t)
+				ctrl := gomock.NewController(t)
+				clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
+				clientMock.EXPECT().VerifyGNFD1EddsaSignature(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any()).Return(false, nil).Times(1)
+				clientMock.EXPECT().VerifyAuthentication(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any()).Return(false, nil).Times(1)
+				g.baseApp.SetGfSpClient(clientMock)
+				return g
+			},
+			body:         mockNotificationConfiguration,
+			wantedResult: "no permission",
+		},
+		{
+			name: "invalid notification configuration",
+			fn: func() *GateModular {
+				g := setup(t)
+				ctrl := gomock.NewController(t)
+				clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
+				clientMock.EXPECT().VerifyGNFD1EddsaSignature(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any()).Return(false, nil).Times(1)
+				clientMock.EXPECT().VerifyAuthentication(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any()).Return(true, nil).Times(1)
+				g.baseApp.SetGfSpClient(clientMock)
+				return g
+			},
+			body:         strings.ReplaceAll(mockNotificationConfiguration, "ObjectRemoved:Delete", "ObjectRemoved:Unknown"),
+			wantedResult: "the event of the webhook configuration is invalid",
+		},
+		{
+			name: "failed to get bucket info from consensus",
+			fn: func() *GateModular {
+				g := setup(t)
+				ctrl := gomock.NewController(t)
+				clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
+				clientMock.EXPECT().VerifyGNFD1EddsaSignature(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any()).Return(false, nil).Times(1)
+				clientMock.EXPECT().VerifyAuthentication(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any()).Return(true, nil).Times(1)
+				g.baseApp.SetGfSpClient(clientMock)
+
+				consensusMock := consensus.NewMockConsensus(ctrl)
+				consensusMock.EXPECT().QueryBucketInfo(gomock.Any(), gomock.Any()).Return(nil, mockErr).Times(1)
+				g.baseApp.SetConsensus(consensusMock)
+				return g
+			},
+			body:         mockNotificationConfiguration,
+			wantedResult: "failed to get bucket info from consensus",
+		},
+		{
+			name: "failed to put bucket notification",
+			fn: func() *GateModular {
+				g := setup(t)
+				ctrl := gomock.NewController(t)
+				clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
+				clientMock.EXPECT().VerifyGNFD1EddsaSignature(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any()).Return(false, nil).Times(1)
+				clientMock.EXPECT().VerifyAuthentication(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any()).Return(true, nil).Times(1)
+				clientMock.EXPECT().PutBucketNotification(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(mockErr).Times(1)
+				g.baseApp.SetGfSpClient(clientMock)
+
+				consensusMock := consensus.NewMockConsensus(ctrl)
+				consensusMock.EXPECT().QueryBucketInfo(gomock.Any(), gomock.Any()).Return(&storagetypes.BucketInfo{
+					BucketName: mockBucketName, Id: sdkmath.NewUint(1)}, nil).Times(1)
+				g.baseApp.SetConsensus(consensusMock)
+				return g
+			},
+			body:         mockNotificationConfiguration,
+			wantedResult: "mock error",
+		},
+		{
+			name: "success",
+			fn: func() *GateModular {
+				g := setup(t)
+				ctrl := gomock.NewController(t)
+				clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
+				clientMock.EXPECT().VerifyGNFD1EddsaSignature(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any()).Return(false, nil).Times(1)
+				clientMock.EXPECT().VerifyAuthentication(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any()).Return(true, nil).Times(1)
+				clientMock.EXPECT().PutBucketNotification(gomock.Any(), mockBucketName, uint64(1), gomock.Any()).
+					DoAndReturn(func(_ any, _ string, _ uint64, rules []*metadatatypes.NotificationRule, _ ...any) error {
+						assert.Equal(t, 1, len(rules))
+						assert.Equal(t, "images", rules[0].GetRuleId())
+						assert.Equal(t, []string{"ObjectCreated:*", "ObjectRemoved:Delete"}, rules[0].GetEvents())
+						assert.Equal(t, "images/", rules[0].GetPrefix())
+						assert.Equal(t, ".jpg", rules[0].GetSuffix())
+						assert.Equal(t, bsdb.HashNotificationSecret("secret"), rules[0].GetSecret())
+						return nil
+					}).Times(1)
+				g.baseApp.SetGfSpClient(clientMock)
+
+				consensusMock := consensus.NewMockConsensus(ctrl)
+				consensusMock.EXPECT().QueryBucketInfo(gomock.Any(), gomock.Any()).Return(&storagetypes.BucketInfo{
+					BucketName: mockBucketName, Id: sdkmath.NewUint(1)}, nil).Times(1)
+				g.baseApp.SetConsensus(consensusMock)
+				return g
+			},
+			body:         mockNotificationConfiguration,
+			wantedResult: "<WebhookConfiguration><Id>images</Id><Url>https://example.com/hook</Url><Secret>secret</Secret></WebhookConfiguration>",
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			router := mockBucketNotificationRoute(t, tt.fn())
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, mockBucketNotificationRequest(http.MethodPut, tt.body))
+			assert.Contains(t, w.Body.String(), tt.wantedResult)
+		})
+	}
+}
+
+func TestGateModular_getBucketNotificationHandler(t *testing.T) {
+	cases := []struct {
+		name         string
+		fn           func() *GateModular
+		wantedResult string
+	}{
+		{
+			name: "no permission to operate",
+			fn: func() *GateModular {
+				g := setup(t)
+				ctrl := gomock.NewController(t)
+				clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
+				clientMock.EXPECT().VerifyGNFD1EddsaSignature(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any()).Return(false, nil).Times(1)
+				clientMock.EXPECT().VerifyAuthentication(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any()).Return(false, nil).Times(1)
+				g.baseApp.SetGfSpClient(clientMock)
+				return g
+			},
+			wantedResult: "no permission",
+		},
+		{
+			name: "failed to get bucket notification",
+			fn: func() *GateModular {
+				g := setup(t)
+				ctrl := gomock.NewController(t)
+				clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
+				clientMock.EXPECT().VerifyGNFD1EddsaSignature(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any()).Return(false, nil).Times(1)
+				clientMock.EXPECT().VerifyAuthentication(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any()).Return(true, nil).Times(1)
+				clientMock.EXPECT().GetBucketNotification(gomock.Any(), gomock.Any()).Return(nil, mockErr).Times(1)
+				g.baseApp.SetGfSpClient(clientMock)
+				return g
+			},
+			wantedResult: "mock error",
+		},
+		{
+			name: "success",
+			fn: func() *GateModular {
+				g := setup(t)
+				ctrl := gomock.NewController(t)
+				clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
+				clientMock.EXPECT().VerifyGNFD1EddsaSignature(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any()).Return(false, nil).Times(1)
+				clientMock.EXPECT().VerifyAuthentication(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any()).Return(true, nil).Times(1)
+				clientMock.EXPECT().GetBucketNotification(gomock.Any(), gomock.Any()).Return([]*metadatatypes.NotificationRule{{
+					RuleId:     "images",
+					Events:     []string{"ObjectCreated:*"},
+					Prefix:     "images/",
+					WebhookUrl: "https://example.com/hook",
+					CreateTime: 100,
+				}}, nil).Times(1)
+				g.baseApp.SetGfSpClient(clientMock)
+				return g
+			},
+			wantedResult: `<WebhookConfiguration><Id>images</Id><Url>https://example.com/hook</Url>` +
+				`<Event>ObjectCreated:*</Event><Filter><Prefix>images/</Prefix></Filter><CreateTime>100</CreateTime>` +
+				`</WebhookConfiguration>`,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			router := mockBucketNotificationRoute(t, tt.fn())
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, mockBucketNotificationRequest(http.MethodGet, ""))
+			assert.Contains(t, w.Body.String(), tt.wantedResult)
+		})
+	}
+}
+
+func TestParseNotificationConfiguration(t *testing.T) {
+	mockLookupIPAddr(t)
+	webhook := func(id, url, secret, event string) string {
+		return fmt.Sprintf("<WebhookConfiguration><Id>%s</Id><Url>%s</Url><Secret>%s</Secret><Event>%s</Event></WebhookConfiguration>",
+			id, url, secret, event)
+	}
+	cases := []struct {
+		name        string
+		body        string
+		wantedRules int
+		wantedErr   string
+	}{
+		{
+			name:        "empty configuration removes the rules",
+			body:        "<NotificationConfiguration></NotificationConfiguration>",
+			wantedRules: 0,
+		},
+		{
+			name:      "invalid xml",
+			body:      "<NotificationConfiguration>",
+			wantedErr: "failed to decode the notification configuration",
+		},
+		{
+			name:      "empty id",
+			body:      "<NotificationConfiguration>" + webhook("", "https://example.com", "secret", "ObjectCreated:*") + "</NotificationConfiguration>",
+			wantedErr: "the id of the webhook configuration",
+		},
+		{
+			name: "repeated id",
+			body: "<NotificationConfiguration>" + webhook("a", "https://example.com", "secret", "ObjectCreated:*") +
+				webhook("a", "https://example.com", "secret", "ObjectCreated:*") + "</NotificationConfiguration>",
+			wantedErr: "the id of the webhook configuration is repeated",
+		},
+		{
+			name:      "invalid url",
+			body:      "<NotificationConfiguration>" + webhook("a", "ftp://example.com", "secret", "ObjectCreated:*") + "</NotificationConfiguration>",
+			wantedErr: "the url of the webhook configuration is invalid",
+		},
+		{
+			name:      "loopback url",
+			body:      "<NotificationConfiguration>" + webhook("a", "http://127.0.0.1:8080", "secret", "ObjectCreated:*") + "</NotificationConfiguration>",
+			wantedErr: "points to a non-public address",
+		},
+		{
+			name:      "metadata url",
+			body:      "<NotificationConfiguration>" + webhook("a", "http://169.254.169.254/latest", "secret", "ObjectCreated:*") + "</NotificationConfiguration>",
+			wantedErr: "points to a non-public address",
+		},
+		{
+			name:      "url resolved to private address",
+			body:      "<NotificationConfiguration>" + webhook("a", "https://internal.example.com", "secret", "ObjectCreated:*") + "</NotificationConfiguration>",
+			wantedErr: "points to a non-public address",
+		},
+		{
+			name:      "unresolved url",
+			body:      "<NotificationConfiguration>" + webhook("a", "https://unknown.example.com", "secret", "ObjectCreated:*") + "</NotificationConfiguration>",
+			wantedErr: "can't be resolved",
+		},
+		{
+			name: "too long secret",
+			body: "<NotificationConfiguration>" + webhook("a", "https://example.com",
+				strings.Repeat("s", MaxBucketNotificationSecretLength+1), "ObjectCreated:*") + "</NotificationConfiguration>",
+			wantedErr: "the secret of the webhook configuration exceeds",
+		},
+		{
+			name:      "invalid event",
+			body:      "<NotificationConfiguration>" + webhook("a", "https://example.com", "secret", "*") + "</NotificationConfiguration>",
+			wantedErr: "the event of the webhook configuration is invalid",
+		},
+		{
+			name:        "success",
+			body:        "<NotificationConfiguration>" + webhook("a", "http://example.com", "secret", "BucketRemoved:Delete") + "</NotificationConfiguration>",
+			wantedRules: 1,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			rules, _, err := parseNotificationConfiguration(context.Background(), []byte(tt.body))
+			if tt.wantedErr != "" {
+				assert.Contains(t, err.Error(), tt.wantedErr)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.wantedRules, len(rules))
+		})
+	}
+
+	body := "<NotificationConfiguration>" + strings.Repeat(webhook("a", "https://example.com", "secret", "ObjectCreated:*"),
+		MaxBucketNotificationRules+1) + "</NotificationConfiguration>"
+	_, _, err := parseNotificationConfiguration(context.Background(), []byte(body))
+	assert.Contains(t, err.Error(), "exceeds")
+}
+
+func TestParseNotificationConfiguration_Secret(t *testing.T) {
+	mockLookupIPAddr(t)
+	body := "<NotificationConfiguration>" +
+		"<WebhookConfiguration><Id>a</Id><Url>https://example.com</Url><Secret>secret</Secret><Event>ObjectCreated:*</Event></WebhookConfiguration>" +
+		"<WebhookConfiguration><Id>b</Id><Url>https://example.com</Url><Event>ObjectCreated:*</Event></WebhookConfiguration>" +
+		"</NotificationConfiguration>"
+	rules, secrets, err := parseNotificationConfiguration(context.Background(), []byte(body))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(rules))
+	assert.Equal(t, "secret", secrets.WebhookConfigurations[0].Secret)
+	assert.Equal(t, bsdb.HashNotificationSecret("secret"), rules[0].GetSecret())
+	// the secret is generated if it's not given
+	generated := secrets.WebhookConfigurations[1].Secret
+	assert.Equal(t, 2*notificationSecretSize, len(generated))
+	assert.Equal(t, bsdb.HashNotificationSecret(generated), rules[1].GetSecret())
+}
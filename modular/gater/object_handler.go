@@ -4,7 +4,9 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -16,6 +18,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	commonhttp "github.com/bnb-chain/greenfield-common/go/http"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gnfd"
 	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsperrors"
 	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
 	coremodule "github.com/bnb-chain/greenfield-storage-provider/core/module"
@@ -24,6 +27,7 @@ import (
 	"github.com/bnb-chain/greenfield-storage-provider/modular/metadata"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
+	mwhttp "github.com/bnb-chain/greenfield-storage-provider/pkg/middleware/http"
 	"github.com/bnb-chain/greenfield-storage-provider/store/sqldb"
 	servicetypes "github.com/bnb-chain/greenfield-storage-provider/store/types"
 	"github.com/bnb-chain/greenfield-storage-provider/util"
@@ -120,6 +124,9 @@ func (g *GateModular) putObjectHandler(w http.ResponseWriter, r *http.Request) {
 		err = ErrConsensusWithDetail("failed to get storage params from consensus, object_name: " + reqCtx.objectName + ", bucket_name: " + reqCtx.bucketName + ", error: " + err.Error())
 		return
 	}
+	if err = reqCtx.checkBandwidthQuota(int64(objectInfo.GetPayloadSize())); err != nil {
+		return
+	}
 	task := &gfsptask.GfSpUploadObjectTask{}
 	task.InitUploadObjectTask(bucketInfo.GetGlobalVirtualGroupFamilyId(), objectInfo, params, g.baseApp.TaskTimeout(task, objectInfo.GetPayloadSize()), false)
 	task.SetCreateTime(uploadPrimaryStartTime.Unix())
@@ -301,6 +308,9 @@ func (g *GateModular) resumablePutObjectHandler(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	if err = reqCtx.checkBandwidthQuota(r.ContentLength); err != nil {
+		return
+	}
 	task := &gfsptask.GfSpResumableUploadObjectTask{}
 	task.InitResumableUploadObjectTask(bucketInfo.GetGlobalVirtualGroupFamilyId(), objectInfo, params, g.baseApp.TaskTimeout(task, objectInfo.GetPayloadSize()), complete, offset, false)
 	task.SetCreateTime(uploadPrimaryStartTime.Unix())
@@ -439,6 +449,10 @@ func (g *GateModular) getObjectHandler(w http.ResponseWriter, r *http.Request) {
 
 	// GNFD1-ECDSA or GNFD1-EDDSA authentication, by checking the headers.
 	reqCtx, reqCtxErr = NewRequestContext(r, g)
+	if errors.Is(reqCtxErr, mwhttp.ErrTooManyRequest) {
+		err = reqCtxErr
+		return
+	}
 
 	if err = s3util.CheckValidBucketName(reqCtx.bucketName); err != nil {
 		log.Errorw("failed to check bucket name", "bucket_name", reqCtx.bucketName, "error", err)
@@ -465,8 +479,9 @@ func (g *GateModular) getObjectHandler(w http.ResponseWriter, r *http.Request) {
 	metrics.PerfGetObjectTimeHistogram.WithLabelValues("get_object_verify_object_permission_time").Observe(time.Since(verifyObjectPermissionTime).Seconds())
 
 	if !authenticated {
-		// if not passed, then check authentication parameters
-		if reqCtxErr != nil {
+		// if not passed, then check the legacy off-chain auth parameters, the url of the presigned url scheme has been
+		// verified by the request context and doesn't fall back to the legacy flow
+		if reqCtxErr != nil && !reqCtx.isPresignedURL() {
 			queryParams := r.URL.Query()
 			gnfdUserParam := queryParams.Get(GnfdUserAddressHeader)
 			gnfdOffChainAuthAppDomainParam := queryParams.Get(GnfdOffChainAuthAppDomainHeader)
@@ -500,7 +515,7 @@ func (g *GateModular) getObjectHandler(w http.ResponseWriter, r *http.Request) {
 
 				if account != nil {
 					reqCtx.account = account.String()
-					reqCtxErr = nil
+					reqCtxErr = reqCtx.checkRequestLimit()
 					// default set content-disposition to download, if specified in query param as view, then set to view
 					w.Header().Set(ContentDispositionHeader, ContentDispositionAttachmentValue+"; filename=\""+url.QueryEscape(reqCtx.objectName)+"\"")
 					offChainAuthViewParam := queryParams.Get(OffChainAuthViewQuery)
@@ -553,8 +568,7 @@ func (g *GateModular) downloadObject(w http.ResponseWriter, reqCtx *RequestConte
 		rangeStart, rangeEnd      int64
 		lowOffset                 int64
 		highOffset                int64
-		pieceInfos                []*downloader.SegmentPieceInfo
-		pieceData                 []byte
+		reader                    io.ReadCloser
 		extraQuota, consumedQuota uint64
 		replyDataSize             int
 		dbUpdateTimeStamp         int64
@@ -583,7 +597,7 @@ func (g *GateModular) downloadObject(w http.ResponseWriter, reqCtx *RequestConte
 	metrics.PerfGetObjectTimeHistogram.WithLabelValues("get_object_get_object_info_time").Observe(time.Since(getObjectTime).Seconds())
 	if err != nil {
 		log.CtxErrorw(reqCtx.Context(), "failed to get object info from consensus", "error", err)
-		if strings.Contains(err.Error(), "No such object") {
+		if errors.Is(err, gnfd.ErrNoSuchObject) {
 			err = ErrConsensusNotFoundWithDetail("failed to get object info from consensus, the object may be deleted. object_name: " + reqCtx.objectName + ", bucket_name: " + reqCtx.bucketName + ", error:" + err.Error())
 		} else {
 			err = ErrConsensusWithDetail("failed to get object info from consensus, object_name: " + reqCtx.objectName + ", bucket_name: " + reqCtx.bucketName + ", error:" + err.Error())
@@ -626,10 +640,14 @@ func (g *GateModular) downloadObject(w http.ResponseWriter, reqCtx *RequestConte
 		highOffset = int64(objectInfo.GetPayloadSize()) - 1
 	}
 
+	if err = reqCtx.checkBandwidthQuota(highOffset - lowOffset + 1); err != nil {
+		return err
+	}
+
 	task := &gfsptask.GfSpDownloadObjectTask{}
 	task.InitDownloadObjectTask(objectInfo, bucketInfo, params, g.baseApp.TaskPriority(task), reqCtx.Account(),
 		lowOffset, highOffset, g.baseApp.TaskTimeout(task, uint64(highOffset-lowOffset+1)), g.baseApp.TaskMaxRetry(task))
-	if pieceInfos, err = downloader.SplitToSegmentPieceInfos(task, g.baseApp.PieceOp()); err != nil {
+	if _, err = downloader.SplitToSegmentPieceInfos(task, g.baseApp.PieceOp()); err != nil {
 		log.CtxErrorw(reqCtx.Context(), "failed to download object", "error", err)
 		return err
 	}
@@ -645,42 +663,48 @@ func (g *GateModular) downloadObject(w http.ResponseWriter, reqCtx *RequestConte
 	consumedQuota = 0
 	extraQuota = 0
 	downloadSize := uint64(highOffset - lowOffset + 1)
-	for idx, pInfo := range pieceInfos {
-		enableCheck := false
-		if idx == 0 { // only check in first piece
-			enableCheck = true
-			dbUpdateTimeStamp = sqldb.GetCurrentTimestampUs()
+	dbUpdateTimeStamp = sqldb.GetCurrentTimestampUs()
+	getStreamTime := time.Now()
+	reader, err = g.baseApp.GfSpClient().GetObjectStream(reqCtx.Context(), task)
+	metrics.PerfGetObjectTimeHistogram.WithLabelValues("get_object_open_stream_time").Observe(time.Since(getStreamTime).Seconds())
+	if err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to download object stream", "error", err)
+		downloaderErr := gfsperrors.MakeGfSpError(err)
+		// the quota has been deducted if it fails to get data from the piece store
+		if downloaderErr.GetInnerCode() == 85101 || downloaderErr.GetInnerCode() == 85102 {
+			extraQuota = downloadSize
 		}
-		pieceTask := &gfsptask.GfSpDownloadPieceTask{}
-		pieceTask.InitDownloadPieceTask(objectInfo, bucketInfo, params, g.baseApp.TaskPriority(task),
-			enableCheck, reqCtx.Account(), downloadSize, pInfo.SegmentPieceKey, pInfo.Offset,
-			pInfo.Length, g.baseApp.TaskTimeout(task, uint64(pieceTask.GetSize())), g.baseApp.TaskMaxRetry(task))
-		getSegmentTime := time.Now()
-		pieceData, err = g.baseApp.GfSpClient().GetPiece(reqCtx.Context(), pieceTask)
-
-		metrics.PerfGetObjectTimeHistogram.WithLabelValues("get_object_segment_data_time").Observe(time.Since(getSegmentTime).Seconds())
-		if err != nil {
-			log.CtxErrorw(reqCtx.Context(), "failed to download piece", "error", err)
-			downloaderErr := gfsperrors.MakeGfSpError(err)
-			// if it is the first piece and the quota db is not updated, no extra data need to updated
-			if idx >= 1 || (idx == 0 && (downloaderErr.GetInnerCode() == 85101 || downloaderErr.GetInnerCode() == 85102)) {
+		return err
+	}
+	defer reader.Close()
+
+	// the data is piped segment by segment, the downloader is blocked by the flow control if the client reads slowly
+	buf := make([]byte, ReplyObjectBufSize)
+	for {
+		n, readErr := reader.Read(buf)
+		if n > 0 {
+			writeTime := time.Now()
+			replyDataSize, err = w.Write(buf[:n])
+			// if the connection of client has been disconnected, the response will fail
+			if err != nil {
+				log.CtxErrorw(reqCtx.Context(), "failed to write the data to connection", "objectName", objectInfo.ObjectName, "error", err)
 				extraQuota = downloadSize - consumedQuota
+				err = ErrReplyData
+				return err
 			}
-			return err
+			// the quota value should be computed by the reply content length
+			consumedQuota += uint64(replyDataSize)
+			metrics.PerfGetObjectTimeHistogram.WithLabelValues("get_object_write_time").Observe(time.Since(writeTime).Seconds())
 		}
-
-		writeTime := time.Now()
-		replyDataSize, err = w.Write(pieceData)
-		// if the connection of client has been disconnected, the response will fail
-		if err != nil {
-			log.CtxErrorw(reqCtx.Context(), "failed to write the data to connection", "objectName", objectInfo.ObjectName, "error", err)
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			log.CtxErrorw(reqCtx.Context(), "failed to read object stream", "error", readErr)
 			extraQuota = downloadSize - consumedQuota
-			err = ErrReplyData
+			err = readErr
 			return err
 		}
-		// the quota value should be computed by the reply content length
-		consumedQuota += uint64(replyDataSize)
-		metrics.PerfGetObjectTimeHistogram.WithLabelValues("get_object_write_time").Observe(time.Since(writeTime).Seconds())
 	}
 
 	metrics.ReqPieceSize.WithLabelValues(GatewayGetObjectSize).Observe(float64(highOffset - lowOffset + 1))
@@ -836,8 +860,10 @@ func (g *GateModular) getObjectByUniversalEndpointHandler(w http.ResponseWriter,
 	userAgent := r.Header.Get("User-Agent")
 	isRequestFromBrowser = checkIfRequestFromBrowser(userAgent)
 
-	// ignore the error, because the universal endpoint does not need signature
-	reqCtx, _ = NewRequestContext(r, g)
+	// ignore the signature error, because the universal endpoint does not need signature
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
 
 	if err = s3util.CheckValidBucketName(reqCtx.bucketName); err != nil {
 		log.Errorw("failed to check bucket name", "bucket_name", reqCtx.bucketName, "error", err)
@@ -1155,7 +1181,7 @@ func (g *GateModular) delegatePutObjectHandler(w http.ResponseWriter, r *http.Re
 	} else {
 		// if object has been created, we can skip the creation process
 		objectInfo, err = g.baseApp.Consensus().QueryObjectInfo(reqCtx.ctx, reqCtx.bucketName, reqCtx.objectName)
-		if err != nil && !strings.Contains(err.Error(), "No such object") {
+		if err != nil && !errors.Is(err, gnfd.ErrNoSuchObject) {
 			log.CtxErrorw(reqCtx.ctx, "failed to QueryObjectInfo", "error", err)
 			return
 		}
@@ -1236,6 +1262,9 @@ func (g *GateModular) delegatePutObjectHandler(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	if err = reqCtx.checkBandwidthQuota(int64(objectInfo.GetPayloadSize())); err != nil {
+		return
+	}
 	uploadTask := &gfsptask.GfSpUploadObjectTask{}
 	uploadTask.InitUploadObjectTask(bucketInfo.GetGlobalVirtualGroupFamilyId(), objectInfo, params, g.baseApp.TaskTimeout(uploadTask, objectInfo.GetPayloadSize()), true)
 	uploadTask.SetCreateTime(uploadPrimaryStartTime.Unix())
@@ -1420,7 +1449,7 @@ func (g *GateModular) delegateResumablePutObjectHandler(w http.ResponseWriter, r
 		}, fingerprint, g.baseApp.TaskPriority(task))
 
 		objectInfo, err = g.baseApp.Consensus().QueryObjectInfo(reqCtx.ctx, reqCtx.bucketName, reqCtx.objectName)
-		if err != nil && strings.Contains(err.Error(), "No such object") {
+		if err != nil && errors.Is(err, gnfd.ErrNoSuchObject) {
 			startAskCreateObjectApproval := time.Now()
 			authenticated, _, err = g.baseApp.GfSpClient().AskDelegateCreateObjectApproval(reqCtx.Context(), task)
 			metrics.PerfApprovalTime.WithLabelValues("gateway_delegate_create_object_cost").Observe(time.Since(startAskCreateObjectApproval).Seconds())
@@ -1507,6 +1536,9 @@ func (g *GateModular) delegateResumablePutObjectHandler(w http.ResponseWriter, r
 		return
 	}
 
+	if err = reqCtx.checkBandwidthQuota(r.ContentLength); err != nil {
+		return
+	}
 	uploadTask := &gfsptask.GfSpResumableUploadObjectTask{}
 	uploadTask.InitResumableUploadObjectTask(bucketInfo.GetGlobalVirtualGroupFamilyId(), objectInfo, params, g.baseApp.TaskTimeout(uploadTask, objectInfo.GetPayloadSize()), complete, offset, true)
 	uploadTask.SetCreateTime(uploadPrimaryStartTime.Unix())
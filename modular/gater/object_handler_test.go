@@ -3,11 +3,13 @@ package gater
 import (
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"strings"
 	"testing"
+	"testing/iotest"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts"
@@ -1424,7 +1426,7 @@ func TestGateModular_getObjectHandler(t *testing.T) {
 				var a = permissiontypes.EFFECT_ALLOW
 				clientMock.EXPECT().VerifyPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(&a, nil).Times(1)
-				clientMock.EXPECT().GetPiece(gomock.Any(), gomock.Any()).Return(nil, mockErr).AnyTimes()
+				clientMock.EXPECT().GetObjectStream(gomock.Any(), gomock.Any()).Return(nil, mockErr).AnyTimes()
 				g.baseApp.SetGfSpClient(clientMock)
 
 				consensusMock := consensus.NewMockConsensus(ctrl)
@@ -1467,7 +1469,7 @@ func TestGateModular_getObjectHandler(t *testing.T) {
 				var a = permissiontypes.EFFECT_ALLOW
 				clientMock.EXPECT().VerifyPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(&a, nil).Times(1)
-				clientMock.EXPECT().GetPiece(gomock.Any(), gomock.Any()).Return([]byte("a"), nil).AnyTimes()
+				clientMock.EXPECT().GetObjectStream(gomock.Any(), gomock.Any()).Return(io.NopCloser(strings.NewReader("a")), nil).AnyTimes()
 				g.baseApp.SetGfSpClient(clientMock)
 
 				consensusMock := consensus.NewMockConsensus(ctrl)
@@ -1499,6 +1501,52 @@ func TestGateModular_getObjectHandler(t *testing.T) {
 			},
 			wantedResult: "",
 		},
+		{
+			name: "failed to read object stream",
+			fn: func() *GateModular {
+				g := setup(t)
+				ctrl := gomock.NewController(t)
+				clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
+				clientMock.EXPECT().VerifyGNFD1EddsaSignature(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any()).Return(false, mockErr).Times(1)
+				var a = permissiontypes.EFFECT_ALLOW
+				clientMock.EXPECT().VerifyPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&a, nil).Times(1)
+				clientMock.EXPECT().GetObjectStream(gomock.Any(), gomock.Any()).Return(io.NopCloser(
+					io.MultiReader(strings.NewReader("a"), iotest.ErrReader(mockErr))), nil).Times(1)
+				// the unread data is recouped to the bucket quota
+				clientMock.EXPECT().RecoupQuota(gomock.Any(), uint64(2), uint64(9), gomock.Any()).Return(nil).Times(1)
+				g.baseApp.SetGfSpClient(clientMock)
+
+				consensusMock := consensus.NewMockConsensus(ctrl)
+				consensusMock.EXPECT().QueryObjectInfo(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+					&storagetypes.ObjectInfo{
+						Id:          sdkmath.NewUint(1),
+						PayloadSize: 10,
+					}, nil).Times(1)
+				consensusMock.EXPECT().QueryBucketInfo(gomock.Any(), gomock.Any()).Return(&storagetypes.BucketInfo{
+					Id: sdkmath.NewUint(2)}, nil).Times(1)
+				consensusMock.EXPECT().QueryStorageParamsByTimestamp(gomock.Any(), gomock.Any()).Return(
+					&storagetypes.Params{MaxPayloadSize: 10}, nil).Times(1)
+				g.baseApp.SetConsensus(consensusMock)
+
+				pieceOpMock := piecestore.NewMockPieceOp(ctrl)
+				g.baseApp.SetPieceOp(pieceOpMock)
+				pieceOpMock.EXPECT().SegmentPieceCount(gomock.Any(), gomock.Any()).Return(uint32(1)).Times(1)
+				pieceOpMock.EXPECT().SegmentPieceKey(gomock.Any(), gomock.Any(), gomock.Any()).Return("test").AnyTimes()
+				return g
+			},
+			request: func() *http.Request {
+				path := fmt.Sprintf("%s%s.%s/%s", scheme, mockBucketName, testDomain, mockObjectName)
+				req := httptest.NewRequest(http.MethodGet, path, strings.NewReader(""))
+				validExpiryDateStr := time.Now().Add(time.Hour * 60).Format(ExpiryDateFormat)
+				req.Header.Set(commonhttp.HTTPHeaderExpiryTimestamp, validExpiryDateStr)
+				req.Header.Set(RangeHeader, "bytes=-1")
+				req.Header.Set(GnfdAuthorizationHeader, "GNFD1-EDDSA,Signature=48656c6c6f20476f7068657221")
+				return req
+			},
+			wantedResult: "mock error",
+		},
 		{
 			name: "failed to check bucket name",
 			fn: func() *GateModular {
@@ -2037,7 +2085,7 @@ func TestGateModular_getObjectByUniversalEndpointHandler(t *testing.T) {
 				var a = permissiontypes.EFFECT_ALLOW
 				clientMock.EXPECT().VerifyPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(&a, nil).Times(0) // public file, so no need to verify permission
-				clientMock.EXPECT().GetPiece(gomock.Any(), gomock.Any()).Return([]byte("a"), nil).AnyTimes()
+				clientMock.EXPECT().GetObjectStream(gomock.Any(), gomock.Any()).Return(io.NopCloser(strings.NewReader("a")), nil).AnyTimes()
 				g.baseApp.SetGfSpClient(clientMock)
 
 				consensusMock := consensus.NewMockConsensus(ctrl)
@@ -2089,7 +2137,7 @@ func TestGateModular_getObjectByUniversalEndpointHandler(t *testing.T) {
 				var a = permissiontypes.EFFECT_ALLOW
 				clientMock.EXPECT().VerifyPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(&a, nil).Times(0) // public file, so no need to verify permission
-				clientMock.EXPECT().GetPiece(gomock.Any(), gomock.Any()).Return([]byte("a"), nil).AnyTimes()
+				clientMock.EXPECT().GetObjectStream(gomock.Any(), gomock.Any()).Return(io.NopCloser(strings.NewReader("a")), nil).AnyTimes()
 				g.baseApp.SetGfSpClient(clientMock)
 
 				consensusMock := consensus.NewMockConsensus(ctrl)
@@ -2141,7 +2189,7 @@ func TestGateModular_getObjectByUniversalEndpointHandler(t *testing.T) {
 				var a = permissiontypes.EFFECT_ALLOW
 				clientMock.EXPECT().VerifyPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(&a, nil).Times(0) // public file, so no need to verify permission
-				clientMock.EXPECT().GetPiece(gomock.Any(), gomock.Any()).Return([]byte("a"), nil).AnyTimes()
+				clientMock.EXPECT().GetObjectStream(gomock.Any(), gomock.Any()).Return(io.NopCloser(strings.NewReader("a")), nil).AnyTimes()
 				g.baseApp.SetGfSpClient(clientMock)
 
 				consensusMock := consensus.NewMockConsensus(ctrl)
@@ -2193,7 +2241,7 @@ func TestGateModular_getObjectByUniversalEndpointHandler(t *testing.T) {
 				var a = permissiontypes.EFFECT_ALLOW
 				clientMock.EXPECT().VerifyPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(&a, nil).Times(0) // public file, so no need to verify permission
-				clientMock.EXPECT().GetPiece(gomock.Any(), gomock.Any()).Return([]byte("a"), nil).AnyTimes()
+				clientMock.EXPECT().GetObjectStream(gomock.Any(), gomock.Any()).Return(io.NopCloser(strings.NewReader("a")), nil).AnyTimes()
 				g.baseApp.SetGfSpClient(clientMock)
 
 				consensusMock := consensus.NewMockConsensus(ctrl)
@@ -2234,7 +2282,7 @@ func TestGateModular_getObjectByUniversalEndpointHandler(t *testing.T) {
 				var a = permissiontypes.EFFECT_ALLOW
 				clientMock.EXPECT().VerifyPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(&a, nil).Times(0) // can't reach here, so no need to verify permission
-				clientMock.EXPECT().GetPiece(gomock.Any(), gomock.Any()).Return([]byte("a"), nil).AnyTimes()
+				clientMock.EXPECT().GetObjectStream(gomock.Any(), gomock.Any()).Return(io.NopCloser(strings.NewReader("a")), nil).AnyTimes()
 				g.baseApp.SetGfSpClient(clientMock)
 
 				consensusMock := consensus.NewMockConsensus(ctrl)
@@ -2281,7 +2329,7 @@ func TestGateModular_getObjectByUniversalEndpointHandler(t *testing.T) {
 				var a = permissiontypes.EFFECT_ALLOW
 				clientMock.EXPECT().VerifyPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(&a, nil).Times(0) // can't reach here, so no need to verify permission
-				clientMock.EXPECT().GetPiece(gomock.Any(), gomock.Any()).Return([]byte("a"), nil).AnyTimes()
+				clientMock.EXPECT().GetObjectStream(gomock.Any(), gomock.Any()).Return(io.NopCloser(strings.NewReader("a")), nil).AnyTimes()
 				g.baseApp.SetGfSpClient(clientMock)
 
 				consensusMock := consensus.NewMockConsensus(ctrl)
@@ -2328,7 +2376,7 @@ func TestGateModular_getObjectByUniversalEndpointHandler(t *testing.T) {
 				var a = permissiontypes.EFFECT_ALLOW
 				clientMock.EXPECT().VerifyPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(&a, nil).Times(0) // can't reach here, so no need to verify permission
-				clientMock.EXPECT().GetPiece(gomock.Any(), gomock.Any()).Return([]byte("a"), nil).AnyTimes()
+				clientMock.EXPECT().GetObjectStream(gomock.Any(), gomock.Any()).Return(io.NopCloser(strings.NewReader("a")), nil).AnyTimes()
 				g.baseApp.SetGfSpClient(clientMock)
 
 				consensusMock := consensus.NewMockConsensus(ctrl)
@@ -2377,7 +2425,7 @@ func TestGateModular_getObjectByUniversalEndpointHandler(t *testing.T) {
 				var a = permissiontypes.EFFECT_ALLOW
 				clientMock.EXPECT().VerifyPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(&a, nil).Times(0) // can't reach here, so no need to verify permission
-				clientMock.EXPECT().GetPiece(gomock.Any(), gomock.Any()).Return([]byte("a"), nil).AnyTimes()
+				clientMock.EXPECT().GetObjectStream(gomock.Any(), gomock.Any()).Return(io.NopCloser(strings.NewReader("a")), nil).AnyTimes()
 				g.baseApp.SetGfSpClient(clientMock)
 
 				consensusMock := consensus.NewMockConsensus(ctrl)
@@ -2438,7 +2486,7 @@ func TestGateModular_getObjectByUniversalEndpointHandler(t *testing.T) {
 				var a = permissiontypes.EFFECT_ALLOW
 				clientMock.EXPECT().VerifyPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(&a, nil).Times(0) // can't reach here, so no need to verify permission
-				clientMock.EXPECT().GetPiece(gomock.Any(), gomock.Any()).Return([]byte("a"), nil).AnyTimes()
+				clientMock.EXPECT().GetObjectStream(gomock.Any(), gomock.Any()).Return(io.NopCloser(strings.NewReader("a")), nil).AnyTimes()
 				g.baseApp.SetGfSpClient(clientMock)
 
 				consensusMock := consensus.NewMockConsensus(ctrl)
@@ -2492,7 +2540,7 @@ func TestGateModular_getObjectByUniversalEndpointHandler(t *testing.T) {
 				var a = permissiontypes.EFFECT_ALLOW
 				clientMock.EXPECT().VerifyPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(&a, nil).Times(0) // can't reach here, so no need to verify permission
-				clientMock.EXPECT().GetPiece(gomock.Any(), gomock.Any()).Return([]byte("a"), nil).AnyTimes()
+				clientMock.EXPECT().GetObjectStream(gomock.Any(), gomock.Any()).Return(io.NopCloser(strings.NewReader("a")), nil).AnyTimes()
 				g.baseApp.SetGfSpClient(clientMock)
 
 				consensusMock := consensus.NewMockConsensus(ctrl)
@@ -2626,7 +2674,7 @@ func TestGateModular_getObjectByUniversalEndpointHandler(t *testing.T) {
 					nil).Times(1)
 				clientMock.EXPECT().VerifyAuthentication(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 					gomock.Any()).Return(true, nil)
-				clientMock.EXPECT().GetPiece(gomock.Any(), gomock.Any()).Return([]byte("a"), nil).AnyTimes()
+				clientMock.EXPECT().GetObjectStream(gomock.Any(), gomock.Any()).Return(io.NopCloser(strings.NewReader("a")), nil).AnyTimes()
 				g.baseApp.SetGfSpClient(clientMock)
 
 				consensusMock := consensus.NewMockConsensus(ctrl)
@@ -2697,7 +2745,7 @@ func TestGateModular_getObjectByUniversalEndpointHandler(t *testing.T) {
 				clientMock.EXPECT().VerifyAuthentication(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 					gomock.Any()).Return(true, nil)
 
-				clientMock.EXPECT().GetPiece(gomock.Any(), gomock.Any()).Return(nil, downloader.ErrExceedBucketQuota).AnyTimes()
+				clientMock.EXPECT().GetObjectStream(gomock.Any(), gomock.Any()).Return(nil, downloader.ErrExceedBucketQuota).AnyTimes()
 				g.baseApp.SetGfSpClient(clientMock)
 
 				consensusMock := consensus.NewMockConsensus(ctrl)
@@ -3,8 +3,11 @@ package gater
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -17,6 +20,7 @@ import (
 	commonhash "github.com/bnb-chain/greenfield-common/go/hash"
 	commonhttp "github.com/bnb-chain/greenfield-common/go/http"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	mwhttp "github.com/bnb-chain/greenfield-storage-provider/pkg/middleware/http"
 )
 
 // RequestContext generates from http request, it records the common info
@@ -53,6 +57,12 @@ var skipAuthRouterNames = []string{
 	listObjectsByIDsRouterName,
 }
 
+// presignedURLRouterNames defines the routers which accept the presigned url.
+var presignedURLRouterNames = []string{
+	getObjectRouterName,
+	putObjectRouterName,
+}
+
 // NewRequestContext returns an instance of RequestContext, and verify the
 // request signature, returns the instance regardless of the success or
 // failure of the verification.
@@ -79,19 +89,75 @@ func NewRequestContext(r *http.Request, g *GateModular) (*RequestContext, error)
 		return reqCtx, nil
 	}
 
-	err := reqCtx.CheckIfSigExpiry()
+	var (
+		account string
+		err     error
+	)
+	if reqCtx.isPresignedURL() {
+		account, err = reqCtx.VerifyPresignedURL()
+	} else {
+		if err = reqCtx.CheckIfSigExpiry(); err != nil {
+			return reqCtx, err
+		}
+		account, err = reqCtx.VerifySignature()
+	}
 	if err != nil {
 		return reqCtx, err
 	}
+	reqCtx.account = account
+	return reqCtx, reqCtx.checkRequestLimit()
+}
 
-	account, err := reqCtx.VerifySignature()
-	if err != nil {
+// newOptionalAuthRequestContext returns the RequestContext of the routers which serve the requests regardless of the
+// signature, the verification failure is ignored but the rate limit of the verified account is still enforced.
+func newOptionalAuthRequestContext(r *http.Request, g *GateModular) (*RequestContext, error) {
+	reqCtx, err := NewRequestContext(r, g)
+	if errors.Is(err, mwhttp.ErrTooManyRequest) {
 		return reqCtx, err
 	}
-	reqCtx.account = account
 	return reqCtx, nil
 }
 
+// checkRequestLimit checks the limits of the verified account and of the bucket, they are charged only after the
+// request is authenticated.
+func (r *RequestContext) checkRequestLimit() error {
+	if err := r.checkAccountLimit(); err != nil {
+		return err
+	}
+	return r.checkBucketLimit()
+}
+
+// checkBucketLimit checks whether the authenticated requests of the bucket reach the limit.
+func (r *RequestContext) checkBucketLimit() error {
+	if r.account == "" {
+		return nil
+	}
+	if !mwhttp.BucketAllow(r.Context(), r.bucketName) {
+		log.CtxErrorw(r.Context(), "failed to pass the rate limit of the bucket", "bucket", r.bucketName)
+		return mwhttp.ErrTooManyRequest
+	}
+	return nil
+}
+
+// checkAccountLimit checks whether the requests of the verified account reach the limit.
+func (r *RequestContext) checkAccountLimit() error {
+	if !mwhttp.AccountAllow(r.Context(), r.account) {
+		log.CtxErrorw(r.Context(), "failed to pass the rate limit of the account", "account", r.account)
+		return mwhttp.ErrTooManyRequest
+	}
+	return nil
+}
+
+// checkBandwidthQuota consumes the bandwidth quota of the account by the size of the transferred payload, returns
+// ErrBandwidthQuotaExceeded if the quota of the current period has been exhausted.
+func (r *RequestContext) checkBandwidthQuota(size int64) error {
+	if !mwhttp.BandwidthAllow(r.Context(), r.account, size) {
+		log.CtxErrorw(r.Context(), "failed to pass the bandwidth quota of the account", "account", r.account, "size", size)
+		return mwhttp.ErrBandwidthQuotaExceeded
+	}
+	return nil
+}
+
 // Context returns the RequestContext runtime context.
 func (r *RequestContext) Context() context.Context {
 	return r.ctx
@@ -195,6 +261,11 @@ func (r *RequestContext) CheckIfSigExpiry() error {
 	if requestExpiredTimestamp == "" {
 		requestExpiredTimestamp = r.request.URL.Query().Get(commonhttp.HTTPHeaderExpiryTimestamp)
 	}
+	return checkExpiryTimestamp(requestExpiredTimestamp)
+}
+
+// checkExpiryTimestamp checks the expiry is in the future and within MaxExpiryAgeInSec.
+func checkExpiryTimestamp(requestExpiredTimestamp string) error {
 	expiryDate, parseErr := time.Parse(ExpiryDateFormat, requestExpiredTimestamp)
 	if parseErr != nil {
 		return ErrInvalidExpiryDateHeader
@@ -206,6 +277,147 @@ func (r *RequestContext) CheckIfSigExpiry() error {
 	return nil
 }
 
+// isPresignedURL returns whether the request is authorized by the presigned url, whose signature, expiry, allowed
+// method and range are encoded in the query params instead of the headers. The presigned url is enabled by
+// EnablePresignedURL, the legacy off-chain auth urls of getting object carry no PresignedMethodQuery, so they are
+// still verified by the legacy flow of getObjectHandler.
+func (r *RequestContext) isPresignedURL() bool {
+	return r.g.enablePresignedURL && r.request.URL.Query().Get(PresignedMethodQuery) != ""
+}
+
+// checkPresignedExpiry checks the expiry of the presigned url, which is only read from the signed query param. The
+// url is rejected if the param is missing or the unsigned expiry header differs from it, otherwise the expired url
+// could be replayed with a new expiry header.
+func (r *RequestContext) checkPresignedExpiry(queryParams url.Values) error {
+	expiry := queryParams.Get(commonhttp.HTTPHeaderExpiryTimestamp)
+	if expiry == "" {
+		return ErrInvalidPresignedURL
+	}
+	if header := r.request.Header.Get(commonhttp.HTTPHeaderExpiryTimestamp); header != "" && header != expiry {
+		log.CtxErrorw(r.Context(), "failed to check the expiry of presigned url", "query", expiry, "header", header)
+		return ErrInvalidPresignedURL
+	}
+	return checkExpiryTimestamp(expiry)
+}
+
+// checkPresignedContentLength checks the payload of putting object matches the signed content length, so the holder
+// of the url can't upload the content of another size.
+func (r *RequestContext) checkPresignedContentLength(queryParams url.Values) error {
+	if r.request.Method != http.MethodPut {
+		return nil
+	}
+	contentLength, err := strconv.ParseInt(queryParams.Get(PresignedContentLengthQuery), 10, 64)
+	if err != nil || contentLength < 0 {
+		return ErrInvalidPresignedURL
+	}
+	if r.request.ContentLength != contentLength {
+		log.CtxErrorw(r.Context(), "failed to check the content length of presigned url", "signed", contentLength,
+			"request", r.request.ContentLength)
+		return ErrPresignedContentLengthMismatch
+	}
+	return nil
+}
+
+// GetMsgToSignForPresignedURL returns the msg to sign of the presigned url, which consists of the method, path,
+// query params except the authorization and host. The headers are not signed, so the url can be requested by the
+// browsers and CDNs which add their own headers.
+func GetMsgToSignForPresignedURL(req *http.Request) []byte {
+	queryValues := req.URL.Query()
+	queryValues.Del(commonhttp.HTTPHeaderAuthorization)
+	canonicalRequest := strings.Join([]string{
+		PresignedURLSignScope,
+		req.Method,
+		commonhttp.EncodePath(req.URL.Path),
+		strings.ReplaceAll(queryValues.Encode(), "+", "%20"),
+		commonhttp.GetHostInfo(req),
+	}, "\n")
+	return crypto.Keccak256([]byte(canonicalRequest))
+}
+
+// VerifyPresignedURL verifies the presigned url of getting or putting object, returns the account who signs the url.
+// The expiry is only read from the signed query param, so the url is valid for MaxExpiryAgeInSec at most, the url is
+// signed by GNFD1-ECDSA with the account key or GNFD2-EDDSA with the registered off-chain auth key.
+func (r *RequestContext) VerifyPresignedURL() (string, error) {
+	if !slices.Contains(presignedURLRouterNames, r.routerName) {
+		return "", ErrPresignedURLNotAllow
+	}
+	queryParams := r.request.URL.Query()
+	if !strings.EqualFold(queryParams.Get(PresignedMethodQuery), r.request.Method) {
+		return "", ErrPresignedMethodMismatch
+	}
+	if err := r.checkPresignedExpiry(queryParams); err != nil {
+		return "", err
+	}
+	if err := r.checkPresignedContentLength(queryParams); err != nil {
+		return "", err
+	}
+	account := queryParams.Get(GnfdUserAddressHeader)
+	userAddress, err := sdk.AccAddressFromHexUnsafe(account)
+	if err != nil {
+		log.CtxErrorw(r.Context(), "failed to parse the user address of presigned url", "account", account, "error", err)
+		return "", ErrInvalidPresignedURL
+	}
+
+	msgToSign := GetMsgToSignForPresignedURL(r.request)
+	authorization := queryParams.Get(commonhttp.HTTPHeaderAuthorization)
+	switch {
+	case strings.HasPrefix(authorization, commonhttp.Gnfd1Ecdsa+","):
+		sigStr, err := parseSignatureFromRequest(authorization[len(commonhttp.Gnfd1Ecdsa)+1:])
+		if err != nil {
+			return "", err
+		}
+		signature, err := hex.DecodeString(sigStr)
+		if err != nil {
+			return "", ErrAuthorizationHeaderFormat
+		}
+		addr, _, err := commonhash.RecoverAddr(msgToSign, signature)
+		if err != nil || !addr.Equals(userAddress) {
+			log.CtxErrorw(r.Context(), "failed to verify the signature of presigned url", "account", account, "error", err)
+			return "", ErrRequestConsistent
+		}
+	case strings.HasPrefix(authorization, commonhttp.Gnfd2Eddsa+","):
+		offChainSig, err := parseSignatureFromRequest(authorization[len(commonhttp.Gnfd2Eddsa)+1:])
+		if err != nil {
+			return "", err
+		}
+		if _, err = r.g.baseApp.GfSpClient().VerifyGNFD2EddsaSignature(r.Context(), account,
+			queryParams.Get(GnfdOffChainAuthAppDomainHeader), queryParams.Get(GnfdOffChainAuthAppRegPublicKeyHeader),
+			offChainSig, msgToSign); err != nil {
+			log.CtxErrorw(r.Context(), "failed to verify the off chain signature of presigned url", "account", account, "error", err)
+			return "", err
+		}
+	default:
+		return "", ErrUnsupportedSignType
+	}
+
+	if err = r.checkPresignedRange(queryParams.Get(PresignedRangeQuery)); err != nil {
+		return "", err
+	}
+	return userAddress.String(), nil
+}
+
+// checkPresignedRange checks the request range is within the presigned range, the presigned range is used as the
+// request range if the request has no range header.
+func (r *RequestContext) checkPresignedRange(presignedRange string) error {
+	if presignedRange == "" {
+		return nil
+	}
+	isRange, rangeStart, rangeEnd := parseRange(presignedRange)
+	if !isRange || r.request.Method != http.MethodGet {
+		return ErrInvalidPresignedURL
+	}
+	requestRange := r.request.Header.Get(RangeHeader)
+	if requestRange == "" {
+		r.request.Header.Set(RangeHeader, presignedRange)
+		return nil
+	}
+	isRequestRange, requestStart, requestEnd := parseRange(requestRange)
+	if !isRequestRange || requestStart < rangeStart || (rangeEnd >= 0 && (requestEnd < 0 || requestEnd > rangeEnd)) {
+		return ErrPresignedRangeMismatch
+	}
+	return nil
+}
+
 // verifySignatureForGNFD1Ecdsa used to verify request type GNFD1_ECDSA, return (address, nil) if check succeed
 func (r *RequestContext) verifySignatureForGNFD1Ecdsa(requestSignature string) (sdk.AccAddress, error) {
 	var (
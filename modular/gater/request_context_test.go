@@ -1,14 +1,21 @@
 package gater
 
 import (
+	"context"
+	"encoding/hex"
 	"net/http"
 	"net/url"
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	commonhttp "github.com/bnb-chain/greenfield-common/go/http"
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspclient"
+	mwhttp "github.com/bnb-chain/greenfield-storage-provider/pkg/middleware/http"
 )
 
 func TestRequestContext_SetHTTPCode(t *testing.T) {
@@ -16,6 +23,68 @@ func TestRequestContext_SetHTTPCode(t *testing.T) {
 	reqCtx.SetHTTPCode(http.StatusOK)
 }
 
+func TestRequestContext_checkBandwidthQuota(t *testing.T) {
+	err := mwhttp.NewAPILimiter(&mwhttp.APILimiterConfig{
+		BandwidthLimitCfg: mwhttp.BandwidthLimitConfig{On: true, Quota: 10, QuotaPeriod: "D"},
+	}, nil)
+	assert.Nil(t, err)
+	defer mwhttp.NewAPILimiter(&mwhttp.APILimiterConfig{}, nil)
+
+	reqCtx := &RequestContext{g: setup(t), ctx: context.Background(), account: "mockAccount"}
+	assert.Nil(t, reqCtx.checkBandwidthQuota(10))
+	assert.Equal(t, mwhttp.ErrBandwidthQuotaExceeded, reqCtx.checkBandwidthQuota(1))
+
+	// the anonymous requests are not limited by the bandwidth quota
+	reqCtx.account = ""
+	assert.Nil(t, reqCtx.checkBandwidthQuota(20))
+}
+
+func TestRequestContext_checkAccountLimit(t *testing.T) {
+	err := mwhttp.NewAPILimiter(&mwhttp.APILimiterConfig{
+		AccountLimitCfg: mwhttp.KeyLimitConfig{On: true, RateLimit: 1, RatePeriod: "D"},
+	}, nil)
+	assert.Nil(t, err)
+	defer mwhttp.NewAPILimiter(&mwhttp.APILimiterConfig{}, nil)
+
+	reqCtx := &RequestContext{g: setup(t), ctx: context.Background(), account: "mockAccount"}
+	assert.Nil(t, reqCtx.checkAccountLimit())
+	assert.Equal(t, mwhttp.ErrTooManyRequest, reqCtx.checkAccountLimit())
+
+	// the anonymous requests are not limited by the account limit
+	reqCtx.account = ""
+	assert.Nil(t, reqCtx.checkAccountLimit())
+}
+
+func TestRequestContext_checkRequestLimit(t *testing.T) {
+	err := mwhttp.NewAPILimiter(&mwhttp.APILimiterConfig{
+		BucketLimitCfg: mwhttp.KeyLimitConfig{On: true, RateLimit: 1, RatePeriod: "D"},
+	}, nil)
+	assert.Nil(t, err)
+	defer mwhttp.NewAPILimiter(&mwhttp.APILimiterConfig{}, nil)
+
+	// the anonymous requests do not charge the bucket limit
+	reqCtx := &RequestContext{g: setup(t), ctx: context.Background(), bucketName: "mockBucket"}
+	assert.Nil(t, reqCtx.checkRequestLimit())
+	assert.Nil(t, reqCtx.checkRequestLimit())
+
+	reqCtx.account = "mockAccount"
+	assert.Nil(t, reqCtx.checkRequestLimit())
+	assert.Equal(t, mwhttp.ErrTooManyRequest, reqCtx.checkRequestLimit())
+}
+
+func Test_newOptionalAuthRequestContext(t *testing.T) {
+	req := &http.Request{
+		Method: http.MethodGet,
+		URL:    &url.URL{Scheme: scheme, Host: testDomain, Path: "/mock-object"},
+		Host:   testDomain,
+		Header: map[string][]string{},
+	}
+	// the verification failure of the unsigned request is ignored
+	reqCtx, err := newOptionalAuthRequestContext(req, setup(t))
+	assert.Nil(t, err)
+	assert.Equal(t, "", reqCtx.Account())
+}
+
 func TestRequestContext_VerifySignature(t *testing.T) {
 	cases := []struct {
 		name        string
@@ -351,6 +420,242 @@ func TestRequestContext_verifyGNFD1EddsaSignatureFromPreSignedURL(t *testing.T)
 	}
 }
 
+// mockPresignedRequest returns the request of the presigned url which is signed by GNFD1-ECDSA with a random key.
+func mockPresignedRequest(t *testing.T, method string, query url.Values) *http.Request {
+	privateKey, err := crypto.GenerateKey()
+	assert.Nil(t, err)
+	query.Set(GnfdUserAddressHeader, sdk.AccAddress(crypto.PubkeyToAddress(privateKey.PublicKey).Bytes()).String())
+	if query.Get(commonhttp.HTTPHeaderExpiryTimestamp) == "" {
+		query.Set(commonhttp.HTTPHeaderExpiryTimestamp, time.Now().Add(time.Hour).Format(ExpiryDateFormat))
+	}
+	if method == http.MethodPut && query.Get(PresignedContentLengthQuery) == "" {
+		query.Set(PresignedContentLengthQuery, "0")
+	}
+	req := &http.Request{
+		Method: method,
+		URL:    &url.URL{Scheme: scheme, Host: testDomain, Path: "/mock-object", RawQuery: query.Encode()},
+		Host:   testDomain,
+		Header: map[string][]string{},
+	}
+	signature, err := crypto.Sign(GetMsgToSignForPresignedURL(req), privateKey)
+	assert.Nil(t, err)
+	query.Set(commonhttp.HTTPHeaderAuthorization, commonhttp.Gnfd1Ecdsa+",Signature="+hex.EncodeToString(signature))
+	req.URL.RawQuery = query.Encode()
+	return req
+}
+
+func TestRequestContext_VerifyPresignedURL(t *testing.T) {
+	cases := []struct {
+		name       string
+		fn         func() *GateModular
+		routerName string
+		request    func() *http.Request
+		wantedErr  error
+	}{
+		{
+			name:       "router not allowed",
+			fn:         func() *GateModular { return setup(t) },
+			routerName: getObjectMetaRouterName,
+			request: func() *http.Request {
+				return mockPresignedRequest(t, http.MethodGet, url.Values{PresignedMethodQuery: {http.MethodGet}})
+			},
+			wantedErr: ErrPresignedURLNotAllow,
+		},
+		{
+			name:       "method mismatch",
+			fn:         func() *GateModular { return setup(t) },
+			routerName: putObjectRouterName,
+			request: func() *http.Request {
+				return mockPresignedRequest(t, http.MethodPut, url.Values{PresignedMethodQuery: {http.MethodGet}})
+			},
+			wantedErr: ErrPresignedMethodMismatch,
+		},
+		{
+			name:       "expired",
+			fn:         func() *GateModular { return setup(t) },
+			routerName: getObjectRouterName,
+			request: func() *http.Request {
+				return mockPresignedRequest(t, http.MethodGet, url.Values{PresignedMethodQuery: {http.MethodGet},
+					commonhttp.HTTPHeaderExpiryTimestamp: {time.Now().Add(-time.Hour).Format(ExpiryDateFormat)}})
+			},
+			wantedErr: ErrInvalidExpiryDateHeader,
+		},
+		{
+			name:       "expired url replayed with expiry header",
+			fn:         func() *GateModular { return setup(t) },
+			routerName: getObjectRouterName,
+			request: func() *http.Request {
+				req := mockPresignedRequest(t, http.MethodGet, url.Values{PresignedMethodQuery: {http.MethodGet},
+					commonhttp.HTTPHeaderExpiryTimestamp: {time.Now().Add(-time.Hour).Format(ExpiryDateFormat)}})
+				req.Header.Set(commonhttp.HTTPHeaderExpiryTimestamp, time.Now().Add(time.Hour).Format(ExpiryDateFormat))
+				return req
+			},
+			wantedErr: ErrInvalidPresignedURL,
+		},
+		{
+			name:       "no expiry",
+			fn:         func() *GateModular { return setup(t) },
+			routerName: getObjectRouterName,
+			request: func() *http.Request {
+				req := mockPresignedRequest(t, http.MethodGet, url.Values{PresignedMethodQuery: {http.MethodGet}})
+				query := req.URL.Query()
+				query.Del(commonhttp.HTTPHeaderExpiryTimestamp)
+				req.URL.RawQuery = query.Encode()
+				req.Header.Set(commonhttp.HTTPHeaderExpiryTimestamp, time.Now().Add(time.Hour).Format(ExpiryDateFormat))
+				return req
+			},
+			wantedErr: ErrInvalidPresignedURL,
+		},
+		{
+			name:       "no content length of put",
+			fn:         func() *GateModular { return setup(t) },
+			routerName: putObjectRouterName,
+			request: func() *http.Request {
+				req := mockPresignedRequest(t, http.MethodPut, url.Values{PresignedMethodQuery: {http.MethodPut}})
+				query := req.URL.Query()
+				query.Del(PresignedContentLengthQuery)
+				req.URL.RawQuery = query.Encode()
+				return req
+			},
+			wantedErr: ErrInvalidPresignedURL,
+		},
+		{
+			name:       "content length mismatch",
+			fn:         func() *GateModular { return setup(t) },
+			routerName: putObjectRouterName,
+			request: func() *http.Request {
+				req := mockPresignedRequest(t, http.MethodPut, url.Values{PresignedMethodQuery: {http.MethodPut},
+					PresignedContentLengthQuery: {"10"}})
+				req.ContentLength = 20
+				return req
+			},
+			wantedErr: ErrPresignedContentLengthMismatch,
+		},
+		{
+			name:       "tampered query",
+			fn:         func() *GateModular { return setup(t) },
+			routerName: getObjectRouterName,
+			request: func() *http.Request {
+				req := mockPresignedRequest(t, http.MethodGet, url.Values{PresignedMethodQuery: {http.MethodGet},
+					PresignedRangeQuery: {"bytes=0-9"}})
+				query := req.URL.Query()
+				query.Set(PresignedRangeQuery, "bytes=0-")
+				req.URL.RawQuery = query.Encode()
+				return req
+			},
+			wantedErr: ErrRequestConsistent,
+		},
+		{
+			name:       "unsupported sign type",
+			fn:         func() *GateModular { return setup(t) },
+			routerName: getObjectRouterName,
+			request: func() *http.Request {
+				req := mockPresignedRequest(t, http.MethodGet, url.Values{PresignedMethodQuery: {http.MethodGet}})
+				query := req.URL.Query()
+				query.Set(commonhttp.HTTPHeaderAuthorization, commonhttp.Gnfd1Eddsa+",Signature=1a8b6fe754d")
+				req.URL.RawQuery = query.Encode()
+				return req
+			},
+			wantedErr: ErrUnsupportedSignType,
+		},
+		{
+			name:       "range mismatch",
+			fn:         func() *GateModular { return setup(t) },
+			routerName: getObjectRouterName,
+			request: func() *http.Request {
+				req := mockPresignedRequest(t, http.MethodGet, url.Values{PresignedMethodQuery: {http.MethodGet},
+					PresignedRangeQuery: {"bytes=0-9"}})
+				req.Header.Set(RangeHeader, "bytes=5-")
+				return req
+			},
+			wantedErr: ErrPresignedRangeMismatch,
+		},
+		{
+			name: "failed to verify off chain signature",
+			fn: func() *GateModular {
+				g := setup(t)
+				ctrl := gomock.NewController(t)
+				m := gfspclient.NewMockGfSpClientAPI(ctrl)
+				m.EXPECT().VerifyGNFD2EddsaSignature(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any()).Return(false, mockErr).Times(1)
+				g.baseApp.SetGfSpClient(m)
+				return g
+			},
+			routerName: putObjectRouterName,
+			request: func() *http.Request {
+				req := mockPresignedRequest(t, http.MethodPut, url.Values{PresignedMethodQuery: {http.MethodPut}})
+				query := req.URL.Query()
+				query.Set(commonhttp.HTTPHeaderAuthorization, commonhttp.Gnfd2Eddsa+",Signature=1a8b6fe754d")
+				req.URL.RawQuery = query.Encode()
+				return req
+			},
+			wantedErr: mockErr,
+		},
+		{
+			name:       "success",
+			fn:         func() *GateModular { return setup(t) },
+			routerName: putObjectRouterName,
+			request: func() *http.Request {
+				return mockPresignedRequest(t, http.MethodPut, url.Values{PresignedMethodQuery: {http.MethodPut}})
+			},
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			req := tt.request()
+			g := tt.fn()
+			g.enablePresignedURL = true
+			reqCtx := &RequestContext{g: g, request: req, routerName: tt.routerName, ctx: context.Background()}
+			assert.True(t, reqCtx.isPresignedURL())
+			account, err := reqCtx.VerifyPresignedURL()
+			assert.Equal(t, tt.wantedErr, err)
+			if tt.wantedErr == nil {
+				assert.Equal(t, req.URL.Query().Get(GnfdUserAddressHeader), account)
+			}
+		})
+	}
+}
+
+func TestRequestContext_isPresignedURLDisabled(t *testing.T) {
+	req := mockPresignedRequest(t, http.MethodGet, url.Values{PresignedMethodQuery: {http.MethodGet}})
+	reqCtx := &RequestContext{g: setup(t), request: req, routerName: getObjectRouterName, ctx: context.Background()}
+	assert.False(t, reqCtx.isPresignedURL())
+}
+
+func TestRequestContext_checkPresignedRange(t *testing.T) {
+	cases := []struct {
+		name           string
+		method         string
+		presignedRange string
+		requestRange   string
+		wantedRange    string
+		wantedErr      error
+	}{
+		{name: "no presigned range", method: http.MethodGet},
+		{name: "invalid presigned range", method: http.MethodGet, presignedRange: "0-9", wantedErr: ErrInvalidPresignedURL},
+		{name: "range of put", method: http.MethodPut, presignedRange: "bytes=0-9", wantedErr: ErrInvalidPresignedURL},
+		{name: "default to presigned range", method: http.MethodGet, presignedRange: "bytes=0-9", wantedRange: "bytes=0-9"},
+		{name: "within presigned range", method: http.MethodGet, presignedRange: "bytes=0-9", requestRange: "bytes=2-5", wantedRange: "bytes=2-5"},
+		{name: "within open presigned range", method: http.MethodGet, presignedRange: "bytes=2-", requestRange: "bytes=3-", wantedRange: "bytes=3-"},
+		{name: "before presigned range", method: http.MethodGet, presignedRange: "bytes=2-9", requestRange: "bytes=1-5", wantedErr: ErrPresignedRangeMismatch},
+		{name: "after presigned range", method: http.MethodGet, presignedRange: "bytes=0-9", requestRange: "bytes=0-10", wantedErr: ErrPresignedRangeMismatch},
+		{name: "open request range", method: http.MethodGet, presignedRange: "bytes=0-9", requestRange: "bytes=0-", wantedErr: ErrPresignedRangeMismatch},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			reqCtx := &RequestContext{request: &http.Request{Method: tt.method, Header: map[string][]string{}}}
+			if tt.requestRange != "" {
+				reqCtx.request.Header.Set(RangeHeader, tt.requestRange)
+			}
+			err := reqCtx.checkPresignedRange(tt.presignedRange)
+			assert.Equal(t, tt.wantedErr, err)
+			if tt.wantedErr == nil {
+				assert.Equal(t, tt.wantedRange, reqCtx.request.Header.Get(RangeHeader))
+			}
+		})
+	}
+}
+
 func Test_parseSignatureFromRequest(t *testing.T) {
 	cases := []struct {
 		name         string
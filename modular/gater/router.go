@@ -35,6 +35,7 @@ const (
 	updateUserPublicKeyV2RouterName                = "UpdateUserPublicKeyV2"
 	listUserPublicKeyV2RouterName                  = "ListUserPublicKeyV2"
 	deleteUserPublicKeyV2RouterName                = "DeleteUserPublicKeyV2"
+	createS3AccessKeyRouterName                    = "CreateS3AccessKey"
 	queryUploadProgressRouterName                  = "QueryUploadProgress"
 	downloadObjectByUniversalEndpointName          = "DownloadObjectByUniversalEndpoint"
 	viewObjectByUniversalEndpointName              = "ViewObjectByUniversalEndpoint"
@@ -88,6 +89,9 @@ const (
 	getBucketSizeRouterName                        = "GetBucketSize"
 	getRecommendedVGFRouterName                    = "GetRecommendedVGF"
 	getBsDBDataInfo                                = "GetBsDBDataInfo"
+	putBucketNotificationRouterName                = "PutBucketNotification"
+	getBucketNotificationRouterName                = "GetBucketNotification"
+	searchObjectsRouterName                        = "SearchObjects"
 )
 
 const (
@@ -112,6 +116,7 @@ func (g *GateModular) RegisterHandler(router *mux.Router) {
 	router.Path(AuthUpdateKeyV2Path).Name(updateUserPublicKeyV2RouterName).Methods(http.MethodPost).HandlerFunc(g.updateUserPublicKeyV2Handler)
 	router.Path(ListAuthKeyV2Path).Name(listUserPublicKeyV2RouterName).Methods(http.MethodGet).HandlerFunc(g.listUserPublicKeyV2Handler)
 	router.Path(AuthDeleteKeysV2Path).Name(deleteUserPublicKeyV2RouterName).Methods(http.MethodPost).HandlerFunc(g.deleteUserPublicKeyV2Handler)
+	router.Path(AuthS3AccessKeyPath).Name(createS3AccessKeyRouterName).Methods(http.MethodPost).HandlerFunc(g.createS3AccessKeyHandler)
 
 	// verify permission router
 	router.Path("/permission/{operator:.+}/{bucket:[^/]*}/{action-type:.+}").Name(verifyPermissionRouterName).
@@ -192,6 +197,15 @@ func (g *GateModular) RegisterHandler(router *mux.Router) {
 		// Get Bucket Meta
 		r.NewRoute().Name(getBucketMetaRouterName).Methods(http.MethodGet).Queries(GetBucketMetaQuery, "").HandlerFunc(g.getBucketMetaHandler)
 
+		// Put Bucket Notification
+		r.NewRoute().Name(putBucketNotificationRouterName).Methods(http.MethodPut).Queries(BucketNotificationQuery, "").HandlerFunc(g.putBucketNotificationHandler)
+
+		// Get Bucket Notification
+		r.NewRoute().Name(getBucketNotificationRouterName).Methods(http.MethodGet).Queries(BucketNotificationQuery, "").HandlerFunc(g.getBucketNotificationHandler)
+
+		// Search Objects
+		r.NewRoute().Name(searchObjectsRouterName).Methods(http.MethodGet).Path("/").Queries(SearchObjectsQuery, "").HandlerFunc(g.searchObjectsHandler)
+
 		// Query migration progress
 		r.NewRoute().Name(queryMigrationProgressRouterName).Methods(http.MethodGet).HandlerFunc(g.queryBucketMigrationProgressHandler).
 			Queries(GetBucketMigrationProgressQuery, "")
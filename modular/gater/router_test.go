@@ -296,6 +296,54 @@ func TestRouters(t *testing.T) {
 			shouldMatch:      true,
 			wantedRouterName: getBucketMetaRouterName,
 		},
+		{
+			name:             "Put bucket notification router, virtual host style",
+			router:           gwRouter,
+			method:           http.MethodPut,
+			url:              fmt.Sprintf("%s%s.%s?%s", scheme, mockBucketName, testDomain, BucketNotificationQuery),
+			shouldMatch:      true,
+			wantedRouterName: putBucketNotificationRouterName,
+		},
+		{
+			name:             "Put bucket notification router, path style",
+			router:           gwRouter,
+			method:           http.MethodPut,
+			url:              fmt.Sprintf("%s%s/%s?%s", scheme, testDomain, mockBucketName, BucketNotificationQuery),
+			shouldMatch:      true,
+			wantedRouterName: putBucketNotificationRouterName,
+		},
+		{
+			name:             "Get bucket notification router, virtual host style",
+			router:           gwRouter,
+			method:           http.MethodGet,
+			url:              fmt.Sprintf("%s%s.%s?%s", scheme, mockBucketName, testDomain, BucketNotificationQuery),
+			shouldMatch:      true,
+			wantedRouterName: getBucketNotificationRouterName,
+		},
+		{
+			name:             "Get bucket notification router, path style",
+			router:           gwRouter,
+			method:           http.MethodGet,
+			url:              fmt.Sprintf("%s%s/%s?%s", scheme, testDomain, mockBucketName, BucketNotificationQuery),
+			shouldMatch:      true,
+			wantedRouterName: getBucketNotificationRouterName,
+		},
+		{
+			name:             "Search objects router, virtual host style",
+			router:           gwRouter,
+			method:           http.MethodGet,
+			url:              fmt.Sprintf("%s%s.%s/?%s&%s=1024", scheme, mockBucketName, testDomain, SearchObjectsQuery, SearchObjectsMinSizeQuery),
+			shouldMatch:      true,
+			wantedRouterName: searchObjectsRouterName,
+		},
+		{
+			name:             "Search objects router, path style",
+			router:           gwRouter,
+			method:           http.MethodGet,
+			url:              fmt.Sprintf("%s%s/%s/?%s", scheme, testDomain, mockBucketName, SearchObjectsQuery),
+			shouldMatch:      true,
+			wantedRouterName: searchObjectsRouterName,
+		},
 		{
 			name:             "Get bucket migration progress router, virtual host style",
 			router:           gwRouter,
@@ -0,0 +1,245 @@
+package gater
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"golang.org/x/exp/slices"
+
+	"github.com/bnb-chain/greenfield-storage-provider/modular/authenticator"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+)
+
+const (
+	// S3DateHeader defines the request time of the S3 signature version 4
+	S3DateHeader = "X-Amz-Date"
+	// S3ContentSHA256Header defines the hex sha256 of the payload of the S3 signature version 4
+	S3ContentSHA256Header = "X-Amz-Content-Sha256"
+	// S3UnsignedPayload indicates the payload is not signed
+	S3UnsignedPayload = "UNSIGNED-PAYLOAD"
+	// S3StreamingPayloadPrefix indicates the payload is signed chunk by chunk, which is not supported
+	S3StreamingPayloadPrefix = "STREAMING-"
+	// S3DateFormat defines the format of S3DateHeader
+	S3DateFormat = "20060102T150405Z"
+	// S3MaxRequestTimeSkew defines the max difference between the request time and the server's time
+	S3MaxRequestTimeSkew = 15 * time.Minute
+	// S3Service defines the service name in the credential scope
+	S3Service = "s3"
+)
+
+// s3RequiredSignedHeaders are the headers which must be covered by the signature, otherwise the host, the request
+// time or the payload of a signed request can be replaced.
+var s3RequiredSignedHeaders = []string{"host", "x-amz-content-sha256", "x-amz-date"}
+
+// s3Authorization is the parsed authorization header of the S3 signature version 4, e.g.
+// "AWS4-HMAC-SHA256 Credential=AKID/20130524/us-east-1/s3/aws4_request, SignedHeaders=host;x-amz-date, Signature=xxx".
+type s3Authorization struct {
+	accessKeyID   string
+	date          string
+	region        string
+	service       string
+	signedHeaders []string
+	signature     string
+}
+
+// scope returns the credential scope of the signature.
+func (a *s3Authorization) scope() string {
+	return strings.Join([]string{a.date, a.region, a.service, authenticator.S3ScopeTerminator}, "/")
+}
+
+// parseS3Authorization parses the authorization header of the S3 signature version 4.
+func parseS3Authorization(authorization string) (*s3Authorization, error) {
+	if !strings.HasPrefix(authorization, authenticator.S3SignAlgorithm+" ") {
+		return nil, ErrS3AuthorizationHeader
+	}
+	auth := &s3Authorization{}
+	for _, item := range strings.Split(authorization[len(authenticator.S3SignAlgorithm)+1:], ",") {
+		key, value, found := strings.Cut(strings.TrimSpace(item), "=")
+		if !found {
+			return nil, ErrS3AuthorizationHeader
+		}
+		switch key {
+		case "Credential":
+			credential := strings.Split(value, "/")
+			if len(credential) != 5 || credential[4] != authenticator.S3ScopeTerminator {
+				return nil, ErrS3AuthorizationHeader
+			}
+			auth.accessKeyID, auth.date, auth.region, auth.service = credential[0], credential[1], credential[2], credential[3]
+		case "SignedHeaders":
+			auth.signedHeaders = strings.Split(value, ";")
+		case "Signature":
+			auth.signature = value
+		default:
+			return nil, ErrS3AuthorizationHeader
+		}
+	}
+	if auth.accessKeyID == "" || len(auth.signedHeaders) == 0 || auth.signature == "" {
+		return nil, ErrS3AuthorizationHeader
+	}
+	return auth, nil
+}
+
+// s3URIEncode encodes the string by the rules of the S3 signature version 4, every byte except the unreserved
+// characters is percent encoded, the slash is kept if encodeSlash is false.
+func s3URIEncode(s string, encodeSlash bool) string {
+	const upperHex = "0123456789ABCDEF"
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' || (c == '/' && !encodeSlash) {
+			sb.WriteByte(c)
+			continue
+		}
+		sb.WriteByte('%')
+		sb.WriteByte(upperHex[c>>4])
+		sb.WriteByte(upperHex[c&15])
+	}
+	return sb.String()
+}
+
+// s3CanonicalRequest returns the canonical request of the S3 signature version 4.
+func s3CanonicalRequest(r *http.Request, signedHeaders []string, payloadHash string) string {
+	canonicalURI := s3URIEncode(r.URL.Path, false)
+	if canonicalURI == "" {
+		canonicalURI = "/"
+	}
+
+	var queries []string
+	for key, values := range r.URL.Query() {
+		for _, value := range values {
+			queries = append(queries, s3URIEncode(key, true)+"="+s3URIEncode(value, true))
+		}
+	}
+	sort.Strings(queries)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range signedHeaders {
+		var values []string
+		switch name {
+		case "host":
+			values = []string{r.Host}
+		case "content-length":
+			values = r.Header.Values(name)
+			if len(values) == 0 {
+				values = []string{strconv.FormatInt(r.ContentLength, 10)}
+			}
+		default:
+			values = r.Header.Values(name)
+		}
+		for i := range values {
+			values[i] = strings.Join(strings.Fields(values[i]), " ")
+		}
+		canonicalHeaders.WriteString(name + ":" + strings.Join(values, ",") + "\n")
+	}
+
+	return strings.Join([]string{r.Method, canonicalURI, strings.Join(queries, "&"), canonicalHeaders.String(),
+		strings.Join(signedHeaders, ";"), payloadHash}, "\n")
+}
+
+// s3StringToSign returns the string to sign of the S3 signature version 4.
+func s3StringToSign(amzDate string, scope string, canonicalRequest string) []byte {
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+	return []byte(strings.Join([]string{authenticator.S3SignAlgorithm, amzDate, scope,
+		hex.EncodeToString(canonicalRequestHash[:])}, "\n"))
+}
+
+// s3PayloadHashReader verifies the payload against the signed x-amz-content-sha256 when the payload is read
+// to the end, the payload is streamed to the uploader, so the mismatch fails the upload instead of the request.
+type s3PayloadHashReader struct {
+	io.ReadCloser
+	hash     hash.Hash
+	expected string
+}
+
+func (p *s3PayloadHashReader) Read(b []byte) (int, error) {
+	n, err := p.ReadCloser.Read(b)
+	p.hash.Write(b[:n])
+	if err == io.EOF && hex.EncodeToString(p.hash.Sum(nil)) != p.expected {
+		return n, ErrS3ContentSHA256Mismatch
+	}
+	return n, err
+}
+
+// NewS3RequestContext returns an instance of RequestContext for the S3 compatible api, and verify the
+// request signature of the S3 signature version 4, returns the instance regardless of the success or
+// failure of the verification.
+func NewS3RequestContext(r *http.Request, g *GateModular) (*RequestContext, error) {
+	vars := mux.Vars(r)
+	routerName := ""
+	if mux.CurrentRoute(r) != nil {
+		routerName = mux.CurrentRoute(r).GetName()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	reqCtx := &RequestContext{
+		g:          g,
+		ctx:        ctx,
+		cancel:     cancel,
+		request:    r,
+		routerName: routerName,
+		bucketName: vars["bucket"],
+		objectName: vars["object"],
+		vars:       vars,
+		startTime:  time.Now(),
+	}
+	account, err := reqCtx.VerifyS3Signature()
+	if err != nil {
+		return reqCtx, err
+	}
+	reqCtx.account = account
+	return reqCtx, reqCtx.checkRequestLimit()
+}
+
+// VerifyS3Signature verifies the request signature of the S3 signature version 4, returns the account which
+// the access key belongs to.
+func (r *RequestContext) VerifyS3Signature() (string, error) {
+	auth, err := parseS3Authorization(r.request.Header.Get(GnfdAuthorizationHeader))
+	if err != nil {
+		return "", err
+	}
+	for _, header := range s3RequiredSignedHeaders {
+		if !slices.Contains(auth.signedHeaders, header) {
+			log.CtxErrorw(r.Context(), "failed to check the signed headers", "signed_headers", auth.signedHeaders, "missing", header)
+			return "", ErrS3AuthorizationHeader
+		}
+	}
+	amzDate := r.request.Header.Get(S3DateHeader)
+	requestTime, err := time.Parse(S3DateFormat, amzDate)
+	if err != nil || auth.date != amzDate[:8] {
+		return "", ErrS3AuthorizationHeader
+	}
+	if skew := time.Since(requestTime); skew > S3MaxRequestTimeSkew || skew < -S3MaxRequestTimeSkew {
+		return "", ErrS3RequestTimeTooSkewed
+	}
+	if auth.region != r.g.s3Region || auth.service != S3Service {
+		log.CtxErrorw(r.Context(), "failed to check the credential scope", "region", auth.region, "service", auth.service)
+		return "", ErrS3AuthorizationHeader
+	}
+	payloadHash := r.request.Header.Get(S3ContentSHA256Header)
+	if payloadHash == "" {
+		return "", ErrS3AuthorizationHeader
+	}
+	if strings.HasPrefix(payloadHash, S3StreamingPayloadPrefix) {
+		return "", ErrS3NotImplemented
+	}
+
+	stringToSign := s3StringToSign(amzDate, auth.scope(), s3CanonicalRequest(r.request, auth.signedHeaders, payloadHash))
+	account, err := r.g.baseApp.GfSpClient().VerifyS3Signature(r.Context(), auth.accessKeyID, stringToSign, auth.signature)
+	if err != nil {
+		log.CtxErrorw(r.Context(), "failed to verify s3 signature", "access_key_id", auth.accessKeyID, "error", err)
+		return "", err
+	}
+	if payloadHash != S3UnsignedPayload {
+		r.request.Body = &s3PayloadHashReader{ReadCloser: r.request.Body, hash: sha256.New(), expected: strings.ToLower(payloadHash)}
+	}
+	return account, nil
+}
@@ -0,0 +1,794 @@
+package gater
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	commonhash "github.com/bnb-chain/greenfield-common/go/hash"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gnfd"
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
+	coremodule "github.com/bnb-chain/greenfield-storage-provider/core/module"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/metadata"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	servicetypes "github.com/bnb-chain/greenfield-storage-provider/store/types"
+	"github.com/bnb-chain/greenfield-storage-provider/util"
+	"github.com/bnb-chain/greenfield/types/s3util"
+	permissiontypes "github.com/bnb-chain/greenfield/x/permission/types"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+const (
+	// S3ETagHeader defines the entity tag of the object
+	S3ETagHeader = "ETag"
+	// S3LastModifiedHeader defines the last modified time of the object
+	S3LastModifiedHeader = "Last-Modified"
+	// S3AcceptRangesHeader indicates the range request is supported
+	S3AcceptRangesHeader = "Accept-Ranges"
+	// S3PayloadSizeHeader defines the total size of the object of the multipart upload, the object is created on
+	// chain when the multipart upload is created, so the payload size must be known in advance
+	S3PayloadSizeHeader = "X-Amz-Meta-Gnfd-Payload-Size"
+	// S3TimeFormat defines the time format in the S3 xml responses
+	S3TimeFormat = "2006-01-02T15:04:05.000Z"
+	// S3DefaultMaxKeys defines the default max keys of the list objects request
+	S3DefaultMaxKeys = 1000
+	// S3MaxPartNumber defines the max part number of the multipart upload
+	S3MaxPartNumber = 10000
+)
+
+// S3ListBucketResult is the response of the ListObjectsV2 api.
+type S3ListBucketResult struct {
+	XMLName               xml.Name         `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListBucketResult"`
+	Name                  string           `xml:"Name"`
+	Prefix                string           `xml:"Prefix"`
+	Delimiter             string           `xml:"Delimiter,omitempty"`
+	StartAfter            string           `xml:"StartAfter,omitempty"`
+	ContinuationToken     string           `xml:"ContinuationToken,omitempty"`
+	NextContinuationToken string           `xml:"NextContinuationToken,omitempty"`
+	EncodingType          string           `xml:"EncodingType,omitempty"`
+	KeyCount              int              `xml:"KeyCount"`
+	MaxKeys               uint64           `xml:"MaxKeys"`
+	IsTruncated           bool             `xml:"IsTruncated"`
+	Contents              []S3Object       `xml:"Contents"`
+	CommonPrefixes        []S3CommonPrefix `xml:"CommonPrefixes"`
+}
+
+// S3Object is the object entry in the S3ListBucketResult.
+type S3Object struct {
+	Key          string `xml:"Key"`
+	LastModified string `xml:"LastModified"`
+	ETag         string `xml:"ETag"`
+	Size         uint64 `xml:"Size"`
+	StorageClass string `xml:"StorageClass"`
+}
+
+// S3CommonPrefix is the common prefix entry in the S3ListBucketResult.
+type S3CommonPrefix struct {
+	Prefix string `xml:"Prefix"`
+}
+
+// S3InitiateMultipartUploadResult is the response of the CreateMultipartUpload api.
+type S3InitiateMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ InitiateMultipartUploadResult"`
+	Bucket   string   `xml:"Bucket"`
+	Key      string   `xml:"Key"`
+	UploadID string   `xml:"UploadId"`
+}
+
+// S3CompleteMultipartUpload is the request body of the CompleteMultipartUpload api.
+type S3CompleteMultipartUpload struct {
+	XMLName xml.Name `xml:"CompleteMultipartUpload"`
+	Parts   []struct {
+		PartNumber int    `xml:"PartNumber"`
+		ETag       string `xml:"ETag"`
+	} `xml:"Part"`
+}
+
+// S3CompleteMultipartUploadResult is the response of the CompleteMultipartUpload api.
+type S3CompleteMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ CompleteMultipartUploadResult"`
+	Location string   `xml:"Location"`
+	Bucket   string   `xml:"Bucket"`
+	Key      string   `xml:"Key"`
+	ETag     string   `xml:"ETag"`
+}
+
+// s3ObjectETag returns the entity tag of the object, it is the hex of the root checksum of the object.
+func s3ObjectETag(objectInfo *storagetypes.ObjectInfo) string {
+	if len(objectInfo.GetChecksums()) == 0 {
+		return `""`
+	}
+	return `"` + hex.EncodeToString(objectInfo.GetChecksums()[0]) + `"`
+}
+
+// s3ObjectLastModified returns the last modified time of the object.
+func s3ObjectLastModified(objectInfo *storagetypes.ObjectInfo) time.Time {
+	return time.Unix(objectInfo.GetLatestUpdatedTime(), 0).UTC()
+}
+
+// s3ObjectResponseWriter completes the Content-Range header of the range request with the total size of the object
+// and responds with 206 Partial Content as the S3 clients expect.
+type s3ObjectResponseWriter struct {
+	http.ResponseWriter
+	payloadSize uint64
+	wroteHeader bool
+}
+
+func (w *s3ObjectResponseWriter) WriteHeader(statusCode int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	var rangeStart, rangeEnd uint64
+	if statusCode == http.StatusOK {
+		if _, err := fmt.Sscanf(w.Header().Get(ContentRangeHeader), "bytes %d-%d", &rangeStart, &rangeEnd); err == nil {
+			w.Header().Set(ContentRangeHeader, fmt.Sprintf("bytes %d-%d/%d", rangeStart, rangeEnd, w.payloadSize))
+			w.Header().Set(ContentLengthHeader, util.Uint64ToString(rangeEnd-rangeStart+1))
+			statusCode = http.StatusPartialContent
+		}
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *s3ObjectResponseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// checkS3ObjectName checks the bucket name and the object name of the S3 request which writes the object.
+func checkS3ObjectName(reqCtx *RequestContext) error {
+	if err := s3util.CheckValidBucketName(reqCtx.bucketName); err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to check bucket name", "bucket_name", reqCtx.bucketName, "error", err)
+		return ErrInvalidQuery
+	}
+	if err := s3util.CheckValidObjectName(reqCtx.objectName); err != nil ||
+		strings.Contains(reqCtx.objectName, "..") ||
+		strings.Contains(reqCtx.objectName, "\\") ||
+		util.IsSQLInjection(reqCtx.objectName) {
+		log.CtxErrorw(reqCtx.Context(), "failed to check object name", "object_name", reqCtx.objectName, "error", err)
+		return ErrInvalidObjectName
+	}
+	return nil
+}
+
+// s3QueryObjectInfo queries the object info from consensus, the missing object is reported as NoSuchKey.
+func (g *GateModular) s3QueryObjectInfo(reqCtx *RequestContext) (*storagetypes.ObjectInfo, error) {
+	objectInfo, err := g.baseApp.Consensus().QueryObjectInfo(reqCtx.Context(), reqCtx.bucketName, reqCtx.objectName)
+	if err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to get object info from consensus", "error", err)
+		if errors.Is(err, gnfd.ErrNoSuchObject) {
+			return nil, ErrConsensusNotFoundWithDetail("no such object, object_name: " + reqCtx.objectName + ", bucket_name: " + reqCtx.bucketName)
+		}
+		return nil, ErrConsensusWithDetail("failed to get object info from consensus, object_name: " + reqCtx.objectName + ", bucket_name: " + reqCtx.bucketName + ", error: " + err.Error())
+	}
+	return objectInfo, nil
+}
+
+// s3QueryReadableObject verifies the account can read the object, returns the sealed object info.
+func (g *GateModular) s3QueryReadableObject(reqCtx *RequestContext) (*storagetypes.ObjectInfo, error) {
+	if err := s3util.CheckValidBucketName(reqCtx.bucketName); err != nil {
+		return nil, ErrInvalidQuery
+	}
+	if err := s3util.CheckValidObjectName(reqCtx.objectName); err != nil {
+		return nil, ErrInvalidQuery
+	}
+	objectInfo, err := g.s3QueryObjectInfo(reqCtx)
+	if err != nil {
+		return nil, err
+	}
+	// the object is invisible to the S3 clients until it is sealed
+	if objectInfo.GetObjectStatus() != storagetypes.OBJECT_STATUS_SEALED {
+		return nil, ErrConsensusNotFoundWithDetail("the object is not sealed, object_name: " + reqCtx.objectName + ", bucket_name: " + reqCtx.bucketName)
+	}
+	authenticated, err := g.baseApp.GfSpClient().VerifyAuthentication(reqCtx.Context(), coremodule.AuthOpTypeGetObject,
+		reqCtx.Account(), reqCtx.bucketName, reqCtx.objectName)
+	if err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to verify authentication", "error", err)
+		return nil, err
+	}
+	if !authenticated {
+		log.CtxErrorw(reqCtx.Context(), "no permission to operate")
+		return nil, ErrNoPermission
+	}
+	return objectInfo, nil
+}
+
+// setS3ObjectHeaders sets the object metadata headers of the GetObject and HeadObject responses.
+func setS3ObjectHeaders(w http.ResponseWriter, objectInfo *storagetypes.ObjectInfo) {
+	w.Header().Set(ContentTypeHeader, objectInfo.GetContentType())
+	w.Header().Set(S3ETagHeader, s3ObjectETag(objectInfo))
+	w.Header().Set(S3LastModifiedHeader, s3ObjectLastModified(objectInfo).Format(http.TimeFormat))
+	w.Header().Set(S3AcceptRangesHeader, "bytes")
+}
+
+// s3ListObjectsV2Handler handles the ListObjectsV2 request, only the sealed objects are listed.
+func (g *GateModular) s3ListObjectsV2Handler(w http.ResponseWriter, reqCtx *RequestContext) error {
+	var (
+		err               error
+		maxKeys           uint64 = S3DefaultMaxKeys
+		continuationToken string
+	)
+	queryParams := reqCtx.request.URL.Query()
+	requestDelimiter := queryParams.Get(ListObjectsDelimiterQuery)
+	requestPrefix := queryParams.Get(ListObjectsPrefixQuery)
+	requestStartAfter := queryParams.Get(ListObjectsStartAfterQuery)
+	requestContinuationToken := queryParams.Get(ListObjectsContinuationTokenQuery)
+	requestEncodingType := queryParams.Get("encoding-type")
+
+	if err = s3util.CheckValidBucketName(reqCtx.bucketName); err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to check bucket name", "bucket_name", reqCtx.bucketName, "error", err)
+		return ErrInvalidQuery
+	}
+	if requestDelimiter != "" && requestDelimiter != "/" {
+		log.CtxErrorw(reqCtx.Context(), "failed to check delimiter", "delimiter", requestDelimiter)
+		return ErrInvalidQuery
+	}
+	if requestEncodingType != "" && requestEncodingType != "url" {
+		return ErrInvalidQuery
+	}
+	if !checkValidObjectPrefix(requestPrefix) {
+		log.CtxErrorw(reqCtx.Context(), "failed to check prefix", "prefix", requestPrefix)
+		return ErrInvalidQuery
+	}
+	if requestMaxKeys := queryParams.Get(ListObjectsMaxKeysQuery); requestMaxKeys != "" {
+		if maxKeys, err = util.StringToUint64(requestMaxKeys); err != nil || maxKeys == 0 {
+			log.CtxErrorw(reqCtx.Context(), "failed to parse max keys", "max_keys", requestMaxKeys, "error", err)
+			return ErrInvalidQuery
+		}
+	}
+	if requestContinuationToken != "" {
+		var decodedContinuationToken []byte
+		if decodedContinuationToken, err = base64.StdEncoding.DecodeString(requestContinuationToken); err != nil {
+			log.CtxErrorw(reqCtx.Context(), "failed to decode continuation token", "continuation_token", requestContinuationToken, "error", err)
+			return ErrInvalidQuery
+		}
+		continuationToken = string(decodedContinuationToken)
+		if !strings.HasPrefix(continuationToken, requestPrefix) {
+			return ErrInvalidQuery
+		}
+	} else {
+		continuationToken = requestStartAfter
+	}
+
+	objects, _, maxKeys, isTruncated, nextContinuationToken, _, _, _, commonPrefixes, _, err :=
+		g.baseApp.GfSpClient().ListObjectsByBucketName(reqCtx.Context(), reqCtx.bucketName, "", maxKeys,
+			requestStartAfter, continuationToken, requestDelimiter, requestPrefix, false)
+	if err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to list objects by bucket name", "error", err)
+		return err
+	}
+
+	var encode = func(s string) string {
+		if requestEncodingType == "url" {
+			return url.QueryEscape(s)
+		}
+		return s
+	}
+	result := &S3ListBucketResult{
+		Name:              reqCtx.bucketName,
+		Prefix:            encode(requestPrefix),
+		Delimiter:         encode(requestDelimiter),
+		StartAfter:        encode(requestStartAfter),
+		ContinuationToken: requestContinuationToken,
+		EncodingType:      requestEncodingType,
+		MaxKeys:           maxKeys,
+		IsTruncated:       isTruncated,
+	}
+	if isTruncated {
+		result.NextContinuationToken = nextContinuationToken
+	}
+	for _, object := range objects {
+		objectInfo := object.GetObjectInfo()
+		if object.GetRemoved() || objectInfo.GetObjectStatus() != storagetypes.OBJECT_STATUS_SEALED {
+			continue
+		}
+		result.Contents = append(result.Contents, S3Object{
+			Key:          encode(objectInfo.GetObjectName()),
+			LastModified: s3ObjectLastModified(objectInfo).Format(S3TimeFormat),
+			ETag:         s3ObjectETag(objectInfo),
+			Size:         objectInfo.GetPayloadSize(),
+			StorageClass: "STANDARD",
+		})
+	}
+	for _, prefix := range commonPrefixes {
+		result.CommonPrefixes = append(result.CommonPrefixes, S3CommonPrefix{Prefix: encode(prefix)})
+	}
+	result.KeyCount = len(result.Contents) + len(result.CommonPrefixes)
+
+	respBytes, err := xml.Marshal(result)
+	if err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to marshal list objects result", "error", err)
+		return ErrEncodeResponseWithDetail("failed to marshal list objects result, error: " + err.Error())
+	}
+	w.Header().Set(ContentTypeHeader, ContentTypeXMLHeaderValue)
+	w.Write(respBytes)
+	return nil
+}
+
+// s3GetObjectHandler handles the GetObject request, the range request is supported.
+func (g *GateModular) s3GetObjectHandler(w http.ResponseWriter, reqCtx *RequestContext) error {
+	objectInfo, err := g.s3QueryReadableObject(reqCtx)
+	if err != nil {
+		return err
+	}
+	setS3ObjectHeaders(w, objectInfo)
+	if objectInfo.GetPayloadSize() == 0 {
+		w.Header().Set(ContentLengthHeader, "0")
+		return nil
+	}
+	return g.downloadObject(&s3ObjectResponseWriter{ResponseWriter: w, payloadSize: objectInfo.GetPayloadSize()}, reqCtx)
+}
+
+// s3HeadObjectHandler handles the HeadObject request.
+func (g *GateModular) s3HeadObjectHandler(w http.ResponseWriter, reqCtx *RequestContext) error {
+	objectInfo, err := g.s3QueryReadableObject(reqCtx)
+	if err != nil {
+		return err
+	}
+	setS3ObjectHeaders(w, objectInfo)
+	w.Header().Set(ContentLengthHeader, util.Uint64ToString(objectInfo.GetPayloadSize()))
+	return nil
+}
+
+// s3QueryStorageParams queries the storage params and checks the payload size against the max payload size.
+func (g *GateModular) s3QueryStorageParams(reqCtx *RequestContext, payloadSize uint64) (*storagetypes.Params, error) {
+	params, err := g.baseApp.Consensus().QueryStorageParamsByTimestamp(reqCtx.Context(), time.Now().Unix())
+	if err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to get storage params from consensus", "error", err)
+		return nil, ErrConsensusWithDetail("failed to get storage params from consensus, object_name: " + reqCtx.objectName + ", bucket_name: " + reqCtx.bucketName + ", error: " + err.Error())
+	}
+	if payloadSize > params.GetMaxPayloadSize() {
+		log.CtxErrorw(reqCtx.Context(), "failed to check payload size", "size", payloadSize, "size limit", params.GetMaxPayloadSize())
+		return nil, ErrInvalidPayloadSize
+	}
+	return params, nil
+}
+
+// s3DelegateCreateObject creates the object on chain on behalf of the account by the SP, or updates the content of
+// the sealed object. An unsealed object with the same payload size which is created or updated by the account is
+// reused, so that the retried requests resume the upload. It returns the object info after the transaction is
+// confirmed, the shadow object info is assigned if the object is updating.
+func (g *GateModular) s3DelegateCreateObject(reqCtx *RequestContext, payloadSize uint64) (
+	*storagetypes.BucketInfo, *storagetypes.ObjectInfo, error) {
+	var (
+		err         error
+		objectInfo  *storagetypes.ObjectInfo
+		txHash      string
+		contentType = reqCtx.request.Header.Get(ContentTypeHeader)
+		opType      = coremodule.AuthOpTypeAgentPutObject
+	)
+	if contentType == "" {
+		contentType = ContentDefault
+	}
+
+	objectInfo, err = g.baseApp.Consensus().QueryObjectInfo(reqCtx.Context(), reqCtx.bucketName, reqCtx.objectName)
+	if err != nil && !errors.Is(err, gnfd.ErrNoSuchObject) {
+		log.CtxErrorw(reqCtx.Context(), "failed to get object info from consensus", "error", err)
+		return nil, nil, ErrConsensusWithDetail("failed to get object info from consensus, object_name: " + reqCtx.objectName + ", bucket_name: " + reqCtx.bucketName + ", error: " + err.Error())
+	}
+	isCreated := objectInfo != nil && objectInfo.GetObjectStatus() == storagetypes.OBJECT_STATUS_CREATED
+	isSealed := objectInfo != nil && objectInfo.GetObjectStatus() == storagetypes.OBJECT_STATUS_SEALED
+	if objectInfo != nil && !isCreated && !isSealed {
+		log.CtxErrorw(reqCtx.Context(), "the object is neither created nor sealed", "status", objectInfo.GetObjectStatus())
+		return nil, nil, ErrInvalidQuery
+	}
+	if isSealed {
+		if payloadSize == 0 {
+			return nil, nil, ErrInvalidPayloadSize
+		}
+		opType = coremodule.AuthOpTypeAgentUpdateObject
+	}
+
+	authenticated, err := g.baseApp.GfSpClient().VerifyAuthentication(reqCtx.Context(), opType, reqCtx.Account(),
+		reqCtx.bucketName, reqCtx.objectName)
+	if err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to verify authentication", "error", err)
+		return nil, nil, err
+	}
+	if !authenticated {
+		log.CtxErrorw(reqCtx.Context(), "no permission to operate")
+		return nil, nil, ErrNoPermission
+	}
+	if err = g.checkSPAndBucketStatus(reqCtx.Context(), reqCtx.bucketName, reqCtx.Account()); err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to check sp and bucket status", "error", err)
+		return nil, nil, err
+	}
+
+	switch {
+	case objectInfo == nil:
+		task := &gfsptask.GfSpDelegateCreateObjectApprovalTask{}
+		task.InitApprovalDelegateCreateObjectTask(reqCtx.Account(), &storagetypes.MsgDelegateCreateObject{
+			Operator:       g.baseApp.OperatorAddress(),
+			Creator:        reqCtx.Account(),
+			BucketName:     reqCtx.bucketName,
+			ObjectName:     reqCtx.objectName,
+			PayloadSize:    payloadSize,
+			ContentType:    contentType,
+			Visibility:     storagetypes.VISIBILITY_TYPE_INHERIT,
+			RedundancyType: storagetypes.REDUNDANCY_EC_TYPE,
+		}, commonhash.GenerateChecksum([]byte(reqCtx.request.Header.Get(GnfdAuthorizationHeader))), g.baseApp.TaskPriority(task))
+		authenticated, _, err = g.baseApp.GfSpClient().AskDelegateCreateObjectApproval(reqCtx.Context(), task)
+		if err != nil {
+			log.CtxErrorw(reqCtx.Context(), "failed to ask object approval", "error", err)
+			return nil, nil, err
+		}
+		if !authenticated {
+			log.CtxErrorw(reqCtx.Context(), "refuse the ask create object approval")
+			return nil, nil, ErrRefuseApproval
+		}
+		if txHash, err = g.baseApp.GfSpClient().DelegateCreateObject(reqCtx.Context(), task.GetDelegateCreateObject()); err != nil {
+			log.CtxErrorw(reqCtx.Context(), "failed to delegate create object", "error", err)
+			return nil, nil, err
+		}
+	case isCreated:
+		if (objectInfo.GetCreator() != reqCtx.Account() && objectInfo.GetOwner() != reqCtx.Account()) ||
+			objectInfo.GetPayloadSize() != payloadSize {
+			log.CtxErrorw(reqCtx.Context(), "the object has been created by others or with a different payload size")
+			return nil, nil, ErrInvalidQuery
+		}
+	case objectInfo.GetIsUpdating():
+		shadowObject, queryErr := g.baseApp.Consensus().QueryShadowObjectInfo(reqCtx.Context(), reqCtx.bucketName, reqCtx.objectName)
+		if queryErr != nil {
+			log.CtxErrorw(reqCtx.Context(), "failed to get shadow object info from consensus", "error", queryErr)
+			return nil, nil, ErrConsensusWithDetail("failed to get shadow object info from consensus, object_name: " + reqCtx.objectName + ", bucket_name: " + reqCtx.bucketName + ", error: " + queryErr.Error())
+		}
+		if objectInfo.GetUpdatedBy() != reqCtx.Account() || shadowObject.GetPayloadSize() != payloadSize {
+			log.CtxErrorw(reqCtx.Context(), "the object is being updated by others or with a different payload size")
+			return nil, nil, ErrInvalidQuery
+		}
+	default:
+		if txHash, err = g.baseApp.GfSpClient().DelegateUpdateObjectContent(reqCtx.Context(), &storagetypes.MsgDelegateUpdateObjectContent{
+			Updater:     reqCtx.Account(),
+			BucketName:  reqCtx.bucketName,
+			ObjectName:  reqCtx.objectName,
+			PayloadSize: payloadSize,
+			ContentType: contentType,
+		}); err != nil {
+			log.CtxErrorw(reqCtx.Context(), "failed to delegate update object", "error", err)
+			return nil, nil, err
+		}
+	}
+	if txHash != "" {
+		if _, err = g.baseApp.Consensus().ConfirmTransaction(reqCtx.Context(), txHash); err != nil {
+			log.CtxErrorw(reqCtx.Context(), "failed to confirm transaction", "tx_hash", txHash, "error", err)
+			return nil, nil, err
+		}
+	}
+
+	bucketInfo, objectInfo, err := g.baseApp.Consensus().QueryBucketInfoAndObjectInfo(reqCtx.Context(), reqCtx.bucketName, reqCtx.objectName)
+	if err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to get object info from consensus", "error", err)
+		return nil, nil, ErrConsensusWithDetail("failed to get object info from consensus, object_name: " + reqCtx.objectName + ", bucket_name: " + reqCtx.bucketName + ", error: " + err.Error())
+	}
+	if err = g.checkAndAssignShadowObjectInfo(reqCtx, objectInfo); err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to get shadow object info from consensus", "error", err)
+		return nil, nil, ErrConsensusWithDetail("failed to get shadow object info from consensus, object_name: " + reqCtx.objectName + ", bucket_name: " + reqCtx.bucketName + ", error: " + err.Error())
+	}
+	return bucketInfo, objectInfo, nil
+}
+
+// s3PutObjectHandler handles the PutObject request, the object is created on chain by the SP on behalf of the
+// account and the payload is uploaded in one piece.
+func (g *GateModular) s3PutObjectHandler(w http.ResponseWriter, reqCtx *RequestContext) error {
+	if err := checkS3ObjectName(reqCtx); err != nil {
+		return err
+	}
+	if reqCtx.request.ContentLength < 0 {
+		return ErrS3MissingContentLength
+	}
+	payloadSize := uint64(reqCtx.request.ContentLength)
+	params, err := g.s3QueryStorageParams(reqCtx, payloadSize)
+	if err != nil {
+		return err
+	}
+	bucketInfo, objectInfo, err := g.s3DelegateCreateObject(reqCtx, payloadSize)
+	if err != nil {
+		return err
+	}
+
+	md5Hash := md5.New()
+	// the empty object is sealed when it is created
+	if payloadSize != 0 {
+		if err = reqCtx.checkBandwidthQuota(int64(payloadSize)); err != nil {
+			return err
+		}
+		uploadTask := &gfsptask.GfSpUploadObjectTask{}
+		uploadTask.InitUploadObjectTask(bucketInfo.GetGlobalVirtualGroupFamilyId(), objectInfo, params,
+			g.baseApp.TaskTimeout(uploadTask, objectInfo.GetPayloadSize()), true)
+		uploadTask.SetCreateTime(time.Now().Unix())
+		uploadTask.AppendLog("gateway-create-s3-upload-task")
+		ctx := log.WithValue(reqCtx.Context(), log.CtxKeyTask, uploadTask.Key().String())
+		if err = g.baseApp.GfSpClient().UploadObject(ctx, uploadTask, io.TeeReader(reqCtx.request.Body, md5Hash)); err != nil {
+			log.CtxErrorw(ctx, "failed to upload payload data", "error", err)
+			return err
+		}
+	}
+	w.Header().Set(S3ETagHeader, `"`+hex.EncodeToString(md5Hash.Sum(nil))+`"`)
+	return nil
+}
+
+// s3CreateMultipartUploadHandler handles the CreateMultipartUpload request, the object is created on chain with
+// the payload size in S3PayloadSizeHeader, and the object id is used as the upload id.
+func (g *GateModular) s3CreateMultipartUploadHandler(w http.ResponseWriter, reqCtx *RequestContext) error {
+	if err := checkS3ObjectName(reqCtx); err != nil {
+		return err
+	}
+	payloadSize, err := util.StringToUint64(reqCtx.request.Header.Get(S3PayloadSizeHeader))
+	if err != nil || payloadSize == 0 {
+		log.CtxErrorw(reqCtx.Context(), "failed to parse payload size header", "payload_size", reqCtx.request.Header.Get(S3PayloadSizeHeader))
+		return ErrInvalidHeader
+	}
+	if _, err = g.s3QueryStorageParams(reqCtx, payloadSize); err != nil {
+		return err
+	}
+	_, objectInfo, err := g.s3DelegateCreateObject(reqCtx, payloadSize)
+	if err != nil {
+		return err
+	}
+
+	respBytes, err := xml.Marshal(&S3InitiateMultipartUploadResult{
+		Bucket:   reqCtx.bucketName,
+		Key:      reqCtx.objectName,
+		UploadID: objectInfo.Id.String(),
+	})
+	if err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to marshal initiate multipart upload result", "error", err)
+		return ErrEncodeResponseWithDetail("failed to marshal initiate multipart upload result, error: " + err.Error())
+	}
+	w.Header().Set(ContentTypeHeader, ContentTypeXMLHeaderValue)
+	w.Write(respBytes)
+	return nil
+}
+
+// s3QueryMultipartUpload returns the object of the multipart upload, the shadow object info is assigned if the
+// object is updating.
+func (g *GateModular) s3QueryMultipartUpload(reqCtx *RequestContext) (*storagetypes.BucketInfo, *storagetypes.ObjectInfo, error) {
+	bucketInfo, objectInfo, err := g.baseApp.Consensus().QueryBucketInfoAndObjectInfo(reqCtx.Context(), reqCtx.bucketName, reqCtx.objectName)
+	if err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to get object info from consensus", "error", err)
+		if errors.Is(err, gnfd.ErrNoSuchObject) {
+			return nil, nil, ErrS3NoSuchUpload
+		}
+		return nil, nil, ErrConsensusWithDetail("failed to get object info from consensus, object_name: " + reqCtx.objectName + ", bucket_name: " + reqCtx.bucketName + ", error: " + err.Error())
+	}
+	if objectInfo.Id.String() != reqCtx.vars["uploadId"] {
+		log.CtxErrorw(reqCtx.Context(), "failed to check upload id", "upload_id", reqCtx.vars["uploadId"], "object_id", objectInfo.Id.String())
+		return nil, nil, ErrS3NoSuchUpload
+	}
+	if err = g.checkAndAssignShadowObjectInfo(reqCtx, objectInfo); err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to get shadow object info from consensus", "error", err)
+		return nil, nil, ErrConsensusWithDetail("failed to get shadow object info from consensus, object_name: " + reqCtx.objectName + ", bucket_name: " + reqCtx.bucketName + ", error: " + err.Error())
+	}
+	return bucketInfo, objectInfo, nil
+}
+
+// isS3UploadInProgress returns whether the payload of the object is being uploaded.
+func isS3UploadInProgress(objectInfo *storagetypes.ObjectInfo) bool {
+	return objectInfo.GetObjectStatus() == storagetypes.OBJECT_STATUS_CREATED || objectInfo.GetIsUpdating()
+}
+
+// s3UploadPartHandler handles the UploadPart request. The parts are mapped onto the resumable upload, so they must
+// be uploaded in order and all parts except the last one must have the same size which is a multiple of the max
+// segment size. A part which has been uploaded is accepted again, so that the S3 clients can retry.
+func (g *GateModular) s3UploadPartHandler(w http.ResponseWriter, reqCtx *RequestContext) error {
+	partNumber, err := util.StringToUint64(reqCtx.vars["partNumber"])
+	if err != nil || partNumber == 0 || partNumber > S3MaxPartNumber {
+		return ErrS3InvalidPart
+	}
+	if reqCtx.request.ContentLength < 0 {
+		return ErrS3MissingContentLength
+	}
+	partSize := uint64(reqCtx.request.ContentLength)
+	bucketInfo, objectInfo, err := g.s3QueryMultipartUpload(reqCtx)
+	if err != nil {
+		return err
+	}
+
+	md5Hash := md5.New()
+	body := io.TeeReader(reqCtx.request.Body, md5Hash)
+	var drain = func() error {
+		if _, err = io.Copy(io.Discard, body); err != nil {
+			log.CtxErrorw(reqCtx.Context(), "failed to read the uploaded part", "error", err)
+			return ErrExceptionStream
+		}
+		w.Header().Set(S3ETagHeader, `"`+hex.EncodeToString(md5Hash.Sum(nil))+`"`)
+		return nil
+	}
+	if !isS3UploadInProgress(objectInfo) {
+		// the multipart upload has been completed, the part is retried
+		return drain()
+	}
+
+	params, err := g.baseApp.Consensus().QueryStorageParamsByTimestamp(reqCtx.Context(), objectInfo.GetLatestUpdatedTime())
+	if err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to get storage params from consensus", "error", err)
+		return ErrConsensusWithDetail("failed to get storage params from consensus, object_name: " + reqCtx.objectName + ", bucket_name: " + reqCtx.bucketName + ", error: " + err.Error())
+	}
+	segmentCount, err := g.baseApp.GfSpClient().GetUploadObjectSegment(reqCtx.Context(), objectInfo.Id.Uint64())
+	if err != nil && err.Error() != metadata.ErrNoRecord.String() {
+		// ignore metadata.ErrNoRecord error
+		log.CtxErrorw(reqCtx.Context(), "failed to get uploading object segment", "error", err)
+		return err
+	}
+	uploaded := uint64(segmentCount) * params.GetMaxSegmentSize()
+	payloadSize := objectInfo.GetPayloadSize()
+
+	var complete bool
+	switch {
+	case partSize != 0 && uploaded+partSize == payloadSize:
+		complete = true
+	case partSize != 0 && partSize%params.GetMaxSegmentSize() == 0 && uploaded == (partNumber-1)*partSize &&
+		uploaded+partSize < payloadSize:
+		complete = false
+	case partSize != 0 && (uploaded >= payloadSize || uploaded >= partNumber*partSize):
+		return drain()
+	default:
+		log.CtxErrorw(reqCtx.Context(), "failed to check the part", "part_number", partNumber, "part_size", partSize,
+			"uploaded", uploaded, "payload_size", payloadSize)
+		return ErrS3InvalidPart
+	}
+
+	if err = reqCtx.checkBandwidthQuota(int64(partSize)); err != nil {
+		return err
+	}
+	uploadTask := &gfsptask.GfSpResumableUploadObjectTask{}
+	uploadTask.InitResumableUploadObjectTask(bucketInfo.GetGlobalVirtualGroupFamilyId(), objectInfo, params,
+		g.baseApp.TaskTimeout(uploadTask, objectInfo.GetPayloadSize()), complete, uploaded, true)
+	uploadTask.SetCreateTime(time.Now().Unix())
+	uploadTask.AppendLog("gateway-create-s3-upload-part-task")
+	ctx := log.WithValue(reqCtx.Context(), log.CtxKeyTask, uploadTask.Key().String())
+	if err = g.baseApp.GfSpClient().ResumableUploadObject(ctx, uploadTask, body); err != nil {
+		log.CtxErrorw(ctx, "failed to resumable upload part", "part_number", partNumber, "error", err)
+		return err
+	}
+	w.Header().Set(S3ETagHeader, `"`+hex.EncodeToString(md5Hash.Sum(nil))+`"`)
+	return nil
+}
+
+// s3CompleteMultipartUploadHandler handles the CompleteMultipartUpload request, the object is sealed by the SP
+// after the last part is uploaded, so it only checks all parts have been uploaded.
+func (g *GateModular) s3CompleteMultipartUploadHandler(w http.ResponseWriter, reqCtx *RequestContext) error {
+	body, err := io.ReadAll(reqCtx.request.Body)
+	if err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to read complete multipart upload body", "error", err)
+		return ErrExceptionStream
+	}
+	request := &S3CompleteMultipartUpload{}
+	if err = xml.Unmarshal(body, request); err != nil || len(request.Parts) == 0 {
+		log.CtxErrorw(reqCtx.Context(), "failed to parse complete multipart upload body", "error", err)
+		return ErrS3InvalidPart
+	}
+	var partETags []byte
+	for i, part := range request.Parts {
+		if part.PartNumber != i+1 {
+			return ErrS3InvalidPart
+		}
+		partETag, _ := hex.DecodeString(strings.Trim(part.ETag, `"`))
+		partETags = append(partETags, partETag...)
+	}
+
+	_, objectInfo, err := g.s3QueryMultipartUpload(reqCtx)
+	if err != nil {
+		return err
+	}
+	if isS3UploadInProgress(objectInfo) {
+		state, _, err := g.baseApp.GfSpClient().GetUploadObjectState(reqCtx.Context(), objectInfo.Id.Uint64())
+		if err != nil {
+			log.CtxErrorw(reqCtx.Context(), "failed to get uploading object state", "error", err)
+			return ErrS3InvalidPart
+		}
+		switch servicetypes.TaskState(state) {
+		case servicetypes.TaskState_TASK_STATE_INIT_UNSPECIFIED, servicetypes.TaskState_TASK_STATE_UPLOAD_OBJECT_DOING,
+			servicetypes.TaskState_TASK_STATE_UPLOAD_OBJECT_ERROR:
+			log.CtxErrorw(reqCtx.Context(), "the multipart upload is incomplete", "state", state)
+			return ErrS3InvalidPart
+		}
+	}
+
+	// the entity tag of the multipart upload follows the S3 convention, the md5 of the part md5s with the part count
+	md5Sum := md5.Sum(partETags)
+	respBytes, err := xml.Marshal(&S3CompleteMultipartUploadResult{
+		Location: "/" + reqCtx.bucketName + "/" + reqCtx.objectName,
+		Bucket:   reqCtx.bucketName,
+		Key:      reqCtx.objectName,
+		ETag:     `"` + hex.EncodeToString(md5Sum[:]) + "-" + strconv.Itoa(len(request.Parts)) + `"`,
+	})
+	if err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to marshal complete multipart upload result", "error", err)
+		return ErrEncodeResponseWithDetail("failed to marshal complete multipart upload result, error: " + err.Error())
+	}
+	w.Header().Set(ContentTypeHeader, ContentTypeXMLHeaderValue)
+	w.Write(respBytes)
+	return nil
+}
+
+// s3DelegateDeleteObject deletes the object on chain on behalf of the account by the SP. The account must have the
+// permission to delete the object, and the account must grant the SP the permission to delete the object too, since
+// the SP is the operator of the transaction.
+func (g *GateModular) s3DelegateDeleteObject(reqCtx *RequestContext) error {
+	effect, err := g.baseApp.GfSpClient().VerifyPermission(reqCtx.Context(), reqCtx.Account(), reqCtx.bucketName,
+		reqCtx.objectName, permissiontypes.ACTION_DELETE_OBJECT)
+	if err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to verify permission", "error", err)
+		return err
+	}
+	if *effect != permissiontypes.EFFECT_ALLOW {
+		log.CtxErrorw(reqCtx.Context(), "no permission to delete the object")
+		return ErrNoPermission
+	}
+	effect, err = g.baseApp.GfSpClient().VerifyPermission(reqCtx.Context(), g.baseApp.OperatorAddress(), reqCtx.bucketName,
+		reqCtx.objectName, permissiontypes.ACTION_DELETE_OBJECT)
+	if err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to verify permission of the sp", "error", err)
+		return err
+	}
+	if *effect != permissiontypes.EFFECT_ALLOW {
+		log.CtxErrorw(reqCtx.Context(), "the sp has no permission to delete the object")
+		return ErrS3DelegateDeleteNotAllow
+	}
+
+	txHash, err := g.baseApp.GfSpClient().DelegateDeleteObject(reqCtx.Context(), &storagetypes.MsgDeleteObject{
+		BucketName: reqCtx.bucketName,
+		ObjectName: reqCtx.objectName,
+	})
+	if err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to delegate delete object", "error", err)
+		return err
+	}
+	if _, err = g.baseApp.Consensus().ConfirmTransaction(reqCtx.Context(), txHash); err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to confirm transaction", "tx_hash", txHash, "error", err)
+		return err
+	}
+	return nil
+}
+
+// s3AbortMultipartUploadHandler handles the AbortMultipartUpload request, the created object is deleted which
+// cancels the creation on chain. Aborting the update of a sealed object is not supported.
+func (g *GateModular) s3AbortMultipartUploadHandler(w http.ResponseWriter, reqCtx *RequestContext) error {
+	_, objectInfo, err := g.s3QueryMultipartUpload(reqCtx)
+	if err != nil {
+		return err
+	}
+	if objectInfo.GetObjectStatus() != storagetypes.OBJECT_STATUS_CREATED {
+		if objectInfo.GetIsUpdating() {
+			return ErrS3NotImplemented
+		}
+		return ErrS3NoSuchUpload
+	}
+	if err = g.s3DelegateDeleteObject(reqCtx); err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// s3DeleteObjectHandler handles the DeleteObject request, deleting a missing object succeeds as S3 does.
+func (g *GateModular) s3DeleteObjectHandler(w http.ResponseWriter, reqCtx *RequestContext) error {
+	if err := checkS3ObjectName(reqCtx); err != nil {
+		return err
+	}
+	if _, err := g.baseApp.Consensus().QueryObjectInfo(reqCtx.Context(), reqCtx.bucketName, reqCtx.objectName); err != nil {
+		if errors.Is(err, gnfd.ErrNoSuchObject) {
+			w.WriteHeader(http.StatusNoContent)
+			return nil
+		}
+		log.CtxErrorw(reqCtx.Context(), "failed to get object info from consensus", "error", err)
+		return ErrConsensusWithDetail("failed to get object info from consensus, object_name: " + reqCtx.objectName + ", bucket_name: " + reqCtx.bucketName + ", error: " + err.Error())
+	}
+	if err := g.s3DelegateDeleteObject(reqCtx); err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
@@ -0,0 +1,310 @@
+package gater
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspclient"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gnfd"
+	"github.com/bnb-chain/greenfield-storage-provider/core/consensus"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/authenticator"
+	metadatatypes "github.com/bnb-chain/greenfield-storage-provider/modular/metadata/types"
+	servicetypes "github.com/bnb-chain/greenfield-storage-provider/store/types"
+	permissiontypes "github.com/bnb-chain/greenfield/x/permission/types"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+var (
+	mockS3SignatureMismatchErr = authenticator.ErrS3SignatureMismatch
+	mockNoSuchObjectErr        = gnfd.ErrNoSuchObject
+)
+
+const mockS3CompleteMultipartUploadBody = "<CompleteMultipartUpload><Part><PartNumber>1</PartNumber><ETag>\"00\"</ETag></Part>" +
+	"<Part><PartNumber>2</PartNumber><ETag>\"01\"</ETag></Part></CompleteMultipartUpload>"
+
+// mockS3Gater returns the gater whose client accepts the S3 signature of the account
+func mockS3Gater(t *testing.T) (*GateModular, *gfspclient.MockGfSpClientAPI, *consensus.MockConsensus) {
+	g := setup(t)
+	g.s3Region = DefaultS3Region
+	ctrl := gomock.NewController(t)
+	clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
+	clientMock.EXPECT().VerifyS3Signature(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("account", nil).AnyTimes()
+	consensusMock := consensus.NewMockConsensus(ctrl)
+	g.baseApp.SetGfSpClient(clientMock)
+	g.baseApp.SetConsensus(consensusMock)
+	g.baseApp.SetOperatorAddress("operator")
+	return g, clientMock, consensusMock
+}
+
+func mockS3ObjectInfo(status storagetypes.ObjectStatus) *storagetypes.ObjectInfo {
+	return &storagetypes.ObjectInfo{
+		Id:           sdkmath.NewUint(1),
+		BucketName:   mockBucketName,
+		ObjectName:   mockObjectName,
+		Creator:      "account",
+		Owner:        "account",
+		PayloadSize:  48,
+		ContentType:  ContentDefault,
+		ObjectStatus: status,
+		Checksums:    [][]byte{{1, 2}},
+		CreateAt:     1,
+	}
+}
+
+func TestGateModular_s3Handlers(t *testing.T) {
+	var allow, deny = permissiontypes.EFFECT_ALLOW, permissiontypes.EFFECT_DENY
+	params := &storagetypes.Params{VersionedParams: storagetypes.VersionedParams{MaxSegmentSize: 16}, MaxPayloadSize: 1024}
+	cases := []struct {
+		name         string
+		fn           func() *GateModular
+		request      func() *http.Request
+		wantedStatus int
+		wantedResult string
+		wantedHeader map[string]string
+	}{
+		{
+			name:         "unsupported api",
+			fn:           func() *GateModular { return setup(t) },
+			request:      func() *http.Request { return mockS3Request(http.MethodGet, "/mock-bucket-name", nil, time.Now()) },
+			wantedStatus: http.StatusNotImplemented,
+			wantedResult: "<Code>NotImplemented</Code>",
+		},
+		{
+			name: "signature does not match",
+			fn: func() *GateModular {
+				g := setup(t)
+				g.s3Region = DefaultS3Region
+				clientMock := gfspclient.NewMockGfSpClientAPI(gomock.NewController(t))
+				clientMock.EXPECT().VerifyS3Signature(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("", mockS3SignatureMismatchErr).Times(1)
+				g.baseApp.SetGfSpClient(clientMock)
+				return g
+			},
+			request: func() *http.Request {
+				return mockS3Request(http.MethodGet, "/mock-bucket-name/mock-object-name", nil, time.Now())
+			},
+			wantedStatus: http.StatusForbidden,
+			wantedResult: "<Code>SignatureDoesNotMatch</Code>",
+		},
+		{
+			name: "list objects v2",
+			fn: func() *GateModular {
+				g, clientMock, _ := mockS3Gater(t)
+				clientMock.EXPECT().ListObjectsByBucketName(gomock.Any(), mockBucketName, "", uint64(S3DefaultMaxKeys), "", "", "/", "", false).
+					Return([]*metadatatypes.Object{
+						{ObjectInfo: mockS3ObjectInfo(storagetypes.OBJECT_STATUS_SEALED)},
+						{ObjectInfo: &storagetypes.ObjectInfo{ObjectName: "created", ObjectStatus: storagetypes.OBJECT_STATUS_CREATED}},
+					}, uint64(3), uint64(S3DefaultMaxKeys), true, "bmV4dA==", mockBucketName, "", "/", []string{"dir/"}, "", nil).Times(1)
+				return g
+			},
+			request: func() *http.Request {
+				return mockS3Request(http.MethodGet, "/mock-bucket-name?list-type=2&delimiter=%2F", nil, time.Now())
+			},
+			wantedStatus: http.StatusOK,
+			wantedResult: "<KeyCount>2</KeyCount><MaxKeys>1000</MaxKeys><IsTruncated>true</IsTruncated><Contents>" +
+				"<Key>mock-object-name</Key><LastModified>1970-01-01T00:00:01.000Z</LastModified><ETag>&#34;0102&#34;</ETag>" +
+				"<Size>48</Size><StorageClass>STANDARD</StorageClass></Contents><CommonPrefixes><Prefix>dir/</Prefix></CommonPrefixes>",
+		},
+		{
+			name: "head object",
+			fn: func() *GateModular {
+				g, clientMock, consensusMock := mockS3Gater(t)
+				consensusMock.EXPECT().QueryObjectInfo(gomock.Any(), mockBucketName, mockObjectName).
+					Return(mockS3ObjectInfo(storagetypes.OBJECT_STATUS_SEALED), nil).Times(1)
+				clientMock.EXPECT().VerifyAuthentication(gomock.Any(), gomock.Any(), "account", mockBucketName, mockObjectName).
+					Return(true, nil).Times(1)
+				return g
+			},
+			request: func() *http.Request {
+				return mockS3Request(http.MethodHead, "/mock-bucket-name/mock-object-name", nil, time.Now())
+			},
+			wantedStatus: http.StatusOK,
+			wantedHeader: map[string]string{ContentLengthHeader: "48", S3ETagHeader: `"0102"`, S3AcceptRangesHeader: "bytes"},
+		},
+		{
+			name: "head unsealed object",
+			fn: func() *GateModular {
+				g, _, consensusMock := mockS3Gater(t)
+				consensusMock.EXPECT().QueryObjectInfo(gomock.Any(), mockBucketName, mockObjectName).
+					Return(mockS3ObjectInfo(storagetypes.OBJECT_STATUS_CREATED), nil).Times(1)
+				return g
+			},
+			request: func() *http.Request {
+				return mockS3Request(http.MethodHead, "/mock-bucket-name/mock-object-name", nil, time.Now())
+			},
+			wantedStatus: http.StatusNotFound,
+		},
+		{
+			name: "delete missing object",
+			fn: func() *GateModular {
+				g, _, consensusMock := mockS3Gater(t)
+				consensusMock.EXPECT().QueryObjectInfo(gomock.Any(), mockBucketName, mockObjectName).
+					Return(nil, mockNoSuchObjectErr).Times(1)
+				return g
+			},
+			request: func() *http.Request {
+				return mockS3Request(http.MethodDelete, "/mock-bucket-name/mock-object-name", nil, time.Now())
+			},
+			wantedStatus: http.StatusNoContent,
+		},
+		{
+			name: "delete object without the permission of the sp",
+			fn: func() *GateModular {
+				g, clientMock, consensusMock := mockS3Gater(t)
+				consensusMock.EXPECT().QueryObjectInfo(gomock.Any(), mockBucketName, mockObjectName).
+					Return(mockS3ObjectInfo(storagetypes.OBJECT_STATUS_SEALED), nil).Times(1)
+				clientMock.EXPECT().VerifyPermission(gomock.Any(), "account", mockBucketName, mockObjectName,
+					permissiontypes.ACTION_DELETE_OBJECT).Return(&allow, nil).Times(1)
+				clientMock.EXPECT().VerifyPermission(gomock.Any(), "operator", mockBucketName, mockObjectName,
+					permissiontypes.ACTION_DELETE_OBJECT).Return(&deny, nil).Times(1)
+				return g
+			},
+			request: func() *http.Request {
+				return mockS3Request(http.MethodDelete, "/mock-bucket-name/mock-object-name", nil, time.Now())
+			},
+			wantedStatus: http.StatusForbidden,
+			wantedResult: "<Code>AccessDenied</Code>",
+		},
+		{
+			name: "delete object",
+			fn: func() *GateModular {
+				g, clientMock, consensusMock := mockS3Gater(t)
+				consensusMock.EXPECT().QueryObjectInfo(gomock.Any(), mockBucketName, mockObjectName).
+					Return(mockS3ObjectInfo(storagetypes.OBJECT_STATUS_SEALED), nil).Times(1)
+				clientMock.EXPECT().VerifyPermission(gomock.Any(), gomock.Any(), mockBucketName, mockObjectName,
+					permissiontypes.ACTION_DELETE_OBJECT).Return(&allow, nil).Times(2)
+				clientMock.EXPECT().DelegateDeleteObject(gomock.Any(), &storagetypes.MsgDeleteObject{
+					BucketName: mockBucketName, ObjectName: mockObjectName}).Return("hash", nil).Times(1)
+				consensusMock.EXPECT().ConfirmTransaction(gomock.Any(), "hash").Return(&sdk.TxResponse{}, nil).Times(1)
+				return g
+			},
+			request: func() *http.Request {
+				return mockS3Request(http.MethodDelete, "/mock-bucket-name/mock-object-name", nil, time.Now())
+			},
+			wantedStatus: http.StatusNoContent,
+		},
+		{
+			name: "upload part out of order",
+			fn: func() *GateModular {
+				g, clientMock, consensusMock := mockS3Gater(t)
+				consensusMock.EXPECT().QueryBucketInfoAndObjectInfo(gomock.Any(), mockBucketName, mockObjectName).
+					Return(&storagetypes.BucketInfo{}, mockS3ObjectInfo(storagetypes.OBJECT_STATUS_CREATED), nil).Times(1)
+				consensusMock.EXPECT().QueryStorageParamsByTimestamp(gomock.Any(), gomock.Any()).Return(params, nil).Times(1)
+				clientMock.EXPECT().GetUploadObjectSegment(gomock.Any(), uint64(1)).Return(uint32(0), nil).Times(1)
+				return g
+			},
+			request: func() *http.Request {
+				return mockS3Request(http.MethodPut, "/mock-bucket-name/mock-object-name?partNumber=2&uploadId=1",
+					strings.NewReader(strings.Repeat("a", 16)), time.Now())
+			},
+			wantedStatus: http.StatusBadRequest,
+			wantedResult: "<Code>InvalidPart</Code>",
+		},
+		{
+			name: "upload part of another upload",
+			fn: func() *GateModular {
+				g, _, consensusMock := mockS3Gater(t)
+				consensusMock.EXPECT().QueryBucketInfoAndObjectInfo(gomock.Any(), mockBucketName, mockObjectName).
+					Return(&storagetypes.BucketInfo{}, mockS3ObjectInfo(storagetypes.OBJECT_STATUS_CREATED), nil).Times(1)
+				return g
+			},
+			request: func() *http.Request {
+				return mockS3Request(http.MethodPut, "/mock-bucket-name/mock-object-name?partNumber=1&uploadId=2",
+					strings.NewReader(strings.Repeat("a", 16)), time.Now())
+			},
+			wantedStatus: http.StatusNotFound,
+			wantedResult: "<Code>NoSuchUpload</Code>",
+		},
+		{
+			name: "upload the last part",
+			fn: func() *GateModular {
+				g, clientMock, consensusMock := mockS3Gater(t)
+				consensusMock.EXPECT().QueryBucketInfoAndObjectInfo(gomock.Any(), mockBucketName, mockObjectName).
+					Return(&storagetypes.BucketInfo{}, mockS3ObjectInfo(storagetypes.OBJECT_STATUS_CREATED), nil).Times(1)
+				consensusMock.EXPECT().QueryStorageParamsByTimestamp(gomock.Any(), gomock.Any()).Return(params, nil).Times(1)
+				clientMock.EXPECT().GetUploadObjectSegment(gomock.Any(), uint64(1)).Return(uint32(2), nil).Times(1)
+				clientMock.EXPECT().ResumableUploadObject(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				return g
+			},
+			request: func() *http.Request {
+				return mockS3Request(http.MethodPut, "/mock-bucket-name/mock-object-name?partNumber=3&uploadId=1",
+					strings.NewReader(strings.Repeat("a", 16)), time.Now())
+			},
+			wantedStatus: http.StatusOK,
+		},
+		{
+			name: "complete incomplete multipart upload",
+			fn: func() *GateModular {
+				g, clientMock, consensusMock := mockS3Gater(t)
+				consensusMock.EXPECT().QueryBucketInfoAndObjectInfo(gomock.Any(), mockBucketName, mockObjectName).
+					Return(&storagetypes.BucketInfo{}, mockS3ObjectInfo(storagetypes.OBJECT_STATUS_CREATED), nil).Times(1)
+				clientMock.EXPECT().GetUploadObjectState(gomock.Any(), uint64(1)).
+					Return(int32(servicetypes.TaskState_TASK_STATE_UPLOAD_OBJECT_DOING), "", nil).Times(1)
+				return g
+			},
+			request: func() *http.Request {
+				return mockS3Request(http.MethodPost, "/mock-bucket-name/mock-object-name?uploadId=1",
+					strings.NewReader(mockS3CompleteMultipartUploadBody), time.Now())
+			},
+			wantedStatus: http.StatusBadRequest,
+			wantedResult: "<Code>InvalidPart</Code>",
+		},
+		{
+			name: "complete multipart upload",
+			fn: func() *GateModular {
+				g, clientMock, consensusMock := mockS3Gater(t)
+				consensusMock.EXPECT().QueryBucketInfoAndObjectInfo(gomock.Any(), mockBucketName, mockObjectName).
+					Return(&storagetypes.BucketInfo{}, mockS3ObjectInfo(storagetypes.OBJECT_STATUS_CREATED), nil).Times(1)
+				clientMock.EXPECT().GetUploadObjectState(gomock.Any(), uint64(1)).
+					Return(int32(servicetypes.TaskState_TASK_STATE_REPLICATE_OBJECT_DOING), "", nil).Times(1)
+				return g
+			},
+			request: func() *http.Request {
+				return mockS3Request(http.MethodPost, "/mock-bucket-name/mock-object-name?uploadId=1",
+					strings.NewReader(mockS3CompleteMultipartUploadBody), time.Now())
+			},
+			wantedStatus: http.StatusOK,
+			wantedResult: "<ETag>&#34;441077cc9e57554dd476bdfb8b8b8102-2&#34;</ETag>",
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			router := mux.NewRouter().SkipClean(true)
+			tt.fn().RegisterS3Handler(router)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, tt.request())
+			assert.Equal(t, tt.wantedStatus, w.Code)
+			assert.Contains(t, w.Body.String(), tt.wantedResult)
+			for key, value := range tt.wantedHeader {
+				assert.Equal(t, value, w.Header().Get(key))
+			}
+		})
+	}
+}
+
+func Test_s3ObjectResponseWriter(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	w := &s3ObjectResponseWriter{ResponseWriter: recorder, payloadSize: 48}
+	w.Header().Set(ContentRangeHeader, "bytes 0-9")
+	_, err := w.Write([]byte("0123456789"))
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusPartialContent, recorder.Code)
+	assert.Equal(t, "bytes 0-9/48", recorder.Header().Get(ContentRangeHeader))
+	assert.Equal(t, "10", recorder.Header().Get(ContentLengthHeader))
+
+	recorder = httptest.NewRecorder()
+	w = &s3ObjectResponseWriter{ResponseWriter: recorder, payloadSize: 48}
+	_, err = w.Write([]byte("0123456789"))
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Empty(t, recorder.Header().Get(ContentRangeHeader))
+}
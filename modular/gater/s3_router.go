@@ -0,0 +1,176 @@
+package gater
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsperrors"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/authenticator"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
+	mwhttp "github.com/bnb-chain/greenfield-storage-provider/pkg/middleware/http"
+)
+
+const (
+	s3ListObjectsV2RouterName           = "S3ListObjectsV2"
+	s3GetObjectRouterName               = "S3GetObject"
+	s3HeadObjectRouterName              = "S3HeadObject"
+	s3PutObjectRouterName               = "S3PutObject"
+	s3DeleteObjectRouterName            = "S3DeleteObject"
+	s3CreateMultipartUploadRouterName   = "S3CreateMultipartUpload"
+	s3UploadPartRouterName              = "S3UploadPart"
+	s3CompleteMultipartUploadRouterName = "S3CompleteMultipartUpload"
+	s3AbortMultipartUploadRouterName    = "S3AbortMultipartUpload"
+)
+
+// S3ErrorResponse is used in the error response of the S3 compatible api.
+type S3ErrorResponse struct {
+	XMLName  xml.Name `xml:"Error"`
+	Code     string   `xml:"Code"`
+	Message  string   `xml:"Message"`
+	Resource string   `xml:"Resource"`
+}
+
+// s3ErrorCode is the error code of the S3 compatible api, the S3 clients retry or report by the code.
+type s3ErrorCode struct {
+	code       string
+	httpStatus int
+}
+
+// s3ErrorCodes maps the inner code of the sp errors to the S3 error codes.
+var s3ErrorCodes = map[int32]s3ErrorCode{
+	ErrS3AuthorizationHeader.GetInnerCode():               {"AuthorizationHeaderMalformed", http.StatusBadRequest},
+	ErrS3RequestTimeTooSkewed.GetInnerCode():              {"RequestTimeTooSkewed", http.StatusForbidden},
+	ErrS3ContentSHA256Mismatch.GetInnerCode():             {"XAmzContentSHA256Mismatch", http.StatusBadRequest},
+	ErrS3NotImplemented.GetInnerCode():                    {"NotImplemented", http.StatusNotImplemented},
+	ErrS3InvalidPart.GetInnerCode():                       {"InvalidPart", http.StatusBadRequest},
+	ErrS3MissingContentLength.GetInnerCode():              {"MissingContentLength", http.StatusLengthRequired},
+	ErrS3NoSuchUpload.GetInnerCode():                      {"NoSuchUpload", http.StatusNotFound},
+	ErrS3DelegateDeleteNotAllow.GetInnerCode():            {"AccessDenied", http.StatusForbidden},
+	ErrNoPermission.GetInnerCode():                        {"AccessDenied", http.StatusForbidden},
+	ErrInvalidRange.GetInnerCode():                        {"InvalidRange", http.StatusRequestedRangeNotSatisfiable},
+	ErrInvalidPayloadSize.GetInnerCode():                  {"EntityTooLarge", http.StatusBadRequest},
+	ErrConsensusNotFoundWithDetail("").GetInnerCode():     {"NoSuchKey", http.StatusNotFound},
+	authenticator.ErrS3AccessKeyNotExist.GetInnerCode():   {"InvalidAccessKeyId", http.StatusForbidden},
+	authenticator.ErrPublicKeyNotExist.GetInnerCode():     {"InvalidAccessKeyId", http.StatusForbidden},
+	authenticator.ErrPublicKeyExpired.GetInnerCode():      {"InvalidAccessKeyId", http.StatusForbidden},
+	authenticator.ErrS3SignatureMismatch.GetInnerCode():   {"SignatureDoesNotMatch", http.StatusForbidden},
+	authenticator.ErrInvalidS3StringToSign.GetInnerCode(): {"AuthorizationHeaderMalformed", http.StatusBadRequest},
+	mwhttp.ErrTooManyRequest.GetInnerCode():               {"SlowDown", http.StatusServiceUnavailable},
+	mwhttp.ErrBandwidthQuotaExceeded.GetInnerCode():       {"SlowDown", http.StatusServiceUnavailable},
+}
+
+// makeS3ErrorResponse writes the error response of the S3 compatible api, returns the http status code.
+func makeS3ErrorResponse(w http.ResponseWriter, r *http.Request, err error) int {
+	gfspErr := gfsperrors.MakeGfSpError(err)
+	errCode, ok := s3ErrorCodes[gfspErr.GetInnerCode()]
+	if !ok {
+		switch gfspErr.GetHttpStatusCode() {
+		case http.StatusBadRequest:
+			errCode = s3ErrorCode{"InvalidRequest", http.StatusBadRequest}
+		case http.StatusUnauthorized, http.StatusForbidden:
+			errCode = s3ErrorCode{"AccessDenied", http.StatusForbidden}
+		case http.StatusNotFound:
+			errCode = s3ErrorCode{"NoSuchKey", http.StatusNotFound}
+		case http.StatusNotImplemented:
+			errCode = s3ErrorCode{"NotImplemented", http.StatusNotImplemented}
+		default:
+			errCode = s3ErrorCode{"InternalError", http.StatusInternalServerError}
+		}
+	}
+	w.Header().Set(ContentTypeHeader, ContentTypeXMLHeaderValue)
+	w.WriteHeader(errCode.httpStatus)
+	// the response of the HEAD request has no body
+	if r.Method == http.MethodHead {
+		return errCode.httpStatus
+	}
+	xmlBody, err := xml.Marshal(&S3ErrorResponse{
+		Code:     errCode.code,
+		Message:  gfspErr.GetDescription(),
+		Resource: r.URL.Path,
+	})
+	if err != nil {
+		log.Errorw("failed to marshal s3 error response", "gfsp_error", gfspErr.String(), "error", err)
+		return errCode.httpStatus
+	}
+	if _, err = w.Write(xmlBody); err != nil {
+		log.Errorw("failed to write s3 error response", "gfsp_error", gfspErr.String(), "error", err)
+	}
+	return errCode.httpStatus
+}
+
+// s3HandlerFunc is the handler of the S3 compatible api, the request has passed the signature verification.
+type s3HandlerFunc func(w http.ResponseWriter, reqCtx *RequestContext) error
+
+// s3Handler verifies the S3 signature of the request before calling the handler, writes the S3 error response
+// and records the metrics of the request.
+func (g *GateModular) s3Handler(handler s3HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var (
+			err    error
+			reqCtx *RequestContext
+		)
+		startTime := time.Now()
+		defer func() {
+			reqCtx.Cancel()
+			if err != nil {
+				reqCtx.SetError(gfsperrors.MakeGfSpError(err))
+				reqCtx.SetHTTPCode(makeS3ErrorResponse(w, r, err))
+				metrics.ReqCounter.WithLabelValues(GatewayTotalFailure).Inc()
+				metrics.ReqTime.WithLabelValues(GatewayTotalFailure).Observe(time.Since(startTime).Seconds())
+			} else {
+				reqCtx.SetHTTPCode(http.StatusOK)
+				metrics.ReqCounter.WithLabelValues(GatewayTotalSuccess).Inc()
+				metrics.ReqTime.WithLabelValues(GatewayTotalSuccess).Observe(time.Since(startTime).Seconds())
+			}
+			log.CtxDebugw(reqCtx.Context(), reqCtx.String())
+		}()
+
+		reqCtx, err = NewS3RequestContext(r, g)
+		if err != nil {
+			return
+		}
+		err = handler(w, reqCtx)
+	}
+}
+
+// s3NotImplementedHandler responds the S3 apis which are not supported by the facade.
+func (g *GateModular) s3NotImplementedHandler(w http.ResponseWriter, r *http.Request) {
+	log.Errorw("failed to find the corresponding s3 handler", "method", r.Method, "host", r.Host, "url", r.URL)
+	if _, err := io.Copy(io.Discard, r.Body); err != nil {
+		log.Errorw("failed to read the unknown s3 request", "error", err)
+	}
+	makeS3ErrorResponse(w, r, ErrS3NotImplemented)
+}
+
+// RegisterS3Handler registers the handlers of the S3 compatible api, only the path style is supported.
+func (g *GateModular) RegisterS3Handler(router *mux.Router) {
+	// multipart upload router, the multipart upload is mapped onto the resumable upload of the object
+	router.Path("/{bucket}/{object:.+}").Name(s3UploadPartRouterName).Methods(http.MethodPut).
+		Queries("partNumber", "{partNumber}", "uploadId", "{uploadId}").HandlerFunc(g.s3Handler(g.s3UploadPartHandler))
+	router.Path("/{bucket}/{object:.+}").Name(s3CreateMultipartUploadRouterName).Methods(http.MethodPost).
+		Queries("uploads", "").HandlerFunc(g.s3Handler(g.s3CreateMultipartUploadHandler))
+	router.Path("/{bucket}/{object:.+}").Name(s3CompleteMultipartUploadRouterName).Methods(http.MethodPost).
+		Queries("uploadId", "{uploadId}").HandlerFunc(g.s3Handler(g.s3CompleteMultipartUploadHandler))
+	router.Path("/{bucket}/{object:.+}").Name(s3AbortMultipartUploadRouterName).Methods(http.MethodDelete).
+		Queries("uploadId", "{uploadId}").HandlerFunc(g.s3Handler(g.s3AbortMultipartUploadHandler))
+
+	// object router
+	router.Path("/{bucket}/{object:.+}").Name(s3PutObjectRouterName).Methods(http.MethodPut).HandlerFunc(g.s3Handler(g.s3PutObjectHandler))
+	router.Path("/{bucket}/{object:.+}").Name(s3DeleteObjectRouterName).Methods(http.MethodDelete).HandlerFunc(g.s3Handler(g.s3DeleteObjectHandler))
+	router.Path("/{bucket}/{object:.+}").Name(s3HeadObjectRouterName).Methods(http.MethodHead).HandlerFunc(g.s3Handler(g.s3HeadObjectHandler))
+	router.Path("/{bucket}/{object:.+}").Name(s3GetObjectRouterName).Methods(http.MethodGet).HandlerFunc(g.s3Handler(g.s3GetObjectHandler))
+
+	// bucket router
+	for _, path := range []string{"/{bucket}", "/{bucket}/"} {
+		router.Path(path).Name(s3ListObjectsV2RouterName).Methods(http.MethodGet).
+			Queries("list-type", "2").HandlerFunc(g.s3Handler(g.s3ListObjectsV2Handler))
+	}
+
+	router.NotFoundHandler = http.HandlerFunc(g.s3NotImplementedHandler)
+	router.MethodNotAllowedHandler = http.HandlerFunc(g.s3NotImplementedHandler)
+}
@@ -0,0 +1,134 @@
+package gater
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsperrors"
+	modelgateway "github.com/bnb-chain/greenfield-storage-provider/model/gateway"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/metadata/types"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/util"
+	"github.com/bnb-chain/greenfield/types/s3util"
+)
+
+// searchObjectsHandler handles the search objects request, the objects of the bucket are filtered by name pattern,
+// prefix, payload size, content type and create time, and are returned in the order of object name.
+func (g *GateModular) searchObjectsHandler(w http.ResponseWriter, r *http.Request) {
+	var (
+		err                      error
+		respBytes                []byte
+		reqCtx                   *RequestContext
+		decodedContinuationToken []byte
+		resp                     *types.GfSpSearchObjectsResponse
+	)
+	startTime := time.Now()
+	defer func() {
+		reqCtx.Cancel()
+		handlerName := mux.CurrentRoute(r).GetName()
+		if err != nil {
+			reqCtx.SetError(gfsperrors.MakeGfSpError(err))
+			modelgateway.MakeErrorResponse(w, err)
+			MetadataHandlerFailureMetrics(err, startTime, handlerName)
+		} else {
+			MetadataHandlerSuccessMetrics(startTime, handlerName)
+		}
+		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
+	}()
+
+	if reqCtx, err = newOptionalAuthRequestContext(r, g); err != nil {
+		return
+	}
+	queryParams := reqCtx.request.URL.Query()
+	req := &types.GfSpSearchObjectsRequest{
+		BucketName:  reqCtx.bucketName,
+		NamePattern: queryParams.Get(SearchObjectsNamePatternQuery),
+		Prefix:      queryParams.Get(ListObjectsPrefixQuery),
+		ContentType: queryParams.Get(SearchObjectsContentTypeQuery),
+	}
+
+	if err = s3util.CheckValidBucketName(req.GetBucketName()); err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to check bucket name", "bucket_name", req.GetBucketName(), "error", err)
+		err = ErrInvalidQuery
+		return
+	}
+	if !checkValidObjectPrefix(req.GetPrefix()) {
+		log.CtxErrorw(reqCtx.Context(), "failed to check prefix", "prefix", req.GetPrefix())
+		err = ErrInvalidQuery
+		return
+	}
+	if len(req.GetNamePattern()) > MaxSearchObjectsPatternLength || len(req.GetContentType()) > MaxSearchObjectsPatternLength {
+		log.CtxErrorw(reqCtx.Context(), "failed to check name pattern or content type due to too long")
+		err = ErrInvalidQuery
+		return
+	}
+
+	if requestMaxKeys := queryParams.Get(ListObjectsMaxKeysQuery); requestMaxKeys != "" {
+		if req.MaxKeys, err = util.StringToUint64(requestMaxKeys); err != nil || req.GetMaxKeys() == 0 {
+			log.CtxErrorw(reqCtx.Context(), "failed to parse or check max keys", "max_keys", requestMaxKeys, "error", err)
+			err = ErrInvalidQuery
+			return
+		}
+	}
+	if requestMinSize := queryParams.Get(SearchObjectsMinSizeQuery); requestMinSize != "" {
+		if req.MinSize, err = util.StringToUint64(requestMinSize); err != nil {
+			log.CtxErrorw(reqCtx.Context(), "failed to parse min size", "min_size", requestMinSize, "error", err)
+			err = ErrInvalidQuery
+			return
+		}
+	}
+	if requestMaxSize := queryParams.Get(SearchObjectsMaxSizeQuery); requestMaxSize != "" {
+		if req.MaxSize, err = util.StringToUint64(requestMaxSize); err != nil || req.GetMaxSize() == 0 ||
+			req.GetMinSize() > req.GetMaxSize() {
+			log.CtxErrorw(reqCtx.Context(), "failed to parse or check max size", "max_size", requestMaxSize, "error", err)
+			err = ErrInvalidQuery
+			return
+		}
+	}
+	if requestCreatedAfter := queryParams.Get(SearchObjectsCreatedAfterQuery); requestCreatedAfter != "" {
+		if req.CreatedAfter, err = strconv.ParseInt(requestCreatedAfter, 10, 64); err != nil || req.GetCreatedAfter() < 0 {
+			log.CtxErrorw(reqCtx.Context(), "failed to parse or check created after", "created_after", requestCreatedAfter, "error", err)
+			err = ErrInvalidQuery
+			return
+		}
+	}
+	if requestCreatedBefore := queryParams.Get(SearchObjectsCreatedBeforeQuery); requestCreatedBefore != "" {
+		if req.CreatedBefore, err = strconv.ParseInt(requestCreatedBefore, 10, 64); err != nil ||
+			req.GetCreatedBefore() <= req.GetCreatedAfter() {
+			log.CtxErrorw(reqCtx.Context(), "failed to parse or check created before", "created_before", requestCreatedBefore, "error", err)
+			err = ErrInvalidQuery
+			return
+		}
+	}
+
+	if requestContinuationToken := queryParams.Get(ListObjectsContinuationTokenQuery); requestContinuationToken != "" {
+		if decodedContinuationToken, err = base64.StdEncoding.DecodeString(requestContinuationToken); err != nil {
+			log.CtxErrorw(reqCtx.Context(), "failed to decode continuation token", "continuation_token", requestContinuationToken, "error", err)
+			err = ErrInvalidQuery
+			return
+		}
+		req.ContinuationToken = string(decodedContinuationToken)
+		if err = s3util.CheckValidObjectName(req.GetContinuationToken()); err != nil {
+			log.CtxErrorw(reqCtx.Context(), "failed to check continuation token", "continuation_token", req.GetContinuationToken(), "error", err)
+			err = ErrInvalidQuery
+			return
+		}
+	}
+
+	if resp, err = g.baseApp.GfSpClient().SearchObjects(reqCtx.Context(), req); err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to search objects", "error", err)
+		return
+	}
+
+	if respBytes, err = xml.Marshal(resp); err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to marshal search objects response", "error", err)
+		return
+	}
+	w.Header().Set(ContentTypeHeader, ContentTypeXMLHeaderValue)
+	w.Write(respBytes)
+}
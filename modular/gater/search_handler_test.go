@@ -0,0 +1,123 @@
+package gater
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspclient"
+	metadatatypes "github.com/bnb-chain/greenfield-storage-provider/modular/metadata/types"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+func mockSearchObjectsRoute(t *testing.T, g *GateModular) *mux.Router {
+	t.Helper()
+	router := mux.NewRouter().SkipClean(true)
+	var routers []*mux.Router
+	routers = append(routers, router.Host("{bucket:.+}."+g.domain).Subrouter())
+	routers = append(routers, router.PathPrefix("/{bucket}").Subrouter())
+	for _, r := range routers {
+		r.NewRoute().Name(searchObjectsRouterName).Methods(http.MethodGet).Path("/").Queries(SearchObjectsQuery, "").
+			HandlerFunc(g.searchObjectsHandler)
+	}
+	return router
+}
+
+func mockSearchObjectsRequest(query string) *http.Request {
+	path := fmt.Sprintf("%s%s.%s/?%s&%s", scheme, mockBucketName, testDomain, SearchObjectsQuery, query)
+	return httptest.NewRequest(http.MethodGet, path, strings.NewReader(""))
+}
+
+func TestGateModular_searchObjectsHandler(t *testing.T) {
+	cases := []struct {
+		name         string
+		fn           func() *GateModular
+		query        string
+		wantedResult string
+	}{
+		{
+			name:         "invalid max keys",
+			fn:           func() *GateModular { return setup(t) },
+			query:        "max-keys=0",
+			wantedResult: "invalid request params for query",
+		},
+		{
+			name:         "invalid size range",
+			fn:           func() *GateModular { return setup(t) },
+			query:        "min-size=2048&max-size=1024",
+			wantedResult: "invalid request params for query",
+		},
+		{
+			name:         "invalid time range",
+			fn:           func() *GateModular { return setup(t) },
+			query:        "created-after=200&created-before=100",
+			wantedResult: "invalid request params for query",
+		},
+		{
+			name:         "invalid continuation token",
+			fn:           func() *GateModular { return setup(t) },
+			query:        "continuation-token=%25",
+			wantedResult: "invalid request params for query",
+		},
+		{
+			name:         "too long name pattern",
+			fn:           func() *GateModular { return setup(t) },
+			query:        "name-pattern=" + strings.Repeat("a", MaxSearchObjectsPatternLength+1),
+			wantedResult: "invalid request params for query",
+		},
+		{
+			name: "failed to search objects",
+			fn: func() *GateModular {
+				g := setup(t)
+				ctrl := gomock.NewController(t)
+				clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
+				clientMock.EXPECT().SearchObjects(gomock.Any(), gomock.Any()).Return(nil, mockErr).Times(1)
+				g.baseApp.SetGfSpClient(clientMock)
+				return g
+			},
+			query:        "min-size=1024",
+			wantedResult: "mock error",
+		},
+		{
+			name: "success",
+			fn: func() *GateModular {
+				g := setup(t)
+				ctrl := gomock.NewController(t)
+				clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
+				clientMock.EXPECT().SearchObjects(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ interface{}, req *metadatatypes.GfSpSearchObjectsRequest, _ ...interface{}) (*metadatatypes.GfSpSearchObjectsResponse, error) {
+						assert.Equal(t, mockBucketName, req.GetBucketName())
+						assert.Equal(t, "*.mp4", req.GetNamePattern())
+						assert.Equal(t, uint64(1024), req.GetMinSize())
+						assert.Equal(t, "video/*", req.GetContentType())
+						assert.Equal(t, int64(100), req.GetCreatedAfter())
+						assert.Equal(t, "a.mp4", req.GetContinuationToken())
+						return &metadatatypes.GfSpSearchObjectsResponse{
+							Objects:  []*metadatatypes.Object{{ObjectInfo: &storagetypes.ObjectInfo{ObjectName: "b.mp4"}}},
+							KeyCount: 1,
+						}, nil
+					}).Times(1)
+				g.baseApp.SetGfSpClient(clientMock)
+				return g
+			},
+			query: fmt.Sprintf("name-pattern=%%2A.mp4&min-size=1024&content-type=video%%2F%%2A&created-after=100&continuation-token=%s",
+				base64.StdEncoding.EncodeToString([]byte("a.mp4"))),
+			wantedResult: "<ObjectName>b.mp4</ObjectName>",
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			router := mockSearchObjectsRoute(t, tt.fn())
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, mockSearchObjectsRequest(tt.query))
+			assert.Contains(t, w.Body.String(), tt.wantedResult)
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package manager
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/bnb-chain/greenfield-common/go/hash"
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
+	"github.com/bnb-chain/greenfield-storage-provider/core/piecestore"
+	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+	coretask "github.com/bnb-chain/greenfield-storage-provider/core/task"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
+	"github.com/bnb-chain/greenfield-storage-provider/store/piecestore/storage"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+const (
+	// IntegrityScrubKey defines the key of the integrity scrubber progress in sp db.
+	IntegrityScrubKey = "piece_integrity"
+
+	// scrubObjectIDRange defines the number of object ids scrubbed in one batch, the progress is
+	// persisted after each batch.
+	scrubObjectIDRange = 100
+
+	// scrubBackoffInterval is used to backoff when failed to scrub a batch.
+	scrubBackoffInterval = 10 * time.Second
+)
+
+// IntegrityScrubber walks the integrity metas in sp db by the object id range, re-hashes the pieces from
+// the piece store and compares them with the piece checksums. The corrupt or missing pieces are healed by
+// the recovery piece tasks. The progress is persisted to sp db after each batch, so the scrubber resumes
+// from the checkpoint after restarting.
+type IntegrityScrubber struct {
+	manager       *ManageModular
+	limiter       *rate.Limiter
+	roundInterval time.Duration
+	progress      *spdb.ScrubProgress
+}
+
+// NewIntegrityScrubber returns an integrity scrubber instance.
+func NewIntegrityScrubber(m *ManageModular) *IntegrityScrubber {
+	return &IntegrityScrubber{
+		manager:       m,
+		limiter:       rate.NewLimiter(rate.Limit(m.integrityScrubRate), m.integrityScrubRate),
+		roundInterval: m.integrityScrubRoundInterval,
+	}
+}
+
+// Start is used to start the integrity scrubber.
+func (s *IntegrityScrubber) Start(ctx context.Context) {
+	go s.run(ctx)
+	log.Info("integrity scrubber startup")
+}
+
+func (s *IntegrityScrubber) run(ctx context.Context) {
+	for {
+		var interval time.Duration
+		finished, err := s.scrubNext(ctx)
+		if err != nil {
+			log.CtxErrorw(ctx, "failed to scrub piece integrity and try again later", "error", err)
+			interval = scrubBackoffInterval
+		} else if finished {
+			interval = s.roundInterval
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+// scrubNext scrubs the next batch of objects and persists the progress, it returns true if all objects
+// have been scrubbed in the current round.
+func (s *IntegrityScrubber) scrubNext(ctx context.Context) (bool, error) {
+	if s.progress == nil {
+		progress, err := s.manager.baseApp.GfSpDB().QueryScrubProgress(IntegrityScrubKey)
+		if err != nil {
+			return false, err
+		}
+		s.progress = progress
+		log.CtxInfow(ctx, "succeed to load integrity scrub progress", "next_object_id", progress.NextObjectID,
+			"round", progress.Round)
+	}
+	latestObjectID, err := s.manager.baseApp.GfSpClient().GetLatestObjectID(ctx)
+	if err != nil {
+		return false, err
+	}
+	start := s.progress.NextObjectID
+	if start > latestObjectID {
+		log.CtxInfow(ctx, "finished a round of integrity scrub", "round", s.progress.Round,
+			"scanned_pieces", s.progress.ScannedPieces, "corrupt_pieces", s.progress.CorruptPieces)
+		s.progress.NextObjectID = 0
+		s.progress.Round++
+		s.progress.ScannedPieces = 0
+		s.progress.CorruptPieces = 0
+		return true, s.saveProgress()
+	}
+
+	end := start + scrubObjectIDRange
+	integrityMetas, err := s.manager.baseApp.GfSpDB().ListIntegrityMetaByObjectIDRange(int64(start), int64(end), true)
+	if err != nil {
+		return false, err
+	}
+	objectMetas := make(map[uint64][]*spdb.IntegrityMeta)
+	objectIDs := make([]uint64, 0)
+	for _, meta := range integrityMetas {
+		if _, ok := objectMetas[meta.ObjectID]; !ok {
+			objectIDs = append(objectIDs, meta.ObjectID)
+		}
+		objectMetas[meta.ObjectID] = append(objectMetas[meta.ObjectID], meta)
+	}
+	sort.Slice(objectIDs, func(i, j int) bool { return objectIDs[i] < objectIDs[j] })
+	for _, objectID := range objectIDs {
+		if err = s.scrubObject(ctx, objectID, objectMetas[objectID]); err != nil {
+			if ctx.Err() != nil {
+				return false, err
+			}
+			log.CtxErrorw(ctx, "failed to scrub object", "object_id", objectID, "error", err)
+		}
+	}
+	s.progress.NextObjectID = end
+	return false, s.saveProgress()
+}
+
+// scrubObject re-hashes all pieces of the object stored in the sp, and schedules the recovery tasks for
+// the corrupt or missing pieces.
+func (s *IntegrityScrubber) scrubObject(ctx context.Context, objectID uint64, integrityMetas []*spdb.IntegrityMeta) error {
+	objectInfo, err := s.manager.baseApp.GfSpClient().GetObjectByID(ctx, objectID)
+	if err != nil {
+		return err
+	}
+	// the pieces of the object in uploading may be incomplete
+	if objectInfo.GetObjectStatus() != storagetypes.OBJECT_STATUS_SEALED {
+		return nil
+	}
+	params, err := s.manager.baseApp.Consensus().QueryStorageParamsByTimestamp(ctx, objectInfo.GetCreateAt())
+	if err != nil {
+		return err
+	}
+	for _, meta := range integrityMetas {
+		for segmentIdx, checksum := range meta.PieceChecksumList {
+			if err = s.limiter.Wait(ctx); err != nil {
+				return err
+			}
+			var pieceKey string
+			if meta.RedundancyIndex == piecestore.PrimarySPRedundancyIndex {
+				pieceKey = s.manager.baseApp.PieceOp().SegmentPieceKey(objectID, uint32(segmentIdx), objectInfo.GetVersion())
+			} else {
+				pieceKey = s.manager.baseApp.PieceOp().ECPieceKey(objectID, uint32(segmentIdx),
+					uint32(meta.RedundancyIndex), objectInfo.GetVersion())
+			}
+			s.progress.ScannedPieces++
+			metrics.ScrubPieceCounter.WithLabelValues(ScrubScannedPiece).Inc()
+			data, getErr := s.manager.baseApp.PieceStore().GetPiece(ctx, pieceKey, 0, -1)
+			if getErr != nil {
+				if !storage.IsErrNoSuchObject(getErr) {
+					log.CtxErrorw(ctx, "failed to get piece to scrub", "piece_key", pieceKey, "error", getErr)
+					continue
+				}
+				log.CtxErrorw(ctx, "found missing piece", "piece_key", pieceKey)
+				metrics.ScrubPieceCounter.WithLabelValues(ScrubMissingPiece).Inc()
+			} else if !bytes.Equal(hash.GenerateChecksum(data), checksum) {
+				log.CtxErrorw(ctx, "found corrupt piece", "piece_key", pieceKey)
+				metrics.ScrubPieceCounter.WithLabelValues(ScrubCorruptPiece).Inc()
+			} else {
+				continue
+			}
+			s.progress.CorruptPieces++
+			s.recoverPiece(ctx, objectInfo, params, uint32(segmentIdx), meta.RedundancyIndex)
+		}
+	}
+	return nil
+}
+
+func (s *IntegrityScrubber) recoverPiece(ctx context.Context, objectInfo *storagetypes.ObjectInfo,
+	params *storagetypes.Params, segmentIdx uint32, redundancyIdx int32) {
+	if objectInfo.GetRedundancyType() != storagetypes.REDUNDANCY_EC_TYPE {
+		log.CtxErrorw(ctx, "failed to recover piece due to unsupported redundancy type",
+			"object_id", objectInfo.Id.Uint64(), "segment_idx", segmentIdx, "redundancy_idx", redundancyIdx)
+		return
+	}
+	task := &gfsptask.GfSpRecoverPieceTask{}
+	task.InitRecoverPieceTask(objectInfo, params, coretask.DefaultSmallerPriority, segmentIdx, redundancyIdx,
+		params.GetMaxSegmentSize(), MaxRecoveryTime, maxRecoveryRetry)
+	if err := s.manager.HandleRecoverPieceTask(ctx, task); err != nil && !errors.Is(err, ErrRepeatedTask) {
+		log.CtxErrorw(ctx, "failed to schedule recovery task for scrubbed piece", "task_info", task.Info(), "error", err)
+		return
+	}
+	log.CtxInfow(ctx, "succeed to schedule recovery task for scrubbed piece", "task_info", task.Info())
+}
+
+func (s *IntegrityScrubber) saveProgress() error {
+	s.progress.UpdateTime = time.Now().Unix()
+	metrics.ScrubObjectIDGauge.WithLabelValues(ScrubNextObjectID).Set(float64(s.progress.NextObjectID))
+	metrics.ScrubObjectIDGauge.WithLabelValues(ScrubRound).Set(float64(s.progress.Round))
+	return s.manager.baseApp.GfSpDB().UpdateScrubProgress(s.progress)
+}
@@ -0,0 +1,141 @@
+package manager
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/bnb-chain/greenfield-common/go/hash"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspclient"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfsppieceop"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfsptqueue"
+	"github.com/bnb-chain/greenfield-storage-provider/core/consensus"
+	"github.com/bnb-chain/greenfield-storage-provider/core/piecestore"
+	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+)
+
+func setupIntegrityScrubber(t *testing.T) (*IntegrityScrubber, *spdb.MockSPDB, *gfspclient.MockGfSpClientAPI,
+	*piecestore.MockPieceStore) {
+	t.Helper()
+	m := setup(t)
+	ctrl := gomock.NewController(t)
+	m.recoveryQueue = gfsptqueue.NewGfSpTQueueWithLimit("test", 10)
+	m.recoveryTaskMap = make(map[string]string)
+	m.integrityScrubRate = 1000
+	m.baseApp.SetPieceOp(&gfsppieceop.GfSpPieceOp{})
+
+	db := spdb.NewMockSPDB(ctrl)
+	m.baseApp.SetGfSpDB(db)
+	client := gfspclient.NewMockGfSpClientAPI(ctrl)
+	m.baseApp.SetGfSpClient(client)
+	pieceStore := piecestore.NewMockPieceStore(ctrl)
+	m.baseApp.SetPieceStore(pieceStore)
+	con := consensus.NewMockConsensus(ctrl)
+	m.baseApp.SetConsensus(con)
+	con.EXPECT().QueryStorageParamsByTimestamp(gomock.Any(), gomock.Any()).Return(&storagetypes.Params{
+		VersionedParams: storagetypes.VersionedParams{MaxSegmentSize: 10},
+	}, nil).AnyTimes()
+	return NewIntegrityScrubber(m), db, client, pieceStore
+}
+
+func TestIntegrityScrubber_ScrubRound(t *testing.T) {
+	s, db, client, pieceStore := setupIntegrityScrubber(t)
+	var (
+		segment   = []byte("segment")
+		checksum  = hash.GenerateChecksum(segment)
+		ctx       = context.Background()
+		newObject = func(id uint64, status storagetypes.ObjectStatus) *storagetypes.ObjectInfo {
+			return &storagetypes.ObjectInfo{
+				Id:             sdkmath.NewUint(id),
+				ObjectStatus:   status,
+				RedundancyType: storagetypes.REDUNDANCY_EC_TYPE,
+			}
+		}
+		savedProgress []spdb.ScrubProgress
+	)
+	db.EXPECT().QueryScrubProgress(IntegrityScrubKey).Return(&spdb.ScrubProgress{ScrubKey: IntegrityScrubKey}, nil).Times(1)
+	db.EXPECT().UpdateScrubProgress(gomock.Any()).DoAndReturn(func(progress *spdb.ScrubProgress) error {
+		savedProgress = append(savedProgress, *progress)
+		return nil
+	}).AnyTimes()
+	client.EXPECT().GetLatestObjectID(gomock.Any()).Return(uint64(150), nil).AnyTimes()
+	db.EXPECT().ListIntegrityMetaByObjectIDRange(int64(0), int64(100), true).Return([]*spdb.IntegrityMeta{
+		{ObjectID: 2, RedundancyIndex: 0, PieceChecksumList: [][]byte{checksum}},
+		{ObjectID: 1, RedundancyIndex: piecestore.PrimarySPRedundancyIndex, PieceChecksumList: [][]byte{checksum, checksum}},
+		{ObjectID: 3, RedundancyIndex: piecestore.PrimarySPRedundancyIndex, PieceChecksumList: [][]byte{checksum}},
+		{ObjectID: 4, RedundancyIndex: piecestore.PrimarySPRedundancyIndex, PieceChecksumList: [][]byte{checksum}},
+		{ObjectID: 5, RedundancyIndex: piecestore.PrimarySPRedundancyIndex, PieceChecksumList: [][]byte{checksum}},
+	}, nil).Times(1)
+	db.EXPECT().ListIntegrityMetaByObjectIDRange(int64(100), int64(200), true).Return(nil, nil).Times(1)
+	client.EXPECT().GetObjectByID(gomock.Any(), uint64(1)).Return(newObject(1, storagetypes.OBJECT_STATUS_SEALED), nil)
+	client.EXPECT().GetObjectByID(gomock.Any(), uint64(2)).Return(newObject(2, storagetypes.OBJECT_STATUS_SEALED), nil)
+	client.EXPECT().GetObjectByID(gomock.Any(), uint64(3)).Return(newObject(3, storagetypes.OBJECT_STATUS_CREATED), nil)
+	client.EXPECT().GetObjectByID(gomock.Any(), uint64(4)).Return(newObject(4, storagetypes.OBJECT_STATUS_SEALED), nil)
+	client.EXPECT().GetObjectByID(gomock.Any(), uint64(5)).Return(nil, errors.New("mock error"))
+	// healthy segment
+	pieceStore.EXPECT().GetPiece(gomock.Any(), "s1_s0", int64(0), int64(-1)).Return(segment, nil)
+	// corrupt segment
+	pieceStore.EXPECT().GetPiece(gomock.Any(), "s1_s1", int64(0), int64(-1)).Return([]byte("corrupt"), nil)
+	// missing ec piece
+	pieceStore.EXPECT().GetPiece(gomock.Any(), "e2_s0_p0", int64(0), int64(-1)).Return(nil, os.ErrNotExist)
+	// failed to read, the piece is not recovered
+	pieceStore.EXPECT().GetPiece(gomock.Any(), "s4_s0", int64(0), int64(-1)).Return(nil, errors.New("mock error"))
+
+	finished, err := s.scrubNext(ctx)
+	assert.Nil(t, err)
+	assert.False(t, finished)
+	assert.Equal(t, uint64(100), s.progress.NextObjectID)
+	assert.Equal(t, uint64(4), s.progress.ScannedPieces)
+	assert.Equal(t, uint64(2), s.progress.CorruptPieces)
+	assert.Equal(t, 2, s.manager.recoveryQueue.Len())
+
+	finished, err = s.scrubNext(ctx)
+	assert.Nil(t, err)
+	assert.False(t, finished)
+	assert.Equal(t, uint64(200), s.progress.NextObjectID)
+
+	finished, err = s.scrubNext(ctx)
+	assert.Nil(t, err)
+	assert.True(t, finished)
+	assert.Equal(t, 3, len(savedProgress))
+	assert.Equal(t, spdb.ScrubProgress{ScrubKey: IntegrityScrubKey, Round: 1, UpdateTime: savedProgress[2].UpdateTime},
+		savedProgress[2])
+}
+
+func TestIntegrityScrubber_ResumeFromProgress(t *testing.T) {
+	s, db, client, _ := setupIntegrityScrubber(t)
+	db.EXPECT().QueryScrubProgress(IntegrityScrubKey).Return(&spdb.ScrubProgress{
+		ScrubKey: IntegrityScrubKey, NextObjectID: 300, Round: 2, ScannedPieces: 10}, nil).Times(1)
+	client.EXPECT().GetLatestObjectID(gomock.Any()).Return(uint64(1000), nil).AnyTimes()
+	db.EXPECT().ListIntegrityMetaByObjectIDRange(int64(300), int64(400), true).Return(nil, nil).Times(1)
+	db.EXPECT().UpdateScrubProgress(gomock.Any()).Return(nil).Times(1)
+
+	finished, err := s.scrubNext(context.Background())
+	assert.Nil(t, err)
+	assert.False(t, finished)
+	assert.Equal(t, uint64(400), s.progress.NextObjectID)
+	assert.Equal(t, uint64(2), s.progress.Round)
+	assert.Equal(t, uint64(10), s.progress.ScannedPieces)
+}
+
+func TestIntegrityScrubber_ScrubNextFailure(t *testing.T) {
+	s, db, client, _ := setupIntegrityScrubber(t)
+	db.EXPECT().QueryScrubProgress(IntegrityScrubKey).Return(nil, errors.New("mock error")).Times(1)
+	_, err := s.scrubNext(context.Background())
+	assert.NotNil(t, err)
+
+	db.EXPECT().QueryScrubProgress(IntegrityScrubKey).Return(&spdb.ScrubProgress{ScrubKey: IntegrityScrubKey}, nil).Times(1)
+	client.EXPECT().GetLatestObjectID(gomock.Any()).Return(uint64(1000), nil).AnyTimes()
+	db.EXPECT().ListIntegrityMetaByObjectIDRange(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil,
+		errors.New("mock error")).Times(1)
+	_, err = s.scrubNext(context.Background())
+	assert.NotNil(t, err)
+	// the progress is not moved forward
+	assert.Equal(t, uint64(0), s.progress.NextObjectID)
+}
@@ -0,0 +1,439 @@
+package manager
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gnfd"
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
+	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+	metadatatypes "github.com/bnb-chain/greenfield-storage-provider/modular/metadata/types"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	storetypes "github.com/bnb-chain/greenfield-storage-provider/store/types"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+const (
+	// InventoryFormatCSV defines the csv format of the inventory manifest files.
+	InventoryFormatCSV = "CSV"
+	// DefaultInventoryIntervalSecond defines the default seconds between the start times of two inventory reports.
+	DefaultInventoryIntervalSecond = 24 * 3600
+	// DefaultInventoryObjectsPerFile defines the default max number of objects in an inventory manifest file.
+	DefaultInventoryObjectsPerFile = 10000
+
+	// inventoryCheckInterval is the interval to check whether the inventory reports are due.
+	inventoryCheckInterval = time.Minute
+	// inventoryListLimit is the max number of objects listed from metadata in one request.
+	inventoryListLimit = 1000
+	// inventoryManifestName is the name of the summary file of an inventory report.
+	inventoryManifestName = "manifest.json"
+	// inventoryReportTimeLayout is the layout of the report time in the object names of an inventory report.
+	inventoryReportTimeLayout = "20060102T150405Z"
+)
+
+// errInventoryObjectConflict is returned if the object to write is left by a previous run and its payload
+// size is different, the running report is restarted with a new report time.
+var errInventoryObjectConflict = errors.New("inventory object already exists with different payload")
+
+// InventoryEncoder encodes the objects into an inventory manifest file.
+type InventoryEncoder interface {
+	// FileExtension returns the extension of the manifest files, e.g. "csv".
+	FileExtension() string
+	// ContentType returns the content type of the manifest files.
+	ContentType() string
+	// Schema returns the column names of the manifest files.
+	Schema() []string
+	// Encode encodes the objects into a manifest file.
+	Encode(objects []*metadatatypes.Object) ([]byte, error)
+}
+
+// NewInventoryEncoder returns the inventory encoder of the format.
+func NewInventoryEncoder(format string) (InventoryEncoder, error) {
+	switch strings.ToUpper(format) {
+	case InventoryFormatCSV:
+		return &csvInventoryEncoder{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported inventory format %q, only %s is supported", format, InventoryFormatCSV)
+	}
+}
+
+var _ InventoryEncoder = &csvInventoryEncoder{}
+
+type csvInventoryEncoder struct{}
+
+func (*csvInventoryEncoder) FileExtension() string { return "csv" }
+
+func (*csvInventoryEncoder) ContentType() string { return "text/csv" }
+
+func (*csvInventoryEncoder) Schema() []string {
+	return []string{"Bucket", "Key", "ObjectID", "Size", "ContentType", "Status", "CreateTime", "Checksums"}
+}
+
+// Encode writes a header row and a row per object, the checksums are hex encoded and separated by ";".
+func (e *csvInventoryEncoder) Encode(objects []*metadatatypes.Object) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write(e.Schema()); err != nil {
+		return nil, err
+	}
+	for _, object := range objects {
+		info := object.GetObjectInfo()
+		checksums := make([]string, len(info.GetChecksums()))
+		for idx, checksum := range info.GetChecksums() {
+			checksums[idx] = hex.EncodeToString(checksum)
+		}
+		if err := writer.Write([]string{
+			info.GetBucketName(),
+			info.GetObjectName(),
+			info.Id.String(),
+			strconv.FormatUint(info.GetPayloadSize(), 10),
+			info.GetContentType(),
+			info.GetObjectStatus().String(),
+			time.Unix(info.GetCreateAt(), 0).UTC().Format(time.RFC3339),
+			strings.Join(checksums, ";"),
+		}); err != nil {
+			return nil, err
+		}
+	}
+	writer.Flush()
+	return buf.Bytes(), writer.Error()
+}
+
+// InventoryManifest is the summary of an inventory report, it's written after all the manifest files of the
+// report are uploaded, so the report is complete once the summary exists.
+type InventoryManifest struct {
+	SourceBucket      string   `json:"sourceBucket"`
+	DestinationBucket string   `json:"destinationBucket"`
+	ReportTime        int64    `json:"reportTime"`
+	FileFormat        string   `json:"fileFormat"`
+	FileSchema        string   `json:"fileSchema"`
+	ObjectCount       uint64   `json:"objectCount"`
+	Files             []string `json:"files"`
+}
+
+// checkInventoryConfigs checks the inventory configs and fills the default values.
+func checkInventoryConfigs(configs []gfspconfig.InventoryConfig) error {
+	sources := make(map[string]struct{})
+	for idx := range configs {
+		cfg := &configs[idx]
+		if cfg.SourceBucket == "" || cfg.DestinationBucket == "" {
+			return fmt.Errorf("the source bucket and the destination bucket of inventory must be set")
+		}
+		if _, ok := sources[cfg.SourceBucket]; ok {
+			return fmt.Errorf("duplicated inventory of the source bucket %s", cfg.SourceBucket)
+		}
+		sources[cfg.SourceBucket] = struct{}{}
+		if cfg.Format == "" {
+			cfg.Format = InventoryFormatCSV
+		}
+		if _, err := NewInventoryEncoder(cfg.Format); err != nil {
+			return err
+		}
+		if cfg.IntervalSecond == 0 {
+			cfg.IntervalSecond = DefaultInventoryIntervalSecond
+		}
+		if cfg.ObjectsPerFile == 0 {
+			cfg.ObjectsPerFile = DefaultInventoryObjectsPerFile
+		}
+	}
+	return nil
+}
+
+// InventoryScheduler periodically writes the inventory reports of the configured buckets. The objects of the
+// source bucket are listed from metadata in the order of object name and written into the manifest files of the
+// destination bucket through the delegated upload path. The progress is persisted to sp db after each manifest
+// file, so the running report resumes from the checkpoint after restarting.
+type InventoryScheduler struct {
+	manager *ManageModular
+	configs []gfspconfig.InventoryConfig
+	now     func() time.Time
+}
+
+// NewInventoryScheduler returns an inventory scheduler instance.
+func NewInventoryScheduler(m *ManageModular) *InventoryScheduler {
+	return &InventoryScheduler{
+		manager: m,
+		configs: m.inventoryConfigs,
+		now:     time.Now,
+	}
+}
+
+// Start is used to start the inventory scheduler.
+func (s *InventoryScheduler) Start(ctx context.Context) {
+	go s.run(ctx)
+	log.Infow("inventory scheduler startup", "inventories", len(s.configs))
+}
+
+func (s *InventoryScheduler) run(ctx context.Context) {
+	ticker := time.NewTicker(inventoryCheckInterval)
+	defer ticker.Stop()
+	for {
+		for _, cfg := range s.configs {
+			if err := s.report(ctx, cfg); err != nil {
+				log.CtxErrorw(ctx, "failed to write inventory report and try again later",
+					"source_bucket", cfg.SourceBucket, "error", err)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// report starts a new report if it's due, and continues the running report until all the objects are written.
+func (s *InventoryScheduler) report(ctx context.Context, cfg gfspconfig.InventoryConfig) error {
+	encoder, err := NewInventoryEncoder(cfg.Format)
+	if err != nil {
+		return err
+	}
+	progress, err := s.manager.baseApp.GfSpDB().QueryInventoryProgress(cfg.SourceBucket)
+	if err != nil {
+		return err
+	}
+	if progress.ReportTime == 0 {
+		if progress.LastReportTime != 0 && s.now().Unix() < progress.LastReportTime+int64(cfg.IntervalSecond) {
+			return nil
+		}
+		if err = s.restart(progress); err != nil {
+			return err
+		}
+		log.CtxInfow(ctx, "start inventory report", "source_bucket", cfg.SourceBucket, "report_time", progress.ReportTime)
+	}
+
+	bucket, err := s.checkDestination(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	for {
+		objects, truncated, listErr := s.listObjects(ctx, cfg.SourceBucket, progress.StartAfter, int(cfg.ObjectsPerFile))
+		if listErr != nil {
+			return listErr
+		}
+		if len(objects) != 0 {
+			data, encodeErr := encoder.Encode(objects)
+			if encodeErr != nil {
+				return encodeErr
+			}
+			name := inventoryFileName(cfg, progress.ReportTime, progress.FileCount, encoder.FileExtension())
+			if err = s.putObject(ctx, bucket, name, encoder.ContentType(), data); err != nil {
+				return s.handlePutError(ctx, progress, name, err)
+			}
+			progress.FileCount++
+			progress.ObjectCount += uint64(len(objects))
+			progress.StartAfter = objects[len(objects)-1].GetObjectInfo().GetObjectName()
+			if err = s.saveProgress(progress); err != nil {
+				return err
+			}
+		}
+		if !truncated {
+			break
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+
+	manifest := &InventoryManifest{
+		SourceBucket:      cfg.SourceBucket,
+		DestinationBucket: cfg.DestinationBucket,
+		ReportTime:        progress.ReportTime,
+		FileFormat:        strings.ToUpper(cfg.Format),
+		FileSchema:        strings.Join(encoder.Schema(), ", "),
+		ObjectCount:       progress.ObjectCount,
+		Files:             make([]string, progress.FileCount),
+	}
+	for idx := range manifest.Files {
+		manifest.Files[idx] = inventoryFileName(cfg, progress.ReportTime, uint32(idx), encoder.FileExtension())
+	}
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	name := inventoryManifestObjectName(cfg, progress.ReportTime)
+	if err = s.putObject(ctx, bucket, name, "application/json", data); err != nil {
+		return s.handlePutError(ctx, progress, name, err)
+	}
+
+	log.CtxInfow(ctx, "finished inventory report", "source_bucket", cfg.SourceBucket, "report_time", progress.ReportTime,
+		"files", progress.FileCount, "objects", progress.ObjectCount)
+	progress.LastReportTime = progress.ReportTime
+	progress.ReportTime = 0
+	progress.StartAfter = ""
+	return s.saveProgress(progress)
+}
+
+// restart resets the progress to a new report which starts from now.
+func (s *InventoryScheduler) restart(progress *spdb.InventoryProgress) error {
+	progress.ReportTime = s.now().Unix()
+	progress.StartAfter = ""
+	progress.FileCount = 0
+	progress.ObjectCount = 0
+	return s.saveProgress(progress)
+}
+
+func (s *InventoryScheduler) handlePutError(ctx context.Context, progress *spdb.InventoryProgress, name string, err error) error {
+	if !errors.Is(err, errInventoryObjectConflict) {
+		return err
+	}
+	// the object is left by a previous run with different content, it can't be overwritten by sp, so a new report
+	// is started under another report time.
+	log.CtxWarnw(ctx, "restart inventory report due to the conflict object", "source_bucket", progress.BucketName,
+		"object_name", name)
+	if restartErr := s.restart(progress); restartErr != nil {
+		return restartErr
+	}
+	return err
+}
+
+func (s *InventoryScheduler) saveProgress(progress *spdb.InventoryProgress) error {
+	progress.UpdateTime = s.now().Unix()
+	return s.manager.baseApp.GfSpDB().UpdateInventoryProgress(progress)
+}
+
+// checkDestination checks the destination bucket is owned by the owner of the source bucket and uses this sp as
+// the primary sp, which is required by the delegated upload.
+func (s *InventoryScheduler) checkDestination(ctx context.Context, cfg gfspconfig.InventoryConfig) (*storagetypes.BucketInfo, error) {
+	source, err := s.manager.baseApp.Consensus().QueryBucketInfo(ctx, cfg.SourceBucket)
+	if err != nil {
+		return nil, err
+	}
+	destination, err := s.manager.baseApp.Consensus().QueryBucketInfo(ctx, cfg.DestinationBucket)
+	if err != nil {
+		return nil, err
+	}
+	if source.GetOwner() != destination.GetOwner() {
+		return nil, fmt.Errorf("the destination bucket %s is not owned by the owner of the source bucket %s",
+			cfg.DestinationBucket, cfg.SourceBucket)
+	}
+	family, err := s.manager.baseApp.Consensus().QueryVirtualGroupFamily(ctx, destination.GetGlobalVirtualGroupFamilyId())
+	if err != nil {
+		return nil, err
+	}
+	spID, err := s.manager.getSPID()
+	if err != nil {
+		return nil, err
+	}
+	if family.GetPrimarySpId() != spID {
+		return nil, fmt.Errorf("the primary sp of the destination bucket %s is not this sp", cfg.DestinationBucket)
+	}
+	return destination, nil
+}
+
+// listObjects lists at most limit objects after the start object name, it returns true if more objects are left.
+func (s *InventoryScheduler) listObjects(ctx context.Context, bucketName, startAfter string, limit int) (
+	[]*metadatatypes.Object, bool, error) {
+	var objects []*metadatatypes.Object
+	for len(objects) < limit {
+		maxKeys := limit - len(objects)
+		if maxKeys > inventoryListLimit {
+			maxKeys = inventoryListLimit
+		}
+		resp, err := s.manager.baseApp.GfSpClient().SearchObjects(ctx, &metadatatypes.GfSpSearchObjectsRequest{
+			BucketName:        bucketName,
+			ContinuationToken: startAfter,
+			MaxKeys:           uint64(maxKeys),
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		objects = append(objects, resp.GetObjects()...)
+		if !resp.GetIsTruncated() || len(resp.GetObjects()) == 0 {
+			return objects, false, nil
+		}
+		startAfter = objects[len(objects)-1].GetObjectInfo().GetObjectName()
+	}
+	return objects, true, nil
+}
+
+// putObject creates the object on chain on behalf of the bucket owner and uploads the payload. The object left by
+// a previous run is reused if its payload size is the same.
+func (s *InventoryScheduler) putObject(ctx context.Context, bucket *storagetypes.BucketInfo, objectName, contentType string,
+	data []byte) error {
+	con := s.manager.baseApp.Consensus()
+	objectInfo, err := con.QueryObjectInfo(ctx, bucket.GetBucketName(), objectName)
+	if err != nil && !errors.Is(err, gnfd.ErrNoSuchObject) {
+		return err
+	}
+	if objectInfo != nil {
+		if objectInfo.GetPayloadSize() != uint64(len(data)) || objectInfo.GetOwner() != bucket.GetOwner() {
+			return errInventoryObjectConflict
+		}
+		if objectInfo.GetObjectStatus() == storagetypes.OBJECT_STATUS_SEALED {
+			return nil
+		}
+		if objectInfo.GetObjectStatus() != storagetypes.OBJECT_STATUS_CREATED {
+			return errInventoryObjectConflict
+		}
+		if s.uploaded(objectInfo.Id.Uint64()) {
+			// the payload is uploaded and waits to be sealed
+			return nil
+		}
+	} else {
+		txHash, createErr := s.manager.baseApp.GfSpClient().DelegateCreateObject(ctx, &storagetypes.MsgDelegateCreateObject{
+			Operator:       s.manager.baseApp.OperatorAddress(),
+			Creator:        bucket.GetOwner(),
+			BucketName:     bucket.GetBucketName(),
+			ObjectName:     objectName,
+			PayloadSize:    uint64(len(data)),
+			ContentType:    contentType,
+			Visibility:     storagetypes.VISIBILITY_TYPE_INHERIT,
+			RedundancyType: storagetypes.REDUNDANCY_EC_TYPE,
+		})
+		if createErr != nil {
+			return createErr
+		}
+		if _, err = con.ConfirmTransaction(ctx, txHash); err != nil {
+			return err
+		}
+		if objectInfo, err = con.QueryObjectInfo(ctx, bucket.GetBucketName(), objectName); err != nil {
+			return err
+		}
+	}
+
+	params, err := con.QueryStorageParamsByTimestamp(ctx, s.now().Unix())
+	if err != nil {
+		return err
+	}
+	task := &gfsptask.GfSpUploadObjectTask{}
+	task.InitUploadObjectTask(bucket.GetGlobalVirtualGroupFamilyId(), objectInfo, params,
+		s.manager.baseApp.TaskTimeout(task, objectInfo.GetPayloadSize()), true)
+	return s.manager.baseApp.GfSpClient().UploadObject(ctx, task, bytes.NewReader(data))
+}
+
+// uploaded returns true if the payload of the object has been uploaded to this sp.
+func (s *InventoryScheduler) uploaded(objectID uint64) bool {
+	state, _, err := s.manager.baseApp.GfSpDB().GetUploadState(objectID)
+	if err != nil {
+		return false
+	}
+	switch state {
+	case storetypes.TaskState_TASK_STATE_INIT_UNSPECIFIED, storetypes.TaskState_TASK_STATE_UPLOAD_OBJECT_DOING,
+		storetypes.TaskState_TASK_STATE_UPLOAD_OBJECT_ERROR:
+		return false
+	default:
+		return true
+	}
+}
+
+func inventoryReportPrefix(cfg gfspconfig.InventoryConfig, reportTime int64) string {
+	return cfg.DestinationPrefix + cfg.SourceBucket + "/" + time.Unix(reportTime, 0).UTC().Format(inventoryReportTimeLayout) + "/"
+}
+
+func inventoryFileName(cfg gfspconfig.InventoryConfig, reportTime int64, index uint32, extension string) string {
+	return fmt.Sprintf("%sdata/%05d.%s", inventoryReportPrefix(cfg, reportTime), index, extension)
+}
+
+func inventoryManifestObjectName(cfg gfspconfig.InventoryConfig, reportTime int64) string {
+	return inventoryReportPrefix(cfg, reportTime) + inventoryManifestName
+}
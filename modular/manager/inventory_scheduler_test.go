@@ -0,0 +1,247 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+	virtualgrouptypes "github.com/bnb-chain/greenfield/x/virtualgroup/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspclient"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gnfd"
+	"github.com/bnb-chain/greenfield-storage-provider/core/consensus"
+	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+	coretask "github.com/bnb-chain/greenfield-storage-provider/core/task"
+	metadatatypes "github.com/bnb-chain/greenfield-storage-provider/modular/metadata/types"
+)
+
+const (
+	mockInventoryOwner      = "0xe978A9160BC061f602fa083e9C68539C549A421D"
+	mockInventoryReportTime = int64(1700000000)
+)
+
+var mockInventoryConfig = gfspconfig.InventoryConfig{
+	SourceBucket:      "source",
+	DestinationBucket: "destination",
+	DestinationPrefix: "inventory/",
+	Format:            InventoryFormatCSV,
+	IntervalSecond:    3600,
+	ObjectsPerFile:    2,
+}
+
+func mockInventoryObject(name string) *metadatatypes.Object {
+	return &metadatatypes.Object{ObjectInfo: &storagetypes.ObjectInfo{
+		BucketName:   "source",
+		ObjectName:   name,
+		Id:           sdkmath.NewUint(1),
+		PayloadSize:  10,
+		ContentType:  "video/mp4",
+		ObjectStatus: storagetypes.OBJECT_STATUS_SEALED,
+		CreateAt:     mockInventoryReportTime,
+		Checksums:    [][]byte{{0x1, 0x2}, {0x3}},
+	}}
+}
+
+// mockInventoryEnv mocks a destination bucket which stores the created objects in memory.
+type mockInventoryEnv struct {
+	scheduler *InventoryScheduler
+	db        *spdb.MockSPDB
+	client    *gfspclient.MockGfSpClientAPI
+	con       *consensus.MockConsensus
+	objects   []*metadatatypes.Object
+	uploaded  map[string]string
+	progress  []spdb.InventoryProgress
+}
+
+func setupInventoryScheduler(t *testing.T, objects []*metadatatypes.Object) *mockInventoryEnv {
+	t.Helper()
+	m := setup(t)
+	m.spID = 1
+	m.inventoryConfigs = []gfspconfig.InventoryConfig{mockInventoryConfig}
+	ctrl := gomock.NewController(t)
+	env := &mockInventoryEnv{
+		db:       spdb.NewMockSPDB(ctrl),
+		client:   gfspclient.NewMockGfSpClientAPI(ctrl),
+		con:      consensus.NewMockConsensus(ctrl),
+		objects:  objects,
+		uploaded: make(map[string]string),
+	}
+	m.baseApp.SetGfSpDB(env.db)
+	m.baseApp.SetGfSpClient(env.client)
+	m.baseApp.SetConsensus(env.con)
+	env.scheduler = NewInventoryScheduler(m)
+	env.scheduler.now = func() time.Time { return time.Unix(mockInventoryReportTime, 0) }
+
+	env.db.EXPECT().UpdateInventoryProgress(gomock.Any()).DoAndReturn(func(progress *spdb.InventoryProgress) error {
+		env.progress = append(env.progress, *progress)
+		return nil
+	}).AnyTimes()
+	env.con.EXPECT().QueryBucketInfo(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, bucketName string) (*storagetypes.BucketInfo, error) {
+			return &storagetypes.BucketInfo{BucketName: bucketName, Owner: mockInventoryOwner, GlobalVirtualGroupFamilyId: 2}, nil
+		}).AnyTimes()
+	env.con.EXPECT().QueryVirtualGroupFamily(gomock.Any(), uint32(2)).Return(
+		&virtualgrouptypes.GlobalVirtualGroupFamily{Id: 2, PrimarySpId: 1}, nil).AnyTimes()
+	env.con.EXPECT().QueryStorageParamsByTimestamp(gomock.Any(), gomock.Any()).Return(&storagetypes.Params{}, nil).AnyTimes()
+	env.con.EXPECT().ConfirmTransaction(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	env.con.EXPECT().QueryObjectInfo(gomock.Any(), "destination", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _, objectName string) (*storagetypes.ObjectInfo, error) {
+			data, ok := env.uploaded[objectName]
+			if !ok {
+				return nil, gnfd.ErrNoSuchObject
+			}
+			return &storagetypes.ObjectInfo{ObjectName: objectName, Owner: mockInventoryOwner, Id: sdkmath.NewUint(9),
+				PayloadSize: uint64(len(data)), ObjectStatus: storagetypes.OBJECT_STATUS_CREATED}, nil
+		}).AnyTimes()
+	env.client.EXPECT().DelegateCreateObject(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, msg *storagetypes.MsgDelegateCreateObject) (string, error) {
+			assert.Equal(t, mockInventoryOwner, msg.GetCreator())
+			env.uploaded[msg.GetObjectName()] = strings.Repeat(" ", int(msg.GetPayloadSize()))
+			return "tx", nil
+		}).AnyTimes()
+	env.client.EXPECT().UploadObject(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, task coretask.UploadObjectTask, stream io.Reader, _ ...any) error {
+			data, err := io.ReadAll(stream)
+			require.NoError(t, err)
+			assert.True(t, task.GetIsAgentUpload())
+			env.uploaded[task.GetObjectInfo().GetObjectName()] = string(data)
+			return nil
+		}).AnyTimes()
+	env.client.EXPECT().SearchObjects(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *metadatatypes.GfSpSearchObjectsRequest, _ ...any) (*metadatatypes.GfSpSearchObjectsResponse, error) {
+			assert.Equal(t, "source", req.GetBucketName())
+			var res []*metadatatypes.Object
+			for _, object := range env.objects {
+				if object.GetObjectInfo().GetObjectName() > req.GetContinuationToken() {
+					res = append(res, object)
+				}
+			}
+			truncated := uint64(len(res)) > req.GetMaxKeys()
+			if truncated {
+				res = res[:req.GetMaxKeys()]
+			}
+			return &metadatatypes.GfSpSearchObjectsResponse{Objects: res, IsTruncated: truncated}, nil
+		}).AnyTimes()
+	return env
+}
+
+func TestCsvInventoryEncoder_Encode(t *testing.T) {
+	encoder, err := NewInventoryEncoder("csv")
+	require.NoError(t, err)
+	data, err := encoder.Encode([]*metadatatypes.Object{mockInventoryObject("a,b.mp4")})
+	require.NoError(t, err)
+	assert.Equal(t, "Bucket,Key,ObjectID,Size,ContentType,Status,CreateTime,Checksums\n"+
+		"source,\"a,b.mp4\",1,10,video/mp4,OBJECT_STATUS_SEALED,2023-11-14T22:13:20Z,0102;03\n", string(data))
+}
+
+func TestCheckInventoryConfigs(t *testing.T) {
+	configs := []gfspconfig.InventoryConfig{{SourceBucket: "source", DestinationBucket: "destination"}}
+	require.NoError(t, checkInventoryConfigs(configs))
+	assert.Equal(t, InventoryFormatCSV, configs[0].Format)
+	assert.Equal(t, uint(DefaultInventoryIntervalSecond), configs[0].IntervalSecond)
+	assert.Equal(t, uint(DefaultInventoryObjectsPerFile), configs[0].ObjectsPerFile)
+
+	assert.Error(t, checkInventoryConfigs([]gfspconfig.InventoryConfig{{SourceBucket: "source"}}))
+	assert.Error(t, checkInventoryConfigs([]gfspconfig.InventoryConfig{
+		{SourceBucket: "source", DestinationBucket: "destination", Format: "Parquet"}}))
+	assert.Error(t, checkInventoryConfigs([]gfspconfig.InventoryConfig{
+		{SourceBucket: "source", DestinationBucket: "a"}, {SourceBucket: "source", DestinationBucket: "b"}}))
+}
+
+func TestInventoryScheduler_Report(t *testing.T) {
+	env := setupInventoryScheduler(t, []*metadatatypes.Object{
+		mockInventoryObject("a"), mockInventoryObject("b"), mockInventoryObject("c")})
+	env.db.EXPECT().QueryInventoryProgress("source").Return(&spdb.InventoryProgress{BucketName: "source"}, nil).Times(1)
+
+	require.NoError(t, env.scheduler.report(context.Background(), mockInventoryConfig))
+	prefix := "inventory/source/20231114T221320Z/"
+	assert.Equal(t, 3, len(env.uploaded))
+	assert.Equal(t, 3, strings.Count(env.uploaded[prefix+"data/00000.csv"], "\n"))
+	assert.Equal(t, 2, strings.Count(env.uploaded[prefix+"data/00001.csv"], "\n"))
+
+	var manifest InventoryManifest
+	require.NoError(t, json.Unmarshal([]byte(env.uploaded[prefix+"manifest.json"]), &manifest))
+	assert.Equal(t, uint64(3), manifest.ObjectCount)
+	assert.Equal(t, []string{prefix + "data/00000.csv", prefix + "data/00001.csv"}, manifest.Files)
+
+	last := env.progress[len(env.progress)-1]
+	assert.Equal(t, int64(0), last.ReportTime)
+	assert.Equal(t, mockInventoryReportTime, last.LastReportTime)
+	assert.Equal(t, uint32(2), last.FileCount)
+}
+
+func TestInventoryScheduler_ReportResume(t *testing.T) {
+	env := setupInventoryScheduler(t, []*metadatatypes.Object{
+		mockInventoryObject("a"), mockInventoryObject("b"), mockInventoryObject("c")})
+	env.db.EXPECT().QueryInventoryProgress("source").Return(&spdb.InventoryProgress{BucketName: "source",
+		ReportTime: mockInventoryReportTime - 60, StartAfter: "b", FileCount: 1, ObjectCount: 2}, nil).Times(1)
+
+	require.NoError(t, env.scheduler.report(context.Background(), mockInventoryConfig))
+	prefix := "inventory/source/20231114T221220Z/"
+	assert.Equal(t, 2, len(env.uploaded))
+	assert.Contains(t, env.uploaded[prefix+"data/00001.csv"], "source,c,")
+
+	var manifest InventoryManifest
+	require.NoError(t, json.Unmarshal([]byte(env.uploaded[prefix+"manifest.json"]), &manifest))
+	assert.Equal(t, uint64(3), manifest.ObjectCount)
+	assert.Equal(t, 2, len(manifest.Files))
+}
+
+func TestInventoryScheduler_ReportNotDue(t *testing.T) {
+	env := setupInventoryScheduler(t, nil)
+	env.db.EXPECT().QueryInventoryProgress("source").Return(&spdb.InventoryProgress{BucketName: "source",
+		LastReportTime: mockInventoryReportTime - 60}, nil).Times(1)
+
+	require.NoError(t, env.scheduler.report(context.Background(), mockInventoryConfig))
+	assert.Equal(t, 0, len(env.uploaded))
+	assert.Equal(t, 0, len(env.progress))
+}
+
+func TestInventoryScheduler_ReportConflict(t *testing.T) {
+	env := setupInventoryScheduler(t, []*metadatatypes.Object{mockInventoryObject("a")})
+	env.db.EXPECT().QueryInventoryProgress("source").Return(&spdb.InventoryProgress{BucketName: "source",
+		ReportTime: mockInventoryReportTime - 60}, nil).Times(1)
+	env.uploaded["inventory/source/20231114T221220Z/data/00000.csv"] = "other"
+
+	err := env.scheduler.report(context.Background(), mockInventoryConfig)
+	assert.ErrorIs(t, err, errInventoryObjectConflict)
+	last := env.progress[len(env.progress)-1]
+	assert.Equal(t, mockInventoryReportTime, last.ReportTime)
+	assert.Equal(t, uint32(0), last.FileCount)
+}
+
+func TestInventoryScheduler_PutObjectQueryError(t *testing.T) {
+	m := setup(t)
+	ctrl := gomock.NewController(t)
+	con := consensus.NewMockConsensus(ctrl)
+	// only the not found error means the object is absent, the other errors don't create the object
+	con.EXPECT().QueryObjectInfo(gomock.Any(), "destination", "a").Return(nil, errors.New("mock query error")).Times(1)
+	m.baseApp.SetConsensus(con)
+
+	err := NewInventoryScheduler(m).putObject(context.Background(), &storagetypes.BucketInfo{BucketName: "destination"},
+		"a", "text/csv", []byte("a"))
+	assert.EqualError(t, err, "mock query error")
+}
+
+func TestInventoryScheduler_CheckDestination(t *testing.T) {
+	m := setup(t)
+	m.spID = 1
+	ctrl := gomock.NewController(t)
+	con := consensus.NewMockConsensus(ctrl)
+	m.baseApp.SetConsensus(con)
+	con.EXPECT().QueryBucketInfo(gomock.Any(), "source").Return(&storagetypes.BucketInfo{Owner: mockInventoryOwner}, nil).Times(1)
+	con.EXPECT().QueryBucketInfo(gomock.Any(), "destination").Return(&storagetypes.BucketInfo{Owner: "other"}, nil).Times(1)
+
+	_, err := NewInventoryScheduler(m).checkDestination(context.Background(), mockInventoryConfig)
+	assert.Error(t, err)
+}
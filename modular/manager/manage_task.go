@@ -137,6 +137,20 @@ func (m *ManageModular) HandleDoneUploadObjectTask(ctx context.Context, task tas
 			time.Since(time.Unix(task.GetCreateTime(), 0)).Seconds())
 	}
 	log.Debugw("UploadObjectTask info", "task", task)
+	// the object has been replicated to the secondary SPs while uploading, it's sealed without replicating again
+	if len(task.GetSecondarySignatures()) != 0 {
+		task.AppendLog("manager-handle-streaming-replicated-upload-task")
+		metrics.ManagerCounter.WithLabelValues(ManagerSuccessStreamingReplicate).Inc()
+		return m.createSealObjectTask(ctx, task, task.GetGlobalVirtualGroupId(), task.GetSecondaryEndpoints(),
+			task.GetSecondarySignatures(), task.GetIsAgentUpload())
+	}
+	// the streaming replication to the gvg failed, the object is replicated to the same gvg so that the partial
+	// pieces on the secondary SPs are overwritten, the gvg is picked again if the secondary SPs are not available
+	if task.GetGlobalVirtualGroupId() != 0 && len(task.GetSecondaryEndpoints()) != 0 {
+		task.AppendLog("manager-handle-streaming-aborted-upload-task")
+		return m.replicateToGVG(ctx, task, task.GetGlobalVirtualGroupId(), task.GetSecondaryEndpoints(),
+			task.GetIsAgentUpload())
+	}
 	return m.pickGVGAndReplicate(ctx, task.GetVirtualGroupFamilyId(), task, task.GetIsAgentUpload())
 }
 
@@ -149,30 +163,35 @@ func (m *ManageModular) pickGVGAndReplicate(ctx context.Context, vgfID uint32, t
 		m.virtualGroupManager.ReleaseAllSP()
 		return err
 	}
+	return m.replicateToGVG(ctx, task, gvgMeta.ID, gvgMeta.SecondarySPEndpoints, isAgentUpload)
+}
+
+// replicateToGVG creates the replicate piece task of the object to the secondary SPs of the gvg.
+func (m *ManageModular) replicateToGVG(ctx context.Context, task task.ObjectTask, gvgID uint32,
+	secondaryEndpoints []string, isAgentUpload bool) error {
 	replicateTask := &gfsptask.GfSpReplicatePieceTask{}
 	replicateTask.InitReplicatePieceTask(task.GetObjectInfo(), task.GetStorageParams(),
 		m.baseApp.TaskPriority(replicateTask),
 		m.baseApp.TaskTimeout(replicateTask, task.GetObjectInfo().GetPayloadSize()),
 		m.baseApp.TaskMaxRetry(replicateTask), isAgentUpload)
-	replicateTask.GlobalVirtualGroupId = gvgMeta.ID
-	replicateTask.SecondaryEndpoints = gvgMeta.SecondarySPEndpoints
-	log.Debugw("replicate task info", "task", replicateTask, "gvg_meta", gvgMeta)
+	replicateTask.GlobalVirtualGroupId = gvgID
+	replicateTask.SecondaryEndpoints = secondaryEndpoints
+	log.Debugw("replicate task info", "task", replicateTask)
 	replicateTask.SetCreateTime(task.GetCreateTime())
 	replicateTask.SetLogs(task.GetLogs())
 	replicateTask.SetRetry(task.GetRetry())
 	replicateTask.AppendLog("manager-create-replicate-task")
-	err = m.replicateQueue.Push(replicateTask)
-	if err != nil {
+	if err := m.replicateQueue.Push(replicateTask); err != nil {
 		log.CtxErrorw(ctx, "failed to push replicate piece task to queue", "error", err)
 		return err
 	}
 	go m.backUpTask()
 	go func() {
-		err = m.baseApp.GfSpDB().UpdateUploadProgress(&spdb.UploadObjectMeta{
+		err := m.baseApp.GfSpDB().UpdateUploadProgress(&spdb.UploadObjectMeta{
 			ObjectID:             task.GetObjectInfo().Id.Uint64(),
 			TaskState:            types.TaskState_TASK_STATE_REPLICATE_OBJECT_DOING,
-			GlobalVirtualGroupID: gvgMeta.ID,
-			SecondaryEndpoints:   gvgMeta.SecondarySPEndpoints,
+			GlobalVirtualGroupID: gvgID,
+			SecondaryEndpoints:   secondaryEndpoints,
 		})
 		if err != nil {
 			log.Errorw("failed to update object task state", "task_info", task.Info(), "error", err)
@@ -363,10 +382,17 @@ func (m *ManageModular) HandleReplicatePieceTask(ctx context.Context, task task.
 	}
 
 	log.CtxDebugw(ctx, "replicate piece object task fails to combine seal object task", "task_info", task.Info())
+	return m.createSealObjectTask(ctx, task, task.GetGlobalVirtualGroupId(), task.GetSecondaryEndpoints(),
+		task.GetSecondarySignatures(), task.GetIsAgentUpload())
+}
+
+// createSealObjectTask creates the seal object task of the object which is replicated to the secondary SPs.
+func (m *ManageModular) createSealObjectTask(ctx context.Context, task task.ObjectTask, gvgID uint32,
+	secondaryEndpoints []string, secondarySignatures [][]byte, isAgentUpload bool) error {
 	sealObject := &gfsptask.GfSpSealObjectTask{}
-	sealObject.InitSealObjectTask(task.GetGlobalVirtualGroupId(), task.GetObjectInfo(), task.GetStorageParams(),
-		m.baseApp.TaskPriority(sealObject), task.GetSecondaryEndpoints(), task.GetSecondarySignatures(),
-		m.baseApp.TaskTimeout(sealObject, 0), m.baseApp.TaskMaxRetry(sealObject), task.GetIsAgentUpload())
+	sealObject.InitSealObjectTask(gvgID, task.GetObjectInfo(), task.GetStorageParams(),
+		m.baseApp.TaskPriority(sealObject), secondaryEndpoints, secondarySignatures,
+		m.baseApp.TaskTimeout(sealObject, 0), m.baseApp.TaskMaxRetry(sealObject), isAgentUpload)
 	sealObject.SetCreateTime(task.GetCreateTime())
 	sealObject.SetLogs(task.GetLogs())
 	sealObject.AppendLog("manager-create-seal-task")
@@ -380,9 +406,9 @@ func (m *ManageModular) HandleReplicatePieceTask(ctx context.Context, task task.
 		if err = m.baseApp.GfSpDB().UpdateUploadProgress(&spdb.UploadObjectMeta{
 			ObjectID:             task.GetObjectInfo().Id.Uint64(),
 			TaskState:            types.TaskState_TASK_STATE_SEAL_OBJECT_DOING,
-			GlobalVirtualGroupID: task.GetGlobalVirtualGroupId(),
-			SecondaryEndpoints:   task.GetSecondaryEndpoints(),
-			SecondarySignatures:  task.GetSecondarySignatures(),
+			GlobalVirtualGroupID: gvgID,
+			SecondaryEndpoints:   secondaryEndpoints,
+			SecondarySignatures:  secondarySignatures,
 			ErrorDescription:     "",
 		}); err != nil {
 			log.Errorw("failed to update object task state", "task_info", task.Info(), "task_info", task.Info(), "error", err)
@@ -1099,6 +1125,22 @@ func (m *ManageModular) createGlobalVirtualGroup(vgfID uint32, params *storagety
 	})
 }
 
+// PickGlobalVirtualGroup is used to pick a suitable gvg for replicating object while uploading.
+func (m *ManageModular) PickGlobalVirtualGroup(ctx context.Context, task task.UploadObjectTask) (
+	*gfspserver.GfSpPickGlobalVirtualGroupResponse, error) {
+	gvgMeta, err := m.pickGlobalVirtualGroup(ctx, task.GetVirtualGroupFamilyId(), task.GetStorageParams())
+	if err != nil {
+		// If there is no way to create a new GVG, release all sp from freeze Pool, better than not serving requests.
+		m.virtualGroupManager.ReleaseAllSP()
+		return nil, err
+	}
+	return &gfspserver.GfSpPickGlobalVirtualGroupResponse{
+		GlobalVirtualGroupId: gvgMeta.ID,
+		SecondarySpIds:       gvgMeta.SecondarySPIDs,
+		SecondaryEndpoints:   gvgMeta.SecondarySPEndpoints,
+	}, nil
+}
+
 // pickGlobalVirtualGroup is used to pick a suitable gvg for replicating object.
 func (m *ManageModular) pickGlobalVirtualGroup(ctx context.Context, vgfID uint32, param *storagetypes.Params) (*vgmgr.GlobalVirtualGroupMeta, error) {
 	var (
@@ -15,6 +15,7 @@ import (
 	"golang.org/x/exp/slices"
 
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspapp"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
 	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfspserver"
 	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
 	"github.com/bnb-chain/greenfield-storage-provider/core/module"
@@ -144,6 +145,14 @@ type ManageModular struct {
 	rejectUnsealThresholdSecond uint64
 	taskRetryScheduler          *TaskRetryScheduler
 
+	enableIntegrityScrubber     bool
+	integrityScrubRate          int
+	integrityScrubRoundInterval time.Duration
+	integrityScrubber           *IntegrityScrubber
+
+	inventoryConfigs   []gfspconfig.InventoryConfig
+	inventoryScheduler *InventoryScheduler
+
 	spMonthlyFreeQuota uint64
 }
 
@@ -200,6 +209,8 @@ func (m *ManageModular) Start(ctx context.Context) error {
 		}
 	}
 	m.startTaskRetryScheduler()
+	m.startIntegrityScrubber(ctx)
+	m.startInventoryScheduler(ctx)
 	go m.delayStartMigrateScheduler()
 	go m.eventLoop(ctx)
 	return nil
@@ -214,6 +225,24 @@ func (m *ManageModular) startTaskRetryScheduler() {
 	m.taskRetryScheduler.Start()
 }
 
+func (m *ManageModular) startIntegrityScrubber(ctx context.Context) {
+	if !m.enableIntegrityScrubber {
+		log.Info("Skip to start integrity scrubber")
+		return
+	}
+	m.integrityScrubber = NewIntegrityScrubber(m)
+	m.integrityScrubber.Start(ctx)
+}
+
+func (m *ManageModular) startInventoryScheduler(ctx context.Context) {
+	if len(m.inventoryConfigs) == 0 {
+		log.Info("Skip to start inventory scheduler")
+		return
+	}
+	m.inventoryScheduler = NewInventoryScheduler(m)
+	m.inventoryScheduler.Start(ctx)
+}
+
 func (m *ManageModular) delayStartMigrateScheduler() {
 	// delay start to wait metadata service ready.
 	// migrate scheduler init depend metadata.
@@ -1,6 +1,8 @@
 package manager
 
 import (
+	"time"
+
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspapp"
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
 	coremodule "github.com/bnb-chain/greenfield-storage-provider/core/module"
@@ -110,6 +112,11 @@ const (
 	DefaultSubscribeSwapOutEventIntervalMillisecond = 2000
 	// DefaultGCExpiredOffChainAuthKeysTimeInterval define the default time interval to gc expired off chain auth keys
 	DefaultGCExpiredOffChainAuthKeysTimeInterval = 24 * 3600
+	// DefaultIntegrityScrubPiecesPerSecond defines the default number of pieces re-hashed per second by the
+	// integrity scrubber.
+	DefaultIntegrityScrubPiecesPerSecond = 10
+	// DefaultIntegrityScrubRoundIntervalSecond defines the default idle seconds between two scrub rounds.
+	DefaultIntegrityScrubRoundIntervalSecond = 24 * 3600
 )
 
 const (
@@ -121,11 +128,19 @@ const (
 	ManagerCancelReplicate         = "manager_replicate_object_cancel"
 	ManagerSuccessReplicateAndSeal = "manager_replicate_and_seal_object_success"
 	ManagerFailureReplicateAndSeal = "manager_replicate_and_seal_object_failure"
-	ManagerSuccessSeal             = "manager_seal_object_success"
-	ManagerFailureSeal             = "manager_seal_object_failure"
-	ManagerCancelSeal              = "manager_seal_object_cancel"
-	ManagerSuccessConfirmReceive   = "manager_confirm_receive_success"
-	ManagerFailureConfirmReceive   = "manager_confirm_receive_failure"
+	// ManagerSuccessStreamingReplicate counts the objects which are replicated while uploading.
+	ManagerSuccessStreamingReplicate = "manager_streaming_replicate_object_success"
+	ManagerSuccessSeal               = "manager_seal_object_success"
+	ManagerFailureSeal               = "manager_seal_object_failure"
+	ManagerCancelSeal                = "manager_seal_object_cancel"
+	ManagerSuccessConfirmReceive     = "manager_confirm_receive_success"
+	ManagerFailureConfirmReceive     = "manager_confirm_receive_failure"
+
+	ScrubScannedPiece = "scrub_scanned_piece"
+	ScrubCorruptPiece = "scrub_corrupt_piece"
+	ScrubMissingPiece = "scrub_missing_piece"
+	ScrubNextObjectID = "scrub_next_object_id"
+	ScrubRound        = "scrub_round"
 )
 
 func NewManageModular(app *gfspapp.GfSpBaseApp, cfg *gfspconfig.GfSpConfig) (coremodule.Modular, error) {
@@ -318,6 +333,21 @@ func DefaultManagerOptions(manager *ManageModular, cfg *gfspconfig.GfSpConfig) (
 	manager.enableTaskRetryScheduler = cfg.Manager.EnableTaskRetryScheduler
 	manager.rejectUnsealThresholdSecond = cfg.Manager.RejectUnsealThresholdSecond
 
+	manager.enableIntegrityScrubber = cfg.Manager.EnableIntegrityScrubber
+	if cfg.Manager.IntegrityScrubPiecesPerSecond == 0 {
+		cfg.Manager.IntegrityScrubPiecesPerSecond = DefaultIntegrityScrubPiecesPerSecond
+	}
+	manager.integrityScrubRate = int(cfg.Manager.IntegrityScrubPiecesPerSecond)
+	if cfg.Manager.IntegrityScrubRoundIntervalSecond == 0 {
+		cfg.Manager.IntegrityScrubRoundIntervalSecond = DefaultIntegrityScrubRoundIntervalSecond
+	}
+	manager.integrityScrubRoundInterval = time.Duration(cfg.Manager.IntegrityScrubRoundIntervalSecond) * time.Second
+
+	if err = checkInventoryConfigs(cfg.Manager.Inventories); err != nil {
+		return err
+	}
+	manager.inventoryConfigs = cfg.Manager.Inventories
+
 	manager.enableBucketMigrateCache = cfg.Manager.EnableBucketMigrateCache
 
 	if cfg.Quota.MonthlyFreeQuota == 0 {
@@ -130,6 +130,97 @@ func TestManageModular_PickGVGAndReplicate(t *testing.T) {
 	assert.Equal(t, nil, err)
 }
 
+func TestManageModular_PickGlobalVirtualGroup(t *testing.T) {
+	m := setup(t)
+	ctrl := gomock.NewController(t)
+	vgm := vgmgr.NewMockVirtualGroupManager(ctrl)
+	m.virtualGroupManager = vgm
+	vgm.EXPECT().PickGlobalVirtualGroup(uint32(1), gomock.Any()).Return(&vgmgr.GlobalVirtualGroupMeta{
+		ID:                   2,
+		SecondarySPIDs:       []uint32{3},
+		SecondarySPEndpoints: []string{"test"},
+	}, nil).Times(1)
+
+	resp, err := m.PickGlobalVirtualGroup(context.TODO(), &gfsptask.GfSpUploadObjectTask{
+		Task:                 &gfsptask.GfSpTask{},
+		VirtualGroupFamilyId: 1,
+		StorageParams:        &types0.Params{},
+	})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, uint32(2), resp.GetGlobalVirtualGroupId())
+	assert.Equal(t, []uint32{3}, resp.GetSecondarySpIds())
+	assert.Equal(t, []string{"test"}, resp.GetSecondaryEndpoints())
+}
+
+func TestManageModular_HandleDoneUploadObjectTaskStreamingReplicated(t *testing.T) {
+	m := setup(t)
+	ctrl := gomock.NewController(t)
+	m.sealQueue = gfsptqueue.NewGfSpTQueueWithLimit("test", 2)
+	db := spdb.NewMockSPDB(ctrl)
+	m.baseApp.SetGfSpDB(db)
+	db.EXPECT().UpdateUploadProgress(gomock.Any()).Return(nil).AnyTimes()
+	// the replicated object is sealed without picking gvg again
+	vgm := vgmgr.NewMockVirtualGroupManager(ctrl)
+	m.virtualGroupManager = vgm
+
+	uot := &gfsptask.GfSpUploadObjectTask{
+		ObjectInfo: &types0.ObjectInfo{
+			Id:         sdkmath.NewUint(1),
+			BucketName: "test",
+			ObjectName: "test",
+		},
+		Task: &gfsptask.GfSpTask{
+			TaskPriority: 1,
+		},
+		StorageParams:        &types0.Params{},
+		GlobalVirtualGroupId: 2,
+		SecondaryEndpoints:   []string{"test"},
+		SecondarySignatures:  [][]byte{[]byte("test")},
+	}
+	err := m.HandleDoneUploadObjectTask(context.TODO(), uot)
+	assert.Equal(t, nil, err)
+	key := &gfsptask.GfSpSealObjectTask{}
+	key.InitSealObjectTask(2, uot.GetObjectInfo(), uot.GetStorageParams(), 0, nil, nil, 0, 0, false)
+	sealTask, ok := m.sealQueue.PopByKey(key.Key()).(*gfsptask.GfSpSealObjectTask)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(2), sealTask.GetGlobalVirtualGroupId())
+	assert.Equal(t, [][]byte{[]byte("test")}, sealTask.GetSecondarySignatures())
+}
+
+func TestManageModular_HandleDoneUploadObjectTaskStreamingAborted(t *testing.T) {
+	m := setup(t)
+	ctrl := gomock.NewController(t)
+	m.replicateQueue = gfsptqueue.NewGfSpTQueueWithLimit("test", 2)
+	db := spdb.NewMockSPDB(ctrl)
+	m.baseApp.SetGfSpDB(db)
+	db.EXPECT().UpdateUploadProgress(gomock.Any()).Return(nil).AnyTimes()
+	// the object is replicated to the gvg of the aborted streaming replication without picking gvg again
+	vgm := vgmgr.NewMockVirtualGroupManager(ctrl)
+	m.virtualGroupManager = vgm
+
+	uot := &gfsptask.GfSpUploadObjectTask{
+		ObjectInfo: &types0.ObjectInfo{
+			Id:         sdkmath.NewUint(1),
+			BucketName: "test",
+			ObjectName: "test",
+		},
+		Task: &gfsptask.GfSpTask{
+			TaskPriority: 1,
+		},
+		StorageParams:        &types0.Params{},
+		GlobalVirtualGroupId: 2,
+		SecondaryEndpoints:   []string{"test"},
+	}
+	err := m.HandleDoneUploadObjectTask(context.TODO(), uot)
+	assert.Equal(t, nil, err)
+	key := &gfsptask.GfSpReplicatePieceTask{}
+	key.InitReplicatePieceTask(uot.GetObjectInfo(), uot.GetStorageParams(), 0, 0, 0, false)
+	replicateTask, ok := m.replicateQueue.PopByKey(key.Key()).(*gfsptask.GfSpReplicatePieceTask)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(2), replicateTask.GetGlobalVirtualGroupId())
+	assert.Equal(t, []string{"test"}, replicateTask.GetSecondaryEndpoints())
+}
+
 func TestManageModular_HandleCreateResumableUploadObjectTask(t *testing.T) {
 	m := setup(t)
 	ctrl := gomock.NewController(t)
@@ -10,6 +10,7 @@ import (
 
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspapp"
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfsptqueue"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gnfd"
 	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
 	"github.com/bnb-chain/greenfield-storage-provider/core/piecestore"
 	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
@@ -665,7 +666,7 @@ func (s *VerifyGVGScheduler) Start() {
 				_, err = s.manager.baseApp.Consensus().QueryObjectInfoByID(context.Background(), util.Uint64ToString(objectID))
 				if err != nil {
 					log.Errorw("failed to get object info from chain", "object_id", objectID, "error", err)
-					if strings.Contains(err.Error(), "No such object") {
+					if errors.Is(err, gnfd.ErrNoSuchObject) {
 						log.Infow("the object has been deleted from chain")
 						err = s.manager.baseApp.GfSpDB().DeleteRecoverFailedObject(objectID)
 						if err != nil {
@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/prysmaticlabs/prysm/crypto/bls"
@@ -12,6 +11,7 @@ import (
 	"github.com/bnb-chain/greenfield-common/go/hash"
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspapp"
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfsptqueue"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gnfd"
 	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
 	"github.com/bnb-chain/greenfield-storage-provider/core/piecestore"
 	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
@@ -268,7 +268,7 @@ func (s *TaskRetryScheduler) startRejectUnsealTaskRetry() {
 }
 
 func isNotFound(err error) bool {
-	return strings.Contains(err.Error(), "No such object")
+	return errors.Is(err, gnfd.ErrNoSuchObject)
 }
 
 // retryReplicateTask is used to push the failed replicate task to task dispatcher,
@@ -450,6 +450,11 @@ func sendAndConfirmSealObjectTx(baseApp *gfspapp.GfSpBaseApp, msg *storagetypes.
 				txErr  error
 			)
 			if txHash, txErr = baseApp.GfSpClient().SealObject(context.Background(), msg); txErr != nil && !isAlreadyExists(txErr) {
+				if txHash != "" {
+					// the seal tx is broadcast but not committed in time, confirm it instead of sending another one
+					log.Warnw("seal object tx is pending", "tx_hash", txHash, "error", txErr)
+					return txHash, nil
+				}
 				log.Errorw("failed to send seal object", "seal_object_msg", msg, "error", txErr)
 				return "", txErr
 			}
@@ -465,6 +470,11 @@ func sendAndConfirmSealObjectTxV2(baseApp *gfspapp.GfSpBaseApp, msg *storagetype
 				txErr  error
 			)
 			if txHash, txErr = baseApp.GfSpClient().SealObjectV2(context.Background(), msg); txErr != nil && !isAlreadyExists(txErr) {
+				if txHash != "" {
+					// the seal tx is broadcast but not committed in time, confirm it instead of sending another one
+					log.Warnw("seal object tx is pending", "tx_hash", txHash, "error", txErr)
+					return txHash, nil
+				}
 				log.Errorw("failed to send seal object", "seal_object_msg", msg, "error", txErr)
 				return "", txErr
 			}
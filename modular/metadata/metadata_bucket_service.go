@@ -40,6 +40,8 @@ var (
 	ErrNoSuchGroup = gfsperrors.Register(coremodule.MetadataModularName, http.StatusNotFound, 90009, "the specified group does not exist")
 	// ErrNoSuchObject defines not existed object error
 	ErrNoSuchObject = gfsperrors.Register(coremodule.MetadataModularName, http.StatusNotFound, 90010, "the specified object does not exist")
+	// ErrHistoryNotAvailable defines the history of the requested block height is not maintained
+	ErrHistoryNotAvailable = gfsperrors.Register(coremodule.MetadataModularName, http.StatusBadRequest, 90011, "the history of the requested block height is not available")
 )
 
 var _ types.GfSpMetadataServiceServer = &MetadataModular{}
@@ -129,7 +131,14 @@ func (r *MetadataModular) GfSpGetBucketByBucketName(ctx context.Context, req *ty
 		return nil, ErrInvalidBucketName
 	}
 
-	bucket, err = r.baseApp.GfBsDB().GetBucketByName(req.BucketName, req.IncludePrivate)
+	if req.AtHeight != 0 {
+		if err = r.checkHistoryHeight(ctx, req.AtHeight); err != nil {
+			return nil, err
+		}
+		bucket, err = r.baseApp.GfBsDB().GetBucketByNameAtHeight(req.BucketName, req.IncludePrivate, req.AtHeight)
+	} else {
+		bucket, err = r.baseApp.GfBsDB().GetBucketByName(req.BucketName, req.IncludePrivate)
+	}
 	if err != nil {
 		log.CtxErrorw(ctx, "failed to get bucket by bucket name", "error", err)
 		return nil, err
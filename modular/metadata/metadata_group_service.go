@@ -156,7 +156,14 @@ func (r *MetadataModular) GfSpGetGroupMembers(ctx context.Context, req *types.Gf
 		req.StartAfter = "0"
 	}
 
-	groups, err = r.baseApp.GfBsDB().GetGroupMembers(common.BigToHash(math.NewUint(req.GroupId).BigInt()), common.HexToAddress(req.StartAfter), limit)
+	if req.AtHeight != 0 {
+		if err = r.checkHistoryHeight(ctx, req.AtHeight); err != nil {
+			return nil, err
+		}
+		groups, err = r.baseApp.GfBsDB().GetGroupMembersAtHeight(common.BigToHash(math.NewUint(req.GroupId).BigInt()), common.HexToAddress(req.StartAfter), limit, req.AtHeight)
+	} else {
+		groups, err = r.baseApp.GfBsDB().GetGroupMembers(common.BigToHash(math.NewUint(req.GroupId).BigInt()), common.HexToAddress(req.StartAfter), limit)
+	}
 	if err != nil {
 		log.CtxErrorw(ctx, "failed to get group members by group id", "error", err)
 		return nil, err
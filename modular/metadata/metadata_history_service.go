@@ -0,0 +1,30 @@
+package metadata
+
+import (
+	"context"
+
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+)
+
+// checkHistoryHeight checks whether the block height is covered by the history tables of the block syncer
+func (r *MetadataModular) checkHistoryHeight(ctx context.Context, height int64) error {
+	if height < 0 {
+		log.CtxErrorw(ctx, "failed to check history height", "height", height)
+		return ErrInvalidParams
+	}
+	epoch, err := r.baseApp.GfBsDB().GetHistoryEpoch()
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to get history epoch", "error", err)
+		return ErrGfSpDBWithDetail("failed to get history epoch, error: " + err.Error())
+	}
+	if epoch == nil || height < epoch.StartHeight {
+		log.CtxErrorw(ctx, "the history of the height is not available", "height", height)
+		return ErrHistoryNotAvailable
+	}
+	if height > epoch.BlockHeight {
+		log.CtxErrorw(ctx, "the height exceeds the latest height of the history", "height", height,
+			"block_height", epoch.BlockHeight)
+		return ErrExceedBlockHeight
+	}
+	return nil
+}
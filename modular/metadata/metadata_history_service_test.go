@@ -0,0 +1,166 @@
+package metadata
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/forbole/juno/v4/common"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/greenfield-storage-provider/modular/metadata/types"
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+	permtypes "github.com/bnb-chain/greenfield/x/permission/types"
+)
+
+func TestMetadataModular_checkHistoryHeight(t *testing.T) {
+	a := setup(t)
+	ctrl := gomock.NewController(t)
+	m := bsdb.NewMockBSDB(ctrl)
+	a.baseApp.SetGfBsDB(m)
+
+	assert.Equal(t, ErrInvalidParams, a.checkHistoryHeight(context.Background(), -1))
+
+	m.EXPECT().GetHistoryEpoch().Return(nil, nil).Times(1)
+	assert.Equal(t, ErrHistoryNotAvailable, a.checkHistoryHeight(context.Background(), 100))
+
+	m.EXPECT().GetHistoryEpoch().Return(&bsdb.HistoryEpoch{StartHeight: 100, BlockHeight: 200}, nil).Times(3)
+	assert.Equal(t, ErrHistoryNotAvailable, a.checkHistoryHeight(context.Background(), 99))
+	assert.Equal(t, ErrExceedBlockHeight, a.checkHistoryHeight(context.Background(), 201))
+	assert.Nil(t, a.checkHistoryHeight(context.Background(), 150))
+
+	m.EXPECT().GetHistoryEpoch().Return(nil, gorm.ErrInvalidDB).Times(1)
+	assert.NotNil(t, a.checkHistoryHeight(context.Background(), 150))
+}
+
+func TestMetadataModular_GfSpGetBucketByBucketName_AtHeight(t *testing.T) {
+	a := setup(t)
+	ctrl := gomock.NewController(t)
+	m := bsdb.NewMockBSDB(ctrl)
+	a.baseApp.SetGfBsDB(m)
+	m.EXPECT().GetHistoryEpoch().Return(&bsdb.HistoryEpoch{StartHeight: 100, BlockHeight: 200}, nil).AnyTimes()
+	m.EXPECT().GetBucketByNameAtHeight("44yei", true, int64(150)).Return(&bsdb.Bucket{
+		BucketName: "44yei",
+		Visibility: "VISIBILITY_TYPE_PRIVATE",
+		UpdateAt:   120,
+	}, nil).Times(1)
+
+	resp, err := a.GfSpGetBucketByBucketName(context.Background(), &types.GfSpGetBucketByBucketNameRequest{
+		BucketName:     "44yei",
+		IncludePrivate: true,
+		AtHeight:       150,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "44yei", resp.Bucket.BucketInfo.BucketName)
+	assert.Equal(t, int64(120), resp.Bucket.UpdateAt)
+
+	_, err = a.GfSpGetBucketByBucketName(context.Background(), &types.GfSpGetBucketByBucketNameRequest{
+		BucketName: "44yei",
+		AtHeight:   300,
+	})
+	assert.Equal(t, ErrExceedBlockHeight, err)
+}
+
+func TestMetadataModular_GfSpListObjectsByBucketName_AtHeight(t *testing.T) {
+	a := setup(t)
+	ctrl := gomock.NewController(t)
+	m := bsdb.NewMockBSDB(ctrl)
+	a.baseApp.SetGfBsDB(m)
+	m.EXPECT().GetHistoryEpoch().Return(&bsdb.HistoryEpoch{StartHeight: 100, BlockHeight: 200}, nil).AnyTimes()
+	m.EXPECT().ListObjectsByBucketNameAtHeight("bucket", "", "a/", 2, int64(150)).Return([]*bsdb.ListObjectsResult{
+		{Object: &bsdb.Object{BucketName: "bucket", ObjectName: "a/1"}},
+		{Object: &bsdb.Object{BucketName: "bucket", ObjectName: "a/2"}},
+		{Object: &bsdb.Object{BucketName: "bucket", ObjectName: "a/3"}},
+	}, nil).Times(1)
+
+	resp, err := a.GfSpListObjectsByBucketName(context.Background(), &types.GfSpListObjectsByBucketNameRequest{
+		BucketName: "bucket",
+		MaxKeys:    2,
+		Prefix:     "a/",
+		AtHeight:   150,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(resp.Objects))
+	assert.True(t, resp.IsTruncated)
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte("a/3")), resp.NextContinuationToken)
+
+	// the folders are not kept in the history
+	_, err = a.GfSpListObjectsByBucketName(context.Background(), &types.GfSpListObjectsByBucketNameRequest{
+		BucketName: "bucket",
+		Delimiter:  "/",
+		AtHeight:   150,
+	})
+	assert.Equal(t, ErrInvalidParams, err)
+}
+
+func TestMetadataModular_GfSpGetObjectMeta_AtHeight(t *testing.T) {
+	a := setup(t)
+	ctrl := gomock.NewController(t)
+	m := bsdb.NewMockBSDB(ctrl)
+	a.baseApp.SetGfBsDB(m)
+	m.EXPECT().GetHistoryEpoch().Return(&bsdb.HistoryEpoch{StartHeight: 100, BlockHeight: 200}, nil).AnyTimes()
+	m.EXPECT().GetObjectByNameAtHeight("object", "bucket", false, int64(150)).
+		Return(&bsdb.Object{BucketName: "bucket", ObjectName: "object"}, nil).Times(1)
+	m.EXPECT().GetObjectByNameAtHeight("object", "bucket", false, int64(110)).
+		Return(nil, gorm.ErrRecordNotFound).Times(1)
+
+	resp, err := a.GfSpGetObjectMeta(context.Background(), &types.GfSpGetObjectMetaRequest{
+		ObjectName: "object",
+		BucketName: "bucket",
+		AtHeight:   150,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "object", resp.Object.ObjectInfo.ObjectName)
+
+	_, err = a.GfSpGetObjectMeta(context.Background(), &types.GfSpGetObjectMetaRequest{
+		ObjectName: "object",
+		BucketName: "bucket",
+		AtHeight:   110,
+	})
+	assert.Equal(t, ErrNoSuchObject, err)
+}
+
+func TestMetadataModular_GfSpGetGroupMembers_AtHeight(t *testing.T) {
+	a := setup(t)
+	ctrl := gomock.NewController(t)
+	m := bsdb.NewMockBSDB(ctrl)
+	a.baseApp.SetGfBsDB(m)
+	m.EXPECT().GetHistoryEpoch().Return(&bsdb.HistoryEpoch{StartHeight: 100, BlockHeight: 200}, nil).Times(1)
+	m.EXPECT().GetGroupMembersAtHeight(gomock.Any(), gomock.Any(), 10, int64(150)).Return([]*bsdb.GroupMemberMeta{
+		{Group: bsdb.Group{AccountID: common.HexToAddress("0x1"), GroupName: "group"}},
+	}, nil).Times(1)
+
+	resp, err := a.GfSpGetGroupMembers(context.Background(), &types.GfSpGetGroupMembersRequest{
+		GroupId:  1,
+		Limit:    10,
+		AtHeight: 150,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(resp.Groups))
+	assert.Equal(t, "group", resp.Groups[0].Group.GroupName)
+}
+
+func TestMetadataModular_GfSpListObjectPolicies_AtHeight(t *testing.T) {
+	a := setup(t)
+	ctrl := gomock.NewController(t)
+	m := bsdb.NewMockBSDB(ctrl)
+	a.baseApp.SetGfBsDB(m)
+	objectID := common.HexToHash("0x1")
+	m.EXPECT().GetHistoryEpoch().Return(&bsdb.HistoryEpoch{StartHeight: 100, BlockHeight: 200}, nil).Times(1)
+	m.EXPECT().GetObjectByNameAtHeight("object", "bucket", true, int64(150)).
+		Return(&bsdb.Object{ObjectID: objectID, BucketName: "bucket", ObjectName: "object"}, nil).Times(1)
+	m.EXPECT().ListObjectPoliciesAtHeight(objectID, permtypes.ACTION_GET_OBJECT, gomock.Any(), 50, int64(150)).
+		Return([]*bsdb.PermissionWithStatement{{Permission: bsdb.Permission{PrincipalValue: "0x01"}}}, nil).Times(1)
+
+	resp, err := a.GfSpListObjectPolicies(context.Background(), &types.GfSpListObjectPoliciesRequest{
+		ObjectName: "object",
+		BucketName: "bucket",
+		ActionType: permtypes.ACTION_GET_OBJECT,
+		AtHeight:   150,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(resp.Policies))
+	assert.Equal(t, "0x01", resp.Policies[0].PrincipalValue)
+}
@@ -0,0 +1,81 @@
+package metadata
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/forbole/juno/v4/common"
+
+	"github.com/bnb-chain/greenfield-storage-provider/modular/metadata/types"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+)
+
+// GfSpPutBucketNotification replaces the notification rules of the bucket. The rules are written to both the master
+// and the backup db, so the notifications keep being queued after the block syncer switches the dbs.
+func (r *MetadataModular) GfSpPutBucketNotification(ctx context.Context, req *types.GfSpPutBucketNotificationRequest) (
+	resp *types.GfSpPutBucketNotificationResponse, err error) {
+	ctx = log.Context(ctx, req)
+	if req.GetBucketName() == "" {
+		log.CtxErrorw(ctx, "failed to put bucket notification due to empty bucket name")
+		return nil, ErrInvalidParams
+	}
+
+	bucketID := common.BigToHash(new(big.Int).SetUint64(req.GetBucketId()))
+	createTime := time.Now().Unix()
+	rules := make([]*bsdb.NotificationRule, len(req.GetRules()))
+	for idx, rule := range req.GetRules() {
+		rules[idx] = &bsdb.NotificationRule{
+			BucketName: req.GetBucketName(),
+			BucketID:   bucketID,
+			RuleID:     rule.GetRuleId(),
+			Events:     strings.Join(rule.GetEvents(), bsdb.NotificationEventSeparator),
+			Prefix:     rule.GetPrefix(),
+			Suffix:     rule.GetSuffix(),
+			WebhookURL: rule.GetWebhookUrl(),
+			Secret:     rule.GetSecret(),
+			CreateTime: createTime,
+		}
+	}
+
+	for _, db := range []bsdb.BSDB{r.baseApp.GfBsDBMaster(), r.baseApp.GfBsDBBackup()} {
+		if db == nil {
+			continue
+		}
+		if err = db.PutNotificationRules(req.GetBucketName(), rules); err != nil {
+			log.CtxErrorw(ctx, "failed to put bucket notification", "error", err)
+			return nil, ErrGfSpDBWithDetail("failed to put bucket notification, error: " + err.Error())
+		}
+	}
+
+	log.CtxInfow(ctx, "succeed to put bucket notification", "rules", len(rules))
+	return &types.GfSpPutBucketNotificationResponse{}, nil
+}
+
+// GfSpGetBucketNotification returns the notification rules of the bucket, the secrets of the rules are not returned
+func (r *MetadataModular) GfSpGetBucketNotification(ctx context.Context, req *types.GfSpGetBucketNotificationRequest) (
+	resp *types.GfSpGetBucketNotificationResponse, err error) {
+	ctx = log.Context(ctx, req)
+	rules, err := r.baseApp.GfBsDB().ListNotificationRules(req.GetBucketName())
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to list bucket notification rules", "error", err)
+		return nil, ErrGfSpDBWithDetail("failed to list bucket notification rules, error: " + err.Error())
+	}
+
+	res := make([]*types.NotificationRule, len(rules))
+	for idx, rule := range rules {
+		res[idx] = &types.NotificationRule{
+			RuleId:     rule.RuleID,
+			Events:     rule.GetEvents(),
+			Prefix:     rule.Prefix,
+			Suffix:     rule.Suffix,
+			WebhookUrl: rule.WebhookURL,
+			CreateTime: rule.CreateTime,
+		}
+	}
+
+	log.CtxInfow(ctx, "succeed to get bucket notification", "rules", len(res))
+	return &types.GfSpGetBucketNotificationResponse{Rules: res}, nil
+}
@@ -0,0 +1,99 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/forbole/juno/v4/common"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bnb-chain/greenfield-storage-provider/modular/metadata/types"
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+)
+
+func TestMetadataModular_GfSpPutBucketNotification(t *testing.T) {
+	a := setup(t)
+	ctrl := gomock.NewController(t)
+	master := bsdb.NewMockBSDB(ctrl)
+	backup := bsdb.NewMockBSDB(ctrl)
+	check := func(bucketName string, rules []*bsdb.NotificationRule) error {
+		assert.Equal(t, "bucket", bucketName)
+		assert.Equal(t, 1, len(rules))
+		assert.Equal(t, common.BigToHash(big.NewInt(10)), rules[0].BucketID)
+		assert.Equal(t, "ObjectCreated:*,ObjectRemoved:Delete", rules[0].Events)
+		assert.Equal(t, "secret", rules[0].Secret)
+		assert.NotZero(t, rules[0].CreateTime)
+		return nil
+	}
+	master.EXPECT().PutNotificationRules(gomock.Any(), gomock.Any()).DoAndReturn(check).Times(1)
+	backup.EXPECT().PutNotificationRules(gomock.Any(), gomock.Any()).DoAndReturn(check).Times(1)
+	a.baseApp.SetGfBsDBMaster(master)
+	a.baseApp.SetGfBsDBBackup(backup)
+
+	_, err := a.GfSpPutBucketNotification(context.Background(), &types.GfSpPutBucketNotificationRequest{
+		BucketName: "bucket",
+		BucketId:   10,
+		Rules: []*types.NotificationRule{{
+			RuleId:     "rule",
+			Events:     []string{"ObjectCreated:*", bsdb.NotificationObjectDeleted},
+			WebhookUrl: "https://example.com",
+			Secret:     "secret",
+		}},
+	})
+	assert.Nil(t, err)
+}
+
+func TestMetadataModular_GfSpPutBucketNotificationFailure(t *testing.T) {
+	t.Run("empty bucket name", func(t *testing.T) {
+		a := setup(t)
+		_, err := a.GfSpPutBucketNotification(context.Background(), &types.GfSpPutBucketNotificationRequest{})
+		assert.Equal(t, ErrInvalidParams, err)
+	})
+
+	t.Run("failed to put rules", func(t *testing.T) {
+		a := setup(t)
+		ctrl := gomock.NewController(t)
+		m := bsdb.NewMockBSDB(ctrl)
+		m.EXPECT().PutNotificationRules(gomock.Any(), gomock.Any()).Return(errors.New("mock error")).Times(1)
+		a.baseApp.SetGfBsDBMaster(m)
+		_, err := a.GfSpPutBucketNotification(context.Background(), &types.GfSpPutBucketNotificationRequest{BucketName: "bucket"})
+		assert.NotNil(t, err)
+	})
+}
+
+func TestMetadataModular_GfSpGetBucketNotification(t *testing.T) {
+	a := setup(t)
+	ctrl := gomock.NewController(t)
+	m := bsdb.NewMockBSDB(ctrl)
+	m.EXPECT().ListNotificationRules("bucket").Return([]*bsdb.NotificationRule{{
+		BucketName: "bucket",
+		RuleID:     "rule",
+		Events:     "ObjectCreated:*,ObjectRemoved:Delete",
+		Prefix:     "images/",
+		WebhookURL: "https://example.com",
+		Secret:     "secret",
+		CreateTime: 100,
+	}}, nil).Times(1)
+	a.baseApp.SetGfBsDB(m)
+
+	resp, err := a.GfSpGetBucketNotification(context.Background(), &types.GfSpGetBucketNotificationRequest{BucketName: "bucket"})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(resp.GetRules()))
+	assert.Equal(t, []string{"ObjectCreated:*", bsdb.NotificationObjectDeleted}, resp.GetRules()[0].GetEvents())
+	assert.Equal(t, "images/", resp.GetRules()[0].GetPrefix())
+	assert.Empty(t, resp.GetRules()[0].GetSecret())
+}
+
+func TestMetadataModular_GfSpGetBucketNotificationFailure(t *testing.T) {
+	a := setup(t)
+	ctrl := gomock.NewController(t)
+	m := bsdb.NewMockBSDB(ctrl)
+	m.EXPECT().ListNotificationRules(gomock.Any()).Return(nil, errors.New("mock error")).Times(1)
+	a.baseApp.SetGfBsDB(m)
+
+	_, err := a.GfSpGetBucketNotification(context.Background(), &types.GfSpGetBucketNotificationRequest{BucketName: "bucket"})
+	assert.NotNil(t, err)
+}
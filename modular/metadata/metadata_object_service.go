@@ -41,7 +41,19 @@ func (r *MetadataModular) GfSpListObjectsByBucketName(ctx context.Context, req *
 	}
 
 	ctx = log.Context(ctx, req)
-	results, err = r.baseApp.GfBsDB().ListObjectsByBucketName(req.BucketName, req.ContinuationToken, req.Prefix, req.Delimiter, int(maxKeys), req.IncludeRemoved)
+	if req.AtHeight != 0 {
+		// the folders and the removed objects are not kept in the history
+		if req.Delimiter != "" || req.IncludeRemoved {
+			log.CtxErrorw(ctx, "failed to list objects at height with delimiter or removed objects")
+			return nil, ErrInvalidParams
+		}
+		if err = r.checkHistoryHeight(ctx, req.AtHeight); err != nil {
+			return nil, err
+		}
+		results, err = r.baseApp.GfBsDB().ListObjectsByBucketNameAtHeight(req.BucketName, req.ContinuationToken, req.Prefix, int(maxKeys), req.AtHeight)
+	} else {
+		results, err = r.baseApp.GfBsDB().ListObjectsByBucketName(req.BucketName, req.ContinuationToken, req.Prefix, req.Delimiter, int(maxKeys), req.IncludeRemoved)
+	}
 	if err != nil {
 		log.CtxErrorw(ctx, "failed to list objects by bucket name", "error", err)
 		return
@@ -193,7 +205,14 @@ func (r *MetadataModular) GfSpGetObjectMeta(ctx context.Context, req *types.GfSp
 		return nil, ErrInvalidParams
 	}
 
-	object, err = r.baseApp.GfBsDB().GetObjectByName(req.ObjectName, req.BucketName, req.IncludePrivate)
+	if req.AtHeight != 0 {
+		if err = r.checkHistoryHeight(ctx, req.AtHeight); err != nil {
+			return nil, err
+		}
+		object, err = r.baseApp.GfBsDB().GetObjectByNameAtHeight(req.ObjectName, req.BucketName, req.IncludePrivate, req.AtHeight)
+	} else {
+		object, err = r.baseApp.GfBsDB().GetObjectByName(req.ObjectName, req.BucketName, req.IncludePrivate)
+	}
 	if err != nil {
 		log.CtxErrorw(ctx, "failed to get object by object name", "error", err)
 		if errors.Is(err, gorm.ErrRecordNotFound) {
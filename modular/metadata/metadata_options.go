@@ -8,6 +8,7 @@ import (
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
 	coremodule "github.com/bnb-chain/greenfield-storage-provider/core/module"
 	"github.com/bnb-chain/greenfield-storage-provider/modular/metadata/types"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
 )
 
@@ -53,6 +54,13 @@ func DefaultMetadataOptions(metadata *MetadataModular, cfg *gfspconfig.GfSpConfi
 	metadata.maxMetadataRequest = cfg.Parallel.QuerySPParallelPerNode
 
 	metadata.baseApp.SetGfBsDB(metadata.baseApp.GfBsDBMaster())
+	if metadata.baseApp.GfBsDBBackup() != nil {
+		interval := cfg.Metadata.BsDBSwitchCheckIntervalSec
+		if interval == 0 {
+			interval = DefaultBsDBSwitchCheckIntervalSec
+		}
+		metadata.startDBSwitchListener(time.Duration(interval) * time.Second)
+	}
 
 	BsModules = cfg.BlockSyncer.Modules
 	BsWorkers = cfg.BlockSyncer.Workers
@@ -83,6 +91,31 @@ func DefaultMetadataOptions(metadata *MetadataModular, cfg *gfspconfig.GfSpConfi
 	return nil
 }
 
+// startDBSwitchListener sets up a ticker to periodically check the switch db signal, and switches the block syncer
+// db to the master one, e.g. after the block syncer finishes re-indexing into the backup db.
+func (r *MetadataModular) startDBSwitchListener(interval time.Duration) {
+	r.switchDB()
+	go func() {
+		dbSwitchTicker := time.NewTicker(interval)
+		for range dbSwitchTicker.C {
+			r.switchDB()
+		}
+	}()
+}
+
+func (r *MetadataModular) switchDB() {
+	signal, err := r.baseApp.GfBsDBMaster().GetSwitchDBSignal()
+	if err != nil {
+		log.Errorw("failed to get switch db signal", "error", err)
+		return
+	}
+	if signal.IsMaster {
+		r.baseApp.SetGfBsDB(r.baseApp.GfBsDBMaster())
+	} else {
+		r.baseApp.SetGfBsDB(r.baseApp.GfBsDBBackup())
+	}
+}
+
 // startGoRoutineListener sets up a ticker to periodically check for go routine count of metadata service.
 func startGoRoutineListener() {
 	go func() {
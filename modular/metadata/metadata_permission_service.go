@@ -461,7 +461,14 @@ func (r *MetadataModular) GfSpListObjectPolicies(ctx context.Context, req *types
 		limit = bsdb.LisPoliciesLimitSize
 	}
 
-	object, err = r.baseApp.GfBsDB().GetObjectByName(req.ObjectName, req.BucketName, true)
+	if req.AtHeight != 0 {
+		if err = r.checkHistoryHeight(ctx, req.AtHeight); err != nil {
+			return nil, err
+		}
+		object, err = r.baseApp.GfBsDB().GetObjectByNameAtHeight(req.ObjectName, req.BucketName, true, req.AtHeight)
+	} else {
+		object, err = r.baseApp.GfBsDB().GetObjectByName(req.ObjectName, req.BucketName, true)
+	}
 	if err != nil {
 		log.CtxErrorw(ctx, "failed to get object info", "error", err)
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -470,7 +477,11 @@ func (r *MetadataModular) GfSpListObjectPolicies(ctx context.Context, req *types
 		return nil, err
 	}
 
-	permissions, err = r.baseApp.GfBsDB().ListObjectPolicies(object.ObjectID, req.ActionType, common.BigToHash(math.NewUint(req.StartAfter).BigInt()), limit)
+	if req.AtHeight != 0 {
+		permissions, err = r.baseApp.GfBsDB().ListObjectPoliciesAtHeight(object.ObjectID, req.ActionType, common.BigToHash(math.NewUint(req.StartAfter).BigInt()), limit, req.AtHeight)
+	} else {
+		permissions, err = r.baseApp.GfBsDB().ListObjectPolicies(object.ObjectID, req.ActionType, common.BigToHash(math.NewUint(req.StartAfter).BigInt()), limit)
+	}
 	if err != nil {
 		log.CtxErrorw(ctx, "failed to list policies by object info", "error", err)
 		return nil, err
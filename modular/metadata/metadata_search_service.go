@@ -0,0 +1,134 @@
+package metadata
+
+import (
+	"context"
+	"encoding/base64"
+
+	"cosmossdk.io/math"
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/greenfield-storage-provider/modular/metadata/types"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	model "github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// GfSpSearchObjects searches the objects of a bucket by name pattern, prefix, payload size, content type and create
+// time. The objects are ordered by object name, the continuation token is the name of the last returned object.
+func (r *MetadataModular) GfSpSearchObjects(ctx context.Context, req *types.GfSpSearchObjectsRequest) (
+	resp *types.GfSpSearchObjectsResponse, err error) {
+	var (
+		objects               []*model.Object
+		filters               []func(*gorm.DB) *gorm.DB
+		keyCount              uint64
+		isTruncated           bool
+		nextContinuationToken string
+		maxKeys               uint64
+		res                   []*types.Object
+	)
+
+	ctx = log.Context(ctx, req)
+	if req.GetBucketName() == "" {
+		log.CtxErrorw(ctx, "failed to search objects due to empty bucket name")
+		return nil, ErrInvalidParams
+	}
+	if req.GetMaxSize() != 0 && req.GetMinSize() > req.GetMaxSize() {
+		log.CtxErrorw(ctx, "failed to search objects due to invalid size range")
+		return nil, ErrInvalidParams
+	}
+	if req.GetCreatedBefore() != 0 && req.GetCreatedAfter() >= req.GetCreatedBefore() {
+		log.CtxErrorw(ctx, "failed to search objects due to invalid time range")
+		return nil, ErrInvalidParams
+	}
+
+	maxKeys = req.GetMaxKeys()
+	if maxKeys == 0 {
+		maxKeys = model.ListObjectsDefaultMaxKeys
+	}
+	if maxKeys > model.ListObjectsLimitSize {
+		maxKeys = model.ListObjectsLimitSize
+	}
+
+	if req.GetPrefix() != "" {
+		filters = append(filters, model.PrefixFilter(req.GetPrefix()))
+	}
+	if req.GetNamePattern() != "" {
+		filters = append(filters, model.ObjectNamePatternFilter(req.GetNamePattern()))
+	}
+	if req.GetMinSize() != 0 {
+		filters = append(filters, model.MinPayloadSizeFilter(req.GetMinSize()))
+	}
+	if req.GetMaxSize() != 0 {
+		filters = append(filters, model.MaxPayloadSizeFilter(req.GetMaxSize()))
+	}
+	if req.GetContentType() != "" {
+		filters = append(filters, model.ContentTypeFilter(req.GetContentType()))
+	}
+	if req.GetCreatedAfter() != 0 {
+		filters = append(filters, model.CreateTimeAfterFilter(req.GetCreatedAfter()))
+	}
+	if req.GetCreatedBefore() != 0 {
+		filters = append(filters, model.CreateTimeBeforeFilter(req.GetCreatedBefore()))
+	}
+
+	// return NextContinuationToken by adding 1 additionally
+	objects, err = r.baseApp.GfBsDB().SearchObjects(req.GetBucketName(), req.GetContinuationToken(), int(maxKeys)+1, filters...)
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to search objects", "error", err)
+		return nil, ErrGfSpDBWithDetail("failed to search objects, error: " + err.Error())
+	}
+
+	keyCount = uint64(len(objects))
+	if keyCount == maxKeys+1 {
+		isTruncated = true
+		keyCount -= 1
+		objects = objects[:len(objects)-1]
+		nextContinuationToken = objects[len(objects)-1].ObjectName
+	}
+
+	res = make([]*types.Object, len(objects))
+	for idx, object := range objects {
+		res[idx] = &types.Object{
+			ObjectInfo: &storagetypes.ObjectInfo{
+				Owner:               object.Owner.String(),
+				Creator:             object.Creator.String(),
+				BucketName:          object.BucketName,
+				ObjectName:          object.ObjectName,
+				Id:                  math.NewUintFromBigInt(object.ObjectID.Big()),
+				LocalVirtualGroupId: object.LocalVirtualGroupId,
+				PayloadSize:         object.PayloadSize,
+				Visibility:          storagetypes.VisibilityType(storagetypes.VisibilityType_value[object.Visibility]),
+				ContentType:         object.ContentType,
+				CreateAt:            object.CreateTime,
+				ObjectStatus:        storagetypes.ObjectStatus(storagetypes.ObjectStatus_value[object.ObjectStatus]),
+				RedundancyType:      storagetypes.RedundancyType(storagetypes.RedundancyType_value[object.RedundancyType]),
+				SourceType:          storagetypes.SourceType(storagetypes.SourceType_value[object.SourceType]),
+				Checksums:           object.Checksums,
+				Tags:                object.GetResourceTags(),
+				IsUpdating:          object.IsUpdating,
+				UpdatedAt:           object.ContentUpdatedTime,
+				UpdatedBy:           object.Updater.String(),
+				Version:             object.Version,
+			},
+			LockedBalance: object.LockedBalance.String(),
+			Removed:       object.Removed,
+			UpdateAt:      object.UpdateAt,
+			DeleteAt:      object.DeleteAt,
+			DeleteReason:  object.DeleteReason,
+			Operator:      object.Operator.String(),
+			CreateTxHash:  object.CreateTxHash.String(),
+			UpdateTxHash:  object.UpdateTxHash.String(),
+			SealTxHash:    object.SealTxHash.String(),
+		}
+	}
+
+	resp = &types.GfSpSearchObjectsResponse{
+		Objects:               res,
+		KeyCount:              keyCount,
+		MaxKeys:               maxKeys,
+		IsTruncated:           isTruncated,
+		NextContinuationToken: base64.StdEncoding.EncodeToString([]byte(nextContinuationToken)),
+	}
+	log.CtxInfow(ctx, "succeed to search objects", "key_count", keyCount)
+	return resp, nil
+}
@@ -0,0 +1,89 @@
+package metadata
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"github.com/forbole/juno/v4/common"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/greenfield-storage-provider/modular/metadata/types"
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+)
+
+func mockSearchObject(name string) *bsdb.Object {
+	return &bsdb.Object{
+		Owner:         common.HexToAddress("0xe978A9160BC061f602fa083e9C68539C549A421D"),
+		BucketName:    "barry",
+		ObjectName:    name,
+		ObjectID:      common.HexToHash("1"),
+		PayloadSize:   2048,
+		ContentType:   "video/mp4",
+		LockedBalance: common.HexToHash("1"),
+	}
+}
+
+func TestMetadataModular_GfSpSearchObjects(t *testing.T) {
+	a := setup(t)
+	ctrl := gomock.NewController(t)
+	m := bsdb.NewMockBSDB(ctrl)
+	a.baseApp.SetGfBsDB(m)
+	m.EXPECT().SearchObjects("barry", "a.mp4", 3, gomock.Any()).DoAndReturn(
+		func(bucketName, startAfter string, limit int, filters ...func(*gorm.DB) *gorm.DB) ([]*bsdb.Object, error) {
+			assert.Equal(t, 5, len(filters))
+			return []*bsdb.Object{mockSearchObject("b.mp4"), mockSearchObject("c.mp4"), mockSearchObject("d.mp4")}, nil
+		},
+	).Times(1)
+
+	resp, err := a.GfSpSearchObjects(context.Background(), &types.GfSpSearchObjectsRequest{
+		BucketName:        "barry",
+		NamePattern:       "*.mp4",
+		MinSize:           1024,
+		MaxSize:           4096,
+		ContentType:       "video/*",
+		CreatedAfter:      100,
+		ContinuationToken: "a.mp4",
+		MaxKeys:           2,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), resp.GetKeyCount())
+	assert.True(t, resp.GetIsTruncated())
+	assert.Equal(t, "b.mp4", resp.GetObjects()[0].GetObjectInfo().GetObjectName())
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte("c.mp4")), resp.GetNextContinuationToken())
+}
+
+func TestMetadataModular_GfSpSearchObjectsFailure(t *testing.T) {
+	t.Run("empty bucket name", func(t *testing.T) {
+		a := setup(t)
+		_, err := a.GfSpSearchObjects(context.Background(), &types.GfSpSearchObjectsRequest{})
+		assert.Equal(t, ErrInvalidParams, err)
+	})
+
+	t.Run("invalid size range", func(t *testing.T) {
+		a := setup(t)
+		_, err := a.GfSpSearchObjects(context.Background(), &types.GfSpSearchObjectsRequest{
+			BucketName: "barry", MinSize: 2, MaxSize: 1})
+		assert.Equal(t, ErrInvalidParams, err)
+	})
+
+	t.Run("invalid time range", func(t *testing.T) {
+		a := setup(t)
+		_, err := a.GfSpSearchObjects(context.Background(), &types.GfSpSearchObjectsRequest{
+			BucketName: "barry", CreatedAfter: 2, CreatedBefore: 2})
+		assert.Equal(t, ErrInvalidParams, err)
+	})
+
+	t.Run("failed to search objects", func(t *testing.T) {
+		a := setup(t)
+		ctrl := gomock.NewController(t)
+		m := bsdb.NewMockBSDB(ctrl)
+		a.baseApp.SetGfBsDB(m)
+		m.EXPECT().SearchObjects(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("mock error")).Times(1)
+		_, err := a.GfSpSearchObjects(context.Background(), &types.GfSpSearchObjectsRequest{BucketName: "barry"})
+		assert.NotNil(t, err)
+	})
+}
@@ -766,6 +766,8 @@ type GfSpListObjectsByBucketNameRequest struct {
 	Prefix string `protobuf:"bytes,7,opt,name=prefix,proto3" json:"prefix,omitempty"`
 	// include_removed indicates whether this request can get the removed objects information
 	IncludeRemoved bool `protobuf:"varint,8,opt,name=include_removed,json=includeRemoved,proto3" json:"include_removed,omitempty"`
+	// at_height lists the objects at the block height from the history tables, 0 means the latest block
+	AtHeight int64 `protobuf:"varint,9,opt,name=at_height,json=atHeight,proto3" json:"at_height,omitempty"`
 }
 
 func (m *GfSpListObjectsByBucketNameRequest) Reset()         { *m = GfSpListObjectsByBucketNameRequest{} }
@@ -857,6 +859,13 @@ func (m *GfSpListObjectsByBucketNameRequest) GetIncludeRemoved() bool {
 	return false
 }
 
+func (m *GfSpListObjectsByBucketNameRequest) GetAtHeight() int64 {
+	if m != nil {
+		return m.AtHeight
+	}
+	return 0
+}
+
 // GfSpListObjectsByBucketNameResponse is response type for the GfSpListObjectsByBucketName RPC method.
 type GfSpListObjectsByBucketNameResponse struct {
 	// objects defines the list of object
@@ -990,6 +999,8 @@ type GfSpGetBucketByBucketNameRequest struct {
 	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
 	// include_private indicates whether this request can get the private buckets information
 	IncludePrivate bool `protobuf:"varint,2,opt,name=include_private,json=includePrivate,proto3" json:"include_private,omitempty"`
+	// at_height gets the bucket at the block height from the history tables, 0 means the latest block
+	AtHeight int64 `protobuf:"varint,3,opt,name=at_height,json=atHeight,proto3" json:"at_height,omitempty"`
 }
 
 func (m *GfSpGetBucketByBucketNameRequest) Reset()         { *m = GfSpGetBucketByBucketNameRequest{} }
@@ -1039,6 +1050,13 @@ func (m *GfSpGetBucketByBucketNameRequest) GetIncludePrivate() bool {
 	return false
 }
 
+func (m *GfSpGetBucketByBucketNameRequest) GetAtHeight() int64 {
+	if m != nil {
+		return m.AtHeight
+	}
+	return 0
+}
+
 // GfSpGetBucketByBucketNameResponse is response type for the GfSpGetBucketByBucketName RPC method.
 type GfSpGetBucketByBucketNameResponse struct {
 	// bucket defines the information of a bucket
@@ -1534,6 +1552,8 @@ type GfSpGetObjectMetaRequest struct {
 	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
 	// include_private indicates whether this request can get the private objects information
 	IncludePrivate bool `protobuf:"varint,3,opt,name=include_private,json=includePrivate,proto3" json:"include_private,omitempty"`
+	// at_height gets the object at the block height from the history tables, 0 means the latest block
+	AtHeight int64 `protobuf:"varint,4,opt,name=at_height,json=atHeight,proto3" json:"at_height,omitempty"`
 }
 
 func (m *GfSpGetObjectMetaRequest) Reset()         { *m = GfSpGetObjectMetaRequest{} }
@@ -1590,6 +1610,13 @@ func (m *GfSpGetObjectMetaRequest) GetIncludePrivate() bool {
 	return false
 }
 
+func (m *GfSpGetObjectMetaRequest) GetAtHeight() int64 {
+	if m != nil {
+		return m.AtHeight
+	}
+	return 0
+}
+
 // GfSpGetObjectMetaResponse is response type for the GfSpGetObjectMeta RPC method.
 type GfSpGetObjectMetaResponse struct {
 	// object defines the information of an object
@@ -6435,6 +6462,8 @@ type GfSpGetGroupMembersRequest struct {
 	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	// start_after is where you want to start listing from
 	StartAfter string `protobuf:"bytes,3,opt,name=start_after,json=startAfter,proto3" json:"start_after,omitempty"`
+	// at_height gets the group members at the block height from the history tables, 0 means the latest block
+	AtHeight int64 `protobuf:"varint,4,opt,name=at_height,json=atHeight,proto3" json:"at_height,omitempty"`
 }
 
 func (m *GfSpGetGroupMembersRequest) Reset()         { *m = GfSpGetGroupMembersRequest{} }
@@ -6491,6 +6520,13 @@ func (m *GfSpGetGroupMembersRequest) GetStartAfter() string {
 	return ""
 }
 
+func (m *GfSpGetGroupMembersRequest) GetAtHeight() int64 {
+	if m != nil {
+		return m.AtHeight
+	}
+	return 0
+}
+
 // GfSpGetGroupMembersResponse is response type for the GfSpGetGroupMembers RPC method
 type GfSpGetGroupMembersResponse struct {
 	// group defines the response of group members
@@ -6758,6 +6794,8 @@ type GfSpListObjectPoliciesRequest struct {
 	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	// start_after is where you want to start listing from
 	StartAfter uint64 `protobuf:"varint,5,opt,name=start_after,json=startAfter,proto3" json:"start_after,omitempty"`
+	// at_height lists the policies at the block height from the history tables, 0 means the latest block
+	AtHeight int64 `protobuf:"varint,6,opt,name=at_height,json=atHeight,proto3" json:"at_height,omitempty"`
 }
 
 func (m *GfSpListObjectPoliciesRequest) Reset()         { *m = GfSpListObjectPoliciesRequest{} }
@@ -6828,6 +6866,13 @@ func (m *GfSpListObjectPoliciesRequest) GetStartAfter() uint64 {
 	return 0
 }
 
+func (m *GfSpListObjectPoliciesRequest) GetAtHeight() int64 {
+	if m != nil {
+		return m.AtHeight
+	}
+	return 0
+}
+
 // GfSpListObjectPoliciesResponse is response type for the GfSpListObjectPolicies RPC method
 type GfSpListObjectPoliciesResponse struct {
 	Policies []*Policy `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
@@ -7730,6 +7775,510 @@ func (m *GfSpGetBsDBInfoResponse) GetObjectSealCount() string {
 	return ""
 }
 
+// NotificationRule defines a rule of the bucket notification, the notifications of the events matching the rule are
+// posted to the webhook
+type NotificationRule struct {
+	// rule_id defines the unique identification of the rule in the bucket
+	RuleId string `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	// events defines the event types matching the rule, the types ends with "*" match all the types with the prefix
+	Events []string `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	// prefix defines the prefix of the object names matching the rule
+	Prefix string `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// suffix defines the suffix of the object names matching the rule
+	Suffix string `protobuf:"bytes,4,opt,name=suffix,proto3" json:"suffix,omitempty"`
+	// webhook_url defines the url which the notifications are posted to
+	WebhookUrl string `protobuf:"bytes,5,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	// secret defines the hash of the secret given by the bucket owner, which signs the notifications, it's never
+	// returned by the queries
+	Secret string `protobuf:"bytes,6,opt,name=secret,proto3" json:"secret,omitempty"`
+	// create_time defines the unix time when the rule is put, the events before it don't match the rule
+	CreateTime int64 `protobuf:"varint,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+}
+
+func (m *NotificationRule) Reset()         { *m = NotificationRule{} }
+func (m *NotificationRule) String() string { return proto.CompactTextString(m) }
+func (*NotificationRule) ProtoMessage()    {}
+func (*NotificationRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cdcff708e247f22, []int{129}
+}
+func (m *NotificationRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NotificationRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NotificationRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NotificationRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationRule.Merge(m, src)
+}
+func (m *NotificationRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *NotificationRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotificationRule proto.InternalMessageInfo
+
+func (m *NotificationRule) GetRuleId() string {
+	if m != nil {
+		return m.RuleId
+	}
+	return ""
+}
+
+func (m *NotificationRule) GetEvents() []string {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *NotificationRule) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *NotificationRule) GetSuffix() string {
+	if m != nil {
+		return m.Suffix
+	}
+	return ""
+}
+
+func (m *NotificationRule) GetWebhookUrl() string {
+	if m != nil {
+		return m.WebhookUrl
+	}
+	return ""
+}
+
+func (m *NotificationRule) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+func (m *NotificationRule) GetCreateTime() int64 {
+	if m != nil {
+		return m.CreateTime
+	}
+	return 0
+}
+
+// GfSpPutBucketNotificationRequest is request type for the GfSpPutBucketNotification RPC method
+type GfSpPutBucketNotificationRequest struct {
+	// bucket_name is the name of the bucket
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// bucket_id is the id of the bucket, the rules don't match the events of another bucket with the same name
+	BucketId uint64 `protobuf:"varint,2,opt,name=bucket_id,json=bucketId,proto3" json:"bucket_id,omitempty"`
+	// rules replace all the notification rules of the bucket, the empty rules remove the notification of the bucket
+	Rules []*NotificationRule `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (m *GfSpPutBucketNotificationRequest) Reset()         { *m = GfSpPutBucketNotificationRequest{} }
+func (m *GfSpPutBucketNotificationRequest) String() string { return proto.CompactTextString(m) }
+func (*GfSpPutBucketNotificationRequest) ProtoMessage()    {}
+func (*GfSpPutBucketNotificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cdcff708e247f22, []int{130}
+}
+func (m *GfSpPutBucketNotificationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GfSpPutBucketNotificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GfSpPutBucketNotificationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GfSpPutBucketNotificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GfSpPutBucketNotificationRequest.Merge(m, src)
+}
+func (m *GfSpPutBucketNotificationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GfSpPutBucketNotificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GfSpPutBucketNotificationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GfSpPutBucketNotificationRequest proto.InternalMessageInfo
+
+func (m *GfSpPutBucketNotificationRequest) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
+	}
+	return ""
+}
+
+func (m *GfSpPutBucketNotificationRequest) GetBucketId() uint64 {
+	if m != nil {
+		return m.BucketId
+	}
+	return 0
+}
+
+func (m *GfSpPutBucketNotificationRequest) GetRules() []*NotificationRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+// GfSpPutBucketNotificationResponse is response type for the GfSpPutBucketNotification RPC method
+type GfSpPutBucketNotificationResponse struct {
+}
+
+func (m *GfSpPutBucketNotificationResponse) Reset()         { *m = GfSpPutBucketNotificationResponse{} }
+func (m *GfSpPutBucketNotificationResponse) String() string { return proto.CompactTextString(m) }
+func (*GfSpPutBucketNotificationResponse) ProtoMessage()    {}
+func (*GfSpPutBucketNotificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cdcff708e247f22, []int{131}
+}
+func (m *GfSpPutBucketNotificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GfSpPutBucketNotificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GfSpPutBucketNotificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GfSpPutBucketNotificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GfSpPutBucketNotificationResponse.Merge(m, src)
+}
+func (m *GfSpPutBucketNotificationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GfSpPutBucketNotificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GfSpPutBucketNotificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GfSpPutBucketNotificationResponse proto.InternalMessageInfo
+
+// GfSpGetBucketNotificationRequest is request type for the GfSpGetBucketNotification RPC method
+type GfSpGetBucketNotificationRequest struct {
+	// bucket_name is the name of the bucket
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+}
+
+func (m *GfSpGetBucketNotificationRequest) Reset()         { *m = GfSpGetBucketNotificationRequest{} }
+func (m *GfSpGetBucketNotificationRequest) String() string { return proto.CompactTextString(m) }
+func (*GfSpGetBucketNotificationRequest) ProtoMessage()    {}
+func (*GfSpGetBucketNotificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cdcff708e247f22, []int{132}
+}
+func (m *GfSpGetBucketNotificationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GfSpGetBucketNotificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GfSpGetBucketNotificationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GfSpGetBucketNotificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GfSpGetBucketNotificationRequest.Merge(m, src)
+}
+func (m *GfSpGetBucketNotificationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GfSpGetBucketNotificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GfSpGetBucketNotificationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GfSpGetBucketNotificationRequest proto.InternalMessageInfo
+
+func (m *GfSpGetBucketNotificationRequest) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
+	}
+	return ""
+}
+
+// GfSpGetBucketNotificationResponse is response type for the GfSpGetBucketNotification RPC method
+type GfSpGetBucketNotificationResponse struct {
+	// rules defines the notification rules of the bucket without the secrets
+	Rules []*NotificationRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (m *GfSpGetBucketNotificationResponse) Reset()         { *m = GfSpGetBucketNotificationResponse{} }
+func (m *GfSpGetBucketNotificationResponse) String() string { return proto.CompactTextString(m) }
+func (*GfSpGetBucketNotificationResponse) ProtoMessage()    {}
+func (*GfSpGetBucketNotificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cdcff708e247f22, []int{133}
+}
+func (m *GfSpGetBucketNotificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GfSpGetBucketNotificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GfSpGetBucketNotificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GfSpGetBucketNotificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GfSpGetBucketNotificationResponse.Merge(m, src)
+}
+func (m *GfSpGetBucketNotificationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GfSpGetBucketNotificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GfSpGetBucketNotificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GfSpGetBucketNotificationResponse proto.InternalMessageInfo
+
+func (m *GfSpGetBucketNotificationResponse) GetRules() []*NotificationRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+// GfSpSearchObjectsRequest is request type for the GfSpSearchObjects RPC method
+type GfSpSearchObjectsRequest struct {
+	// bucket_name is the name of the bucket
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// name_pattern is the glob pattern of the object name, "*" matches any characters and "?" matches a single character
+	NamePattern string `protobuf:"bytes,2,opt,name=name_pattern,json=namePattern,proto3" json:"name_pattern,omitempty"`
+	// prefix limits the response to keys that begin with the specified prefix
+	Prefix string `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// min_size limits the response to objects whose payload sizes are not less than min_size
+	MinSize uint64 `protobuf:"varint,4,opt,name=min_size,json=minSize,proto3" json:"min_size,omitempty"`
+	// max_size limits the response to objects whose payload sizes are not greater than max_size, zero means no limit
+	MaxSize uint64 `protobuf:"varint,5,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+	// content_type limits the response to objects with the content type, e.g. "video/mp4" or "video/*"
+	ContentType string `protobuf:"bytes,6,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// created_after limits the response to objects created at or after the unix time
+	CreatedAfter int64 `protobuf:"varint,7,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	// created_before limits the response to objects created before the unix time, zero means no limit
+	CreatedBefore int64 `protobuf:"varint,8,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	// continuation_token indicates that the search is being continued on this bucket with a token
+	ContinuationToken string `protobuf:"bytes,9,opt,name=continuation_token,json=continuationToken,proto3" json:"continuation_token,omitempty"`
+	// max_keys sets the maximum number of keys returned in the response
+	MaxKeys uint64 `protobuf:"varint,10,opt,name=max_keys,json=maxKeys,proto3" json:"max_keys,omitempty"`
+}
+
+func (m *GfSpSearchObjectsRequest) Reset()         { *m = GfSpSearchObjectsRequest{} }
+func (m *GfSpSearchObjectsRequest) String() string { return proto.CompactTextString(m) }
+func (*GfSpSearchObjectsRequest) ProtoMessage()    {}
+func (*GfSpSearchObjectsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cdcff708e247f22, []int{134}
+}
+func (m *GfSpSearchObjectsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GfSpSearchObjectsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GfSpSearchObjectsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GfSpSearchObjectsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GfSpSearchObjectsRequest.Merge(m, src)
+}
+func (m *GfSpSearchObjectsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GfSpSearchObjectsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GfSpSearchObjectsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GfSpSearchObjectsRequest proto.InternalMessageInfo
+
+func (m *GfSpSearchObjectsRequest) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
+	}
+	return ""
+}
+
+func (m *GfSpSearchObjectsRequest) GetNamePattern() string {
+	if m != nil {
+		return m.NamePattern
+	}
+	return ""
+}
+
+func (m *GfSpSearchObjectsRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *GfSpSearchObjectsRequest) GetMinSize() uint64 {
+	if m != nil {
+		return m.MinSize
+	}
+	return 0
+}
+
+func (m *GfSpSearchObjectsRequest) GetMaxSize() uint64 {
+	if m != nil {
+		return m.MaxSize
+	}
+	return 0
+}
+
+func (m *GfSpSearchObjectsRequest) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *GfSpSearchObjectsRequest) GetCreatedAfter() int64 {
+	if m != nil {
+		return m.CreatedAfter
+	}
+	return 0
+}
+
+func (m *GfSpSearchObjectsRequest) GetCreatedBefore() int64 {
+	if m != nil {
+		return m.CreatedBefore
+	}
+	return 0
+}
+
+func (m *GfSpSearchObjectsRequest) GetContinuationToken() string {
+	if m != nil {
+		return m.ContinuationToken
+	}
+	return ""
+}
+
+func (m *GfSpSearchObjectsRequest) GetMaxKeys() uint64 {
+	if m != nil {
+		return m.MaxKeys
+	}
+	return 0
+}
+
+// GfSpSearchObjectsResponse is response type for the GfSpSearchObjects RPC method
+type GfSpSearchObjectsResponse struct {
+	// objects defines the list of object
+	Objects []*Object `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	// key_count is the number of keys returned with this request
+	KeyCount uint64 `protobuf:"varint,2,opt,name=key_count,json=keyCount,proto3" json:"key_count,omitempty"`
+	// max_keys sets the maximum number of keys returned in the response
+	MaxKeys uint64 `protobuf:"varint,3,opt,name=max_keys,json=maxKeys,proto3" json:"max_keys,omitempty"`
+	// is_truncated set to false if all of the results were returned. set to true if more keys are available to return
+	IsTruncated bool `protobuf:"varint,4,opt,name=is_truncated,json=isTruncated,proto3" json:"is_truncated,omitempty"`
+	// next_continuation_token is sent when is_truncated is true, which means there are more objects matching the filters
+	NextContinuationToken string `protobuf:"bytes,5,opt,name=next_continuation_token,json=nextContinuationToken,proto3" json:"next_continuation_token,omitempty"`
+}
+
+func (m *GfSpSearchObjectsResponse) Reset()         { *m = GfSpSearchObjectsResponse{} }
+func (m *GfSpSearchObjectsResponse) String() string { return proto.CompactTextString(m) }
+func (*GfSpSearchObjectsResponse) ProtoMessage()    {}
+func (*GfSpSearchObjectsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cdcff708e247f22, []int{135}
+}
+func (m *GfSpSearchObjectsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GfSpSearchObjectsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GfSpSearchObjectsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GfSpSearchObjectsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GfSpSearchObjectsResponse.Merge(m, src)
+}
+func (m *GfSpSearchObjectsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GfSpSearchObjectsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GfSpSearchObjectsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GfSpSearchObjectsResponse proto.InternalMessageInfo
+
+func (m *GfSpSearchObjectsResponse) GetObjects() []*Object {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+func (m *GfSpSearchObjectsResponse) GetKeyCount() uint64 {
+	if m != nil {
+		return m.KeyCount
+	}
+	return 0
+}
+
+func (m *GfSpSearchObjectsResponse) GetMaxKeys() uint64 {
+	if m != nil {
+		return m.MaxKeys
+	}
+	return 0
+}
+
+func (m *GfSpSearchObjectsResponse) GetIsTruncated() bool {
+	if m != nil {
+		return m.IsTruncated
+	}
+	return false
+}
+
+func (m *GfSpSearchObjectsResponse) GetNextContinuationToken() string {
+	if m != nil {
+		return m.NextContinuationToken
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Bucket)(nil), "modular.metadata.types.Bucket")
 	proto.RegisterType((*Object)(nil), "modular.metadata.types.Object")
@@ -7863,6 +8412,13 @@ func init() {
 	proto.RegisterType((*GfSpGetBucketInfoByBucketNameResponse)(nil), "modular.metadata.types.GfSpGetBucketInfoByBucketNameResponse")
 	proto.RegisterType((*GfSpGetBsDBInfoRequest)(nil), "modular.metadata.types.GfSpGetBsDBInfoRequest")
 	proto.RegisterType((*GfSpGetBsDBInfoResponse)(nil), "modular.metadata.types.GfSpGetBsDBInfoResponse")
+	proto.RegisterType((*NotificationRule)(nil), "modular.metadata.types.NotificationRule")
+	proto.RegisterType((*GfSpPutBucketNotificationRequest)(nil), "modular.metadata.types.GfSpPutBucketNotificationRequest")
+	proto.RegisterType((*GfSpPutBucketNotificationResponse)(nil), "modular.metadata.types.GfSpPutBucketNotificationResponse")
+	proto.RegisterType((*GfSpGetBucketNotificationRequest)(nil), "modular.metadata.types.GfSpGetBucketNotificationRequest")
+	proto.RegisterType((*GfSpGetBucketNotificationResponse)(nil), "modular.metadata.types.GfSpGetBucketNotificationResponse")
+	proto.RegisterType((*GfSpSearchObjectsRequest)(nil), "modular.metadata.types.GfSpSearchObjectsRequest")
+	proto.RegisterType((*GfSpSearchObjectsResponse)(nil), "modular.metadata.types.GfSpSearchObjectsResponse")
 }
 
 func init() {
@@ -7870,393 +8426,410 @@ func init() {
 }
 
 var fileDescriptor_7cdcff708e247f22 = []byte{
-	// 6162 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5b, 0x6c, 0x1c, 0xc9,
-	0x75, 0x28, 0x9b, 0xc3, 0xd7, 0xd4, 0x0c, 0x1f, 0xaa, 0x95, 0xb4, 0xd4, 0x68, 0x45, 0x49, 0xad,
-	0xdd, 0x15, 0x77, 0x25, 0x91, 0x2b, 0x69, 0x57, 0xab, 0xf7, 0x8a, 0x2f, 0x71, 0x89, 0xb5, 0x56,
-	0x72, 0x0f, 0x25, 0xdb, 0xeb, 0xeb, 0xdb, 0xee, 0xe9, 0xae, 0x19, 0x76, 0x38, 0xd3, 0xdd, 0xdb,
-	0xd5, 0x43, 0x71, 0x84, 0x18, 0x09, 0xf2, 0x02, 0x9c, 0x04, 0x79, 0xc7, 0x30, 0x1c, 0xc7, 0x80,
-	0x83, 0x00, 0x01, 0x02, 0x27, 0x08, 0x12, 0x23, 0x3f, 0x89, 0x93, 0x00, 0xf9, 0x31, 0x90, 0x04,
-	0x30, 0xfc, 0x91, 0x04, 0x46, 0x60, 0x18, 0x76, 0x80, 0x20, 0xdf, 0xf9, 0xcd, 0x47, 0xd0, 0x75,
-	0xaa, 0xbb, 0xab, 0x7b, 0xfa, 0x31, 0x1c, 0xd2, 0x41, 0x3e, 0xfc, 0xb3, 0x9a, 0xae, 0x3a, 0xe7,
-	0x54, 0xd5, 0xa9, 0x73, 0x4e, 0x55, 0x9d, 0x07, 0x17, 0xbd, 0xd6, 0xb1, 0x8d, 0x6e, 0x5b, 0x73,
-	0x97, 0x3b, 0xc4, 0xd3, 0x0c, 0xcd, 0xd3, 0x96, 0xbd, 0x9e, 0x43, 0x68, 0xf8, 0xb9, 0xe4, 0xb8,
-	0xb6, 0x67, 0xe3, 0x93, 0x1c, 0x6c, 0x29, 0x6c, 0x67, 0x60, 0xb5, 0xf3, 0x0d, 0x8d, 0x12, 0x8e,
-	0xd2, 0x6a, 0x52, 0x87, 0xb8, 0xae, 0xed, 0xd2, 0x65, 0xf6, 0x0f, 0xa0, 0xd6, 0x16, 0x12, 0x20,
-	0x9e, 0x46, 0x77, 0x97, 0xfd, 0xff, 0xf0, 0xfe, 0x53, 0xba, 0x4d, 0x3b, 0x36, 0x55, 0xd9, 0xd7,
-	0x32, 0x7c, 0xf0, 0xae, 0xe3, 0x2d, 0xbb, 0x65, 0x43, 0xbb, 0xff, 0x8b, 0xb7, 0xbe, 0xde, 0x72,
-	0x09, 0xb1, 0x9a, 0x26, 0x69, 0x1b, 0xcb, 0x8e, 0xd6, 0xeb, 0x10, 0xcb, 0x5b, 0xa6, 0x9e, 0x4b,
-	0xb4, 0x8e, 0xea, 0x12, 0xdd, 0x76, 0x0d, 0x0e, 0x27, 0x8b, 0x70, 0xc4, 0xed, 0x98, 0x94, 0x9a,
-	0xb6, 0xb5, 0xac, 0xdb, 0x9d, 0x8e, 0x6d, 0x71, 0x98, 0xb3, 0x02, 0x8c, 0x4b, 0xa8, 0xdd, 0x75,
-	0x75, 0x3e, 0xd7, 0x60, 0x76, 0x02, 0x00, 0x75, 0x62, 0x5d, 0x22, 0x2e, 0xf5, 0x6c, 0x57, 0x6b,
-	0x91, 0x65, 0xb2, 0x47, 0x2c, 0x2f, 0x00, 0x58, 0x48, 0x01, 0xf8, 0xb8, 0x4b, 0xdc, 0x5e, 0x4e,
-	0xbf, 0x38, 0xc0, 0xab, 0x42, 0xff, 0x9e, 0xe9, 0x7a, 0x5d, 0xad, 0xdd, 0x72, 0xed, 0xae, 0x13,
-	0x1f, 0xe5, 0x42, 0x16, 0x94, 0x48, 0xea, 0x65, 0x9f, 0x7e, 0xb0, 0x0b, 0xec, 0x37, 0x74, 0xc8,
-	0x5f, 0x2e, 0xa1, 0x89, 0xd5, 0xae, 0xbe, 0x4b, 0x3c, 0xfc, 0x1e, 0xaa, 0x34, 0xd8, 0x2f, 0xd5,
-	0xb4, 0x9a, 0xf6, 0xbc, 0x74, 0x4e, 0x5a, 0xac, 0x5c, 0x5b, 0x58, 0x8a, 0xc8, 0x2f, 0xf1, 0x49,
-	0x2e, 0x01, 0xc2, 0x96, 0xd5, 0xb4, 0x15, 0xd4, 0x08, 0x7f, 0xe3, 0x79, 0x34, 0xe9, 0x92, 0x8e,
-	0xbd, 0x47, 0x8c, 0xf9, 0xd1, 0x73, 0xd2, 0xe2, 0x94, 0x12, 0x7c, 0xe2, 0xd3, 0xa8, 0x6c, 0x90,
-	0x36, 0xf1, 0x88, 0xaa, 0x79, 0xf3, 0xa5, 0x73, 0xd2, 0x62, 0x49, 0x99, 0x82, 0x86, 0x15, 0x0f,
-	0x5f, 0x40, 0xd3, 0xbc, 0xd3, 0x25, 0x1a, 0xb5, 0xad, 0xf9, 0xb1, 0x73, 0xd2, 0x62, 0x59, 0xa9,
-	0x42, 0xa3, 0xc2, 0xda, 0x70, 0x0d, 0x4d, 0xd9, 0x0e, 0x71, 0x35, 0xcf, 0x76, 0xe7, 0xc7, 0x59,
-	0x7f, 0xf8, 0x8d, 0x5f, 0x45, 0x33, 0xba, 0x4b, 0x34, 0x8f, 0xa8, 0xde, 0xbe, 0xba, 0xa3, 0xd1,
-	0x9d, 0xf9, 0x09, 0xa0, 0x00, 0xad, 0xdb, 0xfb, 0xef, 0x6b, 0x74, 0xc7, 0x87, 0xea, 0x3a, 0x86,
-	0x08, 0x35, 0x09, 0x50, 0xd0, 0xca, 0xa1, 0x4e, 0xa3, 0x32, 0x87, 0xd2, 0xbc, 0xf9, 0x29, 0x98,
-	0x29, 0x34, 0xac, 0x78, 0xf8, 0x2c, 0xaa, 0x04, 0x24, 0xcc, 0x0e, 0x99, 0x2f, 0xb3, 0x6e, 0xc4,
-	0xf1, 0xcd, 0x0e, 0xc1, 0xe7, 0x51, 0x95, 0xf3, 0x48, 0xa5, 0xe6, 0x0b, 0x32, 0x8f, 0xd8, 0x08,
-	0x15, 0xde, 0x56, 0x37, 0x5f, 0x10, 0xbc, 0x88, 0xe6, 0xec, 0x66, 0x53, 0xd5, 0x77, 0x34, 0xd3,
-	0x52, 0xa9, 0xa7, 0x79, 0x5d, 0x3a, 0x5f, 0x39, 0x27, 0x2d, 0x8e, 0x2b, 0x33, 0x76, 0xb3, 0xb9,
-	0xe6, 0x37, 0xd7, 0x59, 0xab, 0xfc, 0x5f, 0xa3, 0x68, 0xe2, 0x71, 0xe3, 0xa7, 0x88, 0xce, 0xb6,
-	0xc6, 0x66, 0xbf, 0x0a, 0xb7, 0x06, 0x10, 0x60, 0x6b, 0xec, 0xf0, 0x37, 0x7e, 0x0d, 0xcd, 0xb4,
-	0x6d, 0x7d, 0x97, 0x18, 0x6a, 0x43, 0x6b, 0x6b, 0x96, 0x4e, 0xd8, 0x0e, 0x95, 0x95, 0x69, 0x68,
-	0x5d, 0x85, 0x46, 0x71, 0x07, 0x4b, 0x7d, 0x3b, 0x18, 0xf1, 0x65, 0x2c, 0xc1, 0x97, 0xd8, 0xf6,
-	0x8e, 0x17, 0x6d, 0xef, 0x44, 0xc1, 0xf6, 0x4e, 0x16, 0x6e, 0xef, 0xd4, 0x40, 0xdb, 0x5b, 0x4e,
-	0xd9, 0xde, 0x73, 0xa8, 0x4a, 0x89, 0xd6, 0x0e, 0x61, 0x60, 0x83, 0x90, 0xdf, 0x06, 0x10, 0xf2,
-	0xdf, 0x48, 0x68, 0x1a, 0x98, 0xb8, 0x4e, 0x3c, 0xcd, 0x6c, 0x53, 0x7c, 0x03, 0x4d, 0x00, 0x27,
-	0x43, 0xbe, 0xa7, 0x1b, 0x43, 0xce, 0x7b, 0x85, 0x43, 0xfb, 0x78, 0xa0, 0x1c, 0x8c, 0xd7, 0x39,
-	0x78, 0xa0, 0x4e, 0x0a, 0x87, 0xc6, 0xf7, 0x50, 0xa9, 0xb5, 0xd7, 0x62, 0x1b, 0x50, 0xb9, 0x76,
-	0x49, 0xdc, 0x64, 0x51, 0xbd, 0x97, 0x36, 0xdb, 0x76, 0x43, 0x6b, 0x3f, 0x83, 0xa6, 0x4d, 0xbf,
-	0x49, 0xf1, 0xf1, 0xe4, 0x7f, 0x2f, 0xa1, 0xe9, 0x67, 0x9b, 0x0f, 0xfd, 0x6d, 0xff, 0x89, 0x62,
-	0x1f, 0x95, 0x62, 0xaf, 0xa1, 0xd2, 0x5e, 0xab, 0xc9, 0xc4, 0xa5, 0x72, 0xed, 0xea, 0x01, 0xf6,
-	0xe4, 0xa1, 0xd6, 0x31, 0xdb, 0x3d, 0xc5, 0xc7, 0xee, 0xb3, 0x0e, 0x95, 0xc1, 0xac, 0x43, 0x35,
-	0xd5, 0x3a, 0x7c, 0x4d, 0x42, 0x33, 0x4f, 0xe0, 0xf4, 0x5b, 0xd1, 0x75, 0xbb, 0x6b, 0x79, 0xfe,
-	0x36, 0x69, 0x86, 0xe1, 0x12, 0x4a, 0xd9, 0x1e, 0x97, 0x95, 0xe0, 0x13, 0x1f, 0x47, 0xe3, 0xf6,
-	0x73, 0x8b, 0xb8, 0x5c, 0xeb, 0xe1, 0x03, 0x2f, 0x20, 0xe4, 0x92, 0x66, 0xd7, 0x32, 0xb4, 0x46,
-	0x9b, 0x70, 0x85, 0x17, 0x5a, 0xf2, 0x75, 0x3e, 0xc1, 0xb2, 0xf1, 0x24, 0xcb, 0xe4, 0x6f, 0x48,
-	0x08, 0xc7, 0x27, 0xf8, 0x88, 0x78, 0x1a, 0x7e, 0x8c, 0x66, 0xf9, 0xa1, 0xad, 0x6a, 0xd0, 0xcc,
-	0x05, 0xf2, 0xf5, 0x2c, 0xf5, 0x88, 0x13, 0x51, 0x66, 0x9c, 0xf8, 0xaa, 0x37, 0xd0, 0x74, 0xec,
-	0xf4, 0xe7, 0xda, 0x76, 0x4e, 0xdc, 0x24, 0x8e, 0xb2, 0x54, 0x67, 0x80, 0x0a, 0x83, 0x53, 0xaa,
-	0x54, 0xf8, 0x92, 0x75, 0x74, 0x6a, 0xb3, 0x59, 0x77, 0x36, 0x89, 0xf7, 0x94, 0x12, 0x17, 0x14,
-	0x81, 0x2a, 0xe4, 0xe3, 0x2e, 0xa1, 0x1e, 0x3e, 0x83, 0x10, 0x9f, 0xac, 0x6a, 0x1a, 0x9c, 0xb9,
-	0x65, 0xde, 0xb2, 0x65, 0xe0, 0x8b, 0x68, 0xd6, 0xb4, 0xf4, 0x76, 0xd7, 0xf0, 0x25, 0x5d, 0xd4,
-	0x93, 0x19, 0xde, 0xac, 0x40, 0xab, 0xfc, 0x39, 0x54, 0x4b, 0x1b, 0x84, 0x3a, 0xb6, 0x45, 0x09,
-	0x7e, 0x0f, 0x4d, 0x82, 0xd2, 0xf9, 0xfb, 0x57, 0x5a, 0xac, 0x5c, 0x7b, 0x2d, 0x8b, 0x25, 0x31,
-	0xfd, 0x56, 0x02, 0x2c, 0xf9, 0x4f, 0x47, 0x91, 0xec, 0xd3, 0xff, 0x84, 0x49, 0x3d, 0x30, 0x46,
-	0x74, 0xb5, 0x07, 0x40, 0x1f, 0x6a, 0x1d, 0x12, 0xac, 0xe6, 0x6c, 0x68, 0x0f, 0x2c, 0xad, 0x43,
-	0xf8, 0x72, 0xb8, 0xbe, 0xfb, 0x70, 0x89, 0xe5, 0x8e, 0x26, 0x97, 0x7b, 0x0a, 0x4d, 0x75, 0xb4,
-	0x7d, 0x75, 0x97, 0xf4, 0x28, 0x93, 0x9a, 0x31, 0x65, 0xb2, 0xa3, 0xed, 0x7f, 0x40, 0x7a, 0xd4,
-	0x27, 0x4d, 0x3d, 0xcd, 0xf5, 0x54, 0xad, 0xe9, 0x11, 0x97, 0x2b, 0x3c, 0x62, 0x4d, 0x2b, 0x7e,
-	0x0b, 0xbe, 0x82, 0xb0, 0x6e, 0x5b, 0x9e, 0x69, 0x75, 0x35, 0xcf, 0xb4, 0x2d, 0xd5, 0xb3, 0x77,
-	0x89, 0xc5, 0x15, 0xff, 0x98, 0xd8, 0xb3, 0xed, 0x77, 0xe0, 0x57, 0x98, 0x7d, 0x31, 0x3b, 0xa6,
-	0x4f, 0x0d, 0x94, 0x3f, 0x6a, 0xc0, 0x27, 0xd1, 0x84, 0xe3, 0x92, 0xa6, 0xb9, 0xcf, 0x35, 0x9e,
-	0x7f, 0xa5, 0xed, 0xc7, 0x54, 0xea, 0x7e, 0xfc, 0x4e, 0x09, 0x5d, 0xc8, 0x65, 0x18, 0xdf, 0x99,
-	0x9b, 0x68, 0x12, 0x8c, 0x7a, 0xb0, 0x33, 0x45, 0x67, 0x40, 0x00, 0xee, 0xeb, 0xd0, 0x2e, 0xe9,
-	0xa9, 0x20, 0xe8, 0xa3, 0x8c, 0x59, 0x53, 0xbb, 0xa4, 0xb7, 0xc6, 0x44, 0x37, 0x87, 0x91, 0xe7,
-	0x51, 0xd5, 0xa4, 0xaa, 0xe7, 0x76, 0x2d, 0x5d, 0xf3, 0x88, 0xc1, 0x38, 0x39, 0xa5, 0x54, 0x4c,
-	0xba, 0x1d, 0x34, 0xe1, 0x1b, 0xe8, 0x65, 0x8b, 0xec, 0x7b, 0x6a, 0x26, 0x3f, 0x4f, 0xf8, 0xdd,
-	0x6b, 0x7d, 0x3c, 0xc5, 0x68, 0x8c, 0xed, 0x3b, 0xb0, 0x93, 0xfd, 0xce, 0xe4, 0x64, 0x8c, 0xff,
-	0x53, 0x49, 0xfe, 0x5f, 0x44, 0xb3, 0x70, 0x9b, 0x56, 0x01, 0x9c, 0xd0, 0xf9, 0xf2, 0xb9, 0xd2,
-	0x62, 0x59, 0x99, 0x81, 0xe6, 0x27, 0xbc, 0x35, 0x63, 0xd7, 0x51, 0xc6, 0xae, 0xcb, 0x6d, 0x74,
-	0x8e, 0xab, 0x09, 0xec, 0xc5, 0x50, 0x42, 0x2c, 0x08, 0x81, 0xe3, 0x9a, 0x7b, 0x9a, 0x47, 0x12,
-	0x4a, 0xf9, 0x04, 0x5a, 0xe5, 0xcf, 0xa2, 0xf3, 0x39, 0xa3, 0x71, 0x09, 0x88, 0x0e, 0x73, 0xe9,
-	0x20, 0x87, 0xb9, 0xdc, 0x44, 0x0b, 0xa9, 0xc4, 0xb7, 0xd6, 0x83, 0x85, 0x9c, 0x46, 0xe5, 0xe0,
-	0x74, 0x06, 0xd3, 0x52, 0x52, 0xa6, 0xf8, 0xd9, 0x6b, 0x0c, 0xbe, 0x88, 0xcf, 0xa0, 0xb3, 0x99,
-	0xe3, 0x1c, 0x72, 0x09, 0x7f, 0x2e, 0xa1, 0xe5, 0x40, 0x49, 0xd6, 0xd9, 0xd1, 0x6d, 0x44, 0xba,
-	0xe2, 0xdf, 0x1f, 0x3f, 0xec, 0x76, 0x1a, 0xc4, 0x55, 0x34, 0xab, 0x15, 0xee, 0xce, 0x65, 0x84,
-	0xc1, 0x0e, 0x34, 0x7c, 0x00, 0xd5, 0x62, 0x10, 0x6c, 0xdc, 0x31, 0x65, 0x8e, 0xf5, 0x08, 0x98,
-	0xfe, 0xa9, 0x47, 0x2c, 0x23, 0x0e, 0x0b, 0xba, 0x32, 0x43, 0x2c, 0x43, 0x84, 0x4c, 0xe1, 0x47,
-	0x29, 0x95, 0x1f, 0x5f, 0x92, 0xd0, 0x5b, 0x83, 0x4f, 0xfa, 0xd0, 0x6a, 0x3e, 0xf0, 0x0a, 0xe4,
-	0x9d, 0x50, 0x20, 0x84, 0x23, 0x80, 0x99, 0x83, 0xa3, 0x3e, 0x6c, 0xde, 0x0d, 0x45, 0xa2, 0x7f,
-	0x24, 0xbe, 0xe0, 0xe3, 0x68, 0x3c, 0x3a, 0x82, 0x4b, 0x0a, 0x7c, 0xc8, 0x2f, 0x40, 0x21, 0x7c,
-	0xd6, 0x6d, 0xec, 0x3b, 0xa6, 0x4b, 0x0c, 0x8e, 0xbc, 0xda, 0xab, 0x3b, 0x82, 0xd8, 0xf2, 0xbb,
-	0x99, 0x16, 0xa0, 0x4f, 0x41, 0xc3, 0x8a, 0x87, 0x65, 0x34, 0xed, 0xb8, 0x66, 0x47, 0x73, 0x7b,
-	0x2a, 0x75, 0x82, 0x33, 0x64, 0x5a, 0xa9, 0xf0, 0xc6, 0xba, 0xb3, 0x65, 0xf8, 0x63, 0x33, 0x3b,
-	0xc2, 0xaf, 0x8d, 0xf0, 0x21, 0xff, 0xff, 0xe8, 0x04, 0x4b, 0x1b, 0x3b, 0xda, 0xa8, 0xf8, 0x49,
-	0x59, 0x24, 0xcb, 0xe1, 0x11, 0xf9, 0x8b, 0x12, 0x9a, 0xe7, 0x5c, 0x81, 0x3d, 0xf4, 0x2f, 0x25,
-	0x82, 0x4d, 0xe1, 0xcf, 0x2c, 0xd1, 0xa6, 0x40, 0x13, 0xb3, 0x29, 0x09, 0xa3, 0x33, 0x3a, 0x88,
-	0xd1, 0x49, 0x97, 0xcf, 0x7a, 0x78, 0xdd, 0x10, 0xa7, 0x11, 0x69, 0xea, 0x30, 0x2f, 0x0e, 0xb9,
-	0x13, 0x5a, 0x32, 0x7e, 0x67, 0xfa, 0xf1, 0x1a, 0xce, 0x5d, 0xd8, 0xab, 0xac, 0xe1, 0xf8, 0x62,
-	0xfa, 0xee, 0x67, 0xd2, 0x50, 0xf7, 0xb3, 0x56, 0x28, 0xcd, 0x89, 0xc1, 0x8e, 0xda, 0x92, 0x9a,
-	0xe1, 0xe1, 0x93, 0x32, 0xd0, 0xd1, 0xae, 0xc9, 0x08, 0x65, 0x11, 0x46, 0x48, 0xc8, 0xe2, 0x11,
-	0x6d, 0xd3, 0xd7, 0xa5, 0x50, 0xd6, 0xc4, 0x61, 0xf8, 0x52, 0xee, 0x25, 0x4e, 0x85, 0x01, 0xef,
-	0x9c, 0xc1, 0x63, 0xf5, 0x88, 0x6e, 0xdf, 0xd7, 0xd1, 0x2b, 0x7c, 0x8a, 0x1b, 0x96, 0xe1, 0xd8,
-	0xa6, 0xcf, 0xf5, 0xba, 0x13, 0x6d, 0xed, 0x4b, 0x68, 0x1c, 0x0c, 0x89, 0xc4, 0x0c, 0xc9, 0x18,
-	0x75, 0xb6, 0x0c, 0xf9, 0x0e, 0x3a, 0x93, 0x81, 0xc4, 0xd7, 0x56, 0x43, 0x53, 0x84, 0xf7, 0x70,
-	0x06, 0x86, 0xdf, 0xf2, 0xcf, 0x84, 0xc8, 0x7c, 0x45, 0x44, 0x33, 0x3e, 0xd9, 0xb5, 0xa3, 0x0d,
-	0x38, 0xf4, 0xab, 0xf9, 0x0c, 0x42, 0x3d, 0xa2, 0xb9, 0x6a, 0xc7, 0xb6, 0xbc, 0x9d, 0xe0, 0x16,
-	0xed, 0xb7, 0x3c, 0xf2, 0x1b, 0xe4, 0xff, 0x28, 0x25, 0xae, 0x06, 0xc2, 0x0c, 0xf8, 0xfc, 0xaf,
-	0xa1, 0x12, 0x71, 0xdd, 0x50, 0xb8, 0x1a, 0x1a, 0x25, 0x7c, 0x33, 0x22, 0x5f, 0xeb, 0x92, 0x4f,
-	0x63, 0xc3, 0xff, 0xa9, 0xf8, 0xc0, 0xfe, 0xc9, 0xab, 0xef, 0x68, 0x6e, 0x8b, 0x18, 0xea, 0xc7,
-	0x3e, 0x31, 0x78, 0x6a, 0xc2, 0x59, 0x34, 0xc7, 0x7b, 0xd8, 0x28, 0xec, 0xbd, 0x79, 0x09, 0x61,
-	0xea, 0xa8, 0x4d, 0x97, 0x10, 0x11, 0x1a, 0xee, 0xa2, 0xb3, 0xd4, 0x79, 0xe8, 0x12, 0x12, 0x01,
-	0x5f, 0x40, 0xd3, 0xba, 0x6d, 0xd1, 0x6e, 0x87, 0x18, 0x00, 0x37, 0xc6, 0xe0, 0xaa, 0x41, 0x23,
-	0x03, 0x7a, 0x07, 0xbd, 0x2c, 0x90, 0xe3, 0x5d, 0x00, 0x3e, 0xce, 0xc0, 0x8f, 0x37, 0x03, 0xa2,
-	0x6b, 0xd0, 0xc9, 0xd0, 0x6e, 0xa3, 0x1a, 0x75, 0x80, 0x55, 0xed, 0x5e, 0xdf, 0x84, 0x26, 0x18,
-	0xe6, 0x49, 0xea, 0x3c, 0x02, 0x80, 0xf8, 0xbc, 0xd6, 0xd1, 0xd9, 0x14, 0xc4, 0xd8, 0xd0, 0x93,
-	0x8c, 0xc0, 0xe9, 0x4e, 0x02, 0x5d, 0x9c, 0xc1, 0x65, 0x84, 0x3d, 0xdb, 0xd3, 0xda, 0x71, 0xc4,
-	0x29, 0x60, 0x1c, 0xeb, 0x11, 0xa1, 0xdf, 0x44, 0xc7, 0x5c, 0xd2, 0xf1, 0x5f, 0xe9, 0xc2, 0x34,
-	0xcb, 0xc0, 0x37, 0xe8, 0x08, 0xe7, 0x27, 0xaf, 0x85, 0x76, 0x32, 0xb1, 0xd1, 0xc9, 0x63, 0x5f,
-	0x10, 0x17, 0x29, 0x29, 0x2e, 0x36, 0xbc, 0x54, 0x32, 0x89, 0x1c, 0x42, 0x64, 0xc2, 0x5b, 0xc0,
-	0xa8, 0x78, 0x0b, 0xe8, 0x80, 0x78, 0xfa, 0x27, 0x71, 0x86, 0x86, 0xe4, 0xcf, 0xd8, 0x7f, 0x53,
-	0xd8, 0xcd, 0x26, 0xe5, 0xfe, 0xaf, 0x69, 0x85, 0x7f, 0xc5, 0x0f, 0xfe, 0xe9, 0xe0, 0xe0, 0xff,
-	0xb5, 0x12, 0x3a, 0xd1, 0x37, 0x8e, 0x6f, 0x1b, 0x8a, 0x2d, 0x61, 0xcc, 0xee, 0xf3, 0x37, 0x56,
-	0x68, 0xf7, 0x7f, 0x22, 0xe1, 0xc3, 0x49, 0xb8, 0xfc, 0x55, 0x09, 0x4e, 0xdc, 0x54, 0x01, 0x38,
-	0x84, 0xb4, 0x6d, 0xa0, 0x09, 0x97, 0xd0, 0x6e, 0xdb, 0x17, 0x0b, 0xff, 0xea, 0x76, 0xa5, 0xe0,
-	0xea, 0x16, 0x97, 0x06, 0x85, 0x23, 0xcb, 0xab, 0xa1, 0x3e, 0x7c, 0x42, 0xf3, 0x48, 0xa6, 0x8c,
-	0xf6, 0xdd, 0x09, 0x04, 0xd9, 0x90, 0xbf, 0x22, 0xa1, 0x57, 0xf3, 0x89, 0x1c, 0x62, 0x9d, 0xf7,
-	0xd1, 0x38, 0xdb, 0x26, 0x7e, 0x22, 0x2e, 0x26, 0xb1, 0x58, 0x08, 0xcc, 0xc7, 0x81, 0x41, 0xd9,
-	0x80, 0xec, 0x0c, 0x01, 0x34, 0xf9, 0x07, 0xa9, 0xfc, 0xe7, 0x5c, 0x38, 0xaa, 0x33, 0x2a, 0x7c,
-	0xa7, 0x79, 0x66, 0x87, 0x50, 0x4f, 0xeb, 0x38, 0x6a, 0x97, 0x72, 0x3b, 0x00, 0xef, 0xb4, 0xed,
-	0xa0, 0xe3, 0x69, 0xf8, 0xca, 0x89, 0xc1, 0xc2, 0xed, 0xdd, 0x7f, 0xe5, 0x88, 0x90, 0xaf, 0xa2,
-	0x99, 0x8e, 0xb6, 0xcf, 0xef, 0x04, 0xfe, 0x83, 0x88, 0xfb, 0x0f, 0xab, 0x1d, 0x6d, 0x1f, 0x96,
-	0xf0, 0x61, 0xb7, 0x23, 0xff, 0x85, 0x84, 0x50, 0xb4, 0xa8, 0xe2, 0xeb, 0xf7, 0x69, 0x54, 0x0e,
-	0xc2, 0x20, 0xa1, 0xa2, 0xf3, 0x20, 0x07, 0xbb, 0xe0, 0x05, 0xcf, 0xa6, 0xc0, 0x0b, 0x5a, 0x62,
-	0x14, 0x66, 0x78, 0xf3, 0x0a, 0x77, 0x86, 0x9e, 0x47, 0xd5, 0xd8, 0x0a, 0x60, 0x66, 0x15, 0x4f,
-	0x98, 0xfe, 0x69, 0x54, 0x76, 0x89, 0x66, 0x88, 0x4a, 0x3d, 0xe5, 0x37, 0x30, 0xc5, 0xf8, 0x9e,
-	0x04, 0x37, 0xc4, 0xf4, 0x8d, 0x39, 0x84, 0xc4, 0x7c, 0x80, 0xaa, 0x6c, 0x54, 0xe0, 0x1a, 0xe5,
-	0xfa, 0x21, 0x67, 0xe9, 0x47, 0x34, 0xea, 0xea, 0xd8, 0xb7, 0xbf, 0x7f, 0x56, 0x52, 0x2a, 0x6e,
-	0xd8, 0x42, 0x7d, 0x2b, 0xc5, 0xbc, 0x43, 0x29, 0xdb, 0x0b, 0x5b, 0x76, 0xdc, 0xef, 0xae, 0x27,
-	0xb6, 0x58, 0xbe, 0x07, 0x56, 0xff, 0x93, 0x5d, 0xe2, 0xf6, 0x9e, 0x3a, 0x6d, 0x5b, 0x33, 0x9e,
-	0xb8, 0x76, 0xcb, 0xe7, 0x9b, 0xa0, 0x51, 0xd1, 0x26, 0x48, 0xf1, 0x4d, 0x90, 0xbf, 0xc1, 0x85,
-	0x36, 0x15, 0xff, 0x50, 0xb7, 0x9a, 0x71, 0xea, 0x05, 0x57, 0xdc, 0x99, 0x6b, 0x27, 0x97, 0x20,
-	0x86, 0x09, 0x68, 0xdb, 0x1a, 0xdd, 0xad, 0xfb, 0xbd, 0x0a, 0x00, 0xf9, 0xa2, 0x40, 0x5c, 0x57,
-	0x35, 0x08, 0xd5, 0x5d, 0xd3, 0xf1, 0x4c, 0xdb, 0x0a, 0x44, 0x81, 0xb8, 0xee, 0x7a, 0xd4, 0x2a,
-	0x6f, 0xa0, 0xd7, 0xc3, 0xd9, 0x2a, 0x84, 0x76, 0x3b, 0x5a, 0xa3, 0x4d, 0x60, 0xda, 0x75, 0xd2,
-	0xf2, 0x2f, 0xad, 0x03, 0xad, 0xfa, 0xe7, 0x24, 0x74, 0xb1, 0x90, 0xce, 0x21, 0x56, 0x7f, 0x01,
-	0x4d, 0x53, 0x20, 0x23, 0x38, 0x12, 0xa7, 0x95, 0x2a, 0x6f, 0x64, 0x37, 0x00, 0xf9, 0x2b, 0xa3,
-	0x68, 0x9c, 0x85, 0x1c, 0xf0, 0x75, 0x34, 0xce, 0xc2, 0x11, 0x7c, 0x90, 0x33, 0x69, 0xf6, 0x80,
-	0x41, 0x82, 0xb9, 0x61, 0xb0, 0xb1, 0x38, 0xcc, 0x68, 0x22, 0x0e, 0x13, 0x7b, 0xeb, 0x97, 0x12,
-	0x6f, 0xfd, 0xb3, 0xa8, 0x12, 0x04, 0x69, 0xcc, 0x0e, 0xe1, 0xda, 0x84, 0x78, 0x84, 0xc6, 0xec,
-	0x24, 0xc2, 0x08, 0xe3, 0xf9, 0x61, 0x84, 0x89, 0xbe, 0xc8, 0xcb, 0x9b, 0xe8, 0x18, 0xf8, 0x53,
-	0x54, 0xbb, 0xa9, 0x76, 0x88, 0xff, 0x8b, 0xb2, 0xc3, 0xae, 0xa4, 0xcc, 0x42, 0xc7, 0xe3, 0xe6,
-	0x23, 0x68, 0x16, 0xe3, 0x54, 0x53, 0xb1, 0x38, 0x95, 0xfc, 0xd7, 0xa3, 0xa8, 0xc2, 0x96, 0x0c,
-	0xa0, 0xc3, 0xb1, 0xa8, 0xc0, 0x2d, 0x2e, 0x72, 0xb0, 0x94, 0xc7, 0xc1, 0xb1, 0x7c, 0x0e, 0x8e,
-	0xe7, 0x73, 0x70, 0x22, 0x9f, 0x83, 0x93, 0x7d, 0x1c, 0xcc, 0xe4, 0x0a, 0xd3, 0x93, 0x7d, 0xc7,
-	0x74, 0xb9, 0x53, 0x36, 0x0a, 0x7d, 0xcd, 0x44, 0xcd, 0x2c, 0x96, 0xf3, 0x2d, 0x09, 0xbd, 0xcc,
-	0x0f, 0x4a, 0xc6, 0x15, 0xdf, 0xf4, 0x05, 0x9a, 0x11, 0xb8, 0x93, 0xa5, 0x54, 0x77, 0xf2, 0x68,
-	0xcc, 0x9d, 0x7c, 0x16, 0x55, 0x20, 0xc7, 0x42, 0xf5, 0x45, 0x9e, 0x73, 0x09, 0x41, 0xd3, 0x76,
-	0xcf, 0x21, 0xd1, 0xdd, 0x70, 0x4c, 0x70, 0x0a, 0x09, 0x37, 0x49, 0xe0, 0x4d, 0x70, 0x93, 0x4c,
-	0x71, 0x85, 0x4d, 0xa4, 0xba, 0xc2, 0x5a, 0xe1, 0x43, 0x5b, 0x98, 0x3e, 0x57, 0xc8, 0x77, 0xd0,
-	0x04, 0xdb, 0xde, 0xc0, 0x95, 0x74, 0x26, 0xcb, 0xde, 0x42, 0x8c, 0x95, 0x03, 0x67, 0x5c, 0x9a,
-	0x09, 0x3a, 0x1d, 0x3f, 0x1a, 0xe8, 0x6a, 0x6f, 0x6b, 0x5d, 0x8c, 0x23, 0x85, 0xb7, 0x11, 0x18,
-	0x6f, 0x4c, 0x29, 0x07, 0xd7, 0x11, 0x3a, 0xb8, 0x6b, 0xef, 0x5f, 0x24, 0x78, 0x2f, 0xf7, 0x8f,
-	0xc3, 0x17, 0xf5, 0xd9, 0xa4, 0x83, 0x6c, 0x25, 0x73, 0x55, 0x39, 0x64, 0xf8, 0xad, 0x81, 0x6e,
-	0x58, 0x9e, 0xdb, 0x0b, 0x7d, 0x68, 0xb5, 0x8f, 0x50, 0x55, 0xec, 0xc0, 0x73, 0xa8, 0xb4, 0x4b,
-	0x7a, 0xdc, 0x2a, 0xfa, 0x3f, 0xf1, 0xdb, 0x68, 0x7c, 0x4f, 0x6b, 0x77, 0xc9, 0x80, 0x91, 0x6f,
-	0x00, 0xbe, 0x3d, 0x7a, 0x53, 0x12, 0x19, 0x18, 0xfa, 0x6b, 0xe3, 0x0c, 0x0c, 0xcd, 0x70, 0xc8,
-	0xc0, 0xc0, 0x0e, 0x0f, 0xc9, 0xc0, 0xf8, 0x38, 0x11, 0x03, 0xe3, 0xae, 0xe0, 0x42, 0x06, 0xa6,
-	0x91, 0xe1, 0x0e, 0xba, 0x80, 0x81, 0x9c, 0xa2, 0xcf, 0x40, 0xb1, 0xe3, 0x10, 0x0c, 0xe4, 0x0e,
-	0x40, 0x81, 0x81, 0xbf, 0x30, 0x0a, 0x27, 0xf0, 0x33, 0xe2, 0x9a, 0xcd, 0xde, 0x93, 0x30, 0xef,
-	0xc9, 0x9f, 0x57, 0xc0, 0xc5, 0xb7, 0x05, 0x4b, 0xc5, 0xd4, 0x76, 0x75, 0xfe, 0xbb, 0xdf, 0xbc,
-	0x72, 0x9c, 0xe7, 0x5e, 0xf1, 0x7b, 0x52, 0xdd, 0x73, 0x4d, 0xab, 0x25, 0xd8, 0xb0, 0x87, 0x68,
-	0x3a, 0x48, 0x91, 0x02, 0xf5, 0x85, 0xb3, 0xf8, 0xbc, 0x68, 0x3b, 0x03, 0x80, 0x25, 0x85, 0x44,
-	0x5a, 0xad, 0x54, 0x5d, 0xe1, 0xcb, 0x37, 0x02, 0x21, 0x1d, 0xd3, 0xe0, 0x4f, 0x31, 0x14, 0x34,
-	0x6d, 0x19, 0x78, 0x15, 0x55, 0x34, 0x1d, 0x4c, 0x92, 0x3f, 0xcc, 0x58, 0xff, 0x30, 0x51, 0x3a,
-	0xd7, 0xd2, 0x0a, 0x83, 0x64, 0xc3, 0x20, 0x2d, 0xfc, 0x2d, 0x7f, 0x06, 0xee, 0x68, 0xe9, 0x5c,
-	0x88, 0x34, 0x9f, 0x34, 0x9b, 0x81, 0x9b, 0x75, 0x26, 0x7e, 0x0a, 0x08, 0x43, 0x6c, 0x30, 0x20,
-	0x85, 0x03, 0xcb, 0x0f, 0xd0, 0x1b, 0xc1, 0x9e, 0xf7, 0x45, 0xfa, 0x4d, 0x42, 0x07, 0x70, 0x5c,
-	0xfd, 0x81, 0x84, 0xde, 0x1c, 0x84, 0x04, 0x9f, 0xa7, 0x87, 0xce, 0xb4, 0x58, 0x62, 0x81, 0xca,
-	0x93, 0x0d, 0x54, 0x66, 0x83, 0xd4, 0x26, 0x07, 0xe7, 0x12, 0x3a, 0x44, 0x5a, 0x42, 0xad, 0x95,
-	0xde, 0x63, 0x12, 0x2a, 0x3f, 0x80, 0x3b, 0x8d, 0x6f, 0x33, 0xfb, 0x80, 0x56, 0x7b, 0x9b, 0x7b,
-	0xad, 0x68, 0x91, 0x27, 0xd0, 0x44, 0x6b, 0xaf, 0x15, 0xad, 0x72, 0xbc, 0xb5, 0xd7, 0xda, 0x32,
-	0xe4, 0x2f, 0x4a, 0x68, 0xb1, 0x98, 0x04, 0x5f, 0xe4, 0xe7, 0xd0, 0xf1, 0xb4, 0x45, 0xf2, 0x03,
-	0xfa, 0x40, 0x69, 0x30, 0xb8, 0x7f, 0x55, 0xf2, 0xad, 0xd0, 0xab, 0x9b, 0xc2, 0x86, 0x68, 0x19,
-	0x7b, 0xad, 0xa6, 0xb0, 0x8c, 0xbd, 0x56, 0x73, 0xcb, 0x90, 0x77, 0x42, 0xaf, 0x7a, 0x1a, 0x2a,
-	0x9f, 0x3e, 0x4f, 0x10, 0x91, 0x0e, 0x93, 0x20, 0x22, 0x3f, 0x0b, 0x27, 0x99, 0xb2, 0xaa, 0x01,
-	0x1e, 0xb4, 0xfe, 0x0a, 0xda, 0xb0, 0x11, 0xa3, 0xdc, 0xb7, 0xc2, 0x36, 0xa2, 0x11, 0xae, 0x20,
-	0x8d, 0x6e, 0xe8, 0x08, 0x66, 0x69, 0x47, 0xd2, 0x90, 0x69, 0x47, 0xbb, 0x7d, 0x86, 0x7b, 0xcb,
-	0xda, 0x7c, 0xb6, 0x99, 0x2f, 0x22, 0xc9, 0x7c, 0x01, 0x6e, 0x0b, 0x84, 0x7c, 0x81, 0xd8, 0x85,
-	0x20, 0x74, 0x16, 0xa9, 0x7d, 0xd6, 0x9b, 0x0f, 0x16, 0x65, 0x52, 0xc4, 0xad, 0xf7, 0x6b, 0xf9,
-	0x06, 0x94, 0xa7, 0x7a, 0x85, 0x16, 0x5a, 0xfe, 0x4d, 0xee, 0x19, 0x48, 0x8e, 0xb0, 0x62, 0x19,
-	0xc1, 0x93, 0x2f, 0x77, 0x5d, 0xb9, 0x2e, 0xa9, 0x21, 0x17, 0xbd, 0x83, 0x5e, 0x2b, 0x98, 0xd2,
-	0x51, 0xad, 0xfe, 0xf7, 0xa5, 0xc8, 0xc4, 0x85, 0xc7, 0x9a, 0x38, 0xd4, 0x43, 0xdb, 0xdd, 0x5c,
-	0x0b, 0x58, 0xf0, 0x0a, 0x42, 0x06, 0xf5, 0xd4, 0x18, 0x1b, 0xa6, 0x0c, 0xea, 0x6d, 0xfe, 0xd8,
-	0x38, 0xd1, 0x89, 0xcc, 0x67, 0xde, 0xf4, 0x8e, 0x8a, 0x1d, 0xdb, 0x51, 0x4c, 0xf2, 0x91, 0xd9,
-	0x72, 0x35, 0x8f, 0xc0, 0x30, 0x1b, 0x2c, 0x0d, 0x37, 0x60, 0xc3, 0x29, 0x34, 0x05, 0xe1, 0xdf,
-	0x50, 0x2f, 0x27, 0xd9, 0xf7, 0x96, 0x11, 0x1d, 0x02, 0xa3, 0xc2, 0x21, 0xf0, 0xcf, 0xa3, 0xe8,
-	0xe5, 0x0c, 0x92, 0xf8, 0x3e, 0x1a, 0x67, 0x39, 0xbe, 0x5c, 0x1b, 0x17, 0xd3, 0x9e, 0x27, 0x0c,
-	0x14, 0x90, 0xfd, 0x83, 0x8d, 0xdf, 0xa4, 0x18, 0x1a, 0xfe, 0x24, 0xaa, 0xea, 0x9a, 0xa5, 0x93,
-	0xb6, 0x0a, 0x64, 0xe0, 0x16, 0xb1, 0x94, 0x49, 0x66, 0x8d, 0x01, 0x27, 0x89, 0x55, 0x80, 0x06,
-	0x83, 0xc0, 0x9f, 0x42, 0x33, 0xba, 0xdd, 0x71, 0x58, 0x3a, 0x1f, 0x10, 0x85, 0x04, 0xc5, 0xb7,
-	0xb2, 0x89, 0x72, 0xf0, 0x24, 0xd9, 0xe9, 0x80, 0x0e, 0x10, 0x7e, 0x8c, 0xaa, 0x2e, 0x61, 0x57,
-	0x3a, 0x20, 0x3b, 0xc6, 0xc8, 0x5e, 0xce, 0x24, 0xab, 0x30, 0xe0, 0x18, 0xd7, 0x94, 0x0a, 0x50,
-	0x60, 0xfd, 0xb2, 0x15, 0xe5, 0xf4, 0xa4, 0x6e, 0x17, 0x17, 0x8b, 0x4d, 0x34, 0x01, 0x79, 0xd4,
-	0x5c, 0x2a, 0x96, 0xb3, 0xa4, 0x22, 0x8b, 0x10, 0x47, 0x97, 0x09, 0xba, 0x1c, 0x8c, 0x97, 0xb1,
-	0xe4, 0x81, 0x05, 0xa5, 0x86, 0xca, 0xd4, 0xd5, 0x63, 0x31, 0xf3, 0x49, 0xea, 0xea, 0x75, 0x5f,
-	0x5e, 0x7e, 0x59, 0x42, 0x57, 0x06, 0x1c, 0x87, 0xaf, 0xf0, 0x33, 0x2c, 0x3d, 0x47, 0xd8, 0xb2,
-	0x60, 0xa9, 0x07, 0xdf, 0xb3, 0x99, 0xd8, 0x9e, 0x51, 0xf9, 0xc3, 0xc8, 0x00, 0xd7, 0x9f, 0x6b,
-	0xce, 0xe3, 0xee, 0x21, 0x95, 0xe1, 0xbf, 0x25, 0x74, 0xac, 0x8f, 0x18, 0xbe, 0x27, 0x6c, 0x11,
-	0xc4, 0x26, 0xb3, 0x4e, 0x25, 0x86, 0xc0, 0x91, 0x83, 0x8d, 0xc1, 0x4f, 0xd0, 0xb4, 0xa8, 0x05,
-	0x94, 0xab, 0xc1, 0xa5, 0x7c, 0x2a, 0xa0, 0x0b, 0x01, 0xad, 0xaa, 0xa0, 0x03, 0x14, 0x3f, 0xeb,
-	0xe7, 0x28, 0x68, 0xc1, 0x95, 0x02, 0x9a, 0x1c, 0x29, 0xa0, 0x9a, 0x64, 0x67, 0x03, 0x82, 0x91,
-	0x29, 0xec, 0xe4, 0x5b, 0xb9, 0x92, 0x10, 0xd6, 0x37, 0xf2, 0x84, 0x35, 0x4e, 0x22, 0x10, 0xd3,
-	0x87, 0x51, 0x16, 0x4f, 0xff, 0x19, 0xee, 0x5f, 0x39, 0x89, 0x6e, 0x5b, 0x86, 0xe6, 0xf6, 0xea,
-	0x4f, 0x72, 0x2f, 0xaf, 0xcf, 0xa3, 0xc4, 0x9a, 0x62, 0x3a, 0xe1, 0xed, 0x28, 0xfe, 0xc6, 0x3e,
-	0xd0, 0xf5, 0x82, 0xa3, 0xca, 0x5b, 0x91, 0xd5, 0x4f, 0x1d, 0x38, 0x7e, 0x30, 0xe7, 0x3a, 0xfe,
-	0x5d, 0x74, 0x69, 0x20, 0x52, 0x47, 0x39, 0xfd, 0x47, 0xd1, 0x05, 0xa9, 0xee, 0x6c, 0xec, 0x9b,
-	0x87, 0xd4, 0x98, 0x6f, 0x49, 0x68, 0x2e, 0x49, 0x0b, 0x6f, 0xc6, 0xcf, 0x8d, 0xab, 0x05, 0xfa,
-	0x02, 0x9a, 0xff, 0xc4, 0xb5, 0xf7, 0x4c, 0x83, 0xb8, 0x3e, 0x9d, 0xe0, 0x00, 0xf9, 0x7c, 0x9f,
-	0xb5, 0x07, 0xdd, 0xb9, 0x35, 0xa0, 0x9c, 0xa7, 0x50, 0x8e, 0x9b, 0x7d, 0xf9, 0xf3, 0x82, 0x05,
-	0x89, 0xb1, 0x83, 0xf3, 0xfc, 0x41, 0x42, 0xf7, 0x17, 0x73, 0x25, 0x5e, 0xa4, 0x10, 0x08, 0xfc,
-	0x0a, 0x3a, 0xce, 0x6f, 0xbd, 0xf5, 0x27, 0xcc, 0x8d, 0xc7, 0x39, 0xfd, 0x06, 0x9a, 0x0b, 0xde,
-	0xb4, 0x6a, 0x3c, 0x5f, 0x7a, 0x36, 0x68, 0xe7, 0x4f, 0x60, 0xb9, 0x81, 0x4e, 0x24, 0x48, 0xf0,
-	0xd9, 0x6d, 0xa1, 0xb9, 0x20, 0x95, 0xdb, 0xe1, 0x8b, 0x4c, 0x8d, 0xbe, 0x38, 0x4b, 0x09, 0x56,
-	0x28, 0xb3, 0x34, 0xde, 0x20, 0xdf, 0x84, 0xcb, 0xf9, 0x93, 0x30, 0x35, 0xca, 0xd2, 0xed, 0x0e,
-	0x09, 0x2e, 0x21, 0x79, 0x9a, 0xf8, 0x57, 0x12, 0x5c, 0x4c, 0xb2, 0x50, 0xf9, 0x5c, 0x2f, 0xa1,
-	0x63, 0x7a, 0xd7, 0x75, 0x89, 0x25, 0x84, 0x01, 0x78, 0x7a, 0xcc, 0x1c, 0xef, 0x08, 0x23, 0x00,
-	0x78, 0x07, 0x9d, 0x12, 0x33, 0xb7, 0x18, 0x41, 0xd5, 0x00, 0x8a, 0x45, 0x01, 0xbb, 0xd4, 0x79,
-	0x28, 0x27, 0x9d, 0xd4, 0xe9, 0xc9, 0xff, 0x20, 0xa1, 0x13, 0xa9, 0x18, 0x19, 0xef, 0xb0, 0x23,
-	0x4a, 0x35, 0xc1, 0xdb, 0x68, 0x02, 0x96, 0xc5, 0x4c, 0x77, 0x75, 0xf5, 0xee, 0xb7, 0xbf, 0x7f,
-	0x76, 0xe4, 0x7b, 0xdf, 0x3f, 0xfb, 0x7a, 0xcb, 0xf4, 0x76, 0xba, 0x8d, 0x25, 0xdd, 0xee, 0xf0,
-	0x2a, 0x34, 0xfe, 0xcf, 0x15, 0x6a, 0xec, 0xf2, 0x62, 0xaa, 0x2d, 0xcb, 0xfb, 0xee, 0x37, 0xaf,
-	0x20, 0xee, 0x28, 0xd9, 0xb2, 0x3c, 0x85, 0xd3, 0x92, 0x6f, 0xc3, 0xa5, 0x23, 0x32, 0x7e, 0x07,
-	0xd8, 0xc7, 0xbf, 0xe7, 0x8f, 0x8d, 0x6c, 0xe4, 0x61, 0x76, 0xd2, 0x42, 0xa7, 0x69, 0x40, 0x30,
-	0x73, 0x2f, 0x33, 0x2f, 0x3d, 0x19, 0x73, 0x51, 0xe6, 0x69, 0xc6, 0x24, 0xe5, 0x7f, 0x92, 0xd0,
-	0xcb, 0x19, 0x58, 0x59, 0xaf, 0xa4, 0xff, 0xd3, 0x3b, 0xfa, 0x8f, 0x25, 0x34, 0x01, 0xb5, 0x16,
-	0x58, 0x45, 0x27, 0x92, 0xda, 0x2e, 0x06, 0x5c, 0x2f, 0x65, 0x32, 0x31, 0xae, 0xea, 0xcc, 0x82,
-	0xbc, 0x44, 0xfb, 0x1b, 0x71, 0x1d, 0x1d, 0x03, 0xe3, 0x4f, 0x7b, 0x96, 0x1e, 0x10, 0x07, 0x66,
-	0x5c, 0xcc, 0xf4, 0x9d, 0xfa, 0x08, 0x75, 0x06, 0xcf, 0x08, 0xcf, 0x36, 0xe2, 0x0d, 0xf8, 0x01,
-	0x42, 0x50, 0x47, 0xc2, 0xa8, 0xc1, 0x3d, 0xe5, 0x7c, 0x16, 0x35, 0x56, 0x5a, 0xc2, 0xe8, 0x94,
-	0xf5, 0xe0, 0x27, 0x7e, 0x88, 0xaa, 0x1d, 0xcd, 0xd2, 0x5a, 0xc1, 0x8c, 0xe0, 0x6a, 0x7e, 0x21,
-	0x8b, 0xc6, 0x23, 0x80, 0x65, 0x54, 0x2a, 0x9d, 0xe8, 0x03, 0x6f, 0xa1, 0x69, 0xb2, 0x4f, 0xf4,
-	0xae, 0x6f, 0x71, 0x19, 0xa1, 0x71, 0x46, 0xe8, 0xd5, 0x2c, 0x42, 0x1b, 0x1c, 0x98, 0x51, 0xaa,
-	0x12, 0xe1, 0x0b, 0xbf, 0x8b, 0x26, 0x5b, 0x3a, 0x10, 0x99, 0xc8, 0x77, 0x8d, 0x6e, 0xae, 0x31,
-	0xf4, 0x89, 0x96, 0xee, 0xff, 0x2b, 0xff, 0x76, 0x09, 0xcd, 0x26, 0x58, 0x86, 0x5f, 0x47, 0xb3,
-	0x0d, 0xca, 0x93, 0x76, 0x77, 0x88, 0xd9, 0xda, 0x09, 0x32, 0x59, 0xa7, 0x1b, 0x94, 0xc1, 0xbe,
-	0xcf, 0x1a, 0xb1, 0x8c, 0xa6, 0x23, 0x38, 0x8d, 0x06, 0xc9, 0x5c, 0x95, 0x00, 0x8a, 0x57, 0x21,
-	0x35, 0xa8, 0x2a, 0x46, 0x62, 0x20, 0x50, 0x56, 0x6d, 0xd0, 0xa7, 0x51, 0x2c, 0x66, 0x11, 0xcd,
-	0x35, 0xa8, 0xda, 0xe9, 0xd1, 0x8f, 0xdb, 0xea, 0x1e, 0x71, 0xa9, 0x19, 0x56, 0x45, 0xcd, 0x34,
-	0xe8, 0x23, 0xbf, 0xf9, 0x19, 0xb4, 0xe2, 0x07, 0xe8, 0x4c, 0x83, 0xaa, 0x06, 0x69, 0x6a, 0xdd,
-	0xb6, 0xa7, 0xea, 0x3b, 0x9a, 0xab, 0xe9, 0x1e, 0x71, 0x55, 0x1a, 0xe4, 0xc1, 0x40, 0x8e, 0xff,
-	0xa9, 0x06, 0x5d, 0x07, 0x98, 0xb5, 0x00, 0xa4, 0xce, 0xd3, 0x62, 0x6e, 0xa1, 0x53, 0x22, 0x05,
-	0xbb, 0xdd, 0x86, 0x38, 0x8f, 0x90, 0xfc, 0x7f, 0x32, 0xc2, 0x0e, 0xba, 0x83, 0x02, 0x10, 0x7f,
-	0x9a, 0x3e, 0x63, 0x09, 0x9d, 0x9f, 0x64, 0x39, 0xfd, 0xe5, 0x06, 0x7d, 0x04, 0x0d, 0xbc, 0xfb,
-	0xb9, 0xed, 0xee, 0x12, 0x97, 0xb2, 0xa0, 0xd2, 0xb4, 0xdf, 0xfd, 0x29, 0x68, 0xc0, 0x6f, 0xa0,
-	0x63, 0x0d, 0xaa, 0x12, 0x4b, 0x6b, 0xb4, 0x89, 0x6a, 0x74, 0xb5, 0xb6, 0x6a, 0x34, 0x58, 0x60,
-	0x69, 0xca, 0x5f, 0xe5, 0x06, 0x6b, 0x5f, 0xef, 0x6a, 0xed, 0xf5, 0x86, 0xfc, 0x01, 0x2a, 0x87,
-	0x92, 0xe7, 0x5f, 0x81, 0xb8, 0xc0, 0x06, 0x69, 0xcf, 0x93, 0x20, 0x8b, 0x06, 0x4b, 0xcc, 0x61,
-	0x5d, 0xc1, 0x81, 0x3d, 0xca, 0xe6, 0x54, 0x65, 0x8d, 0xc1, 0x69, 0xfd, 0x9f, 0xa3, 0xe8, 0xa5,
-	0x14, 0x95, 0xf3, 0xb7, 0x99, 0x3a, 0xaa, 0x6e, 0x1b, 0x24, 0xe4, 0x39, 0x90, 0x9f, 0xa6, 0xce,
-	0x9a, 0x6d, 0x90, 0x80, 0xe5, 0xaf, 0xa2, 0x99, 0x00, 0x4e, 0xb7, 0x3b, 0x1d, 0xd3, 0xe3, 0xfb,
-	0x5c, 0x05, 0xb0, 0x35, 0xd6, 0x86, 0x2f, 0x32, 0x6a, 0x9a, 0xab, 0xef, 0x98, 0x1e, 0xd1, 0xbd,
-	0xae, 0x1b, 0xc4, 0xb1, 0x66, 0xa8, 0xb3, 0x22, 0xb4, 0xfa, 0x52, 0x43, 0x1d, 0xb5, 0x65, 0x27,
-	0x36, 0xba, 0x42, 0x9d, 0x4d, 0x3b, 0x18, 0x72, 0x09, 0xbd, 0x44, 0x1d, 0x15, 0xae, 0x1d, 0xa6,
-	0xd5, 0x52, 0x69, 0x8f, 0x7a, 0xa4, 0x13, 0xd4, 0xc3, 0x50, 0xe7, 0x71, 0xd0, 0x53, 0x67, 0x1d,
-	0x31, 0x78, 0xe1, 0xfa, 0x32, 0x11, 0x87, 0x0f, 0x2f, 0x30, 0x7c, 0x49, 0x86, 0xcd, 0x32, 0xd5,
-	0xd8, 0xc6, 0x4f, 0x06, 0x4b, 0x5a, 0x67, 0x8d, 0x6c, 0xbb, 0xaf, 0xa2, 0x13, 0xa9, 0xa9, 0x49,
-	0x3c, 0x37, 0x08, 0xf7, 0x67, 0x25, 0xc9, 0x7f, 0x2c, 0xa1, 0x8a, 0xa0, 0xef, 0x90, 0x1a, 0xc2,
-	0x36, 0xbc, 0x6d, 0x6b, 0x86, 0xea, 0x69, 0x74, 0x97, 0x31, 0x79, 0x4a, 0x99, 0x81, 0xf6, 0x4f,
-	0xd8, 0x9a, 0xb1, 0xad, 0xd1, 0x5d, 0x7c, 0x0d, 0x9d, 0xe0, 0x90, 0x3b, 0x44, 0x6b, 0x7b, 0x3b,
-	0xaa, 0xbe, 0x43, 0xf4, 0x5d, 0x9e, 0x2f, 0x3f, 0xa5, 0xbc, 0x04, 0x9d, 0xef, 0xb3, 0xbe, 0x35,
-	0xe8, 0xc2, 0xf7, 0xd0, 0x69, 0x8e, 0xe3, 0x13, 0x56, 0x5d, 0xe2, 0xf9, 0x67, 0x9a, 0xbe, 0x43,
-	0x7c, 0x79, 0x74, 0x79, 0x8a, 0xf5, 0x3c, 0x80, 0xf8, 0x83, 0x28, 0x3e, 0x40, 0x3d, 0xe8, 0xf7,
-	0x0f, 0xd8, 0xaa, 0x68, 0x53, 0x7c, 0xd5, 0x68, 0x9b, 0xd4, 0x23, 0x96, 0xca, 0xaa, 0x41, 0x81,
-	0x9e, 0xaf, 0xb4, 0x76, 0x37, 0x30, 0x01, 0x27, 0x01, 0xa0, 0x4e, 0xb4, 0x36, 0xa3, 0xb6, 0x0d,
-	0xbd, 0xf8, 0x3e, 0x7a, 0x85, 0xbf, 0x2b, 0x3c, 0x57, 0x6b, 0x36, 0x4d, 0x5d, 0xdd, 0x25, 0xc4,
-	0x61, 0xc8, 0xaa, 0xa1, 0xf5, 0x78, 0x38, 0x70, 0x1e, 0x60, 0xb6, 0x01, 0xe4, 0x03, 0x42, 0x1c,
-	0x1f, 0x7f, 0x5d, 0xeb, 0xe1, 0x9b, 0xe8, 0x94, 0x90, 0xe4, 0x91, 0x40, 0x06, 0x93, 0x71, 0x22,
-	0xca, 0xe3, 0x10, 0x30, 0xe5, 0x5f, 0x91, 0xd0, 0x04, 0x18, 0x35, 0x81, 0xdb, 0x2d, 0x5d, 0x7d,
-	0x61, 0x77, 0x1a, 0x26, 0x89, 0x73, 0x7b, 0x53, 0xff, 0x88, 0xb5, 0xfa, 0x02, 0x10, 0x41, 0xfa,
-	0x16, 0x92, 0xb3, 0xb9, 0x1a, 0xc0, 0xb1, 0xa2, 0xbc, 0xab, 0xe8, 0x04, 0xef, 0x86, 0xb9, 0x98,
-	0x96, 0x47, 0xdc, 0x3d, 0xad, 0xcd, 0x27, 0x84, 0x5b, 0x0c, 0xcc, 0x9f, 0xc8, 0x16, 0xef, 0x91,
-	0x4f, 0x46, 0xb7, 0x6b, 0x76, 0x48, 0xf2, 0x1b, 0x8e, 0xfc, 0x38, 0xba, 0x32, 0xf3, 0xf6, 0x28,
-	0xa9, 0x9d, 0x17, 0x34, 0x16, 0x24, 0xb5, 0x73, 0x3c, 0x0e, 0x2d, 0x3b, 0x61, 0xec, 0xf6, 0x29,
-	0x25, 0x2e, 0xbc, 0xd0, 0x06, 0x2c, 0x95, 0x08, 0xbd, 0x87, 0xa3, 0x82, 0xf7, 0xb0, 0xd0, 0xe9,
-	0x28, 0x7f, 0x3a, 0x56, 0x0a, 0x18, 0x8c, 0xc8, 0x97, 0x71, 0x27, 0xf1, 0x16, 0xbc, 0x90, 0x1b,
-	0x2e, 0x86, 0x74, 0x83, 0xf0, 0x0d, 0x68, 0x85, 0xf5, 0x7f, 0x42, 0xaf, 0xf8, 0x04, 0x84, 0xc0,
-	0x4e, 0xf4, 0x04, 0x64, 0xdf, 0x07, 0x59, 0x49, 0xac, 0xda, 0x4e, 0xfe, 0x08, 0xde, 0x9c, 0x7d,
-	0xe3, 0x1d, 0xc5, 0x5a, 0xba, 0x61, 0x02, 0xb5, 0xcf, 0xa5, 0xc7, 0xcf, 0x2d, 0x62, 0xfc, 0x6f,
-	0x6c, 0xce, 0xe7, 0x62, 0xf5, 0x33, 0xb1, 0x61, 0x8f, 0x62, 0x55, 0x7f, 0x37, 0x8a, 0x26, 0x9e,
-	0xd8, 0x6d, 0x53, 0xef, 0xe1, 0xd7, 0xd0, 0x8c, 0xe3, 0x9a, 0x96, 0x6e, 0x3a, 0x5a, 0x1b, 0x22,
-	0x91, 0x12, 0xab, 0xc4, 0x9d, 0x0e, 0x5b, 0x59, 0x38, 0xf3, 0x22, 0x9a, 0x8d, 0xc0, 0xa2, 0xa0,
-	0x6d, 0x59, 0x89, 0xb0, 0x9f, 0xf9, 0xad, 0xfd, 0xf1, 0xd3, 0xd2, 0x91, 0xc4, 0x4f, 0x79, 0x8d,
-	0xa5, 0x10, 0x3f, 0x7d, 0x03, 0xcd, 0x09, 0xf9, 0x24, 0xf0, 0x4a, 0x80, 0xc4, 0x89, 0xd9, 0x28,
-	0xa9, 0x04, 0x1e, 0x09, 0x6f, 0xa0, 0x39, 0xe1, 0xca, 0x02, 0xa0, 0x90, 0x60, 0x32, 0x1b, 0x65,
-	0x90, 0x00, 0x68, 0x4a, 0xb2, 0xc8, 0x64, 0x6a, 0xb2, 0xc8, 0xbf, 0x49, 0x91, 0x37, 0x0b, 0x3c,
-	0xea, 0x8c, 0xa1, 0x26, 0xa1, 0x47, 0x57, 0x67, 0x93, 0x08, 0x11, 0x97, 0x86, 0x08, 0x11, 0xa7,
-	0xc7, 0x16, 0x92, 0x02, 0x38, 0xde, 0x27, 0x80, 0xff, 0x2f, 0xca, 0x8b, 0x4e, 0xae, 0x8e, 0x0b,
-	0xe0, 0x6d, 0x34, 0xe5, 0xf0, 0xb6, 0xa2, 0xf2, 0x24, 0x10, 0x35, 0x25, 0x84, 0x97, 0x1f, 0x47,
-	0x71, 0xa7, 0x78, 0xdd, 0x33, 0xbc, 0x7f, 0x42, 0x16, 0x5e, 0x4c, 0x2f, 0xa3, 0x2e, 0x27, 0xcb,
-	0xa3, 0x65, 0x2d, 0x8a, 0x1a, 0x65, 0x10, 0x3c, 0x74, 0x4d, 0xd5, 0x5a, 0x14, 0x1f, 0xf1, 0x75,
-	0x32, 0x3e, 0xcc, 0x80, 0xe6, 0x40, 0xfe, 0xe9, 0xc8, 0x6b, 0x9f, 0x4a, 0x84, 0xcf, 0xf2, 0x29,
-	0x9a, 0x4b, 0xac, 0x3b, 0x78, 0xca, 0xbe, 0x39, 0x58, 0xfd, 0x38, 0x2b, 0x7e, 0x99, 0x8d, 0x33,
-	0x89, 0xca, 0xb7, 0xc0, 0x30, 0x33, 0x87, 0x20, 0xf7, 0x01, 0x0a, 0x59, 0x27, 0xa7, 0x51, 0x39,
-	0x30, 0xcc, 0x41, 0xd2, 0xc9, 0x14, 0xb7, 0xcc, 0x54, 0xfe, 0x8e, 0x14, 0x39, 0xf6, 0x62, 0xb8,
-	0x7c, 0xc6, 0x9f, 0x4a, 0x98, 0xa3, 0xf7, 0x8a, 0x12, 0x49, 0x52, 0x88, 0x80, 0xa9, 0xe2, 0x69,
-	0x24, 0x9c, 0x5c, 0xed, 0xd3, 0x3c, 0xa5, 0x2d, 0x33, 0x89, 0xe4, 0x7a, 0x3c, 0x89, 0xa4, 0x20,
-	0xb1, 0x49, 0xc8, 0x21, 0xb9, 0x0b, 0x32, 0xc3, 0xdc, 0x5e, 0x3c, 0x12, 0x60, 0xb5, 0x78, 0x69,
-	0x17, 0x94, 0x4d, 0xe6, 0xb9, 0x33, 0xee, 0x43, 0x52, 0x65, 0x1e, 0x76, 0x5a, 0xf9, 0xe1, 0x58,
-	0x90, 0x43, 0xf5, 0x1c, 0x46, 0x87, 0xd4, 0x0d, 0x1e, 0x78, 0xd9, 0x7c, 0xb6, 0x19, 0x25, 0x71,
-	0x0c, 0x1a, 0x0a, 0x6f, 0xc5, 0x42, 0xe1, 0xe0, 0x72, 0xa8, 0xa1, 0xb2, 0x41, 0x3d, 0x1e, 0x61,
-	0x81, 0x02, 0x84, 0x49, 0x83, 0x7a, 0x2c, 0xc2, 0xa2, 0xc2, 0xc4, 0xf3, 0x06, 0x3e, 0x5c, 0xe6,
-	0xc8, 0xbb, 0x89, 0x7a, 0xaf, 0xba, 0xf9, 0x82, 0x0c, 0xe4, 0xaf, 0xbe, 0x9b, 0xa8, 0xe0, 0x02,
-	0x44, 0x3e, 0x99, 0xc8, 0x58, 0xb2, 0x74, 0xe5, 0x58, 0x7d, 0x04, 0x4b, 0x58, 0x5e, 0x08, 0x8b,
-	0xab, 0x20, 0xcb, 0x9d, 0xff, 0x8d, 0x98, 0x20, 0x7d, 0x43, 0xbe, 0x1b, 0x9e, 0xe4, 0xc9, 0x7e,
-	0x3e, 0x42, 0x6e, 0xf2, 0xeb, 0x66, 0x98, 0x43, 0x1f, 0xe5, 0x98, 0x0f, 0x53, 0x77, 0x28, 0xab,
-	0xa1, 0xd4, 0x65, 0x11, 0x3a, 0x64, 0x21, 0xf3, 0x1d, 0x74, 0x32, 0x18, 0x80, 0xae, 0xaf, 0x8a,
-	0x2e, 0xe1, 0xf3, 0xa8, 0xda, 0xe7, 0x05, 0x18, 0x53, 0x2a, 0x8d, 0xc8, 0x07, 0x20, 0x7f, 0x29,
-	0x4a, 0x81, 0x8c, 0xb0, 0xf9, 0x84, 0x8a, 0xd1, 0xf1, 0x65, 0x84, 0x39, 0x0b, 0x83, 0x12, 0x8c,
-	0x20, 0x77, 0xb0, 0xac, 0xcc, 0x41, 0xcf, 0x36, 0x54, 0x60, 0x74, 0x2d, 0x0f, 0xbf, 0x89, 0x8e,
-	0x71, 0x68, 0xf6, 0x3e, 0x01, 0xe0, 0x12, 0xf7, 0x51, 0xb3, 0x0e, 0xff, 0x5d, 0xc2, 0x60, 0xaf,
-	0x7d, 0xf5, 0x3e, 0x7a, 0xc9, 0x9f, 0xd8, 0x23, 0xbe, 0xf6, 0x3a, 0x71, 0xf7, 0x4c, 0x9d, 0xe0,
-	0x2f, 0x20, 0xdc, 0x5f, 0xfe, 0x8b, 0xaf, 0xe6, 0x59, 0x9f, 0xd4, 0x3f, 0x7e, 0x51, 0xbb, 0x76,
-	0x10, 0x14, 0xe0, 0x88, 0x3c, 0x82, 0xbf, 0x2c, 0xa5, 0x64, 0xf2, 0x45, 0x9b, 0x89, 0x6f, 0x0f,
-	0x9c, 0x4f, 0xd7, 0x27, 0x4a, 0xb5, 0x3b, 0x43, 0xe1, 0x86, 0x53, 0xfb, 0xad, 0x64, 0x41, 0x64,
-	0x6c, 0x62, 0x37, 0x0b, 0x96, 0x9b, 0xf9, 0x27, 0x09, 0x6a, 0xb7, 0x86, 0xc0, 0x0c, 0x27, 0xf5,
-	0xab, 0x82, 0x7c, 0x25, 0x2a, 0xf8, 0xf1, 0x8d, 0x03, 0x11, 0x0e, 0x15, 0xbb, 0xf6, 0xee, 0x81,
-	0xf1, 0xc2, 0xe9, 0xfc, 0x2d, 0xcf, 0xdd, 0x1a, 0xa4, 0x7e, 0x1e, 0x6f, 0x16, 0xed, 0xc7, 0x80,
-	0x7f, 0x36, 0xa0, 0xf6, 0xfe, 0xe1, 0x09, 0xa5, 0x31, 0x34, 0x59, 0xff, 0x5e, 0xc8, 0xd0, 0x8c,
-	0xd2, 0xfc, 0x42, 0x86, 0x66, 0x15, 0xda, 0xcb, 0x23, 0xf8, 0x77, 0xa5, 0xe8, 0x8a, 0xd1, 0x5f,
-	0xd9, 0x8e, 0x6f, 0x15, 0xad, 0x3c, 0xb3, 0x12, 0xbf, 0x76, 0x7b, 0x18, 0xd4, 0x70, 0x5e, 0x2f,
-	0xd0, 0xb1, 0xbe, 0x42, 0x74, 0xfc, 0x56, 0xc1, 0x3a, 0xfb, 0x4a, 0xe7, 0x6b, 0x57, 0x0f, 0x80,
-	0x11, 0x8e, 0xfd, 0x4b, 0x52, 0xe8, 0x2c, 0x88, 0x9f, 0x3c, 0xf8, 0xed, 0x02, 0x72, 0xa9, 0x07,
-	0x59, 0xed, 0x9d, 0x03, 0x62, 0xf5, 0x6d, 0x4e, 0x7a, 0x29, 0x3b, 0x2e, 0x52, 0xec, 0xec, 0x6a,
-	0xfb, 0xfc, 0xcd, 0xc9, 0xaf, 0x9c, 0x97, 0x47, 0xf0, 0xaf, 0x47, 0x7f, 0xad, 0xa0, 0xaf, 0x18,
-	0x1d, 0xbf, 0x7b, 0x30, 0xd2, 0x11, 0x9b, 0x6e, 0x1e, 0x1c, 0x31, 0x9c, 0xd1, 0x17, 0xc0, 0xed,
-	0x93, 0xcc, 0xab, 0xc5, 0xa9, 0x29, 0x25, 0xac, 0x1e, 0x26, 0x09, 0x1a, 0x49, 0xcc, 0xe0, 0x18,
-	0x29, 0xd2, 0x1a, 0x95, 0xb2, 0x17, 0x4a, 0x6b, 0x5f, 0x71, 0x7d, 0xa1, 0xb4, 0xf6, 0xd7, 0xc9,
-	0xc7, 0xa5, 0x35, 0x5e, 0x6f, 0x5e, 0x28, 0xad, 0xa9, 0x35, 0xed, 0x85, 0xd2, 0x9a, 0x5e, 0xd4,
-	0x2e, 0x8f, 0xe0, 0x2f, 0x4a, 0xd1, 0x45, 0x26, 0x5e, 0xb0, 0x88, 0xdf, 0x19, 0x68, 0x61, 0xc9,
-	0x2a, 0xc9, 0xda, 0x8d, 0x83, 0xa2, 0xf5, 0x59, 0xd9, 0x94, 0x2a, 0xd1, 0x7c, 0x2b, 0x9b, 0x5d,
-	0x57, 0x9c, 0x6f, 0x65, 0x73, 0xca, 0x51, 0x85, 0x5b, 0x47, 0x46, 0x99, 0x34, 0xbe, 0x7d, 0xb0,
-	0x85, 0xc6, 0x8c, 0xff, 0x9d, 0xa1, 0x70, 0xc3, 0xa9, 0xfd, 0x9e, 0x94, 0xb8, 0x86, 0x27, 0xd9,
-	0x75, 0x67, 0x20, 0xeb, 0x95, 0xc1, 0xb3, 0xbb, 0xc3, 0x21, 0xf7, 0x59, 0x9a, 0xb4, 0xa2, 0x46,
-	0x7c, 0x80, 0x0d, 0x89, 0xd5, 0xa7, 0xe6, 0x5b, 0x9a, 0xbc, 0xfa, 0x49, 0x41, 0xb2, 0x52, 0x4a,
-	0x09, 0xf3, 0x25, 0x2b, 0xbb, 0x76, 0x31, 0x5f, 0xb2, 0x72, 0x6a, 0x16, 0xe5, 0x11, 0xfc, 0x87,
-	0x62, 0x65, 0x63, 0x7a, 0x8d, 0x1f, 0xbe, 0x5f, 0x48, 0x3e, 0xb7, 0xc8, 0xb0, 0xf6, 0xde, 0xd0,
-	0xf8, 0xe1, 0x34, 0x9f, 0xa3, 0xb9, 0x64, 0xa5, 0x13, 0x5e, 0x2e, 0x90, 0x8d, 0x64, 0x49, 0x57,
-	0xed, 0xad, 0xc1, 0x11, 0xc2, 0x81, 0x7f, 0x5e, 0x82, 0x93, 0x21, 0x59, 0x4b, 0x84, 0xaf, 0x1f,
-	0xac, 0xf2, 0x08, 0x66, 0xf0, 0xf6, 0x30, 0xe5, 0x4a, 0x89, 0x59, 0x88, 0x05, 0x39, 0xc5, 0xb3,
-	0x48, 0xa9, 0x36, 0x2a, 0x9e, 0x45, 0x5a, 0xcd, 0x8f, 0xa0, 0x4c, 0x69, 0xd5, 0x27, 0xf9, 0xca,
-	0x94, 0x53, 0xb5, 0x93, 0xaf, 0x4c, 0x79, 0x85, 0x2e, 0xf2, 0x08, 0xfe, 0x13, 0x29, 0xf2, 0xd1,
-	0x65, 0x57, 0x9c, 0xe0, 0xc2, 0x22, 0xa7, 0xc2, 0x82, 0x97, 0xda, 0xea, 0x61, 0x48, 0x84, 0xf3,
-	0xfd, 0x23, 0x29, 0xa7, 0x14, 0x82, 0x97, 0x8e, 0xe0, 0xf7, 0x8a, 0xc4, 0xb4, 0xa0, 0x6e, 0xa5,
-	0xf6, 0x60, 0x78, 0x02, 0x69, 0x8f, 0xc9, 0xfe, 0xb2, 0x8e, 0xc2, 0xc7, 0x64, 0x66, 0x31, 0x4a,
-	0xe1, 0x63, 0x32, 0xbb, 0x16, 0x25, 0x3e, 0xa9, 0xfe, 0x35, 0x14, 0x4e, 0x2a, 0xb3, 0xf8, 0xa4,
-	0x70, 0x52, 0xd9, 0xe5, 0x25, 0xe9, 0xba, 0xc9, 0x0a, 0x18, 0x06, 0xd6, 0x4d, 0xb1, 0xa0, 0x64,
-	0x60, 0xdd, 0x8c, 0x15, 0x86, 0xc8, 0x23, 0xf8, 0x6b, 0x7d, 0xd1, 0x89, 0x44, 0x19, 0x05, 0xbe,
-	0x7b, 0x10, 0xca, 0xc9, 0x82, 0x90, 0xda, 0xbd, 0x21, 0xb1, 0x53, 0x55, 0x35, 0xbb, 0xba, 0x01,
-	0x0f, 0x5e, 0x8f, 0x98, 0x55, 0xb8, 0x51, 0xac, 0xaa, 0xc5, 0xc5, 0x15, 0x09, 0x4f, 0x41, 0x51,
-	0x42, 0x70, 0xb1, 0xa7, 0x60, 0xc0, 0xd4, 0xe4, 0x62, 0x4f, 0xc1, 0xa0, 0xb9, 0xc9, 0xf2, 0x08,
-	0xfe, 0x33, 0x29, 0x8a, 0x3d, 0xe4, 0xa4, 0x03, 0xe3, 0xd5, 0xa1, 0xc6, 0x8c, 0x8b, 0xc7, 0xda,
-	0xa1, 0x68, 0xa4, 0x7a, 0xd7, 0xd2, 0x0a, 0x48, 0x0a, 0x7d, 0x02, 0xd9, 0x85, 0x2c, 0xc5, 0xde,
-	0xb5, 0x9c, 0xaa, 0x0a, 0x79, 0x04, 0xff, 0xa5, 0x14, 0x45, 0x9c, 0x72, 0xeb, 0x14, 0xf0, 0x7a,
-	0xd1, 0x40, 0x83, 0x94, 0x53, 0xd4, 0x36, 0x0e, 0x49, 0xa5, 0xef, 0x7d, 0xd7, 0x5f, 0x87, 0x50,
-	0x68, 0x6b, 0xd2, 0x6a, 0x20, 0xf2, 0xdf, 0x77, 0x99, 0xa9, 0xfe, 0x09, 0x43, 0x19, 0x4b, 0xef,
-	0x2e, 0x34, 0x94, 0x29, 0x89, 0xe5, 0xc5, 0x86, 0x32, 0x2d, 0xfd, 0x5a, 0x1e, 0xc1, 0x16, 0x9a,
-	0x8e, 0xe5, 0x3e, 0xe3, 0xcb, 0x05, 0xc6, 0x3f, 0x96, 0x65, 0x5d, 0xbb, 0x32, 0x20, 0x74, 0xda,
-	0x78, 0x90, 0x75, 0x59, 0x38, 0x9e, 0x98, 0x77, 0x52, 0x3c, 0x5e, 0x2c, 0x1b, 0x25, 0xe6, 0x4a,
-	0x88, 0xb2, 0x3c, 0x0a, 0x5d, 0x09, 0x7d, 0x29, 0x28, 0xb5, 0xab, 0x07, 0xc0, 0x08, 0xc7, 0xfe,
-	0x59, 0x09, 0x7c, 0xf6, 0x89, 0xc4, 0x0c, 0x7c, 0x6d, 0x90, 0x8b, 0x77, 0x3c, 0x6b, 0xa4, 0x76,
-	0xfd, 0x40, 0x38, 0x69, 0x4e, 0x84, 0x44, 0x22, 0x45, 0xa1, 0x13, 0x21, 0x3d, 0xdf, 0xa3, 0x76,
-	0xe3, 0xa0, 0x68, 0x7d, 0x73, 0xe9, 0x8f, 0xa9, 0xe3, 0x77, 0x06, 0x3b, 0xa3, 0x12, 0x19, 0x06,
-	0xb5, 0x1b, 0x07, 0x45, 0x4b, 0xbd, 0x1f, 0xa4, 0x06, 0xcc, 0x8b, 0xef, 0x07, 0x79, 0x81, 0xfb,
-	0xe2, 0xfb, 0x41, 0x6e, 0x94, 0x3e, 0x61, 0xfa, 0x53, 0x22, 0xe5, 0xc5, 0xa6, 0x3f, 0x3b, 0x46,
-	0x5f, 0x6c, 0xfa, 0x73, 0x42, 0xf3, 0x82, 0x58, 0x27, 0xa2, 0xd8, 0xf9, 0x62, 0x9d, 0x1e, 0x73,
-	0xaf, 0x5d, 0x1f, 0x22, 0x4c, 0x2e, 0x8f, 0xe0, 0xaf, 0x4b, 0x61, 0x7e, 0x50, 0x46, 0xf4, 0x19,
-	0xdf, 0x2b, 0xb4, 0x4c, 0x79, 0x31, 0xef, 0xda, 0xfd, 0x61, 0xd1, 0xfb, 0xe6, 0x98, 0x1d, 0x68,
-	0xce, 0x9f, 0x63, 0x61, 0x64, 0x3c, 0x7f, 0x8e, 0xc5, 0xf1, 0xed, 0x14, 0x47, 0x2b, 0xfb, 0x03,
-	0x72, 0x83, 0x39, 0x5a, 0x85, 0xa8, 0xf6, 0x80, 0x8e, 0x56, 0x31, 0x9c, 0x2d, 0x78, 0xe3, 0xd3,
-	0xeb, 0x5a, 0xf2, 0xbd, 0xf1, 0xb9, 0x65, 0x34, 0xf9, 0xde, 0xf8, 0xfc, 0x32, 0x1a, 0xc1, 0x83,
-	0x97, 0x55, 0xa7, 0x91, 0xef, 0xc1, 0x2b, 0x28, 0x0d, 0xc9, 0xf7, 0xe0, 0x15, 0x95, 0x86, 0x08,
-	0x86, 0x2b, 0x33, 0x7e, 0x8e, 0xef, 0x0e, 0xb4, 0x19, 0x19, 0xf1, 0xfb, 0xda, 0xbd, 0x21, 0xb1,
-	0xc3, 0x09, 0x7a, 0x68, 0x36, 0x11, 0x40, 0xc7, 0x4b, 0x45, 0x34, 0xe3, 0x71, 0xfa, 0xda, 0xf2,
-	0xc0, 0xf0, 0xc1, 0xa8, 0xab, 0xda, 0xb7, 0x7f, 0xb8, 0x20, 0x7d, 0xe7, 0x87, 0x0b, 0xd2, 0x0f,
-	0x7e, 0xb8, 0x20, 0xfd, 0xc6, 0x8f, 0x16, 0x46, 0xbe, 0xf3, 0xa3, 0x85, 0x91, 0x7f, 0xfd, 0xd1,
-	0xc2, 0xc8, 0x47, 0x9b, 0x42, 0x79, 0x48, 0xc3, 0x6a, 0x5c, 0x61, 0xb9, 0xe4, 0xcb, 0x51, 0x50,
-	0xe2, 0x0a, 0x0f, 0x4a, 0x5c, 0x09, 0x6a, 0x3e, 0x96, 0xd3, 0xff, 0x5f, 0x59, 0x8d, 0x09, 0xf6,
-	0xbf, 0x52, 0xba, 0xfe, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x08, 0xc3, 0x31, 0x24, 0x4c, 0x6b,
-	0x00, 0x00,
+	// 6443 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3d, 0x59, 0x8c, 0x24, 0xc9,
+	0x55, 0x9b, 0x5d, 0x7d, 0x54, 0xbd, 0x3e, 0x27, 0xe7, 0xea, 0xc9, 0x99, 0xe9, 0xe9, 0xce, 0xb9,
+	0x7a, 0x8f, 0xe9, 0xde, 0xb9, 0x76, 0xee, 0xd9, 0xed, 0x6b, 0x7a, 0x5b, 0xeb, 0xd9, 0x69, 0x67,
+	0xcf, 0x8e, 0xed, 0x35, 0x26, 0x9d, 0x95, 0x19, 0x55, 0x9d, 0x74, 0x55, 0x66, 0x6e, 0x46, 0x56,
+	0xcf, 0xd4, 0x0a, 0x0b, 0x04, 0x08, 0xc9, 0x18, 0x71, 0x63, 0x59, 0x06, 0x2c, 0x6c, 0x21, 0x21,
+	0x21, 0x23, 0x21, 0xb0, 0xf8, 0x01, 0x03, 0x82, 0x1f, 0x4b, 0x06, 0xc9, 0xf2, 0x07, 0x20, 0x7f,
+	0x58, 0x96, 0x17, 0x04, 0x7c, 0xf3, 0x85, 0xc4, 0x07, 0x8a, 0x23, 0xef, 0xab, 0xaa, 0xba, 0x8d,
+	0x10, 0xf2, 0xcf, 0x74, 0x65, 0xc4, 0x7b, 0x2f, 0x5e, 0xbc, 0x88, 0xf7, 0x22, 0xe2, 0xc5, 0x7b,
+	0x31, 0x70, 0xb1, 0x6d, 0x1b, 0x9d, 0x96, 0xe6, 0x2e, 0xb7, 0x91, 0xa7, 0x19, 0x9a, 0xa7, 0x2d,
+	0x7b, 0x5d, 0x07, 0xe1, 0xe0, 0x73, 0xc9, 0x71, 0x6d, 0xcf, 0x16, 0x4f, 0x70, 0xb0, 0xa5, 0xa0,
+	0x9c, 0x82, 0x49, 0x0b, 0x75, 0x0d, 0x23, 0x8e, 0xd2, 0x6c, 0x60, 0x07, 0xb9, 0xae, 0xed, 0xe2,
+	0x65, 0xfa, 0x87, 0xa1, 0x4a, 0x73, 0x09, 0x10, 0x4f, 0xc3, 0x7b, 0xcb, 0xe4, 0x1f, 0x5e, 0x7f,
+	0x4a, 0xb7, 0x71, 0xdb, 0xc6, 0x2a, 0xfd, 0x5a, 0x66, 0x1f, 0xbc, 0xea, 0x58, 0xd3, 0x6e, 0xda,
+	0xac, 0x9c, 0xfc, 0xe2, 0xa5, 0x97, 0x9a, 0x2e, 0x42, 0x56, 0xc3, 0x44, 0x2d, 0x63, 0xd9, 0xd1,
+	0xba, 0x6d, 0x64, 0x79, 0xcb, 0xd8, 0x73, 0x91, 0xd6, 0x56, 0x5d, 0xa4, 0xdb, 0xae, 0xc1, 0xe1,
+	0xe4, 0x28, 0x1c, 0x72, 0xdb, 0x26, 0xc6, 0xa6, 0x6d, 0x2d, 0xeb, 0x76, 0xbb, 0x6d, 0x5b, 0x1c,
+	0xe6, 0x5c, 0x04, 0xc6, 0x45, 0xd8, 0xee, 0xb8, 0x3a, 0xe7, 0xd5, 0xe7, 0x2e, 0x02, 0x80, 0x9d,
+	0x58, 0x55, 0x14, 0x17, 0x7b, 0xb6, 0xab, 0x35, 0xd1, 0x32, 0xda, 0x47, 0x96, 0xe7, 0x03, 0xcc,
+	0x65, 0x00, 0x7c, 0xd0, 0x41, 0x6e, 0xb7, 0xa0, 0x3e, 0xda, 0xc0, 0x85, 0x48, 0xfd, 0xbe, 0xe9,
+	0x7a, 0x1d, 0xad, 0xd5, 0x74, 0xed, 0x8e, 0x13, 0x6f, 0xe5, 0x7c, 0x1e, 0x54, 0x94, 0xd4, 0x49,
+	0x42, 0xdf, 0x1f, 0x05, 0xfa, 0x9b, 0x55, 0xc8, 0x5f, 0xaa, 0xc0, 0xe8, 0x6a, 0x47, 0xdf, 0x43,
+	0x9e, 0xf8, 0x26, 0x8c, 0xd7, 0xe9, 0x2f, 0xd5, 0xb4, 0x1a, 0xf6, 0xac, 0x30, 0x2f, 0x2c, 0x8e,
+	0x5f, 0x9b, 0x5b, 0x0a, 0xc9, 0x2f, 0x71, 0x26, 0x97, 0x18, 0xc2, 0x96, 0xd5, 0xb0, 0x15, 0xa8,
+	0x07, 0xbf, 0xc5, 0x59, 0x18, 0x73, 0x51, 0xdb, 0xde, 0x47, 0xc6, 0xec, 0xd0, 0xbc, 0xb0, 0x58,
+	0x55, 0xfc, 0x4f, 0xf1, 0x34, 0xd4, 0x0c, 0xd4, 0x42, 0x1e, 0x52, 0x35, 0x6f, 0xb6, 0x32, 0x2f,
+	0x2c, 0x56, 0x94, 0x2a, 0x2b, 0x58, 0xf1, 0xc4, 0xf3, 0x30, 0xc9, 0x2b, 0x5d, 0xa4, 0x61, 0xdb,
+	0x9a, 0x1d, 0x9e, 0x17, 0x16, 0x6b, 0xca, 0x04, 0x2b, 0x54, 0x68, 0x99, 0x28, 0x41, 0xd5, 0x76,
+	0x90, 0xab, 0x79, 0xb6, 0x3b, 0x3b, 0x42, 0xeb, 0x83, 0x6f, 0xf1, 0x02, 0x4c, 0xe9, 0x2e, 0xd2,
+	0x3c, 0xa4, 0x7a, 0x2f, 0xd4, 0x5d, 0x0d, 0xef, 0xce, 0x8e, 0x32, 0x0a, 0xac, 0xf4, 0xe9, 0x8b,
+	0xb7, 0x35, 0xbc, 0x4b, 0xa0, 0x3a, 0x8e, 0x11, 0x85, 0x1a, 0x63, 0x50, 0xac, 0x94, 0x43, 0x9d,
+	0x86, 0x1a, 0x87, 0xd2, 0xbc, 0xd9, 0x2a, 0xe3, 0x94, 0x15, 0xac, 0x78, 0xe2, 0x39, 0x18, 0xf7,
+	0x49, 0x98, 0x6d, 0x34, 0x5b, 0xa3, 0xd5, 0xc0, 0xf1, 0xcd, 0x36, 0x12, 0x17, 0x60, 0x82, 0xcb,
+	0x48, 0xc5, 0xe6, 0x87, 0x68, 0x16, 0x68, 0x0b, 0xe3, 0xbc, 0x6c, 0xc7, 0xfc, 0x10, 0x89, 0x8b,
+	0x30, 0x63, 0x37, 0x1a, 0xaa, 0xbe, 0xab, 0x99, 0x96, 0x8a, 0x3d, 0xcd, 0xeb, 0xe0, 0xd9, 0xf1,
+	0x79, 0x61, 0x71, 0x44, 0x99, 0xb2, 0x1b, 0x8d, 0x35, 0x52, 0xbc, 0x43, 0x4b, 0xe5, 0xff, 0x1c,
+	0x82, 0xd1, 0x27, 0xf5, 0x9f, 0x42, 0x3a, 0x1d, 0x1a, 0x9b, 0xfe, 0x2a, 0x1d, 0x1a, 0x86, 0xc0,
+	0x86, 0xc6, 0x0e, 0x7e, 0x8b, 0x17, 0x61, 0xaa, 0x65, 0xeb, 0x7b, 0xc8, 0x50, 0xeb, 0x5a, 0x4b,
+	0xb3, 0x74, 0x44, 0x47, 0xa8, 0xa6, 0x4c, 0xb2, 0xd2, 0x55, 0x56, 0x18, 0x1d, 0xc1, 0x4a, 0x6a,
+	0x04, 0x43, 0xb9, 0x0c, 0x27, 0xe4, 0x12, 0x1b, 0xde, 0x91, 0xb2, 0xe1, 0x1d, 0x2d, 0x19, 0xde,
+	0xb1, 0xd2, 0xe1, 0xad, 0xf6, 0x34, 0xbc, 0xb5, 0x8c, 0xe1, 0x9d, 0x87, 0x09, 0x8c, 0xb4, 0x56,
+	0x00, 0xc3, 0x06, 0x08, 0x48, 0x19, 0x83, 0x90, 0xff, 0x4a, 0x80, 0x49, 0x26, 0xc4, 0x75, 0xe4,
+	0x69, 0x66, 0x0b, 0x8b, 0x6f, 0xc0, 0x28, 0x93, 0x64, 0x20, 0xf7, 0x6c, 0x63, 0xc8, 0x65, 0xaf,
+	0x70, 0x68, 0x82, 0xc7, 0x94, 0x63, 0x76, 0xa8, 0x18, 0x8f, 0xa9, 0x93, 0xc2, 0xa1, 0xc5, 0x07,
+	0x50, 0x69, 0xee, 0x37, 0xe9, 0x00, 0x8c, 0x5f, 0x7b, 0x35, 0x3a, 0xc8, 0x51, 0xf5, 0x5e, 0xda,
+	0x6c, 0xd9, 0x75, 0xad, 0xf5, 0x8c, 0x15, 0x6d, 0x92, 0x22, 0x85, 0xe0, 0xc9, 0xff, 0x52, 0x81,
+	0xc9, 0x67, 0x9b, 0x8f, 0xc8, 0xb0, 0xff, 0x58, 0xb1, 0x0f, 0x4b, 0xb1, 0xd7, 0xa0, 0xb2, 0xdf,
+	0x6c, 0xd0, 0xe9, 0x32, 0x7e, 0xed, 0x6a, 0x1f, 0x63, 0xf2, 0x48, 0x6b, 0x9b, 0xad, 0xae, 0x42,
+	0xb0, 0x53, 0xd6, 0x61, 0xbc, 0x37, 0xeb, 0x30, 0x91, 0x69, 0x1d, 0xbe, 0x22, 0xc0, 0xd4, 0x36,
+	0x5b, 0xfd, 0x56, 0x74, 0xdd, 0xee, 0x58, 0x1e, 0x19, 0x26, 0xcd, 0x30, 0x5c, 0x84, 0x31, 0x1d,
+	0xe3, 0x9a, 0xe2, 0x7f, 0x8a, 0xc7, 0x60, 0xc4, 0x7e, 0x6e, 0x21, 0x97, 0x6b, 0x3d, 0xfb, 0x10,
+	0xe7, 0x00, 0x5c, 0xd4, 0xe8, 0x58, 0x86, 0x56, 0x6f, 0x21, 0xae, 0xf0, 0x91, 0x92, 0x62, 0x9d,
+	0x4f, 0x88, 0x6c, 0x24, 0x29, 0x32, 0xf9, 0xeb, 0x02, 0x88, 0x71, 0x06, 0x1f, 0x23, 0x4f, 0x13,
+	0x9f, 0xc0, 0x34, 0x5f, 0xb4, 0x55, 0x8d, 0x15, 0xf3, 0x09, 0x79, 0x29, 0x4f, 0x3d, 0xe2, 0x44,
+	0x94, 0x29, 0x27, 0xde, 0xeb, 0x0d, 0x98, 0x8c, 0xad, 0xfe, 0x5c, 0xdb, 0xe6, 0xa3, 0x83, 0xc4,
+	0x51, 0x96, 0x76, 0x28, 0xa0, 0x42, 0xe1, 0x94, 0x09, 0x1c, 0xf9, 0x92, 0x75, 0x38, 0xb5, 0xd9,
+	0xd8, 0x71, 0x36, 0x91, 0xf7, 0x1e, 0x46, 0x2e, 0x53, 0x04, 0xac, 0xa0, 0x0f, 0x3a, 0x08, 0x7b,
+	0xe2, 0x59, 0x00, 0xce, 0xac, 0x6a, 0x1a, 0x5c, 0xb8, 0x35, 0x5e, 0xb2, 0x65, 0x88, 0x97, 0x61,
+	0xda, 0xb4, 0xf4, 0x56, 0xc7, 0x40, 0x2a, 0x57, 0x0c, 0xae, 0x27, 0x53, 0xbc, 0x58, 0x61, 0xa5,
+	0xf2, 0x67, 0x40, 0xca, 0x6a, 0x04, 0x3b, 0xb6, 0x85, 0x91, 0xf8, 0x26, 0x8c, 0x31, 0xa5, 0x23,
+	0xe3, 0x57, 0x59, 0x1c, 0xbf, 0x76, 0x31, 0x4f, 0x24, 0x31, 0xfd, 0x56, 0x7c, 0x2c, 0xf9, 0xdb,
+	0x43, 0x20, 0x13, 0xfa, 0x1f, 0x33, 0xb1, 0xc7, 0x8c, 0x11, 0x5e, 0xed, 0x32, 0xa0, 0x77, 0xb5,
+	0x36, 0xf2, 0x7b, 0x73, 0x2e, 0xb0, 0x07, 0x96, 0xd6, 0x46, 0xbc, 0x3b, 0x50, 0x0f, 0xe0, 0x12,
+	0xdd, 0x1d, 0x4a, 0x76, 0xf7, 0x14, 0x54, 0xdb, 0xda, 0x0b, 0x75, 0x0f, 0x75, 0x31, 0x9d, 0x35,
+	0xc3, 0xca, 0x58, 0x5b, 0x7b, 0xf1, 0x0e, 0xea, 0x62, 0x42, 0x1a, 0x7b, 0x9a, 0xeb, 0xa9, 0x5a,
+	0xc3, 0x43, 0x2e, 0x57, 0x78, 0xa0, 0x45, 0x2b, 0xa4, 0x44, 0xbc, 0x02, 0xa2, 0x6e, 0x5b, 0x9e,
+	0x69, 0x75, 0x34, 0xcf, 0xb4, 0x2d, 0xd5, 0xb3, 0xf7, 0x90, 0xc5, 0x15, 0xff, 0x48, 0xb4, 0xe6,
+	0x29, 0xa9, 0x10, 0xcf, 0x50, 0xfb, 0x62, 0xb6, 0x4d, 0x42, 0x8d, 0x29, 0x7f, 0x58, 0x20, 0x9e,
+	0x80, 0x51, 0xc7, 0x45, 0x0d, 0xf3, 0x05, 0xd7, 0x78, 0xfe, 0x95, 0x35, 0x1e, 0xd5, 0xac, 0xf1,
+	0x20, 0x33, 0x5c, 0xf3, 0xd4, 0x5d, 0x64, 0x36, 0x77, 0x3d, 0xae, 0xf5, 0x55, 0xcd, 0x7b, 0x9b,
+	0x7e, 0xcb, 0xbf, 0x55, 0x81, 0xf3, 0x85, 0xd2, 0xe4, 0xc3, 0x76, 0x1b, 0xc6, 0x98, 0xc5, 0xf7,
+	0x87, 0xad, 0x6c, 0x81, 0xf0, 0xc1, 0x49, 0xf3, 0x7b, 0xa8, 0xab, 0x32, 0x2d, 0x18, 0xa2, 0x92,
+	0xac, 0xee, 0xa1, 0xee, 0x1a, 0x9d, 0xd7, 0x05, 0x52, 0x5e, 0x80, 0x09, 0x13, 0xab, 0x9e, 0xdb,
+	0xb1, 0x74, 0xcd, 0x43, 0x06, 0x15, 0x73, 0x55, 0x19, 0x37, 0xf1, 0x53, 0xbf, 0x48, 0x7c, 0x03,
+	0x4e, 0x5a, 0xe8, 0x85, 0xa7, 0xe6, 0x0a, 0xfb, 0x38, 0xa9, 0x5e, 0x4b, 0x09, 0x5c, 0x84, 0x61,
+	0x3a, 0x29, 0x98, 0xac, 0xe9, 0xef, 0x5c, 0x31, 0xc7, 0x06, 0xa7, 0x9a, 0x1c, 0x9c, 0xcb, 0x30,
+	0xcd, 0xb6, 0xda, 0x2a, 0x03, 0x47, 0x78, 0xb6, 0x36, 0x5f, 0x59, 0xac, 0x29, 0x53, 0xac, 0x78,
+	0x9b, 0x97, 0xe6, 0x4c, 0x09, 0xc8, 0x99, 0x12, 0xf2, 0xe7, 0x05, 0x98, 0xe7, 0x4a, 0xc4, 0x06,
+	0x63, 0xa0, 0x29, 0x1e, 0x99, 0x22, 0x8e, 0x6b, 0xee, 0x6b, 0x1e, 0x4a, 0xa8, 0xec, 0x36, 0x2b,
+	0x8d, 0x4f, 0x91, 0x4a, 0x62, 0x8a, 0x7c, 0x1a, 0x16, 0x0a, 0x58, 0xe1, 0xf3, 0x23, 0xdc, 0x07,
+	0x08, 0xfd, 0xec, 0x03, 0xe4, 0x06, 0xcc, 0x65, 0x12, 0xdf, 0x5a, 0xf7, 0x7b, 0x79, 0x1a, 0x6a,
+	0xfe, 0xc2, 0xce, 0xac, 0x52, 0x45, 0xa9, 0xf2, 0x65, 0xdb, 0xe8, 0xb9, 0x87, 0xf2, 0xa7, 0xe0,
+	0x5c, 0x6e, 0x3b, 0x07, 0xec, 0xc2, 0x9f, 0x0a, 0xb0, 0xec, 0xab, 0xd0, 0x3a, 0x5d, 0xf5, 0x8d,
+	0x50, 0x93, 0xc8, 0xd6, 0xf3, 0xdd, 0x4e, 0xbb, 0x8e, 0x5c, 0x45, 0xb3, 0x9a, 0xc1, 0xd0, 0xbd,
+	0x06, 0x22, 0x33, 0x21, 0x75, 0x02, 0xa0, 0x5a, 0x14, 0x82, 0xb6, 0x3b, 0xac, 0xcc, 0xd0, 0x9a,
+	0x08, 0x26, 0x59, 0x30, 0x91, 0x65, 0xc4, 0x61, 0x99, 0x26, 0x4d, 0x21, 0xcb, 0x88, 0x42, 0x66,
+	0xc8, 0xa3, 0x92, 0x29, 0x8f, 0x2f, 0x0a, 0xf0, 0x7a, 0xef, 0x4c, 0x1f, 0xd8, 0x08, 0xf4, 0xdc,
+	0x03, 0x79, 0x37, 0x98, 0x10, 0x91, 0xd5, 0x83, 0x1a, 0x8b, 0xc3, 0x5e, 0xa7, 0x6e, 0xc1, 0xb9,
+	0xdc, 0x96, 0x78, 0x87, 0x8f, 0xc1, 0x48, 0xb8, 0x7a, 0x57, 0x14, 0xf6, 0x21, 0x7f, 0x08, 0x0b,
+	0xbe, 0xe8, 0x36, 0x5e, 0x38, 0xa6, 0x8b, 0x0c, 0x8e, 0xbc, 0xda, 0xdd, 0x71, 0x22, 0xd3, 0x96,
+	0x6f, 0xeb, 0x34, 0x1f, 0xbd, 0xca, 0x0a, 0x56, 0x3c, 0x51, 0x86, 0x49, 0xc7, 0x35, 0xdb, 0x9a,
+	0xdb, 0x55, 0xb1, 0xe3, 0x2f, 0x3f, 0x93, 0xca, 0x38, 0x2f, 0xdc, 0x71, 0xb6, 0x0c, 0xd2, 0x36,
+	0xb5, 0x32, 0x5c, 0x1f, 0xd9, 0x87, 0xfc, 0x93, 0x20, 0x17, 0xb5, 0x1d, 0x0e, 0x54, 0x7c, 0x91,
+	0x2d, 0x9b, 0xcb, 0xc1, 0xea, 0xfa, 0x35, 0x01, 0x66, 0xb9, 0x54, 0xd8, 0x18, 0x92, 0xfd, 0x4c,
+	0xc4, 0xe0, 0xf0, 0x13, 0x5a, 0xd4, 0xe0, 0xb0, 0x22, 0x6a, 0x70, 0x12, 0x16, 0x69, 0xa8, 0x17,
+	0x8b, 0x54, 0x29, 0xb7, 0x48, 0xc3, 0x09, 0x8b, 0xb4, 0x03, 0xa7, 0x32, 0x78, 0x0c, 0xd5, 0x78,
+	0x90, 0x93, 0x8c, 0xdc, 0x0e, 0xcc, 0x1c, 0xdf, 0x8b, 0xfd, 0x48, 0x4d, 0xae, 0xbc, 0x07, 0x72,
+	0x51, 0x73, 0xbc, 0x33, 0xa9, 0x7d, 0x9f, 0x30, 0xd0, 0xbe, 0xaf, 0x09, 0xe7, 0xb2, 0x1b, 0x3b,
+	0x6c, 0x33, 0x6b, 0xc2, 0x7c, 0x7e, 0x43, 0x87, 0xdb, 0x27, 0x23, 0x98, 0xa8, 0xac, 0x85, 0xc4,
+	0x44, 0x3d, 0xa4, 0x61, 0xfa, 0xaa, 0x00, 0xa7, 0x32, 0x9a, 0xe1, 0x5d, 0x79, 0x90, 0x58, 0x32,
+	0x7a, 0xdc, 0xcb, 0x72, 0xa4, 0xc3, 0xda, 0xd5, 0x5f, 0x87, 0x33, 0x9c, 0xc5, 0x0d, 0xcb, 0x70,
+	0x6c, 0x93, 0x48, 0x7d, 0xc7, 0x09, 0x87, 0xf6, 0x28, 0x8c, 0x30, 0x2b, 0x23, 0x50, 0x2b, 0x33,
+	0x8c, 0x9d, 0x2d, 0x43, 0xbe, 0x07, 0x67, 0x73, 0x90, 0x78, 0xdf, 0x24, 0xa8, 0x22, 0x5e, 0xc3,
+	0x05, 0x18, 0x7c, 0xcb, 0x3f, 0x13, 0x20, 0xf3, 0x1e, 0x21, 0xcd, 0xf8, 0x78, 0xc7, 0x0e, 0x07,
+	0xe0, 0xc0, 0xa7, 0xf1, 0xb3, 0x00, 0x5d, 0xa4, 0xb9, 0x6a, 0xdb, 0xb6, 0xbc, 0x5d, 0x7f, 0x77,
+	0x4e, 0x4a, 0x1e, 0x93, 0x02, 0xf9, 0xdf, 0x2a, 0x30, 0x97, 0xc7, 0x01, 0xe7, 0xff, 0x1a, 0x54,
+	0x90, 0xeb, 0x06, 0x93, 0x8b, 0x38, 0x68, 0xf9, 0x60, 0x84, 0x3e, 0xdc, 0x25, 0x42, 0x63, 0x83,
+	0xfc, 0x54, 0x08, 0x30, 0x59, 0x96, 0xf5, 0x5d, 0xcd, 0x6d, 0x22, 0x43, 0xfd, 0x80, 0x10, 0x63,
+	0x47, 0x58, 0xb6, 0x50, 0xcd, 0xf0, 0x1a, 0xda, 0x0a, 0x3d, 0xc7, 0xbe, 0x0a, 0x22, 0x76, 0xd4,
+	0x86, 0x8b, 0x50, 0x14, 0x9a, 0x6d, 0x63, 0xa7, 0xb1, 0xf3, 0xc8, 0x45, 0x28, 0x04, 0x3e, 0x0f,
+	0x93, 0xba, 0x6d, 0xe1, 0x4e, 0x1b, 0x19, 0x0c, 0x6e, 0x98, 0xc2, 0x4d, 0xf8, 0x85, 0x14, 0xe8,
+	0x26, 0x9c, 0x8c, 0x90, 0xe3, 0x55, 0x0c, 0x7c, 0x84, 0x82, 0x1f, 0x6b, 0xf8, 0x44, 0xd7, 0x58,
+	0x25, 0x45, 0xbb, 0x0b, 0x12, 0x76, 0x98, 0xa8, 0x5a, 0xdd, 0x14, 0x43, 0xa3, 0x14, 0xf3, 0x04,
+	0x76, 0x1e, 0x33, 0x80, 0x38, 0x5f, 0xeb, 0x70, 0x2e, 0x03, 0x31, 0xd6, 0xf4, 0x18, 0x25, 0x70,
+	0xba, 0x9d, 0x40, 0x8f, 0x72, 0xf0, 0x1a, 0x88, 0x9e, 0xed, 0x69, 0xad, 0x38, 0x62, 0x95, 0x09,
+	0x8e, 0xd6, 0x44, 0xa1, 0x5f, 0x81, 0x23, 0x2e, 0x6a, 0x93, 0xd3, 0x7f, 0x84, 0xcd, 0x1a, 0x93,
+	0x1b, 0xab, 0x08, 0xf8, 0x93, 0xd7, 0x40, 0xce, 0x1e, 0xe8, 0xe4, 0x9e, 0x20, 0x32, 0x5d, 0x84,
+	0xe4, 0x74, 0xb1, 0xe1, 0x7c, 0x21, 0x91, 0x03, 0x4c, 0x99, 0x60, 0x8b, 0x30, 0x14, 0xdd, 0x22,
+	0xb4, 0x61, 0xce, 0x5f, 0xa6, 0x73, 0x34, 0xa4, 0x98, 0x63, 0x72, 0x1c, 0xb1, 0x1b, 0x0d, 0xcc,
+	0xfd, 0x6a, 0x93, 0x0a, 0xff, 0x8a, 0xef, 0x0a, 0x26, 0xfd, 0x5d, 0xc1, 0xaf, 0x54, 0xe0, 0x78,
+	0xaa, 0x1d, 0x62, 0x1b, 0xca, 0x2d, 0x61, 0xcc, 0xee, 0xf3, 0xe3, 0x59, 0x60, 0xf7, 0x7f, 0x3c,
+	0xc3, 0x07, 0x9b, 0xe1, 0xf2, 0xef, 0x0a, 0x70, 0x2e, 0x77, 0x02, 0x1c, 0x60, 0xb6, 0x6d, 0xc0,
+	0xa8, 0x8b, 0x70, 0xa7, 0x45, 0xa6, 0x05, 0xd9, 0xd7, 0x5d, 0x29, 0xd9, 0xd7, 0xc5, 0x67, 0x83,
+	0xc2, 0x91, 0xe5, 0xd5, 0x40, 0x1f, 0x3e, 0xa6, 0x79, 0x28, 0x77, 0x8e, 0xa6, 0xf6, 0x04, 0x91,
+	0xb9, 0x21, 0x7f, 0x59, 0x80, 0x0b, 0xc5, 0x44, 0x0e, 0xd0, 0xcf, 0x87, 0x30, 0x42, 0x87, 0x89,
+	0xaf, 0x88, 0x8b, 0x49, 0x2c, 0x7a, 0xb5, 0x46, 0x70, 0x58, 0xa3, 0xb4, 0x41, 0xba, 0x86, 0x30,
+	0x34, 0xf9, 0x07, 0x99, 0xf2, 0xe7, 0x52, 0x38, 0xac, 0x35, 0x2a, 0x38, 0xc4, 0x79, 0x66, 0x1b,
+	0x61, 0x4f, 0x6b, 0x3b, 0x6a, 0x07, 0x73, 0x3b, 0xc0, 0x0e, 0x71, 0x4f, 0xfd, 0x8a, 0xf7, 0x82,
+	0x23, 0x50, 0x0c, 0x96, 0x6d, 0xed, 0xc9, 0x11, 0x28, 0x0a, 0x79, 0x01, 0xa6, 0x88, 0x53, 0x84,
+	0xed, 0x09, 0xc8, 0x69, 0x89, 0x6f, 0x80, 0x27, 0xda, 0xda, 0x0b, 0xd6, 0x85, 0x77, 0x3b, 0x6d,
+	0xf9, 0xcf, 0x04, 0x80, 0xb0, 0x53, 0xe5, 0x7b, 0xf3, 0xd3, 0x50, 0xe3, 0x00, 0xa1, 0xa2, 0xb3,
+	0x02, 0xb6, 0xc1, 0xf3, 0xcf, 0x54, 0xbe, 0x77, 0xb5, 0x42, 0x29, 0x4c, 0xf1, 0xe2, 0x15, 0x56,
+	0x4a, 0xbc, 0x32, 0xb1, 0x1e, 0x30, 0xce, 0xc6, 0xbd, 0x08, 0xfb, 0xa7, 0xa1, 0xe6, 0x22, 0xcd,
+	0x88, 0x2a, 0x75, 0x95, 0x14, 0x50, 0xc5, 0xf8, 0x1e, 0x77, 0x6c, 0x64, 0x0f, 0xcc, 0x01, 0x66,
+	0xcc, 0x3b, 0x30, 0x41, 0x5b, 0x65, 0x52, 0xc3, 0x5c, 0x3f, 0xe4, 0x3c, 0xfd, 0x08, 0x5b, 0x5d,
+	0x1d, 0xfe, 0xd6, 0xf7, 0xcf, 0x09, 0xca, 0xb8, 0x1b, 0x94, 0x60, 0x62, 0xa5, 0xa8, 0x63, 0x29,
+	0x63, 0x78, 0xd9, 0x90, 0x1d, 0x23, 0xd5, 0x3b, 0x89, 0x21, 0x96, 0x1f, 0x30, 0xab, 0xff, 0x71,
+	0x72, 0xfd, 0xf9, 0x9e, 0xd3, 0xb2, 0x35, 0x63, 0xdb, 0xb5, 0x9b, 0x44, 0x6e, 0x11, 0x8d, 0x0a,
+	0x07, 0x41, 0x88, 0x0f, 0x82, 0xfc, 0x75, 0x3e, 0x69, 0x33, 0xf1, 0x0f, 0xb4, 0xab, 0x19, 0xc1,
+	0x9e, 0xbf, 0xc5, 0x9d, 0xba, 0x76, 0x62, 0x89, 0xdd, 0x8d, 0x32, 0xb4, 0xa7, 0x1a, 0xde, 0xdb,
+	0x21, 0xb5, 0x0a, 0x03, 0x22, 0x53, 0x01, 0xb9, 0xae, 0x6a, 0x20, 0xac, 0xbb, 0xa6, 0x43, 0x5c,
+	0x52, 0xfe, 0x54, 0x40, 0xae, 0xbb, 0x1e, 0x96, 0xca, 0x1b, 0x70, 0x29, 0xe0, 0x56, 0x41, 0xb8,
+	0xd3, 0x26, 0xfe, 0x74, 0xc6, 0xf6, 0x0e, 0x6a, 0xb6, 0x91, 0xe5, 0xf5, 0xd4, 0xeb, 0x9f, 0x13,
+	0xe0, 0x72, 0x29, 0x9d, 0x03, 0xf4, 0xfe, 0x3c, 0x4c, 0x62, 0x46, 0x26, 0xe2, 0x83, 0x9c, 0x54,
+	0x26, 0x78, 0x21, 0xdd, 0x01, 0xc8, 0x5f, 0x1e, 0x82, 0x11, 0x7a, 0x95, 0x21, 0x5e, 0x87, 0x11,
+	0x7a, 0xcd, 0xc1, 0x1b, 0x39, 0x9b, 0x65, 0x0f, 0x28, 0x24, 0x33, 0x37, 0x14, 0x36, 0x76, 0xbf,
+	0x33, 0x94, 0xb8, 0xdf, 0x89, 0x39, 0x02, 0x2a, 0x09, 0x47, 0xc0, 0x39, 0x18, 0xe7, 0x95, 0xf4,
+	0x82, 0x81, 0x69, 0x13, 0xf0, 0x9b, 0x1f, 0xb3, 0x9d, 0xb8, 0x9e, 0x18, 0x29, 0xbe, 0x9e, 0x18,
+	0x4d, 0xdd, 0xe8, 0xbc, 0x02, 0x47, 0x98, 0xb3, 0x45, 0xb5, 0x1b, 0x6a, 0x1b, 0x91, 0x5f, 0x98,
+	0x2e, 0x76, 0x15, 0x65, 0x9a, 0x55, 0x3c, 0x69, 0x3c, 0x66, 0xc5, 0xd1, 0xfb, 0xaf, 0x6a, 0xec,
+	0xfe, 0x4b, 0xfe, 0xcb, 0x21, 0x18, 0xa7, 0x5d, 0x66, 0xa0, 0x83, 0x89, 0xa8, 0xc4, 0xdd, 0x1e,
+	0x95, 0x60, 0xa5, 0x48, 0x82, 0xc3, 0xc5, 0x12, 0x1c, 0x29, 0x96, 0xe0, 0x68, 0xb1, 0x04, 0xc7,
+	0x52, 0x12, 0xcc, 0x95, 0x0a, 0xd5, 0x13, 0xe2, 0x81, 0xe1, 0xfe, 0xdc, 0xf0, 0x4a, 0x6d, 0x2a,
+	0x2c, 0xa6, 0x77, 0x44, 0xdf, 0x14, 0xe0, 0x24, 0x5f, 0x28, 0xa9, 0x54, 0x88, 0xe9, 0xf3, 0x35,
+	0xc3, 0xf7, 0x44, 0x0b, 0x99, 0x9e, 0xe8, 0xa1, 0x98, 0x27, 0x9a, 0x5c, 0x3b, 0xd0, 0xd8, 0x0d,
+	0x95, 0x4c, 0x79, 0x2e, 0x25, 0x60, 0x45, 0x4f, 0xbb, 0x0e, 0x0a, 0xf7, 0x86, 0xc3, 0x11, 0x8f,
+	0x51, 0x64, 0x27, 0xc9, 0x64, 0xc3, 0xbf, 0xb2, 0xfc, 0x64, 0xa3, 0x99, 0x7e, 0xb2, 0x26, 0xcc,
+	0xa6, 0xd9, 0xe7, 0x0a, 0x79, 0x13, 0x46, 0xe9, 0xf0, 0xfa, 0x7e, 0xa6, 0xb3, 0x79, 0xf6, 0x96,
+	0xa2, 0x2a, 0x1c, 0x38, 0x67, 0xd3, 0x8c, 0xe0, 0x74, 0x7c, 0x69, 0xc0, 0xab, 0xdd, 0xad, 0xf5,
+	0xe8, 0xfd, 0x54, 0xb0, 0x1b, 0x61, 0xed, 0x0d, 0x2b, 0x35, 0x7f, 0x3b, 0x82, 0x7b, 0xf7, 0xfb,
+	0xfd, 0x93, 0x00, 0x67, 0xb2, 0xdb, 0xe1, 0x9d, 0xfa, 0x74, 0xd2, 0x7b, 0xb6, 0x92, 0xdb, 0xab,
+	0x02, 0x32, 0x7c, 0xd7, 0x80, 0x37, 0x2c, 0xcf, 0xed, 0x06, 0x0e, 0x36, 0xe9, 0x7d, 0x98, 0x88,
+	0x56, 0x88, 0x33, 0x50, 0xd9, 0x43, 0x5d, 0x6e, 0x15, 0xc9, 0x4f, 0xf1, 0x06, 0x8c, 0xec, 0x6b,
+	0xad, 0x0e, 0xea, 0xf1, 0x46, 0x9d, 0x01, 0xdf, 0x1d, 0xba, 0x2d, 0x44, 0x05, 0x18, 0x38, 0x73,
+	0xe3, 0x02, 0x0c, 0xcc, 0x70, 0x20, 0x40, 0xdf, 0x0e, 0x0f, 0x28, 0xc0, 0x78, 0x3b, 0xa1, 0x00,
+	0xe3, 0x7e, 0xe2, 0x52, 0x01, 0x66, 0x91, 0xe1, 0x0e, 0x3a, 0x5f, 0x80, 0x9c, 0x22, 0x11, 0x60,
+	0xb4, 0xe2, 0x00, 0x02, 0x64, 0x64, 0xa2, 0x02, 0xfc, 0x85, 0x21, 0xb6, 0x02, 0x3f, 0x43, 0xae,
+	0xd9, 0xe8, 0x6e, 0x07, 0xf1, 0x54, 0x84, 0x2f, 0x5f, 0x8a, 0x37, 0x22, 0x96, 0x8a, 0xaa, 0xed,
+	0xea, 0xec, 0x77, 0xbf, 0x71, 0xe5, 0x18, 0x8f, 0xe9, 0xe2, 0xfb, 0xa4, 0x1d, 0xcf, 0x35, 0xad,
+	0x66, 0xc4, 0x86, 0x3d, 0x82, 0x49, 0x17, 0x45, 0xd5, 0x97, 0xad, 0xc5, 0x0b, 0x51, 0xdb, 0xe9,
+	0x03, 0x2c, 0x29, 0x28, 0xd4, 0x6a, 0x65, 0xc2, 0x8d, 0x7c, 0x11, 0x23, 0x10, 0xd0, 0x31, 0x0d,
+	0x7e, 0x14, 0x03, 0xbf, 0x68, 0xcb, 0x10, 0x57, 0x61, 0x5c, 0xd3, 0x99, 0x49, 0x22, 0xcd, 0x0c,
+	0xa7, 0x9b, 0x09, 0xc3, 0xc4, 0x96, 0x56, 0x28, 0x24, 0x6d, 0x06, 0xb4, 0xe0, 0xb7, 0xfc, 0x29,
+	0x98, 0xcf, 0x97, 0x42, 0xa8, 0xf9, 0xa8, 0xd1, 0xf0, 0xdd, 0xac, 0x53, 0xd7, 0xce, 0xe6, 0x34,
+	0xb1, 0x41, 0x81, 0x14, 0x0e, 0x2c, 0xbf, 0x05, 0x2f, 0xfb, 0x63, 0x9e, 0x8a, 0x20, 0x30, 0x11,
+	0xee, 0xc1, 0x71, 0xf5, 0x35, 0x01, 0x5e, 0xe9, 0x85, 0x04, 0xe7, 0xd3, 0x83, 0xb3, 0x4d, 0x1a,
+	0xb0, 0xa0, 0xf2, 0x20, 0x06, 0x95, 0xda, 0x20, 0xb5, 0xc1, 0xc1, 0xf9, 0x0c, 0x1d, 0x20, 0xdc,
+	0x41, 0x6a, 0x66, 0xd7, 0x98, 0x08, 0xcb, 0x6f, 0xc1, 0x65, 0xdf, 0x66, 0xa6, 0x80, 0x56, 0xbb,
+	0x9b, 0xfb, 0xcd, 0xb0, 0x93, 0xc7, 0x61, 0xb4, 0xb9, 0xdf, 0x0c, 0x7b, 0x39, 0xd2, 0xdc, 0x6f,
+	0x6e, 0x19, 0xe4, 0x06, 0x70, 0xb1, 0x9c, 0x04, 0xef, 0xe4, 0x67, 0xe0, 0x58, 0x56, 0x27, 0x67,
+	0x85, 0xfe, 0xc3, 0x6b, 0xc4, 0x74, 0xaf, 0xe4, 0x3b, 0x81, 0x57, 0x37, 0x43, 0x0c, 0x61, 0x37,
+	0xf6, 0x9b, 0x8d, 0x48, 0x37, 0xf6, 0x9b, 0x8d, 0x2d, 0x43, 0xde, 0x85, 0x85, 0x02, 0x54, 0xce,
+	0x3e, 0x0f, 0x3c, 0x11, 0x0e, 0x12, 0x78, 0x22, 0x3f, 0x0b, 0x98, 0xcc, 0xe8, 0x55, 0x0f, 0x07,
+	0x5a, 0xd2, 0x83, 0x16, 0x1b, 0x88, 0x21, 0xee, 0x5b, 0xa1, 0x03, 0x51, 0x87, 0x85, 0x02, 0xba,
+	0x81, 0x23, 0x98, 0x86, 0x33, 0x09, 0x03, 0x86, 0x33, 0xed, 0xa5, 0x0c, 0xf7, 0x96, 0xb5, 0xf9,
+	0x6c, 0xb3, 0x78, 0x8a, 0x24, 0xe3, 0x10, 0xb8, 0x2d, 0x88, 0xc4, 0x21, 0xc4, 0x36, 0x04, 0x81,
+	0xb3, 0x48, 0x85, 0x33, 0xd9, 0x8d, 0x85, 0x11, 0x1a, 0x71, 0xeb, 0x7d, 0xb1, 0xd8, 0x80, 0xf2,
+	0x10, 0xb2, 0xc0, 0x42, 0xcb, 0xbf, 0xce, 0x3d, 0x03, 0xc9, 0x16, 0x56, 0x2c, 0xc3, 0x3f, 0xf2,
+	0x15, 0xf6, 0xab, 0xd0, 0x25, 0x35, 0x60, 0xa7, 0x77, 0xe1, 0x62, 0x09, 0x4b, 0x87, 0xd5, 0xfb,
+	0xdf, 0x13, 0x42, 0x13, 0x17, 0x2c, 0x6b, 0xd1, 0xa6, 0x1e, 0xd9, 0xee, 0xe6, 0x9a, 0x2f, 0x82,
+	0x33, 0x00, 0x06, 0xf6, 0xd4, 0x98, 0x18, 0xaa, 0x06, 0xf6, 0x36, 0x7f, 0x64, 0x92, 0x68, 0xc3,
+	0x2b, 0xbd, 0xb0, 0x77, 0x58, 0xe2, 0x78, 0x1a, 0x5e, 0x58, 0x3e, 0x36, 0x9b, 0xae, 0xe6, 0x21,
+	0xd6, 0xcc, 0x06, 0x0d, 0xef, 0xf5, 0xc5, 0x70, 0x0a, 0xaa, 0xec, 0x6e, 0x38, 0xd0, 0xcb, 0x31,
+	0xfa, 0xbd, 0x65, 0x84, 0x8b, 0xc0, 0x50, 0x64, 0x11, 0xf8, 0xc7, 0x21, 0x38, 0x99, 0x43, 0x92,
+	0xf8, 0x8e, 0x68, 0xec, 0x30, 0xd7, 0xc6, 0xc5, 0xac, 0xe3, 0x09, 0x05, 0x65, 0xc8, 0x64, 0x61,
+	0xe3, 0x3b, 0x29, 0x8a, 0x26, 0x7e, 0x1c, 0x26, 0x74, 0xcd, 0xd2, 0x51, 0x4b, 0x65, 0x64, 0xd8,
+	0x2e, 0x62, 0x29, 0x97, 0xcc, 0x1a, 0x05, 0x4e, 0x12, 0x1b, 0x67, 0x34, 0x28, 0x84, 0xf8, 0x09,
+	0x20, 0xf1, 0x20, 0x0e, 0x0d, 0x13, 0x64, 0x44, 0x59, 0xe0, 0xe3, 0xeb, 0xf9, 0x44, 0x39, 0x78,
+	0x92, 0xec, 0xa4, 0x4f, 0x87, 0x11, 0x7e, 0x42, 0xbc, 0x1e, 0x74, 0x4b, 0xc7, 0xc8, 0x0e, 0x53,
+	0xb2, 0xaf, 0xe5, 0x92, 0x55, 0x28, 0x70, 0x4c, 0x6a, 0xc4, 0xf3, 0x41, 0x0a, 0x69, 0xbd, 0x6c,
+	0x85, 0xe1, 0x40, 0x99, 0xc3, 0xc5, 0xa7, 0xc5, 0x26, 0x8c, 0xd2, 0x06, 0xfd, 0x59, 0xb1, 0x9c,
+	0x37, 0x2b, 0xf2, 0x08, 0x71, 0x74, 0x19, 0xc1, 0x6b, 0x7e, 0x7b, 0x39, 0x5d, 0xee, 0x79, 0xa2,
+	0x48, 0x50, 0xc3, 0xae, 0x1e, 0xbb, 0x50, 0x1f, 0xc3, 0xae, 0x4e, 0x2e, 0xd3, 0xe5, 0x5f, 0x12,
+	0xe0, 0x4a, 0x8f, 0xed, 0xf0, 0x1e, 0x7e, 0x8a, 0x46, 0xf6, 0x44, 0x86, 0xcc, 0xef, 0x6a, 0xff,
+	0x63, 0x36, 0x15, 0x1b, 0x33, 0x2c, 0xbf, 0x1b, 0x1a, 0xe0, 0x9d, 0xe7, 0x9a, 0xf3, 0xa4, 0x73,
+	0x40, 0x65, 0xf8, 0x6f, 0x01, 0x8e, 0xa4, 0x88, 0x91, 0xbb, 0xc9, 0x80, 0x6f, 0x76, 0x37, 0x99,
+	0xb7, 0x2a, 0x51, 0x04, 0x8e, 0xec, 0x0f, 0x8c, 0xb8, 0x0d, 0x93, 0x51, 0x2d, 0xc0, 0xb3, 0x43,
+	0x25, 0x6b, 0x5b, 0x44, 0x17, 0x7c, 0x5a, 0x13, 0x11, 0x1d, 0xc0, 0xe2, 0xb3, 0xb4, 0x44, 0x99,
+	0x16, 0x5c, 0x29, 0xa1, 0xc9, 0x91, 0x7c, 0xaa, 0x49, 0x71, 0xd6, 0xd9, 0x65, 0x64, 0x86, 0x38,
+	0xf9, 0x50, 0xae, 0x24, 0x26, 0xeb, 0xcb, 0x45, 0x93, 0x35, 0x4e, 0xc2, 0x9f, 0xa6, 0x8f, 0xc2,
+	0x10, 0x9f, 0xf4, 0x1a, 0x4e, 0xb6, 0x9c, 0x48, 0xb7, 0x2d, 0x83, 0x84, 0x6e, 0x6c, 0x17, 0x6e,
+	0x5e, 0x9f, 0xc3, 0xeb, 0xbd, 0xd3, 0x09, 0x76, 0x47, 0xf1, 0x33, 0x76, 0x5f, 0xdb, 0x0b, 0x8e,
+	0x2a, 0x6f, 0x85, 0x56, 0x3f, 0xb3, 0xe1, 0xf8, 0xc2, 0x5c, 0xe8, 0xf8, 0x77, 0xe1, 0xd5, 0x9e,
+	0x48, 0x1d, 0x26, 0xfb, 0x8f, 0xc3, 0x0d, 0xd2, 0x8e, 0xb3, 0xf1, 0xc2, 0x3c, 0xa0, 0xc6, 0x7c,
+	0x53, 0x80, 0x99, 0x24, 0x2d, 0x71, 0x33, 0xbe, 0x6e, 0x5c, 0x2d, 0xd1, 0x17, 0xa6, 0xf9, 0xdb,
+	0xae, 0xbd, 0x6f, 0x1a, 0xc8, 0x25, 0x74, 0xfc, 0x05, 0xe4, 0xb3, 0x29, 0x6b, 0xcf, 0x74, 0xe7,
+	0x4e, 0x8f, 0xf3, 0x3c, 0x83, 0x72, 0xdc, 0xec, 0xcb, 0x9f, 0x8d, 0x58, 0x90, 0x98, 0x38, 0xb8,
+	0xcc, 0xdf, 0x4a, 0xe8, 0xfe, 0x62, 0xe1, 0x8c, 0x8f, 0x52, 0xf0, 0x27, 0xfc, 0x0a, 0x1c, 0xe3,
+	0xbb, 0xde, 0x9d, 0x6d, 0xea, 0xc6, 0xe3, 0x92, 0x7e, 0x19, 0x66, 0xfc, 0x33, 0xad, 0x1a, 0x8f,
+	0xc3, 0x9e, 0xf6, 0xcb, 0xf9, 0x11, 0x58, 0xae, 0xc3, 0xf1, 0x04, 0x09, 0xce, 0xdd, 0x16, 0xcc,
+	0xf8, 0x21, 0xe2, 0x0e, 0xef, 0x64, 0xe6, 0xed, 0x8b, 0xb3, 0x94, 0x10, 0x85, 0x32, 0x8d, 0xe3,
+	0x05, 0xf2, 0x6d, 0xb6, 0x39, 0xdf, 0x0e, 0xe2, 0xa6, 0x2c, 0xdd, 0x6e, 0x23, 0x7f, 0x13, 0x52,
+	0xa4, 0x89, 0x7f, 0x21, 0x80, 0x5c, 0x84, 0xca, 0x79, 0x7d, 0x15, 0x8e, 0xe8, 0x1d, 0xd7, 0x45,
+	0x56, 0xe4, 0x1a, 0x80, 0x87, 0xc7, 0xcc, 0xf0, 0x8a, 0xe0, 0x06, 0x40, 0xdc, 0x85, 0x53, 0xd1,
+	0xb0, 0x2e, 0x4a, 0x50, 0x35, 0x18, 0xc5, 0xb2, 0x0b, 0xbb, 0x4c, 0x3e, 0x94, 0x13, 0x4e, 0x26,
+	0x7b, 0xf2, 0xb7, 0x05, 0x38, 0x9e, 0x89, 0x91, 0x73, 0x0e, 0x3b, 0xa4, 0x50, 0x13, 0xf1, 0x29,
+	0x8c, 0xb2, 0x6e, 0x51, 0xd3, 0x3d, 0xb1, 0x7a, 0xff, 0x5b, 0xdf, 0x3f, 0xf7, 0xd2, 0xf7, 0xbe,
+	0x7f, 0xee, 0x52, 0xd3, 0xf4, 0x76, 0x3b, 0xf5, 0x25, 0xdd, 0x6e, 0xf3, 0xec, 0x36, 0xfe, 0xe7,
+	0x0a, 0x36, 0xf6, 0x78, 0x92, 0xd6, 0x96, 0xe5, 0x7d, 0xf7, 0x1b, 0x57, 0x80, 0x95, 0x93, 0x2f,
+	0x85, 0xd3, 0x92, 0xef, 0xb2, 0x4d, 0x47, 0x68, 0xfc, 0xfa, 0x18, 0xc7, 0xbf, 0xe3, 0x87, 0x8d,
+	0x7c, 0xe4, 0x41, 0x46, 0xd2, 0x82, 0xd3, 0xd8, 0x27, 0x98, 0x3b, 0x96, 0xb9, 0x9b, 0x9e, 0x1c,
+	0x5e, 0x94, 0x59, 0x9c, 0xc3, 0xa4, 0xfc, 0x0f, 0x02, 0x9c, 0xcc, 0xc1, 0xca, 0x3b, 0x25, 0xfd,
+	0x9f, 0x1e, 0xd1, 0xbf, 0xaf, 0xc0, 0x28, 0xcb, 0xe1, 0x10, 0x55, 0x38, 0x9e, 0xd4, 0xf6, 0xe8,
+	0x85, 0xeb, 0xab, 0xb9, 0x42, 0x8c, 0xab, 0x3a, 0xb5, 0x20, 0x47, 0x71, 0xba, 0x50, 0xdc, 0x81,
+	0x23, 0xcc, 0xf8, 0xe3, 0xae, 0xa5, 0xfb, 0xc4, 0x99, 0x30, 0x2e, 0xe7, 0xfa, 0x4e, 0x09, 0xc2,
+	0x0e, 0x85, 0xa7, 0x84, 0xa7, 0xeb, 0xf1, 0x02, 0xf1, 0x2d, 0x00, 0x96, 0x9f, 0x42, 0xa9, 0xb1,
+	0x7d, 0xca, 0x42, 0x1e, 0x35, 0x9a, 0xb2, 0x42, 0xe9, 0xd4, 0x74, 0xff, 0xa7, 0xf8, 0x08, 0x26,
+	0xda, 0x9a, 0xa5, 0x35, 0x7d, 0x8e, 0xd8, 0xd6, 0xfc, 0x7c, 0x1e, 0x8d, 0xc7, 0x0c, 0x96, 0x52,
+	0x19, 0x6f, 0x87, 0x1f, 0xe2, 0x16, 0x4c, 0xa2, 0x17, 0x48, 0xef, 0x78, 0x36, 0x27, 0x34, 0x42,
+	0x09, 0x5d, 0xc8, 0x23, 0xb4, 0xc1, 0x81, 0x29, 0xa5, 0x09, 0x14, 0xf9, 0x12, 0x6f, 0xc1, 0x58,
+	0x53, 0x67, 0x44, 0x46, 0x8b, 0x5d, 0xa3, 0x9b, 0x6b, 0x14, 0x7d, 0xb4, 0xa9, 0x93, 0xbf, 0xf2,
+	0x6f, 0x56, 0x60, 0x3a, 0x21, 0x32, 0xf1, 0x12, 0x4c, 0xd7, 0x31, 0x8f, 0xe8, 0xe5, 0x71, 0x9a,
+	0x4c, 0x9b, 0x26, 0xeb, 0x98, 0xc2, 0xb2, 0x60, 0x4d, 0x12, 0xeb, 0x1a, 0xc2, 0x91, 0xc4, 0x25,
+	0x76, 0xab, 0x31, 0xee, 0x43, 0xf1, 0xec, 0xa6, 0x3a, 0x56, 0xa3, 0x37, 0x31, 0xec, 0xa2, 0x6c,
+	0xa2, 0x8e, 0xdf, 0x0b, 0xef, 0x62, 0x16, 0x61, 0xa6, 0x8e, 0xd5, 0x76, 0x17, 0x7f, 0xd0, 0x52,
+	0xf7, 0x91, 0x4b, 0xdc, 0x8b, 0x3c, 0xf9, 0x62, 0xaa, 0x8e, 0x1f, 0x93, 0xe2, 0x67, 0xac, 0x54,
+	0x7c, 0x0b, 0xce, 0xd6, 0xb1, 0x6a, 0xa0, 0x86, 0xd6, 0x69, 0x79, 0x24, 0xd1, 0xc8, 0xd5, 0x74,
+	0x0f, 0xb9, 0x2a, 0xf6, 0xe3, 0x60, 0x58, 0x7a, 0xc0, 0xa9, 0x3a, 0x5e, 0x67, 0x30, 0x6b, 0x3e,
+	0xc8, 0x0e, 0x0f, 0x8b, 0xb9, 0x03, 0xa7, 0xa2, 0x14, 0xec, 0x56, 0x8b, 0xdd, 0xf3, 0x44, 0xf2,
+	0x06, 0x4e, 0x84, 0xd8, 0x7e, 0xb5, 0x9f, 0x58, 0x42, 0xd8, 0x24, 0x82, 0x45, 0xe4, 0xb6, 0x8d,
+	0xa4, 0x03, 0xd4, 0xea, 0xf8, 0x31, 0x2b, 0xe0, 0xd5, 0xcf, 0x6d, 0x77, 0x8f, 0x5c, 0xc6, 0x55,
+	0xa9, 0x4a, 0xd7, 0xea, 0xf8, 0x13, 0xac, 0x40, 0x7c, 0x19, 0x8e, 0xd4, 0xb1, 0x8a, 0x2c, 0x72,
+	0x09, 0xaa, 0x1a, 0xc4, 0x95, 0x67, 0xd4, 0xe9, 0xc5, 0x52, 0x95, 0xf4, 0x72, 0x83, 0x96, 0xaf,
+	0x77, 0xb4, 0xd6, 0x7a, 0x5d, 0x7e, 0x07, 0x6a, 0xc1, 0xcc, 0x23, 0x5b, 0x20, 0x3e, 0x61, 0xfd,
+	0x98, 0xe8, 0x31, 0x36, 0x17, 0x0d, 0x1a, 0x98, 0x43, 0xab, 0xfc, 0x05, 0x7b, 0x88, 0xf2, 0x34,
+	0x41, 0x0b, 0xfd, 0xd5, 0xfa, 0x3f, 0x86, 0xe0, 0x68, 0x86, 0xca, 0x91, 0x61, 0xc6, 0x8e, 0xaa,
+	0xdb, 0x06, 0x0a, 0x64, 0xce, 0xc8, 0x4f, 0x62, 0x67, 0xcd, 0x36, 0x90, 0x2f, 0xf2, 0x0b, 0x30,
+	0xe5, 0xc3, 0x91, 0xd4, 0x07, 0xd3, 0xe3, 0xe3, 0x3c, 0xc1, 0xc0, 0xd6, 0x68, 0x19, 0xb9, 0x63,
+	0xc0, 0x8e, 0xaa, 0xb9, 0xfa, 0xae, 0xe9, 0x21, 0xdd, 0xeb, 0xb8, 0xfe, 0x3d, 0xd6, 0x14, 0x76,
+	0x56, 0x22, 0xa5, 0x64, 0xd6, 0x60, 0x47, 0x6d, 0xda, 0x89, 0x81, 0x1e, 0xc7, 0xce, 0xa6, 0xed,
+	0x37, 0xb9, 0x04, 0x47, 0xb1, 0xa3, 0xb2, 0x6d, 0x87, 0x69, 0x35, 0x55, 0xdc, 0xc5, 0x1e, 0x6a,
+	0xfb, 0x79, 0x36, 0xd8, 0x79, 0xe2, 0xd7, 0xec, 0xd0, 0x8a, 0x18, 0x7c, 0x64, 0xfb, 0x32, 0x1a,
+	0x87, 0x0f, 0x36, 0x30, 0xbc, 0x4b, 0x86, 0x4d, 0x23, 0xd5, 0x2c, 0x8d, 0xdf, 0x0f, 0xd2, 0x2e,
+	0xad, 0xd3, 0x42, 0x3a, 0xdc, 0x57, 0xe1, 0x78, 0x66, 0x68, 0x12, 0x8f, 0x0d, 0x12, 0xd3, 0x51,
+	0x49, 0xf2, 0x1f, 0x09, 0x30, 0x1e, 0xd1, 0x77, 0x16, 0x1a, 0x42, 0x07, 0x9c, 0x5c, 0x7a, 0xab,
+	0x24, 0xb8, 0x85, 0x0a, 0xb9, 0xaa, 0x4c, 0xb1, 0xf2, 0x8f, 0xd9, 0x9a, 0x41, 0x2e, 0xea, 0xc5,
+	0x6b, 0x70, 0x9c, 0x43, 0xee, 0x22, 0xad, 0xe5, 0xed, 0xaa, 0xfa, 0x2e, 0xd2, 0xf7, 0x78, 0x30,
+	0x7d, 0x55, 0x39, 0xca, 0x2a, 0xdf, 0xa6, 0x75, 0x6b, 0xac, 0x4a, 0x7c, 0x00, 0xa7, 0x39, 0x0e,
+	0x21, 0xac, 0xba, 0xc8, 0x23, 0x6b, 0x9a, 0xbe, 0x8b, 0xc8, 0x7c, 0x74, 0x79, 0xfc, 0xf5, 0x2c,
+	0x03, 0x21, 0x8d, 0x28, 0x04, 0x60, 0xc7, 0xaf, 0x27, 0x0b, 0xec, 0x44, 0xd4, 0xa6, 0x10, 0xd5,
+	0x68, 0x99, 0xd8, 0x43, 0x96, 0x4a, 0xb3, 0x4c, 0x19, 0x3d, 0xa2, 0xb4, 0x76, 0xc7, 0x37, 0x01,
+	0x27, 0x18, 0xc0, 0x0e, 0xd2, 0x5a, 0x94, 0xda, 0x53, 0x56, 0x2b, 0x3e, 0x84, 0x33, 0xfc, 0x5c,
+	0xe1, 0xb9, 0x5a, 0xa3, 0x61, 0xea, 0xea, 0x1e, 0x42, 0x0e, 0x45, 0x56, 0x0d, 0xad, 0xcb, 0xaf,
+	0x03, 0x67, 0x19, 0xcc, 0x53, 0x06, 0xf2, 0x0e, 0x42, 0x0e, 0xc1, 0x5f, 0xd7, 0xba, 0xe2, 0x6d,
+	0x38, 0x15, 0x09, 0xf2, 0x48, 0x20, 0x33, 0x93, 0x71, 0x3c, 0x8c, 0xe3, 0x88, 0x60, 0xca, 0x5f,
+	0x10, 0x60, 0x74, 0x73, 0x2d, 0x21, 0xed, 0xa6, 0xae, 0x7e, 0x68, 0xb7, 0xeb, 0x26, 0x8a, 0x4b,
+	0x7b, 0x53, 0x7f, 0x9f, 0x96, 0x92, 0x09, 0x10, 0x42, 0xb6, 0x11, 0x0f, 0x47, 0xaa, 0x2a, 0x13,
+	0x3e, 0x1c, 0x4d, 0xf6, 0xbb, 0x0a, 0xc7, 0x79, 0x35, 0xe3, 0xc5, 0xb4, 0x3c, 0xe4, 0xee, 0x6b,
+	0x2d, 0xce, 0x90, 0xd8, 0xa4, 0x60, 0x84, 0x91, 0x2d, 0x5e, 0x23, 0x9f, 0x08, 0x77, 0xd7, 0x74,
+	0x91, 0xe4, 0x3b, 0x1c, 0xf9, 0x09, 0x1c, 0x4f, 0x94, 0x87, 0x41, 0xed, 0x3c, 0x51, 0xb2, 0x24,
+	0xa8, 0x9d, 0xe3, 0x71, 0x68, 0xd9, 0x81, 0xd9, 0x48, 0x8e, 0x03, 0x3b, 0xa1, 0xf5, 0x98, 0x47,
+	0x11, 0x78, 0x0f, 0x87, 0x22, 0xde, 0xc3, 0x52, 0xa7, 0xa3, 0xfc, 0x49, 0x38, 0x95, 0xd1, 0x22,
+	0xef, 0xc6, 0xbd, 0xc4, 0x59, 0xf0, 0x7c, 0xe1, 0x75, 0x31, 0x0b, 0x37, 0x08, 0xce, 0x80, 0x5f,
+	0x10, 0x82, 0xc4, 0xc2, 0x48, 0x75, 0xf4, 0x0c, 0x48, 0x01, 0x23, 0x67, 0x40, 0xfa, 0xdd, 0x4f,
+	0x57, 0xe2, 0x69, 0x7c, 0x85, 0x39, 0x08, 0xef, 0xc3, 0xe9, 0x4c, 0x66, 0x0e, 0xa3, 0xa7, 0x1d,
+	0x38, 0x1b, 0x91, 0xe1, 0x93, 0xe7, 0x16, 0x32, 0xfe, 0x37, 0x86, 0xee, 0x33, 0x30, 0x97, 0xd7,
+	0xec, 0x61, 0xf4, 0xea, 0x6f, 0x86, 0x60, 0x74, 0xdb, 0x6e, 0x99, 0x7a, 0x97, 0x64, 0xea, 0x3b,
+	0xae, 0x69, 0xe9, 0xa6, 0xa3, 0xb5, 0xd8, 0x3d, 0xa5, 0x40, 0xf3, 0x7f, 0x27, 0x83, 0x52, 0x7a,
+	0xd9, 0x79, 0x19, 0xa6, 0x43, 0xb0, 0xf0, 0x4a, 0xb7, 0xa6, 0x84, 0xd8, 0xcf, 0x48, 0x69, 0xfa,
+	0x76, 0xb5, 0x72, 0x28, 0xb7, 0xab, 0x3c, 0xb3, 0x33, 0x72, 0xbb, 0xfa, 0x32, 0xcc, 0x44, 0xa2,
+	0x4d, 0xd8, 0x19, 0x82, 0x85, 0x55, 0x4c, 0x87, 0x21, 0x27, 0xb4, 0x98, 0x80, 0x46, 0x36, 0x34,
+	0x0c, 0x94, 0x85, 0x9f, 0x4c, 0x87, 0xf1, 0x25, 0x0c, 0x34, 0x23, 0x94, 0x64, 0x2c, 0x33, 0x94,
+	0xe4, 0xbf, 0x84, 0xd0, 0xd7, 0xc5, 0xfc, 0xed, 0x54, 0xa0, 0x26, 0xc2, 0x87, 0x97, 0xa2, 0x93,
+	0xb8, 0x40, 0xae, 0x0c, 0x70, 0x81, 0x9c, 0x7d, 0xf3, 0x90, 0x9c, 0x80, 0x23, 0xa9, 0x0b, 0x8b,
+	0x98, 0xc2, 0x8d, 0x26, 0x14, 0xee, 0x27, 0x60, 0x2e, 0xaf, 0xeb, 0x7c, 0x76, 0xde, 0x85, 0xaa,
+	0xc3, 0xcb, 0xca, 0xd2, 0x9e, 0xd8, 0x3c, 0x54, 0x02, 0x78, 0xf9, 0x49, 0x78, 0x65, 0x15, 0x4f,
+	0xc5, 0x66, 0x47, 0xa7, 0x40, 0xbe, 0x97, 0xb3, 0x33, 0xbb, 0x6b, 0xc9, 0x8c, 0x6d, 0x59, 0x83,
+	0x8b, 0x25, 0x04, 0x0f, 0x9c, 0xab, 0xb5, 0x16, 0x5e, 0xad, 0x10, 0x85, 0x8d, 0x37, 0xd3, 0xa3,
+	0xad, 0x90, 0x7f, 0x1a, 0xce, 0x17, 0x12, 0xe1, 0x5c, 0xbe, 0x07, 0x33, 0x89, 0x7e, 0xfb, 0xa7,
+	0xe0, 0x57, 0x7a, 0x4b, 0x69, 0xa7, 0x79, 0x33, 0xd3, 0x71, 0x21, 0x61, 0xf9, 0x0e, 0x48, 0x7e,
+	0xeb, 0xbe, 0xfb, 0x30, 0x12, 0xb0, 0x72, 0x1a, 0x6a, 0xbe, 0x49, 0xf7, 0xe3, 0x55, 0xaa, 0xdc,
+	0xa6, 0x63, 0xf9, 0x3b, 0x02, 0x9c, 0xce, 0xc4, 0xe5, 0x1c, 0x7f, 0x22, 0x61, 0xab, 0xde, 0x2c,
+	0x8b, 0x41, 0xc9, 0x20, 0xc2, 0xec, 0x18, 0x8f, 0x40, 0xe1, 0xe4, 0xa4, 0x4f, 0xc2, 0x78, 0xa4,
+	0x38, 0x23, 0xfe, 0xe4, 0x7a, 0x3c, 0xfe, 0xa4, 0x24, 0x26, 0x2a, 0x12, 0x7e, 0x72, 0x1f, 0x2e,
+	0xfa, 0xcb, 0xff, 0x36, 0xbf, 0x44, 0xb0, 0x9a, 0x3c, 0x2b, 0x8c, 0xa5, 0x63, 0x16, 0x79, 0x42,
+	0x1e, 0xc2, 0xa5, 0x32, 0xec, 0xac, 0xb4, 0xc6, 0x61, 0x3f, 0xfc, 0xea, 0x39, 0x5c, 0x0c, 0xa3,
+	0x3e, 0x18, 0x01, 0xb4, 0xf9, 0x6c, 0x33, 0x8c, 0xff, 0xe8, 0xf5, 0x16, 0xbd, 0x19, 0xbb, 0x45,
+	0x67, 0xde, 0x0a, 0x09, 0x6a, 0xe4, 0x9e, 0x93, 0x71, 0xcd, 0x72, 0x17, 0xc6, 0x0c, 0xe2, 0x7b,
+	0xdc, 0x32, 0x64, 0x15, 0x2e, 0x95, 0x35, 0x7c, 0xb0, 0xa0, 0x93, 0x5b, 0x89, 0x54, 0x31, 0x12,
+	0x89, 0xdc, 0x93, 0xab, 0xfb, 0x3e, 0x9c, 0xca, 0x40, 0xe4, 0xcc, 0x84, 0x96, 0x94, 0x46, 0x3a,
+	0xc7, 0x52, 0x2b, 0x08, 0xa0, 0x3c, 0x07, 0x67, 0x62, 0x01, 0xf2, 0xfc, 0xd9, 0x1a, 0x3f, 0xf2,
+	0x43, 0xbe, 0x0f, 0x67, 0x73, 0xea, 0x79, 0x0b, 0x85, 0x71, 0xb3, 0x9b, 0x70, 0x21, 0xc6, 0x1b,
+	0xcd, 0x2f, 0x1b, 0x20, 0x65, 0x51, 0x56, 0x83, 0x59, 0x97, 0x47, 0xe8, 0x80, 0x09, 0xd2, 0xf7,
+	0xe0, 0x84, 0xdf, 0x00, 0x5e, 0x5f, 0x8d, 0x7a, 0x93, 0x17, 0x60, 0x22, 0xe5, 0x40, 0x18, 0x56,
+	0xc6, 0xeb, 0xa1, 0xfb, 0x40, 0xfe, 0x62, 0x18, 0x3d, 0x19, 0x62, 0x73, 0x86, 0xca, 0xd1, 0x49,
+	0x8c, 0x3e, 0x17, 0xa1, 0x9f, 0xbd, 0xe1, 0x87, 0x1d, 0xd6, 0x94, 0x19, 0x56, 0xf3, 0x94, 0x25,
+	0x6f, 0x74, 0x2c, 0x8f, 0xc4, 0xcb, 0x72, 0x68, 0x8c, 0x02, 0xe0, 0x0a, 0x77, 0x6f, 0xd3, 0x8a,
+	0x1d, 0xc4, 0x61, 0x89, 0xcb, 0x6e, 0xe6, 0x5d, 0xdb, 0x33, 0x1b, 0xa6, 0x4e, 0x17, 0x68, 0xa5,
+	0xd3, 0x42, 0xe2, 0x49, 0x18, 0x73, 0x3b, 0x2d, 0x14, 0x5a, 0xda, 0x51, 0xf2, 0xb9, 0x65, 0x90,
+	0x28, 0xcc, 0xe0, 0x1e, 0x8d, 0x1c, 0xbe, 0xf9, 0x57, 0x24, 0xd8, 0xb3, 0x12, 0x0b, 0xf6, 0x3c,
+	0x01, 0xa3, 0xb8, 0xd3, 0x20, 0xe5, 0x6c, 0x13, 0xc2, 0xbf, 0xc8, 0x68, 0x3e, 0x47, 0xf5, 0x5d,
+	0xdb, 0xde, 0x53, 0x3b, 0x6e, 0x8b, 0x9f, 0x75, 0x81, 0x17, 0xbd, 0xe7, 0xb6, 0x28, 0x22, 0xd2,
+	0x5d, 0xe4, 0xf1, 0x73, 0x2d, 0xff, 0x4a, 0xc6, 0xc9, 0x8e, 0x25, 0xe3, 0x64, 0xe5, 0xdf, 0xe7,
+	0x91, 0xf9, 0xdb, 0x1d, 0x3e, 0x0f, 0x62, 0x9d, 0xeb, 0x35, 0xb1, 0xb2, 0x30, 0x62, 0xe1, 0x21,
+	0x8c, 0xb8, 0xd4, 0x29, 0x52, 0x99, 0xaf, 0x14, 0xdd, 0x4a, 0x24, 0xc5, 0xaa, 0x30, 0x34, 0xf9,
+	0x3c, 0x2c, 0x14, 0x70, 0xc8, 0x26, 0x85, 0xbc, 0x96, 0x78, 0x39, 0x61, 0x90, 0x6e, 0xc8, 0x3a,
+	0x2c, 0x14, 0x10, 0xe1, 0xd3, 0x2f, 0xe8, 0x8e, 0x30, 0x58, 0x77, 0xfe, 0x75, 0x88, 0xd9, 0xa5,
+	0x1d, 0x44, 0x1c, 0x22, 0x3c, 0x18, 0xa3, 0x67, 0x49, 0x2f, 0xc0, 0x04, 0xa9, 0x51, 0x1d, 0xcd,
+	0xf3, 0x90, 0x6b, 0xf9, 0x6e, 0x35, 0x52, 0xb6, 0xcd, 0x8a, 0x72, 0x27, 0x17, 0x79, 0x75, 0xc3,
+	0xb4, 0x98, 0xd9, 0x1a, 0xe6, 0xaf, 0x6e, 0x98, 0x16, 0x4d, 0x73, 0xe2, 0x0f, 0x72, 0x44, 0x72,
+	0x37, 0xc8, 0x83, 0x1c, 0xb4, 0x6a, 0x01, 0x48, 0x2a, 0x97, 0x47, 0x1d, 0xe8, 0x64, 0x67, 0xc8,
+	0xe6, 0xd7, 0x38, 0x2f, 0xa3, 0xfb, 0x3e, 0xe2, 0x69, 0xa2, 0x33, 0xca, 0xe0, 0x7b, 0x3c, 0x36,
+	0xcd, 0xf8, 0x53, 0x46, 0x06, 0xdb, 0xe5, 0x5d, 0xf4, 0x1f, 0x3c, 0x32, 0xd4, 0x3a, 0x6a, 0xd8,
+	0x2e, 0xe2, 0x2f, 0x15, 0xf9, 0xa8, 0xab, 0xb4, 0x30, 0xe7, 0xc5, 0x8c, 0x5a, 0xde, 0x23, 0x2a,
+	0xd1, 0x97, 0x44, 0x20, 0xf6, 0x92, 0x88, 0xfc, 0xef, 0x3c, 0x87, 0x37, 0x21, 0xe7, 0xff, 0x87,
+	0x2f, 0x9b, 0x5c, 0xfb, 0xdb, 0x15, 0x38, 0x4a, 0xba, 0xfa, 0x98, 0x73, 0xbe, 0x83, 0xdc, 0x7d,
+	0x53, 0x47, 0xe2, 0xe7, 0x40, 0x4c, 0xbf, 0x75, 0x20, 0x5e, 0x2d, 0xda, 0x12, 0x65, 0x3e, 0x12,
+	0x24, 0x5d, 0xeb, 0x07, 0x85, 0x6b, 0xe4, 0x4b, 0xe2, 0x97, 0x84, 0x8c, 0xc8, 0xe4, 0x70, 0x85,
+	0x11, 0xef, 0xf6, 0x1c, 0x1f, 0x9c, 0x5a, 0xdf, 0xa4, 0x7b, 0x03, 0xe1, 0x06, 0xac, 0xfd, 0x46,
+	0x32, 0xc1, 0x3b, 0xc6, 0xd8, 0xed, 0x92, 0xee, 0xe6, 0x3e, 0xce, 0x22, 0xdd, 0x19, 0x00, 0x33,
+	0x60, 0xea, 0x97, 0x23, 0x8b, 0x5e, 0xe2, 0xb9, 0x12, 0xf1, 0x8d, 0xbe, 0x08, 0x07, 0xbb, 0x0d,
+	0xe9, 0x56, 0xdf, 0x78, 0x01, 0x3b, 0x7f, 0xcd, 0x63, 0x51, 0x7b, 0x79, 0x2c, 0x44, 0xdc, 0x2c,
+	0x1b, 0x8f, 0x1e, 0xdf, 0x48, 0x91, 0xde, 0x3e, 0x38, 0xa1, 0x2c, 0x81, 0x26, 0x1f, 0xfb, 0x28,
+	0x15, 0x68, 0xce, 0x3b, 0x24, 0xd2, 0xad, 0xbe, 0xf1, 0x02, 0x76, 0x7e, 0x5b, 0x00, 0x29, 0xff,
+	0x19, 0x0f, 0xf1, 0x4e, 0x59, 0xcf, 0x73, 0x9f, 0x1d, 0x91, 0xee, 0x0e, 0x82, 0x1a, 0xf0, 0xf5,
+	0x21, 0x1c, 0x49, 0x3d, 0xac, 0x21, 0xbe, 0x5e, 0xd2, 0xcf, 0xd4, 0x3b, 0x21, 0xd2, 0xd5, 0x3e,
+	0x30, 0x82, 0xb6, 0x7f, 0x51, 0x80, 0xe3, 0x99, 0xdb, 0x61, 0xf1, 0x46, 0x09, 0xb9, 0xcc, 0xdd,
+	0xb5, 0x74, 0xb3, 0x4f, 0xac, 0xd4, 0xe0, 0x64, 0x3f, 0xcd, 0x21, 0x96, 0x29, 0x76, 0xfe, 0xeb,
+	0x21, 0xd2, 0xdd, 0x41, 0x50, 0x03, 0xbe, 0x7e, 0x35, 0x7c, 0x9a, 0x25, 0xf5, 0xb8, 0x86, 0x78,
+	0xab, 0x3f, 0xd2, 0xa1, 0x98, 0x6e, 0xf7, 0x8f, 0x18, 0x70, 0xf4, 0x39, 0x38, 0x16, 0x1e, 0xdc,
+	0xc2, 0xe3, 0x9a, 0x98, 0x19, 0x22, 0x47, 0xf3, 0xfb, 0x92, 0xa0, 0xe1, 0x8c, 0xe9, 0x1d, 0x23,
+	0x63, 0xb6, 0x86, 0x4f, 0x73, 0x94, 0xce, 0xd6, 0xd4, 0x63, 0x21, 0xd2, 0xd5, 0x3e, 0x30, 0xb2,
+	0x66, 0x6b, 0xfc, 0xfd, 0x8c, 0xd2, 0xd9, 0x9a, 0xf9, 0x46, 0x87, 0x74, 0xb3, 0x4f, 0xac, 0x80,
+	0x91, 0xcf, 0x0b, 0x70, 0x22, 0xc6, 0x68, 0x90, 0x80, 0x2d, 0xde, 0xec, 0xa9, 0x63, 0xc9, 0xac,
+	0x6f, 0xe9, 0x8d, 0x7e, 0xd1, 0x52, 0x56, 0x36, 0x23, 0xeb, 0xbd, 0xd8, 0xca, 0xe6, 0xbf, 0x93,
+	0x20, 0xdd, 0xea, 0x1b, 0x2f, 0xb5, 0xeb, 0xc8, 0x79, 0xf6, 0x41, 0xbc, 0xdb, 0x5f, 0x47, 0x63,
+	0xc6, 0xff, 0xde, 0x40, 0xb8, 0x01, 0x6b, 0xbf, 0x23, 0x24, 0x7c, 0x03, 0x49, 0x71, 0xdd, 0xeb,
+	0xc9, 0x7a, 0xe5, 0xc8, 0xec, 0xfe, 0x60, 0xc8, 0x29, 0x4b, 0x93, 0x95, 0xa4, 0x2d, 0xf6, 0x31,
+	0x20, 0xb1, 0x7c, 0x7b, 0xe9, 0x76, 0xff, 0x88, 0xa9, 0x99, 0x95, 0x91, 0x1a, 0x5d, 0x3c, 0xb3,
+	0xf2, 0x73, 0xb1, 0xa5, 0x5b, 0x7d, 0xe3, 0x05, 0xec, 0xfc, 0x41, 0x34, 0x53, 0x3b, 0x3b, 0x67,
+	0x59, 0x7c, 0x58, 0x4a, 0xbe, 0x30, 0x69, 0x5a, 0x7a, 0x73, 0x60, 0xfc, 0x80, 0xcd, 0xe7, 0x30,
+	0x93, 0xcc, 0xdc, 0x14, 0x97, 0x4b, 0xe6, 0x46, 0x32, 0x45, 0x55, 0x7a, 0xbd, 0x77, 0x84, 0xa0,
+	0xe1, 0x9f, 0x17, 0xd8, 0xca, 0x90, 0xcc, 0x8d, 0x14, 0xaf, 0xf7, 0x97, 0x49, 0xc9, 0x38, 0xb8,
+	0x31, 0x48, 0xfa, 0x65, 0x82, 0x8b, 0x68, 0x82, 0x61, 0x39, 0x17, 0x19, 0xd9, 0x93, 0xd2, 0x8d,
+	0xfe, 0x90, 0x52, 0xca, 0x94, 0x95, 0x4d, 0x57, 0xac, 0x4c, 0x05, 0x59, 0x88, 0xd2, 0xed, 0xfe,
+	0x11, 0x03, 0x8e, 0xfe, 0x58, 0x08, 0x2f, 0x0e, 0xf2, 0x33, 0xe8, 0xc4, 0xd2, 0xa4, 0xcd, 0xd2,
+	0x04, 0x3e, 0x69, 0xf5, 0x20, 0x24, 0x02, 0x7e, 0xff, 0x50, 0x80, 0xf9, 0xb2, 0x54, 0x38, 0xf1,
+	0xcd, 0xb2, 0x69, 0x5a, 0x92, 0x87, 0x27, 0xbd, 0x35, 0x38, 0x81, 0xac, 0xc3, 0x64, 0xaa, 0x67,
+	0xdd, 0xd2, 0xc3, 0x64, 0x6e, 0x72, 0x9d, 0x74, 0x67, 0x00, 0xcc, 0x2c, 0xa6, 0xd2, 0x7d, 0x28,
+	0x65, 0x2a, 0x37, 0x99, 0x4e, 0xba, 0x33, 0x00, 0x66, 0x91, 0x6e, 0xd2, 0x84, 0xac, 0x9e, 0x75,
+	0x33, 0x9a, 0x20, 0x27, 0xdd, 0xe8, 0x0f, 0x29, 0xe0, 0xe2, 0x2b, 0xa9, 0xfb, 0xd4, 0x44, 0x5a,
+	0x98, 0x78, 0xbf, 0x1f, 0xca, 0xc9, 0x04, 0x37, 0xe9, 0xc1, 0x80, 0xd8, 0x99, 0xaa, 0x9a, 0x9f,
+	0xad, 0x25, 0xf6, 0x9e, 0x5f, 0x9d, 0x97, 0x88, 0x26, 0xad, 0x1e, 0x84, 0x44, 0xa6, 0xa7, 0xa0,
+	0x2c, 0xc1, 0xa1, 0xdc, 0x53, 0xd0, 0x63, 0xaa, 0x85, 0xf4, 0xf6, 0xc1, 0x09, 0x05, 0x3d, 0xf8,
+	0x13, 0x01, 0xce, 0x17, 0xa2, 0xf1, 0x89, 0xb1, 0x3a, 0x50, 0x9b, 0xf1, 0xe9, 0xb1, 0x76, 0x20,
+	0x1a, 0x99, 0xde, 0xb5, 0xac, 0x84, 0xb8, 0x52, 0x9f, 0x40, 0x7e, 0x62, 0x9e, 0x74, 0x6f, 0x20,
+	0xdc, 0x80, 0xb5, 0x3f, 0x17, 0xc2, 0x6b, 0xf0, 0xc2, 0xbc, 0x2b, 0x71, 0xbd, 0xac, 0xa1, 0x5e,
+	0xd2, 0xc3, 0xa4, 0x8d, 0x03, 0x52, 0x49, 0x9d, 0xef, 0xd2, 0x79, 0x55, 0xa5, 0xb6, 0x26, 0x2b,
+	0xa7, 0x4b, 0xba, 0xd9, 0x27, 0x56, 0xa6, 0xa1, 0x8c, 0xa5, 0xab, 0x94, 0x1a, 0xca, 0x8c, 0x44,
+	0x19, 0xe9, 0x46, 0x7f, 0x48, 0x01, 0x17, 0x16, 0x4c, 0xc6, 0x72, 0x39, 0xc4, 0xd7, 0x4a, 0x8c,
+	0x7f, 0x2c, 0x6b, 0x44, 0xba, 0xd2, 0x23, 0x74, 0x56, 0x7b, 0x2c, 0x8a, 0xbc, 0xb4, 0xbd, 0x68,
+	0x1c, 0x9d, 0x74, 0xa5, 0x47, 0xe8, 0x0c, 0x57, 0x42, 0x18, 0xb5, 0x56, 0xea, 0x4a, 0x48, 0x85,
+	0xd4, 0x49, 0x57, 0xfb, 0xc0, 0x08, 0xda, 0xfe, 0x59, 0x01, 0x8e, 0xfa, 0x4b, 0x66, 0x24, 0x94,
+	0x4c, 0xbc, 0xd6, 0xcb, 0xc6, 0x3b, 0x1e, 0x04, 0x27, 0x5d, 0xef, 0x0b, 0x27, 0xcb, 0x89, 0x90,
+	0x08, 0xfd, 0x2a, 0x75, 0x22, 0x64, 0x47, 0xa8, 0x49, 0x6f, 0xf4, 0x8b, 0x96, 0xe2, 0x25, 0x1d,
+	0xe8, 0x23, 0xde, 0xec, 0x6d, 0x8d, 0x4a, 0xc4, 0x44, 0x49, 0x6f, 0xf4, 0x8b, 0x96, 0xb9, 0x3f,
+	0xc8, 0x8c, 0xe2, 0x29, 0xdf, 0x1f, 0x14, 0x45, 0x13, 0x49, 0x0f, 0x06, 0xc4, 0xce, 0x34, 0xfd,
+	0x19, 0xe1, 0x3b, 0xe5, 0xa6, 0x3f, 0x3f, 0x70, 0x48, 0xba, 0x37, 0x10, 0x6e, 0x6a, 0x5a, 0x27,
+	0x42, 0x6b, 0x8a, 0xa7, 0x75, 0x76, 0x20, 0x90, 0x74, 0x7d, 0x80, 0xd8, 0x1d, 0xf9, 0x25, 0xf1,
+	0xab, 0x02, 0xcc, 0x15, 0x87, 0xc4, 0x88, 0x0f, 0x4a, 0x2d, 0x53, 0x51, 0x20, 0x8e, 0xf4, 0x70,
+	0x50, 0xf4, 0x14, 0x8f, 0xf9, 0xd1, 0x2f, 0xc5, 0x3c, 0x96, 0x86, 0xeb, 0x48, 0x0f, 0x07, 0x45,
+	0xcf, 0x75, 0xb4, 0xd2, 0xeb, 0xe0, 0xde, 0x1c, 0xad, 0x91, 0x50, 0x1b, 0xe9, 0x6a, 0x1f, 0x18,
+	0x29, 0x6f, 0x7c, 0x76, 0x9e, 0x5e, 0xb1, 0x37, 0xbe, 0x30, 0x2d, 0x50, 0xba, 0x3b, 0x08, 0x6a,
+	0xca, 0x83, 0x97, 0x97, 0x77, 0x56, 0xec, 0xc1, 0x2b, 0x49, 0x75, 0x93, 0xee, 0x0f, 0x86, 0x9c,
+	0x32, 0x5c, 0xb9, 0x41, 0x3d, 0xe2, 0xfd, 0x9e, 0x06, 0x23, 0x27, 0xa8, 0x48, 0x7a, 0x30, 0x20,
+	0x76, 0xc0, 0xa0, 0x07, 0xd3, 0x89, 0xa8, 0x1e, 0x71, 0xa9, 0x8c, 0x66, 0x3c, 0x78, 0x48, 0x5a,
+	0xee, 0x19, 0x3e, 0x75, 0x14, 0xce, 0x8c, 0x20, 0x29, 0x3e, 0x0a, 0x17, 0x85, 0xc5, 0x48, 0x77,
+	0x06, 0xc0, 0xcc, 0xbf, 0x81, 0xee, 0x9d, 0xa9, 0x4d, 0x34, 0x28, 0x53, 0x85, 0x91, 0x2d, 0xa1,
+	0xca, 0xc7, 0x42, 0x26, 0x8a, 0x55, 0x3e, 0x2b, 0x8a, 0x45, 0xba, 0xda, 0x07, 0x86, 0xdf, 0xf6,
+	0xaa, 0xf6, 0xad, 0x1f, 0xce, 0x09, 0xdf, 0xf9, 0xe1, 0x9c, 0xf0, 0x83, 0x1f, 0xce, 0x09, 0xbf,
+	0xf6, 0xd1, 0xdc, 0x4b, 0xdf, 0xf9, 0x68, 0xee, 0xa5, 0x7f, 0xfe, 0x68, 0xee, 0xa5, 0xf7, 0x37,
+	0x23, 0x49, 0x89, 0x75, 0xab, 0x7e, 0x85, 0x66, 0x30, 0x2d, 0x87, 0x57, 0x47, 0x57, 0xf8, 0xd5,
+	0xd1, 0x15, 0x3f, 0xd3, 0x70, 0x39, 0xfb, 0x7f, 0x7e, 0xac, 0x8f, 0xd2, 0xff, 0x18, 0xf0, 0xfa,
+	0xff, 0x0c, 0x00, 0x79, 0x41, 0x5a, 0x2a, 0x1a, 0x72, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -8325,6 +8898,9 @@ type GfSpMetadataServiceClient interface {
 	GfSpSecondarySpIncomeDetails(ctx context.Context, in *GfSpSecondarySpIncomeDetailsRequest, opts ...grpc.CallOption) (*GfSpSecondarySpIncomeDetailsResponse, error)
 	GfSpGetBucketInfoByBucketName(ctx context.Context, in *GfSpGetBucketInfoByBucketNameRequest, opts ...grpc.CallOption) (*GfSpGetBucketInfoByBucketNameResponse, error)
 	GfSpGetBsDBInfo(ctx context.Context, in *GfSpGetBsDBInfoRequest, opts ...grpc.CallOption) (*GfSpGetBsDBInfoResponse, error)
+	GfSpPutBucketNotification(ctx context.Context, in *GfSpPutBucketNotificationRequest, opts ...grpc.CallOption) (*GfSpPutBucketNotificationResponse, error)
+	GfSpGetBucketNotification(ctx context.Context, in *GfSpGetBucketNotificationRequest, opts ...grpc.CallOption) (*GfSpGetBucketNotificationResponse, error)
+	GfSpSearchObjects(ctx context.Context, in *GfSpSearchObjectsRequest, opts ...grpc.CallOption) (*GfSpSearchObjectsResponse, error)
 }
 
 type gfSpMetadataServiceClient struct {
@@ -8821,6 +9397,33 @@ func (c *gfSpMetadataServiceClient) GfSpGetBsDBInfo(ctx context.Context, in *GfS
 	return out, nil
 }
 
+func (c *gfSpMetadataServiceClient) GfSpPutBucketNotification(ctx context.Context, in *GfSpPutBucketNotificationRequest, opts ...grpc.CallOption) (*GfSpPutBucketNotificationResponse, error) {
+	out := new(GfSpPutBucketNotificationResponse)
+	err := c.cc.Invoke(ctx, "/modular.metadata.types.GfSpMetadataService/GfSpPutBucketNotification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gfSpMetadataServiceClient) GfSpGetBucketNotification(ctx context.Context, in *GfSpGetBucketNotificationRequest, opts ...grpc.CallOption) (*GfSpGetBucketNotificationResponse, error) {
+	out := new(GfSpGetBucketNotificationResponse)
+	err := c.cc.Invoke(ctx, "/modular.metadata.types.GfSpMetadataService/GfSpGetBucketNotification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gfSpMetadataServiceClient) GfSpSearchObjects(ctx context.Context, in *GfSpSearchObjectsRequest, opts ...grpc.CallOption) (*GfSpSearchObjectsResponse, error) {
+	out := new(GfSpSearchObjectsResponse)
+	err := c.cc.Invoke(ctx, "/modular.metadata.types.GfSpMetadataService/GfSpSearchObjects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GfSpMetadataServiceServer is the server API for GfSpMetadataService service.
 type GfSpMetadataServiceServer interface {
 	GfSpGetUserBuckets(context.Context, *GfSpGetUserBucketsRequest) (*GfSpGetUserBucketsResponse, error)
@@ -8877,6 +9480,9 @@ type GfSpMetadataServiceServer interface {
 	GfSpSecondarySpIncomeDetails(context.Context, *GfSpSecondarySpIncomeDetailsRequest) (*GfSpSecondarySpIncomeDetailsResponse, error)
 	GfSpGetBucketInfoByBucketName(context.Context, *GfSpGetBucketInfoByBucketNameRequest) (*GfSpGetBucketInfoByBucketNameResponse, error)
 	GfSpGetBsDBInfo(context.Context, *GfSpGetBsDBInfoRequest) (*GfSpGetBsDBInfoResponse, error)
+	GfSpPutBucketNotification(context.Context, *GfSpPutBucketNotificationRequest) (*GfSpPutBucketNotificationResponse, error)
+	GfSpGetBucketNotification(context.Context, *GfSpGetBucketNotificationRequest) (*GfSpGetBucketNotificationResponse, error)
+	GfSpSearchObjects(context.Context, *GfSpSearchObjectsRequest) (*GfSpSearchObjectsResponse, error)
 }
 
 // UnimplementedGfSpMetadataServiceServer can be embedded to have forward compatible implementations.
@@ -9045,6 +9651,15 @@ func (*UnimplementedGfSpMetadataServiceServer) GfSpGetBucketInfoByBucketName(ctx
 func (*UnimplementedGfSpMetadataServiceServer) GfSpGetBsDBInfo(ctx context.Context, req *GfSpGetBsDBInfoRequest) (*GfSpGetBsDBInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GfSpGetBsDBInfo not implemented")
 }
+func (*UnimplementedGfSpMetadataServiceServer) GfSpPutBucketNotification(ctx context.Context, req *GfSpPutBucketNotificationRequest) (*GfSpPutBucketNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GfSpPutBucketNotification not implemented")
+}
+func (*UnimplementedGfSpMetadataServiceServer) GfSpGetBucketNotification(ctx context.Context, req *GfSpGetBucketNotificationRequest) (*GfSpGetBucketNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GfSpGetBucketNotification not implemented")
+}
+func (*UnimplementedGfSpMetadataServiceServer) GfSpSearchObjects(ctx context.Context, req *GfSpSearchObjectsRequest) (*GfSpSearchObjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GfSpSearchObjects not implemented")
+}
 
 func RegisterGfSpMetadataServiceServer(s grpc1.Server, srv GfSpMetadataServiceServer) {
 	s.RegisterService(&_GfSpMetadataService_serviceDesc, srv)
@@ -10022,6 +10637,60 @@ func _GfSpMetadataService_GfSpGetBsDBInfo_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GfSpMetadataService_GfSpPutBucketNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GfSpPutBucketNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GfSpMetadataServiceServer).GfSpPutBucketNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modular.metadata.types.GfSpMetadataService/GfSpPutBucketNotification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GfSpMetadataServiceServer).GfSpPutBucketNotification(ctx, req.(*GfSpPutBucketNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GfSpMetadataService_GfSpGetBucketNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GfSpGetBucketNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GfSpMetadataServiceServer).GfSpGetBucketNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modular.metadata.types.GfSpMetadataService/GfSpGetBucketNotification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GfSpMetadataServiceServer).GfSpGetBucketNotification(ctx, req.(*GfSpGetBucketNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GfSpMetadataService_GfSpSearchObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GfSpSearchObjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GfSpMetadataServiceServer).GfSpSearchObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modular.metadata.types.GfSpMetadataService/GfSpSearchObjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GfSpMetadataServiceServer).GfSpSearchObjects(ctx, req.(*GfSpSearchObjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _GfSpMetadataService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "modular.metadata.types.GfSpMetadataService",
 	HandlerType: (*GfSpMetadataServiceServer)(nil),
@@ -10242,6 +10911,18 @@ var _GfSpMetadataService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GfSpGetBsDBInfo",
 			Handler:    _GfSpMetadataService_GfSpGetBsDBInfo_Handler,
 		},
+		{
+			MethodName: "GfSpPutBucketNotification",
+			Handler:    _GfSpMetadataService_GfSpPutBucketNotification_Handler,
+		},
+		{
+			MethodName: "GfSpGetBucketNotification",
+			Handler:    _GfSpMetadataService_GfSpGetBucketNotification_Handler,
+		},
+		{
+			MethodName: "GfSpSearchObjects",
+			Handler:    _GfSpMetadataService_GfSpSearchObjects_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "modular/metadata/types/metadata.proto",
@@ -10816,6 +11497,11 @@ func (m *GfSpListObjectsByBucketNameRequest) MarshalToSizedBuffer(dAtA []byte) (
 	_ = i
 	var l int
 	_ = l
+	if m.AtHeight != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.AtHeight))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.IncludeRemoved {
 		i--
 		if m.IncludeRemoved {
@@ -10997,6 +11683,11 @@ func (m *GfSpGetBucketByBucketNameRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
+	if m.AtHeight != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.AtHeight))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.IncludePrivate {
 		i--
 		if m.IncludePrivate {
@@ -11373,6 +12064,11 @@ func (m *GfSpGetObjectMetaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.AtHeight != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.AtHeight))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.IncludePrivate {
 		i--
 		if m.IncludePrivate {
@@ -14953,6 +15649,11 @@ func (m *GfSpGetGroupMembersRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.AtHeight != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.AtHeight))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.StartAfter) > 0 {
 		i -= len(m.StartAfter)
 		copy(dAtA[i:], m.StartAfter)
@@ -15169,6 +15870,11 @@ func (m *GfSpListObjectPoliciesRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.AtHeight != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.AtHeight))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.StartAfter != 0 {
 		i = encodeVarintMetadata(dAtA, i, uint64(m.StartAfter))
 		i--
@@ -15826,6 +16532,364 @@ func (m *GfSpGetBsDBInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *NotificationRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NotificationRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NotificationRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreateTime != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.CreateTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Secret) > 0 {
+		i -= len(m.Secret)
+		copy(dAtA[i:], m.Secret)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.Secret)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.WebhookUrl) > 0 {
+		i -= len(m.WebhookUrl)
+		copy(dAtA[i:], m.WebhookUrl)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.WebhookUrl)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Suffix) > 0 {
+		i -= len(m.Suffix)
+		copy(dAtA[i:], m.Suffix)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.Suffix)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Events[iNdEx])
+			copy(dAtA[i:], m.Events[iNdEx])
+			i = encodeVarintMetadata(dAtA, i, uint64(len(m.Events[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RuleId) > 0 {
+		i -= len(m.RuleId)
+		copy(dAtA[i:], m.RuleId)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.RuleId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GfSpPutBucketNotificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GfSpPutBucketNotificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GfSpPutBucketNotificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetadata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.BucketId != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.BucketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GfSpPutBucketNotificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GfSpPutBucketNotificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GfSpPutBucketNotificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GfSpGetBucketNotificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GfSpGetBucketNotificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GfSpGetBucketNotificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GfSpGetBucketNotificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GfSpGetBucketNotificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GfSpGetBucketNotificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetadata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GfSpSearchObjectsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GfSpSearchObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GfSpSearchObjectsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxKeys != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.MaxKeys))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ContinuationToken) > 0 {
+		i -= len(m.ContinuationToken)
+		copy(dAtA[i:], m.ContinuationToken)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.ContinuationToken)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.CreatedBefore != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.CreatedBefore))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CreatedAfter != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.CreatedAfter))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MaxSize != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.MaxSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MinSize != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.MinSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NamePattern) > 0 {
+		i -= len(m.NamePattern)
+		copy(dAtA[i:], m.NamePattern)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.NamePattern)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GfSpSearchObjectsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GfSpSearchObjectsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GfSpSearchObjectsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextContinuationToken) > 0 {
+		i -= len(m.NextContinuationToken)
+		copy(dAtA[i:], m.NextContinuationToken)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.NextContinuationToken)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.IsTruncated {
+		i--
+		if m.IsTruncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxKeys != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.MaxKeys))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.KeyCount != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.KeyCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Objects) > 0 {
+		for iNdEx := len(m.Objects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Objects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetadata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMetadata(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMetadata(v)
 	base := offset
@@ -16114,6 +17178,9 @@ func (m *GfSpListObjectsByBucketNameRequest) Size() (n int) {
 	if m.IncludeRemoved {
 		n += 2
 	}
+	if m.AtHeight != 0 {
+		n += 1 + sovMetadata(uint64(m.AtHeight))
+	}
 	return n
 }
 
@@ -16180,6 +17247,9 @@ func (m *GfSpGetBucketByBucketNameRequest) Size() (n int) {
 	if m.IncludePrivate {
 		n += 2
 	}
+	if m.AtHeight != 0 {
+		n += 1 + sovMetadata(uint64(m.AtHeight))
+	}
 	return n
 }
 
@@ -16338,6 +17408,9 @@ func (m *GfSpGetObjectMetaRequest) Size() (n int) {
 	if m.IncludePrivate {
 		n += 2
 	}
+	if m.AtHeight != 0 {
+		n += 1 + sovMetadata(uint64(m.AtHeight))
+	}
 	return n
 }
 
@@ -17849,6 +18922,9 @@ func (m *GfSpGetGroupMembersRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMetadata(uint64(l))
 	}
+	if m.AtHeight != 0 {
+		n += 1 + sovMetadata(uint64(m.AtHeight))
+	}
 	return n
 }
 
@@ -17956,6 +19032,9 @@ func (m *GfSpListObjectPoliciesRequest) Size() (n int) {
 	if m.StartAfter != 0 {
 		n += 1 + sovMetadata(uint64(m.StartAfter))
 	}
+	if m.AtHeight != 0 {
+		n += 1 + sovMetadata(uint64(m.AtHeight))
+	}
 	return n
 }
 
@@ -18226,6 +19305,175 @@ func (m *GfSpGetBsDBInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *NotificationRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RuleId)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	if len(m.Events) > 0 {
+		for _, s := range m.Events {
+			l = len(s)
+			n += 1 + l + sovMetadata(uint64(l))
+		}
+	}
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	l = len(m.Suffix)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	l = len(m.WebhookUrl)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	l = len(m.Secret)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	if m.CreateTime != 0 {
+		n += 1 + sovMetadata(uint64(m.CreateTime))
+	}
+	return n
+}
+
+func (m *GfSpPutBucketNotificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	if m.BucketId != 0 {
+		n += 1 + sovMetadata(uint64(m.BucketId))
+	}
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovMetadata(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GfSpPutBucketNotificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GfSpGetBucketNotificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	return n
+}
+
+func (m *GfSpGetBucketNotificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovMetadata(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GfSpSearchObjectsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	l = len(m.NamePattern)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	if m.MinSize != 0 {
+		n += 1 + sovMetadata(uint64(m.MinSize))
+	}
+	if m.MaxSize != 0 {
+		n += 1 + sovMetadata(uint64(m.MaxSize))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	if m.CreatedAfter != 0 {
+		n += 1 + sovMetadata(uint64(m.CreatedAfter))
+	}
+	if m.CreatedBefore != 0 {
+		n += 1 + sovMetadata(uint64(m.CreatedBefore))
+	}
+	l = len(m.ContinuationToken)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	if m.MaxKeys != 0 {
+		n += 1 + sovMetadata(uint64(m.MaxKeys))
+	}
+	return n
+}
+
+func (m *GfSpSearchObjectsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for _, e := range m.Objects {
+			l = e.Size()
+			n += 1 + l + sovMetadata(uint64(l))
+		}
+	}
+	if m.KeyCount != 0 {
+		n += 1 + sovMetadata(uint64(m.KeyCount))
+	}
+	if m.MaxKeys != 0 {
+		n += 1 + sovMetadata(uint64(m.MaxKeys))
+	}
+	if m.IsTruncated {
+		n += 2
+	}
+	l = len(m.NextContinuationToken)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	return n
+}
+
 func sovMetadata(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -20186,6 +21434,25 @@ func (m *GfSpListObjectsByBucketNameRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IncludeRemoved = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtHeight", wireType)
+			}
+			m.AtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
@@ -20622,6 +21889,25 @@ func (m *GfSpGetBucketByBucketNameRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IncludePrivate = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtHeight", wireType)
+			}
+			m.AtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
@@ -21590,6 +22876,25 @@ func (m *GfSpGetObjectMetaRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IncludePrivate = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtHeight", wireType)
+			}
+			m.AtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
@@ -31323,61 +32628,11 @@ func (m *GfSpGetGroupMembersRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.StartAfter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMetadata(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMetadata
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GfSpGetGroupMembersResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMetadata
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GfSpGetGroupMembersResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GfSpGetGroupMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtHeight", wireType)
 			}
-			var msglen int
+			m.AtHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadata
@@ -31387,26 +32642,95 @@ func (m *GfSpGetGroupMembersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.AtHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetadata
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetadata
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Groups = append(m.Groups, &GroupMember{})
-			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GfSpGetGroupMembersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GfSpGetGroupMembersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GfSpGetGroupMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groups = append(m.Groups, &GroupMember{})
+			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
@@ -31991,145 +33315,11 @@ func (m *GfSpListObjectPoliciesRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMetadata(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMetadata
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GfSpListObjectPoliciesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMetadata
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GfSpListObjectPoliciesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GfSpListObjectPoliciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetadata
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetadata
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetadata
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Policies = append(m.Policies, &Policy{})
-			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMetadata(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMetadata
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GfSpListPaymentAccountStreamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMetadata
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GfSpListPaymentAccountStreamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GfSpListPaymentAccountStreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccount", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtHeight", wireType)
 			}
-			var stringLen uint64
+			m.AtHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadata
@@ -32139,24 +33329,11 @@ func (m *GfSpListPaymentAccountStreamsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AtHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMetadata
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetadata
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PaymentAccount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
@@ -32178,7 +33355,7 @@ func (m *GfSpListPaymentAccountStreamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GfSpListPaymentAccountStreamsResponse) Unmarshal(dAtA []byte) error {
+func (m *GfSpListObjectPoliciesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32201,15 +33378,15 @@ func (m *GfSpListPaymentAccountStreamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GfSpListPaymentAccountStreamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GfSpListObjectPoliciesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GfSpListPaymentAccountStreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GfSpListObjectPoliciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32236,8 +33413,8 @@ func (m *GfSpListPaymentAccountStreamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Buckets = append(m.Buckets, &Bucket{})
-			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Policies = append(m.Policies, &Policy{})
+			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -32262,7 +33439,7 @@ func (m *GfSpListPaymentAccountStreamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GfSpListUserPaymentAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *GfSpListPaymentAccountStreamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32285,15 +33462,181 @@ func (m *GfSpListUserPaymentAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GfSpListUserPaymentAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GfSpListPaymentAccountStreamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GfSpListUserPaymentAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GfSpListPaymentAccountStreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GfSpListPaymentAccountStreamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GfSpListPaymentAccountStreamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GfSpListPaymentAccountStreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, &Bucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GfSpListUserPaymentAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GfSpListUserPaymentAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GfSpListUserPaymentAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33673,6 +35016,1097 @@ func (m *GfSpGetBsDBInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *NotificationRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NotificationRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NotificationRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suffix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Suffix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WebhookUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+			}
+			m.CreateTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GfSpPutBucketNotificationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GfSpPutBucketNotificationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GfSpPutBucketNotificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			m.BucketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BucketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, &NotificationRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GfSpPutBucketNotificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GfSpPutBucketNotificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GfSpPutBucketNotificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GfSpGetBucketNotificationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GfSpGetBucketNotificationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GfSpGetBucketNotificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GfSpGetBucketNotificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GfSpGetBucketNotificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GfSpGetBucketNotificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, &NotificationRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GfSpSearchObjectsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GfSpSearchObjectsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GfSpSearchObjectsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamePattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamePattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSize", wireType)
+			}
+			m.MinSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSize", wireType)
+			}
+			m.MaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAfter", wireType)
+			}
+			m.CreatedAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAfter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBefore", wireType)
+			}
+			m.CreatedBefore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedBefore |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuationToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContinuationToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxKeys", wireType)
+			}
+			m.MaxKeys = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxKeys |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GfSpSearchObjectsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GfSpSearchObjectsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GfSpSearchObjectsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Objects = append(m.Objects, &Object{})
+			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyCount", wireType)
+			}
+			m.KeyCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxKeys", wireType)
+			}
+			m.MaxKeys = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxKeys |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsTruncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsTruncated = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextContinuationToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextContinuationToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMetadata(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
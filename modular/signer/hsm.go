@@ -0,0 +1,243 @@
+package signer
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	ctypes "github.com/cosmos/cosmos-sdk/crypto/types"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/signer/types"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield/sdk/keys"
+)
+
+// SoftTokenName defines the name of the built-in software token
+const SoftTokenName = "soft"
+
+var (
+	// ErrTokenNotLoggedIn is returned if the token is used before login
+	ErrTokenNotLoggedIn = errors.New("token is not logged in")
+	// ErrKeyNotFound is returned if no private key object has the label
+	ErrKeyNotFound = errors.New("key is not found in token")
+)
+
+// KeyHandle identifies a private key object in the token as same as the object handle of PKCS#11.
+type KeyHandle uint64
+
+// Token is a PKCS#11-style token which holds the private keys, the keys are used by the handles and
+// can not be exported from the token.
+type Token interface {
+	// Login opens the user session by the pin.
+	Login(pin string) error
+	// FindKey returns the handle of the private key object with the label.
+	FindKey(label string) (KeyHandle, error)
+	// GetPublicKey returns the type and the compressed public key of the private key object.
+	GetPublicKey(handle KeyHandle) (types.KeyType, []byte, error)
+	// Sign signs the data by the private key object inside the token.
+	Sign(handle KeyHandle, data []byte) ([]byte, error)
+	// Logout closes the user session.
+	Logout() error
+}
+
+// NewTokenFunc opens the token by the path.
+type NewTokenFunc func(path string) (Token, error)
+
+var (
+	tokenMu  sync.RWMutex
+	tokenMap = map[string]NewTokenFunc{
+		SoftTokenName: func(path string) (Token, error) { return NewSoftToken(path), nil },
+	}
+)
+
+// RegisterToken registers the token implementation by name, it is used to plug in the binding of a
+// PKCS#11 module.
+func RegisterToken(name string, fn NewTokenFunc) {
+	tokenMu.Lock()
+	defer tokenMu.Unlock()
+	tokenMap[strings.ToLower(name)] = fn
+}
+
+// hsmKeyProvider signs by the private key objects in the token, the key objects are found by the sign
+// type as label.
+type hsmKeyProvider struct {
+	mu    sync.Mutex
+	token Token
+}
+
+// NewHSMKeyProvider opens and logs in the token specified by cfg.
+func NewHSMKeyProvider(cfg gfspconfig.HSMConfig, pin string) (KeyProvider, error) {
+	tokenMu.RLock()
+	fn, ok := tokenMap[strings.ToLower(cfg.Token)]
+	tokenMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("invalid hsm token: %s", cfg.Token)
+	}
+	token, err := fn(cfg.TokenPath)
+	if err != nil {
+		log.Errorw("failed to open hsm token", "token", cfg.Token, "error", err)
+		return nil, err
+	}
+	return newHSMKeyProvider(token, pin)
+}
+
+func newHSMKeyProvider(token Token, pin string) (*hsmKeyProvider, error) {
+	if err := token.Login(pin); err != nil {
+		log.Errorw("failed to login hsm token", "error", err)
+		return nil, err
+	}
+	return &hsmKeyProvider{token: token}, nil
+}
+
+func (p *hsmKeyProvider) KeyManager(scope SignType) (keys.KeyManager, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	handle, err := p.token.FindKey(string(scope))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find %s key: %w", scope, err)
+	}
+	keyType, pubKey, err := p.token.GetPublicKey(handle)
+	if err != nil {
+		return nil, err
+	}
+	if keyType != keyTypeOf(scope) {
+		return nil, fmt.Errorf("mismatched %s key type: %s", scope, keyType)
+	}
+	return newDelegatedKeyManager(keyType, pubKey, func(msg []byte) ([]byte, error) {
+		// the sessions of PKCS#11 tokens are not safe for concurrent use
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		return p.token.Sign(handle, msg)
+	})
+}
+
+func (p *hsmKeyProvider) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.token.Logout()
+}
+
+var _ Token = &SoftToken{}
+
+// SoftToken is a software token which stands in for the hardware tokens in development and tests. The
+// keys are loaded from the encrypted keystore files in the directory when logging in, the pin is the
+// password of keystore files and the label is the file name without .json suffix.
+type SoftToken struct {
+	mu       sync.Mutex
+	dir      string
+	loggedIn bool
+	labels   map[string]KeyHandle
+	keys     map[KeyHandle]ctypes.PrivKey
+}
+
+// NewSoftToken returns a software token which loads the keystore files in dir, the token has no keys
+// if dir is empty.
+func NewSoftToken(dir string) *SoftToken {
+	return &SoftToken{dir: dir}
+}
+
+func (t *SoftToken) Login(pin string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.labels = make(map[string]KeyHandle)
+	t.keys = make(map[KeyHandle]ctypes.PrivKey)
+	if t.dir != "" {
+		files, err := filepath.Glob(filepath.Join(t.dir, "*.json"))
+		if err != nil {
+			return err
+		}
+		for _, file := range files {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				return err
+			}
+			privKey, err := DecryptKeystore(data, pin)
+			if err != nil {
+				return fmt.Errorf("failed to decrypt %s: %w", filepath.Base(file), err)
+			}
+			t.addKey(strings.TrimSuffix(filepath.Base(file), ".json"), privKey)
+		}
+	}
+	t.loggedIn = true
+	return nil
+}
+
+// ImportKey imports the private key to the token with the label.
+func (t *SoftToken) ImportKey(label string, keyType types.KeyType, privKey []byte) (KeyHandle, error) {
+	pk, err := newPrivKey(keyType, privKey)
+	if err != nil {
+		return 0, err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !t.loggedIn {
+		return 0, ErrTokenNotLoggedIn
+	}
+	return t.addKey(label, pk), nil
+}
+
+func (t *SoftToken) addKey(label string, privKey ctypes.PrivKey) KeyHandle {
+	handle := KeyHandle(len(t.keys) + 1)
+	t.labels[label] = handle
+	t.keys[handle] = privKey
+	return handle
+}
+
+func (t *SoftToken) FindKey(label string) (KeyHandle, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !t.loggedIn {
+		return 0, ErrTokenNotLoggedIn
+	}
+	handle, ok := t.labels[label]
+	if !ok {
+		return 0, ErrKeyNotFound
+	}
+	return handle, nil
+}
+
+func (t *SoftToken) GetPublicKey(handle KeyHandle) (types.KeyType, []byte, error) {
+	privKey, err := t.key(handle)
+	if err != nil {
+		return types.KeyType_KEY_TYPE_UNSPECIFIED, nil, err
+	}
+	keyType := types.KeyType_KEY_TYPE_ETH_SECP256K1
+	if privKey.Type() == keyTypeName(types.KeyType_KEY_TYPE_ETH_BLS) {
+		keyType = types.KeyType_KEY_TYPE_ETH_BLS
+	}
+	return keyType, privKey.PubKey().Bytes(), nil
+}
+
+func (t *SoftToken) Sign(handle KeyHandle, data []byte) ([]byte, error) {
+	privKey, err := t.key(handle)
+	if err != nil {
+		return nil, err
+	}
+	return privKey.Sign(data)
+}
+
+func (t *SoftToken) key(handle KeyHandle) (ctypes.PrivKey, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !t.loggedIn {
+		return nil, ErrTokenNotLoggedIn
+	}
+	privKey, ok := t.keys[handle]
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+	return privKey, nil
+}
+
+func (t *SoftToken) Logout() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.loggedIn = false
+	t.labels = nil
+	t.keys = nil
+	return nil
+}
@@ -0,0 +1,189 @@
+package signer
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/bls"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/ethsecp256k1"
+	ctypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/signer/types"
+	"github.com/bnb-chain/greenfield/sdk/keys"
+)
+
+const (
+	// SignBls is the type of signature signed by the bls key
+	SignBls SignType = "bls"
+
+	// LocalKeyProvider defines the key provider which reads the raw hex private keys from config or env
+	LocalKeyProvider = "local"
+	// KeystoreKeyProvider defines the key provider which reads the encrypted keystore files
+	KeystoreKeyProvider = "keystore"
+	// RemoteKeyProvider defines the key provider which signs by a remote signer
+	RemoteKeyProvider = "remote"
+	// HSMKeyProvider defines the key provider which signs by a PKCS#11-style token
+	HSMKeyProvider = "hsm"
+
+	// SpKeystorePassword defines env variable name for the password of keystore files
+	SpKeystorePassword = "SIGNER_KEYSTORE_PASSWORD"
+	// SpHSMPin defines env variable name for the user pin of hsm token
+	SpHSMPin = "SIGNER_HSM_PIN"
+
+	// blsPubKeySize defines the size of compressed bls public key
+	blsPubKeySize = 48
+)
+
+// KeyProvider provides the key managers of sp accounts. The key managers of remote and hsm providers only
+// hold the public keys and delegate signing to the backends, so the signer never holds the private keys.
+type KeyProvider interface {
+	// KeyManager returns the key manager of the account specified by the sign type.
+	KeyManager(scope SignType) (keys.KeyManager, error)
+	// Close releases the resources of the provider.
+	Close() error
+}
+
+// NewKeyProvider returns the key provider specified by the sp account config.
+func NewKeyProvider(cfg *gfspconfig.SpAccountConfig) (KeyProvider, error) {
+	switch strings.ToLower(cfg.KeyProvider) {
+	case "", LocalKeyProvider:
+		return newLocalKeyProvider(cfg), nil
+	case KeystoreKeyProvider:
+		password, err := readSecret(cfg.Keystore.PasswordFile, SpKeystorePassword)
+		if err != nil {
+			return nil, err
+		}
+		return NewKeystoreKeyProvider(cfg.Keystore.Dir, password)
+	case RemoteKeyProvider:
+		return NewRemoteKeyProvider(cfg.RemoteSigner)
+	case HSMKeyProvider:
+		pin, err := readSecret(cfg.HSM.PinFile, SpHSMPin)
+		if err != nil {
+			return nil, err
+		}
+		return NewHSMKeyProvider(cfg.HSM, pin)
+	default:
+		return nil, fmt.Errorf("invalid key provider: %s", cfg.KeyProvider)
+	}
+}
+
+// readSecret reads the secret from env variable first, and then from the file.
+func readSecret(file, env string) (string, error) {
+	if val, ok := os.LookupEnv(env); ok {
+		return val, nil
+	}
+	if file == "" {
+		return "", fmt.Errorf("neither %s env variable nor secret file is set", env)
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+// localKeyProvider reads the raw hex private keys from config, it is kept for compatibility and the
+// other key providers are recommended in production.
+type localKeyProvider struct {
+	keys map[SignType]string
+}
+
+func newLocalKeyProvider(cfg *gfspconfig.SpAccountConfig) *localKeyProvider {
+	return &localKeyProvider{keys: map[SignType]string{
+		SignOperator: cfg.OperatorPrivateKey,
+		SignSeal:     cfg.SealPrivateKey,
+		SignApproval: cfg.ApprovalPrivateKey,
+		SignGc:       cfg.GcPrivateKey,
+		SignBls:      cfg.BlsPrivateKey,
+	}}
+}
+
+func (p *localKeyProvider) KeyManager(scope SignType) (keys.KeyManager, error) {
+	key, ok := p.keys[scope]
+	if !ok {
+		return nil, fmt.Errorf("unknown key: %s", scope)
+	}
+	if scope == SignBls {
+		return keys.NewBlsPrivateKeyManager(key)
+	}
+	return keys.NewPrivateKeyManager(key)
+}
+
+func (p *localKeyProvider) Close() error {
+	return nil
+}
+
+var _ keys.KeyManager = &delegatedKeyManager{}
+
+// delegatedKeyManager implements keys.KeyManager by delegating signing to the remote signer or the hsm
+// token, it only holds the public key.
+type delegatedKeyManager struct {
+	pubKey ctypes.PubKey
+	addr   sdk.AccAddress
+	sign   func(msg []byte) ([]byte, error)
+}
+
+func newDelegatedKeyManager(keyType types.KeyType, pubKey []byte, sign func(msg []byte) ([]byte, error)) (
+	*delegatedKeyManager, error) {
+	pk, err := newPubKey(keyType, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	return &delegatedKeyManager{pubKey: pk, addr: sdk.AccAddress(pk.Address()), sign: sign}, nil
+}
+
+func (km *delegatedKeyManager) Bytes() []byte {
+	panic("Not allow to get privKey bytes from KeyManager")
+}
+
+func (km *delegatedKeyManager) Sign(msg []byte) ([]byte, error) {
+	return km.sign(msg)
+}
+
+func (km *delegatedKeyManager) PubKey() ctypes.PubKey {
+	return km.pubKey
+}
+
+func (km *delegatedKeyManager) Equals(key ctypes.LedgerPrivKey) bool {
+	return km.pubKey.Equals(key.PubKey())
+}
+
+func (km *delegatedKeyManager) Type() string {
+	return km.pubKey.Type()
+}
+
+func (km *delegatedKeyManager) GetAddr() sdk.AccAddress {
+	return km.addr
+}
+
+func (km *delegatedKeyManager) String() string { return "" }
+func (km *delegatedKeyManager) ProtoMessage()  {}
+func (km *delegatedKeyManager) Reset()         {}
+
+func newPubKey(keyType types.KeyType, pubKey []byte) (ctypes.PubKey, error) {
+	switch keyType {
+	case types.KeyType_KEY_TYPE_ETH_SECP256K1:
+		if len(pubKey) != ethsecp256k1.PubKeySize {
+			return nil, fmt.Errorf("invalid secp256k1 public key size: %d", len(pubKey))
+		}
+		return &ethsecp256k1.PubKey{Key: pubKey}, nil
+	case types.KeyType_KEY_TYPE_ETH_BLS:
+		if len(pubKey) != blsPubKeySize {
+			return nil, fmt.Errorf("invalid bls public key size: %d", len(pubKey))
+		}
+		return &bls.PubKey{Key: pubKey}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type: %s", keyType)
+	}
+}
+
+// keyTypeOf returns the key type of the sign type, only the bls key is not a secp256k1 key.
+func keyTypeOf(scope SignType) types.KeyType {
+	if scope == SignBls {
+		return types.KeyType_KEY_TYPE_ETH_BLS
+	}
+	return types.KeyType_KEY_TYPE_ETH_SECP256K1
+}
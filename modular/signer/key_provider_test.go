@@ -0,0 +1,326 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/signer/types"
+)
+
+const mockPassword = "mockPassword"
+
+func init() {
+	scryptN = keystore.LightScryptN
+	scryptP = keystore.LightScryptP
+}
+
+func genSecpKey(t *testing.T) []byte {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	return crypto.FromECDSA(key)
+}
+
+func writeKeystore(t *testing.T, dir string, scope SignType, privKey []byte) {
+	t.Helper()
+	data, err := EncryptKeystore(privKey, types.KeyType_KEY_TYPE_ETH_SECP256K1, mockPassword)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, string(scope)+".json"), data, 0600))
+}
+
+func TestKeystore_EncryptDecrypt(t *testing.T) {
+	privKey := genSecpKey(t)
+	data, err := EncryptKeystore(privKey, types.KeyType_KEY_TYPE_ETH_SECP256K1, mockPassword)
+	require.NoError(t, err)
+
+	pk, err := DecryptKeystore(data, mockPassword)
+	require.NoError(t, err)
+	assert.Equal(t, privKey, pk.Bytes())
+
+	_, err = DecryptKeystore(data, "wrongPassword")
+	assert.Error(t, err)
+
+	_, err = EncryptKeystore([]byte{1, 2, 3}, types.KeyType_KEY_TYPE_ETH_SECP256K1, mockPassword)
+	assert.Error(t, err)
+}
+
+func TestKeystoreKeyProvider(t *testing.T) {
+	dir := t.TempDir()
+	privKey := genSecpKey(t)
+	writeKeystore(t, dir, SignOperator, privKey)
+
+	t.Setenv(SpKeystorePassword, mockPassword)
+	provider, err := NewKeyProvider(&gfspconfig.SpAccountConfig{
+		KeyProvider: KeystoreKeyProvider,
+		Keystore:    gfspconfig.KeystoreConfig{Dir: dir},
+	})
+	require.NoError(t, err)
+	defer provider.Close()
+
+	km, err := provider.KeyManager(SignOperator)
+	require.NoError(t, err)
+	ecdsaKey, err := crypto.ToECDSA(privKey)
+	require.NoError(t, err)
+	assert.Equal(t, crypto.PubkeyToAddress(ecdsaKey.PublicKey).Bytes(), km.GetAddr().Bytes())
+	assert.Panics(t, func() { km.Bytes() })
+
+	msg := []byte("mockMessage")
+	sig, err := km.Sign(msg)
+	require.NoError(t, err)
+	assert.True(t, verifySignature(km.PubKey(), msg, sig))
+
+	_, err = provider.KeyManager(SignSeal)
+	assert.Error(t, err)
+}
+
+func TestHSMKeyProvider_SoftToken(t *testing.T) {
+	token := NewSoftToken("")
+	provider, err := newHSMKeyProvider(token, "")
+	require.NoError(t, err)
+
+	_, err = token.ImportKey(string(SignSeal), types.KeyType_KEY_TYPE_ETH_SECP256K1, genSecpKey(t))
+	require.NoError(t, err)
+	km, err := provider.KeyManager(SignSeal)
+	require.NoError(t, err)
+	msg := crypto.Keccak256([]byte("mockMessage"))
+	sig, err := km.Sign(msg)
+	require.NoError(t, err)
+	assert.True(t, verifySignature(km.PubKey(), msg, sig))
+
+	_, err = provider.KeyManager(SignGc)
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+
+	require.NoError(t, provider.Close())
+	_, err = km.Sign(msg)
+	assert.ErrorIs(t, err, ErrTokenNotLoggedIn)
+}
+
+func TestHSMKeyProvider_SoftTokenKeystore(t *testing.T) {
+	dir := t.TempDir()
+	writeKeystore(t, dir, SignApproval, genSecpKey(t))
+
+	_, err := NewHSMKeyProvider(gfspconfig.HSMConfig{Token: "unknown"}, mockPassword)
+	assert.Error(t, err)
+	_, err = NewHSMKeyProvider(gfspconfig.HSMConfig{Token: SoftTokenName, TokenPath: dir}, "wrongPin")
+	assert.Error(t, err)
+
+	provider, err := NewHSMKeyProvider(gfspconfig.HSMConfig{Token: SoftTokenName, TokenPath: dir}, mockPassword)
+	require.NoError(t, err)
+	defer provider.Close()
+	km, err := provider.KeyManager(SignApproval)
+	require.NoError(t, err)
+	msg := []byte("mockMessage")
+	sig, err := km.Sign(msg)
+	require.NoError(t, err)
+	assert.True(t, verifySignature(km.PubKey(), msg, sig))
+}
+
+// wrongKeySignerServer signs by a key other than the one it reports.
+type wrongKeySignerServer struct {
+	*RemoteSignerServer
+	other *RemoteSignerServer
+}
+
+func (s *wrongKeySignerServer) Sign(ctx context.Context, req *types.SignRequest) (*types.SignResponse, error) {
+	return s.other.Sign(ctx, req)
+}
+
+func dialRemoteSigner(t *testing.T, server types.RemoteSignerServiceServer) *remoteKeyProvider {
+	t.Helper()
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	types.RegisterRemoteSignerServiceServer(s, server)
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	return newRemoteKeyProvider(conn, time.Second)
+}
+
+func newSoftTokenServer(t *testing.T, scopes ...SignType) *RemoteSignerServer {
+	t.Helper()
+	token := NewSoftToken("")
+	provider, err := newHSMKeyProvider(token, "")
+	require.NoError(t, err)
+	for _, scope := range scopes {
+		_, err = token.ImportKey(string(scope), types.KeyType_KEY_TYPE_ETH_SECP256K1, genSecpKey(t))
+		require.NoError(t, err)
+	}
+	return NewRemoteSignerServer(provider)
+}
+
+func TestRemoteKeyProvider(t *testing.T) {
+	provider := dialRemoteSigner(t, newSoftTokenServer(t, SignOperator, SignSeal))
+	defer provider.Close()
+
+	km, err := provider.KeyManager(SignSeal)
+	require.NoError(t, err)
+	msg := []byte("mockMessage")
+	sig, err := km.Sign(msg)
+	require.NoError(t, err)
+	assert.True(t, verifySignature(km.PubKey(), msg, sig))
+
+	operatorKM, err := provider.KeyManager(SignOperator)
+	require.NoError(t, err)
+	assert.NotEqual(t, km.GetAddr(), operatorKM.GetAddr())
+
+	_, err = provider.KeyManager(SignGc)
+	assert.Error(t, err)
+	_, err = provider.client.GetPubKey(context.Background(), &types.GetPubKeyRequest{KeyName: "unknown"})
+	assert.Error(t, err)
+}
+
+func TestRemoteKeyProvider_InvalidSignature(t *testing.T) {
+	provider := dialRemoteSigner(t, &wrongKeySignerServer{
+		RemoteSignerServer: newSoftTokenServer(t, SignSeal),
+		other:              newSoftTokenServer(t, SignSeal),
+	})
+	defer provider.Close()
+
+	km, err := provider.KeyManager(SignSeal)
+	require.NoError(t, err)
+	_, err = km.Sign([]byte("mockMessage"))
+	assert.Error(t, err)
+}
+
+// mockCA issues the certificates for the mutual TLS tests.
+type mockCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	file string
+}
+
+func newMockCA(t *testing.T, dir, name string) *mockCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	file := filepath.Join(dir, name+".pem")
+	require.NoError(t, os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	return &mockCA{cert: cert, key: key, file: file}
+}
+
+// issue writes the certificate and key files signed by the CA, and returns their paths.
+func (ca *mockCA) issue(t *testing.T, dir, name string, usage x509.ExtKeyUsage) (string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	certFile, keyFile := filepath.Join(dir, name+".pem"), filepath.Join(dir, name+".key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+	return certFile, keyFile
+}
+
+func TestRemoteSigner_MutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newMockCA(t, dir, "ca")
+	otherCA := newMockCA(t, dir, "otherCA")
+	serverCert, serverKey := ca.issue(t, dir, "server", x509.ExtKeyUsageServerAuth)
+	clientCert, clientKey := ca.issue(t, dir, "client", x509.ExtKeyUsageClientAuth)
+	rogueCert, rogueKey := otherCA.issue(t, dir, "rogue", x509.ExtKeyUsageClientAuth)
+
+	token := NewSoftToken("")
+	provider, err := newHSMKeyProvider(token, "")
+	require.NoError(t, err)
+	_, err = token.ImportKey(string(SignSeal), types.KeyType_KEY_TYPE_ETH_SECP256K1, genSecpKey(t))
+	require.NoError(t, err)
+
+	_, err = NewRemoteSignerGRPCServer(provider, gfspconfig.RemoteSignerServerConfig{
+		TLSCertFile: serverCert, TLSKeyFile: serverKey})
+	assert.Error(t, err)
+	server, err := NewRemoteSignerGRPCServer(provider, gfspconfig.RemoteSignerServerConfig{
+		TLSCertFile: serverCert, TLSKeyFile: serverKey, TLSClientCAFile: ca.file})
+	require.NoError(t, err)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = server.Serve(lis) }()
+	defer server.Stop()
+
+	_, err = NewRemoteKeyProvider(gfspconfig.RemoteSignerConfig{Address: lis.Addr().String(), TLSCAFile: ca.file})
+	assert.Error(t, err)
+
+	client, err := NewRemoteKeyProvider(gfspconfig.RemoteSignerConfig{Address: lis.Addr().String(),
+		TLSCAFile: ca.file, TLSCertFile: clientCert, TLSKeyFile: clientKey, TimeoutSecond: 5})
+	require.NoError(t, err)
+	defer client.Close()
+	km, err := client.KeyManager(SignSeal)
+	require.NoError(t, err)
+	msg := []byte("mockMessage")
+	sig, err := km.Sign(msg)
+	require.NoError(t, err)
+	assert.True(t, verifySignature(km.PubKey(), msg, sig))
+
+	rogue, err := NewRemoteKeyProvider(gfspconfig.RemoteSignerConfig{Address: lis.Addr().String(),
+		TLSCAFile: ca.file, TLSCertFile: rogueCert, TLSKeyFile: rogueKey, TimeoutSecond: 5})
+	require.NoError(t, err)
+	defer rogue.Close()
+	_, err = rogue.KeyManager(SignSeal)
+	assert.Error(t, err)
+}
+
+func TestRunRemoteSigner_InvalidKeyProvider(t *testing.T) {
+	for _, keyProvider := range []string{"", LocalKeyProvider, RemoteKeyProvider} {
+		err := RunRemoteSigner(context.Background(), &gfspconfig.SpAccountConfig{KeyProvider: keyProvider,
+			RemoteSignerServer: gfspconfig.RemoteSignerServerConfig{Address: "127.0.0.1:0"}})
+		assert.Error(t, err, keyProvider)
+	}
+}
+
+func TestNewKeyProvider(t *testing.T) {
+	_, err := NewKeyProvider(&gfspconfig.SpAccountConfig{KeyProvider: "unknown"})
+	assert.Error(t, err)
+	_, err = NewKeyProvider(&gfspconfig.SpAccountConfig{KeyProvider: RemoteKeyProvider})
+	assert.Error(t, err)
+
+	provider, err := NewKeyProvider(&gfspconfig.SpAccountConfig{})
+	require.NoError(t, err)
+	_, err = provider.KeyManager(SignOperator)
+	assert.Error(t, err)
+}
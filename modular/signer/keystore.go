@@ -0,0 +1,140 @@
+package signer
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/bls"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/ethsecp256k1"
+	ctypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+
+	"github.com/bnb-chain/greenfield-storage-provider/modular/signer/types"
+	"github.com/bnb-chain/greenfield/sdk/keys"
+)
+
+// keystoreVersion defines the version of keystore file, it is compatible with the keystore v3 of ethereum,
+// so the keystore files of secp256k1 keys exported by other wallets can be used directly.
+const keystoreVersion = 3
+
+// scryptN and scryptP are the scrypt parameters to encrypt keystore files, they are lowered in tests.
+var (
+	scryptN = keystore.StandardScryptN
+	scryptP = keystore.StandardScryptP
+)
+
+// keystoreFile is the content of encrypted keystore file, the private key is encrypted by scrypt and
+// aes-128-ctr as same as the keystore v3 of ethereum.
+type keystoreFile struct {
+	// Type is the key type, ethereum keystore files have no type and are secp256k1 keys
+	Type    string              `json:"type,omitempty"`
+	Address string              `json:"address,omitempty"`
+	Crypto  keystore.CryptoJSON `json:"crypto"`
+	Version int                 `json:"version"`
+}
+
+// EncryptKeystore encrypts the private key to the content of keystore file by the password.
+func EncryptKeystore(privKey []byte, keyType types.KeyType, password string) ([]byte, error) {
+	pk, err := newPrivKey(keyType, privKey)
+	if err != nil {
+		return nil, err
+	}
+	cryptoJSON, err := keystore.EncryptDataV3(privKey, []byte(password), scryptN, scryptP)
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(&keystoreFile{
+		Type:    pk.Type(),
+		Address: pk.PubKey().Address().String(),
+		Crypto:  cryptoJSON,
+		Version: keystoreVersion,
+	}, "", "  ")
+}
+
+// DecryptKeystore decrypts the private key from the content of keystore file by the password.
+func DecryptKeystore(data []byte, password string) (ctypes.PrivKey, error) {
+	var kf keystoreFile
+	if err := json.Unmarshal(data, &kf); err != nil {
+		return nil, fmt.Errorf("failed to parse keystore file: %w", err)
+	}
+	if kf.Version != keystoreVersion {
+		return nil, fmt.Errorf("unsupported keystore version: %d", kf.Version)
+	}
+	keyType := types.KeyType_KEY_TYPE_ETH_SECP256K1
+	switch kf.Type {
+	case "", ethsecp256k1.KeyType:
+	case bls.KeyType:
+		keyType = types.KeyType_KEY_TYPE_ETH_BLS
+	default:
+		return nil, fmt.Errorf("unsupported keystore key type: %s", kf.Type)
+	}
+	privKey, err := keystore.DecryptDataV3(kf.Crypto, password)
+	if err != nil {
+		return nil, err
+	}
+	return newPrivKey(keyType, privKey)
+}
+
+// keystoreKeyProvider reads the private keys from the encrypted keystore files, the files are named by
+// the sign type such as operator.json in the keystore directory. The keys are only encrypted at rest, they are
+// decrypted into the memory of the process which loads them.
+type keystoreKeyProvider struct {
+	dir      string
+	password string
+}
+
+// NewKeystoreKeyProvider returns a key provider which reads the encrypted keystore files in dir.
+func NewKeystoreKeyProvider(dir, password string) (KeyProvider, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("keystore directory is not set")
+	}
+	if _, err := os.Stat(dir); err != nil {
+		return nil, err
+	}
+	return &keystoreKeyProvider{dir: dir, password: password}, nil
+}
+
+func (p *keystoreKeyProvider) KeyManager(scope SignType) (keys.KeyManager, error) {
+	data, err := os.ReadFile(filepath.Join(p.dir, string(scope)+".json"))
+	if err != nil {
+		return nil, err
+	}
+	privKey, err := DecryptKeystore(data, p.password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt %s keystore: %w", scope, err)
+	}
+	if privKey.Type() != keyTypeName(keyTypeOf(scope)) {
+		return nil, fmt.Errorf("mismatched %s key type: %s", scope, privKey.Type())
+	}
+	return newDelegatedKeyManager(keyTypeOf(scope), privKey.PubKey().Bytes(), privKey.Sign)
+}
+
+func (p *keystoreKeyProvider) Close() error {
+	return nil
+}
+
+func newPrivKey(keyType types.KeyType, privKey []byte) (ctypes.PrivKey, error) {
+	switch keyType {
+	case types.KeyType_KEY_TYPE_ETH_SECP256K1:
+		if len(privKey) != ethsecp256k1.PrivKeySize {
+			return nil, fmt.Errorf("invalid secp256k1 private key size: %d", len(privKey))
+		}
+		return &ethsecp256k1.PrivKey{Key: privKey}, nil
+	case types.KeyType_KEY_TYPE_ETH_BLS:
+		if len(privKey) != 32 {
+			return nil, fmt.Errorf("invalid bls private key size: %d", len(privKey))
+		}
+		return &bls.PrivKey{Key: privKey}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type: %s", keyType)
+	}
+}
+
+func keyTypeName(keyType types.KeyType) string {
+	if keyType == types.KeyType_KEY_TYPE_ETH_BLS {
+		return bls.KeyType
+	}
+	return ethsecp256k1.KeyType
+}
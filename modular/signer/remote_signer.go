@@ -0,0 +1,267 @@
+package signer
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/ethsecp256k1"
+	ctypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/signer/types"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield/sdk/keys"
+)
+
+// DefaultRemoteSignerTimeout defines the default timeout of requests to the remote signer
+const DefaultRemoteSignerTimeout = 10 * time.Second
+
+// remoteKeyProvider signs by a remote signer which implements the RemoteSignerService protocol, the
+// private keys are held by the remote signer and never sent to the sp. The connection is mutual TLS, so that
+// the remote signer only signs for the sp which presents a trusted client certificate.
+type remoteKeyProvider struct {
+	conn    *grpc.ClientConn
+	client  types.RemoteSignerServiceClient
+	timeout time.Duration
+}
+
+// NewRemoteKeyProvider returns a key provider which connects to the remote signer.
+func NewRemoteKeyProvider(cfg gfspconfig.RemoteSignerConfig) (KeyProvider, error) {
+	if cfg.Address == "" {
+		return nil, fmt.Errorf("remote signer address is not set")
+	}
+	tlsConfig, err := remoteSignerClientTLS(cfg)
+	if err != nil {
+		log.Errorw("failed to load remote signer tls files", "error", err)
+		return nil, err
+	}
+	conn, err := grpc.Dial(cfg.Address, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	if err != nil {
+		log.Errorw("failed to dial remote signer", "address", cfg.Address, "error", err)
+		return nil, err
+	}
+	timeout := time.Duration(cfg.TimeoutSecond) * time.Second
+	if timeout <= 0 {
+		timeout = DefaultRemoteSignerTimeout
+	}
+	return newRemoteKeyProvider(conn, timeout), nil
+}
+
+// remoteSignerClientTLS returns the TLS config which verifies the remote signer by the CA and presents the
+// client certificate to it.
+func remoteSignerClientTLS(cfg gfspconfig.RemoteSignerConfig) (*tls.Config, error) {
+	if cfg.TLSCAFile == "" || cfg.TLSCertFile == "" || cfg.TLSKeyFile == "" {
+		return nil, fmt.Errorf("remote signer requires TLSCAFile, TLSCertFile and TLSKeyFile")
+	}
+	cert, err := tls.LoadX509KeyPair(cfg.TLSCertFile, cfg.TLSKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := loadCertPool(cfg.TLSCAFile)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{Certificates: []tls.Certificate{cert}, RootCAs: pool, MinVersion: tls.VersionTLS13}, nil
+}
+
+// loadCertPool loads the PEM encoded CA certificates in the file.
+func loadCertPool(file string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no certificate is found in %s", file)
+	}
+	return pool, nil
+}
+
+func newRemoteKeyProvider(conn *grpc.ClientConn, timeout time.Duration) *remoteKeyProvider {
+	return &remoteKeyProvider{conn: conn, client: types.NewRemoteSignerServiceClient(conn), timeout: timeout}
+}
+
+func (p *remoteKeyProvider) KeyManager(scope SignType) (keys.KeyManager, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+	resp, err := p.client.GetPubKey(ctx, &types.GetPubKeyRequest{KeyName: string(scope)})
+	if err != nil {
+		log.Errorw("failed to get public key from remote signer", "key", scope, "error", err)
+		return nil, err
+	}
+	if resp.GetKeyType() != keyTypeOf(scope) {
+		return nil, fmt.Errorf("mismatched %s key type: %s", scope, resp.GetKeyType())
+	}
+	var km *delegatedKeyManager
+	km, err = newDelegatedKeyManager(resp.GetKeyType(), resp.GetPubKey(), func(msg []byte) ([]byte, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+		defer cancel()
+		signResp, err := p.client.Sign(ctx, &types.SignRequest{KeyName: string(scope), SignBytes: msg})
+		if err != nil {
+			log.Errorw("failed to sign by remote signer", "key", scope, "error", err)
+			return nil, err
+		}
+		// the signature is verified in case the remote signer signs by an unexpected key
+		if !verifySignature(km.pubKey, msg, signResp.GetSignature()) {
+			return nil, fmt.Errorf("invalid signature from remote signer for %s key", scope)
+		}
+		return signResp.GetSignature(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return km, nil
+}
+
+func (p *remoteKeyProvider) Close() error {
+	return p.conn.Close()
+}
+
+// verifySignature verifies the signature as same as it is signed by the Sign method of the private key,
+// the secp256k1 private key signs the 32 bytes message as digest directly and hashes the others.
+func verifySignature(pubKey ctypes.PubKey, msg, sig []byte) bool {
+	pk, ok := pubKey.(*ethsecp256k1.PubKey)
+	if !ok {
+		return pubKey.VerifySignature(msg, sig)
+	}
+	if len(sig) != crypto.SignatureLength {
+		return false
+	}
+	digest := msg
+	if len(digest) != crypto.DigestLength {
+		digest = crypto.Keccak256(msg)
+	}
+	return crypto.VerifySignature(pk.Key, digest, sig[:crypto.SignatureLength-1])
+}
+
+var _ types.RemoteSignerServiceServer = &RemoteSignerServer{}
+
+// RemoteSignerServer serves the RemoteSignerService protocol by the keys of a key provider, it is used to
+// run the remote signer on an isolated host.
+type RemoteSignerServer struct {
+	provider KeyProvider
+
+	mu  sync.Mutex
+	kms map[SignType]keys.KeyManager
+}
+
+// NewRemoteSignerServer returns a remote signer server which signs by the keys of the provider.
+func NewRemoteSignerServer(provider KeyProvider) *RemoteSignerServer {
+	return &RemoteSignerServer{provider: provider, kms: make(map[SignType]keys.KeyManager)}
+}
+
+func (s *RemoteSignerServer) keyManager(name string) (keys.KeyManager, error) {
+	scope := SignType(name)
+	switch scope {
+	case SignOperator, SignSeal, SignApproval, SignGc, SignBls:
+	default:
+		return nil, status.Errorf(codes.NotFound, "unknown key: %s", name)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if km, ok := s.kms[scope]; ok {
+		return km, nil
+	}
+	km, err := s.provider.KeyManager(scope)
+	if err != nil {
+		log.Errorw("failed to load key", "key", name, "error", err)
+		return nil, status.Errorf(codes.Unavailable, "failed to load key: %s", name)
+	}
+	s.kms[scope] = km
+	return km, nil
+}
+
+func (s *RemoteSignerServer) GetPubKey(ctx context.Context, req *types.GetPubKeyRequest) (*types.GetPubKeyResponse, error) {
+	km, err := s.keyManager(req.GetKeyName())
+	if err != nil {
+		return nil, err
+	}
+	return &types.GetPubKeyResponse{KeyType: keyTypeOf(SignType(req.GetKeyName())), PubKey: km.PubKey().Bytes()}, nil
+}
+
+func (s *RemoteSignerServer) Sign(ctx context.Context, req *types.SignRequest) (*types.SignResponse, error) {
+	km, err := s.keyManager(req.GetKeyName())
+	if err != nil {
+		return nil, err
+	}
+	sig, err := km.Sign(req.GetSignBytes())
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to sign", "key", req.GetKeyName(), "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to sign: %v", err)
+	}
+	log.CtxDebugw(ctx, "succeed to sign", "key", req.GetKeyName())
+	return &types.SignResponse{Signature: sig}, nil
+}
+
+// NewRemoteSignerGRPCServer returns the grpc server which serves the RemoteSignerService protocol by the keys of
+// the provider, it requires the client certificates and verifies them by TLSClientCAFile.
+func NewRemoteSignerGRPCServer(provider KeyProvider, cfg gfspconfig.RemoteSignerServerConfig) (*grpc.Server, error) {
+	if cfg.TLSCertFile == "" || cfg.TLSKeyFile == "" || cfg.TLSClientCAFile == "" {
+		return nil, fmt.Errorf("remote signer server requires TLSCertFile, TLSKeyFile and TLSClientCAFile")
+	}
+	cert, err := tls.LoadX509KeyPair(cfg.TLSCertFile, cfg.TLSKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := loadCertPool(cfg.TLSClientCAFile)
+	if err != nil {
+		return nil, err
+	}
+	server := grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS13,
+	})))
+	types.RegisterRemoteSignerServiceServer(server, NewRemoteSignerServer(provider))
+	return server, nil
+}
+
+// RunRemoteSigner serves the keys of the key provider in cfg as the remote signer until ctx is done. Only the
+// keystore and hsm providers can be served, the raw keys of local provider are not meant to leave the sp.
+func RunRemoteSigner(ctx context.Context, cfg *gfspconfig.SpAccountConfig) error {
+	switch strings.ToLower(cfg.KeyProvider) {
+	case KeystoreKeyProvider, HSMKeyProvider:
+	default:
+		return fmt.Errorf("remote signer only serves keystore or hsm keys, invalid key provider: %s", cfg.KeyProvider)
+	}
+	if cfg.RemoteSignerServer.Address == "" {
+		return fmt.Errorf("remote signer server address is not set")
+	}
+	provider, err := NewKeyProvider(cfg)
+	if err != nil {
+		return err
+	}
+	defer provider.Close()
+	server, err := NewRemoteSignerGRPCServer(provider, cfg.RemoteSignerServer)
+	if err != nil {
+		return err
+	}
+	lis, err := net.Listen("tcp", cfg.RemoteSignerServer.Address)
+	if err != nil {
+		return err
+	}
+	stopped := make(chan struct{})
+	defer close(stopped)
+	go func() {
+		select {
+		case <-ctx.Done():
+			server.GracefulStop()
+		case <-stopped:
+		}
+	}()
+	log.Infow("remote signer is serving", "address", cfg.RemoteSignerServer.Address, "key_provider", cfg.KeyProvider)
+	return server.Serve(lis)
+}
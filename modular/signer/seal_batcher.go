@@ -0,0 +1,213 @@
+package signer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+)
+
+// DefaultSealBatchWindow defines the default time the first seal msg of a batch waits for the others
+const DefaultSealBatchWindow = 500 * time.Millisecond
+
+const (
+	// DefaultWaitTxTimeout defines the max time to wait for a broadcast tx to be committed
+	DefaultWaitTxTimeout = 30 * time.Second
+	// DefaultWaitTxInterval defines the interval to query whether a broadcast tx is committed
+	DefaultWaitTxInterval = time.Second
+	// DefaultSealBatchMaxGasLimit defines the default max gas limit of a seal batch tx, it bounds the batch size
+	// since the gas limit and fee of the tx are scaled by the number of msgs
+	DefaultSealBatchMaxGasLimit = 1000000
+)
+
+var (
+	// ErrSealBatcherStopped is returned if the seal msg is submitted after the batcher is stopped
+	ErrSealBatcherStopped = errors.New("seal batcher is stopped")
+	// errTxNotCommitted is returned if the broadcast tx is not committed within DefaultWaitTxTimeout, the tx
+	// may still be committed later
+	errTxNotCommitted = errors.New("tx is not committed in time")
+)
+
+// broadcastFunc broadcasts the msgs in one tx under the account of the scope and returns the tx hash.
+type broadcastFunc func(ctx context.Context, scope SignType, msgs []sdk.Msg) (string, error)
+
+// waitTxFunc waits until the tx is committed, returns txRejectedError if the tx fails in DeliverTx and
+// errTxNotCommitted if it is still pending after DefaultWaitTxTimeout.
+type waitTxFunc func(ctx context.Context, scope SignType, txHash string) error
+
+type sealRequest struct {
+	ctx    context.Context
+	msg    sdk.Msg
+	result chan sealResult
+}
+
+type sealResult struct {
+	txHash string
+	err    error
+}
+
+// sealBatcher coalesces the pending seal msgs into multi-msg txs, a batch is broadcast once it has size msgs or
+// the window since its first msg elapses. The callers get the result after the tx is committed, since a bad
+// seal msg passes CheckTx and fails the whole tx in DeliverTx. If the chain rejects a batch in either step,
+// the batch is split in halves and broadcast again, so only the callers of the bad msgs get the error.
+// All the batches are broadcast under the account of scope.
+type sealBatcher struct {
+	scope     SignType
+	size      int
+	window    time.Duration
+	broadcast broadcastFunc
+	waitTx    waitTxFunc
+	requests  chan *sealRequest
+	stopCh    chan struct{}
+	doneCh    chan struct{}
+	// waiting is the batches which are broadcast and waiting to be committed
+	waiting sync.WaitGroup
+}
+
+// sealBatchSize returns the configured seal batch size bounded by the max gas limit of a batch tx.
+func sealBatchSize(cfg *gfspconfig.ChainConfig) int {
+	maxGasLimit := cfg.SealBatchMaxGasLimit
+	if maxGasLimit == 0 {
+		maxGasLimit = DefaultSealBatchMaxGasLimit
+	}
+	size := uint64(cfg.SealBatchSize)
+	if cfg.SealGasLimit > 0 && size*cfg.SealGasLimit > maxGasLimit {
+		size = maxGasLimit / cfg.SealGasLimit
+	}
+	return int(size)
+}
+
+func newSealBatcher(scope SignType, size int, window time.Duration, broadcast broadcastFunc, waitTx waitTxFunc) *sealBatcher {
+	if window <= 0 {
+		window = DefaultSealBatchWindow
+	}
+	return &sealBatcher{
+		scope:     scope,
+		size:      size,
+		window:    window,
+		broadcast: broadcast,
+		waitTx:    waitTx,
+		requests:  make(chan *sealRequest),
+		stopCh:    make(chan struct{}),
+		doneCh:    make(chan struct{}),
+	}
+}
+
+func (b *sealBatcher) start() {
+	go b.loop()
+}
+
+// stop stops the batcher after the pending batch is broadcast and the broadcast batches are committed.
+func (b *sealBatcher) stop() {
+	close(b.stopCh)
+	<-b.doneCh
+	b.waiting.Wait()
+}
+
+// seal submits the seal msg and waits for the result of the tx which contains it.
+func (b *sealBatcher) seal(ctx context.Context, msg sdk.Msg) (string, error) {
+	req := &sealRequest{ctx: ctx, msg: msg, result: make(chan sealResult, 1)}
+	select {
+	case b.requests <- req:
+	case <-ctx.Done():
+		return "", ctx.Err()
+	case <-b.stopCh:
+		return "", ErrSealBatcherStopped
+	}
+	select {
+	case res := <-req.result:
+		return res.txHash, res.err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+func (b *sealBatcher) loop() {
+	defer close(b.doneCh)
+	for {
+		var batch []*sealRequest
+		select {
+		case req := <-b.requests:
+			batch = append(batch, req)
+		case <-b.stopCh:
+			return
+		}
+		stopped := false
+		timer := time.NewTimer(b.window)
+	collect:
+		for len(batch) < b.size {
+			select {
+			case req := <-b.requests:
+				batch = append(batch, req)
+			case <-timer.C:
+				break collect
+			case <-b.stopCh:
+				stopped = true
+				break collect
+			}
+		}
+		timer.Stop()
+		b.flush(batch)
+		if stopped {
+			return
+		}
+	}
+}
+
+// flush broadcasts the batch except the msgs whose callers have given up.
+func (b *sealBatcher) flush(batch []*sealRequest) {
+	pending := batch[:0]
+	for _, req := range batch {
+		if err := req.ctx.Err(); err != nil {
+			req.result <- sealResult{err: err}
+			continue
+		}
+		pending = append(pending, req)
+	}
+	if len(pending) > 0 {
+		b.broadcastBatch(pending)
+	}
+}
+
+func (b *sealBatcher) broadcastBatch(batch []*sealRequest) {
+	msgs := make([]sdk.Msg, len(batch))
+	for i, req := range batch {
+		msgs[i] = req.msg
+	}
+	txHash, err := b.broadcast(context.Background(), b.scope, msgs)
+	if err != nil {
+		b.done(batch, txHash, err)
+		return
+	}
+	log.Debugw("succeed to broadcast seal batch", "batch_size", len(batch), "tx_hash", txHash)
+	// the next batch is broadcast while this one is waiting to be committed
+	b.waiting.Add(1)
+	go func() {
+		defer b.waiting.Done()
+		b.done(batch, txHash, b.waitTx(context.Background(), b.scope, txHash))
+	}()
+}
+
+// done returns the result to the callers of the batch, the rejected batch is split and broadcast again. The
+// callers keep the tx hash if the tx is still pending, so that they can look it up later.
+func (b *sealBatcher) done(batch []*sealRequest, txHash string, err error) {
+	var rejected *txRejectedError
+	if err != nil && len(batch) > 1 && errors.As(err, &rejected) {
+		log.Warnw("seal batch is rejected, split and retry", "batch_size", len(batch), "tx_hash", txHash, "error", err)
+		mid := len(batch) / 2
+		b.broadcastBatch(batch[:mid])
+		b.broadcastBatch(batch[mid:])
+		return
+	}
+	if err != nil && !errors.Is(err, errTxNotCommitted) {
+		txHash = ""
+	}
+	for _, req := range batch {
+		req.result <- sealResult{txHash: txHash, err: err}
+	}
+}
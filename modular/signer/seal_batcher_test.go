@@ -0,0 +1,250 @@
+package signer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+)
+
+const (
+	badObjectName    = "badObject"
+	failedObjectName = "failedObject"
+)
+
+// mockChain records the broadcast batches, rejects the batches which contain the bad object in CheckTx and
+// fails the txs which contain the failed object in DeliverTx.
+type mockChain struct {
+	mu      sync.Mutex
+	batches [][]string
+	scopes  []SignType
+	err     error
+}
+
+func (c *mockChain) waitTx(ctx context.Context, scope SignType, txHash string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var i int
+	if _, err := fmt.Sscanf(txHash, "tx%d", &i); err != nil || i < 1 || i > len(c.batches) {
+		return fmt.Errorf("unknown tx %s", txHash)
+	}
+	for _, name := range c.batches[i-1] {
+		if name == failedObjectName {
+			return &txRejectedError{code: 2, codespace: "storage", txHash: txHash}
+		}
+	}
+	return nil
+}
+
+func (c *mockChain) broadcast(ctx context.Context, scope SignType, msgs []sdk.Msg) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	names := make([]string, len(msgs))
+	for i, msg := range msgs {
+		names[i] = msg.(*storagetypes.MsgSealObject).GetObjectName()
+	}
+	c.batches = append(c.batches, names)
+	c.scopes = append(c.scopes, scope)
+	if c.err != nil {
+		return "", c.err
+	}
+	for _, name := range names {
+		if name == badObjectName {
+			return "", &txRejectedError{code: 1, codespace: "storage"}
+		}
+	}
+	return fmt.Sprintf("tx%d", len(c.batches)), nil
+}
+
+func sealConcurrently(b *sealBatcher, names ...string) map[string]sealResult {
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		results = make(map[string]sealResult)
+	)
+	for _, name := range names {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			txHash, err := b.seal(context.Background(), &storagetypes.MsgSealObject{ObjectName: name})
+			mu.Lock()
+			results[name] = sealResult{txHash: txHash, err: err}
+			mu.Unlock()
+		}(name)
+	}
+	wg.Wait()
+	return results
+}
+
+func TestSealBatcher_Coalesce(t *testing.T) {
+	chain := &mockChain{}
+	b := newSealBatcher(SignSeal, 3, time.Minute, chain.broadcast, chain.waitTx)
+	b.start()
+	defer b.stop()
+
+	results := sealConcurrently(b, "object1", "object2", "object3")
+	assert.Len(t, chain.batches, 1)
+	assert.Len(t, chain.batches[0], 3)
+	for _, res := range results {
+		assert.NoError(t, res.err)
+		assert.Equal(t, "tx1", res.txHash)
+	}
+}
+
+func TestSealBatcher_Window(t *testing.T) {
+	chain := &mockChain{}
+	b := newSealBatcher(SignSeal, 10, 10*time.Millisecond, chain.broadcast, chain.waitTx)
+	b.start()
+	defer b.stop()
+
+	txHash, err := b.seal(context.Background(), &storagetypes.MsgSealObject{ObjectName: "object1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "tx1", txHash)
+	assert.Equal(t, [][]string{{"object1"}}, chain.batches)
+}
+
+func TestSealBatcher_SplitRejectedBatch(t *testing.T) {
+	chain := &mockChain{}
+	b := newSealBatcher(SignSeal, 4, time.Minute, chain.broadcast, chain.waitTx)
+	b.start()
+	defer b.stop()
+
+	results := sealConcurrently(b, "object1", "object2", badObjectName, "object3")
+	for name, res := range results {
+		if name == badObjectName {
+			var rejected *txRejectedError
+			assert.True(t, errors.As(res.err, &rejected))
+			continue
+		}
+		assert.NoError(t, res.err, name)
+		assert.NotEmpty(t, res.txHash)
+	}
+	// the whole batch, the two halves and the two msgs of the rejected half
+	assert.Len(t, chain.batches, 5)
+}
+
+func TestSealBatcher_SplitFailedTx(t *testing.T) {
+	chain := &mockChain{}
+	b := newSealBatcher(SignSeal, 4, time.Minute, chain.broadcast, chain.waitTx)
+	b.start()
+	defer b.stop()
+
+	results := sealConcurrently(b, "object1", "object2", failedObjectName, "object3")
+	for name, res := range results {
+		if name == failedObjectName {
+			var rejected *txRejectedError
+			assert.True(t, errors.As(res.err, &rejected))
+			assert.Equal(t, uint32(2), rejected.code)
+			assert.Empty(t, res.txHash)
+			continue
+		}
+		assert.NoError(t, res.err, name)
+		assert.NoError(t, chain.waitTx(context.Background(), SignSeal, res.txHash), name)
+	}
+	// the committed but failed batch, the two halves and the two msgs of the failed half
+	assert.Len(t, chain.batches, 5)
+}
+
+func TestSealBatcher_NotSplitWaitTxError(t *testing.T) {
+	chain := &mockChain{}
+	waitTx := func(ctx context.Context, scope SignType, txHash string) error {
+		return errors.New("mock wait tx error")
+	}
+	b := newSealBatcher(SignSeal, 2, time.Minute, chain.broadcast, waitTx)
+	b.start()
+	defer b.stop()
+
+	results := sealConcurrently(b, "object1", "object2")
+	assert.Len(t, chain.batches, 1)
+	for _, res := range results {
+		assert.Error(t, res.err)
+		assert.Empty(t, res.txHash)
+	}
+}
+
+func TestSealBatcher_PendingTx(t *testing.T) {
+	chain := &mockChain{}
+	waitTx := func(ctx context.Context, scope SignType, txHash string) error {
+		return fmt.Errorf("failed to wait for tx %s to be committed: %w", txHash, errTxNotCommitted)
+	}
+	b := newSealBatcher(SignSeal, 2, time.Minute, chain.broadcast, waitTx)
+	b.start()
+	defer b.stop()
+
+	results := sealConcurrently(b, "object1", "object2")
+	assert.Len(t, chain.batches, 1)
+	for _, res := range results {
+		assert.ErrorIs(t, res.err, errTxNotCommitted)
+		assert.Equal(t, "tx1", res.txHash)
+	}
+}
+
+func TestSealBatcher_Scope(t *testing.T) {
+	chain := &mockChain{}
+	b := newSealBatcher(SignGc, 2, time.Minute, chain.broadcast, chain.waitTx)
+	b.start()
+	defer b.stop()
+
+	sealConcurrently(b, "object1", "object2")
+	assert.Equal(t, []SignType{SignGc}, chain.scopes)
+}
+
+func TestSealBatcher_NotSplitBroadcastError(t *testing.T) {
+	chain := &mockChain{err: errors.New("mock broadcast error")}
+	b := newSealBatcher(SignSeal, 2, time.Minute, chain.broadcast, chain.waitTx)
+	b.start()
+	defer b.stop()
+
+	results := sealConcurrently(b, "object1", "object2")
+	assert.Len(t, chain.batches, 1)
+	for _, res := range results {
+		assert.Error(t, res.err)
+	}
+}
+
+func TestSealBatcher_Stop(t *testing.T) {
+	chain := &mockChain{}
+	b := newSealBatcher(SignSeal, 10, time.Minute, chain.broadcast, chain.waitTx)
+	b.start()
+
+	done := make(chan sealResult)
+	go func() {
+		txHash, err := b.seal(context.Background(), &storagetypes.MsgSealObject{ObjectName: "object1"})
+		done <- sealResult{txHash: txHash, err: err}
+	}()
+	// wait until the msg is collected into the pending batch
+	time.Sleep(50 * time.Millisecond)
+	b.stop()
+	res := <-done
+	assert.NoError(t, res.err)
+
+	_, err := b.seal(context.Background(), &storagetypes.MsgSealObject{ObjectName: "object2"})
+	assert.ErrorIs(t, err, ErrSealBatcherStopped)
+}
+
+func TestSealBatchSize(t *testing.T) {
+	cases := []struct {
+		name string
+		cfg  gfspconfig.ChainConfig
+		want int
+	}{
+		{"under the default max gas", gfspconfig.ChainConfig{SealBatchSize: 10, SealGasLimit: 1200}, 10},
+		{"capped by the default max gas", gfspconfig.ChainConfig{SealBatchSize: 10000, SealGasLimit: 1200}, 833},
+		{"capped by the configured max gas", gfspconfig.ChainConfig{SealBatchSize: 10, SealGasLimit: 1200,
+			SealBatchMaxGasLimit: 6000}, 5},
+		{"max gas below one msg", gfspconfig.ChainConfig{SealBatchSize: 10, SealGasLimit: 1200,
+			SealBatchMaxGasLimit: 1000}, 0},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.want, sealBatchSize(&c.cfg), c.name)
+	}
+}
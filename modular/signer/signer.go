@@ -41,6 +41,8 @@ var (
 	ErrCancelSwapIn                       = gfsperrors.Register(module.SignModularName, http.StatusBadRequest, 120018, "send cancel swap in failed")
 	ErrDelegateUpdateObjectContentOnChain = gfsperrors.Register(module.SignModularName, http.StatusBadRequest, 120019, "send DelegateUpdateObjectContent failed")
 	ErrDelegateCreateObjectOnChain        = gfsperrors.Register(module.SignModularName, http.StatusBadRequest, 120020, "send DelegateCreateObject failed")
+	ErrDelegateDeleteObjectOnChain        = gfsperrors.Register(module.SignModularName, http.StatusBadRequest, 120021, "send DelegateDeleteObject failed")
+	ErrSealObjectTxPending                = gfsperrors.Register(module.SignModularName, http.StatusGatewayTimeout, 120022, "seal object tx is not committed in time")
 )
 
 var _ module.Signer = &SignModular{}
@@ -55,11 +57,25 @@ func (s *SignModular) Name() string {
 }
 
 func (s *SignModular) Start(ctx context.Context) error {
+	if s.client.txTracker != nil {
+		if err := s.client.txTracker.start(); err != nil {
+			return err
+		}
+	}
+	if s.client.sealBatcher != nil {
+		s.client.sealBatcher.start()
+	}
 	return nil
 }
 
 func (s *SignModular) Stop(ctx context.Context) error {
-	return nil
+	if s.client.sealBatcher != nil {
+		s.client.sealBatcher.stop()
+	}
+	if s.client.txTracker != nil {
+		s.client.txTracker.stop()
+	}
+	return s.client.provider.Close()
 }
 
 func (s *SignModular) ReserveResource(ctx context.Context, state *rcmgr.ScopeStat) (
@@ -261,6 +277,10 @@ func (s *SignModular) DelegateCreateObject(ctx context.Context, msg *storagetype
 	return s.client.DelegateCreateObject(ctx, SignOperator, msg)
 }
 
+func (s *SignModular) DelegateDeleteObject(ctx context.Context, msg *storagetypes.MsgDeleteObject) (string, error) {
+	return s.client.DelegateDeleteObject(ctx, SignOperator, msg)
+}
+
 func (s *SignModular) SealObjectV2(ctx context.Context, object *storagetypes.MsgSealObjectV2) (string, error) {
 	return s.client.SealObjectV2(ctx, SignSeal, object)
 }
@@ -5,13 +5,18 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	"github.com/ethereum/go-ethereum/crypto"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
 	"github.com/bnb-chain/greenfield/sdk/client"
@@ -77,16 +82,19 @@ type GreenfieldChainSignClient struct {
 	sealAccNonce      uint64
 	gcAccNonce        uint64
 	blsKm             keys.KeyManager
+	provider          KeyProvider
+	sealBatcher       *sealBatcher
+	txTracker         *txTracker
 }
 
-// NewGreenfieldChainSignClient return the GreenfieldChainSignClient instance
-func NewGreenfieldChainSignClient(rpcAddr, chainID string, gasInfo map[GasInfoType]GasInfo, operatorPrivateKey, fundingPrivateKey,
-	sealPrivateKey, approvalPrivateKey, gcPrivateKey string, blsPrivKey string) (*GreenfieldChainSignClient, error) {
+// NewGreenfieldChainSignClient return the GreenfieldChainSignClient instance, the key managers of accounts
+// are provided by the key provider.
+func NewGreenfieldChainSignClient(rpcAddr, chainID string, gasInfo map[GasInfoType]GasInfo, provider KeyProvider) (
+	*GreenfieldChainSignClient, error) {
 	// init clients
-	// TODO: Get private key from KMS(AWS, GCP, Azure, Aliyun)
-	operatorKM, err := keys.NewPrivateKeyManager(operatorPrivateKey)
+	operatorKM, err := provider.KeyManager(SignOperator)
 	if err != nil {
-		log.Errorw("failed to new operator private key manager", "error", err)
+		log.Errorw("failed to new operator key manager", "error", err)
 		return nil, err
 	}
 
@@ -101,15 +109,15 @@ func NewGreenfieldChainSignClient(rpcAddr, chainID string, gasInfo map[GasInfoTy
 		return nil, err
 	}
 
-	blsKM, err := keys.NewBlsPrivateKeyManager(blsPrivKey)
+	blsKM, err := provider.KeyManager(SignBls)
 	if err != nil {
-		log.Errorw("failed to new bls private key manager", "error", err)
+		log.Errorw("failed to new bls key manager", "error", err)
 		return nil, err
 	}
 
-	sealKM, err := keys.NewPrivateKeyManager(sealPrivateKey)
+	sealKM, err := provider.KeyManager(SignSeal)
 	if err != nil {
-		log.Errorw("failed to new seal private key manager", "error", err)
+		log.Errorw("failed to new seal key manager", "error", err)
 		return nil, err
 	}
 	sealClient, err := client.NewGreenfieldClient(rpcAddr, chainID, client.WithKeyManager(sealKM))
@@ -123,9 +131,9 @@ func NewGreenfieldChainSignClient(rpcAddr, chainID string, gasInfo map[GasInfoTy
 		return nil, err
 	}
 
-	approvalKM, err := keys.NewPrivateKeyManager(approvalPrivateKey)
+	approvalKM, err := provider.KeyManager(SignApproval)
 	if err != nil {
-		log.Errorw("failed to new approval private key manager", "error", err)
+		log.Errorw("failed to new approval key manager", "error", err)
 		return nil, err
 	}
 	approvalClient, err := client.NewGreenfieldClient(rpcAddr, chainID, client.WithKeyManager(approvalKM))
@@ -134,9 +142,9 @@ func NewGreenfieldChainSignClient(rpcAddr, chainID string, gasInfo map[GasInfoTy
 		return nil, err
 	}
 
-	gcKM, err := keys.NewPrivateKeyManager(gcPrivateKey)
+	gcKM, err := provider.KeyManager(SignGc)
 	if err != nil {
-		log.Errorw("failed to new gc private key manager", "error", err)
+		log.Errorw("failed to new gc key manager", "error", err)
 		return nil, err
 	}
 	gcClient, err := client.NewGreenfieldClient(rpcAddr, chainID, client.WithKeyManager(gcKM))
@@ -164,6 +172,7 @@ func NewGreenfieldChainSignClient(rpcAddr, chainID string, gasInfo map[GasInfoTy
 		gcAccNonce:        gcAccNonce,
 		operatorAccNonce:  operatorAccNonce,
 		blsKm:             blsKM,
+		provider:          provider,
 	}, nil
 }
 
@@ -209,54 +218,34 @@ func (client *GreenfieldChainSignClient) SealObject(ctx context.Context, scope S
 		return "", ErrSignMsg
 	}
 
-	client.sealLock.Lock()
-	defer client.sealLock.Unlock()
-
 	msgSealObject := storagetypes.NewMsgSealObject(km.GetAddr(),
 		sealObject.GetBucketName(), sealObject.GetObjectName(), sealObject.GetGlobalVirtualGroupId(),
 		sealObject.GetSecondarySpBlsAggSignatures())
 
-	mode := tx.BroadcastMode_BROADCAST_MODE_SYNC
-
-	var (
-		txHash   string
-		nonce    uint64
-		nonceErr error
-	)
-	for i := 0; i < BroadcastTxRetry; i++ {
-		nonce = client.sealAccNonce
-		txOpt := &ctypes.TxOption{
-			NoSimulate: true,
-			Mode:       &mode,
-			GasLimit:   client.gasInfo[Seal].GasLimit,
-			FeeAmount:  client.gasInfo[Seal].FeeAmount,
-			Nonce:      nonce,
-		}
-
-		txHash, err = client.broadcastTx(ctx, client.greenfieldClients[scope], []sdk.Msg{msgSealObject}, txOpt)
-		if errors.IsOf(err, sdkErrors.ErrWrongSequence) {
-			// if nonce mismatch, wait for next block, reset nonce by querying the nonce on chain
-			nonce, nonceErr = client.getNonceOnChain(ctx, client.greenfieldClients[scope])
-			if nonceErr != nil {
-				log.CtxErrorw(ctx, "failed to get seal account nonce", "error", nonceErr)
-				ErrSealObjectOnChain.SetError(fmt.Errorf("failed to get seal account nonce, error: %v", nonceErr))
-				return "", ErrSealObjectOnChain
-			}
-			client.sealAccNonce = nonce
-		}
-
-		if err != nil {
-			log.CtxErrorw(ctx, "failed to broadcast seal object tx", "retry_number", i, "error", err)
-			continue
-		}
-		client.sealAccNonce = nonce + 1
-		log.CtxDebugw(ctx, "succeed to broadcast seal object tx", "tx_hash", txHash, "seal_msg", msgSealObject)
-		return txHash, nil
+	txHash, err := client.sealMsg(ctx, scope, msgSealObject)
+	if errors.IsOf(err, errTxNotCommitted) {
+		log.CtxWarnw(ctx, "seal object tx is not committed in time", "tx_hash", txHash, "error", err)
+		ErrSealObjectTxPending.SetError(fmt.Errorf("seal object tx %s is not committed in time", txHash))
+		return txHash, ErrSealObjectTxPending
+	}
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to broadcast seal object tx", "error", err)
+		ErrSealObjectOnChain.SetError(fmt.Errorf("failed to broadcast seal object tx, error: %v", err))
+		return "", ErrSealObjectOnChain
 	}
+	log.CtxDebugw(ctx, "succeed to broadcast seal object tx", "tx_hash", txHash, "seal_msg", msgSealObject)
+	return txHash, nil
+}
 
-	// failed to broadcast tx
-	ErrSealObjectOnChain.SetError(fmt.Errorf("failed to broadcast seal object tx, error: %v", err))
-	return "", ErrSealObjectOnChain
+// sealMsg broadcasts the seal msg through the seal batcher if it is enabled for the scope, the batched call
+// returns after the tx is committed, or with errTxNotCommitted and the tx hash if it is still pending.
+func (client *GreenfieldChainSignClient) sealMsg(ctx context.Context, scope SignType, msg sdk.Msg) (string, error) {
+	if client.sealBatcher != nil && client.sealBatcher.scope == scope {
+		return client.sealBatcher.seal(ctx, msg)
+	}
+	client.sealLock.Lock()
+	defer client.sealLock.Unlock()
+	return client.broadcastSealTx(ctx, scope, []sdk.Msg{msg})
 }
 
 // RejectUnSealObject reject seal object on the greenfield chain.
@@ -982,6 +971,59 @@ func (client *GreenfieldChainSignClient) DelegateCreateObject(ctx context.Contex
 	return "", ErrDelegateCreateObjectOnChain
 }
 
+// DelegateDeleteObject deletes the object on behalf of the user, the SP is the operator of the msg, so the user
+// should grant the SP the permission to delete the object.
+func (client *GreenfieldChainSignClient) DelegateDeleteObject(ctx context.Context, scope SignType,
+	msg *storagetypes.MsgDeleteObject) (string, error) {
+	if msg == nil {
+		log.CtxError(ctx, "delegate delete object msg pointer dangling")
+		return "", ErrDanglingPointer
+	}
+	km, err := client.greenfieldClients[scope].GetKeyManager()
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to get private key", "error", err)
+		return "", ErrSignMsg
+	}
+	client.opLock.Lock()
+	defer client.opLock.Unlock()
+
+	msg.Operator = km.GetAddr().String()
+
+	var (
+		txHash   string
+		nonce    uint64
+		nonceErr error
+	)
+	for i := 0; i < BroadcastTxRetry; i++ {
+		nonce = client.operatorAccNonce
+		txOpt := &ctypes.TxOption{
+			Nonce: nonce,
+		}
+		txHash, err = client.broadcastTx(ctx, client.greenfieldClients[scope], []sdk.Msg{msg}, txOpt)
+		if errors.IsOf(err, sdkErrors.ErrWrongSequence) {
+			// if nonce mismatches, waiting for next block, reset nonce by querying the nonce on chain
+			nonce, nonceErr = client.getNonceOnChain(ctx, client.greenfieldClients[scope])
+			if nonceErr != nil {
+				log.CtxErrorw(ctx, "failed to get operator account nonce", "error", err)
+				ErrDelegateDeleteObjectOnChain.SetError(fmt.Errorf("failed to get operator account nonce, error: %v", err))
+				return "", ErrDelegateDeleteObjectOnChain
+			}
+			client.operatorAccNonce = nonce
+		}
+		if err != nil {
+			log.CtxErrorw(ctx, "failed to broadcast delegate delete object tx", "retry_number", i, "error", err)
+			continue
+		}
+		client.operatorAccNonce = nonce + 1
+		log.CtxDebugw(ctx, "succeed to broadcast delegate delete object tx", "tx_hash", txHash, "delete_object_msg", msg)
+		return txHash, nil
+	}
+
+	// failed to broadcast tx
+	ErrDelegateDeleteObjectOnChain.SetError(fmt.Errorf("failed to delegate delete object, error: %v", err))
+	return "", ErrDelegateDeleteObjectOnChain
+}
+
 func (client *GreenfieldChainSignClient) DelegateUpdateObjectContent(ctx context.Context, scope SignType,
 	msg *storagetypes.MsgDelegateUpdateObjectContent) (string, error) {
 	if msg == nil {
@@ -1033,6 +1075,49 @@ func (client *GreenfieldChainSignClient) DelegateUpdateObjectContent(ctx context
 	return "", ErrDelegateUpdateObjectContentOnChain
 }
 
+// broadcastSealTx broadcasts the seal msgs in one tx by the seal account, the gas limit and fee amount are
+// scaled by the number of msgs. The caller should hold sealLock.
+func (client *GreenfieldChainSignClient) broadcastSealTx(ctx context.Context, scope SignType, msgs []sdk.Msg) (string, error) {
+	mode := tx.BroadcastMode_BROADCAST_MODE_SYNC
+	gasInfo := client.gasInfo[Seal]
+
+	var (
+		txHash   string
+		nonce    uint64
+		nonceErr error
+		err      error
+	)
+	for i := 0; i < BroadcastTxRetry; i++ {
+		nonce = client.sealAccNonce
+		txOpt := &ctypes.TxOption{
+			NoSimulate: true,
+			Mode:       &mode,
+			GasLimit:   gasInfo.GasLimit * uint64(len(msgs)),
+			FeeAmount:  gasInfo.FeeAmount.MulInt(sdk.NewInt(int64(len(msgs)))),
+			Nonce:      nonce,
+		}
+
+		txHash, err = client.broadcastTx(ctx, client.greenfieldClients[scope], msgs, txOpt)
+		if errors.IsOf(err, sdkErrors.ErrWrongSequence) {
+			// if nonce mismatch, wait for next block, reset nonce by querying the nonce on chain
+			nonce, nonceErr = client.getNonceOnChain(ctx, client.greenfieldClients[scope])
+			if nonceErr != nil {
+				log.CtxErrorw(ctx, "failed to get seal account nonce", "error", nonceErr)
+				return "", fmt.Errorf("failed to get seal account nonce, error: %v", nonceErr)
+			}
+			client.sealAccNonce = nonce
+		}
+
+		if err != nil {
+			log.CtxErrorw(ctx, "failed to broadcast seal tx", "msg_count", len(msgs), "retry_number", i, "error", err)
+			continue
+		}
+		client.sealAccNonce = nonce + 1
+		return txHash, nil
+	}
+	return "", err
+}
+
 func (client *GreenfieldChainSignClient) getNonceOnChain(ctx context.Context, gnfdClient *client.GreenfieldClient) (uint64, error) {
 	err := client.signer.baseApp.Consensus().WaitForNextBlock(ctx)
 	if err != nil {
@@ -1049,7 +1134,18 @@ func (client *GreenfieldChainSignClient) getNonceOnChain(ctx context.Context, gn
 
 func (client *GreenfieldChainSignClient) broadcastTx(ctx context.Context, gnfdClient *client.GreenfieldClient,
 	msgs []sdk.Msg, txOpt *ctypes.TxOption, opts ...grpc.CallOption) (string, error) {
-	resp, err := gnfdClient.BroadcastTx(ctx, msgs, txOpt, opts...)
+	txBytes, err := gnfdClient.SignTx(ctx, msgs, txOpt)
+	if err != nil {
+		if strings.Contains(err.Error(), "account sequence mismatch") {
+			return "", sdkErrors.ErrWrongSequence
+		}
+		return "", errors.Wrap(err, "failed to sign tx with greenfield client")
+	}
+	mode := tx.BroadcastMode_BROADCAST_MODE_SYNC
+	if txOpt.Mode != nil {
+		mode = *txOpt.Mode
+	}
+	resp, err := gnfdClient.TxClient.BroadcastTx(ctx, &tx.BroadcastTxRequest{Mode: mode, TxBytes: txBytes}, opts...)
 	if err != nil {
 		if strings.Contains(err.Error(), "account sequence mismatch") {
 			return "", sdkErrors.ErrWrongSequence
@@ -1060,11 +1156,160 @@ func (client *GreenfieldChainSignClient) broadcastTx(ctx context.Context, gnfdCl
 		return "", sdkErrors.ErrWrongSequence
 	}
 	if resp.TxResponse.Code != 0 {
-		return "", fmt.Errorf("failed to broadcast tx, resp code: %d, code space: %s", resp.TxResponse.Code, resp.TxResponse.Codespace)
+		return "", &txRejectedError{code: resp.TxResponse.Code, codespace: resp.TxResponse.Codespace}
+	}
+	if client.txTracker != nil {
+		if scope, km, ok := client.accountOf(gnfdClient); ok {
+			client.txTracker.track(scope, km.GetAddr().String(), msgs, txBytes, resp.TxResponse.TxHash,
+				txOpt.Nonce, txOpt.GasLimit, txOpt.FeeAmount)
+		}
 	}
 	return resp.TxResponse.TxHash, nil
 }
 
+// accountOf returns the sign type and key manager of the greenfield client.
+func (client *GreenfieldChainSignClient) accountOf(gnfdClient *client.GreenfieldClient) (SignType, keys.KeyManager, bool) {
+	for scope, c := range client.greenfieldClients {
+		if c != gnfdClient {
+			continue
+		}
+		km, err := c.GetKeyManager()
+		if err != nil {
+			return "", nil, false
+		}
+		return scope, km, true
+	}
+	return "", nil, false
+}
+
+// accountLock returns the lock which serializes the txs of the account and the nonce of the account in memory,
+// returns nil if the account does not broadcast txs.
+func (client *GreenfieldChainSignClient) accountLock(scope SignType) (*sync.Mutex, *uint64) {
+	switch scope {
+	case SignOperator:
+		return &client.opLock, &client.operatorAccNonce
+	case SignSeal:
+		return &client.sealLock, &client.sealAccNonce
+	case SignGc:
+		return &client.gcLock, &client.gcAccNonce
+	default:
+		return nil, nil
+	}
+}
+
+func (client *GreenfieldChainSignClient) getTx(ctx context.Context, scope SignType, txHash string) (*sdk.TxResponse, error) {
+	resp, err := client.greenfieldClients[scope].TxClient.GetTx(ctx, &tx.GetTxRequest{Hash: txHash})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return resp.GetTxResponse(), nil
+}
+
+// waitForTx polls the tx until it is committed, returns txRejectedError if the tx fails in DeliverTx and
+// errTxNotCommitted if it is not committed within DefaultWaitTxTimeout.
+func (client *GreenfieldChainSignClient) waitForTx(ctx context.Context, scope SignType, txHash string) error {
+	ctx, cancel := context.WithTimeout(ctx, DefaultWaitTxTimeout)
+	defer cancel()
+	ticker := time.NewTicker(DefaultWaitTxInterval)
+	defer ticker.Stop()
+	for {
+		resp, err := client.getTx(ctx, scope, txHash)
+		if err != nil {
+			log.CtxWarnw(ctx, "failed to get tx", "tx_hash", txHash, "error", err)
+		} else if resp != nil {
+			if resp.Code != 0 {
+				return &txRejectedError{code: resp.Code, codespace: resp.Codespace, txHash: txHash}
+			}
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("failed to wait for tx %s to be committed: %w, error: %v", txHash, errTxNotCommitted, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func (client *GreenfieldChainSignClient) getNonce(ctx context.Context, scope SignType) (uint64, error) {
+	return client.greenfieldClients[scope].GetNonce(ctx)
+}
+
+func (client *GreenfieldChainSignClient) rebroadcast(ctx context.Context, scope SignType, txBytes []byte) error {
+	resp, err := client.greenfieldClients[scope].TxClient.BroadcastTx(ctx, &tx.BroadcastTxRequest{
+		Mode:    tx.BroadcastMode_BROADCAST_MODE_SYNC,
+		TxBytes: txBytes,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.TxResponse.Code != 0 && resp.TxResponse.Code != sdkErrors.ErrTxInMempoolCache.ABCICode() {
+		return &txRejectedError{code: resp.TxResponse.Code, codespace: resp.TxResponse.Codespace}
+	}
+	return nil
+}
+
+func (client *GreenfieldChainSignClient) bumpFee(ctx context.Context, scope SignType, txBytes []byte, nonce uint64,
+	percent uint32) (string, error) {
+	gnfdClient := client.greenfieldClients[scope]
+	txConfig := authtx.NewTxConfig(gnfdClient.GetCodec(), []signing.SignMode{signing.SignMode_SIGN_MODE_EIP_712})
+	decoded, err := txConfig.TxDecoder()(txBytes)
+	if err != nil {
+		return "", err
+	}
+	feeTx, ok := decoded.(sdk.FeeTx)
+	if !ok {
+		return "", fmt.Errorf("failed to get fee of tx")
+	}
+	feeAmount := sdk.NewCoins()
+	for _, coin := range feeTx.GetFee() {
+		amount := coin.Amount.MulRaw(int64(100 + percent)).QuoRaw(100)
+		feeAmount = feeAmount.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	mode := tx.BroadcastMode_BROADCAST_MODE_SYNC
+	txOpt := &ctypes.TxOption{
+		NoSimulate: true,
+		Mode:       &mode,
+		GasLimit:   feeTx.GetGas(),
+		FeeAmount:  feeAmount,
+		Nonce:      nonce,
+	}
+	if lock, _ := client.accountLock(scope); lock != nil {
+		lock.Lock()
+		defer lock.Unlock()
+	}
+	return client.broadcastTx(ctx, gnfdClient, decoded.GetMsgs(), txOpt)
+}
+
+func (client *GreenfieldChainSignClient) recoverNonce(scope SignType, pendingNonce uint64) {
+	lock, nonce := client.accountLock(scope)
+	if lock == nil {
+		return
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	if *nonce <= pendingNonce {
+		*nonce = pendingNonce + 1
+	}
+}
+
+// txRejectedError is returned if the tx is rejected by the chain, the txHash is set if the tx is committed
+// but fails in DeliverTx.
+type txRejectedError struct {
+	code      uint32
+	codespace string
+	txHash    string
+}
+
+func (e *txRejectedError) Error() string {
+	if e.txHash != "" {
+		return fmt.Sprintf("failed to execute tx, tx hash: %s, resp code: %d, code space: %s", e.txHash, e.code, e.codespace)
+	}
+	return fmt.Sprintf("failed to broadcast tx, resp code: %d, code space: %s", e.code, e.codespace)
+}
+
 func (client *GreenfieldChainSignClient) ReserveSwapIn(ctx context.Context, scope SignType,
 	msg *virtualgrouptypes.MsgReserveSwapIn) (string, error) {
 	log.Infow("signer starts to reserve swap in", "scope", scope)
@@ -1239,52 +1484,21 @@ func (client *GreenfieldChainSignClient) SealObjectV2(ctx context.Context, scope
 		return "", ErrSignMsg
 	}
 
-	client.sealLock.Lock()
-	defer client.sealLock.Unlock()
-
 	msgSealObject := storagetypes.NewMsgSealObjectV2(km.GetAddr(),
 		sealObject.GetBucketName(), sealObject.GetObjectName(), sealObject.GetGlobalVirtualGroupId(),
 		sealObject.GetSecondarySpBlsAggSignatures(), sealObject.GetExpectChecksums())
 
-	mode := tx.BroadcastMode_BROADCAST_MODE_SYNC
-
-	var (
-		txHash   string
-		nonce    uint64
-		nonceErr error
-	)
-	for i := 0; i < BroadcastTxRetry; i++ {
-		nonce = client.sealAccNonce
-		txOpt := &ctypes.TxOption{
-			NoSimulate: true,
-			Mode:       &mode,
-			GasLimit:   client.gasInfo[Seal].GasLimit,
-			FeeAmount:  client.gasInfo[Seal].FeeAmount,
-			Nonce:      nonce,
-		}
-
-		txHash, err = client.broadcastTx(ctx, client.greenfieldClients[scope], []sdk.Msg{msgSealObject}, txOpt)
-		if errors.IsOf(err, sdkErrors.ErrWrongSequence) {
-			// if nonce mismatch, wait for next block, reset nonce by querying the nonce on chain
-			nonce, nonceErr = client.getNonceOnChain(ctx, client.greenfieldClients[scope])
-			if nonceErr != nil {
-				log.CtxErrorw(ctx, "failed to get seal account nonce", "error", nonceErr)
-				ErrSealObjectOnChain.SetError(fmt.Errorf("failed to get seal account nonce, error: %v", nonceErr))
-				return "", ErrSealObjectOnChain
-			}
-			client.sealAccNonce = nonce
-		}
-
-		if err != nil {
-			log.CtxErrorw(ctx, "failed to broadcast seal object tx", "retry_number", i, "error", err)
-			continue
-		}
-		client.sealAccNonce = nonce + 1
-		log.CtxDebugw(ctx, "succeed to broadcast seal object tx", "tx_hash", txHash, "seal_msg", msgSealObject)
-		return txHash, nil
+	txHash, err := client.sealMsg(ctx, scope, msgSealObject)
+	if errors.IsOf(err, errTxNotCommitted) {
+		log.CtxWarnw(ctx, "seal object tx is not committed in time", "tx_hash", txHash, "error", err)
+		ErrSealObjectTxPending.SetError(fmt.Errorf("seal object tx %s is not committed in time", txHash))
+		return txHash, ErrSealObjectTxPending
 	}
-
-	// failed to broadcast tx
-	ErrSealObjectOnChain.SetError(fmt.Errorf("failed to broadcast seal object tx, error: %v", err))
-	return "", ErrSealObjectOnChain
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to broadcast seal object tx", "error", err)
+		ErrSealObjectOnChain.SetError(fmt.Errorf("failed to broadcast seal object tx, error: %v", err))
+		return "", ErrSealObjectOnChain
+	}
+	log.CtxDebugw(ctx, "succeed to broadcast seal object tx", "tx_hash", txHash, "seal_msg", msgSealObject)
+	return txHash, nil
 }
@@ -1,14 +1,17 @@
 package signer
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspapp"
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
 	coremodule "github.com/bnb-chain/greenfield-storage-provider/core/module"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
 	"github.com/bnb-chain/greenfield/sdk/types"
 )
 
@@ -112,12 +115,33 @@ func DefaultSignerOptions(signer *SignModular, cfg *gfspconfig.GfSpConfig) error
 		FeeAmount: sdk.NewCoins(sdk.NewCoin(types.Denom, sdk.NewInt(int64(cfg.Chain.CreateGlobalVirtualGroupFeeAmount)))),
 	}
 
-	client, err := NewGreenfieldChainSignClient(cfg.Chain.ChainAddress[0], cfg.Chain.ChainID,
-		gasInfo, cfg.SpAccount.OperatorPrivateKey, cfg.SpAccount.FundingPrivateKey,
-		cfg.SpAccount.SealPrivateKey, cfg.SpAccount.ApprovalPrivateKey, cfg.SpAccount.GcPrivateKey, cfg.SpAccount.BlsPrivateKey)
+	provider, err := NewKeyProvider(&cfg.SpAccount)
 	if err != nil {
+		log.Errorw("failed to new key provider", "key_provider", cfg.SpAccount.KeyProvider, "error", err)
 		return err
 	}
+	client, err := NewGreenfieldChainSignClient(cfg.Chain.ChainAddress[0], cfg.Chain.ChainID, gasInfo, provider)
+	if err != nil {
+		_ = provider.Close()
+		return err
+	}
+	if size := sealBatchSize(&cfg.Chain); size > 1 {
+		client.sealBatcher = newSealBatcher(SignSeal, size,
+			time.Duration(cfg.Chain.SealBatchWindowMillisecond)*time.Millisecond,
+			func(ctx context.Context, scope SignType, msgs []sdk.Msg) (string, error) {
+				client.sealLock.Lock()
+				defer client.sealLock.Unlock()
+				return client.broadcastSealTx(ctx, scope, msgs)
+			},
+			client.waitForTx)
+	}
+	if cfg.Chain.TxTracker.Enable {
+		if signer.baseApp.GfSpDB() == nil {
+			_ = provider.Close()
+			return fmt.Errorf("tx tracker needs sp db")
+		}
+		client.txTracker = newTxTracker(client, signer.baseApp.GfSpDB(), cfg.Chain.TxTracker)
+	}
 	signer.client = client
 	client.signer = signer
 	return nil
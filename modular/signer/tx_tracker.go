@@ -0,0 +1,239 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+)
+
+const (
+	// DefaultTxTrackerInterval defines the default interval to check the pending txs
+	DefaultTxTrackerInterval = 10 * time.Second
+	// DefaultStuckTxTimeout defines the default time a pending tx waits for inclusion before it is re-broadcast
+	DefaultStuckTxTimeout = 60 * time.Second
+	// DefaultMaxRebroadcast defines the default times a stuck tx is re-broadcast before its fee is bumped
+	DefaultMaxRebroadcast = 3
+	// DefaultFeeBumpPercent defines the default percentage by which the fee of a stuck tx is bumped
+	DefaultFeeBumpPercent = 10
+	// DefaultTxRetention defines the default time the finished txs are kept
+	DefaultTxRetention = 7 * 24 * time.Hour
+
+	// maxCheckPendingTxs defines the max number of pending txs checked in one round
+	maxCheckPendingTxs = 1000
+)
+
+// trackedChain abstracts the chain operations used by the tx tracker.
+type trackedChain interface {
+	// getTx returns the response of the tx which is included in a block, returns nil if the tx is not found.
+	getTx(ctx context.Context, scope SignType, txHash string) (*sdk.TxResponse, error)
+	// getNonce returns the next nonce of the account on chain.
+	getNonce(ctx context.Context, scope SignType) (uint64, error)
+	// rebroadcast broadcasts the signed tx again.
+	rebroadcast(ctx context.Context, scope SignType, txBytes []byte) error
+	// bumpFee re-signs the msgs of the tx with the same nonce and the bumped fee, and broadcasts the new tx.
+	bumpFee(ctx context.Context, scope SignType, txBytes []byte, nonce uint64, percent uint32) (string, error)
+	// recoverNonce advances the nonce of the account in memory if it is not greater than the pending nonce.
+	recoverNonce(scope SignType, pendingNonce uint64)
+}
+
+// txTracker records the txs broadcast by the signer accounts in SPDB, and follows them until they are
+// included. A tx which is not included within the stuck timeout is re-broadcast, and its fee is bumped
+// after it has been re-broadcast for max times.
+type txTracker struct {
+	chain          trackedChain
+	db             spdb.TxDB
+	interval       time.Duration
+	stuckTimeout   time.Duration
+	maxRebroadcast uint32
+	feeBumpPercent uint32
+	retention      time.Duration
+	stopCh         chan struct{}
+	doneCh         chan struct{}
+}
+
+func newTxTracker(chain trackedChain, db spdb.TxDB, cfg gfspconfig.TxTrackerConfig) *txTracker {
+	t := &txTracker{
+		chain:          chain,
+		db:             db,
+		interval:       time.Duration(cfg.IntervalSecond) * time.Second,
+		stuckTimeout:   time.Duration(cfg.StuckTimeoutSecond) * time.Second,
+		maxRebroadcast: cfg.MaxRebroadcast,
+		feeBumpPercent: cfg.FeeBumpPercent,
+		retention:      time.Duration(cfg.RetentionSecond) * time.Second,
+		stopCh:         make(chan struct{}),
+		doneCh:         make(chan struct{}),
+	}
+	if t.interval == 0 {
+		t.interval = DefaultTxTrackerInterval
+	}
+	if t.stuckTimeout == 0 {
+		t.stuckTimeout = DefaultStuckTxTimeout
+	}
+	if t.maxRebroadcast == 0 {
+		t.maxRebroadcast = DefaultMaxRebroadcast
+	}
+	if t.feeBumpPercent == 0 {
+		t.feeBumpPercent = DefaultFeeBumpPercent
+	}
+	if t.retention == 0 {
+		t.retention = DefaultTxRetention
+	}
+	return t
+}
+
+// start recovers the nonces from the pending txs and starts to check the pending txs in background.
+func (t *txTracker) start() error {
+	records, err := t.db.ListTxs("", []spdb.TxStatus{spdb.TxStatusPending}, 0)
+	if err != nil {
+		log.Errorw("failed to list pending txs", "error", err)
+		return err
+	}
+	// the nonces on chain do not count the txs in mempool, continue from the pending txs to avoid
+	// the sequence mismatch after restarting
+	for _, record := range records {
+		t.chain.recoverNonce(SignType(record.Account), record.Nonce)
+	}
+	go t.loop()
+	return nil
+}
+
+func (t *txTracker) stop() {
+	close(t.stopCh)
+	<-t.doneCh
+}
+
+// track records the tx which is accepted by the chain.
+func (t *txTracker) track(scope SignType, address string, msgs []sdk.Msg, txBytes []byte, txHash string,
+	nonce uint64, gasLimit uint64, feeAmount sdk.Coins) {
+	msgTypes := make([]string, len(msgs))
+	for i, msg := range msgs {
+		msgTypes[i] = sdk.MsgTypeURL(msg)
+	}
+	now := time.Now().Unix()
+	err := t.db.InsertTx(&spdb.TxRecord{
+		TxHash:         txHash,
+		Account:        string(scope),
+		Address:        address,
+		Nonce:          nonce,
+		MsgTypes:       strings.Join(msgTypes, ","),
+		TxBytes:        txBytes,
+		GasLimit:       gasLimit,
+		FeeAmount:      feeAmount.String(),
+		Status:         spdb.TxStatusPending,
+		BroadcastCount: 1,
+		BroadcastTime:  now,
+		CreateTime:     now,
+		UpdateTime:     now,
+	})
+	if err != nil {
+		log.Errorw("failed to record tx", "account", scope, "tx_hash", txHash, "error", err)
+	}
+}
+
+func (t *txTracker) loop() {
+	defer close(t.doneCh)
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			t.checkPendingTxs(context.Background())
+			if err := t.db.DeleteFinishedTxs(time.Now().Add(-t.retention).Unix()); err != nil {
+				log.Errorw("failed to delete finished txs", "error", err)
+			}
+		case <-t.stopCh:
+			return
+		}
+	}
+}
+
+func (t *txTracker) checkPendingTxs(ctx context.Context) {
+	records, err := t.db.ListTxs("", []spdb.TxStatus{spdb.TxStatusPending}, maxCheckPendingTxs)
+	if err != nil {
+		log.Errorw("failed to list pending txs", "error", err)
+		return
+	}
+	for _, record := range records {
+		t.checkTx(ctx, record)
+	}
+}
+
+func (t *txTracker) checkTx(ctx context.Context, record *spdb.TxRecord) {
+	scope := SignType(record.Account)
+	resp, err := t.chain.getTx(ctx, scope, record.TxHash)
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to get tx", "tx_hash", record.TxHash, "error", err)
+		return
+	}
+	now := time.Now()
+	if resp != nil {
+		record.Height = resp.Height
+		record.GasUsed = resp.GasUsed
+		record.Status = spdb.TxStatusIncluded
+		if resp.Code != 0 {
+			record.Status = spdb.TxStatusFailed
+			record.ErrorMsg = resp.RawLog
+		}
+		t.updateTx(record, now)
+		return
+	}
+
+	stuck := now.Sub(time.Unix(record.BroadcastTime, 0)) >= t.stuckTimeout
+	if !stuck {
+		return
+	}
+	nonce, err := t.chain.getNonce(ctx, scope)
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to get nonce", "account", scope, "error", err)
+		return
+	}
+	if nonce > record.Nonce {
+		// the nonce is used but the tx is still not found after the stuck timeout
+		record.Status = spdb.TxStatusDropped
+		record.ErrorMsg = fmt.Sprintf("nonce %d is used by another tx", record.Nonce)
+		t.updateTx(record, now)
+		return
+	}
+
+	if record.BroadcastCount <= t.maxRebroadcast {
+		record.BroadcastCount++
+		record.BroadcastTime = now.Unix()
+		record.ErrorMsg = ""
+		if err = t.chain.rebroadcast(ctx, scope, record.TxBytes); err != nil {
+			log.CtxErrorw(ctx, "failed to re-broadcast stuck tx", "tx_hash", record.TxHash, "error", err)
+			record.ErrorMsg = err.Error()
+		} else {
+			log.CtxInfow(ctx, "succeed to re-broadcast stuck tx", "tx_hash", record.TxHash,
+				"broadcast_count", record.BroadcastCount)
+		}
+		t.updateTx(record, now)
+		return
+	}
+
+	txHash, err := t.chain.bumpFee(ctx, scope, record.TxBytes, record.Nonce, t.feeBumpPercent)
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to bump fee of stuck tx", "tx_hash", record.TxHash, "error", err)
+		record.BroadcastTime = now.Unix()
+		record.ErrorMsg = err.Error()
+		t.updateTx(record, now)
+		return
+	}
+	log.CtxInfow(ctx, "succeed to bump fee of stuck tx", "tx_hash", record.TxHash, "new_tx_hash", txHash)
+	record.Status = spdb.TxStatusReplaced
+	record.ErrorMsg = "replaced by " + txHash
+	t.updateTx(record, now)
+}
+
+func (t *txTracker) updateTx(record *spdb.TxRecord, now time.Time) {
+	record.UpdateTime = now.Unix()
+	if err := t.db.UpdateTx(record); err != nil {
+		log.Errorw("failed to update tx", "tx_hash", record.TxHash, "error", err)
+	}
+}
@@ -0,0 +1,210 @@
+package signer
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// mockTxDB keeps the tx records in memory.
+type mockTxDB struct {
+	mu  sync.Mutex
+	txs map[string]*spdb.TxRecord
+}
+
+func newMockTxDB() *mockTxDB {
+	return &mockTxDB{txs: make(map[string]*spdb.TxRecord)}
+}
+
+func (db *mockTxDB) InsertTx(tx *spdb.TxRecord) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	record := *tx
+	db.txs[tx.TxHash] = &record
+	return nil
+}
+
+func (db *mockTxDB) UpdateTx(tx *spdb.TxRecord) error {
+	return db.InsertTx(tx)
+}
+
+func (db *mockTxDB) ListTxs(account string, statuses []spdb.TxStatus, limit int) ([]*spdb.TxRecord, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	var records []*spdb.TxRecord
+	for _, tx := range db.txs {
+		if account != "" && tx.Account != account {
+			continue
+		}
+		for _, status := range statuses {
+			if tx.Status == status {
+				record := *tx
+				records = append(records, &record)
+			}
+		}
+	}
+	return records, nil
+}
+
+func (db *mockTxDB) DeleteFinishedTxs(updateTimeBefore int64) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	for hash, tx := range db.txs {
+		if tx.Status != spdb.TxStatusPending && tx.UpdateTime < updateTimeBefore {
+			delete(db.txs, hash)
+		}
+	}
+	return nil
+}
+
+func (db *mockTxDB) get(txHash string) *spdb.TxRecord {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return db.txs[txHash]
+}
+
+// mockTrackedChain returns the preset tx responses and nonces.
+type mockTrackedChain struct {
+	txs          map[string]*sdk.TxResponse
+	nonce        uint64
+	rebroadcasts int
+	bumpedNonce  uint64
+	nonces       map[SignType]uint64
+}
+
+func (c *mockTrackedChain) getTx(ctx context.Context, scope SignType, txHash string) (*sdk.TxResponse, error) {
+	return c.txs[txHash], nil
+}
+
+func (c *mockTrackedChain) getNonce(ctx context.Context, scope SignType) (uint64, error) {
+	return c.nonce, nil
+}
+
+func (c *mockTrackedChain) rebroadcast(ctx context.Context, scope SignType, txBytes []byte) error {
+	c.rebroadcasts++
+	return nil
+}
+
+func (c *mockTrackedChain) bumpFee(ctx context.Context, scope SignType, txBytes []byte, nonce uint64,
+	percent uint32) (string, error) {
+	c.bumpedNonce = nonce
+	return "bumpedTxHash", nil
+}
+
+func (c *mockTrackedChain) recoverNonce(scope SignType, pendingNonce uint64) {
+	if c.nonces[scope] <= pendingNonce {
+		c.nonces[scope] = pendingNonce + 1
+	}
+}
+
+func setupTxTracker(t *testing.T) (*txTracker, *mockTrackedChain, *mockTxDB) {
+	t.Helper()
+	chain := &mockTrackedChain{txs: make(map[string]*sdk.TxResponse), nonces: make(map[SignType]uint64)}
+	db := newMockTxDB()
+	tracker := newTxTracker(chain, db, gfspconfig.TxTrackerConfig{MaxRebroadcast: 1})
+	return tracker, chain, db
+}
+
+func trackSealTx(tracker *txTracker, txHash string, nonce uint64) {
+	tracker.track(SignSeal, "mockAddress", []sdk.Msg{&storagetypes.MsgSealObject{}}, []byte("mockTxBytes"),
+		txHash, nonce, 1200, sdk.NewCoins(sdk.NewInt64Coin("BNB", 100)))
+}
+
+func TestTxTracker_Track(t *testing.T) {
+	tracker, _, db := setupTxTracker(t)
+	trackSealTx(tracker, "txHash", 5)
+	record := db.get("txHash")
+	require.NotNil(t, record)
+	assert.Equal(t, string(SignSeal), record.Account)
+	assert.Equal(t, uint64(5), record.Nonce)
+	assert.Equal(t, "/greenfield.storage.MsgSealObject", record.MsgTypes)
+	assert.Equal(t, "100BNB", record.FeeAmount)
+	assert.Equal(t, spdb.TxStatusPending, record.Status)
+	assert.Equal(t, uint32(1), record.BroadcastCount)
+}
+
+func TestTxTracker_Included(t *testing.T) {
+	tracker, chain, db := setupTxTracker(t)
+	trackSealTx(tracker, "includedTx", 1)
+	trackSealTx(tracker, "failedTx", 2)
+	trackSealTx(tracker, "pendingTx", 3)
+	chain.txs["includedTx"] = &sdk.TxResponse{Height: 10, GasUsed: 1000}
+	chain.txs["failedTx"] = &sdk.TxResponse{Height: 11, GasUsed: 900, Code: 5, RawLog: "mock failure"}
+
+	tracker.checkPendingTxs(context.Background())
+	included := db.get("includedTx")
+	assert.Equal(t, spdb.TxStatusIncluded, included.Status)
+	assert.Equal(t, int64(10), included.Height)
+	assert.Equal(t, int64(1000), included.GasUsed)
+	failed := db.get("failedTx")
+	assert.Equal(t, spdb.TxStatusFailed, failed.Status)
+	assert.Equal(t, "mock failure", failed.ErrorMsg)
+	assert.Equal(t, spdb.TxStatusPending, db.get("pendingTx").Status)
+	assert.Equal(t, 0, chain.rebroadcasts)
+}
+
+func TestTxTracker_StuckTx(t *testing.T) {
+	tracker, chain, db := setupTxTracker(t)
+	trackSealTx(tracker, "stuckTx", 3)
+	chain.nonce = 3
+	stale := func() {
+		record := db.get("stuckTx")
+		record.BroadcastTime = time.Now().Add(-2 * tracker.stuckTimeout).Unix()
+		_ = db.UpdateTx(record)
+	}
+
+	// re-broadcast until max times
+	stale()
+	tracker.checkPendingTxs(context.Background())
+	assert.Equal(t, 1, chain.rebroadcasts)
+	assert.Equal(t, uint32(2), db.get("stuckTx").BroadcastCount)
+
+	// not stuck again until the timeout elapses
+	tracker.checkPendingTxs(context.Background())
+	assert.Equal(t, 1, chain.rebroadcasts)
+
+	// bump fee after re-broadcast for max times
+	stale()
+	tracker.checkPendingTxs(context.Background())
+	assert.Equal(t, 1, chain.rebroadcasts)
+	assert.Equal(t, uint64(3), chain.bumpedNonce)
+	record := db.get("stuckTx")
+	assert.Equal(t, spdb.TxStatusReplaced, record.Status)
+	assert.Equal(t, "replaced by bumpedTxHash", record.ErrorMsg)
+}
+
+func TestTxTracker_DroppedTx(t *testing.T) {
+	tracker, chain, db := setupTxTracker(t)
+	trackSealTx(tracker, "droppedTx", 3)
+	chain.nonce = 4
+	record := db.get("droppedTx")
+	record.BroadcastTime = time.Now().Add(-2 * tracker.stuckTimeout).Unix()
+	_ = db.UpdateTx(record)
+
+	tracker.checkPendingTxs(context.Background())
+	assert.Equal(t, spdb.TxStatusDropped, db.get("droppedTx").Status)
+	assert.Equal(t, 0, chain.rebroadcasts)
+}
+
+func TestTxTracker_StartRecoverNonce(t *testing.T) {
+	tracker, chain, db := setupTxTracker(t)
+	trackSealTx(tracker, "pendingTx1", 7)
+	trackSealTx(tracker, "pendingTx2", 8)
+	trackSealTx(tracker, "includedTx", 9)
+	record := db.get("includedTx")
+	record.Status = spdb.TxStatusIncluded
+	_ = db.UpdateTx(record)
+
+	require.NoError(t, tracker.start())
+	tracker.stop()
+	assert.Equal(t, uint64(9), chain.nonces[SignSeal])
+}
@@ -0,0 +1,307 @@
+package uploader
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/avast/retry-go/v4"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+
+	"github.com/bnb-chain/greenfield-common/go/hash"
+	"github.com/bnb-chain/greenfield-common/go/redundancy"
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
+	coretask "github.com/bnb-chain/greenfield-storage-provider/core/task"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// replicatePieceTimeout defines the timeout of replicating a piece to a secondary SP once.
+var replicatePieceTimeout = 10 * time.Second
+
+// replicateSegment is a segment waiting in the queue of the streaming replicator.
+type replicateSegment struct {
+	segIdx uint32
+	data   []byte
+}
+
+// streamingReplicator replicates the segments of the object to the secondary SPs while the object is uploading. The
+// segments are EC-encoded as they arrive, so the executor does not read the object from the piece store again to
+// replicate it. The segments are replicated from a bounded queue by the workers, so the upload never waits for the
+// secondary SPs; if they fall behind and the queue is full, the replication fails. Once any piece fails to replicate,
+// the replicator stops, and the object is replicated by the executor after uploading to the same gvg, which
+// overwrites the partial pieces on the secondary SPs.
+type streamingReplicator struct {
+	u        *UploadModular
+	task     coretask.UploadObjectTask
+	gvgID    uint32
+	spIDs    []uint32
+	spEps    []string
+	priority coretask.TPriority
+
+	// ctx is not bound to the upload request, the replication is finished after the request returns
+	ctx    context.Context
+	cancel context.CancelFunc
+	mu     sync.Mutex
+	err    error
+
+	queue   chan *replicateSegment
+	workers sync.WaitGroup
+}
+
+// newStreamingReplicator picks the gvg of the object and returns the replicator of it, it returns nil if the
+// streaming replication is disabled or not available for the object.
+func (u *UploadModular) newStreamingReplicator(ctx context.Context, task coretask.UploadObjectTask) *streamingReplicator {
+	// the checksums of the agent upload are unknown until the upload is done, they're replicated by the executor
+	if !u.streamingReplicate || task.GetIsAgentUpload() {
+		return nil
+	}
+	resp, err := u.baseApp.GfSpClient().PickGlobalVirtualGroup(ctx, task)
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to pick global virtual group for streaming replication", "error", err)
+		return nil
+	}
+	if len(resp.GetSecondaryEndpoints()) == 0 || len(resp.GetSecondaryEndpoints()) != len(resp.GetSecondarySpIds()) {
+		log.CtxErrorw(ctx, "failed to pick global virtual group for streaming replication, invalid secondary sps",
+			"gvg_id", resp.GetGlobalVirtualGroupId(), "secondary_sp_ids", resp.GetSecondarySpIds(),
+			"secondary_endpoints", resp.GetSecondaryEndpoints())
+		return nil
+	}
+	// the pieces are received by the secondary SPs in the same way as the replicate piece task of the executor
+	priority := u.baseApp.TaskPriority(&gfsptask.GfSpReplicatePieceTask{})
+	replicateCtx, cancel := context.WithCancel(log.WithValue(context.Background(), log.CtxKeyTask, task.Key().String()))
+	queueSize := u.streamingQueueSize
+	if queueSize <= 0 {
+		queueSize = DefaultStreamingReplicateQueueSize
+	}
+	r := &streamingReplicator{
+		u:        u,
+		task:     task,
+		gvgID:    resp.GetGlobalVirtualGroupId(),
+		spIDs:    resp.GetSecondarySpIds(),
+		spEps:    resp.GetSecondaryEndpoints(),
+		priority: priority,
+		ctx:      replicateCtx,
+		cancel:   cancel,
+		queue:    make(chan *replicateSegment, queueSize),
+	}
+	// the segments are replicated with the same parallel as they're put to the piece store
+	workers := u.segmentParallel
+	if workers <= 0 {
+		workers = 1
+	}
+	r.workers.Add(workers)
+	for i := 0; i < workers; i++ {
+		go r.work()
+	}
+	return r
+}
+
+// work replicates the segments in the queue until it's closed, the segments are dropped after the replication fails.
+func (r *streamingReplicator) work() {
+	defer r.workers.Done()
+	for seg := range r.queue {
+		r.replicate(seg.segIdx, seg.data)
+	}
+}
+
+// enqueue copies the segment to the queue of the replication without waiting for it, the replication fails if the
+// queue is full. It must not be called after finish.
+func (r *streamingReplicator) enqueue(segIdx uint32, data []byte) {
+	if r.failed() != nil {
+		return
+	}
+	select {
+	case r.queue <- &replicateSegment{segIdx: segIdx, data: append([]byte(nil), data...)}:
+	default:
+		r.abort(fmt.Errorf("replication queue is full at segment %d, secondary sps fall behind", segIdx))
+	}
+}
+
+// failed returns the error which stops the replication.
+func (r *streamingReplicator) failed() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.err
+}
+
+// abort stops the replication with the error, the in flight pieces are canceled.
+func (r *streamingReplicator) abort(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err == nil {
+		log.CtxErrorw(r.ctx, "streaming replication is aborted", "error", err)
+		r.err = err
+	}
+	r.cancel()
+}
+
+// replicate replicates the segment to all the secondary SPs, it returns after the pieces are replicated or failed.
+// The data is not used after it returns.
+func (r *streamingReplicator) replicate(segIdx uint32, data []byte) {
+	if r.failed() != nil {
+		return
+	}
+	startTime := time.Now()
+	pieces := make([][]byte, len(r.spEps))
+	if r.task.GetObjectInfo().GetRedundancyType() == storagetypes.REDUNDANCY_EC_TYPE {
+		ecData, err := redundancy.EncodeRawSegment(data,
+			int(r.task.GetStorageParams().VersionedParams.GetRedundantDataChunkNum()),
+			int(r.task.GetStorageParams().VersionedParams.GetRedundantParityChunkNum()))
+		metrics.PerfPutObjectTime.WithLabelValues("uploader_streaming_replicate_ec_cost").Observe(time.Since(startTime).Seconds())
+		if err != nil {
+			r.abort(fmt.Errorf("failed to ec encode segment %d: %w", segIdx, err))
+			return
+		}
+		if len(ecData) != len(r.spEps) {
+			r.abort(fmt.Errorf("ec pieces number %d mismatch secondary sps number %d", len(ecData), len(r.spEps)))
+			return
+		}
+		copy(pieces, ecData)
+	} else {
+		for rIdx := range pieces {
+			pieces[rIdx] = data
+		}
+	}
+
+	var wg sync.WaitGroup
+	for rIdx, spEp := range r.spEps {
+		wg.Add(1)
+		go func(rIdx int, spEp string) {
+			defer wg.Done()
+			if err := r.replicatePiece(segIdx, int32(rIdx), spEp, pieces[rIdx]); err != nil {
+				r.abort(err)
+			}
+		}(rIdx, spEp)
+	}
+	wg.Wait()
+	metrics.PerfPutObjectTime.WithLabelValues("uploader_streaming_replicate_segment_cost").Observe(time.Since(startTime).Seconds())
+}
+
+func (r *streamingReplicator) replicatePiece(segIdx uint32, rIdx int32, spEp string, data []byte) error {
+	receive := &gfsptask.GfSpReceivePieceTask{}
+	receive.InitReceivePieceTask(r.gvgID, r.task.GetObjectInfo(), r.task.GetStorageParams(), r.priority, segIdx, rIdx,
+		int64(len(data)), false)
+	receive.SetPieceChecksum(hash.GenerateChecksum(data))
+	signature, err := r.u.baseApp.GfSpClient().SignReceiveTask(r.ctx, receive)
+	if err != nil {
+		log.CtxErrorw(r.ctx, "failed to sign receive task", "segment_idx", segIdx, "redundancy_idx", rIdx, "error", err)
+		return err
+	}
+	receive.SetSignature(signature)
+	return retry.Do(func() error {
+		ctx, cancel := context.WithTimeout(r.ctx, replicatePieceTimeout)
+		defer cancel()
+		return r.u.baseApp.GfSpClient().ReplicatePieceToSecondary(ctx, spEp, receive, data)
+	}, rtyAttem, rtyDelay, rtyErr, retry.Context(r.ctx),
+		retry.OnRetry(func(n uint, err error) {
+			log.CtxErrorw(r.ctx, "failed to replicate piece", "sp_endpoint", spEp, "segment_idx", segIdx,
+				"redundancy_idx", rIdx, "error", err, "attempt", n, "max_attempts", rtyAttNum)
+		}))
+}
+
+// finish waits for the queued segments and finishes the replication on the secondary SPs, then sets the gvg and
+// the signatures of the secondary SPs to the task. If the replication fails, only the gvg is set, so the object is
+// replicated to the same secondary SPs again and their partial pieces are overwritten.
+func (r *streamingReplicator) finish() {
+	defer r.cancel()
+	close(r.queue)
+	r.workers.Wait()
+	if r.failed() != nil {
+		r.fallback()
+		return
+	}
+	startTime := time.Now()
+	signatures := make([][]byte, len(r.spEps))
+	var wg sync.WaitGroup
+	for rIdx, spEp := range r.spEps {
+		wg.Add(1)
+		go func(rIdx int, spEp string) {
+			defer wg.Done()
+			signature, err := r.doneReplicate(int32(rIdx), spEp)
+			if err != nil {
+				r.abort(err)
+				return
+			}
+			signatures[rIdx] = signature
+		}(rIdx, spEp)
+	}
+	wg.Wait()
+	metrics.PerfPutObjectTime.WithLabelValues("uploader_streaming_replicate_done_cost").Observe(time.Since(startTime).Seconds())
+	if r.failed() != nil {
+		r.fallback()
+		return
+	}
+	r.task.SetGlobalVirtualGroupID(r.gvgID)
+	r.task.SetSecondaryEndpoints(r.spEps)
+	r.task.SetSecondarySignatures(signatures)
+	r.task.AppendLog("uploader-streaming-replicate-done")
+	log.CtxDebugw(r.ctx, "succeed to replicate object while uploading", "gvg_id", r.gvgID)
+}
+
+// fallback sets the gvg of the failed replication to the task without the signatures, so the object is replicated
+// to the same secondary SPs by the executor.
+func (r *streamingReplicator) fallback() {
+	r.task.SetGlobalVirtualGroupID(r.gvgID)
+	r.task.SetSecondaryEndpoints(r.spEps)
+	r.task.AppendLog("uploader-streaming-replicate-aborted")
+}
+
+func (r *streamingReplicator) doneReplicate(rIdx int32, spEp string) ([]byte, error) {
+	objectInfo := r.task.GetObjectInfo()
+	if int(rIdx+1) >= len(objectInfo.GetChecksums()) {
+		return nil, fmt.Errorf("redundancy index %d out of checksums bounds", rIdx)
+	}
+	receive := &gfsptask.GfSpReceivePieceTask{}
+	receive.InitReceivePieceTask(r.gvgID, objectInfo, r.task.GetStorageParams(), r.priority, 0, rIdx, 0, false)
+	receive.SetFinished(true)
+	taskSignature, err := r.u.baseApp.GfSpClient().SignReceiveTask(r.ctx, receive)
+	if err != nil {
+		log.CtxErrorw(r.ctx, "failed to sign done receive task", "redundancy_idx", rIdx, "error", err)
+		return nil, err
+	}
+	receive.SetSignature(taskSignature)
+	var signature []byte
+	if err = retry.Do(func() error {
+		ctx, cancel := context.WithTimeout(r.ctx, replicatePieceTimeout)
+		defer cancel()
+		signature, err = r.u.baseApp.GfSpClient().DoneReplicatePieceToSecondary(ctx, spEp, receive)
+		return err
+	}, rtyAttem, rtyDelay, rtyErr, retry.Context(r.ctx),
+		retry.OnRetry(func(n uint, err error) {
+			log.CtxErrorw(r.ctx, "failed to done replicate piece", "sp_endpoint", spEp, "redundancy_idx", rIdx,
+				"error", err, "attempt", n, "max_attempts", rtyAttNum)
+		})); err != nil {
+		return nil, err
+	}
+
+	sp, err := r.u.baseApp.Consensus().QuerySPByID(r.ctx, r.spIDs[rIdx])
+	if err != nil {
+		log.CtxErrorw(r.ctx, "failed to query secondary sp", "secondary_sp_id", r.spIDs[rIdx], "error", err)
+		return nil, err
+	}
+	msg := storagetypes.NewSecondarySpSealObjectSignDoc(r.u.baseApp.ChainID(), r.gvgID, objectInfo.Id,
+		storagetypes.GenerateHash(objectInfo.GetChecksums()[:])).GetBlsSignHash()
+	if err = verifyBlsSignature(sp.GetBlsKey(), signature, msg[:]); err != nil {
+		log.CtxErrorw(r.ctx, "failed to verify secondary sp bls signature", "secondary_sp_id", r.spIDs[rIdx], "error", err)
+		return nil, err
+	}
+	return signature, nil
+}
+
+func verifyBlsSignature(blsKey, signature, sigDoc []byte) error {
+	publicKey, err := bls.PublicKeyFromBytes(blsKey)
+	if err != nil {
+		return err
+	}
+	sig, err := bls.SignatureFromBytes(signature)
+	if err != nil {
+		return err
+	}
+	if !sig.Verify(publicKey, sigDoc) {
+		return fmt.Errorf("failed to verify bls signature")
+	}
+	return nil
+}
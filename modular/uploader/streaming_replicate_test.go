@@ -0,0 +1,217 @@
+package uploader
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspclient"
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfspserver"
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
+	"github.com/bnb-chain/greenfield-storage-provider/core/consensus"
+	coretask "github.com/bnb-chain/greenfield-storage-provider/core/task"
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+func mockStreamingUploadTask(redundancyType storagetypes.RedundancyType) *gfsptask.GfSpUploadObjectTask {
+	return &gfsptask.GfSpUploadObjectTask{
+		Task: &gfsptask.GfSpTask{},
+		ObjectInfo: &storagetypes.ObjectInfo{
+			Id:             sdkmath.NewUint(1),
+			RedundancyType: redundancyType,
+			Checksums:      make([][]byte, 7),
+		},
+		StorageParams: &storagetypes.Params{VersionedParams: storagetypes.VersionedParams{
+			RedundantDataChunkNum:   4,
+			RedundantParityChunkNum: 2,
+		}},
+	}
+}
+
+func mockPickGVGResponse(secondaryNum int) *gfspserver.GfSpPickGlobalVirtualGroupResponse {
+	resp := &gfspserver.GfSpPickGlobalVirtualGroupResponse{GlobalVirtualGroupId: 1}
+	for i := 0; i < secondaryNum; i++ {
+		resp.SecondarySpIds = append(resp.SecondarySpIds, uint32(i+2))
+		resp.SecondaryEndpoints = append(resp.SecondaryEndpoints, "endpoint")
+	}
+	return resp
+}
+
+func TestUploadModular_NewStreamingReplicator(t *testing.T) {
+	u := setup(t)
+	ctrl := gomock.NewController(t)
+	m := gfspclient.NewMockGfSpClientAPI(ctrl)
+	u.baseApp.SetGfSpClient(m)
+	task := mockStreamingUploadTask(storagetypes.REDUNDANCY_EC_TYPE)
+
+	// disabled
+	assert.Nil(t, u.newStreamingReplicator(context.TODO(), task))
+
+	u.streamingReplicate = true
+	m.EXPECT().PickGlobalVirtualGroup(gomock.Any(), gomock.Any()).Return(nil, mockErr).Times(1)
+	assert.Nil(t, u.newStreamingReplicator(context.TODO(), task))
+
+	m.EXPECT().PickGlobalVirtualGroup(gomock.Any(), gomock.Any()).Return(mockPickGVGResponse(6), nil).Times(1)
+	r := u.newStreamingReplicator(context.TODO(), task)
+	assert.NotNil(t, r)
+	assert.Equal(t, uint32(1), r.gvgID)
+	assert.Equal(t, 6, len(r.spEps))
+	r.abort(mockErr)
+	r.finish()
+
+	// the checksums of the agent upload are unknown
+	task.IsAgentUpload = true
+	assert.Nil(t, u.newStreamingReplicator(context.TODO(), task))
+}
+
+func TestStreamingReplicator_Replicate(t *testing.T) {
+	cases := []struct {
+		name           string
+		redundancyType storagetypes.RedundancyType
+		pieceSize      int
+	}{
+		{name: "ec", redundancyType: storagetypes.REDUNDANCY_EC_TYPE, pieceSize: 4},
+		{name: "replica", redundancyType: storagetypes.REDUNDANCY_REPLICA_TYPE, pieceSize: 16},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			u := setup(t)
+			u.streamingReplicate = true
+			ctrl := gomock.NewController(t)
+			m := gfspclient.NewMockGfSpClientAPI(ctrl)
+			u.baseApp.SetGfSpClient(m)
+			m.EXPECT().PickGlobalVirtualGroup(gomock.Any(), gomock.Any()).Return(mockPickGVGResponse(6), nil).Times(1)
+			m.EXPECT().SignReceiveTask(gomock.Any(), gomock.Any()).Return([]byte("sig"), nil).Times(6)
+			var (
+				mu      sync.Mutex
+				indexes = make(map[int32]bool)
+			)
+			m.EXPECT().ReplicatePieceToSecondary(gomock.Any(), "endpoint", gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, endpoint string, receive coretask.ReceivePieceTask, data []byte) error {
+					mu.Lock()
+					defer mu.Unlock()
+					assert.Equal(t, uint32(3), receive.GetSegmentIdx())
+					assert.Equal(t, tt.pieceSize, len(data))
+					indexes[receive.GetRedundancyIdx()] = true
+					return nil
+				}).Times(6)
+
+			r := u.newStreamingReplicator(context.TODO(), mockStreamingUploadTask(tt.redundancyType))
+			r.replicate(3, bytes.Repeat([]byte("a"), 16))
+			assert.NoError(t, r.failed())
+			assert.Equal(t, 6, len(indexes))
+		})
+	}
+}
+
+func TestStreamingReplicator_ReplicateFailure(t *testing.T) {
+	u := setup(t)
+	u.streamingReplicate = true
+	ctrl := gomock.NewController(t)
+	m := gfspclient.NewMockGfSpClientAPI(ctrl)
+	u.baseApp.SetGfSpClient(m)
+	m.EXPECT().PickGlobalVirtualGroup(gomock.Any(), gomock.Any()).Return(mockPickGVGResponse(6), nil).Times(1)
+	m.EXPECT().SignReceiveTask(gomock.Any(), gomock.Any()).Return([]byte("sig"), nil).AnyTimes()
+	m.EXPECT().ReplicatePieceToSecondary(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockErr).AnyTimes()
+
+	task := mockStreamingUploadTask(storagetypes.REDUNDANCY_EC_TYPE)
+	r := u.newStreamingReplicator(context.TODO(), task)
+	r.replicate(0, bytes.Repeat([]byte("a"), 16))
+	assert.Equal(t, mockErr, r.failed())
+	// the later segments and the done replication are skipped, the task is replicated by the executor to the same gvg
+	r.enqueue(1, bytes.Repeat([]byte("a"), 16))
+	r.finish()
+	assert.Equal(t, uint32(1), task.GetGlobalVirtualGroupId())
+	assert.Equal(t, 6, len(task.GetSecondaryEndpoints()))
+	assert.Nil(t, task.GetSecondarySignatures())
+}
+
+func TestStreamingReplicator_Enqueue(t *testing.T) {
+	u := setup(t)
+	u.streamingReplicate = true
+	u.segmentParallel = 2
+	ctrl := gomock.NewController(t)
+	m := gfspclient.NewMockGfSpClientAPI(ctrl)
+	u.baseApp.SetGfSpClient(m)
+	m.EXPECT().PickGlobalVirtualGroup(gomock.Any(), gomock.Any()).Return(mockPickGVGResponse(2), nil).Times(1)
+	m.EXPECT().SignReceiveTask(gomock.Any(), gomock.Any()).Return([]byte("sig"), nil).AnyTimes()
+	m.EXPECT().ReplicatePieceToSecondary(gomock.Any(), "endpoint", gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, endpoint string, receive coretask.ReceivePieceTask, data []byte) error {
+			// the queued segment is a copy, which is not changed after the buffer is reused
+			assert.Equal(t, bytes.Repeat([]byte("a"), 16), data)
+			return nil
+		}).Times(4)
+	m.EXPECT().DoneReplicatePieceToSecondary(gomock.Any(), "endpoint", gomock.Any()).Return(nil, mockErr).AnyTimes()
+
+	task := mockStreamingUploadTask(storagetypes.REDUNDANCY_REPLICA_TYPE)
+	r := u.newStreamingReplicator(context.TODO(), task)
+	for segIdx := uint32(0); segIdx < 2; segIdx++ {
+		data := bytes.Repeat([]byte("a"), 16)
+		r.enqueue(segIdx, data)
+		copy(data, bytes.Repeat([]byte("b"), 16))
+	}
+	r.finish()
+	assert.Equal(t, mockErr, r.failed())
+	assert.Nil(t, task.GetSecondarySignatures())
+}
+
+func TestStreamingReplicator_QueueFull(t *testing.T) {
+	u := setup(t)
+	u.streamingReplicate = true
+	u.segmentParallel = 1
+	u.streamingQueueSize = 1
+	ctrl := gomock.NewController(t)
+	m := gfspclient.NewMockGfSpClientAPI(ctrl)
+	u.baseApp.SetGfSpClient(m)
+	m.EXPECT().PickGlobalVirtualGroup(gomock.Any(), gomock.Any()).Return(mockPickGVGResponse(2), nil).Times(1)
+	m.EXPECT().SignReceiveTask(gomock.Any(), gomock.Any()).Return([]byte("sig"), nil).AnyTimes()
+	// the secondary sps hang until the replication is aborted
+	m.EXPECT().ReplicatePieceToSecondary(gomock.Any(), "endpoint", gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, endpoint string, receive coretask.ReceivePieceTask, data []byte) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}).AnyTimes()
+
+	task := mockStreamingUploadTask(storagetypes.REDUNDANCY_REPLICA_TYPE)
+	r := u.newStreamingReplicator(context.TODO(), task)
+	// the upload doesn't wait for the replication, the replication fails once the queue is full
+	for segIdx := uint32(0); segIdx < 3; segIdx++ {
+		r.enqueue(segIdx, bytes.Repeat([]byte("a"), 16))
+	}
+	assert.Error(t, r.failed())
+	r.finish()
+	assert.Equal(t, uint32(1), task.GetGlobalVirtualGroupId())
+	assert.Nil(t, task.GetSecondarySignatures())
+}
+
+func TestStreamingReplicator_FinishFailure(t *testing.T) {
+	u := setup(t)
+	u.streamingReplicate = true
+	ctrl := gomock.NewController(t)
+	m := gfspclient.NewMockGfSpClientAPI(ctrl)
+	u.baseApp.SetGfSpClient(m)
+	m.EXPECT().PickGlobalVirtualGroup(gomock.Any(), gomock.Any()).Return(mockPickGVGResponse(2), nil).Times(1)
+	m.EXPECT().SignReceiveTask(gomock.Any(), gomock.Any()).Return([]byte("sig"), nil).AnyTimes()
+	m.EXPECT().DoneReplicatePieceToSecondary(gomock.Any(), "endpoint", gomock.Any()).DoAndReturn(
+		func(ctx context.Context, endpoint string, receive coretask.ReceivePieceTask) ([]byte, error) {
+			assert.True(t, receive.GetFinished())
+			return []byte("signature"), nil
+		}).MinTimes(1)
+	m1 := consensus.NewMockConsensus(ctrl)
+	u.baseApp.SetConsensus(m1)
+	m1.EXPECT().QuerySPByID(gomock.Any(), uint32(2)).Return(&sptypes.StorageProvider{}, mockErr).AnyTimes()
+	m1.EXPECT().QuerySPByID(gomock.Any(), uint32(3)).Return(&sptypes.StorageProvider{}, mockErr).AnyTimes()
+
+	task := mockStreamingUploadTask(storagetypes.REDUNDANCY_REPLICA_TYPE)
+	r := u.newStreamingReplicator(context.TODO(), task)
+	r.finish()
+	assert.Equal(t, mockErr, r.failed())
+	assert.Nil(t, task.GetSecondarySignatures())
+	assert.Equal(t, []string{"endpoint", "endpoint"}, task.GetSecondaryEndpoints())
+}
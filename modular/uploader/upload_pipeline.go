@@ -0,0 +1,191 @@
+package uploader
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/bnb-chain/greenfield-common/go/hash"
+	"github.com/bnb-chain/greenfield-storage-provider/core/piecestore"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
+)
+
+// segmentBufferPool reuses the segment buffers between the uploads, the buffers are pooled by their size. Only the
+// buffers of the max segment size are pooled, so the number of the pools is bounded by the storage params.
+type segmentBufferPool struct {
+	pools sync.Map
+}
+
+// get returns a buffer of the size, it's taken from the pool if the size is pooled.
+func (p *segmentBufferPool) get(size int64, pooled bool) []byte {
+	if !pooled {
+		return make([]byte, size)
+	}
+	pool, _ := p.pools.LoadOrStore(size, &sync.Pool{New: func() interface{} {
+		buf := make([]byte, size)
+		return &buf
+	}})
+	return *(pool.(*sync.Pool).Get().(*[]byte))
+}
+
+// put returns the buffer of the size to the pool.
+func (p *segmentBufferPool) put(buf []byte, size int64, pooled bool) {
+	if !pooled || int64(cap(buf)) != size {
+		return
+	}
+	if pool, ok := p.pools.Load(size); ok {
+		buf = buf[:size]
+		pool.(*sync.Pool).Put(&buf)
+	}
+}
+
+// segmentPiece is a segment piece put to the piece store in the background.
+type segmentPiece struct {
+	segIdx   uint32
+	key      string
+	size     int
+	checksum []byte
+	done     chan error
+}
+
+// segmentPipeline reads the segments from the stream and puts them to the piece store in parallel, the next segment
+// is read while the previous ones are being put. At most parallel segments are in flight, and every segment is
+// committed in order after it's put, e.g. its checksum is appended to the checksum list of the object. If the upload
+// fails, the pieces which are put but not kept are deleted from the piece store.
+type segmentPipeline struct {
+	pieceStore  piecestore.PieceStore
+	bufferPool  *segmentBufferPool
+	parallel    int
+	segmentSize int64
+	// pooled defines whether the segment buffers are taken from the buffer pool
+	pooled bool
+	// pieceKey returns the piece key of the segment
+	pieceKey func(segIdx uint32) string
+	// commit is called in the order of the segments after the piece is put
+	commit func(segIdx uint32, checksum []byte, size int) error
+	// keepCommitted defines whether the committed pieces are kept if the upload fails, e.g. the resumable upload
+	// records the committed pieces in db and resumes from them
+	keepCommitted bool
+	// replicate hands the segment over to the replication to the secondary SPs before putting it, it must not keep
+	// the data or wait for the replication. It's nil if the object is replicated after uploading
+	replicate func(segIdx uint32, data []byte)
+	// createTime is the create time of the task, which the metrics of the upload stages are relative to
+	createTime time.Time
+}
+
+// newSegmentPipeline returns the segment pipeline of the uploader, the buffers are pooled if the segment size is the
+// max segment size, e.g. the object has more than one segment.
+func (u *UploadModular) newSegmentPipeline(segmentSize int64, maxSegmentSize uint64, createTime time.Time) *segmentPipeline {
+	return &segmentPipeline{
+		pieceStore:  u.baseApp.PieceStore(),
+		bufferPool:  &u.bufferPool,
+		parallel:    u.segmentParallel,
+		segmentSize: segmentSize,
+		pooled:      segmentSize == int64(maxSegmentSize),
+		createTime:  createTime,
+	}
+}
+
+// run reads the stream until EOF from the segment index, and returns the size of the read data.
+func (p *segmentPipeline) run(ctx context.Context, stream io.Reader, segIdx uint32) (int, error) {
+	putCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	parallel := p.parallel
+	if parallel <= 0 {
+		parallel = 1
+	}
+
+	var (
+		readSize int
+		err      error
+		inflight []*segmentPiece
+		put      []*segmentPiece
+	)
+	// wait waits for the first in flight piece and commits it
+	wait := func() {
+		piece := inflight[0]
+		inflight = inflight[1:]
+		putErr := <-piece.done
+		if putErr != nil {
+			if err == nil {
+				log.CtxErrorw(ctx, "failed to put segment piece to piece store", "piece_key", piece.key, "error", putErr)
+				err = ErrPieceStoreWithDetail(fmt.Sprintf("failed to put segment piece to piece store, piece_key: %s, error: %s",
+					piece.key, putErr.Error()))
+				cancel()
+			}
+			return
+		}
+		put = append(put, piece)
+		if err != nil {
+			return
+		}
+		if err = p.commit(piece.segIdx, piece.checksum, piece.size); err != nil {
+			cancel()
+			return
+		}
+		if p.keepCommitted {
+			put = put[:len(put)-1]
+		}
+	}
+
+	for err == nil {
+		if len(inflight) == parallel {
+			wait()
+			continue
+		}
+		data := p.bufferPool.get(p.segmentSize, p.pooled)
+		startReadTime := time.Now()
+		readN, readErr := StreamReadAt(stream, data)
+		metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_server_read_data_cost").Observe(time.Since(startReadTime).Seconds())
+		metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_server_read_data_end").Observe(time.Since(p.createTime).Seconds())
+		readSize += readN
+		if readErr != nil && readErr != io.EOF {
+			p.bufferPool.put(data, p.segmentSize, p.pooled)
+			log.CtxErrorw(ctx, "stream closed abnormally", "seg_idx", segIdx, "error", readErr)
+			err = ErrClosedStream
+			break
+		}
+		if readN == 0 {
+			p.bufferPool.put(data, p.segmentSize, p.pooled)
+		} else {
+			piece := &segmentPiece{segIdx: segIdx, key: p.pieceKey(segIdx), size: readN, done: make(chan error, 1)}
+			inflight = append(inflight, piece)
+			go func(data []byte) {
+				// the buffer is reused after the piece store returns, which doesn't keep the data
+				defer p.bufferPool.put(data, p.segmentSize, p.pooled)
+				if p.replicate != nil {
+					p.replicate(piece.segIdx, data)
+				}
+				piece.checksum = hash.GenerateChecksum(data)
+				startPutTime := time.Now()
+				putErr := p.pieceStore.PutPiece(putCtx, piece.key, data)
+				metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_server_put_piece_cost").Observe(time.Since(startPutTime).Seconds())
+				metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_server_put_piece_end").Observe(time.Since(p.createTime).Seconds())
+				piece.done <- putErr
+			}(data[:readN])
+			segIdx++
+		}
+		if readErr == io.EOF {
+			break
+		}
+	}
+	for len(inflight) > 0 {
+		wait()
+	}
+	if err != nil {
+		p.cleanup(ctx, put)
+	}
+	return readSize, err
+}
+
+// cleanup deletes the pieces of the failed upload, it's not canceled with the upload.
+func (p *segmentPipeline) cleanup(ctx context.Context, pieces []*segmentPiece) {
+	for _, piece := range pieces {
+		if err := p.pieceStore.DeletePiece(context.Background(), piece.key); err != nil {
+			log.CtxErrorw(ctx, "failed to delete segment piece of the failed upload", "piece_key", piece.key, "error", err)
+		}
+	}
+}
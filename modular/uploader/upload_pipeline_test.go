@@ -0,0 +1,149 @@
+package uploader
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/bnb-chain/greenfield-common/go/hash"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bnb-chain/greenfield-storage-provider/core/piecestore"
+)
+
+func newTestSegmentPipeline(store piecestore.PieceStore, parallel int) *segmentPipeline {
+	return &segmentPipeline{
+		pieceStore:  store,
+		bufferPool:  &segmentBufferPool{},
+		parallel:    parallel,
+		segmentSize: 2,
+		pooled:      true,
+		pieceKey:    func(segIdx uint32) string { return fmt.Sprintf("piece_%d", segIdx) },
+		createTime:  time.Now(),
+	}
+}
+
+func TestSegmentPipeline_RunInOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := piecestore.NewMockPieceStore(ctrl)
+	var inflight, maxInflight int32
+	store.EXPECT().PutPiece(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, key string, value []byte) error {
+			n := atomic.AddInt32(&inflight, 1)
+			defer atomic.AddInt32(&inflight, -1)
+			for {
+				m := atomic.LoadInt32(&maxInflight)
+				if n <= m || atomic.CompareAndSwapInt32(&maxInflight, m, n) {
+					break
+				}
+			}
+			// the earlier pieces are put slower
+			var segIdx int
+			_, _ = fmt.Sscanf(key, "piece_%d", &segIdx)
+			time.Sleep(time.Duration(5-segIdx) * 5 * time.Millisecond)
+			return nil
+		}).Times(5)
+
+	p := newTestSegmentPipeline(store, 3)
+	var (
+		segments  []uint32
+		checksums [][]byte
+	)
+	p.commit = func(segIdx uint32, checksum []byte, size int) error {
+		segments = append(segments, segIdx)
+		checksums = append(checksums, checksum)
+		return nil
+	}
+	readSize, err := p.run(context.Background(), bytes.NewReader([]byte("abcdefghi")), 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 9, readSize)
+	assert.Equal(t, []uint32{0, 1, 2, 3, 4}, segments)
+	assert.Equal(t, [][]byte{hash.GenerateChecksum([]byte("ab")), hash.GenerateChecksum([]byte("cd")),
+		hash.GenerateChecksum([]byte("ef")), hash.GenerateChecksum([]byte("gh")), hash.GenerateChecksum([]byte("i"))}, checksums)
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxInflight), int32(3))
+}
+
+func TestSegmentPipeline_RunPutFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := piecestore.NewMockPieceStore(ctrl)
+	store.EXPECT().PutPiece(gomock.Any(), "piece_0", gomock.Any()).Return(nil)
+	store.EXPECT().PutPiece(gomock.Any(), "piece_1", gomock.Any()).Return(mockErr)
+	store.EXPECT().PutPiece(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	var (
+		mu      sync.Mutex
+		deleted = make(map[string]bool)
+	)
+	store.EXPECT().DeletePiece(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, key string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		deleted[key] = true
+		return nil
+	}).AnyTimes()
+
+	p := newTestSegmentPipeline(store, 2)
+	p.commit = func(segIdx uint32, checksum []byte, size int) error { return nil }
+	_, err := p.run(context.Background(), bytes.NewReader([]byte("abcdefghi")), 0)
+	assert.Contains(t, err.Error(), mockErr.Error())
+	// the committed piece is deleted too, the failed piece is never written
+	assert.True(t, deleted["piece_0"])
+	assert.False(t, deleted["piece_1"])
+}
+
+func TestSegmentPipeline_RunCommitFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := piecestore.NewMockPieceStore(ctrl)
+	store.EXPECT().PutPiece(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	var deleted []string
+	store.EXPECT().DeletePiece(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, key string) error {
+		deleted = append(deleted, key)
+		return nil
+	}).AnyTimes()
+
+	p := newTestSegmentPipeline(store, 1)
+	p.keepCommitted = true
+	p.commit = func(segIdx uint32, checksum []byte, size int) error {
+		if segIdx == 3 {
+			return mockErr
+		}
+		return nil
+	}
+	_, err := p.run(context.Background(), bytes.NewReader([]byte("abcdefghi")), 2)
+	assert.Equal(t, mockErr, err)
+	// the committed piece is kept to resume from
+	assert.Equal(t, []string{"piece_3"}, deleted)
+}
+
+func TestSegmentPipeline_RunReplicate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := piecestore.NewMockPieceStore(ctrl)
+	store.EXPECT().PutPiece(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(3)
+
+	p := newTestSegmentPipeline(store, 2)
+	p.commit = func(segIdx uint32, checksum []byte, size int) error { return nil }
+	var (
+		mu         sync.Mutex
+		replicated = make(map[uint32]string)
+	)
+	p.replicate = func(segIdx uint32, data []byte) {
+		mu.Lock()
+		defer mu.Unlock()
+		replicated[segIdx] = string(data)
+	}
+	_, err := p.run(context.Background(), bytes.NewReader([]byte("abcde")), 0)
+	assert.NoError(t, err)
+	assert.Equal(t, map[uint32]string{0: "ab", 1: "cd", 2: "e"}, replicated)
+}
+
+func TestSegmentBufferPool(t *testing.T) {
+	pool := &segmentBufferPool{}
+	buf := pool.get(4, true)
+	assert.Equal(t, 4, len(buf))
+	pool.put(buf[:1], 4, true)
+	assert.Equal(t, 4, len(pool.get(4, true)))
+	assert.Equal(t, 3, len(pool.get(3, false)))
+}
@@ -100,14 +100,12 @@ func (u *UploadModular) HandleUploadObjectTask(ctx context.Context, uploadObject
 		uploadObjectTask.GetStorageParams().GetMaxSegmentSize())
 	var (
 		err       error
-		segIdx    uint32 = 0
-		pieceKey  string
 		integrity []byte
 		checksums [][]byte
-		readN     int
 		readSize  int
-		data      = make([]byte, segmentSize)
+		uploaded  bool
 	)
+	replicator := u.newStreamingReplicator(ctx, uploadObjectTask)
 	metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_begin_from_task_create").Observe(time.Since(time.Unix(uploadObjectTask.GetCreateTime(), 0)).Seconds())
 	defer func() {
 		if err != nil {
@@ -117,7 +115,14 @@ func (u *UploadModular) HandleUploadObjectTask(ctx context.Context, uploadObject
 			"read_size", readSize, "error", err)
 		uploadObjectTask.AppendLog("uploader-report-upload-task")
 		metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_end_from_task_create").Observe(time.Since(time.Unix(uploadObjectTask.GetCreateTime(), 0)).Seconds())
+		if replicator != nil && !uploaded {
+			replicator.abort(fmt.Errorf("failed to upload object"))
+		}
 		go func() {
+			if replicator != nil {
+				// the manager replicates the object to the same gvg if the streaming replication fails
+				replicator.finish()
+			}
 			metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_before_report_manager_end").Observe(time.Since(time.Unix(uploadObjectTask.GetCreateTime(), 0)).Seconds())
 			if err = retry.Do(func() error {
 				return u.baseApp.GfSpClient().ReportTask(context.Background(), uploadObjectTask)
@@ -132,103 +137,77 @@ func (u *UploadModular) HandleUploadObjectTask(ctx context.Context, uploadObject
 			metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_after_report_manager_end").Observe(time.Since(time.Unix(uploadObjectTask.GetCreateTime(), 0)).Seconds())
 		}()
 	}()
-	startTime := time.Now()
-	for {
-		if err != nil {
-			return err
-		}
-		data = data[0:segmentSize]
-		startReadFromGateway := time.Now()
-		readN, err = StreamReadAt(stream, data)
-		metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_server_read_data_cost").Observe(time.Since(startReadFromGateway).Seconds())
-		metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_server_read_data_end").Observe(time.Since(time.Unix(uploadObjectTask.GetCreateTime(), 0)).Seconds())
-		readSize += readN
-		data = data[0:readN]
-
-		if err == io.EOF {
-			err = nil
-			if readN != 0 { // the last segment piece
-				pieceKey = u.baseApp.PieceOp().SegmentPieceKey(uploadObjectTask.GetObjectInfo().Id.Uint64(), segIdx, uploadObjectTask.GetObjectInfo().Version)
-				checksums = append(checksums, hash.GenerateChecksum(data))
-				startPutPiece := time.Now()
-				err = u.baseApp.PieceStore().PutPiece(ctx, pieceKey, data)
-				metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_server_put_piece_cost").Observe(time.Since(startPutPiece).Seconds())
-				metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_server_put_piece_end").Observe(time.Since(startTime).Seconds())
-				if err != nil {
-					log.CtxErrorw(ctx, "failed to put segment piece to piece store", "piece_key", pieceKey, "error", err)
-					return ErrPieceStoreWithDetail("failed to put segment piece to piece store, piece_key: " + pieceKey + ", error: " + err.Error())
-				}
-			}
-			integrity = hash.GenerateIntegrityHash(checksums)
-			if !uploadObjectTask.GetIsAgentUpload() {
-				expectedChecksum := uploadObjectTask.GetObjectInfo().GetChecksums()[0]
-				if !bytes.Equal(integrity, expectedChecksum) {
-					log.CtxErrorw(ctx, "failed to put object due to check integrity hash not consistent",
-						"object_info", uploadObjectTask.GetObjectInfo(), "actual_integrity", hex.EncodeToString(integrity),
-						"expected_integrity", hex.EncodeToString(expectedChecksum))
-					err = ErrInvalidIntegrity
-					return ErrInvalidIntegrity
-				}
-			}
-			if uint64(readSize) != uploadObjectTask.GetObjectInfo().GetPayloadSize() {
-				log.CtxErrorw(ctx, "readSize is not equal payloadSize", "objectID", uploadObjectTask.GetObjectInfo().Id.Uint64(), "readSize", readSize, "payloadSize", uploadObjectTask.GetObjectInfo().GetPayloadSize())
-				go u.rejectCreateObject(ctx, uploadObjectTask.GetObjectInfo())
-				return ErrPayloadSize
-			}
-			startUpdateSignature := time.Now()
-			if uploadObjectTask.GetObjectInfo().GetIsUpdating() {
-				integrityMeta := &corespdb.ShadowIntegrityMeta{
-					ObjectID:          uploadObjectTask.GetObjectInfo().Id.Uint64(),
-					RedundancyIndex:   piecestore.PrimarySPRedundancyIndex,
-					PieceChecksumList: checksums,
-					IntegrityChecksum: integrity,
-					Version:           uploadObjectTask.GetObjectInfo().GetVersion(),
-					ObjectSize:        uint64(readSize),
-				}
-				err = u.baseApp.GfSpDB().SetShadowObjectIntegrity(integrityMeta)
-			} else {
-				integrityMeta := &corespdb.IntegrityMeta{
-					ObjectID:          uploadObjectTask.GetObjectInfo().Id.Uint64(),
-					RedundancyIndex:   piecestore.PrimarySPRedundancyIndex,
-					PieceChecksumList: checksums,
-					IntegrityChecksum: integrity,
-					ObjectSize:        uint64(readSize),
-				}
-				err = u.baseApp.GfSpDB().SetObjectIntegrity(integrityMeta)
-			}
-			metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_set_integrity_cost").Observe(time.Since(startUpdateSignature).Seconds())
-			metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_set_integrity_end").Observe(time.Since(time.Unix(uploadObjectTask.GetCreateTime(), 0)).Seconds())
-			if err != nil {
-				log.CtxErrorw(ctx, "failed to write integrity hash to db", "error", err)
-				return ErrGfSpDBWithDetail("failed to write integrity hash to db, error: " + err.Error())
-			}
-			err = u.baseApp.GfSpDB().UpdateUploadProgress(&corespdb.UploadObjectMeta{
-				ObjectID:  uploadObjectTask.GetObjectInfo().Id.Uint64(),
-				TaskState: types.TaskState_TASK_STATE_UPLOAD_OBJECT_DONE,
-			})
-			if err != nil {
-				log.CtxErrorw(ctx, "failed to update upload progress", "error", err)
-				return ErrGfSpDBWithDetail("failed to update upload progress, error: " + err.Error())
-			}
-			log.CtxDebugw(ctx, "succeed to upload payload to piece store")
-			return nil
+
+	pipeline := u.newSegmentPipeline(segmentSize, uploadObjectTask.GetStorageParams().GetMaxSegmentSize(),
+		time.Unix(uploadObjectTask.GetCreateTime(), 0))
+	pipeline.pieceKey = func(segIdx uint32) string {
+		return u.baseApp.PieceOp().SegmentPieceKey(uploadObjectTask.GetObjectInfo().Id.Uint64(), segIdx, uploadObjectTask.GetObjectInfo().Version)
+	}
+	pipeline.commit = func(_ uint32, checksum []byte, _ int) error {
+		checksums = append(checksums, checksum)
+		return nil
+	}
+	if replicator != nil {
+		pipeline.replicate = replicator.enqueue
+	}
+	if readSize, err = pipeline.run(ctx, stream, 0); err != nil {
+		return err
+	}
+
+	integrity = hash.GenerateIntegrityHash(checksums)
+	if !uploadObjectTask.GetIsAgentUpload() {
+		expectedChecksum := uploadObjectTask.GetObjectInfo().GetChecksums()[0]
+		if !bytes.Equal(integrity, expectedChecksum) {
+			log.CtxErrorw(ctx, "failed to put object due to check integrity hash not consistent",
+				"object_info", uploadObjectTask.GetObjectInfo(), "actual_integrity", hex.EncodeToString(integrity),
+				"expected_integrity", hex.EncodeToString(expectedChecksum))
+			err = ErrInvalidIntegrity
+			return ErrInvalidIntegrity
 		}
-		if err != nil {
-			log.CtxErrorw(ctx, "stream closed abnormally", "piece_key", pieceKey, "error", err)
-			return ErrClosedStream
+	}
+	if uint64(readSize) != uploadObjectTask.GetObjectInfo().GetPayloadSize() {
+		log.CtxErrorw(ctx, "readSize is not equal payloadSize", "objectID", uploadObjectTask.GetObjectInfo().Id.Uint64(), "readSize", readSize, "payloadSize", uploadObjectTask.GetObjectInfo().GetPayloadSize())
+		go u.rejectCreateObject(ctx, uploadObjectTask.GetObjectInfo())
+		return ErrPayloadSize
+	}
+	startUpdateSignature := time.Now()
+	if uploadObjectTask.GetObjectInfo().GetIsUpdating() {
+		integrityMeta := &corespdb.ShadowIntegrityMeta{
+			ObjectID:          uploadObjectTask.GetObjectInfo().Id.Uint64(),
+			RedundancyIndex:   piecestore.PrimarySPRedundancyIndex,
+			PieceChecksumList: checksums,
+			IntegrityChecksum: integrity,
+			Version:           uploadObjectTask.GetObjectInfo().GetVersion(),
+			ObjectSize:        uint64(readSize),
 		}
-		checksums = append(checksums, hash.GenerateChecksum(data))
-		startPutPiece := time.Now()
-		pieceKey = u.baseApp.PieceOp().SegmentPieceKey(uploadObjectTask.GetObjectInfo().Id.Uint64(), segIdx, uploadObjectTask.GetObjectInfo().Version)
-		err = u.baseApp.PieceStore().PutPiece(ctx, pieceKey, data)
-		metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_server_put_piece_cost").Observe(time.Since(startPutPiece).Seconds())
-		metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_server_put_piece_end").Observe(time.Since(time.Unix(uploadObjectTask.GetCreateTime(), 0)).Seconds())
-		if err != nil {
-			log.CtxErrorw(ctx, "failed to put segment piece to piece store", "error", err)
-			return ErrPieceStoreWithDetail("failed to put segment piece to piece store, error: " + err.Error())
+		err = u.baseApp.GfSpDB().SetShadowObjectIntegrity(integrityMeta)
+	} else {
+		integrityMeta := &corespdb.IntegrityMeta{
+			ObjectID:          uploadObjectTask.GetObjectInfo().Id.Uint64(),
+			RedundancyIndex:   piecestore.PrimarySPRedundancyIndex,
+			PieceChecksumList: checksums,
+			IntegrityChecksum: integrity,
+			ObjectSize:        uint64(readSize),
 		}
-		segIdx++
+		err = u.baseApp.GfSpDB().SetObjectIntegrity(integrityMeta)
 	}
+	metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_set_integrity_cost").Observe(time.Since(startUpdateSignature).Seconds())
+	metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_set_integrity_end").Observe(time.Since(time.Unix(uploadObjectTask.GetCreateTime(), 0)).Seconds())
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to write integrity hash to db", "error", err)
+		return ErrGfSpDBWithDetail("failed to write integrity hash to db, error: " + err.Error())
+	}
+	err = u.baseApp.GfSpDB().UpdateUploadProgress(&corespdb.UploadObjectMeta{
+		ObjectID:  uploadObjectTask.GetObjectInfo().Id.Uint64(),
+		TaskState: types.TaskState_TASK_STATE_UPLOAD_OBJECT_DONE,
+	})
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to update upload progress", "error", err)
+		return ErrGfSpDBWithDetail("failed to update upload progress, error: " + err.Error())
+	}
+	uploaded = true
+	log.CtxDebugw(ctx, "succeed to upload payload to piece store")
+	return nil
 }
 
 func (u *UploadModular) PostUploadObject(ctx context.Context, uploadObjectTask coretask.UploadObjectTask) {
@@ -284,11 +263,7 @@ func (u *UploadModular) HandleResumableUploadObjectTask(ctx context.Context, tas
 
 	var (
 		err       error
-		segIdx    = uint32(int64(offset) / segmentSize)
-		pieceKey  string
-		readN     int
 		readSize  int
-		data      = make([]byte, segmentSize)
 		pieceSize uint64
 	)
 	defer func() {
@@ -313,84 +288,58 @@ func (u *UploadModular) HandleResumableUploadObjectTask(ctx context.Context, tas
 		}()
 	}()
 
-	for {
+	pipeline := u.newSegmentPipeline(segmentSize, task.GetStorageParams().GetMaxSegmentSize(), time.Unix(task.GetCreateTime(), 0))
+	pipeline.pieceKey = func(segIdx uint32) string {
+		return u.baseApp.PieceOp().SegmentPieceKey(task.GetObjectInfo().Id.Uint64(), segIdx, task.GetObjectInfo().GetVersion())
+	}
+	// the checksums are appended to db in order, the upload is resumed from the last appended segment
+	pipeline.commit = func(_ uint32, checksum []byte, size int) error {
+		if err := u.updatePieceCheckSum(task, checksum, isUpdate, uint64(size)); err != nil {
+			log.CtxErrorw(ctx, "failed to append integrity checksum to db", "error", err)
+			return ErrGfSpDBWithDetail("failed to append integrity checksum to db, error: " + err.Error())
+		}
+		return nil
+	}
+	pipeline.keepCommitted = true
+	if readSize, err = pipeline.run(ctx, stream, uint32(int64(offset)/segmentSize)); err != nil {
+		return err
+	}
+
+	if task.GetCompleted() {
+		var pieceChecksumList [][]byte
+		pieceChecksumList, pieceSize, err = u.getPieceCheckSumListAndPieceSize(task, isUpdate)
 		if err != nil {
+			log.CtxErrorw(ctx, "failed to get object integrity hash", "error", err)
 			return err
 		}
-		data = data[0:segmentSize]
-		readN, err = StreamReadAt(stream, data)
-		readSize += readN
-		data = data[0:readN]
-
-		if err == io.EOF {
-			err = nil
-			if readN != 0 {
-				pieceKey = u.baseApp.PieceOp().SegmentPieceKey(task.GetObjectInfo().Id.Uint64(), segIdx, task.GetObjectInfo().Version)
-				err = u.baseApp.PieceStore().PutPiece(ctx, pieceKey, data)
-				if err != nil {
-					log.CtxErrorw(ctx, "failed to put segment piece to piece store", "piece_key", pieceKey, "error", err)
-					return ErrPieceStoreWithDetail(fmt.Sprintf("failed to put segment piece to piece store, piece_key: %s, error: %s",
-						pieceKey, err.Error()))
-				}
-				err = u.updatePieceCheckSum(task, data, isUpdate, uint64(readN))
-				if err != nil {
-					log.CtxErrorw(ctx, "failed to append integrity checksum to db", "error", err)
-					return ErrGfSpDBWithDetail("failed to append integrity checksum to db, error: " + err.Error())
-				}
-			}
-			if task.GetCompleted() {
-				var pieceChecksumList [][]byte
-				pieceChecksumList, pieceSize, err = u.getPieceCheckSumListAndPieceSize(task, isUpdate)
-				if err != nil {
-					log.CtxErrorw(ctx, "failed to get object integrity hash", "error", err)
-					return err
-				}
-				if task.GetIsAgentUpload() && pieceSize != task.GetObjectInfo().GetPayloadSize() {
-					log.CtxErrorw(ctx, "payload size error", "expected", pieceSize, "actual", task.GetObjectInfo().GetPayloadSize())
-					go u.rejectCreateObject(ctx, task.GetObjectInfo())
-				}
-				integrityHash := hash.GenerateIntegrityHash(pieceChecksumList)
-				if !task.GetIsAgentUpload() && !bytes.Equal(integrityHash, task.GetObjectInfo().GetChecksums()[0]) {
-					log.CtxErrorw(ctx, "invalid integrity hash", "object_info", task.GetObjectInfo(),
-						"actual", hex.EncodeToString(integrityHash), "expected", hex.EncodeToString(task.GetObjectInfo().GetChecksums()[0]))
-					err = ErrInvalidIntegrity
-					return ErrInvalidIntegrity
-				}
-				err = u.updateIntegrityChecksum(task.GetObjectInfo().Id.Uint64(), integrityHash, isUpdate)
-				if err != nil {
-					log.CtxErrorw(ctx, "failed to write integrity hash to db", "error", err)
-					return ErrGfSpDBWithDetail("failed to write integrity hash to db, error: " + err.Error())
-				}
-				err = u.baseApp.GfSpDB().UpdateUploadProgress(&corespdb.UploadObjectMeta{
-					ObjectID:  task.GetObjectInfo().Id.Uint64(),
-					TaskState: types.TaskState_TASK_STATE_UPLOAD_OBJECT_DONE,
-				})
-				if err != nil {
-					log.CtxErrorw(ctx, "failed to update upload progress", "error", err)
-					return ErrGfSpDBWithDetail("failed to update upload progress, error: " + err.Error())
-				}
-			}
-
-			log.CtxDebug(ctx, "succeed to upload payload to piece store")
-			return nil
+		if task.GetIsAgentUpload() && pieceSize != task.GetObjectInfo().GetPayloadSize() {
+			log.CtxErrorw(ctx, "payload size error", "expected", pieceSize, "actual", task.GetObjectInfo().GetPayloadSize())
+			go u.rejectCreateObject(ctx, task.GetObjectInfo())
 		}
-		if err != nil {
-			log.CtxErrorw(ctx, "stream closed abnormally", "piece_key", pieceKey, "error", err)
-			return ErrClosedStream
+		integrityHash := hash.GenerateIntegrityHash(pieceChecksumList)
+		if !task.GetIsAgentUpload() && !bytes.Equal(integrityHash, task.GetObjectInfo().GetChecksums()[0]) {
+			log.CtxErrorw(ctx, "invalid integrity hash", "object_info", task.GetObjectInfo(),
+				"actual", hex.EncodeToString(integrityHash), "expected", hex.EncodeToString(task.GetObjectInfo().GetChecksums()[0]))
+			err = ErrInvalidIntegrity
+			return ErrInvalidIntegrity
 		}
-		pieceKey = u.baseApp.PieceOp().SegmentPieceKey(task.GetObjectInfo().Id.Uint64(), segIdx, task.GetObjectInfo().GetVersion())
-		err = u.baseApp.PieceStore().PutPiece(ctx, pieceKey, data)
+		err = u.updateIntegrityChecksum(task.GetObjectInfo().Id.Uint64(), integrityHash, isUpdate)
 		if err != nil {
-			log.CtxErrorw(ctx, "failed to put segment piece to piece store", "error", err)
-			return ErrPieceStoreWithDetail("failed to put segment piece to piece store, error: " + err.Error())
+			log.CtxErrorw(ctx, "failed to write integrity hash to db", "error", err)
+			return ErrGfSpDBWithDetail("failed to write integrity hash to db, error: " + err.Error())
 		}
-		err = u.updatePieceCheckSum(task, data, isUpdate, uint64(readN))
+		err = u.baseApp.GfSpDB().UpdateUploadProgress(&corespdb.UploadObjectMeta{
+			ObjectID:  task.GetObjectInfo().Id.Uint64(),
+			TaskState: types.TaskState_TASK_STATE_UPLOAD_OBJECT_DONE,
+		})
 		if err != nil {
-			log.CtxErrorw(ctx, "failed to append integrity checksum to db", "error", err)
-			return ErrGfSpDBWithDetail("failed to append integrity checksum to db, error: " + err.Error())
+			log.CtxErrorw(ctx, "failed to update upload progress", "error", err)
+			return ErrGfSpDBWithDetail("failed to update upload progress, error: " + err.Error())
 		}
-		segIdx++
 	}
+
+	log.CtxDebug(ctx, "succeed to upload payload to piece store")
+	return nil
 }
 
 func (*UploadModular) PostResumableUploadObject(ctx context.Context, task coretask.ResumableUploadObjectTask) {
@@ -416,12 +365,12 @@ func StreamReadAt(stream io.Reader, b []byte) (int, error) {
 	}
 }
 
-func (u *UploadModular) updatePieceCheckSum(task coretask.ResumableUploadObjectTask, data []byte, isUpdate bool, dataLength uint64) error {
+func (u *UploadModular) updatePieceCheckSum(task coretask.ResumableUploadObjectTask, checksum []byte, isUpdate bool, dataLength uint64) error {
 	var err error
 	if isUpdate {
-		err = u.baseApp.GfSpDB().UpdateShadowPieceChecksum(task.GetObjectInfo().Id.Uint64(), piecestore.PrimarySPRedundancyIndex, hash.GenerateChecksum(data), task.GetObjectInfo().GetVersion(), dataLength)
+		err = u.baseApp.GfSpDB().UpdateShadowPieceChecksum(task.GetObjectInfo().Id.Uint64(), piecestore.PrimarySPRedundancyIndex, checksum, task.GetObjectInfo().GetVersion(), dataLength)
 	} else {
-		err = u.baseApp.GfSpDB().UpdatePieceChecksum(task.GetObjectInfo().Id.Uint64(), piecestore.PrimarySPRedundancyIndex, hash.GenerateChecksum(data), dataLength)
+		err = u.baseApp.GfSpDB().UpdatePieceChecksum(task.GetObjectInfo().Id.Uint64(), piecestore.PrimarySPRedundancyIndex, checksum, dataLength)
 	}
 	return err
 }
@@ -16,6 +16,11 @@ type UploadModular struct {
 	scope                 rcmgr.ResourceScope
 	uploadQueue           taskqueue.TQueueOnStrategy
 	resumeableUploadQueue taskqueue.TQueueOnStrategy
+	segmentParallel       int
+	bufferPool            segmentBufferPool
+	streamingReplicate    bool
+	// streamingQueueSize is the max number of segments of an object waiting to be replicated
+	streamingQueueSize int
 }
 
 func (u *UploadModular) Name() string {
@@ -10,6 +10,12 @@ const (
 	// DefaultUploadObjectParallelPerNode defines the default max parallel of uploading
 	// object per uploader.
 	DefaultUploadObjectParallelPerNode = 10240
+	// DefaultUploadSegmentParallelPerObject defines the default max number of segment pieces of
+	// an object put to the piece store in parallel.
+	DefaultUploadSegmentParallelPerObject = 4
+	// DefaultStreamingReplicateQueueSize defines the default max number of segments of an object waiting to be
+	// replicated to the secondary SPs while uploading.
+	DefaultStreamingReplicateQueueSize = 4
 	// RejectUnSealObjectRetry defines the retry number of sending reject unseal object tx.
 	RejectUnSealObjectRetry = 3
 	// RejectUnSealObjectTimeout defines the timeout of sending reject unseal object tx.
@@ -28,6 +34,15 @@ func defaultUploaderOptions(uploader *UploadModular, cfg *gfspconfig.GfSpConfig)
 	if cfg.Parallel.UploadObjectParallelPerNode == 0 {
 		cfg.Parallel.UploadObjectParallelPerNode = DefaultUploadObjectParallelPerNode
 	}
+	if cfg.Parallel.UploadSegmentParallelPerObject == 0 {
+		cfg.Parallel.UploadSegmentParallelPerObject = DefaultUploadSegmentParallelPerObject
+	}
+	uploader.segmentParallel = cfg.Parallel.UploadSegmentParallelPerObject
+	if cfg.Uploader.StreamingReplicateQueueSize == 0 {
+		cfg.Uploader.StreamingReplicateQueueSize = DefaultStreamingReplicateQueueSize
+	}
+	uploader.streamingReplicate = cfg.Uploader.StreamingReplicateEnabled
+	uploader.streamingQueueSize = cfg.Uploader.StreamingReplicateQueueSize
 	uploader.uploadQueue = cfg.Customize.NewStrategyTQueueFunc(
 		uploader.Name()+"-upload-object", cfg.Parallel.UploadObjectParallelPerNode)
 	uploader.resumeableUploadQueue = cfg.Customize.NewStrategyTQueueFunc(
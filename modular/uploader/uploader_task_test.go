@@ -741,6 +741,7 @@ func TestUploadModular_HandleResumableUploadObjectTaskFailure3(t *testing.T) {
 
 	m4 := piecestore.NewMockPieceStore(ctrl)
 	m4.EXPECT().PutPiece(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	m4.EXPECT().DeletePiece(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 	u.baseApp.SetPieceStore(m4)
 
 	m5 := corespdb.NewMockSPDB(ctrl)
@@ -963,6 +964,7 @@ func TestUploadModular_HandleResumableUploadObjectTaskFailure9(t *testing.T) {
 	m4 := piecestore.NewMockPieceStore(ctrl)
 	u.baseApp.SetPieceStore(m4)
 	m4.EXPECT().PutPiece(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	m4.EXPECT().DeletePiece(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	m5 := corespdb.NewMockSPDB(ctrl)
 	u.baseApp.SetGfSpDB(m5)
@@ -30,6 +30,10 @@ var MetricsItems = []prometheus.Collector{
 	PieceStoreTime,
 	PieceStoreCounter,
 	PieceStoreUsageAmountGauge,
+	PieceStoreCacheCounter,
+	PieceStoreCacheUsageGauge,
+	PieceCacheCounter,
+	PieceCacheUsageGauge,
 
 	// db metrics category
 	SPDBTime,
@@ -63,6 +67,8 @@ var MetricsItems = []prometheus.Collector{
 	GCBlockNumberGauge,
 	SPHealthCheckerTime,
 	SPHealthCheckerFailureCounter,
+	ScrubPieceCounter,
+	ScrubObjectIDGauge,
 
 	// workflow metrics category
 	PerfApprovalTime,
@@ -82,6 +88,13 @@ var MetricsItems = []prometheus.Collector{
 	ChainLatestHeight,
 	ChainRPCTime,
 	SaveBlockResultErr,
+	BlocksyncerReorgCounter,
+	DataStatisticsErr,
+	BsDBObjectCountGauge,
+	BsDBBucketCountGauge,
+	BsDBHeightLagGauge,
+	BsDBDataDriftGauge,
+	SLOBreachGauge,
 
 	// metadata metrics category
 	MetadataReqTime,
@@ -146,6 +159,22 @@ var (
 		Name: "usage_amount_piece_store",
 		Help: "Track usage amount of piece store.",
 	}, []string{"usage_amount_piece_store"})
+	PieceStoreCacheCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "piece_store_cache_counter",
+		Help: "Track hit, miss and eviction counter of piece store local cache tier.",
+	}, []string{"piece_store_cache_counter"})
+	PieceStoreCacheUsageGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "usage_amount_piece_store_cache",
+		Help: "Track usage amount of piece store local cache tier.",
+	}, []string{"usage_amount_piece_store_cache"})
+	PieceCacheCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "piece_cache_counter",
+		Help: "Track hit, miss, eviction and rejection counter of downloader piece cache.",
+	}, []string{"piece_cache_counter"})
+	PieceCacheUsageGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "usage_amount_piece_cache",
+		Help: "Track usage amount of downloader piece cache in memory and disk spill.",
+	}, []string{"usage_amount_piece_cache"})
 
 	// spdb metrics
 	SPDBTime = prometheus.NewHistogramVec(prometheus.HistogramOpts{
@@ -263,6 +292,14 @@ var (
 		},
 		[]string{"sp_id"},
 	)
+	ScrubPieceCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "scrub_piece_counter",
+		Help: "Track scanned, corrupt and missing pieces counter of integrity scrubber.",
+	}, []string{"scrub_piece_counter"})
+	ScrubObjectIDGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "scrub_object_id",
+		Help: "Track the next object id and finished rounds of integrity scrubber.",
+	}, []string{"scrub_object_id"})
 )
 
 // workflow metrics items
@@ -335,6 +372,30 @@ var (
 		Name: "data_statistics_err",
 		Help: "Track the data statistics err",
 	})
+	BlocksyncerReorgCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "blocksyncer_reorg_total",
+		Help: "Track the number of chain reorgs rolled back by block syncer",
+	})
+	BsDBObjectCountGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "bsdb_object_count",
+		Help: "Track the number of the objects in bsdb by status, the removed objects are not counted",
+	}, []string{"status"})
+	BsDBBucketCountGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "bsdb_bucket_count",
+		Help: "Track the number of the buckets in bsdb by status, the removed buckets are not counted",
+	}, []string{"status"})
+	BsDBHeightLagGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "bsdb_block_height_lag",
+		Help: "Track the number of blocks which bsdb is behind the chain",
+	})
+	BsDBDataDriftGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "bsdb_data_drift_ratio",
+		Help: "Track the ratio of the sampled bsdb rows which mismatch the chain state",
+	}, []string{"resource"})
+	SLOBreachGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "slo_breach",
+		Help: "Track whether the service level objective is breached (1 indicates breached, 0 indicates met)",
+	}, []string{"slo"})
 )
 
 var (
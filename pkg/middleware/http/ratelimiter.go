@@ -2,6 +2,7 @@ package http
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"regexp"
@@ -23,7 +24,8 @@ const (
 )
 
 var (
-	ErrTooManyRequest = gfsperrors.Register(Middleware, http.StatusTooManyRequests, 960001, "too many requests, please try it again later")
+	ErrTooManyRequest         = gfsperrors.Register(Middleware, http.StatusTooManyRequests, 960001, "too many requests, please try it again later")
+	ErrBandwidthQuotaExceeded = gfsperrors.Register(Middleware, http.StatusTooManyRequests, 960002, "the bandwidth quota of the account is exhausted, please try it again later")
 )
 
 type KeyToRateLimiterNameCell struct {
@@ -38,12 +40,32 @@ type IPLimitConfig struct {
 	RatePeriod string `comment:"optional"`
 }
 
+// KeyLimitConfig limits the requests of every account or bucket, each account or bucket has its own counter.
+type KeyLimitConfig struct {
+	On         bool   `comment:"optional"`
+	RateLimit  int    `comment:"optional"`
+	RatePeriod string `comment:"optional"`
+}
+
+// BandwidthLimitConfig limits the bytes which every account uploads and downloads in the period.
+type BandwidthLimitConfig struct {
+	On          bool   `comment:"optional"`
+	Quota       int64  `comment:"optional"`
+	QuotaPeriod string `comment:"optional"`
+}
+
 type RateLimiterConfig struct {
-	IPLimitCfg  IPLimitConfig
-	PathPattern []KeyToRateLimiterNameCell `comment:"optional"`
-	HostPattern []KeyToRateLimiterNameCell `comment:"optional"`
-	APILimits   []KeyToRateLimiterNameCell `comment:"optional"`
-	NameToLimit []MemoryLimiterConfig      `comment:"optional"`
+	// Store is one of memory, sql and redis, the counters are shared among the gateway instances by sql or redis
+	Store             string `comment:"optional"`
+	RedisCfg          RedisConfig
+	IPLimitCfg        IPLimitConfig
+	AccountLimitCfg   KeyLimitConfig
+	BucketLimitCfg    KeyLimitConfig
+	BandwidthLimitCfg BandwidthLimitConfig
+	PathPattern       []KeyToRateLimiterNameCell `comment:"optional"`
+	HostPattern       []KeyToRateLimiterNameCell `comment:"optional"`
+	APILimits         []KeyToRateLimiterNameCell `comment:"optional"`
+	NameToLimit       []MemoryLimiterConfig      `comment:"optional"`
 }
 
 type MemoryLimiterConfig struct {
@@ -53,12 +75,17 @@ type MemoryLimiterConfig struct {
 }
 
 type APILimiterConfig struct {
-	IPLimitCfg   IPLimitConfig
-	PathPattern  map[string][]MemoryLimiterConfig
-	PathSequence []string
-	APILimits    map[string][]MemoryLimiterConfig // routePrefix-apiName  =>  limit config
-	HostPattern  map[string][]MemoryLimiterConfig
-	HostSequence []string
+	Store             string
+	RedisCfg          RedisConfig
+	IPLimitCfg        IPLimitConfig
+	AccountLimitCfg   KeyLimitConfig
+	BucketLimitCfg    KeyLimitConfig
+	BandwidthLimitCfg BandwidthLimitConfig
+	PathPattern       map[string][]MemoryLimiterConfig
+	PathSequence      []string
+	APILimits         map[string][]MemoryLimiterConfig // routePrefix-apiName  =>  limit config
+	HostPattern       map[string][]MemoryLimiterConfig
+	HostSequence      []string
 }
 
 type rateLimiterWithName struct {
@@ -67,32 +94,55 @@ type rateLimiterWithName struct {
 }
 
 type apiLimiter struct {
-	store      slimiter.Store
-	limiterMap sync.Map
-	cfg        APILimiterConfig
+	store         slimiter.Store
+	limiterMap    sync.Map
+	cfg           APILimiterConfig
+	accountRate   slimiter.Rate
+	bucketRate    slimiter.Rate
+	bandwidthRate slimiter.Rate
 }
 
 var limiter *apiLimiter
 
-func NewAPILimiter(cfg *APILimiterConfig) error {
-	localStore := smemory.NewStoreWithOptions(slimiter.StoreOptions{
-		Prefix:          "sp_api_rate_limiter",
-		CleanUpInterval: 5 * time.Second,
-	})
+// NewAPILimiter initializes the api limiter, the db is only used by the sql store and can be nil for the others.
+func NewAPILimiter(cfg *APILimiterConfig, db SQLCounterStore) error {
+	localStore, err := newLimiterStore(cfg, db)
+	if err != nil {
+		return err
+	}
 	limiter = &apiLimiter{
 		store: localStore,
 		cfg: APILimiterConfig{
-			APILimits:    make(map[string][]MemoryLimiterConfig),
-			PathPattern:  make(map[string][]MemoryLimiterConfig),
-			PathSequence: cfg.PathSequence,
-			HostPattern:  make(map[string][]MemoryLimiterConfig),
-			HostSequence: cfg.HostSequence,
-			IPLimitCfg:   cfg.IPLimitCfg,
+			Store:             cfg.Store,
+			RedisCfg:          cfg.RedisCfg,
+			APILimits:         make(map[string][]MemoryLimiterConfig),
+			PathPattern:       make(map[string][]MemoryLimiterConfig),
+			PathSequence:      cfg.PathSequence,
+			HostPattern:       make(map[string][]MemoryLimiterConfig),
+			HostSequence:      cfg.HostSequence,
+			IPLimitCfg:        cfg.IPLimitCfg,
+			AccountLimitCfg:   cfg.AccountLimitCfg,
+			BucketLimitCfg:    cfg.BucketLimitCfg,
+			BandwidthLimitCfg: cfg.BandwidthLimitCfg,
 		},
 	}
 
-	var err error
 	var rate slimiter.Rate
+	if cfg.AccountLimitCfg.On {
+		if limiter.accountRate, err = slimiter.NewRateFromFormatted(fmt.Sprintf("%d-%s", cfg.AccountLimitCfg.RateLimit, cfg.AccountLimitCfg.RatePeriod)); err != nil {
+			return err
+		}
+	}
+	if cfg.BucketLimitCfg.On {
+		if limiter.bucketRate, err = slimiter.NewRateFromFormatted(fmt.Sprintf("%d-%s", cfg.BucketLimitCfg.RateLimit, cfg.BucketLimitCfg.RatePeriod)); err != nil {
+			return err
+		}
+	}
+	if cfg.BandwidthLimitCfg.On {
+		if limiter.bandwidthRate, err = slimiter.NewRateFromFormatted(fmt.Sprintf("%d-%s", cfg.BandwidthLimitCfg.Quota, cfg.BandwidthLimitCfg.QuotaPeriod)); err != nil {
+			return err
+		}
+	}
 
 	for k, v := range cfg.PathPattern {
 		limiter.cfg.PathPattern[strings.ToLower(k)] = v
@@ -116,6 +166,31 @@ func NewAPILimiter(cfg *APILimiterConfig) error {
 	return nil
 }
 
+// newLimiterStore returns the store of the counters by the config, the memory store is used by default.
+func newLimiterStore(cfg *APILimiterConfig, db SQLCounterStore) (slimiter.Store, error) {
+	switch strings.ToLower(cfg.Store) {
+	case "", MemoryLimiterStore:
+		return smemory.NewStoreWithOptions(slimiter.StoreOptions{
+			Prefix:          LimiterStorePrefix,
+			CleanUpInterval: 5 * time.Second,
+		}), nil
+	case SQLLimiterStore:
+		if db == nil {
+			return nil, errors.New("sp db is required by the sql limiter store")
+		}
+		go cleanUpExpiredCounters(db)
+		return NewCounterStore(LimiterStorePrefix, db), nil
+	case RedisLimiterStore:
+		redisStore, err := NewRedisCounterStore(cfg.RedisCfg)
+		if err != nil {
+			return nil, err
+		}
+		return NewCounterStore(LimiterStorePrefix, redisStore), nil
+	default:
+		return nil, fmt.Errorf("unknown limiter store: %s", cfg.Store)
+	}
+}
+
 func (a *apiLimiter) findLimiter(host, path, key string, virtualHost bool, method string) []rateLimiterWithName {
 	var result []rateLimiterWithName
 	newLimiter, ok := a.limiterMap.Load(key)
@@ -208,7 +283,10 @@ func (t *apiLimiter) Allow(ctx context.Context, r *http.Request, domain string)
 	allow := true
 	// iterate through all map component, if any one reached limit, record false and continue, so all counters get increased
 	for _, rateLimiterWName := range rateLimiterWithNames {
-		limiterCtx, _ := t.store.Increment(ctx, rateLimiterWName.name, 1, rateLimiterWName.rateLimiter.Rate)
+		limiterCtx, err := t.store.Increment(ctx, rateLimiterWName.name, 1, rateLimiterWName.rateLimiter.Rate)
+		if err != nil {
+			log.Errorw("failed to increase rate limit counter", "name", rateLimiterWName.name, "error", err)
+		}
 
 		if limiterCtx.Reached {
 			allow = false
@@ -240,6 +318,66 @@ func (t *apiLimiter) HTTPAllow(ctx context.Context, r *http.Request) bool {
 	return true
 }
 
+// BucketAllow checks whether the requests of the bucket reach the limit, it is only charged by the authenticated
+// requests, so that the anonymous clients can not exhaust the limit of the bucket.
+func (t *apiLimiter) BucketAllow(ctx context.Context, bucket string) bool {
+	if !t.cfg.BucketLimitCfg.On || bucket == "" {
+		return true
+	}
+	return t.keyAllow(ctx, "bucket_"+bucket, 1, t.bucketRate)
+}
+
+// AccountAllow checks whether the requests of the account reach the limit.
+func (t *apiLimiter) AccountAllow(ctx context.Context, account string) bool {
+	if !t.cfg.AccountLimitCfg.On || account == "" {
+		return true
+	}
+	return t.keyAllow(ctx, "account_"+strings.ToLower(account), 1, t.accountRate)
+}
+
+// BandwidthAllow consumes the bandwidth quota of the account by the size and checks whether the quota is exceeded
+// in one atomic increment, the rejected transfer is still counted, so the quota stays exhausted in the period.
+func (t *apiLimiter) BandwidthAllow(ctx context.Context, account string, size int64) bool {
+	if !t.cfg.BandwidthLimitCfg.On || account == "" {
+		return true
+	}
+	return t.keyAllow(ctx, "bandwidth_"+strings.ToLower(account), size, t.bandwidthRate)
+}
+
+// keyAllow increases the counter of the key, the request is allowed if the store fails.
+func (t *apiLimiter) keyAllow(ctx context.Context, key string, count int64, rate slimiter.Rate) bool {
+	limiterCtx, err := t.store.Increment(ctx, key, count, rate)
+	if err != nil {
+		log.Errorw("failed to increase rate limit counter", "key", key, "error", err)
+		return true
+	}
+	return !limiterCtx.Reached
+}
+
+// AccountAllow checks whether the requests of the authenticated account reach the limit.
+func AccountAllow(ctx context.Context, account string) bool {
+	if limiter == nil {
+		return true
+	}
+	return limiter.AccountAllow(ctx, account)
+}
+
+// BucketAllow checks whether the requests of the bucket reach the limit, it is called once the request is authenticated.
+func BucketAllow(ctx context.Context, bucket string) bool {
+	if limiter == nil {
+		return true
+	}
+	return limiter.BucketAllow(ctx, bucket)
+}
+
+// BandwidthAllow checks and consumes the bandwidth quota of the account by the size of the transferred payload.
+func BandwidthAllow(ctx context.Context, account string, size int64) bool {
+	if limiter == nil {
+		return true
+	}
+	return limiter.BandwidthAllow(ctx, account, size)
+}
+
 func Limit(domain string) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,121 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	slimiter "github.com/ulule/limiter/v3"
+	"github.com/ulule/limiter/v3/drivers/store/common"
+
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+)
+
+const (
+	// MemoryLimiterStore keeps the counters in the memory of every gateway instance, the limits multiply with
+	// the number of the instances.
+	MemoryLimiterStore = "memory"
+	// SQLLimiterStore shares the counters among the gateway instances by the SPDB.
+	SQLLimiterStore = "sql"
+	// RedisLimiterStore shares the counters among the gateway instances by the redis protocol compatible server.
+	RedisLimiterStore = "redis"
+
+	// LimiterStorePrefix defines the prefix of the keys of the counters.
+	LimiterStorePrefix = "sp_api_rate_limiter"
+	// SQLCounterCleanUpInterval defines the interval of deleting the expired counters in the SPDB.
+	SQLCounterCleanUpInterval = time.Minute
+)
+
+// CounterStore is the storage of the fixed window counters which is shared by all the gateway instances. The
+// window start and the expire time are the unix milliseconds.
+type CounterStore interface {
+	// IncrRateLimitCounter increases the counter of the key in the window and returns the increased counter.
+	IncrRateLimitCounter(key string, windowStart int64, count int64, expireTime int64) (int64, error)
+	// GetRateLimitCounter returns the counter of the key in the window, returns 0 if it is not found.
+	GetRateLimitCounter(key string, windowStart int64) (int64, error)
+	// DeleteRateLimitCounter deletes the counter of the key in the window.
+	DeleteRateLimitCounter(key string, windowStart int64) error
+}
+
+// SQLCounterStore is the CounterStore backed by the SPDB, the expired counters have to be deleted periodically.
+type SQLCounterStore interface {
+	CounterStore
+	// DeleteExpiredRateLimitCounters deletes the counters which are expired before the time.
+	DeleteExpiredRateLimitCounters(expireTimeBefore int64) error
+}
+
+// counterStore implements the store of the limiter by the fixed window counters of the CounterStore, the windows
+// are aligned to the unix epoch, so all the gateway instances count the request in the same window.
+type counterStore struct {
+	prefix  string
+	counter CounterStore
+}
+
+var _ slimiter.Store = &counterStore{}
+
+// NewCounterStore returns the store of the limiter which shares the counters by the CounterStore.
+func NewCounterStore(prefix string, counter CounterStore) slimiter.Store {
+	return &counterStore{prefix: prefix, counter: counter}
+}
+
+// window returns the start and the end of the window which the time belongs to.
+func (s *counterStore) window(now time.Time, rate slimiter.Rate) (int64, int64) {
+	period := rate.Period.Milliseconds()
+	if period <= 0 {
+		period = 1
+	}
+	windowStart := now.UnixMilli() / period * period
+	return windowStart, windowStart + period
+}
+
+func (s *counterStore) key(key string) string {
+	return fmt.Sprintf("%s:%s", s.prefix, key)
+}
+
+// Get increases the counter by one and returns the limit context.
+func (s *counterStore) Get(ctx context.Context, key string, rate slimiter.Rate) (slimiter.Context, error) {
+	return s.Increment(ctx, key, 1, rate)
+}
+
+// Peek returns the limit context without increasing the counter.
+func (s *counterStore) Peek(ctx context.Context, key string, rate slimiter.Rate) (slimiter.Context, error) {
+	now := time.Now()
+	windowStart, windowEnd := s.window(now, rate)
+	count, err := s.counter.GetRateLimitCounter(s.key(key), windowStart)
+	if err != nil {
+		return slimiter.Context{}, err
+	}
+	return common.GetContextFromState(now, rate, time.UnixMilli(windowEnd), count), nil
+}
+
+// Reset deletes the counter of the current window.
+func (s *counterStore) Reset(ctx context.Context, key string, rate slimiter.Rate) (slimiter.Context, error) {
+	now := time.Now()
+	windowStart, windowEnd := s.window(now, rate)
+	if err := s.counter.DeleteRateLimitCounter(s.key(key), windowStart); err != nil {
+		return slimiter.Context{}, err
+	}
+	return common.GetContextFromState(now, rate, time.UnixMilli(windowEnd), 0), nil
+}
+
+// Increment increases the counter by the count and returns the limit context.
+func (s *counterStore) Increment(ctx context.Context, key string, count int64, rate slimiter.Rate) (slimiter.Context, error) {
+	now := time.Now()
+	windowStart, windowEnd := s.window(now, rate)
+	total, err := s.counter.IncrRateLimitCounter(s.key(key), windowStart, count, windowEnd)
+	if err != nil {
+		return slimiter.Context{}, err
+	}
+	return common.GetContextFromState(now, rate, time.UnixMilli(windowEnd), total), nil
+}
+
+// cleanUpExpiredCounters deletes the expired counters of the SQLCounterStore periodically.
+func cleanUpExpiredCounters(db SQLCounterStore) {
+	ticker := time.NewTicker(SQLCounterCleanUpInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if err := db.DeleteExpiredRateLimitCounters(time.Now().UnixMilli()); err != nil {
+			log.Errorw("failed to delete expired rate limit counters", "error", err)
+		}
+	}
+}
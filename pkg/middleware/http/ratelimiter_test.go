@@ -0,0 +1,128 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	slimiter "github.com/ulule/limiter/v3"
+)
+
+// mockCounterStore is the CounterStore which fails every operation.
+type mockCounterStore struct{}
+
+func (mockCounterStore) IncrRateLimitCounter(string, int64, int64, int64) (int64, error) {
+	return 0, errors.New("mock error")
+}
+
+func (mockCounterStore) GetRateLimitCounter(string, int64) (int64, error) {
+	return 0, errors.New("mock error")
+}
+
+func (mockCounterStore) DeleteRateLimitCounter(string, int64) error {
+	return errors.New("mock error")
+}
+
+func (mockCounterStore) DeleteExpiredRateLimitCounters(int64) error {
+	return errors.New("mock error")
+}
+
+func Test_counterStore(t *testing.T) {
+	server := newMockRedisServer(t, "")
+	redisStore, err := NewRedisCounterStore(RedisConfig{Address: server.address()})
+	assert.Nil(t, err)
+	store := NewCounterStore(LimiterStorePrefix, redisStore)
+	rate := slimiter.Rate{Limit: 2, Period: 24 * time.Hour}
+	ctx := context.Background()
+
+	limiterCtx, err := store.Get(ctx, "mockKey", rate)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), limiterCtx.Remaining)
+	assert.False(t, limiterCtx.Reached)
+	assert.Equal(t, 0, int(limiterCtx.Reset%(24*3600)))
+
+	limiterCtx, err = store.Increment(ctx, "mockKey", 2, rate)
+	assert.Nil(t, err)
+	assert.True(t, limiterCtx.Reached)
+
+	limiterCtx, err = store.Peek(ctx, "mockKey", rate)
+	assert.Nil(t, err)
+	assert.True(t, limiterCtx.Reached)
+
+	limiterCtx, err = store.Reset(ctx, "mockKey", rate)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), limiterCtx.Remaining)
+	limiterCtx, err = store.Peek(ctx, "mockKey", rate)
+	assert.Nil(t, err)
+	assert.False(t, limiterCtx.Reached)
+
+	store = NewCounterStore(LimiterStorePrefix, mockCounterStore{})
+	_, err = store.Increment(ctx, "mockKey", 1, rate)
+	assert.NotNil(t, err)
+	_, err = store.Peek(ctx, "mockKey", rate)
+	assert.NotNil(t, err)
+	_, err = store.Reset(ctx, "mockKey", rate)
+	assert.NotNil(t, err)
+}
+
+func TestNewAPILimiterStore(t *testing.T) {
+	server := newMockRedisServer(t, "")
+	cases := []struct {
+		name      string
+		cfg       *APILimiterConfig
+		db        SQLCounterStore
+		wantedErr bool
+	}{
+		{name: "memory store", cfg: &APILimiterConfig{}},
+		{name: "sql store", cfg: &APILimiterConfig{Store: SQLLimiterStore}, db: mockCounterStore{}},
+		{name: "sql store without db", cfg: &APILimiterConfig{Store: SQLLimiterStore}, wantedErr: true},
+		{name: "redis store", cfg: &APILimiterConfig{Store: RedisLimiterStore, RedisCfg: RedisConfig{Address: server.address()}}},
+		{name: "redis store without address", cfg: &APILimiterConfig{Store: RedisLimiterStore}, wantedErr: true},
+		{name: "unknown store", cfg: &APILimiterConfig{Store: "unknown"}, wantedErr: true},
+		{
+			name:      "invalid account rate",
+			cfg:       &APILimiterConfig{AccountLimitCfg: KeyLimitConfig{On: true, RateLimit: 1, RatePeriod: "A"}},
+			wantedErr: true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewAPILimiter(tt.cfg, tt.db)
+			assert.Equal(t, tt.wantedErr, err != nil)
+		})
+	}
+}
+
+func TestAPILimiter_KeyLimits(t *testing.T) {
+	server := newMockRedisServer(t, "")
+	err := NewAPILimiter(&APILimiterConfig{
+		Store:             RedisLimiterStore,
+		RedisCfg:          RedisConfig{Address: server.address()},
+		AccountLimitCfg:   KeyLimitConfig{On: true, RateLimit: 2, RatePeriod: "D"},
+		BucketLimitCfg:    KeyLimitConfig{On: true, RateLimit: 1, RatePeriod: "D"},
+		BandwidthLimitCfg: BandwidthLimitConfig{On: true, Quota: 100, QuotaPeriod: "D"},
+	}, nil)
+	assert.Nil(t, err)
+	ctx := context.Background()
+
+	// the counters are shared with the other gateway instances by the redis server
+	assert.True(t, AccountAllow(ctx, "0xA"))
+	assert.True(t, AccountAllow(ctx, "0xa"))
+	assert.False(t, AccountAllow(ctx, "0xA"))
+	assert.True(t, AccountAllow(ctx, "0xB"))
+	assert.True(t, AccountAllow(ctx, ""))
+
+	assert.True(t, limiter.BucketAllow(ctx, "mock-bucket"))
+	assert.False(t, limiter.BucketAllow(ctx, "mock-bucket"))
+	assert.True(t, limiter.BucketAllow(ctx, "other-bucket"))
+
+	// the transfer is allowed until the quota is exhausted
+	assert.True(t, BandwidthAllow(ctx, "0xA", 60))
+	assert.True(t, BandwidthAllow(ctx, "0xA", 40))
+	assert.False(t, BandwidthAllow(ctx, "0xA", 1))
+	assert.True(t, BandwidthAllow(ctx, "0xB", 1))
+	assert.False(t, BandwidthAllow(ctx, "0xB", 100))
+	assert.False(t, BandwidthAllow(ctx, "0xB", 1))
+}
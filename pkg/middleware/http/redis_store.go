@@ -0,0 +1,253 @@
+package http
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultRedisPoolSize defines the default max idle connections to the redis server.
+	DefaultRedisPoolSize = 16
+	// DefaultRedisTimeout defines the default timeout of dialing and every command.
+	DefaultRedisTimeout = time.Second
+)
+
+// RedisConfig defines the redis protocol compatible server which stores the rate limit counters.
+type RedisConfig struct {
+	Address  string `comment:"optional"`
+	Password string `comment:"optional"`
+	DB       int    `comment:"optional"`
+	PoolSize int    `comment:"optional"`
+}
+
+// redisConn is the connection to the redis server which speaks the RESP protocol.
+type redisConn struct {
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+// RedisCounterStore implements the CounterStore by the redis protocol compatible server, the counter of every
+// window is a standalone key which expires at the end of the window.
+type RedisCounterStore struct {
+	cfg  RedisConfig
+	pool chan *redisConn
+}
+
+var _ CounterStore = &RedisCounterStore{}
+
+// NewRedisCounterStore returns an instance of RedisCounterStore, and checks the connection to the server.
+func NewRedisCounterStore(cfg RedisConfig) (*RedisCounterStore, error) {
+	if cfg.Address == "" {
+		return nil, errors.New("redis address is empty")
+	}
+	if cfg.PoolSize <= 0 {
+		cfg.PoolSize = DefaultRedisPoolSize
+	}
+	s := &RedisCounterStore{cfg: cfg, pool: make(chan *redisConn, cfg.PoolSize)}
+	if _, err := s.exec([][]string{{"PING"}}); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *RedisCounterStore) counterKey(key string, windowStart int64) string {
+	return key + ":" + strconv.FormatInt(windowStart, 10)
+}
+
+// IncrRateLimitCounter increases the counter and sets its expire time in a transaction.
+func (s *RedisCounterStore) IncrRateLimitCounter(key string, windowStart int64, count int64, expireTime int64) (int64, error) {
+	counterKey := s.counterKey(key, windowStart)
+	replies, err := s.exec([][]string{
+		{"MULTI"},
+		{"INCRBY", counterKey, strconv.FormatInt(count, 10)},
+		{"PEXPIREAT", counterKey, strconv.FormatInt(expireTime, 10)},
+		{"EXEC"},
+	})
+	if err != nil {
+		return 0, err
+	}
+	results, ok := replies[3].([]interface{})
+	if !ok || len(results) != 2 {
+		return 0, fmt.Errorf("unexpected redis reply: %v", replies[3])
+	}
+	counter, ok := results[0].(int64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected redis reply: %v", results[0])
+	}
+	return counter, nil
+}
+
+// GetRateLimitCounter returns the counter, returns 0 if the key is not found or expired.
+func (s *RedisCounterStore) GetRateLimitCounter(key string, windowStart int64) (int64, error) {
+	replies, err := s.exec([][]string{{"GET", s.counterKey(key, windowStart)}})
+	if err != nil {
+		return 0, err
+	}
+	value, ok := replies[0].([]byte)
+	if !ok {
+		return 0, nil
+	}
+	return strconv.ParseInt(string(value), 10, 64)
+}
+
+// DeleteRateLimitCounter deletes the counter.
+func (s *RedisCounterStore) DeleteRateLimitCounter(key string, windowStart int64) error {
+	_, err := s.exec([][]string{{"DEL", s.counterKey(key, windowStart)}})
+	return err
+}
+
+// exec pipelines the commands on one connection and returns the replies, returns the first error reply as error.
+func (s *RedisCounterStore) exec(commands [][]string) ([]interface{}, error) {
+	conn, err := s.get()
+	if err != nil {
+		return nil, err
+	}
+	replies, err := s.send(conn, commands)
+	if err != nil {
+		conn.conn.Close()
+		return nil, err
+	}
+	s.put(conn)
+	for _, reply := range replies {
+		if err, ok := reply.(redisError); ok {
+			return nil, err
+		}
+	}
+	return replies, nil
+}
+
+// send writes the commands and reads their replies on the connection.
+func (s *RedisCounterStore) send(conn *redisConn, commands [][]string) ([]interface{}, error) {
+	if err := conn.conn.SetDeadline(time.Now().Add(DefaultRedisTimeout)); err != nil {
+		return nil, err
+	}
+	var buf []byte
+	for _, args := range commands {
+		buf = append(buf, '*')
+		buf = strconv.AppendInt(buf, int64(len(args)), 10)
+		buf = append(buf, '\r', '\n')
+		for _, arg := range args {
+			buf = append(buf, '$')
+			buf = strconv.AppendInt(buf, int64(len(arg)), 10)
+			buf = append(buf, '\r', '\n')
+			buf = append(buf, arg...)
+			buf = append(buf, '\r', '\n')
+		}
+	}
+	if _, err := conn.conn.Write(buf); err != nil {
+		return nil, err
+	}
+	replies := make([]interface{}, len(commands))
+	for i := range commands {
+		reply, err := readRedisReply(conn.reader)
+		if err != nil {
+			return nil, err
+		}
+		replies[i] = reply
+	}
+	return replies, nil
+}
+
+// get returns an idle connection or dials a new one.
+func (s *RedisCounterStore) get() (*redisConn, error) {
+	select {
+	case conn := <-s.pool:
+		return conn, nil
+	default:
+	}
+	netConn, err := net.DialTimeout("tcp", s.cfg.Address, DefaultRedisTimeout)
+	if err != nil {
+		return nil, err
+	}
+	conn := &redisConn{conn: netConn, reader: bufio.NewReader(netConn)}
+	var commands [][]string
+	if s.cfg.Password != "" {
+		commands = append(commands, []string{"AUTH", s.cfg.Password})
+	}
+	if s.cfg.DB != 0 {
+		commands = append(commands, []string{"SELECT", strconv.Itoa(s.cfg.DB)})
+	}
+	if len(commands) == 0 {
+		return conn, nil
+	}
+	replies, err := s.send(conn, commands)
+	if err == nil {
+		for _, reply := range replies {
+			if replyErr, ok := reply.(redisError); ok {
+				err = replyErr
+				break
+			}
+		}
+	}
+	if err != nil {
+		netConn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// put returns the connection to the pool, the connection is closed if the pool is full.
+func (s *RedisCounterStore) put(conn *redisConn) {
+	select {
+	case s.pool <- conn:
+	default:
+		conn.conn.Close()
+	}
+}
+
+// redisError is the error reply of the redis server.
+type redisError string
+
+func (e redisError) Error() string {
+	return string(e)
+}
+
+// readRedisReply reads a reply of the RESP protocol, the simple string is returned as string, the bulk string as
+// []byte, the integer as int64, the array as []interface{}, the error as redisError and the null as nil.
+func readRedisReply(reader *bufio.Reader) (interface{}, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 3 || line[len(line)-2] != '\r' {
+		return nil, fmt.Errorf("invalid redis reply: %q", line)
+	}
+	payload := line[1 : len(line)-2]
+	switch line[0] {
+	case '+':
+		return payload, nil
+	case '-':
+		return redisError(payload), nil
+	case ':':
+		return strconv.ParseInt(payload, 10, 64)
+	case '$':
+		size, err := strconv.Atoi(payload)
+		if err != nil || size < 0 {
+			return nil, err
+		}
+		buf := make([]byte, size+2)
+		if _, err = io.ReadFull(reader, buf); err != nil {
+			return nil, err
+		}
+		return buf[:size], nil
+	case '*':
+		size, err := strconv.Atoi(payload)
+		if err != nil || size < 0 {
+			return nil, err
+		}
+		replies := make([]interface{}, size)
+		for i := range replies {
+			if replies[i], err = readRedisReply(reader); err != nil {
+				return nil, err
+			}
+		}
+		return replies, nil
+	default:
+		return nil, fmt.Errorf("invalid redis reply: %q", line)
+	}
+}
@@ -0,0 +1,181 @@
+package http
+
+import (
+	"bufio"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// mockRedisServer is the local stand-in of the redis server, it supports the commands used by RedisCounterStore.
+type mockRedisServer struct {
+	listener net.Listener
+	password string
+	mu       sync.Mutex
+	values   map[string]int64
+	expires  map[string]int64
+}
+
+func newMockRedisServer(t *testing.T, password string) *mockRedisServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	m := &mockRedisServer{listener: listener, password: password, values: make(map[string]int64), expires: make(map[string]int64)}
+	t.Cleanup(func() { listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go m.serve(conn)
+		}
+	}()
+	return m
+}
+
+func (m *mockRedisServer) address() string {
+	return m.listener.Addr().String()
+}
+
+func (m *mockRedisServer) serve(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	authenticated := m.password == ""
+	var queued [][]string
+	inMulti := false
+	for {
+		request, err := readRedisReply(reader)
+		if err != nil {
+			return
+		}
+		var args []string
+		for _, arg := range request.([]interface{}) {
+			args = append(args, string(arg.([]byte)))
+		}
+		command := strings.ToUpper(args[0])
+		switch {
+		case command == "AUTH":
+			if args[1] != m.password {
+				conn.Write([]byte("-WRONGPASS invalid password\r\n"))
+				continue
+			}
+			authenticated = true
+			conn.Write([]byte("+OK\r\n"))
+		case !authenticated:
+			conn.Write([]byte("-NOAUTH Authentication required.\r\n"))
+		case command == "MULTI":
+			inMulti = true
+			conn.Write([]byte("+OK\r\n"))
+		case command == "EXEC":
+			reply := "*" + strconv.Itoa(len(queued)) + "\r\n"
+			for _, queuedArgs := range queued {
+				reply += m.execute(queuedArgs)
+			}
+			inMulti, queued = false, nil
+			conn.Write([]byte(reply))
+		case inMulti:
+			queued = append(queued, args)
+			conn.Write([]byte("+QUEUED\r\n"))
+		default:
+			conn.Write([]byte(m.execute(args)))
+		}
+	}
+}
+
+func (m *mockRedisServer) execute(args []string) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := ""
+	if len(args) > 1 {
+		key = args[1]
+		if expire, ok := m.expires[key]; ok && expire <= time.Now().UnixMilli() {
+			delete(m.values, key)
+			delete(m.expires, key)
+		}
+	}
+	switch strings.ToUpper(args[0]) {
+	case "PING":
+		return "+PONG\r\n"
+	case "SELECT":
+		return "+OK\r\n"
+	case "INCRBY":
+		count, _ := strconv.ParseInt(args[2], 10, 64)
+		m.values[key] += count
+		return ":" + strconv.FormatInt(m.values[key], 10) + "\r\n"
+	case "PEXPIREAT":
+		m.expires[key], _ = strconv.ParseInt(args[2], 10, 64)
+		return ":1\r\n"
+	case "GET":
+		value, ok := m.values[key]
+		if !ok {
+			return "$-1\r\n"
+		}
+		s := strconv.FormatInt(value, 10)
+		return "$" + strconv.Itoa(len(s)) + "\r\n" + s + "\r\n"
+	case "DEL":
+		_, ok := m.values[key]
+		delete(m.values, key)
+		delete(m.expires, key)
+		if ok {
+			return ":1\r\n"
+		}
+		return ":0\r\n"
+	default:
+		return "-ERR unknown command\r\n"
+	}
+}
+
+func TestNewRedisCounterStore(t *testing.T) {
+	server := newMockRedisServer(t, "mockPassword")
+
+	_, err := NewRedisCounterStore(RedisConfig{})
+	assert.NotNil(t, err)
+
+	_, err = NewRedisCounterStore(RedisConfig{Address: server.address(), Password: "wrongPassword"})
+	assert.Equal(t, "WRONGPASS invalid password", err.Error())
+
+	_, err = NewRedisCounterStore(RedisConfig{Address: server.address()})
+	assert.Equal(t, "NOAUTH Authentication required.", err.Error())
+
+	_, err = NewRedisCounterStore(RedisConfig{Address: server.address(), Password: "mockPassword", DB: 1})
+	assert.Nil(t, err)
+}
+
+func TestRedisCounterStore(t *testing.T) {
+	server := newMockRedisServer(t, "")
+	store, err := NewRedisCounterStore(RedisConfig{Address: server.address(), PoolSize: 1})
+	assert.Nil(t, err)
+
+	now := time.Now().UnixMilli()
+	counter, err := store.IncrRateLimitCounter("mockKey", 1000, 2, now+time.Hour.Milliseconds())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), counter)
+	counter, err = store.IncrRateLimitCounter("mockKey", 1000, 3, now+time.Hour.Milliseconds())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), counter)
+
+	// the counters of the different windows are different keys
+	counter, err = store.GetRateLimitCounter("mockKey", 2000)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), counter)
+	counter, err = store.GetRateLimitCounter("mockKey", 1000)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), counter)
+
+	assert.Nil(t, store.DeleteRateLimitCounter("mockKey", 1000))
+	counter, err = store.GetRateLimitCounter("mockKey", 1000)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), counter)
+
+	// the counter expires at the end of the window
+	_, err = store.IncrRateLimitCounter("mockKey", 3000, 1, now-1)
+	assert.Nil(t, err)
+	counter, err = store.GetRateLimitCounter("mockKey", 3000)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), counter)
+}
@@ -8,19 +8,32 @@ import (
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
 )
 
+// BandwidthLimiterConfig is the config of the process local token bucket.
+//
+// Deprecated: use BandwidthLimitConfig of RateLimiterConfig instead, which limits the bandwidth of every account and
+// shares the counters among the gateway instances. It will be removed in the next major version.
 type BandwidthLimiterConfig struct {
 	Enable bool       //Enable Whether to enable bandwidth limiting
 	R      rate.Limit //R The speed at which tokens are generated R per second
 	B      int        //B The size of the token bucket
 }
 
+// BandwidthLimiter is the process local token bucket.
+//
+// Deprecated: use BandwidthAllow instead. It will be removed in the next major version.
 type BandwidthLimiter struct {
 	Limiter *rate.Limiter
 }
 
+// Deprecated: use BandwidthAllow instead. It will be removed in the next major version.
 var LimiterOnce sync.Once
+
+// Deprecated: use BandwidthAllow instead. It will be removed in the next major version.
 var BandwidthLimit *BandwidthLimiter
 
+// NewBandwidthLimiter initializes BandwidthLimit once.
+//
+// Deprecated: use NewAPILimiter with BandwidthLimitCfg instead. It will be removed in the next major version.
 func NewBandwidthLimiter(r rate.Limit, b int) {
 	log.Infof("config r: %v, b:%d", r, b)
 
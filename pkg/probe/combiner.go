@@ -16,6 +16,18 @@ func Combine(probes ...coreprober.Prober) coreprober.Prober {
 	return &combined{probes: probes}
 }
 
+// AddReadinessCondition adds the condition to the probes which support readiness conditions.
+func (p *combined) AddReadinessCondition(condition func() error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, probe := range p.probes {
+		if conditioner, ok := probe.(Conditioner); ok {
+			conditioner.AddReadinessCondition(condition)
+		}
+	}
+}
+
 // Ready sets components status to ready.
 func (p *combined) Ready() {
 	p.mu.Lock()
@@ -3,6 +3,7 @@ package probe
 import (
 	"io"
 	"net/http"
+	"sync"
 	"sync/atomic"
 
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
@@ -10,10 +11,19 @@ import (
 
 type check func() bool
 
+// Conditioner is implemented by the probes whose readiness also depends on the added conditions.
+type Conditioner interface {
+	// AddReadinessCondition adds a condition which keeps the component unready while it returns an error.
+	AddReadinessCondition(condition func() error)
+}
+
 // HTTPProbe represents health and readiness status of given component, and provides HTTP integration.
 type HTTPProbe struct {
 	ready   atomic.Uint32
 	healthy atomic.Uint32
+
+	mu         sync.RWMutex
+	conditions []func() error
 }
 
 // NewHTTPProbe returns HTTPProbe representing readiness and liveness of given component.
@@ -43,10 +53,27 @@ func (p *HTTPProbe) handler(c check) http.HandlerFunc {
 	}
 }
 
-// IsReady returns true if component is ready.
+// IsReady returns true if component is ready and all the readiness conditions are met.
 func (p *HTTPProbe) IsReady() bool {
-	value := p.ready.Load()
-	return value > 0
+	if p.ready.Load() == 0 {
+		return false
+	}
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	for _, condition := range p.conditions {
+		if condition() != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// AddReadinessCondition adds a condition which keeps the component unready while it returns an error, it doesn't
+// change the status set by Ready and Unready.
+func (p *HTTPProbe) AddReadinessCondition(condition func() error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.conditions = append(p.conditions, condition)
 }
 
 // IsHealthy returns true if component is healthy.
@@ -0,0 +1,97 @@
+package probe
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	coreprober "github.com/bnb-chain/greenfield-storage-provider/core/prober"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
+)
+
+// SLOProbe tracks the service level objectives of a component. It's added to the given probe as a readiness
+// condition, so the probe is unready while any of the objectives is breached. The status set by the others on the
+// probe is kept, since the objectives never set the probe ready or unready directly.
+type SLOProbe struct {
+	mu       sync.Mutex
+	breaches map[string]error
+}
+
+// NewSLOProbe returns SLOProbe which is a readiness condition of the given probe, the objectives are only exported
+// as metrics if the probe is nil or doesn't support the readiness conditions.
+func NewSLOProbe(prober coreprober.Prober) *SLOProbe {
+	p := &SLOProbe{breaches: make(map[string]error)}
+	if conditioner, ok := prober.(Conditioner); ok {
+		conditioner.AddReadinessCondition(p.Err)
+	}
+	return p
+}
+
+// Check compares the observed value of the objective with its threshold, the objective is breached if the value
+// exceeds the threshold. It returns the cause if the objective is breached.
+func (p *SLOProbe) Check(slo string, value, threshold float64) error {
+	if value > threshold {
+		err := fmt.Errorf("slo %s is breached, value: %v, threshold: %v", slo, value, threshold)
+		p.Breach(slo, err)
+		return err
+	}
+	p.Meet(slo)
+	return nil
+}
+
+// Breach records the objective is breached with the given error as a cause.
+func (p *SLOProbe) Breach(slo string, err error) {
+	metrics.SLOBreachGauge.WithLabelValues(slo).Set(1)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.breaches[slo]; !ok {
+		log.Warnw("slo is breached", "slo", slo, "error", err)
+	}
+	p.breaches[slo] = err
+}
+
+// Meet records the objective is met.
+func (p *SLOProbe) Meet(slo string) {
+	metrics.SLOBreachGauge.WithLabelValues(slo).Set(0)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.breaches[slo]; !ok {
+		return
+	}
+	log.Infow("slo is met again", "slo", slo)
+	delete(p.breaches, slo)
+}
+
+// Err returns the causes of the breached objectives, or nil if all of them are met.
+func (p *SLOProbe) Err() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.breaches) == 0 {
+		return nil
+	}
+	return p.cause()
+}
+
+// Breached returns the names of the breached objectives in order.
+func (p *SLOProbe) Breached() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	slos := make([]string, 0, len(p.breaches))
+	for slo := range p.breaches {
+		slos = append(slos, slo)
+	}
+	sort.Strings(slos)
+	return slos
+}
+
+func (p *SLOProbe) cause() error {
+	causes := make([]string, 0, len(p.breaches))
+	for _, err := range p.breaches {
+		causes = append(causes, err.Error())
+	}
+	sort.Strings(causes)
+	return errors.New(strings.Join(causes, "; "))
+}
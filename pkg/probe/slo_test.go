@@ -0,0 +1,36 @@
+package probe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSLOProbe_ReadinessCondition(t *testing.T) {
+	httpProbe := NewHTTPProbe()
+	slo := NewSLOProbe(Combine(httpProbe, NewInstrumentation()))
+	httpProbe.Ready()
+	assert.True(t, httpProbe.IsReady())
+
+	assert.Error(t, slo.Check("lag", 2, 1))
+	assert.False(t, httpProbe.IsReady())
+	assert.Equal(t, []string{"lag"}, slo.Breached())
+
+	// the objective met again doesn't override the unready status set by the others
+	httpProbe.Unready(errors.New("mock error"))
+	assert.NoError(t, slo.Check("lag", 1, 1))
+	assert.NoError(t, slo.Err())
+	assert.False(t, httpProbe.IsReady())
+
+	httpProbe.Ready()
+	assert.True(t, httpProbe.IsReady())
+}
+
+func TestSLOProbe_WithoutProbe(t *testing.T) {
+	slo := NewSLOProbe(nil)
+	slo.Breach("drift", errors.New("mock error"))
+	assert.EqualError(t, slo.Err(), "mock error")
+	slo.Meet("drift")
+	assert.Empty(t, slo.Breached())
+}
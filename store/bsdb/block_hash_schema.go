@@ -0,0 +1,20 @@
+package bsdb
+
+import (
+	"github.com/forbole/juno/v4/common"
+)
+
+// BlockHash records the hash of every indexed block, it is used to detect the chain reorg
+type BlockHash struct {
+	// Height defines the block number
+	Height int64 `gorm:"column:height;type:bigint(64);primaryKey;autoIncrement:false"`
+	// BlockHash defines the hash of the indexed block
+	BlockHash common.Hash `gorm:"column:block_hash;type:BINARY(32)"`
+	// ParentHash defines the hash of the previous block
+	ParentHash common.Hash `gorm:"column:parent_hash;type:BINARY(32)"`
+}
+
+// TableName is used to set BlockHash table name in database
+func (*BlockHash) TableName() string {
+	return BlockHashTableName
+}
@@ -0,0 +1,13 @@
+package bsdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockHash_TableName(t *testing.T) {
+	blockHash := BlockHash{}
+	name := blockHash.TableName()
+	assert.Equal(t, BlockHashTableName, name)
+}
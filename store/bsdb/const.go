@@ -74,6 +74,28 @@ const (
 	StreamRecordTableName = "stream_records"
 	// PaymentAccountTableName defines payment account info
 	PaymentAccountTableName = "payment_accounts"
+	// BlockHashTableName defines the name of block hash table
+	BlockHashTableName = "block_hashes"
+	// RollbackJournalTableName defines the name of rollback journal table
+	RollbackJournalTableName = "rollback_journal"
+	// NotificationRuleTableName defines the name of bucket notification rule table
+	NotificationRuleTableName = "notification_rules"
+	// NotificationTableName defines the name of the table which queues the notifications to deliver
+	NotificationTableName = "notifications"
+	// NotificationDeadLetterTableName defines the name of the table which keeps the undeliverable notifications
+	NotificationDeadLetterTableName = "notification_dead_letters"
+	// HistoryEpochTableName defines the name of the table which records the block range of the history tables
+	HistoryEpochTableName = "history_epoch"
+	// BucketHistoryTableName defines the name of bucket history table
+	BucketHistoryTableName = "bucket_histories"
+	// ObjectHistoryTableName defines the name of object history table
+	ObjectHistoryTableName = "object_histories"
+	// GroupHistoryTableName defines the name of group history table
+	GroupHistoryTableName = "group_histories"
+	// PermissionHistoryTableName defines the name of permission history table
+	PermissionHistoryTableName = "permission_histories"
+	// StatementHistoryTableName defines the name of statement history table
+	StatementHistoryTableName = "statement_histories"
 )
 
 // define the list objects const
@@ -37,6 +37,8 @@ type Metadata interface {
 	GetGroupsByGroupIDAndAccount(groupIDList []common.Hash, account common.Address, includeRemoved bool) ([]*Group, error)
 	// ListObjectsByBucketName list objects info by a bucket name
 	ListObjectsByBucketName(bucketName, continuationToken, prefix, delimiter string, maxKeys int, includeRemoved bool) ([]*ListObjectsResult, error)
+	// SearchObjects search objects of a bucket by filters, the objects are ordered by object name
+	SearchObjects(bucketName, startAfter string, limit int, filters ...func(*gorm.DB) *gorm.DB) ([]*Object, error)
 	// ListDeletedObjectsByBlockNumberRange list deleted objects info by a block number range
 	ListDeletedObjectsByBlockNumberRange(startBlockNumber uint64, endBlockNumber uint64, includePrivate bool) ([]*Object, error)
 	// ListExpiredBucketsBySp list expired buckets by sp
@@ -147,6 +149,22 @@ type Metadata interface {
 	GetBsDBDataStatistics(blockHeight uint64) (*DataStat, error)
 	// GetObjectCount get object count by update_at
 	GetObjectCount(blockHeight int64, objectStatus string) ([]int64, error)
+	// ListNotificationRules list the notification rules of a bucket
+	ListNotificationRules(bucketName string) ([]*NotificationRule, error)
+	// PutNotificationRules replace the notification rules of a bucket
+	PutNotificationRules(bucketName string, rules []*NotificationRule) error
+	// GetHistoryEpoch get the block range of the history tables, returns nil if the history is not maintained
+	GetHistoryEpoch() (*HistoryEpoch, error)
+	// GetBucketByNameAtHeight get bucket info by a bucket name at the block height
+	GetBucketByNameAtHeight(bucketName string, includePrivate bool, height int64) (*Bucket, error)
+	// ListObjectsByBucketNameAtHeight list objects info by a bucket name at the block height
+	ListObjectsByBucketNameAtHeight(bucketName, continuationToken, prefix string, maxKeys int, height int64) ([]*ListObjectsResult, error)
+	// GetObjectByNameAtHeight get object info by an object name at the block height
+	GetObjectByNameAtHeight(objectName, bucketName string, includePrivate bool, height int64) (*Object, error)
+	// GetGroupMembersAtHeight get group members by group id at the block height
+	GetGroupMembersAtHeight(groupID common.Hash, startAfter common.Address, limit int, height int64) ([]*GroupMemberMeta, error)
+	// ListObjectPoliciesAtHeight list policies by object info at the block height
+	ListObjectPoliciesAtHeight(objectID common.Hash, actionType types.ActionType, startAfter common.Hash, limit int, height int64) ([]*PermissionWithStatement, error)
 }
 
 // BSDB contains all the methods required by block syncer database
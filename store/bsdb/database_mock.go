@@ -87,6 +87,21 @@ func (mr *MockMetadataMockRecorder) GetBucketByName(bucketName, includePrivate a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketByName", reflect.TypeOf((*MockMetadata)(nil).GetBucketByName), bucketName, includePrivate)
 }
 
+// GetBucketByNameAtHeight mocks base method.
+func (m *MockMetadata) GetBucketByNameAtHeight(bucketName string, includePrivate bool, height int64) (*Bucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBucketByNameAtHeight", bucketName, includePrivate, height)
+	ret0, _ := ret[0].(*Bucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBucketByNameAtHeight indicates an expected call of GetBucketByNameAtHeight.
+func (mr *MockMetadataMockRecorder) GetBucketByNameAtHeight(bucketName, includePrivate, height any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketByNameAtHeight", reflect.TypeOf((*MockMetadata)(nil).GetBucketByNameAtHeight), bucketName, includePrivate, height)
+}
+
 // GetBucketInfoByBucketName mocks base method.
 func (m *MockMetadata) GetBucketInfoByBucketName(bucketName string) (*Bucket, error) {
 	m.ctrl.T.Helper()
@@ -267,6 +282,21 @@ func (mr *MockMetadataMockRecorder) GetGroupMembers(groupID, startAfter, limit a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembers", reflect.TypeOf((*MockMetadata)(nil).GetGroupMembers), groupID, startAfter, limit)
 }
 
+// GetGroupMembersAtHeight mocks base method.
+func (m *MockMetadata) GetGroupMembersAtHeight(groupID common.Hash, startAfter common.Address, limit int, height int64) ([]*GroupMemberMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupMembersAtHeight", groupID, startAfter, limit, height)
+	ret0, _ := ret[0].([]*GroupMemberMeta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupMembersAtHeight indicates an expected call of GetGroupMembersAtHeight.
+func (mr *MockMetadataMockRecorder) GetGroupMembersAtHeight(groupID, startAfter, limit, height any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembersAtHeight", reflect.TypeOf((*MockMetadata)(nil).GetGroupMembersAtHeight), groupID, startAfter, limit, height)
+}
+
 // GetGroupMembersCount mocks base method.
 func (m *MockMetadata) GetGroupMembersCount(groupIDs []common.Hash) ([]*GroupCount, error) {
 	m.ctrl.T.Helper()
@@ -312,6 +342,21 @@ func (mr *MockMetadataMockRecorder) GetGvgByBucketAndLvgID(bucketID, lvgID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGvgByBucketAndLvgID", reflect.TypeOf((*MockMetadata)(nil).GetGvgByBucketAndLvgID), bucketID, lvgID)
 }
 
+// GetHistoryEpoch mocks base method.
+func (m *MockMetadata) GetHistoryEpoch() (*HistoryEpoch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistoryEpoch")
+	ret0, _ := ret[0].(*HistoryEpoch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistoryEpoch indicates an expected call of GetHistoryEpoch.
+func (mr *MockMetadataMockRecorder) GetHistoryEpoch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoryEpoch", reflect.TypeOf((*MockMetadata)(nil).GetHistoryEpoch))
+}
+
 // GetLatestBlockNumber mocks base method.
 func (m *MockMetadata) GetLatestBlockNumber() (uint64, error) {
 	m.ctrl.T.Helper()
@@ -417,6 +462,21 @@ func (mr *MockMetadataMockRecorder) GetObjectByName(objectName, bucketName, incl
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectByName", reflect.TypeOf((*MockMetadata)(nil).GetObjectByName), objectName, bucketName, includePrivate)
 }
 
+// GetObjectByNameAtHeight mocks base method.
+func (m *MockMetadata) GetObjectByNameAtHeight(objectName, bucketName string, includePrivate bool, height int64) (*Object, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetObjectByNameAtHeight", objectName, bucketName, includePrivate, height)
+	ret0, _ := ret[0].(*Object)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetObjectByNameAtHeight indicates an expected call of GetObjectByNameAtHeight.
+func (mr *MockMetadataMockRecorder) GetObjectByNameAtHeight(objectName, bucketName, includePrivate, height any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectByNameAtHeight", reflect.TypeOf((*MockMetadata)(nil).GetObjectByNameAtHeight), objectName, bucketName, includePrivate, height)
+}
+
 // GetObjectCount mocks base method.
 func (m *MockMetadata) GetObjectCount(blockHeight int64, objectStatus string) ([]int64, error) {
 	m.ctrl.T.Helper()
@@ -880,6 +940,21 @@ func (mr *MockMetadataMockRecorder) ListMigrateBucketEvents(spID any, filters ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMigrateBucketEvents", reflect.TypeOf((*MockMetadata)(nil).ListMigrateBucketEvents), varargs...)
 }
 
+// ListNotificationRules mocks base method.
+func (m *MockMetadata) ListNotificationRules(bucketName string) ([]*NotificationRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNotificationRules", bucketName)
+	ret0, _ := ret[0].([]*NotificationRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNotificationRules indicates an expected call of ListNotificationRules.
+func (mr *MockMetadataMockRecorder) ListNotificationRules(bucketName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotificationRules", reflect.TypeOf((*MockMetadata)(nil).ListNotificationRules), bucketName)
+}
+
 // ListObjectPolicies mocks base method.
 func (m *MockMetadata) ListObjectPolicies(objectID common.Hash, actionType types.ActionType, startAfter common.Hash, limit int) ([]*PermissionWithStatement, error) {
 	m.ctrl.T.Helper()
@@ -895,6 +970,21 @@ func (mr *MockMetadataMockRecorder) ListObjectPolicies(objectID, actionType, sta
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectPolicies", reflect.TypeOf((*MockMetadata)(nil).ListObjectPolicies), objectID, actionType, startAfter, limit)
 }
 
+// ListObjectPoliciesAtHeight mocks base method.
+func (m *MockMetadata) ListObjectPoliciesAtHeight(objectID common.Hash, actionType types.ActionType, startAfter common.Hash, limit int, height int64) ([]*PermissionWithStatement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObjectPoliciesAtHeight", objectID, actionType, startAfter, limit, height)
+	ret0, _ := ret[0].([]*PermissionWithStatement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjectPoliciesAtHeight indicates an expected call of ListObjectPoliciesAtHeight.
+func (mr *MockMetadataMockRecorder) ListObjectPoliciesAtHeight(objectID, actionType, startAfter, limit, height any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectPoliciesAtHeight", reflect.TypeOf((*MockMetadata)(nil).ListObjectPoliciesAtHeight), objectID, actionType, startAfter, limit, height)
+}
+
 // ListObjectsByBucketName mocks base method.
 func (m *MockMetadata) ListObjectsByBucketName(bucketName, continuationToken, prefix, delimiter string, maxKeys int, includeRemoved bool) ([]*ListObjectsResult, error) {
 	m.ctrl.T.Helper()
@@ -910,6 +1000,21 @@ func (mr *MockMetadataMockRecorder) ListObjectsByBucketName(bucketName, continua
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectsByBucketName", reflect.TypeOf((*MockMetadata)(nil).ListObjectsByBucketName), bucketName, continuationToken, prefix, delimiter, maxKeys, includeRemoved)
 }
 
+// ListObjectsByBucketNameAtHeight mocks base method.
+func (m *MockMetadata) ListObjectsByBucketNameAtHeight(bucketName, continuationToken, prefix string, maxKeys int, height int64) ([]*ListObjectsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObjectsByBucketNameAtHeight", bucketName, continuationToken, prefix, maxKeys, height)
+	ret0, _ := ret[0].([]*ListObjectsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjectsByBucketNameAtHeight indicates an expected call of ListObjectsByBucketNameAtHeight.
+func (mr *MockMetadataMockRecorder) ListObjectsByBucketNameAtHeight(bucketName, continuationToken, prefix, maxKeys, height any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectsByBucketNameAtHeight", reflect.TypeOf((*MockMetadata)(nil).ListObjectsByBucketNameAtHeight), bucketName, continuationToken, prefix, maxKeys, height)
+}
+
 // ListObjectsByGVGAndBucketForGC mocks base method.
 func (m *MockMetadata) ListObjectsByGVGAndBucketForGC(bucketID common.Hash, gvgID uint32, startAfter common.Hash, limit int) ([]*Object, *Bucket, error) {
 	m.ctrl.T.Helper()
@@ -1102,6 +1207,40 @@ func (mr *MockMetadataMockRecorder) ListVirtualGroupFamiliesByVgfIDs(vgfIDs any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualGroupFamiliesByVgfIDs", reflect.TypeOf((*MockMetadata)(nil).ListVirtualGroupFamiliesByVgfIDs), vgfIDs)
 }
 
+// PutNotificationRules mocks base method.
+func (m *MockMetadata) PutNotificationRules(bucketName string, rules []*NotificationRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutNotificationRules", bucketName, rules)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutNotificationRules indicates an expected call of PutNotificationRules.
+func (mr *MockMetadataMockRecorder) PutNotificationRules(bucketName, rules any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutNotificationRules", reflect.TypeOf((*MockMetadata)(nil).PutNotificationRules), bucketName, rules)
+}
+
+// SearchObjects mocks base method.
+func (m *MockMetadata) SearchObjects(bucketName, startAfter string, limit int, filters ...func(*gorm.DB) *gorm.DB) ([]*Object, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{bucketName, startAfter, limit}
+	for _, a := range filters {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchObjects", varargs...)
+	ret0, _ := ret[0].([]*Object)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchObjects indicates an expected call of SearchObjects.
+func (mr *MockMetadataMockRecorder) SearchObjects(bucketName, startAfter, limit any, filters ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{bucketName, startAfter, limit}, filters...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchObjects", reflect.TypeOf((*MockMetadata)(nil).SearchObjects), varargs...)
+}
+
 // MockBSDB is a mock of BSDB interface.
 type MockBSDB struct {
 	ctrl     *gomock.Controller
@@ -1170,6 +1309,21 @@ func (mr *MockBSDBMockRecorder) GetBucketByName(bucketName, includePrivate any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketByName", reflect.TypeOf((*MockBSDB)(nil).GetBucketByName), bucketName, includePrivate)
 }
 
+// GetBucketByNameAtHeight mocks base method.
+func (m *MockBSDB) GetBucketByNameAtHeight(bucketName string, includePrivate bool, height int64) (*Bucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBucketByNameAtHeight", bucketName, includePrivate, height)
+	ret0, _ := ret[0].(*Bucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBucketByNameAtHeight indicates an expected call of GetBucketByNameAtHeight.
+func (mr *MockBSDBMockRecorder) GetBucketByNameAtHeight(bucketName, includePrivate, height any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketByNameAtHeight", reflect.TypeOf((*MockBSDB)(nil).GetBucketByNameAtHeight), bucketName, includePrivate, height)
+}
+
 // GetBucketInfoByBucketName mocks base method.
 func (m *MockBSDB) GetBucketInfoByBucketName(bucketName string) (*Bucket, error) {
 	m.ctrl.T.Helper()
@@ -1350,6 +1504,21 @@ func (mr *MockBSDBMockRecorder) GetGroupMembers(groupID, startAfter, limit any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembers", reflect.TypeOf((*MockBSDB)(nil).GetGroupMembers), groupID, startAfter, limit)
 }
 
+// GetGroupMembersAtHeight mocks base method.
+func (m *MockBSDB) GetGroupMembersAtHeight(groupID common.Hash, startAfter common.Address, limit int, height int64) ([]*GroupMemberMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupMembersAtHeight", groupID, startAfter, limit, height)
+	ret0, _ := ret[0].([]*GroupMemberMeta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupMembersAtHeight indicates an expected call of GetGroupMembersAtHeight.
+func (mr *MockBSDBMockRecorder) GetGroupMembersAtHeight(groupID, startAfter, limit, height any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembersAtHeight", reflect.TypeOf((*MockBSDB)(nil).GetGroupMembersAtHeight), groupID, startAfter, limit, height)
+}
+
 // GetGroupMembersCount mocks base method.
 func (m *MockBSDB) GetGroupMembersCount(groupIDs []common.Hash) ([]*GroupCount, error) {
 	m.ctrl.T.Helper()
@@ -1395,6 +1564,21 @@ func (mr *MockBSDBMockRecorder) GetGvgByBucketAndLvgID(bucketID, lvgID any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGvgByBucketAndLvgID", reflect.TypeOf((*MockBSDB)(nil).GetGvgByBucketAndLvgID), bucketID, lvgID)
 }
 
+// GetHistoryEpoch mocks base method.
+func (m *MockBSDB) GetHistoryEpoch() (*HistoryEpoch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistoryEpoch")
+	ret0, _ := ret[0].(*HistoryEpoch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistoryEpoch indicates an expected call of GetHistoryEpoch.
+func (mr *MockBSDBMockRecorder) GetHistoryEpoch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoryEpoch", reflect.TypeOf((*MockBSDB)(nil).GetHistoryEpoch))
+}
+
 // GetLatestBlockNumber mocks base method.
 func (m *MockBSDB) GetLatestBlockNumber() (uint64, error) {
 	m.ctrl.T.Helper()
@@ -1500,6 +1684,21 @@ func (mr *MockBSDBMockRecorder) GetObjectByName(objectName, bucketName, includeP
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectByName", reflect.TypeOf((*MockBSDB)(nil).GetObjectByName), objectName, bucketName, includePrivate)
 }
 
+// GetObjectByNameAtHeight mocks base method.
+func (m *MockBSDB) GetObjectByNameAtHeight(objectName, bucketName string, includePrivate bool, height int64) (*Object, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetObjectByNameAtHeight", objectName, bucketName, includePrivate, height)
+	ret0, _ := ret[0].(*Object)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetObjectByNameAtHeight indicates an expected call of GetObjectByNameAtHeight.
+func (mr *MockBSDBMockRecorder) GetObjectByNameAtHeight(objectName, bucketName, includePrivate, height any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectByNameAtHeight", reflect.TypeOf((*MockBSDB)(nil).GetObjectByNameAtHeight), objectName, bucketName, includePrivate, height)
+}
+
 // GetObjectCount mocks base method.
 func (m *MockBSDB) GetObjectCount(blockHeight int64, objectStatus string) ([]int64, error) {
 	m.ctrl.T.Helper()
@@ -1963,6 +2162,21 @@ func (mr *MockBSDBMockRecorder) ListMigrateBucketEvents(spID any, filters ...any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMigrateBucketEvents", reflect.TypeOf((*MockBSDB)(nil).ListMigrateBucketEvents), varargs...)
 }
 
+// ListNotificationRules mocks base method.
+func (m *MockBSDB) ListNotificationRules(bucketName string) ([]*NotificationRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNotificationRules", bucketName)
+	ret0, _ := ret[0].([]*NotificationRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNotificationRules indicates an expected call of ListNotificationRules.
+func (mr *MockBSDBMockRecorder) ListNotificationRules(bucketName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotificationRules", reflect.TypeOf((*MockBSDB)(nil).ListNotificationRules), bucketName)
+}
+
 // ListObjectPolicies mocks base method.
 func (m *MockBSDB) ListObjectPolicies(objectID common.Hash, actionType types.ActionType, startAfter common.Hash, limit int) ([]*PermissionWithStatement, error) {
 	m.ctrl.T.Helper()
@@ -1978,6 +2192,21 @@ func (mr *MockBSDBMockRecorder) ListObjectPolicies(objectID, actionType, startAf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectPolicies", reflect.TypeOf((*MockBSDB)(nil).ListObjectPolicies), objectID, actionType, startAfter, limit)
 }
 
+// ListObjectPoliciesAtHeight mocks base method.
+func (m *MockBSDB) ListObjectPoliciesAtHeight(objectID common.Hash, actionType types.ActionType, startAfter common.Hash, limit int, height int64) ([]*PermissionWithStatement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObjectPoliciesAtHeight", objectID, actionType, startAfter, limit, height)
+	ret0, _ := ret[0].([]*PermissionWithStatement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjectPoliciesAtHeight indicates an expected call of ListObjectPoliciesAtHeight.
+func (mr *MockBSDBMockRecorder) ListObjectPoliciesAtHeight(objectID, actionType, startAfter, limit, height any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectPoliciesAtHeight", reflect.TypeOf((*MockBSDB)(nil).ListObjectPoliciesAtHeight), objectID, actionType, startAfter, limit, height)
+}
+
 // ListObjectsByBucketName mocks base method.
 func (m *MockBSDB) ListObjectsByBucketName(bucketName, continuationToken, prefix, delimiter string, maxKeys int, includeRemoved bool) ([]*ListObjectsResult, error) {
 	m.ctrl.T.Helper()
@@ -1993,6 +2222,21 @@ func (mr *MockBSDBMockRecorder) ListObjectsByBucketName(bucketName, continuation
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectsByBucketName", reflect.TypeOf((*MockBSDB)(nil).ListObjectsByBucketName), bucketName, continuationToken, prefix, delimiter, maxKeys, includeRemoved)
 }
 
+// ListObjectsByBucketNameAtHeight mocks base method.
+func (m *MockBSDB) ListObjectsByBucketNameAtHeight(bucketName, continuationToken, prefix string, maxKeys int, height int64) ([]*ListObjectsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObjectsByBucketNameAtHeight", bucketName, continuationToken, prefix, maxKeys, height)
+	ret0, _ := ret[0].([]*ListObjectsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjectsByBucketNameAtHeight indicates an expected call of ListObjectsByBucketNameAtHeight.
+func (mr *MockBSDBMockRecorder) ListObjectsByBucketNameAtHeight(bucketName, continuationToken, prefix, maxKeys, height any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectsByBucketNameAtHeight", reflect.TypeOf((*MockBSDB)(nil).ListObjectsByBucketNameAtHeight), bucketName, continuationToken, prefix, maxKeys, height)
+}
+
 // ListObjectsByGVGAndBucketForGC mocks base method.
 func (m *MockBSDB) ListObjectsByGVGAndBucketForGC(bucketID common.Hash, gvgID uint32, startAfter common.Hash, limit int) ([]*Object, *Bucket, error) {
 	m.ctrl.T.Helper()
@@ -2184,3 +2428,37 @@ func (mr *MockBSDBMockRecorder) ListVirtualGroupFamiliesByVgfIDs(vgfIDs any) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualGroupFamiliesByVgfIDs", reflect.TypeOf((*MockBSDB)(nil).ListVirtualGroupFamiliesByVgfIDs), vgfIDs)
 }
+
+// PutNotificationRules mocks base method.
+func (m *MockBSDB) PutNotificationRules(bucketName string, rules []*NotificationRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutNotificationRules", bucketName, rules)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutNotificationRules indicates an expected call of PutNotificationRules.
+func (mr *MockBSDBMockRecorder) PutNotificationRules(bucketName, rules any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutNotificationRules", reflect.TypeOf((*MockBSDB)(nil).PutNotificationRules), bucketName, rules)
+}
+
+// SearchObjects mocks base method.
+func (m *MockBSDB) SearchObjects(bucketName, startAfter string, limit int, filters ...func(*gorm.DB) *gorm.DB) ([]*Object, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{bucketName, startAfter, limit}
+	for _, a := range filters {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchObjects", varargs...)
+	ret0, _ := ret[0].([]*Object)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchObjects indicates an expected call of SearchObjects.
+func (mr *MockBSDBMockRecorder) SearchObjects(bucketName, startAfter, limit any, filters ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{bucketName, startAfter, limit}, filters...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchObjects", reflect.TypeOf((*MockBSDB)(nil).SearchObjects), varargs...)
+}
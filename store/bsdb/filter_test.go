@@ -150,3 +150,62 @@ func TestWithLimit(t *testing.T) {
 	db.db.Table(ObjectTableName).Scopes(WithLimit(limit)).Find(&[]struct{}{})
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestObjectNameStartAfterFilter(t *testing.T) {
+	db, mock := setupDB(t)
+
+	expectedSQL := "SELECT * FROM `objects` WHERE object_name > ?"
+	mock.ExpectQuery(expectedSQL).WithArgs("a.jpg").WillReturnRows(sqlmock.NewRows([]string{}))
+
+	db.db.Table(ObjectTableName).Scopes(ObjectNameStartAfterFilter("a.jpg")).Find(&[]struct{}{})
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestObjectNamePatternFilter(t *testing.T) {
+	db, mock := setupDB(t)
+
+	expectedSQL := "SELECT * FROM `objects` WHERE object_name LIKE ?"
+	mock.ExpectQuery(expectedSQL).WithArgs(`videos/%\_v.mp_`).WillReturnRows(sqlmock.NewRows([]string{}))
+
+	db.db.Table(ObjectTableName).Scopes(ObjectNamePatternFilter("videos/*_v.mp?")).Find(&[]struct{}{})
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPayloadSizeFilter(t *testing.T) {
+	db, mock := setupDB(t)
+
+	expectedSQL := "SELECT * FROM `objects` WHERE payload_size >= ? AND payload_size <= ?"
+	mock.ExpectQuery(expectedSQL).WithArgs(uint64(1), uint64(2)).WillReturnRows(sqlmock.NewRows([]string{}))
+
+	db.db.Table(ObjectTableName).Scopes(MinPayloadSizeFilter(1), MaxPayloadSizeFilter(2)).Find(&[]struct{}{})
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContentTypeFilter(t *testing.T) {
+	db, mock := setupDB(t)
+
+	expectedSQL := "SELECT * FROM `objects` WHERE content_type LIKE ?"
+	mock.ExpectQuery(expectedSQL).WithArgs("video/%").WillReturnRows(sqlmock.NewRows([]string{}))
+	db.db.Table(ObjectTableName).Scopes(ContentTypeFilter("video/*")).Find(&[]struct{}{})
+
+	expectedSQL = "SELECT * FROM `objects` WHERE content_type = ?"
+	mock.ExpectQuery(expectedSQL).WithArgs("video/mp4").WillReturnRows(sqlmock.NewRows([]string{}))
+	db.db.Table(ObjectTableName).Scopes(ContentTypeFilter("video/mp4")).Find(&[]struct{}{})
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateTimeFilter(t *testing.T) {
+	db, mock := setupDB(t)
+
+	expectedSQL := "SELECT * FROM `objects` WHERE create_time >= ? AND create_time < ?"
+	mock.ExpectQuery(expectedSQL).WithArgs(int64(100), int64(200)).WillReturnRows(sqlmock.NewRows([]string{}))
+
+	db.db.Table(ObjectTableName).Scopes(CreateTimeAfterFilter(100), CreateTimeBeforeFilter(200)).Find(&[]struct{}{})
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGlobToLikePattern(t *testing.T) {
+	assert.Equal(t, "%", GlobToLikePattern("*"))
+	assert.Equal(t, `a\%b\_c_`, GlobToLikePattern("a%b_c?"))
+	assert.Equal(t, `\\`, GlobToLikePattern(`\`))
+}
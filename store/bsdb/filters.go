@@ -1,6 +1,8 @@
 package bsdb
 
 import (
+	"strings"
+
 	"github.com/forbole/juno/v4/common"
 	"gorm.io/gorm"
 )
@@ -91,3 +93,89 @@ func WithLimit(limit int) func(*gorm.DB) *gorm.DB {
 		return db.Limit(limit)
 	}
 }
+
+// ObjectNameStartAfterFilter filters the objects whose names are after the given name, it's used to paginate the
+// objects by the index of bucket name and object name
+func ObjectNameStartAfterFilter(startAfter string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("object_name > ?", startAfter)
+	}
+}
+
+// ObjectNamePatternFilter filters the objects whose names match the glob pattern, "*" matches any characters and
+// "?" matches a single character
+func ObjectNamePatternFilter(pattern string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("object_name LIKE ?", GlobToLikePattern(pattern))
+	}
+}
+
+// MinPayloadSizeFilter filters the objects whose payload sizes are not less than the given size
+func MinPayloadSizeFilter(size uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("payload_size >= ?", size)
+	}
+}
+
+// MaxPayloadSizeFilter filters the objects whose payload sizes are not greater than the given size
+func MaxPayloadSizeFilter(size uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("payload_size <= ?", size)
+	}
+}
+
+// ContentTypeFilter filters the objects by content type, the content type ends with "/*" matches all the subtypes,
+// e.g. "video/*"
+func ContentTypeFilter(contentType string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if strings.HasSuffix(contentType, "/*") {
+			return db.Where("content_type LIKE ?", escapeLike(strings.TrimSuffix(contentType, "*"))+"%")
+		}
+		return db.Where("content_type = ?", contentType)
+	}
+}
+
+// CreateTimeAfterFilter filters the objects created at or after the given unix time
+func CreateTimeAfterFilter(createTime int64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("create_time >= ?", createTime)
+	}
+}
+
+// CreateTimeBeforeFilter filters the objects created before the given unix time
+func CreateTimeBeforeFilter(createTime int64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("create_time < ?", createTime)
+	}
+}
+
+// ObjectStatusFilter filters the objects by status
+func ObjectStatusFilter(status string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("status = ?", status)
+	}
+}
+
+// GlobToLikePattern converts the glob pattern to the pattern of sql LIKE, the wildcards of LIKE in the glob
+// pattern are escaped
+func GlobToLikePattern(pattern string) string {
+	var builder strings.Builder
+	for _, c := range pattern {
+		switch c {
+		case '*':
+			builder.WriteRune('%')
+		case '?':
+			builder.WriteRune('_')
+		case '%', '_', '\\':
+			builder.WriteRune('\\')
+			builder.WriteRune(c)
+		default:
+			builder.WriteRune(c)
+		}
+	}
+	return builder.String()
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
@@ -0,0 +1,264 @@
+package bsdb
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/forbole/juno/v4/common"
+	"github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+
+	gnfdresource "github.com/bnb-chain/greenfield/types/resource"
+	permtypes "github.com/bnb-chain/greenfield/x/permission/types"
+	"github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// errNoSuchTableCode is the mysql error code of querying a table which does not exist
+const errNoSuchTableCode = 1146
+
+// GetObjectHistoriesTableName returns the name of the object history table shard of the bucket
+func GetObjectHistoriesTableName(bucketName string) string {
+	return GetObjectHistoriesTableNameByShardNumber(int(GetObjectsShardNumberByBucketName(bucketName)))
+}
+
+// GetObjectHistoriesTableNameByShardNumber returns the name of the object history table shard
+func GetObjectHistoriesTableNameByShardNumber(shard int) string {
+	return fmt.Sprintf("%s_%02d", ObjectHistoryTableName, shard)
+}
+
+// latestVersionCondition selects the latest version at or below a block height of every row of the history table,
+// the versions of a row are matched by the key columns. It takes the block height as its only argument.
+func latestVersionCondition(table, alias string, keys ...string) string {
+	matches := make([]string, len(keys))
+	for i, key := range keys {
+		matches[i] = fmt.Sprintf("v.%s = %s.%s", key, alias, key)
+	}
+	return fmt.Sprintf("%s.height = (SELECT MAX(v.height) FROM %s v WHERE %s AND v.height <= ?)",
+		alias, table, strings.Join(matches, " AND "))
+}
+
+// GetHistoryEpoch gets the block range of the history tables, returns nil if the history is not maintained
+func (b *BsDBImpl) GetHistoryEpoch() (*HistoryEpoch, error) {
+	var (
+		epoch *HistoryEpoch
+		err   error
+	)
+	startTime := time.Now()
+	methodName := currentFunction()
+	defer func() {
+		if err != nil {
+			MetadataDatabaseFailureMetrics(err, startTime, methodName)
+		} else {
+			MetadataDatabaseSuccessMetrics(startTime, methodName)
+		}
+	}()
+
+	err = b.db.Table(HistoryEpochTableName).Take(&epoch).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = nil
+		return nil, nil
+	}
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) && mysqlErr.Number == errNoSuchTableCode {
+		err = nil
+		return nil, nil
+	}
+	return epoch, err
+}
+
+// GetBucketByNameAtHeight gets the bucket info by a bucket name at the block height, returns nil if the bucket did
+// not exist or was removed at the height
+func (b *BsDBImpl) GetBucketByNameAtHeight(bucketName string, includePrivate bool, height int64) (*Bucket, error) {
+	var (
+		bucket *Bucket
+		err    error
+	)
+	startTime := time.Now()
+	methodName := currentFunction()
+	defer func() {
+		if err != nil {
+			MetadataDatabaseFailureMetrics(err, startTime, methodName)
+		} else {
+			MetadataDatabaseSuccessMetrics(startTime, methodName)
+		}
+	}()
+
+	condition := "h.bucket_name = ? AND h.removed = false AND " + latestVersionCondition(BucketHistoryTableName, "h", "bucket_id")
+	args := []interface{}{bucketName, height}
+	if !includePrivate {
+		condition += " AND h.visibility = ?"
+		args = append(args, types.VISIBILITY_TYPE_PUBLIC_READ.String())
+	}
+	err = b.db.Table(BucketHistoryTableName+" h").
+		Select("h.*").
+		Where(condition, args...).
+		Take(&bucket).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = nil
+		return nil, nil
+	}
+	return bucket, err
+}
+
+// ListObjectsByBucketNameAtHeight lists the objects of the bucket at the block height in the order of object name,
+// the removed objects are not returned. It returns maxKeys+1 objects at most to tell whether there are more objects.
+func (b *BsDBImpl) ListObjectsByBucketNameAtHeight(bucketName, continuationToken, prefix string, maxKeys int, height int64) ([]*ListObjectsResult, error) {
+	var (
+		results []*ListObjectsResult
+		filters []func(*gorm.DB) *gorm.DB
+		err     error
+	)
+	startTime := time.Now()
+	methodName := currentFunction()
+	defer func() {
+		if err != nil {
+			MetadataDatabaseFailureMetrics(err, startTime, methodName)
+		} else {
+			MetadataDatabaseSuccessMetrics(startTime, methodName)
+		}
+	}()
+
+	if continuationToken != "" {
+		filters = append(filters, ContinuationTokenFilter(continuationToken))
+	}
+	if prefix != "" {
+		filters = append(filters, PrefixFilter(prefix))
+	}
+
+	table := GetObjectHistoriesTableName(bucketName)
+	err = b.db.Table(table+" h").
+		Select("h.*").
+		Where("h.bucket_name = ? AND h.removed = false AND "+latestVersionCondition(table, "h", "object_id"), bucketName, height).
+		Scopes(filters...).
+		Limit(maxKeys + 1).
+		Order("object_name asc").
+		Find(&results).Error
+	return results, err
+}
+
+// GetObjectByNameAtHeight gets the object info by an object name at the block height, it returns
+// gorm.ErrRecordNotFound if the object did not exist, was removed or was not public at the height.
+func (b *BsDBImpl) GetObjectByNameAtHeight(objectName, bucketName string, includePrivate bool, height int64) (*Object, error) {
+	var (
+		object *Object
+		bucket *Bucket
+		err    error
+	)
+	startTime := time.Now()
+	methodName := currentFunction()
+	defer func() {
+		if err != nil {
+			MetadataDatabaseFailureMetrics(err, startTime, methodName)
+		} else {
+			MetadataDatabaseSuccessMetrics(startTime, methodName)
+		}
+	}()
+
+	table := GetObjectHistoriesTableName(bucketName)
+	err = b.db.Table(table+" h").
+		Select("h.*").
+		Where("h.bucket_name = ? AND h.object_name = ? AND h.removed = false AND "+latestVersionCondition(table, "h", "object_id"),
+			bucketName, objectName, height).
+		Take(&object).Error
+	if err != nil || includePrivate {
+		return object, err
+	}
+
+	if object.Visibility == types.VISIBILITY_TYPE_PUBLIC_READ.String() {
+		return object, nil
+	}
+	if object.Visibility != types.VISIBILITY_TYPE_INHERIT.String() {
+		err = gorm.ErrRecordNotFound
+		return nil, err
+	}
+	// the object inherits the visibility of the bucket at the same height
+	if bucket, err = b.GetBucketByNameAtHeight(bucketName, false, height); err != nil {
+		return nil, err
+	}
+	if bucket == nil {
+		err = gorm.ErrRecordNotFound
+		return nil, err
+	}
+	return object, nil
+}
+
+// GetGroupMembersAtHeight gets the members of the group at the block height in the order of account
+func (b *BsDBImpl) GetGroupMembersAtHeight(groupID common.Hash, startAfter common.Address, limit int, height int64) ([]*GroupMemberMeta, error) {
+	var (
+		members []*GroupMemberMeta
+		err     error
+	)
+	startTime := time.Now()
+	methodName := currentFunction()
+	defer func() {
+		if err != nil {
+			MetadataDatabaseFailureMetrics(err, startTime, methodName)
+		} else {
+			MetadataDatabaseSuccessMetrics(startTime, methodName)
+		}
+	}()
+
+	err = b.db.Raw(`
+        SELECT g1.*, g2.extra as group_extra, g2.source_type as group_source_type, g2.tags as group_tags
+        FROM `+GroupHistoryTableName+` g1
+        LEFT JOIN `+GroupHistoryTableName+` g2
+        ON g1.group_id = g2.group_id AND g2.account_id = ? AND `+latestVersionCondition(GroupHistoryTableName, "g2", "group_id", "account_id")+`
+        WHERE g1.group_id = ?
+        AND g1.account_id > ?
+        AND g1.removed = false
+        AND `+latestVersionCondition(GroupHistoryTableName, "g1", "group_id", "account_id")+`
+        ORDER BY g1.account_id
+        LIMIT ?
+    `, common.HexToAddress(GroupAddress), height, groupID, startAfter, height, limit).Find(&members).Error
+	return members, err
+}
+
+// ListObjectPoliciesAtHeight lists the policies which allow the action on the object at the block height. The block
+// time of the height is not recorded, so the policies are not filtered by their expiration time.
+func (b *BsDBImpl) ListObjectPoliciesAtHeight(objectID common.Hash, actionType permtypes.ActionType, startAfter common.Hash, limit int, height int64) ([]*PermissionWithStatement, error) {
+	var (
+		permissions  []*PermissionWithStatement
+		actionValues []int
+		err          error
+	)
+	startTime := time.Now()
+	methodName := currentFunction()
+	defer func() {
+		if err != nil {
+			MetadataDatabaseFailureMetrics(err, startTime, methodName)
+		} else {
+			MetadataDatabaseSuccessMetrics(startTime, methodName)
+		}
+	}()
+
+	actionValues = PossibleValuesForAction(actionType)
+	if len(actionValues) == 0 {
+		return nil, nil
+	}
+
+	err = b.db.Table(PermissionHistoryTableName+" p").
+		Select("*").
+		Joins("INNER JOIN "+StatementHistoryTableName+" s ON s.policy_id = p.policy_id").
+		Where(`
+			p.resource_type = ? AND
+			p.resource_id = ? AND
+			p.policy_id > ? AND
+			p.removed = false AND
+			`+latestVersionCondition(PermissionHistoryTableName, "p", "id")+` AND
+			s.action_value in (?) AND
+			s.effect = "EFFECT_ALLOW" AND
+			s.removed = false AND
+			`+latestVersionCondition(StatementHistoryTableName, "s", "id"),
+			gnfdresource.RESOURCE_TYPE_OBJECT.String(),
+			objectID,
+			startAfter,
+			height,
+			actionValues,
+			height).
+		Order("p.policy_id").
+		Limit(limit).
+		Find(&permissions).Error
+	return permissions, err
+}
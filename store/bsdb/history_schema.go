@@ -0,0 +1,256 @@
+package bsdb
+
+import (
+	"github.com/forbole/juno/v4/common"
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+	"gorm.io/datatypes"
+)
+
+// HistoryEpoch records the block range of the versioned history tables, the history is only available for the
+// block heights between StartHeight and BlockHeight
+type HistoryEpoch struct {
+	// OneRowID defines if the table only has one row
+	OneRowID bool `gorm:"column:one_row_id;not null;default:true;primaryKey"`
+	// StartHeight defines the block number when the history is snapshotted from the current tables
+	StartHeight int64 `gorm:"column:start_height;type:bigint(64)"`
+	// BlockHeight defines the latest block number recorded in the history
+	BlockHeight int64 `gorm:"column:block_height;type:bigint(64)"`
+}
+
+// TableName is used to set HistoryEpoch table name in database
+func (*HistoryEpoch) TableName() string {
+	return HistoryEpochTableName
+}
+
+// BucketHistory is a version of a bucket row at the block height when it's changed
+type BucketHistory struct {
+	// BucketID is the unique identification for bucket
+	BucketID common.Hash `gorm:"column:bucket_id;type:BINARY(32);primaryKey"`
+	// Height defines the block number of the version
+	Height int64 `gorm:"column:height;type:bigint(64);primaryKey;autoIncrement:false;index:idx_height"`
+	// ID defines db auto_increment id of the bucket row
+	ID uint64 `gorm:"column:id"`
+	// BucketName is a globally unique name of bucket
+	BucketName string `gorm:"column:bucket_name;type:varchar(64);index:idx_bucket_name"`
+	// Owner is the account address of bucket creator
+	Owner common.Address `gorm:"column:owner;type:BINARY(20)"`
+	// PaymentAddress is the address of the payment account
+	PaymentAddress common.Address `gorm:"column:payment_address;type:BINARY(20)"`
+	// GlobalVirtualGroupFamilyID defines the unique id of gvg family
+	GlobalVirtualGroupFamilyID uint32 `gorm:"column:global_virtual_group_family_id"`
+	// Operator defines the operator address of bucket
+	Operator common.Address `gorm:"column:operator;type:BINARY(20)"`
+	// SourceType defines which chain the user should send the bucket management transactions to
+	SourceType string `gorm:"column:source_type;type:VARCHAR(50)"`
+	// ChargedReadQuota defines the traffic quota for read
+	ChargedReadQuota uint64 `gorm:"column:charged_read_quota"`
+	// Visibility defines the highest permissions for bucket
+	Visibility string `gorm:"column:visibility;type:VARCHAR(50)"`
+	// Status defines the status of bucket
+	Status string `gorm:"column:status;type:varchar(64)"`
+	// DeleteAt defines the block number when the bucket deleted
+	DeleteAt int64 `gorm:"column:delete_at"`
+	// DeleteReason defines the deleted reason of bucket
+	DeleteReason string `gorm:"column:delete_reason;type:varchar(256)"`
+	// StorageSize storage size of bucket
+	StorageSize decimal.Decimal `gorm:"column:storage_size;type:DECIMAL(65, 0);not null"`
+	// ChargeSize charge size of bucket
+	ChargeSize decimal.Decimal `gorm:"column:charge_size;type:DECIMAL(65, 0);not null"`
+	// CreateAt defines the block number when the bucket created
+	CreateAt int64 `gorm:"column:create_at"`
+	// CreateTxHash defines the creation transaction hash of bucket
+	CreateTxHash common.Hash `gorm:"column:create_tx_hash;type:BINARY(32);not null"`
+	// CreateTime defines the timestamp when the bucket created
+	CreateTime int64 `gorm:"column:create_time"`
+	// UpdateAt defines the block number when the bucket updated
+	UpdateAt int64 `gorm:"column:update_at"`
+	// UpdateTxHash defines the update transaction hash of bucket
+	UpdateTxHash common.Hash `gorm:"column:update_tx_hash;type:BINARY(32);not null"`
+	// UpdateTime defines the timestamp when the bucket updated
+	UpdateTime int64 `gorm:"column:update_time"`
+	// Removed defines the bucket is deleted or not
+	Removed bool `gorm:"column:removed;default:false"`
+	// OffChainStatus represents the status of a bucket in the off-chain storage
+	OffChainStatus int `gorm:"column:off_chain_status;type:int;not null;default:0"`
+	// Tags defines the tags of bucket
+	Tags datatypes.JSON `gorm:"column:tags;TYPE:json"`
+}
+
+// TableName is used to set BucketHistory table name in database
+func (*BucketHistory) TableName() string {
+	return BucketHistoryTableName
+}
+
+// ObjectHistory is a version of an object row at the block height when it's changed, the table is sharded by the
+// bucket name like the objects table
+type ObjectHistory struct {
+	// ObjectID is the unique identifier of object
+	ObjectID common.Hash `gorm:"column:object_id;type:BINARY(32);primaryKey"`
+	// Height defines the block number of the version
+	Height int64 `gorm:"column:height;type:bigint(64);primaryKey;autoIncrement:false;index:idx_height"`
+	// ID defines db auto_increment id of the object row
+	ID uint64 `gorm:"column:id"`
+	// BucketID is the unique identifier of bucket
+	BucketID common.Hash `gorm:"column:bucket_id;type:BINARY(32)"`
+	// BucketName is the name of the bucket
+	BucketName string `gorm:"column:bucket_name;type:varchar(64);index:idx_bucket_name_object_name,priority:1"`
+	// ObjectName is the name of object
+	ObjectName string `gorm:"column:object_name;type:varchar(1024);index:idx_bucket_name_object_name,length:512,priority:2"`
+	// Creator defines the account address of object creator
+	Creator common.Address `gorm:"column:creator;type:BINARY(20)"`
+	// Owner defines the account address of object owner
+	Owner common.Address `gorm:"column:owner;type:BINARY(20)"`
+	// LocalVirtualGroupId defines the local virtual group id of object
+	LocalVirtualGroupId uint32 `gorm:"column:local_virtual_group_id"`
+	// Operator defines the operator address of object
+	Operator common.Address `gorm:"column:operator;type:BINARY(20)"`
+	// PayloadSize is the total size of the object payload
+	PayloadSize uint64 `gorm:"column:payload_size"`
+	// Visibility defines the highest permissions for object
+	Visibility string `gorm:"column:visibility;type:VARCHAR(50)"`
+	// ContentType defines the format of the object which should be a standard MIME type
+	ContentType string `gorm:"column:content_type"`
+	// Status defines the upload status of the object
+	Status string `gorm:"column:status;type:VARCHAR(50)"`
+	// RedundancyType defines the type of the redundancy which can be multi-replication or EC
+	RedundancyType string `gorm:"column:redundancy_type;type:VARCHAR(50)"`
+	// SourceType defines the source of the object
+	SourceType string `gorm:"column:source_type;type:VARCHAR(50)"`
+	// CheckSums defines the root hash of the pieces which stored in a SP
+	CheckSums pq.ByteaArray `gorm:"column:checksums;type:text"`
+	// DeleteAt defines the block number when the object deleted
+	DeleteAt int64 `gorm:"column:delete_at"`
+	// DeleteReason defines the deleted reason of object
+	DeleteReason string `gorm:"column:delete_reason;type:varchar(256)"`
+	// CreateAt defines the block number when the object created
+	CreateAt int64 `gorm:"column:create_at"`
+	// CreateTxHash defines the creation transaction hash of object
+	CreateTxHash common.Hash `gorm:"column:create_tx_hash;type:BINARY(32);not null"`
+	// CreateTime defines the timestamp when the object created
+	CreateTime int64 `gorm:"column:create_time"`
+	// UpdateAt defines the block number when the object updated
+	UpdateAt int64 `gorm:"column:update_at"`
+	// UpdateTxHash defines the update transaction hash of object
+	UpdateTxHash common.Hash `gorm:"column:update_tx_hash;type:BINARY(32);not null"`
+	// SealedTxHash defines the sealed transaction hash of object
+	SealedTxHash common.Hash `gorm:"column:sealed_tx_hash;type:BINARY(32)"`
+	// UpdateTime defines the timestamp when the object updated
+	UpdateTime int64 `gorm:"column:update_time"`
+	// Removed defines the object is deleted or not
+	Removed bool `gorm:"column:removed;default:false"`
+	// Tags defines the tags of object
+	Tags datatypes.JSON `gorm:"column:tags;TYPE:json"`
+	// IsUpdating defines the object content is being updated or not
+	IsUpdating bool `gorm:"column:is_updating"`
+	// ContentUpdatedTime defines the content updated time
+	ContentUpdatedTime int64 `gorm:"column:content_updated_time"`
+	// Updater defines the account that updates the object content recently
+	Updater common.Address `gorm:"column:updater;type:BINARY(20)"`
+	// Version defines the version of the object content
+	Version int64 `gorm:"column:version"`
+}
+
+// TableName is used to set ObjectHistory table name in database
+func (*ObjectHistory) TableName() string {
+	return ObjectHistoryTableName
+}
+
+// GroupHistory is a version of a group row or a group member row at the block height when it's changed
+type GroupHistory struct {
+	// GroupID is the unique identification for group
+	GroupID common.Hash `gorm:"column:group_id;type:BINARY(32);primaryKey"`
+	// AccountID defines the group member address, it's GroupAddress for the group itself
+	AccountID common.Address `gorm:"column:account_id;type:BINARY(20);primaryKey"`
+	// Height defines the block number of the version
+	Height int64 `gorm:"column:height;type:bigint(64);primaryKey;autoIncrement:false;index:idx_height"`
+	// ID defines db auto_increment id of the group row
+	ID uint64 `gorm:"column:id"`
+	// Owner is the account address of group creator
+	Owner common.Address `gorm:"column:owner;type:BINARY(20)"`
+	// GroupName defines the name of the group
+	GroupName string `gorm:"column:group_name;type:varchar(63)"`
+	// SourceType defines which chain the user should send the group management transactions to
+	SourceType string `gorm:"column:source_type;type:varchar(63)"`
+	// Extra defines the extra info for the group to update
+	Extra string `gorm:"column:extra;type:varchar(512)"`
+	// Operator defines operator address of group
+	Operator common.Address `gorm:"column:operator;type:BINARY(20)"`
+	// ExpirationTime defines the expiration time of group member
+	ExpirationTime int64 `gorm:"column:expiration_time"`
+	// CreateAt defines the block number when the group created
+	CreateAt int64 `gorm:"column:create_at"`
+	// CreateTime defines the timestamp when the group created
+	CreateTime int64 `gorm:"column:create_time"`
+	// UpdateAt defines the block number when the group updated
+	UpdateAt int64 `gorm:"column:update_at"`
+	// UpdateTime defines the timestamp when the group updated
+	UpdateTime int64 `gorm:"column:update_time"`
+	// Removed defines the group is deleted or not
+	Removed bool `gorm:"column:removed;default:false"`
+	// Tags defines the tags of group
+	Tags datatypes.JSON `gorm:"column:tags;TYPE:json"`
+}
+
+// TableName is used to set GroupHistory table name in database
+func (*GroupHistory) TableName() string {
+	return GroupHistoryTableName
+}
+
+// PermissionHistory is a version of a permission row at the block height when it's changed
+type PermissionHistory struct {
+	// ID defines db auto_increment id of the permission row
+	ID uint64 `gorm:"column:id;type:bigint(64);primaryKey;autoIncrement:false"`
+	// Height defines the block number of the version
+	Height int64 `gorm:"column:height;type:bigint(64);primaryKey;autoIncrement:false;index:idx_height"`
+	// PrincipalType defines the type of principal
+	PrincipalType int32 `gorm:"column:principal_type;type:int"`
+	// PrincipalValue defines the value of principal
+	PrincipalValue string `gorm:"column:principal_value;type:varchar(128)"`
+	// ResourceType defines the type of resource that grants permission for
+	ResourceType string `gorm:"column:resource_type;type:varchar(64)"`
+	// ResourceID defines the bucket/object/group id of the resource that grants permission for
+	ResourceID common.Hash `gorm:"column:resource_id;type:BINARY(32);index:idx_resource_id"`
+	// PolicyID is a unique u256 sequence for each policy
+	PolicyID common.Hash `gorm:"column:policy_id;type:BINARY(32)"`
+	// CreateTimestamp defines the create time of permission
+	CreateTimestamp int64 `gorm:"column:create_timestamp;type:bigint(64)"`
+	// UpdateTimestamp defines the update time of permission
+	UpdateTimestamp int64 `gorm:"column:update_timestamp;type:bigint(64)"`
+	// ExpirationTime defines the expiration time of permission
+	ExpirationTime int64 `gorm:"column:expiration_time;type:bigint(64)"`
+	// Removed defines the permission is deleted or not
+	Removed bool `gorm:"column:removed"`
+}
+
+// TableName is used to set PermissionHistory table name in database
+func (*PermissionHistory) TableName() string {
+	return PermissionHistoryTableName
+}
+
+// StatementHistory is a version of a statement row at the block height when it's changed
+type StatementHistory struct {
+	// ID defines db auto_increment id of the statement row
+	ID uint64 `gorm:"column:id;type:bigint(64);primaryKey;autoIncrement:false"`
+	// Height defines the block number of the version
+	Height int64 `gorm:"column:height;type:bigint(64);primaryKey;autoIncrement:false;index:idx_height"`
+	// PolicyID is a unique u256 sequence for each policy
+	PolicyID common.Hash `gorm:"column:policy_id;type:BINARY(32);index:idx_policy_id"`
+	// Effect define the impact of permissions, which is Allow/Deny
+	Effect string `gorm:"column:effect;type:varchar(32)"`
+	// ActionValue define the operation type you can act
+	ActionValue int `gorm:"column:action_value;type:int"`
+	// Resources defines the sub-resources of the bucket which the statement applies to
+	Resources pq.StringArray `gorm:"column:resources;type:text"`
+	// ExpirationTime defines how long the permission is valid
+	ExpirationTime int64 `gorm:"column:expiration_time;type:bigint(64)"`
+	// LimitSize defines the total data size that is allowed to operate
+	LimitSize uint64 `gorm:"column:limit_size;type:bigint(64)"`
+	// Removed defines the statement is deleted or not
+	Removed bool `gorm:"column:removed"`
+}
+
+// TableName is used to set StatementHistory table name in database
+func (*StatementHistory) TableName() string {
+	return StatementHistoryTableName
+}
@@ -0,0 +1,37 @@
+package bsdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistoryEpoch_TableName(t *testing.T) {
+	epoch := HistoryEpoch{}
+	assert.Equal(t, HistoryEpochTableName, epoch.TableName())
+}
+
+func TestBucketHistory_TableName(t *testing.T) {
+	bucket := BucketHistory{}
+	assert.Equal(t, BucketHistoryTableName, bucket.TableName())
+}
+
+func TestObjectHistory_TableName(t *testing.T) {
+	object := ObjectHistory{}
+	assert.Equal(t, ObjectHistoryTableName, object.TableName())
+}
+
+func TestGroupHistory_TableName(t *testing.T) {
+	group := GroupHistory{}
+	assert.Equal(t, GroupHistoryTableName, group.TableName())
+}
+
+func TestPermissionHistory_TableName(t *testing.T) {
+	permission := PermissionHistory{}
+	assert.Equal(t, PermissionHistoryTableName, permission.TableName())
+}
+
+func TestStatementHistory_TableName(t *testing.T) {
+	statement := StatementHistory{}
+	assert.Equal(t, StatementHistoryTableName, statement.TableName())
+}
@@ -0,0 +1,137 @@
+package bsdb
+
+import (
+	"database/sql/driver"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/forbole/juno/v4/common"
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	permtypes "github.com/bnb-chain/greenfield/x/permission/types"
+)
+
+func TestGetObjectHistoriesTableName(t *testing.T) {
+	assert.Equal(t, "object_histories_62", GetObjectHistoriesTableName("ot005test-bucket"))
+}
+
+func TestBsDBImpl_GetHistoryEpoch(t *testing.T) {
+	db, mock := setupDB(t)
+	mock.ExpectQuery("SELECT * FROM `history_epoch` LIMIT 1").
+		WillReturnRows(sqlmock.NewRows([]string{"one_row_id", "start_height", "block_height"}).AddRow(true, 100, 200))
+
+	epoch, err := db.GetHistoryEpoch()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), epoch.StartHeight)
+	assert.Equal(t, int64(200), epoch.BlockHeight)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBsDBImpl_GetHistoryEpochNotMaintained(t *testing.T) {
+	db, mock := setupDB(t)
+	mock.ExpectQuery("SELECT * FROM `history_epoch` LIMIT 1").WillReturnError(gorm.ErrRecordNotFound)
+	mock.ExpectQuery("SELECT * FROM `history_epoch` LIMIT 1").
+		WillReturnError(&mysql.MySQLError{Number: 1146, Message: "Table 'history_epoch' doesn't exist"})
+
+	epoch, err := db.GetHistoryEpoch()
+	assert.NoError(t, err)
+	assert.Nil(t, epoch)
+	epoch, err = db.GetHistoryEpoch()
+	assert.NoError(t, err)
+	assert.Nil(t, epoch)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBsDBImpl_GetBucketByNameAtHeight(t *testing.T) {
+	db, mock := setupDB(t)
+	expectedSQL := "SELECT h.* FROM bucket_histories h WHERE h.bucket_name = ? AND h.removed = false AND " +
+		"h.height = (SELECT MAX(v.height) FROM bucket_histories v WHERE v.bucket_id = h.bucket_id AND v.height <= ?) AND " +
+		"h.visibility = ? LIMIT 1"
+	mock.ExpectQuery(expectedSQL).WithArgs("bucket", int64(100), "VISIBILITY_TYPE_PUBLIC_READ").
+		WillReturnRows(sqlmock.NewRows([]string{"bucket_name", "update_at"}).AddRow("bucket", 90))
+	mock.ExpectQuery(expectedSQL).WithArgs("bucket", int64(80), "VISIBILITY_TYPE_PUBLIC_READ").
+		WillReturnRows(sqlmock.NewRows([]string{"bucket_name", "update_at"}))
+
+	bucket, err := db.GetBucketByNameAtHeight("bucket", false, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(90), bucket.UpdateAt)
+	bucket, err = db.GetBucketByNameAtHeight("bucket", false, 80)
+	assert.NoError(t, err)
+	assert.Nil(t, bucket)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBsDBImpl_ListObjectsByBucketNameAtHeight(t *testing.T) {
+	db, mock := setupDB(t)
+	expectedSQL := "SELECT h.* FROM object_histories_62 h WHERE (h.bucket_name = ? AND h.removed = false AND " +
+		"h.height = (SELECT MAX(v.height) FROM object_histories_62 v WHERE v.object_id = h.object_id AND v.height <= ?)) AND " +
+		"object_name >= ? AND object_name LIKE ? ORDER BY object_name asc LIMIT 11"
+	mock.ExpectQuery(expectedSQL).WithArgs("ot005test-bucket", int64(100), "a/b", "a/%").
+		WillReturnRows(sqlmock.NewRows([]string{"object_name", "payload_size"}).AddRow("a/b", 1024))
+
+	results, err := db.ListObjectsByBucketNameAtHeight("ot005test-bucket", "a/b", "a/", 10, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(results))
+	assert.Equal(t, "a/b", results[0].ObjectName)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBsDBImpl_GetObjectByNameAtHeight(t *testing.T) {
+	db, mock := setupDB(t)
+	objectSQL := "SELECT h.* FROM object_histories_62 h WHERE h.bucket_name = ? AND h.object_name = ? AND h.removed = false AND " +
+		"h.height = (SELECT MAX(v.height) FROM object_histories_62 v WHERE v.object_id = h.object_id AND v.height <= ?) LIMIT 1"
+	bucketSQL := "SELECT h.* FROM bucket_histories h WHERE h.bucket_name = ? AND h.removed = false AND " +
+		"h.height = (SELECT MAX(v.height) FROM bucket_histories v WHERE v.bucket_id = h.bucket_id AND v.height <= ?) AND " +
+		"h.visibility = ? LIMIT 1"
+
+	// the object inherits the visibility of the public bucket
+	mock.ExpectQuery(objectSQL).WithArgs("ot005test-bucket", "object", int64(100)).
+		WillReturnRows(sqlmock.NewRows([]string{"object_name", "visibility"}).AddRow("object", "VISIBILITY_TYPE_INHERIT"))
+	mock.ExpectQuery(bucketSQL).WithArgs("ot005test-bucket", int64(100), "VISIBILITY_TYPE_PUBLIC_READ").
+		WillReturnRows(sqlmock.NewRows([]string{"bucket_name"}).AddRow("ot005test-bucket"))
+	object, err := db.GetObjectByNameAtHeight("object", "ot005test-bucket", false, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, "object", object.ObjectName)
+
+	// the object is private at the height
+	mock.ExpectQuery(objectSQL).WithArgs("ot005test-bucket", "object", int64(50)).
+		WillReturnRows(sqlmock.NewRows([]string{"object_name", "visibility"}).AddRow("object", "VISIBILITY_TYPE_PRIVATE"))
+	_, err = db.GetObjectByNameAtHeight("object", "ot005test-bucket", false, 50)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+	// the object did not exist at the height
+	mock.ExpectQuery(objectSQL).WithArgs("ot005test-bucket", "object", int64(10)).
+		WillReturnRows(sqlmock.NewRows([]string{"object_name", "visibility"}))
+	_, err = db.GetObjectByNameAtHeight("object", "ot005test-bucket", true, 10)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBsDBImpl_ListObjectPoliciesAtHeight(t *testing.T) {
+	db, mock := setupDB(t)
+	objectID := common.HexToHash("0x1")
+	actionValues := PossibleValuesForAction(permtypes.ACTION_GET_OBJECT)
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(actionValues)), ",")
+	expectedSQL := "SELECT * FROM permission_histories p INNER JOIN statement_histories s ON s.policy_id = p.policy_id WHERE " +
+		"p.resource_type = ? AND p.resource_id = ? AND p.policy_id > ? AND p.removed = false AND " +
+		"p.height = (SELECT MAX(v.height) FROM permission_histories v WHERE v.id = p.id AND v.height <= ?) AND " +
+		"s.action_value in (" + placeholders + ") AND s.effect = \"EFFECT_ALLOW\" AND s.removed = false AND " +
+		"s.height = (SELECT MAX(v.height) FROM statement_histories v WHERE v.id = s.id AND v.height <= ?) " +
+		"ORDER BY p.policy_id LIMIT 10"
+	args := []driver.Value{"RESOURCE_TYPE_OBJECT", sqlmock.AnyArg(), sqlmock.AnyArg(), int64(100)}
+	for _, value := range actionValues {
+		args = append(args, value)
+	}
+	args = append(args, int64(100))
+	mock.ExpectQuery(expectedSQL).WithArgs(args...).
+		WillReturnRows(sqlmock.NewRows([]string{"principal_value", "effect"}).AddRow("0x01", "EFFECT_ALLOW"))
+
+	permissions, err := db.ListObjectPoliciesAtHeight(objectID, permtypes.ACTION_GET_OBJECT, common.Hash{}, 10, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(permissions))
+	assert.Equal(t, "0x01", permissions[0].PrincipalValue)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
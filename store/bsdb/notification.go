@@ -0,0 +1,128 @@
+package bsdb
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// define the event types of the bucket notification
+const (
+	NotificationObjectCreated       = "ObjectCreated:Create"
+	NotificationObjectCopied        = "ObjectCreated:Copy"
+	NotificationObjectSealed        = "ObjectCreated:Seal"
+	NotificationObjectContentUpdate = "ObjectCreated:UpdateContent"
+	NotificationObjectInfoUpdate    = "ObjectUpdated:UpdateInfo"
+	NotificationObjectDeleted       = "ObjectRemoved:Delete"
+	NotificationObjectCreateCancel  = "ObjectRemoved:CancelCreate"
+	NotificationObjectSealRejected  = "ObjectRemoved:RejectSeal"
+	NotificationObjectDiscontinued  = "ObjectRemoved:Discontinue"
+	NotificationBucketCreated       = "BucketCreated:Create"
+	NotificationBucketInfoUpdate    = "BucketUpdated:UpdateInfo"
+	NotificationBucketMigrated      = "BucketUpdated:CompleteMigration"
+	NotificationBucketDeleted       = "BucketRemoved:Delete"
+
+	// NotificationEventWildcard matches all the event types with the prefix before it
+	NotificationEventWildcard = "*"
+	// NotificationEventSeparator separates the event types of a NotificationRule
+	NotificationEventSeparator = ","
+)
+
+// NotificationEventTypes defines all the event types which can be subscribed by the bucket notification
+var NotificationEventTypes = []string{
+	NotificationObjectCreated,
+	NotificationObjectCopied,
+	NotificationObjectSealed,
+	NotificationObjectContentUpdate,
+	NotificationObjectInfoUpdate,
+	NotificationObjectDeleted,
+	NotificationObjectCreateCancel,
+	NotificationObjectSealRejected,
+	NotificationObjectDiscontinued,
+	NotificationBucketCreated,
+	NotificationBucketInfoUpdate,
+	NotificationBucketMigrated,
+	NotificationBucketDeleted,
+}
+
+// IsValidNotificationEvent returns whether the event is a notification event type, or a wildcard which matches
+// at least one of them.
+func IsValidNotificationEvent(event string) bool {
+	for _, eventType := range NotificationEventTypes {
+		if matchNotificationEvent(event, eventType) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchNotificationEvent(event, eventType string) bool {
+	if prefix, ok := strings.CutSuffix(event, NotificationEventWildcard); ok {
+		return prefix != "" && strings.HasPrefix(eventType, prefix)
+	}
+	return event == eventType
+}
+
+// HashNotificationSecret returns the hex encoded sha256 of the secret given by the bucket owner. Only the hash is
+// stored in the rules, and it's the key which signs the notifications, so the secret itself is never kept by the SP.
+func HashNotificationSecret(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(hash[:])
+}
+
+// GetEvents returns the event types of the rule
+func (r *NotificationRule) GetEvents() []string {
+	if r.Events == "" {
+		return nil
+	}
+	return strings.Split(r.Events, NotificationEventSeparator)
+}
+
+// Match returns whether the event of the object matches the rule. The bucket events have an empty object name, so
+// they only match the rules without the prefix and suffix filters.
+func (r *NotificationRule) Match(eventType, objectName string) bool {
+	if r.Prefix != "" || r.Suffix != "" {
+		if objectName == "" || !strings.HasPrefix(objectName, r.Prefix) || !strings.HasSuffix(objectName, r.Suffix) {
+			return false
+		}
+	}
+	for _, event := range r.GetEvents() {
+		if matchNotificationEvent(event, eventType) {
+			return true
+		}
+	}
+	return false
+}
+
+// ListNotificationRules lists the notification rules of the bucket
+func (b *BsDBImpl) ListNotificationRules(bucketName string) ([]*NotificationRule, error) {
+	var (
+		rules []*NotificationRule
+		err   error
+	)
+
+	err = b.db.Table((&NotificationRule{}).TableName()).
+		Select("*").
+		Where("bucket_name = ?", bucketName).
+		Order("rule_id").
+		Find(&rules).Error
+
+	return rules, err
+}
+
+// PutNotificationRules replaces the notification rules of the bucket, the rules are removed if it's empty
+func (b *BsDBImpl) PutNotificationRules(bucketName string, rules []*NotificationRule) error {
+	return b.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table((&NotificationRule{}).TableName()).
+			Where("bucket_name = ?", bucketName).
+			Delete(&NotificationRule{}).Error; err != nil {
+			return err
+		}
+		if len(rules) == 0 {
+			return nil
+		}
+		return tx.Table((&NotificationRule{}).TableName()).Create(&rules).Error
+	})
+}
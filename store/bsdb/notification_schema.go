@@ -0,0 +1,102 @@
+package bsdb
+
+import (
+	"github.com/forbole/juno/v4/common"
+)
+
+// NotificationRule defines a rule which delivers the matched events of a bucket to a webhook
+type NotificationRule struct {
+	// ID defines db auto_increment id of the rule
+	ID uint64 `gorm:"column:id;primaryKey"`
+	// BucketName defines the name of the bucket which the rule belongs to
+	BucketName string `gorm:"column:bucket_name;type:varchar(64);uniqueIndex:idx_bucket_rule,priority:1"`
+	// RuleID defines the unique identification of the rule in the bucket
+	RuleID string `gorm:"column:rule_id;type:varchar(64);uniqueIndex:idx_bucket_rule,priority:2"`
+	// BucketID defines the id of the bucket when the rule is put, the rule never matches a recreated bucket
+	BucketID common.Hash `gorm:"column:bucket_id;type:BINARY(32)"`
+	// Events defines the comma separated event types, a type ending with * matches all the types with the prefix
+	Events string `gorm:"column:events;type:varchar(1024)"`
+	// Prefix filters the object events by the prefix of the object name
+	Prefix string `gorm:"column:prefix;type:varchar(1024)"`
+	// Suffix filters the object events by the suffix of the object name
+	Suffix string `gorm:"column:suffix;type:varchar(1024)"`
+	// WebhookURL defines the url which the notifications are posted to
+	WebhookURL string `gorm:"column:webhook_url;type:varchar(2048)"`
+	// Secret defines the key which signs the notifications, it is the hash of the secret given by the bucket owner
+	Secret string `gorm:"column:secret;type:varchar(256)"`
+	// CreateTime defines the unix time when the rule is put, the events of the earlier blocks never match it
+	CreateTime int64 `gorm:"column:create_time;type:bigint(64)"`
+}
+
+// TableName is used to set NotificationRule table name in database
+func (*NotificationRule) TableName() string {
+	return NotificationRuleTableName
+}
+
+// Notification defines a matched event which is queued to be delivered to the webhook of the rule
+type Notification struct {
+	// ID defines db auto_increment id of the notification
+	ID uint64 `gorm:"column:id;primaryKey"`
+	// NotificationID defines the unique identification of the notification, it's the same after a redelivery
+	NotificationID string `gorm:"column:notification_id;type:varchar(66)"`
+	// BucketName defines the name of the bucket which the event belongs to
+	BucketName string `gorm:"column:bucket_name;type:varchar(64)"`
+	// RuleID defines the rule which matches the event
+	RuleID string `gorm:"column:rule_id;type:varchar(64)"`
+	// EventType defines the type of the event
+	EventType string `gorm:"column:event_type;type:varchar(64)"`
+	// Height defines the block number of the event
+	Height int64 `gorm:"column:height;type:bigint(64)"`
+	// WebhookURL defines the url which the notification is posted to
+	WebhookURL string `gorm:"column:webhook_url;type:varchar(2048)"`
+	// Secret defines the key which signs the notification, it is the hash of the secret given by the bucket owner
+	Secret string `gorm:"column:secret;type:varchar(256)"`
+	// Payload defines the json encoded notification which is posted to the webhook
+	Payload string `gorm:"column:payload;type:text"`
+	// Attempts defines the number of the failed deliveries
+	Attempts uint32 `gorm:"column:attempts"`
+	// NextRetryTime defines the unix time after which the notification is delivered
+	NextRetryTime int64 `gorm:"column:next_retry_time;type:bigint(64);index:idx_next_retry_time"`
+	// LastError defines the error of the last failed delivery
+	LastError string `gorm:"column:last_error;type:varchar(1024)"`
+	// CreateTime defines the unix time when the notification is queued
+	CreateTime int64 `gorm:"column:create_time;type:bigint(64)"`
+}
+
+// TableName is used to set Notification table name in database
+func (*Notification) TableName() string {
+	return NotificationTableName
+}
+
+// NotificationDeadLetter defines a notification which failed to be delivered after the max attempts
+type NotificationDeadLetter struct {
+	// ID defines db auto_increment id of the dead letter
+	ID uint64 `gorm:"column:id;primaryKey"`
+	// NotificationID defines the unique identification of the notification
+	NotificationID string `gorm:"column:notification_id;type:varchar(66)"`
+	// BucketName defines the name of the bucket which the event belongs to
+	BucketName string `gorm:"column:bucket_name;type:varchar(64);index:idx_bucket_name"`
+	// RuleID defines the rule which matches the event
+	RuleID string `gorm:"column:rule_id;type:varchar(64)"`
+	// EventType defines the type of the event
+	EventType string `gorm:"column:event_type;type:varchar(64)"`
+	// Height defines the block number of the event
+	Height int64 `gorm:"column:height;type:bigint(64)"`
+	// WebhookURL defines the url which the notification failed to be posted to
+	WebhookURL string `gorm:"column:webhook_url;type:varchar(2048)"`
+	// Payload defines the json encoded notification
+	Payload string `gorm:"column:payload;type:text"`
+	// Attempts defines the number of the failed deliveries
+	Attempts uint32 `gorm:"column:attempts"`
+	// LastError defines the error of the last failed delivery
+	LastError string `gorm:"column:last_error;type:varchar(1024)"`
+	// CreateTime defines the unix time when the notification is queued
+	CreateTime int64 `gorm:"column:create_time;type:bigint(64)"`
+	// FailedTime defines the unix time when the notification is moved to the dead letters
+	FailedTime int64 `gorm:"column:failed_time;type:bigint(64)"`
+}
+
+// TableName is used to set NotificationDeadLetter table name in database
+func (*NotificationDeadLetter) TableName() string {
+	return NotificationDeadLetterTableName
+}
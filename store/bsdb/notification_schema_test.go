@@ -0,0 +1,25 @@
+package bsdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationRule_TableName(t *testing.T) {
+	rule := NotificationRule{}
+	name := rule.TableName()
+	assert.Equal(t, NotificationRuleTableName, name)
+}
+
+func TestNotification_TableName(t *testing.T) {
+	notification := Notification{}
+	name := notification.TableName()
+	assert.Equal(t, NotificationTableName, name)
+}
+
+func TestNotificationDeadLetter_TableName(t *testing.T) {
+	deadLetter := NotificationDeadLetter{}
+	name := deadLetter.TableName()
+	assert.Equal(t, NotificationDeadLetterTableName, name)
+}
@@ -0,0 +1,74 @@
+package bsdb
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidNotificationEvent(t *testing.T) {
+	assert.True(t, IsValidNotificationEvent(NotificationObjectSealed))
+	assert.True(t, IsValidNotificationEvent("ObjectCreated:*"))
+	assert.True(t, IsValidNotificationEvent("Bucket*"))
+	assert.False(t, IsValidNotificationEvent("*"))
+	assert.False(t, IsValidNotificationEvent("ObjectCreated"))
+	assert.False(t, IsValidNotificationEvent("PolicyUpdated:*"))
+}
+
+func TestHashNotificationSecret(t *testing.T) {
+	assert.Equal(t, "2bb80d537b1da3e38bd30361aa855686bde0eacd7162fef6a25fe97bf527a25b", HashNotificationSecret("secret"))
+}
+
+func TestNotificationRule_Match(t *testing.T) {
+	rule := &NotificationRule{Events: "ObjectCreated:*,ObjectRemoved:Delete", Prefix: "images/", Suffix: ".jpg"}
+	assert.True(t, rule.Match(NotificationObjectSealed, "images/a.jpg"))
+	assert.True(t, rule.Match(NotificationObjectDeleted, "images/a.jpg"))
+	assert.False(t, rule.Match(NotificationObjectCreateCancel, "images/a.jpg"))
+	assert.False(t, rule.Match(NotificationObjectSealed, "docs/a.jpg"))
+	assert.False(t, rule.Match(NotificationObjectSealed, "images/a.png"))
+	assert.False(t, rule.Match(NotificationObjectSealed, ""))
+
+	rule = &NotificationRule{Events: NotificationBucketDeleted}
+	assert.True(t, rule.Match(NotificationBucketDeleted, ""))
+	assert.False(t, rule.Match(NotificationBucketCreated, ""))
+}
+
+func TestBsDBImpl_ListNotificationRules(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery("SELECT * FROM `notification_rules` WHERE bucket_name = ? ORDER BY rule_id").
+		WithArgs("test-bucket").
+		WillReturnRows(sqlmock.NewRows([]string{"bucket_name", "rule_id", "events"}).
+			AddRow("test-bucket", "rule-1", NotificationObjectSealed))
+
+	rules, err := s.ListNotificationRules("test-bucket")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(rules))
+	assert.Equal(t, "rule-1", rules[0].RuleID)
+}
+
+func TestBsDBImpl_PutNotificationRules(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `notification_rules` WHERE bucket_name = ?").
+		WithArgs("test-bucket").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO `notification_rules` (`bucket_name`,`rule_id`,`bucket_id`,`events`,`prefix`,`suffix`,`webhook_url`,`secret`,`create_time`) VALUES (?,?,?,?,?,?,?,?,?)").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err := s.PutNotificationRules("test-bucket", []*NotificationRule{{BucketName: "test-bucket", RuleID: "rule-1"}})
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestBsDBImpl_PutNotificationRulesEmpty(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `notification_rules` WHERE bucket_name = ?").
+		WithArgs("test-bucket").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := s.PutNotificationRules("test-bucket", nil)
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
@@ -84,6 +84,36 @@ func (b *BsDBImpl) ListObjectsByBucketName(bucketName, continuationToken, prefix
 	return results, err
 }
 
+// SearchObjects searches the objects of the bucket with the given filters, the objects are ordered by object name and
+// paginated by the index of bucket name and object name
+func (b *BsDBImpl) SearchObjects(bucketName, startAfter string, limit int, filters ...func(*gorm.DB) *gorm.DB) ([]*Object, error) {
+	var (
+		err     error
+		objects []*Object
+	)
+	startTime := time.Now()
+	methodName := currentFunction()
+	defer func() {
+		if err != nil {
+			MetadataDatabaseFailureMetrics(err, startTime, methodName)
+		} else {
+			MetadataDatabaseSuccessMetrics(startTime, methodName)
+		}
+	}()
+
+	if startAfter != "" {
+		filters = append(filters, ObjectNameStartAfterFilter(startAfter))
+	}
+	err = b.db.Table(GetObjectsTableName(bucketName)).
+		Select("*").
+		Where("bucket_name = ? and removed = false", bucketName).
+		Scopes(filters...).
+		Limit(limit).
+		Order("object_name asc").
+		Find(&objects).Error
+	return objects, err
+}
+
 type ByUpdateAtAndObjectID []*Object
 
 func (a ByUpdateAtAndObjectID) Len() int { return len(a) }
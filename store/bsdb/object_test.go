@@ -1,8 +1,10 @@
 package bsdb
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -10,3 +12,31 @@ func Test_GetObjectsTableName(t *testing.T) {
 	objectTableName := GetObjectsTableName("ot005test-bucket")
 	assert.Equal(t, "objects_62", objectTableName)
 }
+
+func TestBsDBImpl_SearchObjects(t *testing.T) {
+	db, mock := setupDB(t)
+	bucketName := "ot005test-bucket"
+
+	expectedSQL := "SELECT * FROM `objects_62` WHERE (bucket_name = ? and removed = false) AND payload_size >= ? AND " +
+		"content_type LIKE ? AND object_name > ? ORDER BY object_name asc LIMIT 11"
+	mock.ExpectQuery(expectedSQL).WithArgs(bucketName, uint64(1024), "video/%", "a.mp4").
+		WillReturnRows(sqlmock.NewRows([]string{"object_name", "payload_size"}).AddRow("b.mp4", 2048))
+
+	objects, err := db.SearchObjects(bucketName, "a.mp4", 11, MinPayloadSizeFilter(1024), ContentTypeFilter("video/*"))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(objects))
+	assert.Equal(t, "b.mp4", objects[0].ObjectName)
+	assert.Equal(t, uint64(2048), objects[0].PayloadSize)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBsDBImpl_SearchObjectsWithError(t *testing.T) {
+	db, mock := setupDB(t)
+
+	expectedSQL := "SELECT * FROM `objects_62` WHERE bucket_name = ? and removed = false ORDER BY object_name asc LIMIT 11"
+	mock.ExpectQuery(expectedSQL).WithArgs("ot005test-bucket").WillReturnError(errors.New("mock error"))
+
+	_, err := db.SearchObjects("ot005test-bucket", "", 11)
+	assert.Error(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,23 @@
+package bsdb
+
+// RollbackJournal records the rows which are changed by a statement of an indexed block and their pre-images,
+// the journals are replayed in reverse order to roll back the indexed blocks after a chain reorg
+type RollbackJournal struct {
+	// ID defines db auto_increment id of the journal
+	ID uint64 `gorm:"column:id;primaryKey"`
+	// Height defines the block number which changes the rows
+	Height int64 `gorm:"column:height;type:bigint(64);index:idx_height"`
+	// Table defines the name of the table which the rows belong to
+	Table string `gorm:"column:table_name;type:varchar(64)"`
+	// WhereClause defines the condition which selects the changed rows
+	WhereClause string `gorm:"column:where_clause;type:text"`
+	// Args defines the gob encoded arguments of the where clause
+	Args []byte `gorm:"column:args;type:blob"`
+	// PreImage defines the gob encoded rows selected by the where clause before the block is indexed
+	PreImage []byte `gorm:"column:pre_image;type:longblob"`
+}
+
+// TableName is used to set RollbackJournal table name in database
+func (*RollbackJournal) TableName() string {
+	return RollbackJournalTableName
+}
@@ -0,0 +1,13 @@
+package bsdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRollbackJournal_TableName(t *testing.T) {
+	journal := RollbackJournal{}
+	name := journal.TableName()
+	assert.Equal(t, RollbackJournalTableName, name)
+}
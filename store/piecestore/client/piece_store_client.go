@@ -54,6 +54,14 @@ func NewStoreClient(pieceConfig *storage.PieceStoreConfig) (*StoreClient, error)
 	return &StoreClient{ps: ps, name: pieceConfig.Store.Storage}, nil
 }
 
+// Close stops the background routines of the piece store.
+func (client *StoreClient) Close() error {
+	if closer, ok := client.ps.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
 // GetPiece gets piece data from piece store.
 func (client *StoreClient) GetPiece(ctx context.Context, key string, offset, limit int64) (data []byte, err error) {
 	startTime := time.Now()
@@ -74,6 +82,7 @@ func (client *StoreClient) GetPiece(ctx context.Context, key string, offset, lim
 		log.Errorw("failed to get piece data from piece store", "piece_key", key, "error", err)
 		return nil, err
 	}
+	defer rc.Close()
 	buf := &bytes.Buffer{}
 	_, err = io.Copy(buf, rc)
 	if err != nil {
@@ -83,6 +92,29 @@ func (client *StoreClient) GetPiece(ctx context.Context, key string, offset, lim
 	return buf.Bytes(), nil
 }
 
+// GetPieceReader returns the reader of piece data from piece store, the data is not buffered in memory.
+func (client *StoreClient) GetPieceReader(ctx context.Context, key string, offset, limit int64) (rc io.ReadCloser, err error) {
+	startTime := time.Now()
+	defer func() {
+		if err != nil {
+			metrics.PieceStoreCounter.WithLabelValues(PieceStoreFailureGet).Inc()
+			metrics.PieceStoreTime.WithLabelValues(PieceStoreFailureGet).Observe(
+				time.Since(startTime).Seconds())
+			return
+		}
+		metrics.PieceStoreCounter.WithLabelValues(PieceStoreSuccessGet).Inc()
+		metrics.PieceStoreTime.WithLabelValues(PieceStoreSuccessGet).Observe(
+			time.Since(startTime).Seconds())
+	}()
+
+	rc, err = client.ps.Get(ctx, key, offset, limit)
+	if err != nil {
+		log.Errorw("failed to get piece reader from piece store", "piece_key", key, "error", err)
+		return nil, err
+	}
+	return rc, nil
+}
+
 // PutPiece puts piece to piece store.
 func (client *StoreClient) PutPiece(ctx context.Context, key string, value []byte) error {
 	var (
@@ -107,6 +107,35 @@ func TestGetPieceFailedToGet(t *testing.T) {
 	assert.Equal(t, errors.New("invalid key"), err)
 }
 
+func TestGetPieceReader(t *testing.T) {
+	cfg := &storage.PieceStoreConfig{
+		Shards: 0,
+		Store: storage.ObjectStorageConfig{
+			Storage:   storage.MemoryStore,
+			BucketURL: "mock",
+			IAMType:   storage.AKSKIAMType,
+		},
+	}
+	client, err := NewStoreClient(cfg)
+	assert.Nil(t, err)
+	ctrl := gomock.NewController(t)
+	p := piece.NewMockPieceAPI(ctrl)
+	p.EXPECT().Get(gomock.Any(), "mock", int64(0), int64(0)).Return(io.NopCloser(strings.NewReader("golang")), nil).Times(1)
+	p.EXPECT().Get(gomock.Any(), "invalid", int64(0), int64(0)).Return(nil, errors.New("invalid key")).Times(1)
+	client.ps = p
+
+	rc, err := client.GetPieceReader(context.Background(), "mock", 0, 0)
+	assert.Nil(t, err)
+	data, err := io.ReadAll(rc)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("golang"), data)
+	assert.Nil(t, rc.Close())
+
+	rc, err = client.GetPieceReader(context.Background(), "invalid", 0, 0)
+	assert.Nil(t, rc)
+	assert.Equal(t, errors.New("invalid key"), err)
+}
+
 func TestPutPieceSuccessfully(t *testing.T) {
 	cfg := &storage.PieceStoreConfig{
 		Shards: 0,
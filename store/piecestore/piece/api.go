@@ -2,6 +2,7 @@ package piece
 
 import (
 	"context"
+	"errors"
 	"io"
 
 	"github.com/bnb-chain/greenfield-storage-provider/store/piecestore/storage"
@@ -21,6 +22,11 @@ type PieceStore struct {
 	storeAPI storage.ObjectStorage
 }
 
+// Close stops the background routines of the PieceStore
+func (p *PieceStore) Close() error {
+	return storage.CloseObjectStorage(p.storeAPI)
+}
+
 // Get one piece from PieceStore
 func (p *PieceStore) Get(ctx context.Context, key string, offset, limit int64) (io.ReadCloser, error) {
 	return p.storeAPI.GetObject(ctx, key, offset, limit)
@@ -41,6 +47,28 @@ func (p *PieceStore) DeleteByPrefix(ctx context.Context, key string) (uint64, er
 	return p.storeAPI.DeleteObjectsByPrefix(ctx, key)
 }
 
+// Rebalance moves the pieces to the shard picked by the current shard layout and returns the number of
+// moved pieces, it is run by the piecestore.rebalance command instead of the SP services, so that only
+// one process moves the pieces.
+func (p *PieceStore) Rebalance(ctx context.Context) (uint64, error) {
+	r, ok := p.storeAPI.(storage.Rebalancer)
+	if !ok {
+		return 0, errors.New("piece store is not sharded")
+	}
+	return r.Rebalance(ctx)
+}
+
+// RotateKeys re-wraps the data keys of pieces by the active master key and encrypts the plaintext pieces,
+// and returns the number of rotated pieces, it is run by the piecestore.rotate.keys command instead of the
+// SP services, so that only one process rewrites the pieces.
+func (p *PieceStore) RotateKeys(ctx context.Context) (uint64, error) {
+	r, ok := p.storeAPI.(storage.KeyRotator)
+	if !ok {
+		return 0, errors.New("piece store is not encrypted")
+	}
+	return r.RotateKeys(ctx)
+}
+
 // Head returns piece info in PieceStore
 func (p *PieceStore) Head(ctx context.Context, key string) (storage.Object, error) {
 	return p.storeAPI.HeadObject(ctx, key)
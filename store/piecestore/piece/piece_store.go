@@ -25,9 +25,8 @@ func NewPieceStore(pieceConfig *storage.PieceStoreConfig) (*PieceStore, error) {
 		return nil, err
 	}
 	log.Infow("piece store is running", "storage type", pieceConfig.Store.Storage,
-		"shards", pieceConfig.Shards)
-
-	return &PieceStore{blob}, nil
+		"shards", pieceConfig.Shards, "tiered", pieceConfig.Tiered.Enable, "encrypted", pieceConfig.Encryption.Enable)
+	return &PieceStore{storeAPI: blob}, nil
 }
 
 // checkConfig checks config if right
@@ -36,6 +35,9 @@ func checkConfig(cfg *storage.PieceStoreConfig) error {
 	if cfg.Shards > 256 {
 		return fmt.Errorf("too many shards: %d", cfg.Shards)
 	}
+	if cfg.ShardRing.PreviousShards > 256 {
+		return fmt.Errorf("too many previous shards: %d", cfg.ShardRing.PreviousShards)
+	}
 	if cfg.Store.IAMType != storage.AKSKIAMType && cfg.Store.IAMType != storage.SAIAMType {
 		return fmt.Errorf("invalid iam type: %s", cfg.Store.IAMType)
 	}
@@ -57,6 +59,14 @@ func checkConfig(cfg *storage.PieceStoreConfig) error {
 		cfg.Store.BucketURL = p
 		cfg.Store.BucketURL += "/"
 	}
+	if cfg.Tiered.Enable && cfg.Tiered.Store.Storage == storage.DiskFileStore {
+		p, err := filepath.Abs(cfg.Tiered.Store.BucketURL)
+		if err != nil {
+			log.Errorw("failed to get absolute path", "bucket", cfg.Tiered.Store.BucketURL, "error", err)
+			return err
+		}
+		cfg.Tiered.Store.BucketURL = p + "/"
+	}
 	return nil
 }
 
@@ -71,7 +81,9 @@ func createStorage(cfg storage.PieceStoreConfig) (storage.ObjectStorage, error)
 		object storage.ObjectStorage
 		err    error
 	)
-	if cfg.Shards > 1 {
+	if cfg.Tiered.Enable {
+		object, err = storage.NewTiered(cfg)
+	} else if cfg.Shards > 1 || cfg.ShardRing.PreviousShards > 1 {
 		object, err = storage.NewSharded(cfg)
 	} else {
 		object, err = storage.NewObjectStorage(cfg.Store)
@@ -80,6 +92,12 @@ func createStorage(cfg storage.PieceStoreConfig) (storage.ObjectStorage, error)
 		log.Errorw("failed to create storage", "error", err, "object", object)
 		return nil, err
 	}
+	if cfg.Encryption.Enable {
+		if object, err = storage.NewEncrypted(cfg.Encryption, object); err != nil {
+			log.Errorw("failed to create encrypted storage", "error", err)
+			return nil, err
+		}
+	}
 
 	if err = checkBucket(context.Background(), object); err != nil {
 		log.Errorw("failed to check bucket due to storage is not configured rightly ", "error", err,
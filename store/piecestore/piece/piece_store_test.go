@@ -199,6 +199,22 @@ func Test_createStorage(t *testing.T) {
 			wantedIsErr: true,
 			wantedErr:   errors.New("can not generate different endpoint using [mock]"),
 		},
+		{
+			name: "encryption with invalid key provider",
+			cfg: storage.PieceStoreConfig{
+				Store: storage.ObjectStorageConfig{
+					Storage:   storage.MemoryStore,
+					BucketURL: "mock",
+					IAMType:   storage.AKSKIAMType,
+				},
+				Encryption: storage.EncryptionConfig{
+					Enable:      true,
+					KeyProvider: "unknown",
+				},
+			},
+			wantedIsErr: true,
+			wantedErr:   errors.New("invalid master key provider: unknown"),
+		},
 	}
 	for _, tt := range cases {
 		t.Run(tt.name, func(t *testing.T) {
@@ -33,7 +33,10 @@ func newB2Store(cfg ObjectStorageConfig) (ObjectStorage, error) {
 		return nil, err
 	}
 	log.Infow("new b2 store succeeds", "bucket", bucket)
-	return &b2Store{s3Store{bucketName: bucket, api: s3.New(b2Session)}}, nil
+	store := &b2Store{s3Store{bucketName: bucket, api: s3.New(b2Session)}}
+	store.multipart = newMultipartUploader(cfg, &store.s3Store)
+	store.multipart.startAbortOrphanedUploads()
+	return store, nil
 }
 
 func (sc *SessionCache) newB2Session(cfg ObjectStorageConfig) (*session.Session, string, error) {
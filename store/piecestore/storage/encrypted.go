@@ -0,0 +1,618 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+)
+
+const (
+	// encryptionChunkSize the plaintext size of each encrypted chunk, range reads only decrypt the
+	// chunks which overlap the range
+	encryptionChunkSize = 64 * 1024
+	// encryptionHeaderSize the fixed size of encrypted object header
+	encryptionHeaderSize = 512
+	// encryptionVersion the version of encrypted object layout
+	encryptionVersion = 1
+	// dataKeySize the size of AES-256 data key
+	dataKeySize = 32
+	// gcmTagSize the size of AES-GCM authentication tag appended to each chunk
+	gcmTagSize = 16
+	// encryptedChunkSize the ciphertext size of each full chunk
+	encryptedChunkSize = encryptionChunkSize + gcmTagSize
+	// maxWrappedKeyLength the max length of wrapped data key recorded in the header
+	maxWrappedKeyLength = 360
+	// maxDataKeyCacheSize the max number of unwrapped data keys cached in memory
+	maxDataKeyCacheSize = 4096
+	// rotateBatchSize the number of objects listed in each batch of key rotation
+	rotateBatchSize = 1000
+	// encryptionMarkerKey the marker which records that every object has been encrypted by key rotation,
+	// the objects without valid header are not read as plaintext once it exists
+	encryptionMarkerKey = ".encryption/complete"
+)
+
+var encryptionMagic = []byte("GFEN")
+
+// KeyRotator is implemented by the object storage which can re-wrap the data keys of existing objects.
+type KeyRotator interface {
+	// RotateKeys re-wraps the data keys which are not wrapped by the active master key and encrypts the
+	// objects written before encryption is enabled, and returns the number of rotated objects. It must
+	// only be run by one process at a time, which is the piecestore.rotate.keys command.
+	RotateKeys(ctx context.Context) (uint64, error)
+}
+
+var (
+	_ ObjectStorage = &encrypted{}
+	_ Rebalancer    = &encrypted{}
+	_ KeyRotator    = &encrypted{}
+)
+
+// encrypted encrypts the objects before they are written to the underlying object storage by envelope
+// encryption. Each object is encrypted by a random AES-256-GCM data key which is wrapped by the master
+// key, and the wrapped data key is stored in a fixed-size header in front of the ciphertext. The
+// plaintext is split into chunks which are sealed separately, so range reads only fetch and decrypt the
+// chunks which overlap the range. Both the wrapped data key and the chunks are bound to the object key,
+// so the ciphertext of one object can not be served as another.
+//
+// The objects written before encryption is enabled are read as plaintext until key rotation records that
+// every object is encrypted. Until then, an object is plaintext if it has no valid header or if its data
+// key is not authenticated by the master key with the object key, so a plaintext object which happens to
+// start with the magic is still readable. Afterwards, every object must be encrypted.
+//
+// Layout of an encrypted object:
+//
+//	header: magic(4) | version(1) | key id length(1) | key id | wrapped key length(2) | wrapped key | plaintext size(8), padded to 512 bytes
+//	chunks: AES-GCM(chunk 0) | AES-GCM(chunk 1) | ... each chunk is 64KB plaintext with a 16 bytes tag
+type encrypted struct {
+	store    ObjectStorage
+	provider MasterKeyProvider
+	// strict whether every object is known to be encrypted, it is loaded from encryptionMarkerKey
+	strict atomic.Bool
+
+	mu       sync.Mutex
+	dataKeys map[string][]byte
+}
+
+type encryptionHeader struct {
+	keyID      string
+	wrappedKey []byte
+	size       int64
+}
+
+// NewEncrypted returns an object storage which encrypts the objects of store by the master keys of the
+// provider specified by cfg.
+func NewEncrypted(cfg EncryptionConfig, store ObjectStorage) (ObjectStorage, error) {
+	provider, err := NewMasterKeyProvider(cfg)
+	if err != nil {
+		return nil, err
+	}
+	e := newEncrypted(store, provider)
+	e.loadStrict(context.Background())
+	return e, nil
+}
+
+func newEncrypted(store ObjectStorage, provider MasterKeyProvider) *encrypted {
+	return &encrypted{store: store, provider: provider, dataKeys: make(map[string][]byte)}
+}
+
+// loadStrict stops reading the objects without valid header as plaintext if the key rotation has
+// encrypted every object, it stays compatible with plaintext objects if the marker can not be read.
+func (e *encrypted) loadStrict(ctx context.Context) {
+	_, err := e.store.HeadObject(ctx, encryptionMarkerKey)
+	if err == nil {
+		e.strict.Store(true)
+		return
+	}
+	if !IsErrNoSuchObject(err) {
+		log.Warnw("failed to read encryption marker", "marker", encryptionMarkerKey, "error", err)
+	}
+}
+
+func (e *encrypted) String() string {
+	return fmt.Sprintf("encrypted://%s", e.store)
+}
+
+func (e *encrypted) Close() error {
+	return CloseObjectStorage(e.store)
+}
+
+func (e *encrypted) CreateBucket(ctx context.Context) error {
+	return e.store.CreateBucket(ctx)
+}
+
+func (e *encrypted) GetObject(ctx context.Context, key string, offset, limit int64) (io.ReadCloser, error) {
+	if offset < 0 {
+		offset = 0
+	}
+	first := offset / encryptionChunkSize
+	var (
+		header *encryptionHeader
+		body   []byte
+		err    error
+	)
+	if first == 0 {
+		// read the header and the chunks in one request
+		readLimit := int64(-1)
+		if limit > 0 {
+			readLimit = encryptionHeaderSize + chunkCount(offset+limit)*encryptedChunkSize
+		}
+		data, err := e.readAll(ctx, key, 0, readLimit)
+		if err != nil {
+			return nil, err
+		}
+		if header, err = e.resolveHeader(ctx, key, data); err != nil {
+			if errors.Is(err, errNotEncrypted) {
+				return e.store.GetObject(ctx, key, offset, limit)
+			}
+			return nil, err
+		}
+		body = data[encryptionHeaderSize:]
+	} else {
+		if header, err = e.readHeader(ctx, key); err != nil {
+			if errors.Is(err, errNotEncrypted) {
+				return e.store.GetObject(ctx, key, offset, limit)
+			}
+			return nil, err
+		}
+		if offset >= header.size {
+			return io.NopCloser(bytes.NewReader(nil)), nil
+		}
+		readLimit := int64(-1)
+		if limit > 0 {
+			readLimit = (chunkCount(offset+limit) - first) * encryptedChunkSize
+		}
+		if body, err = e.readAll(ctx, key, encryptionHeaderSize+first*encryptedChunkSize, readLimit); err != nil {
+			return nil, err
+		}
+	}
+
+	aead, err := e.dataKeyAEAD(ctx, key, header)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := decryptChunks(aead, key, body, first, header.size)
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to decrypt object", "key", key, "error", err)
+		return nil, err
+	}
+	end := header.size
+	if limit > 0 && chunkCount(offset+limit)*encryptionChunkSize < end {
+		end = chunkCount(offset+limit) * encryptionChunkSize
+	}
+	if expected := end - first*encryptionChunkSize; expected > 0 && int64(len(plaintext)) != expected {
+		log.CtxErrorw(ctx, "found truncated encrypted object", "key", key, "expected", expected, "actual", len(plaintext))
+		return nil, fmt.Errorf("truncated encrypted object: %s", key)
+	}
+	start := offset - first*encryptionChunkSize
+	if start > int64(len(plaintext)) {
+		start = int64(len(plaintext))
+	}
+	plaintext = plaintext[start:]
+	if limit > 0 && limit < int64(len(plaintext)) {
+		plaintext = plaintext[:limit]
+	}
+	return io.NopCloser(bytes.NewReader(plaintext)), nil
+}
+
+func (e *encrypted) PutObject(ctx context.Context, key string, reader io.Reader) error {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	ciphertext, err := e.encrypt(ctx, key, data)
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to encrypt object", "key", key, "error", err)
+		return err
+	}
+	return e.store.PutObject(ctx, key, bytes.NewReader(ciphertext))
+}
+
+func (e *encrypted) DeleteObject(ctx context.Context, key string) error {
+	return e.store.DeleteObject(ctx, key)
+}
+
+func (e *encrypted) DeleteObjectsByPrefix(ctx context.Context, key string) (uint64, error) {
+	return e.store.DeleteObjectsByPrefix(ctx, key)
+}
+
+func (e *encrypted) HeadBucket(ctx context.Context) error {
+	return e.store.HeadBucket(ctx)
+}
+
+// HeadObject returns the plaintext size recorded in the header.
+func (e *encrypted) HeadObject(ctx context.Context, key string) (Object, error) {
+	obj, err := e.store.HeadObject(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	header, err := e.readHeader(ctx, key)
+	if err != nil {
+		if errors.Is(err, errNotEncrypted) {
+			return obj, nil
+		}
+		return nil, err
+	}
+	return &encryptedObject{Object: obj, size: header.size}, nil
+}
+
+// ListObjects returns the plaintext sizes which are computed from the ciphertext sizes without reading
+// the headers, the sizes of objects written before encryption is enabled are not accurate.
+func (e *encrypted) ListObjects(ctx context.Context, prefix, marker, delimiter string, limit int64) ([]Object, error) {
+	objs, err := e.store.ListObjects(ctx, prefix, marker, delimiter, limit)
+	if err != nil {
+		return nil, err
+	}
+	for i, obj := range objs {
+		if !obj.IsSymlink() && obj.Size() >= encryptionHeaderSize+gcmTagSize {
+			objs[i] = &encryptedObject{Object: obj, size: plaintextSize(obj.Size())}
+		}
+	}
+	return objs, nil
+}
+
+func (e *encrypted) ListAllObjects(ctx context.Context, prefix, marker string) (<-chan Object, error) {
+	return e.store.ListAllObjects(ctx, prefix, marker)
+}
+
+// Rebalance rebalances the underlying object storage if it is sharded.
+func (e *encrypted) Rebalance(ctx context.Context) (uint64, error) {
+	if r, ok := e.store.(Rebalancer); ok {
+		return r.Rebalance(ctx)
+	}
+	return 0, nil
+}
+
+// RotateKeys only rewrites the header of the objects encrypted by the old master keys, the ciphertext
+// of chunks is not changed. Once no object is left in plaintext, the completion is recorded by
+// encryptionMarkerKey and the objects without valid header are no longer read as plaintext.
+func (e *encrypted) RotateKeys(ctx context.Context) (uint64, error) {
+	var (
+		rotated uint64
+		failed  uint64
+		marker  string
+		active  = e.provider.ActiveKeyID()
+	)
+	for {
+		objs, err := e.store.ListObjects(ctx, "", marker, "", rotateBatchSize)
+		if err != nil {
+			log.Errorw("failed to list objects for key rotation", "error", err)
+			return rotated, err
+		}
+		for _, obj := range objs {
+			if obj.IsSymlink() || isStorageMarker(obj.Key()) {
+				continue
+			}
+			ok, err := e.rotate(ctx, obj.Key(), active)
+			if err != nil {
+				if ctx.Err() != nil {
+					return rotated, ctx.Err()
+				}
+				log.Errorw("failed to rotate key of object", "key", obj.Key(), "error", err)
+				failed++
+				continue
+			}
+			if ok {
+				rotated++
+			}
+		}
+		if len(objs) < rotateBatchSize {
+			break
+		}
+		marker = objs[len(objs)-1].Key()
+	}
+	if failed > 0 {
+		return rotated, fmt.Errorf("failed to rotate keys of %d objects", failed)
+	}
+	if !e.strict.Load() {
+		if err := e.store.PutObject(ctx, encryptionMarkerKey, strings.NewReader(active)); err != nil {
+			log.Errorw("failed to persist encryption marker", "marker", encryptionMarkerKey, "error", err)
+			return rotated, err
+		}
+		e.strict.Store(true)
+	}
+	return rotated, nil
+}
+
+// rotate rewrites the object wrapped by the active master key, the write is skipped if the object has been
+// deleted or overwritten since it is read. The check and the write are not atomic with the deletes of SP
+// services, so a piece which is deleted by GC right between them is written back as an orphan.
+func (e *encrypted) rotate(ctx context.Context, key, active string) (bool, error) {
+	read, err := e.store.HeadObject(ctx, key)
+	if err != nil {
+		if IsErrNoSuchObject(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	header, err := e.readHeader(ctx, key)
+	if err != nil && !errors.Is(err, errNotEncrypted) {
+		return false, err
+	}
+	if header != nil && header.keyID == active {
+		return false, nil
+	}
+	data, err := e.readAll(ctx, key, 0, -1)
+	if err != nil {
+		return false, err
+	}
+	if header == nil {
+		// the object is written before encryption is enabled
+		if data, err = e.encrypt(ctx, key, data); err != nil {
+			return false, err
+		}
+	} else {
+		if header, err = e.resolveHeader(ctx, key, data); err != nil {
+			return false, err
+		}
+		dataKey, err := e.unwrapDataKey(ctx, key, header)
+		if err != nil {
+			return false, err
+		}
+		wrappedKey, err := e.provider.WrapKey(ctx, active, dataKey, []byte(key))
+		if err != nil {
+			return false, err
+		}
+		newHeader, err := encodeEncryptionHeader(&encryptionHeader{keyID: active, wrappedKey: wrappedKey, size: header.size})
+		if err != nil {
+			return false, err
+		}
+		copy(data, newHeader)
+	}
+
+	current, err := e.store.HeadObject(ctx, key)
+	if err != nil {
+		if IsErrNoSuchObject(err) {
+			log.Infow("skip rotating key of deleted object", "key", key)
+			return false, nil
+		}
+		return false, err
+	}
+	if current.Size() != read.Size() || !current.ModTime().Equal(read.ModTime()) {
+		log.Infow("skip rotating key of changed object", "key", key)
+		return false, nil
+	}
+	return true, e.store.PutObject(ctx, key, bytes.NewReader(data))
+}
+
+func (e *encrypted) encrypt(ctx context.Context, key string, data []byte) ([]byte, error) {
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+	keyID := e.provider.ActiveKeyID()
+	wrappedKey, err := e.provider.WrapKey(ctx, keyID, dataKey, []byte(key))
+	if err != nil {
+		return nil, err
+	}
+	header := &encryptionHeader{keyID: keyID, wrappedKey: wrappedKey, size: int64(len(data))}
+	headerBytes, err := encodeEncryptionHeader(header)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	e.cacheDataKey(key, header, dataKey)
+
+	n := chunkCount(int64(len(data)))
+	ciphertext := make([]byte, 0, encryptionHeaderSize+int64(len(data))+n*gcmTagSize)
+	ciphertext = append(ciphertext, headerBytes...)
+	for i := int64(0); i < n; i++ {
+		start := i * encryptionChunkSize
+		end := start + encryptionChunkSize
+		if end > int64(len(data)) {
+			end = int64(len(data))
+		}
+		ciphertext = aead.Seal(ciphertext, chunkNonce(aead, i), data[start:end], chunkAdditionalData(key, i, i == n-1))
+	}
+	return ciphertext, nil
+}
+
+func (e *encrypted) readHeader(ctx context.Context, key string) (*encryptionHeader, error) {
+	data, err := e.readAll(ctx, key, 0, encryptionHeaderSize)
+	if err != nil {
+		return nil, err
+	}
+	return e.resolveHeader(ctx, key, data)
+}
+
+// resolveHeader parses the header of the object and authenticates its data key by the master key with the
+// object key. errNotEncrypted is returned for the objects written before encryption is enabled, which are
+// only accepted until the key rotation has encrypted every object.
+func (e *encrypted) resolveHeader(ctx context.Context, key string, data []byte) (*encryptionHeader, error) {
+	header, err := parseEncryptionHeader(data)
+	if err == nil {
+		if _, err = e.unwrapDataKey(ctx, key, header); err == nil {
+			return header, nil
+		}
+	}
+	if e.strict.Load() {
+		if errors.Is(err, errNotEncrypted) {
+			return nil, fmt.Errorf("missing encryption header of object: %s", key)
+		}
+		return nil, err
+	}
+	// a plaintext object may start with the magic, but it has no data key wrapped for the object key
+	if header == nil || errors.Is(err, errDataKeyMismatch) {
+		return nil, errNotEncrypted
+	}
+	return nil, err
+}
+
+func (e *encrypted) readAll(ctx context.Context, key string, offset, limit int64) ([]byte, error) {
+	rc, err := e.store.GetObject(ctx, key, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
+
+func (e *encrypted) dataKeyAEAD(ctx context.Context, key string, header *encryptionHeader) (cipher.AEAD, error) {
+	dataKey, err := e.unwrapDataKey(ctx, key, header)
+	if err != nil {
+		return nil, err
+	}
+	return newAEAD(dataKey)
+}
+
+// unwrapDataKey unwraps the data key by the master key provider, the unwrapped data keys are cached to
+// avoid calling the provider for every read of hot objects.
+func (e *encrypted) unwrapDataKey(ctx context.Context, key string, header *encryptionHeader) ([]byte, error) {
+	cacheKey := dataKeyCacheKey(key, header)
+	e.mu.Lock()
+	dataKey, ok := e.dataKeys[cacheKey]
+	e.mu.Unlock()
+	if ok {
+		return dataKey, nil
+	}
+	dataKey, err := e.provider.UnwrapKey(ctx, header.keyID, header.wrappedKey, []byte(key))
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to unwrap data key", "key", key, "key_id", header.keyID, "error", err)
+		return nil, err
+	}
+	if len(dataKey) != dataKeySize {
+		return nil, fmt.Errorf("invalid data key size: %d", len(dataKey))
+	}
+	e.cacheDataKey(key, header, dataKey)
+	return dataKey, nil
+}
+
+func (e *encrypted) cacheDataKey(key string, header *encryptionHeader, dataKey []byte) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if len(e.dataKeys) >= maxDataKeyCacheSize {
+		e.dataKeys = make(map[string][]byte)
+	}
+	e.dataKeys[dataKeyCacheKey(key, header)] = dataKey
+}
+
+// dataKeyCacheKey includes the object key, since the data key is only authenticated for the object
+// which it is wrapped for.
+func dataKeyCacheKey(key string, header *encryptionHeader) string {
+	return key + "/" + header.keyID + "/" + string(header.wrappedKey)
+}
+
+// isStorageMarker returns whether the key is a marker of piece store maintenance rather than a piece.
+func isStorageMarker(key string) bool {
+	return key == encryptionMarkerKey || strings.HasPrefix(key, rebalanceMarkerPrefix)
+}
+
+var errNotEncrypted = errors.New("object is not encrypted")
+
+func parseEncryptionHeader(data []byte) (*encryptionHeader, error) {
+	if len(data) < encryptionHeaderSize || !bytes.Equal(data[:len(encryptionMagic)], encryptionMagic) {
+		return nil, errNotEncrypted
+	}
+	if data[4] != encryptionVersion {
+		return nil, fmt.Errorf("unsupported encryption version: %d", data[4])
+	}
+	var (
+		pos      = 5
+		keyIDLen = int(data[pos])
+	)
+	pos++
+	if keyIDLen > maxMasterKeyIDLength {
+		return nil, fmt.Errorf("invalid master key id length: %d", keyIDLen)
+	}
+	header := &encryptionHeader{keyID: string(data[pos : pos+keyIDLen])}
+	pos += keyIDLen
+	wrappedLen := int(binary.BigEndian.Uint16(data[pos:]))
+	pos += 2
+	if wrappedLen > maxWrappedKeyLength {
+		return nil, fmt.Errorf("invalid wrapped key length: %d", wrappedLen)
+	}
+	header.wrappedKey = append([]byte{}, data[pos:pos+wrappedLen]...)
+	pos += wrappedLen
+	header.size = int64(binary.BigEndian.Uint64(data[pos:]))
+	return header, nil
+}
+
+func encodeEncryptionHeader(header *encryptionHeader) ([]byte, error) {
+	if len(header.keyID) > maxMasterKeyIDLength {
+		return nil, fmt.Errorf("invalid master key id length: %d", len(header.keyID))
+	}
+	if len(header.wrappedKey) > maxWrappedKeyLength {
+		return nil, fmt.Errorf("invalid wrapped key length: %d", len(header.wrappedKey))
+	}
+	data := make([]byte, 0, encryptionHeaderSize)
+	data = append(data, encryptionMagic...)
+	data = append(data, encryptionVersion, byte(len(header.keyID)))
+	data = append(data, header.keyID...)
+	data = binary.BigEndian.AppendUint16(data, uint16(len(header.wrappedKey)))
+	data = append(data, header.wrappedKey...)
+	data = binary.BigEndian.AppendUint64(data, uint64(header.size))
+	return data[:encryptionHeaderSize], nil
+}
+
+// decryptChunks decrypts the chunks starting from the first chunk, the last chunk of the object is
+// authenticated as the final chunk, so that truncated objects fail to decrypt.
+func decryptChunks(aead cipher.AEAD, key string, body []byte, first, size int64) ([]byte, error) {
+	last := chunkCount(size) - 1
+	plaintext := make([]byte, 0, len(body))
+	for idx := first; len(body) > 0; idx++ {
+		n := int64(len(body))
+		if n > encryptedChunkSize {
+			n = encryptedChunkSize
+		}
+		if idx > last {
+			return nil, errors.New("unexpected encrypted chunk")
+		}
+		var err error
+		plaintext, err = aead.Open(plaintext, chunkNonce(aead, idx), body[:n], chunkAdditionalData(key, idx, idx == last))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt chunk %d: %w", idx, err)
+		}
+		body = body[n:]
+	}
+	return plaintext, nil
+}
+
+// chunkNonce uses the chunk index as nonce, it is safe since each object has its own data key.
+func chunkNonce(aead cipher.AEAD, idx int64) []byte {
+	nonce := make([]byte, aead.NonceSize())
+	binary.BigEndian.PutUint64(nonce[len(nonce)-8:], uint64(idx))
+	return nonce
+}
+
+// chunkAdditionalData binds the chunk to its index, whether it is the final chunk and the object key.
+func chunkAdditionalData(key string, idx int64, final bool) []byte {
+	ad := binary.BigEndian.AppendUint64(make([]byte, 0, 9+len(key)), uint64(idx))
+	if final {
+		ad = append(ad, 1)
+	} else {
+		ad = append(ad, 0)
+	}
+	return append(ad, key...)
+}
+
+// chunkCount returns the number of chunks of the plaintext, an empty object has one empty chunk.
+func chunkCount(size int64) int64 {
+	if size <= 0 {
+		return 1
+	}
+	return (size + encryptionChunkSize - 1) / encryptionChunkSize
+}
+
+func plaintextSize(ciphertextSize int64) int64 {
+	body := ciphertextSize - encryptionHeaderSize
+	return body - (body+encryptedChunkSize-1)/encryptedChunkSize*gcmTagSize
+}
+
+type encryptedObject struct {
+	Object
+	size int64
+}
+
+func (o *encryptedObject) Size() int64 { return o.size }
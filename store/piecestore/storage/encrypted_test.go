@@ -0,0 +1,292 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeKeyFile(t *testing.T, active string, ids ...string) (string, map[string]string) {
+	t.Helper()
+	kf := keyFile{ActiveKeyID: active, Keys: make(map[string]string)}
+	for _, id := range ids {
+		key := make([]byte, dataKeySize)
+		_, _ = rand.Read(key)
+		kf.Keys[id] = base64.StdEncoding.EncodeToString(key)
+	}
+	return saveKeyFile(t, kf), kf.Keys
+}
+
+func saveKeyFile(t *testing.T, kf keyFile) string {
+	t.Helper()
+	data, err := json.Marshal(kf)
+	assert.Nil(t, err)
+	path := filepath.Join(t.TempDir(), "keys.json")
+	assert.Nil(t, os.WriteFile(path, data, 0600))
+	return path
+}
+
+func setupEncrypted(t *testing.T) (*encrypted, ObjectStorage) {
+	t.Helper()
+	path, _ := writeKeyFile(t, "key-1", "key-1")
+	provider, err := newFileKeyProvider(path)
+	assert.Nil(t, err)
+	store, err := newMemoryStore(ObjectStorageConfig{BucketURL: "encrypted"})
+	assert.Nil(t, err)
+	return newEncrypted(store, provider), store
+}
+
+func readObject(t *testing.T, s ObjectStorage, key string, offset, limit int64) []byte {
+	t.Helper()
+	rc, err := s.GetObject(context.Background(), key, offset, limit)
+	assert.Nil(t, err)
+	data, err := io.ReadAll(rc)
+	assert.Nil(t, err)
+	return data
+}
+
+func TestNewMasterKeyProvider(t *testing.T) {
+	_, err := NewMasterKeyProvider(EncryptionConfig{KeyProvider: "unknown"})
+	assert.NotNil(t, err)
+	_, err = NewMasterKeyProvider(EncryptionConfig{KeyProvider: FileKeyProvider, KeyFile: "/not/existed"})
+	assert.NotNil(t, err)
+	_, err = NewMasterKeyProvider(EncryptionConfig{KeyProvider: KMSKeyProvider})
+	assert.NotNil(t, err)
+
+	path, _ := writeKeyFile(t, "key-2", "key-1")
+	_, err = NewMasterKeyProvider(EncryptionConfig{KeyProvider: FileKeyProvider, KeyFile: path})
+	assert.ErrorContains(t, err, "active master key")
+	path = saveKeyFile(t, keyFile{ActiveKeyID: "key-1", Keys: map[string]string{"key-1": "c2hvcnQ="}})
+	_, err = NewMasterKeyProvider(EncryptionConfig{KeyProvider: FileKeyProvider, KeyFile: path})
+	assert.ErrorContains(t, err, "invalid master key")
+
+	path, _ = writeKeyFile(t, "key-1", "key-1")
+	provider, err := NewMasterKeyProvider(EncryptionConfig{KeyProvider: FileKeyProvider, KeyFile: path})
+	assert.Nil(t, err)
+	wrapped, err := provider.WrapKey(context.Background(), "key-1", []byte("data key"), []byte("piece"))
+	assert.Nil(t, err)
+	dataKey, err := provider.UnwrapKey(context.Background(), "key-1", wrapped, []byte("piece"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("data key"), dataKey)
+	_, err = provider.UnwrapKey(context.Background(), "key-2", wrapped, []byte("piece"))
+	assert.ErrorIs(t, err, errDataKeyMismatch)
+	// the wrapped data key is bound to the additional data
+	_, err = provider.UnwrapKey(context.Background(), "key-1", wrapped, []byte("other piece"))
+	assert.ErrorIs(t, err, errDataKeyMismatch)
+}
+
+func TestEncrypted_PutGetObject(t *testing.T) {
+	e, store := setupEncrypted(t)
+	ctx := context.Background()
+	data := make([]byte, 3*encryptionChunkSize+100)
+	_, _ = rand.Read(data)
+	assert.Nil(t, e.PutObject(ctx, mockKey, bytes.NewReader(data)))
+
+	// the piece is not stored as plaintext
+	raw := readObject(t, store, mockKey, 0, -1)
+	assert.Equal(t, int64(len(data)), plaintextSize(int64(len(raw))))
+	assert.False(t, bytes.Contains(raw, data[:1024]))
+
+	assert.Equal(t, data, readObject(t, e, mockKey, 0, -1))
+	cases := []struct {
+		offset int64
+		limit  int64
+	}{
+		{0, 10},
+		{10, encryptionChunkSize},
+		{encryptionChunkSize - 1, 2},
+		{2 * encryptionChunkSize, -1},
+		{3*encryptionChunkSize + 50, 1000},
+		{int64(len(data)) - 1, 1},
+	}
+	for _, c := range cases {
+		end := int64(len(data))
+		if c.limit > 0 && c.offset+c.limit < end {
+			end = c.offset + c.limit
+		}
+		assert.Equal(t, data[c.offset:end], readObject(t, e, mockKey, c.offset, c.limit), "offset %d limit %d",
+			c.offset, c.limit)
+	}
+	assert.Empty(t, readObject(t, e, mockKey, int64(len(data))+10, 10))
+
+	obj, err := e.HeadObject(ctx, mockKey)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(data)), obj.Size())
+	objs, err := e.ListObjects(ctx, "", "", "", 10)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(data)), objs[0].Size())
+
+	// empty object
+	assert.Nil(t, e.PutObject(ctx, "empty", bytes.NewReader(nil)))
+	assert.Empty(t, readObject(t, e, "empty", 0, -1))
+}
+
+func TestEncrypted_TamperedObject(t *testing.T) {
+	e, store := setupEncrypted(t)
+	ctx := context.Background()
+	data := bytes.Repeat([]byte("a"), 2*encryptionChunkSize)
+	assert.Nil(t, e.PutObject(ctx, mockKey, bytes.NewReader(data)))
+	raw := readObject(t, store, mockKey, 0, -1)
+
+	tampered := append([]byte{}, raw...)
+	tampered[encryptionHeaderSize+10] ^= 1
+	assert.Nil(t, store.PutObject(ctx, mockKey, bytes.NewReader(tampered)))
+	_, err := e.GetObject(ctx, mockKey, 0, -1)
+	assert.NotNil(t, err)
+
+	// the object truncated at the chunk boundary is detected
+	assert.Nil(t, store.PutObject(ctx, mockKey, bytes.NewReader(raw[:encryptionHeaderSize+encryptedChunkSize])))
+	_, err = e.GetObject(ctx, mockKey, 0, -1)
+	assert.NotNil(t, err)
+}
+
+func TestEncrypted_SwappedObject(t *testing.T) {
+	e, store := setupEncrypted(t)
+	ctx := context.Background()
+	assert.Nil(t, e.PutObject(ctx, "a", bytes.NewReader([]byte("piece a"))))
+	assert.Nil(t, e.PutObject(ctx, "b", bytes.NewReader([]byte("piece b"))))
+	rawA := readObject(t, store, "a", 0, -1)
+	rawB := readObject(t, store, "b", 0, -1)
+
+	// the chunks of b fail to decrypt under the header of a
+	swapped := append(append([]byte{}, rawA[:encryptionHeaderSize]...), rawB[encryptionHeaderSize:]...)
+	assert.Nil(t, store.PutObject(ctx, "a", bytes.NewReader(swapped)))
+	_, err := e.GetObject(ctx, "a", 0, -1)
+	assert.NotNil(t, err)
+
+	// the data key of b is not unwrapped for a, the object is not decrypted as b
+	assert.Nil(t, store.PutObject(ctx, "a", bytes.NewReader(rawB)))
+	assert.Equal(t, rawB, readObject(t, e, "a", 0, -1))
+	e.strict.Store(true)
+	_, err = e.GetObject(ctx, "a", 0, -1)
+	assert.ErrorIs(t, err, errDataKeyMismatch)
+}
+
+func TestEncrypted_PlaintextObject(t *testing.T) {
+	e, store := setupEncrypted(t)
+	ctx := context.Background()
+	assert.Nil(t, store.PutObject(ctx, "legacy", bytes.NewReader([]byte("legacy piece"))))
+	assert.Equal(t, []byte("legacy piece"), readObject(t, e, "legacy", 0, -1))
+	assert.Equal(t, []byte("piece"), readObject(t, e, "legacy", 7, 5))
+	obj, err := e.HeadObject(ctx, "legacy")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(12), obj.Size())
+
+	// the plaintext which starts with a header is still readable
+	header, err := encodeEncryptionHeader(&encryptionHeader{keyID: "key-1", wrappedKey: make([]byte, 60), size: 10})
+	assert.Nil(t, err)
+	forged := append(header, []byte("legacy piece")...)
+	assert.Nil(t, store.PutObject(ctx, "forged", bytes.NewReader(forged)))
+	assert.Equal(t, forged, readObject(t, e, "forged", 0, -1))
+
+	// the plaintext is not accepted once every object is known to be encrypted
+	e.strict.Store(true)
+	_, err = e.GetObject(ctx, "legacy", 0, -1)
+	assert.ErrorContains(t, err, "missing encryption header")
+	_, err = e.GetObject(ctx, "forged", 0, -1)
+	assert.ErrorIs(t, err, errDataKeyMismatch)
+}
+
+func TestEncrypted_RotateKeys(t *testing.T) {
+	ctx := context.Background()
+	path, keys := writeKeyFile(t, "key-1", "key-1")
+	provider, err := newFileKeyProvider(path)
+	assert.Nil(t, err)
+	store, err := newMemoryStore(ObjectStorageConfig{BucketURL: "rotate"})
+	assert.Nil(t, err)
+	e := newEncrypted(store, provider)
+	data := bytes.Repeat([]byte("b"), encryptionChunkSize+1)
+	assert.Nil(t, e.PutObject(ctx, "old", bytes.NewReader(data)))
+	assert.Nil(t, store.PutObject(ctx, "legacy", bytes.NewReader([]byte("legacy piece"))))
+
+	// rotate to key-2 and keep key-1 in key file
+	newKey := make([]byte, dataKeySize)
+	_, _ = rand.Read(newKey)
+	keys["key-2"] = base64.StdEncoding.EncodeToString(newKey)
+	provider, err = newFileKeyProvider(saveKeyFile(t, keyFile{ActiveKeyID: "key-2", Keys: keys}))
+	assert.Nil(t, err)
+	e = newEncrypted(store, provider)
+	assert.Nil(t, e.PutObject(ctx, "new", bytes.NewReader([]byte("new piece"))))
+
+	rotated, err := e.RotateKeys(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), rotated)
+	assert.True(t, e.strict.Load())
+	for _, key := range []string{"old", "legacy", "new"} {
+		header, err := e.readHeader(ctx, key)
+		assert.Nil(t, err)
+		assert.Equal(t, "key-2", header.keyID)
+	}
+
+	// key-1 can be removed after rotation
+	delete(keys, "key-1")
+	provider, err = newFileKeyProvider(saveKeyFile(t, keyFile{ActiveKeyID: "key-2", Keys: keys}))
+	assert.Nil(t, err)
+	e = newEncrypted(store, provider)
+	e.loadStrict(ctx)
+	assert.True(t, e.strict.Load())
+	assert.Equal(t, data, readObject(t, e, "old", 0, -1))
+	assert.Equal(t, []byte("legacy piece"), readObject(t, e, "legacy", 0, -1))
+	rotated, err = e.RotateKeys(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), rotated)
+}
+
+// deletingStore deletes or overwrites the object after it is read by the key rotation, as GC or the other
+// processes do concurrently.
+type deletingStore struct {
+	ObjectStorage
+	overwrite bool
+}
+
+func (d *deletingStore) GetObject(ctx context.Context, key string, offset, limit int64) (io.ReadCloser, error) {
+	rc, err := d.ObjectStorage.GetObject(ctx, key, offset, limit)
+	if err != nil || limit != -1 {
+		return rc, err
+	}
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, err
+	}
+	if d.overwrite {
+		err = d.ObjectStorage.PutObject(ctx, key, bytes.NewReader(append(data, 0)))
+	} else {
+		err = d.ObjectStorage.DeleteObject(ctx, key)
+	}
+	return io.NopCloser(bytes.NewReader(data)), err
+}
+
+func TestEncrypted_RotateKeysChangedObject(t *testing.T) {
+	ctx := context.Background()
+	path, _ := writeKeyFile(t, "key-1", "key-1")
+	provider, err := newFileKeyProvider(path)
+	assert.Nil(t, err)
+
+	for _, overwrite := range []bool{false, true} {
+		store, err := newMemoryStore(ObjectStorageConfig{BucketURL: "rotate"})
+		assert.Nil(t, err)
+		assert.Nil(t, store.PutObject(ctx, "legacy", bytes.NewReader([]byte("legacy piece"))))
+		e := newEncrypted(&deletingStore{ObjectStorage: store, overwrite: overwrite}, provider)
+
+		rotated, err := e.RotateKeys(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(0), rotated)
+		obj, err := store.HeadObject(ctx, "legacy")
+		if overwrite {
+			// the newer object is kept as it is
+			assert.Nil(t, err)
+			assert.Equal(t, int64(len("legacy piece")+1), obj.Size())
+		} else {
+			// the deleted object is not written back
+			assert.True(t, IsErrNoSuchObject(err))
+		}
+	}
+}
@@ -0,0 +1,224 @@
+package storage
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+)
+
+const (
+	// FileKeyProvider defines the master key provider which loads master keys from a local key file
+	FileKeyProvider = "file"
+	// KMSKeyProvider defines the master key provider which wraps data keys by a KMS-compatible service
+	KMSKeyProvider = "kms"
+
+	// maxMasterKeyIDLength the max length of master key id recorded in the encrypted object header
+	maxMasterKeyIDLength = 128
+	// kmsContextObjectKey the key of kms encryption context which binds the wrapped data key to the object
+	kmsContextObjectKey = "object"
+)
+
+// errDataKeyMismatch is returned if the wrapped data key is not authenticated by the master key and the
+// additional data, or the master key is not known.
+var errDataKeyMismatch = errors.New("data key is not wrapped by the master key")
+
+// MasterKeyProvider wraps and unwraps the per-object data keys by the master keys, the master keys never
+// leave the provider. The wrapped data key is stored with the key id in the encrypted object header, so
+// the objects written before a key rotation can still be decrypted by the old master key.
+type MasterKeyProvider interface {
+	// ActiveKeyID returns the id of master key which wraps the new data keys
+	ActiveKeyID() string
+	// WrapKey encrypts the data key by the master key specified by key id, the additional data is
+	// authenticated but not encrypted
+	WrapKey(ctx context.Context, keyID string, dataKey, additionalData []byte) ([]byte, error)
+	// UnwrapKey decrypts the wrapped data key by the master key specified by key id, errDataKeyMismatch is
+	// returned if the wrapped data key or the additional data is not authenticated
+	UnwrapKey(ctx context.Context, keyID string, wrappedKey, additionalData []byte) ([]byte, error)
+}
+
+// NewMasterKeyProvider returns the master key provider specified by the encryption config.
+func NewMasterKeyProvider(cfg EncryptionConfig) (MasterKeyProvider, error) {
+	switch strings.ToLower(cfg.KeyProvider) {
+	case FileKeyProvider:
+		return newFileKeyProvider(cfg.KeyFile)
+	case KMSKeyProvider:
+		return newKMSKeyProvider(cfg)
+	default:
+		return nil, fmt.Errorf("invalid master key provider: %s", cfg.KeyProvider)
+	}
+}
+
+// keyFile is the content of local key file, the keys are base64 encoded 32 bytes AES-256 keys. The old
+// keys should be kept in the file after rotation until all objects have been re-wrapped.
+type keyFile struct {
+	ActiveKeyID string            `json:"ActiveKeyID"`
+	Keys        map[string]string `json:"Keys"`
+}
+
+type fileKeyProvider struct {
+	active string
+	keys   map[string]cipher.AEAD
+}
+
+func newFileKeyProvider(path string) (*fileKeyProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		log.Errorw("failed to read master key file", "path", path, "error", err)
+		return nil, err
+	}
+	var kf keyFile
+	if err = json.Unmarshal(data, &kf); err != nil {
+		return nil, fmt.Errorf("failed to parse master key file: %w", err)
+	}
+	p := &fileKeyProvider{active: kf.ActiveKeyID, keys: make(map[string]cipher.AEAD, len(kf.Keys))}
+	for id, encoded := range kf.Keys {
+		if id == "" || len(id) > maxMasterKeyIDLength {
+			return nil, fmt.Errorf("invalid master key id: %q", id)
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode master key %s: %w", id, err)
+		}
+		if len(key) != dataKeySize {
+			return nil, fmt.Errorf("invalid master key %s size: %d", id, len(key))
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+		p.keys[id] = aead
+	}
+	if _, ok := p.keys[p.active]; !ok {
+		return nil, fmt.Errorf("active master key %q is not found in key file", p.active)
+	}
+	return p, nil
+}
+
+func (p *fileKeyProvider) ActiveKeyID() string {
+	return p.active
+}
+
+// WrapKey seals the data key with a random nonce which is prepended to the wrapped key.
+func (p *fileKeyProvider) WrapKey(ctx context.Context, keyID string, dataKey, additionalData []byte) ([]byte, error) {
+	aead, ok := p.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("master key %q is not found", keyID)
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, dataKey, wrapAdditionalData(keyID, additionalData)), nil
+}
+
+func (p *fileKeyProvider) UnwrapKey(ctx context.Context, keyID string, wrappedKey, additionalData []byte) ([]byte, error) {
+	aead, ok := p.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: master key %q is not found", errDataKeyMismatch, keyID)
+	}
+	if len(wrappedKey) < aead.NonceSize() {
+		return nil, fmt.Errorf("%w: invalid wrapped data key", errDataKeyMismatch)
+	}
+	nonce, sealed := wrappedKey[:aead.NonceSize()], wrappedKey[aead.NonceSize():]
+	dataKey, err := aead.Open(nil, nonce, sealed, wrapAdditionalData(keyID, additionalData))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errDataKeyMismatch, err)
+	}
+	return dataKey, nil
+}
+
+// wrapAdditionalData binds the wrapped data key to both the master key id and the additional data, the
+// key id is length prefixed so that the boundary is unambiguous.
+func wrapAdditionalData(keyID string, additionalData []byte) []byte {
+	ad := make([]byte, 0, 1+len(keyID)+len(additionalData))
+	ad = append(ad, byte(len(keyID)))
+	ad = append(ad, keyID...)
+	return append(ad, additionalData...)
+}
+
+// kmsKeyProvider wraps the data keys by the Encrypt and Decrypt APIs of AWS KMS or any KMS-compatible
+// service such as local-kms, the master key is rotated by changing KMSKeyID in config.
+type kmsKeyProvider struct {
+	keyID string
+	api   kmsiface.KMSAPI
+}
+
+func newKMSKeyProvider(cfg EncryptionConfig) (*kmsKeyProvider, error) {
+	if cfg.KMSKeyID == "" || len(cfg.KMSKeyID) > maxMasterKeyIDLength {
+		return nil, fmt.Errorf("invalid kms key id: %q", cfg.KMSKeyID)
+	}
+	awsConfig := &aws.Config{Region: aws.String(cfg.KMSRegion)}
+	if cfg.KMSEndpoint != "" {
+		awsConfig.Endpoint = aws.String(cfg.KMSEndpoint)
+	}
+	key := getSecretKeyFromEnv(AWSAccessKey, AWSSecretKey, AWSSessionToken)
+	if key.accessKey != "" && key.secretKey != "" {
+		awsConfig.Credentials = credentials.NewStaticCredentials(key.accessKey, key.secretKey, key.sessionToken)
+	}
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		log.Errorw("failed to new kms session", "error", err)
+		return nil, err
+	}
+	return &kmsKeyProvider{keyID: cfg.KMSKeyID, api: kms.New(sess)}, nil
+}
+
+func (p *kmsKeyProvider) ActiveKeyID() string {
+	return p.keyID
+}
+
+// WrapKey passes the additional data as encryption context, which KMS requires to be the same on decrypt.
+func (p *kmsKeyProvider) WrapKey(ctx context.Context, keyID string, dataKey, additionalData []byte) ([]byte, error) {
+	resp, err := p.api.EncryptWithContext(ctx, &kms.EncryptInput{
+		KeyId:             aws.String(keyID),
+		Plaintext:         dataKey,
+		EncryptionContext: map[string]*string{kmsContextObjectKey: aws.String(string(additionalData))},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.CiphertextBlob, nil
+}
+
+func (p *kmsKeyProvider) UnwrapKey(ctx context.Context, keyID string, wrappedKey, additionalData []byte) ([]byte, error) {
+	resp, err := p.api.DecryptWithContext(ctx, &kms.DecryptInput{
+		KeyId:             aws.String(keyID),
+		CiphertextBlob:    wrappedKey,
+		EncryptionContext: map[string]*string{kmsContextObjectKey: aws.String(string(additionalData))},
+	})
+	if err != nil {
+		var awsErr awserr.Error
+		if errors.As(err, &awsErr) {
+			switch awsErr.Code() {
+			case kms.ErrCodeInvalidCiphertextException, kms.ErrCodeIncorrectKeyException, kms.ErrCodeNotFoundException:
+				return nil, fmt.Errorf("%w: %v", errDataKeyMismatch, err)
+			}
+		}
+		return nil, err
+	}
+	return resp.Plaintext, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
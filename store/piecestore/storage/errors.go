@@ -2,6 +2,10 @@ package storage
 
 import (
 	"errors"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/s3"
 )
 
 // piece store errors
@@ -19,3 +23,16 @@ var (
 	// ErrNoPermissionAccessBucket defines deny access bucket error
 	ErrNoPermissionAccessBucket = errors.New("deny access bucket")
 )
+
+// IsErrNoSuchObject returns whether the error indicates the piece does not exist in the object storage, the
+// errors of the remote storages are compared by the message since they may be wrapped across the rpc.
+func IsErrNoSuchObject(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, ErrNoSuchObject) || errors.Is(err, os.ErrNotExist) {
+		return true
+	}
+	msg := err.Error()
+	return strings.Contains(msg, s3.ErrCodeNoSuchKey) || strings.Contains(msg, ErrNoSuchObject.Error())
+}
@@ -0,0 +1,30 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsErrNoSuchObject(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    error
+		wanted bool
+	}{
+		{name: "nil error", err: nil, wanted: false},
+		{name: "no such object", err: ErrNoSuchObject, wanted: true},
+		{name: "wrapped no such object", err: fmt.Errorf("failed to get piece: %w", ErrNoSuchObject), wanted: true},
+		{name: "file not exist", err: &os.PathError{Op: "open", Path: "mock", Err: os.ErrNotExist}, wanted: true},
+		{name: "s3 no such key", err: errors.New("NoSuchKey: The specified key does not exist."), wanted: true},
+		{name: "other error", err: errors.New("mock error"), wanted: false},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wanted, IsErrNoSuchObject(tt.err))
+		})
+	}
+}
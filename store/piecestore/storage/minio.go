@@ -35,7 +35,10 @@ func newMinioStore(cfg ObjectStorageConfig) (ObjectStorage, error) {
 		return nil, err
 	}
 	log.Infow("new minio store succeeds", "bucket", bucket)
-	return &minioStore{s3Store{bucketName: bucket, api: s3.New(minioSession)}}, nil
+	store := &minioStore{s3Store{bucketName: bucket, api: s3.New(minioSession)}}
+	store.multipart = newMultipartUploader(cfg, &store.s3Store)
+	store.multipart.startAbortOrphanedUploads()
+	return store, nil
 }
 
 func (sc *SessionCache) newMinioSession(cfg ObjectStorageConfig) (*session.Session, string, error) {
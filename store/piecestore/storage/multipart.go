@@ -0,0 +1,255 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+)
+
+const (
+	// DefaultMultipartPartSize defines the default part size of multipart upload
+	DefaultMultipartPartSize = 8 << 20
+	// MinMultipartPartSize defines the min part size of multipart upload except the last part, the S3-family
+	// backends reject completing the upload with smaller parts
+	MinMultipartPartSize = 5 << 20
+	// DefaultMultipartConcurrency defines the default number of parts uploaded concurrently
+	DefaultMultipartConcurrency = 4
+	// DefaultMultipartOrphanTimeout defines the default age after which an incomplete multipart
+	// upload is regarded as orphaned and aborted
+	DefaultMultipartOrphanTimeout = 24 * time.Hour
+	// maxMultipartParts the max number of parts of an object, the part size is enlarged if exceeded
+	maxMultipartParts = 10000
+	// abortTimeout the timeout of aborting a failed multipart upload, it does not depend on the
+	// context of the upload which may have been canceled
+	abortTimeout = 30 * time.Second
+)
+
+// multipartAPI is the multipart upload operations of an object storage, the S3-family backends implement
+// it and share the same multipart upload code path by multipartUploader.
+type multipartAPI interface {
+	// createMultipartUpload initiates a multipart upload with the checksum of the whole object as metadata
+	createMultipartUpload(ctx context.Context, key, checksum string) (string, error)
+	// uploadPart uploads a part and returns its etag, the part number starts from 1
+	uploadPart(ctx context.Context, key, uploadID string, partNumber int, body io.ReadSeeker, size int64) (string, error)
+	// completeMultipartUpload assembles the uploaded parts which are sorted by part number
+	completeMultipartUpload(ctx context.Context, key, uploadID string, parts []*completedPart) error
+	// abortMultipartUpload aborts the multipart upload and frees the uploaded parts
+	abortMultipartUpload(ctx context.Context, key, uploadID string) error
+	// listMultipartUploads returns the incomplete multipart uploads of the bucket
+	listMultipartUploads(ctx context.Context) ([]*pendingUpload, error)
+}
+
+type completedPart struct {
+	number int
+	etag   string
+}
+
+type pendingUpload struct {
+	key       string
+	uploadID  string
+	initiated time.Time
+}
+
+// sizedReadSeeker is implemented by bytes.Reader, strings.Reader and io.SectionReader, the parts are read
+// from it by io.SectionReader without copying the data.
+type sizedReadSeeker interface {
+	io.ReadSeeker
+	io.ReaderAt
+	Size() int64
+}
+
+// multipartUploader uploads the large objects by multipart upload, the parts are uploaded concurrently,
+// and the failed or orphaned uploads are aborted so that their parts are not left in the bucket.
+type multipartUploader struct {
+	api           multipartAPI
+	threshold     int64
+	partSize      int64
+	concurrency   int
+	orphanTimeout time.Duration
+	// cancel stops the background routine of aborting the orphaned uploads
+	cancel context.CancelFunc
+}
+
+// newMultipartUploader returns nil if multipart upload is disabled by the config.
+func newMultipartUploader(cfg ObjectStorageConfig, api multipartAPI) *multipartUploader {
+	if cfg.MultipartThreshold <= 0 {
+		return nil
+	}
+	u := &multipartUploader{
+		api:           api,
+		threshold:     cfg.MultipartThreshold,
+		partSize:      cfg.MultipartPartSize,
+		concurrency:   cfg.MultipartConcurrency,
+		orphanTimeout: time.Duration(cfg.MultipartOrphanTimeoutSecond) * time.Second,
+	}
+	if u.partSize <= 0 {
+		u.partSize = DefaultMultipartPartSize
+	} else if u.partSize < MinMultipartPartSize {
+		log.Warnw("multipart part size is too small, use the min part size", "part_size", u.partSize,
+			"min_part_size", MinMultipartPartSize)
+		u.partSize = MinMultipartPartSize
+	}
+	if u.concurrency <= 0 {
+		u.concurrency = DefaultMultipartConcurrency
+	}
+	if u.orphanTimeout <= 0 {
+		u.orphanTimeout = DefaultMultipartOrphanTimeout
+	}
+	return u
+}
+
+// accept returns the reader as sizedReadSeeker if it should be uploaded by multipart upload.
+func (u *multipartUploader) accept(reader io.Reader) (sizedReadSeeker, bool) {
+	if u == nil {
+		return nil, false
+	}
+	r, ok := reader.(sizedReadSeeker)
+	if !ok || r.Size() < u.threshold {
+		return nil, false
+	}
+	return r, true
+}
+
+// putObject uploads the reader by multipart upload, the upload is aborted if any part fails.
+func (u *multipartUploader) putObject(ctx context.Context, key string, reader sizedReadSeeker) error {
+	var (
+		size     = reader.Size()
+		partSize = u.partSize
+	)
+	if (size+partSize-1)/partSize > maxMultipartParts {
+		partSize = (size + maxMultipartParts - 1) / maxMultipartParts
+	}
+	partNum := int((size + partSize - 1) / partSize)
+
+	uploadID, err := u.api.createMultipartUpload(ctx, key, generateChecksum(reader))
+	if err != nil {
+		log.Errorw("failed to create multipart upload", "key", key, "error", err)
+		return err
+	}
+
+	var (
+		uploadCtx, cancel = context.WithCancel(ctx)
+		parts             = make([]*completedPart, partNum)
+		limit             = make(chan struct{}, u.concurrency)
+		wg                sync.WaitGroup
+		once              sync.Once
+		uploadErr         error
+	)
+	defer cancel()
+	for i := 0; i < partNum; i++ {
+		offset := int64(i) * partSize
+		length := partSize
+		if offset+length > size {
+			length = size - offset
+		}
+		select {
+		case limit <- struct{}{}:
+		case <-uploadCtx.Done():
+		}
+		if uploadCtx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func(number int, offset, length int64) {
+			defer func() {
+				<-limit
+				wg.Done()
+			}()
+			etag, partErr := u.api.uploadPart(uploadCtx, key, uploadID, number,
+				io.NewSectionReader(reader, offset, length), length)
+			if partErr != nil {
+				once.Do(func() {
+					uploadErr = fmt.Errorf("failed to upload part %d: %w", number, partErr)
+					cancel()
+				})
+				return
+			}
+			parts[number-1] = &completedPart{number: number, etag: etag}
+		}(i+1, offset, length)
+	}
+	wg.Wait()
+	if uploadErr == nil && ctx.Err() != nil {
+		uploadErr = ctx.Err()
+	}
+	if uploadErr == nil {
+		uploadErr = u.api.completeMultipartUpload(ctx, key, uploadID, parts)
+	}
+	if uploadErr != nil {
+		log.Errorw("failed to multipart upload object", "key", key, "upload_id", uploadID, "error", uploadErr)
+		u.abort(key, uploadID)
+		return uploadErr
+	}
+	return nil
+}
+
+func (u *multipartUploader) abort(key, uploadID string) {
+	ctx, cancel := context.WithTimeout(context.Background(), abortTimeout)
+	defer cancel()
+	if err := u.api.abortMultipartUpload(ctx, key, uploadID); err != nil {
+		log.Errorw("failed to abort multipart upload", "key", key, "upload_id", uploadID, "error", err)
+	}
+}
+
+// abortOrphanedUploads aborts the incomplete multipart uploads which are initiated earlier than the orphan
+// timeout, they are left by the crashed or killed uploads.
+func (u *multipartUploader) abortOrphanedUploads(ctx context.Context) (int, error) {
+	uploads, err := u.api.listMultipartUploads(ctx)
+	if err != nil {
+		return 0, err
+	}
+	sort.Slice(uploads, func(i, j int) bool { return uploads[i].initiated.Before(uploads[j].initiated) })
+	deadline := time.Now().Add(-u.orphanTimeout)
+	aborted := 0
+	for _, upload := range uploads {
+		if upload.initiated.After(deadline) {
+			break
+		}
+		if err = u.api.abortMultipartUpload(ctx, upload.key, upload.uploadID); err != nil {
+			log.Errorw("failed to abort orphaned multipart upload", "key", upload.key,
+				"upload_id", upload.uploadID, "error", err)
+			continue
+		}
+		aborted++
+	}
+	return aborted, nil
+}
+
+// startAbortOrphanedUploads aborts the orphaned uploads at startup and periodically in background until
+// the uploader is stopped.
+func (u *multipartUploader) startAbortOrphanedUploads() {
+	if u == nil {
+		return
+	}
+	var ctx context.Context
+	ctx, u.cancel = context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(u.orphanTimeout)
+		defer ticker.Stop()
+		for {
+			aborted, err := u.abortOrphanedUploads(ctx)
+			if err != nil && ctx.Err() == nil {
+				log.Errorw("failed to abort orphaned multipart uploads", "error", err)
+			} else if aborted > 0 {
+				log.Infow("succeed to abort orphaned multipart uploads", "count", aborted)
+			}
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// stop stops the background routine of aborting the orphaned uploads.
+func (u *multipartUploader) stop() {
+	if u == nil || u.cancel == nil {
+		return
+	}
+	u.cancel()
+}
@@ -0,0 +1,288 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeMultipartAPI struct {
+	mu          sync.Mutex
+	checksum    string
+	parts       map[int][]byte
+	completed   []byte
+	aborted     []string
+	failPart    int
+	uploads     []*pendingUpload
+	listed      int
+	running     int
+	maxRunning  int
+	createError error
+}
+
+func newFakeMultipartAPI() *fakeMultipartAPI {
+	return &fakeMultipartAPI{parts: make(map[int][]byte)}
+}
+
+func (f *fakeMultipartAPI) createMultipartUpload(ctx context.Context, key, checksum string) (string, error) {
+	f.checksum = checksum
+	return "upload_" + key, f.createError
+}
+
+func (f *fakeMultipartAPI) uploadPart(ctx context.Context, key, uploadID string, partNumber int, body io.ReadSeeker,
+	size int64) (string, error) {
+	f.mu.Lock()
+	f.running++
+	if f.running > f.maxRunning {
+		f.maxRunning = f.running
+	}
+	f.mu.Unlock()
+	defer func() {
+		f.mu.Lock()
+		f.running--
+		f.mu.Unlock()
+	}()
+	time.Sleep(time.Millisecond)
+	if partNumber == f.failPart {
+		return "", errors.New("mock error")
+	}
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return "", err
+	}
+	if int64(len(data)) != size {
+		return "", fmt.Errorf("mismatched part size %d, expected %d", len(data), size)
+	}
+	f.mu.Lock()
+	f.parts[partNumber] = data
+	f.mu.Unlock()
+	return fmt.Sprintf("etag_%d", partNumber), nil
+}
+
+func (f *fakeMultipartAPI) completeMultipartUpload(ctx context.Context, key, uploadID string, parts []*completedPart) error {
+	for i, part := range parts {
+		if part.number != i+1 || part.etag != fmt.Sprintf("etag_%d", part.number) {
+			return errors.New("invalid part")
+		}
+		f.completed = append(f.completed, f.parts[part.number]...)
+	}
+	return nil
+}
+
+func (f *fakeMultipartAPI) abortMultipartUpload(ctx context.Context, key, uploadID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.aborted = append(f.aborted, uploadID)
+	return nil
+}
+
+func (f *fakeMultipartAPI) listMultipartUploads(ctx context.Context) ([]*pendingUpload, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.listed++
+	return f.uploads, nil
+}
+
+func (f *fakeMultipartAPI) listedTimes() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.listed
+}
+
+// newTestMultipartUploader returns the uploader with the configured part size even if it is smaller than
+// MinMultipartPartSize, so the tests can upload small parts.
+func newTestMultipartUploader(cfg ObjectStorageConfig, api multipartAPI) *multipartUploader {
+	u := newMultipartUploader(cfg, api)
+	u.partSize = cfg.MultipartPartSize
+	return u
+}
+
+func TestNewMultipartUploader(t *testing.T) {
+	assert.Nil(t, newMultipartUploader(ObjectStorageConfig{}, newFakeMultipartAPI()))
+	u := newMultipartUploader(ObjectStorageConfig{MultipartThreshold: 100}, newFakeMultipartAPI())
+	assert.Equal(t, int64(DefaultMultipartPartSize), u.partSize)
+	assert.Equal(t, DefaultMultipartConcurrency, u.concurrency)
+	assert.Equal(t, DefaultMultipartOrphanTimeout, u.orphanTimeout)
+	// the part size smaller than the min part size is rejected by the backends when completing the upload
+	u = newMultipartUploader(ObjectStorageConfig{MultipartThreshold: 100, MultipartPartSize: 1024}, newFakeMultipartAPI())
+	assert.Equal(t, int64(MinMultipartPartSize), u.partSize)
+	u = newMultipartUploader(ObjectStorageConfig{MultipartThreshold: 100, MultipartPartSize: 16 << 20}, newFakeMultipartAPI())
+	assert.Equal(t, int64(16<<20), u.partSize)
+
+	_, ok := u.accept(bytes.NewReader(make([]byte, 99)))
+	assert.False(t, ok)
+	_, ok = u.accept(strings.NewReader(strings.Repeat("a", 100)))
+	assert.True(t, ok)
+	// the reader without size is uploaded by single request
+	_, ok = u.accept(io.MultiReader(bytes.NewReader(make([]byte, 100))))
+	assert.False(t, ok)
+	// the disabled uploader accepts nothing
+	var disabled *multipartUploader
+	_, ok = disabled.accept(bytes.NewReader(make([]byte, 100)))
+	assert.False(t, ok)
+}
+
+func TestMultipartUploader_PutObjectSuccess(t *testing.T) {
+	api := newFakeMultipartAPI()
+	u := newTestMultipartUploader(ObjectStorageConfig{MultipartThreshold: 1, MultipartPartSize: 10,
+		MultipartConcurrency: 3}, api)
+	data := []byte(strings.Repeat("0123456789", 20) + "abc")
+	err := u.putObject(context.Background(), mockKey, bytes.NewReader(data))
+	assert.Nil(t, err)
+	assert.Equal(t, data, api.completed)
+	assert.Equal(t, 21, len(api.parts))
+	assert.Equal(t, generateChecksum(bytes.NewReader(data)), api.checksum)
+	assert.LessOrEqual(t, api.maxRunning, 3)
+	assert.Empty(t, api.aborted)
+}
+
+func TestMultipartUploader_PutObjectEnlargePartSize(t *testing.T) {
+	api := newFakeMultipartAPI()
+	u := newTestMultipartUploader(ObjectStorageConfig{MultipartThreshold: 1, MultipartPartSize: 1,
+		MultipartConcurrency: 16}, api)
+	data := bytes.Repeat([]byte("a"), maxMultipartParts*2+1)
+	err := u.putObject(context.Background(), mockKey, bytes.NewReader(data))
+	assert.Nil(t, err)
+	assert.Equal(t, data, api.completed)
+	assert.LessOrEqual(t, len(api.parts), maxMultipartParts)
+}
+
+func TestMultipartUploader_PutObjectError(t *testing.T) {
+	api := newFakeMultipartAPI()
+	api.createError = errors.New("mock error")
+	u := newTestMultipartUploader(ObjectStorageConfig{MultipartThreshold: 1, MultipartPartSize: 10}, api)
+	err := u.putObject(context.Background(), mockKey, bytes.NewReader(make([]byte, 100)))
+	assert.NotNil(t, err)
+	assert.Empty(t, api.aborted)
+
+	// the upload is aborted if any part fails
+	api = newFakeMultipartAPI()
+	api.failPart = 3
+	u = newTestMultipartUploader(ObjectStorageConfig{MultipartThreshold: 1, MultipartPartSize: 10}, api)
+	err = u.putObject(context.Background(), mockKey, bytes.NewReader(make([]byte, 100)))
+	assert.ErrorContains(t, err, "failed to upload part 3")
+	assert.Equal(t, []string{"upload_" + mockKey}, api.aborted)
+	assert.Nil(t, api.completed)
+
+	// the upload is aborted if the context is canceled
+	api = newFakeMultipartAPI()
+	u = newTestMultipartUploader(ObjectStorageConfig{MultipartThreshold: 1, MultipartPartSize: 10}, api)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = u.putObject(ctx, mockKey, bytes.NewReader(make([]byte, 100)))
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []string{"upload_" + mockKey}, api.aborted)
+}
+
+func TestMultipartUploader_AbortOrphanedUploads(t *testing.T) {
+	api := newFakeMultipartAPI()
+	now := time.Now()
+	api.uploads = []*pendingUpload{
+		{key: "a", uploadID: "fresh", initiated: now.Add(-time.Minute)},
+		{key: "b", uploadID: "orphan_1", initiated: now.Add(-25 * time.Hour)},
+		{key: "c", uploadID: "orphan_2", initiated: now.Add(-48 * time.Hour)},
+	}
+	u := newMultipartUploader(ObjectStorageConfig{MultipartThreshold: 1}, api)
+	aborted, err := u.abortOrphanedUploads(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 2, aborted)
+	assert.Equal(t, []string{"orphan_2", "orphan_1"}, api.aborted)
+}
+
+func TestMultipartUploader_StopAbortOrphanedUploads(t *testing.T) {
+	api := newFakeMultipartAPI()
+	u := newMultipartUploader(ObjectStorageConfig{MultipartThreshold: 1}, api)
+	u.orphanTimeout = 5 * time.Millisecond
+	u.startAbortOrphanedUploads()
+	assert.Eventually(t, func() bool { return api.listedTimes() >= 2 }, time.Second, time.Millisecond)
+
+	u.stop()
+	time.Sleep(10 * time.Millisecond)
+	listed := api.listedTimes()
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, listed, api.listedTimes())
+
+	// the disabled uploader has nothing to stop
+	var disabled *multipartUploader
+	disabled.stop()
+	s := &s3Store{}
+	assert.Nil(t, CloseObjectStorage(s))
+}
+
+type mockMultipartS3Client struct {
+	s3iface.S3API
+	mu        sync.Mutex
+	parts     map[int64]int64
+	completed []*s3.CompletedPart
+	aborted   bool
+}
+
+func (m *mockMultipartS3Client) CreateMultipartUploadWithContext(aws.Context, *s3.CreateMultipartUploadInput,
+	...request.Option) (*s3.CreateMultipartUploadOutput, error) {
+	return &s3.CreateMultipartUploadOutput{UploadId: aws.String("mockUploadID")}, nil
+}
+
+func (m *mockMultipartS3Client) UploadPartWithContext(_ aws.Context, input *s3.UploadPartInput, _ ...request.Option) (
+	*s3.UploadPartOutput, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.parts[aws.Int64Value(input.PartNumber)] = aws.Int64Value(input.ContentLength)
+	return &s3.UploadPartOutput{ETag: aws.String(fmt.Sprintf("etag_%d", aws.Int64Value(input.PartNumber)))}, nil
+}
+
+func (m *mockMultipartS3Client) CompleteMultipartUploadWithContext(_ aws.Context,
+	input *s3.CompleteMultipartUploadInput, _ ...request.Option) (*s3.CompleteMultipartUploadOutput, error) {
+	m.completed = input.MultipartUpload.Parts
+	return &s3.CompleteMultipartUploadOutput{}, nil
+}
+
+func (m *mockMultipartS3Client) AbortMultipartUploadWithContext(aws.Context, *s3.AbortMultipartUploadInput,
+	...request.Option) (*s3.AbortMultipartUploadOutput, error) {
+	m.aborted = true
+	return &s3.AbortMultipartUploadOutput{}, nil
+}
+
+func (m *mockMultipartS3Client) ListMultipartUploadsWithContext(_ aws.Context, input *s3.ListMultipartUploadsInput,
+	_ ...request.Option) (*s3.ListMultipartUploadsOutput, error) {
+	if input.KeyMarker == nil {
+		return &s3.ListMultipartUploadsOutput{
+			Uploads:            []*s3.MultipartUpload{{Key: aws.String("a"), UploadId: aws.String("1")}},
+			IsTruncated:        aws.Bool(true),
+			NextKeyMarker:      aws.String("a"),
+			NextUploadIdMarker: aws.String("1"),
+		}, nil
+	}
+	return &s3.ListMultipartUploadsOutput{
+		Uploads: []*s3.MultipartUpload{{Key: aws.String("b"), UploadId: aws.String("2")}},
+	}, nil
+}
+
+func TestS3Store_PutObjectMultipartSuccess(t *testing.T) {
+	client := &mockMultipartS3Client{parts: make(map[int64]int64)}
+	s := &s3Store{bucketName: mockS3Bucket, api: client}
+	s.multipart = newTestMultipartUploader(ObjectStorageConfig{MultipartThreshold: 10, MultipartPartSize: 4}, s)
+
+	err := s.PutObject(context.Background(), mockKey, strings.NewReader("0123456789"))
+	assert.Nil(t, err)
+	assert.Equal(t, map[int64]int64{1: 4, 2: 4, 3: 2}, client.parts)
+	assert.Equal(t, 3, len(client.completed))
+	assert.Equal(t, "etag_3", aws.StringValue(client.completed[2].ETag))
+	assert.False(t, client.aborted)
+
+	uploads, err := s.listMultipartUploads(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(uploads))
+	assert.Equal(t, "2", uploads[1].uploadID)
+}
@@ -3,6 +3,7 @@ package storage
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"sync"
@@ -18,6 +19,14 @@ func NewObjectStorage(cfg ObjectStorageConfig) (ObjectStorage, error) {
 	return nil, fmt.Errorf("invalid object storage: %s", cfg.Storage)
 }
 
+// CloseObjectStorage stops the background routines of the object storage if it has any.
+func CloseObjectStorage(store ObjectStorage) error {
+	if closer, ok := store.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
 type StorageFn func(cfg ObjectStorageConfig) (ObjectStorage, error)
 
 var storageMap = map[string]StorageFn{
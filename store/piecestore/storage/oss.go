@@ -19,14 +19,21 @@ import (
 )
 
 type ossStore struct {
-	client *oss.Client
-	bucket *oss.Bucket
+	client    *oss.Client
+	bucket    *oss.Bucket
+	multipart *multipartUploader
 }
 
 func (o *ossStore) String() string {
 	return fmt.Sprintf("oss://%s/", o.bucket.BucketName)
 }
 
+// Close stops aborting the orphaned multipart uploads in background.
+func (o *ossStore) Close() error {
+	o.multipart.stop()
+	return nil
+}
+
 func (o *ossStore) CreateBucket(ctx context.Context) error {
 	err := o.bucket.Client.CreateBucket(o.bucket.BucketName)
 	if err != nil && isErrExists(err) {
@@ -60,6 +67,9 @@ func (o *ossStore) PutObject(ctx context.Context, key string, in io.Reader) erro
 		option     []oss.Option
 		respHeader http.Header
 	)
+	if r, ok := o.multipart.accept(in); ok {
+		return o.multipart.putObject(ctx, key, r)
+	}
 	if rs, ok := in.(io.ReadSeeker); ok {
 		option = append(option, oss.Meta(ChecksumAlgo, generateChecksum(rs)))
 	}
@@ -177,6 +187,62 @@ func (o *ossStore) ListAllObjects(ctx context.Context, prefix, marker string) (<
 	return nil, ErrUnsupportedMethod
 }
 
+func (o *ossStore) multipartUpload(key, uploadID string) oss.InitiateMultipartUploadResult {
+	return oss.InitiateMultipartUploadResult{Bucket: o.bucket.BucketName, Key: key, UploadID: uploadID}
+}
+
+func (o *ossStore) createMultipartUpload(ctx context.Context, key, checksum string) (string, error) {
+	imur, err := o.bucket.InitiateMultipartUpload(key, oss.Meta(ChecksumAlgo, checksum))
+	if err != nil {
+		return "", err
+	}
+	return imur.UploadID, nil
+}
+
+func (o *ossStore) uploadPart(ctx context.Context, key, uploadID string, partNumber int, body io.ReadSeeker,
+	size int64) (string, error) {
+	part, err := o.bucket.UploadPart(o.multipartUpload(key, uploadID), body, size, partNumber)
+	if err != nil {
+		return "", err
+	}
+	return part.ETag, nil
+}
+
+func (o *ossStore) completeMultipartUpload(ctx context.Context, key, uploadID string, parts []*completedPart) error {
+	uploaded := make([]oss.UploadPart, 0, len(parts))
+	for _, part := range parts {
+		uploaded = append(uploaded, oss.UploadPart{PartNumber: part.number, ETag: part.etag})
+	}
+	_, err := o.bucket.CompleteMultipartUpload(o.multipartUpload(key, uploadID), uploaded)
+	return err
+}
+
+func (o *ossStore) abortMultipartUpload(ctx context.Context, key, uploadID string) error {
+	return o.bucket.AbortMultipartUpload(o.multipartUpload(key, uploadID))
+}
+
+func (o *ossStore) listMultipartUploads(ctx context.Context) ([]*pendingUpload, error) {
+	var (
+		uploads        []*pendingUpload
+		keyMarker      string
+		uploadIDMarker string
+	)
+	for {
+		resp, err := o.bucket.ListMultipartUploads(oss.KeyMarker(keyMarker), oss.UploadIDMarker(uploadIDMarker))
+		if err != nil {
+			log.Errorw("OSS failed to list multipart uploads", "error", err)
+			return nil, err
+		}
+		for _, upload := range resp.Uploads {
+			uploads = append(uploads, &pendingUpload{key: upload.Key, uploadID: upload.UploadID, initiated: upload.Initiated})
+		}
+		if !resp.IsTruncated {
+			return uploads, nil
+		}
+		keyMarker, uploadIDMarker = resp.NextKeyMarker, resp.NextUploadIDMarker
+	}
+}
+
 func newOSSStore(cfg ObjectStorageConfig) (ObjectStorage, error) {
 	var (
 		cli          *oss.Client
@@ -232,7 +298,10 @@ func newOSSStore(cfg ObjectStorageConfig) (ObjectStorage, error) {
 	if err != nil {
 		return nil, fmt.Errorf("cannot get bucket instance %s: %s", bucketName, err)
 	}
-	return &ossStore{client: cli, bucket: bucket}, nil
+	store := &ossStore{client: cli, bucket: bucket}
+	store.multipart = newMultipartUploader(cfg, store)
+	store.multipart.startAbortOrphanedUploads()
+	return store, nil
 }
 
 func newOIDCCredentialProvider() (oss.CredentialsProvider, error) {
@@ -42,6 +42,7 @@ var (
 type s3Store struct {
 	bucketName string
 	api        s3iface.S3API
+	multipart  *multipartUploader
 }
 
 func newS3Store(cfg ObjectStorageConfig) (ObjectStorage, error) {
@@ -52,13 +53,22 @@ func newS3Store(cfg ObjectStorageConfig) (ObjectStorage, error) {
 	}
 	log.Infow("new S3 store succeeds", "bucket", bucket)
 
-	return &s3Store{bucketName: bucket, api: s3.New(awsSession)}, nil
+	store := &s3Store{bucketName: bucket, api: s3.New(awsSession)}
+	store.multipart = newMultipartUploader(cfg, store)
+	store.multipart.startAbortOrphanedUploads()
+	return store, nil
 }
 
 func (s *s3Store) String() string {
 	return fmt.Sprintf("s3://%s/", s.bucketName)
 }
 
+// Close stops aborting the orphaned multipart uploads in background.
+func (s *s3Store) Close() error {
+	s.multipart.stop()
+	return nil
+}
+
 func (s *s3Store) CreateBucket(ctx context.Context) error {
 	_, err := s.api.CreateBucketWithContext(ctx, &s3.CreateBucketInput{
 		Bucket: aws.String(s.bucketName),
@@ -114,6 +124,9 @@ func (s *s3Store) PutObject(ctx context.Context, key string, reader io.Reader) e
 		}
 		body = bytes.NewReader(data)
 	}
+	if r, ok := s.multipart.accept(body); ok {
+		return s.multipart.putObject(ctx, key, r)
+	}
 
 	checksum := generateChecksum(body)
 	params := &s3.PutObjectInput{
@@ -267,6 +280,91 @@ func (s *s3Store) ListAllObjects(ctx context.Context, prefix, marker string) (<-
 	return nil, ErrUnsupportedMethod
 }
 
+func (s *s3Store) createMultipartUpload(ctx context.Context, key, checksum string) (string, error) {
+	resp, err := s.api.CreateMultipartUploadWithContext(ctx, &s3.CreateMultipartUploadInput{
+		Bucket:      aws.String(s.bucketName),
+		Key:         aws.String(key),
+		ContentType: aws.String(OctetStream),
+		Metadata:    map[string]*string{ChecksumAlgo: aws.String(checksum)},
+	})
+	if err != nil {
+		return "", err
+	}
+	return aws.StringValue(resp.UploadId), nil
+}
+
+func (s *s3Store) uploadPart(ctx context.Context, key, uploadID string, partNumber int, body io.ReadSeeker,
+	size int64) (string, error) {
+	resp, err := s.api.UploadPartWithContext(ctx, &s3.UploadPartInput{
+		Bucket:        aws.String(s.bucketName),
+		Key:           aws.String(key),
+		UploadId:      aws.String(uploadID),
+		PartNumber:    aws.Int64(int64(partNumber)),
+		Body:          body,
+		ContentLength: aws.Int64(size),
+	})
+	if err != nil {
+		return "", err
+	}
+	return aws.StringValue(resp.ETag), nil
+}
+
+func (s *s3Store) completeMultipartUpload(ctx context.Context, key, uploadID string, parts []*completedPart) error {
+	completed := make([]*s3.CompletedPart, 0, len(parts))
+	for _, part := range parts {
+		completed = append(completed, &s3.CompletedPart{
+			ETag:       aws.String(part.etag),
+			PartNumber: aws.Int64(int64(part.number)),
+		})
+	}
+	_, err := s.api.CompleteMultipartUploadWithContext(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(s.bucketName),
+		Key:             aws.String(key),
+		UploadId:        aws.String(uploadID),
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: completed},
+	})
+	return err
+}
+
+func (s *s3Store) abortMultipartUpload(ctx context.Context, key, uploadID string) error {
+	_, err := s.api.AbortMultipartUploadWithContext(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(s.bucketName),
+		Key:      aws.String(key),
+		UploadId: aws.String(uploadID),
+	})
+	return err
+}
+
+func (s *s3Store) listMultipartUploads(ctx context.Context) ([]*pendingUpload, error) {
+	var (
+		uploads        []*pendingUpload
+		keyMarker      *string
+		uploadIDMarker *string
+	)
+	for {
+		resp, err := s.api.ListMultipartUploadsWithContext(ctx, &s3.ListMultipartUploadsInput{
+			Bucket:         aws.String(s.bucketName),
+			KeyMarker:      keyMarker,
+			UploadIdMarker: uploadIDMarker,
+		})
+		if err != nil {
+			log.Errorw("S3 failed to list multipart uploads", "error", err)
+			return nil, err
+		}
+		for _, upload := range resp.Uploads {
+			uploads = append(uploads, &pendingUpload{
+				key:       aws.StringValue(upload.Key),
+				uploadID:  aws.StringValue(upload.UploadId),
+				initiated: aws.TimeValue(upload.Initiated),
+			})
+		}
+		if !aws.BoolValue(resp.IsTruncated) {
+			return uploads, nil
+		}
+		keyMarker, uploadIDMarker = resp.NextKeyMarker, resp.NextUploadIdMarker
+	}
+}
+
 // SessionCache holds session.Session according to ObjectStorageConfig and it synchronizes access/modification
 type SessionCache struct {
 	sync.Mutex
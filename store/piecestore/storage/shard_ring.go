@@ -0,0 +1,103 @@
+package storage
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math"
+)
+
+// shardPicker decides which shard a key belongs to.
+type shardPicker interface {
+	// pick returns the shard index of the key
+	pick(key string) int
+	// size returns the number of shards
+	size() int
+	// String describes the layout, it differs if any key may be picked differently
+	String() string
+}
+
+// moduloPicker is the legacy picker which uses fnv32 % shards, changing the number of shards
+// remaps almost every key.
+type moduloPicker struct {
+	shards int
+}
+
+func (m *moduloPicker) pick(key string) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return int(h.Sum32() % uint32(m.shards))
+}
+
+func (m *moduloPicker) size() int { return m.shards }
+
+func (m *moduloPicker) String() string { return fmt.Sprintf("modulo%d", m.shards) }
+
+// rendezvousPicker is a weighted rendezvous(highest random weight) picker. Adding a shard
+// only moves the keys that the new shard wins, and removing a shard only moves the keys
+// that were on it, the expected share of each shard is proportional to its weight.
+type rendezvousPicker struct {
+	weights []float64
+}
+
+func newRendezvousPicker(shards int, weights []uint32) (*rendezvousPicker, error) {
+	if len(weights) != 0 && len(weights) != shards {
+		return nil, fmt.Errorf("mismatched shard weights: %d weights for %d shards", len(weights), shards)
+	}
+	r := &rendezvousPicker{weights: make([]float64, shards)}
+	for i := range r.weights {
+		r.weights[i] = 1
+		if len(weights) != 0 {
+			if weights[i] == 0 {
+				return nil, fmt.Errorf("invalid weight of shard %d: 0", i)
+			}
+			r.weights[i] = float64(weights[i])
+		}
+	}
+	return r, nil
+}
+
+func (r *rendezvousPicker) pick(key string) int {
+	var (
+		best      = 0
+		bestScore = math.Inf(-1)
+	)
+	for i, w := range r.weights {
+		if score := w / -math.Log(shardHash(key, i)); score > bestScore {
+			best, bestScore = i, score
+		}
+	}
+	return best
+}
+
+func (r *rendezvousPicker) size() int { return len(r.weights) }
+
+func (r *rendezvousPicker) String() string { return fmt.Sprintf("rendezvous%v", r.weights) }
+
+// shardHash returns a uniformly distributed value in (0, 1) for the key and shard pair.
+func shardHash(key string, shard int) float64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(shard))
+	_, _ = h.Write(b[:])
+	// splitmix64 finalizer, improves the avalanche of fnv on the shard suffix
+	x := h.Sum64()
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	// use the top 53 bits, and never return 0 which makes log(0) infinite
+	return (float64(x>>11) + 0.5) / (1 << 53)
+}
+
+func newShardPicker(shards int, ring bool, weights []uint32) (shardPicker, error) {
+	if shards <= 0 {
+		return nil, fmt.Errorf("invalid shards: %d", shards)
+	}
+	if !ring {
+		return &moduloPicker{shards: shards}, nil
+	}
+	return newRendezvousPicker(shards, weights)
+}
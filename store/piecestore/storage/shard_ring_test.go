@@ -0,0 +1,93 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewShardPicker(t *testing.T) {
+	cases := []struct {
+		name      string
+		shards    int
+		ring      bool
+		weights   []uint32
+		wantedErr error
+	}{
+		{
+			name:   "modulo picker",
+			shards: 2,
+		},
+		{
+			name:    "rendezvous picker",
+			shards:  2,
+			ring:    true,
+			weights: []uint32{1, 3},
+		},
+		{
+			name:      "invalid shards",
+			shards:    0,
+			wantedErr: errors.New("invalid shards: 0"),
+		},
+		{
+			name:      "zero weight",
+			shards:    2,
+			ring:      true,
+			weights:   []uint32{1, 0},
+			wantedErr: errors.New("invalid weight of shard 1: 0"),
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := newShardPicker(tt.shards, tt.ring, tt.weights)
+			assert.Equal(t, tt.wantedErr, err)
+			if tt.wantedErr == nil {
+				assert.Equal(t, tt.shards, p.size())
+			}
+		})
+	}
+}
+
+func TestModuloPicker_Compatible(t *testing.T) {
+	// the legacy layout must stay the same as fnv32 % shards
+	p := &moduloPicker{shards: 4}
+	assert.Equal(t, p.pick(mockKey), p.pick(mockKey))
+	assert.True(t, p.pick(mockKey) < 4)
+}
+
+func TestRendezvousPicker_MinimalMovement(t *testing.T) {
+	before, err := newRendezvousPicker(4, nil)
+	assert.Nil(t, err)
+	after, err := newRendezvousPicker(5, nil)
+	assert.Nil(t, err)
+
+	const total = 20000
+	moved := 0
+	for i := 0; i < total; i++ {
+		key := fmt.Sprintf("s%d_s%d", i, i%16)
+		b, a := before.pick(key), after.pick(key)
+		if b != a {
+			// keys only move to the new shard
+			assert.Equal(t, 4, a)
+			moved++
+		}
+	}
+	// about 1/5 of keys move to the new shard
+	assert.InDelta(t, total/5, moved, total/50)
+}
+
+func TestRendezvousPicker_Weighted(t *testing.T) {
+	p, err := newRendezvousPicker(3, []uint32{1, 2, 1})
+	assert.Nil(t, err)
+
+	const total = 20000
+	counts := make([]int, 3)
+	for i := 0; i < total; i++ {
+		counts[p.pick(fmt.Sprintf("s%d_s0", i))]++
+	}
+	assert.InDelta(t, total/4, counts[0], total/40)
+	assert.InDelta(t, total/2, counts[1], total/40)
+	assert.InDelta(t, total/4, counts[2], total/40)
+}
@@ -1,23 +1,65 @@
 package storage
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"hash/fnv"
 	"io"
+	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
 )
 
+const (
+	rebalanceBatchSize          = 1000
+	defaultRebalanceConcurrency = 4
+	// rebalanceMarkerPrefix the prefix of markers which record the finished rebalances in the first shard,
+	// the marker is keyed by the fingerprint of previous and current layout
+	rebalanceMarkerPrefix = ".rebalanced/"
+)
+
+// Rebalancer is implemented by the object storage which can move keys between its shards.
+type Rebalancer interface {
+	// Rebalance moves the keys that are not on the shard picked by the current layout to
+	// their new shard, and returns the number of moved keys.
+	Rebalance(ctx context.Context) (uint64, error)
+}
+
+var _ Rebalancer = &sharded{}
+
 type sharded struct {
-	stores []ObjectStorage
+	// stores contains the shards of both current and previous layout
+	stores  []ObjectStorage
+	current shardPicker
+	// previous is the layout before resizing, it is nil if no resizing is in progress
+	previous    shardPicker
+	migrating   atomic.Bool
+	concurrency int
 	DefaultObjectStorage
 }
 
 func NewSharded(cfg PieceStoreConfig) (ObjectStorage, error) {
-	stores := make([]ObjectStorage, cfg.Shards)
-	var err error
+	ring := cfg.ShardRing
+	current, err := newShardPicker(cfg.Shards, ring.Enable, ring.Weights)
+	if err != nil {
+		return nil, err
+	}
+	var previous shardPicker
+	if ring.PreviousShards > 0 {
+		if previous, err = newShardPicker(ring.PreviousShards, ring.PreviousRing, ring.PreviousWeights); err != nil {
+			return nil, err
+		}
+	}
+
+	n := cfg.Shards
+	if ring.PreviousShards > n {
+		n = ring.PreviousShards
+	}
+	stores := make([]ObjectStorage, n)
 	shardingURL := cfg.Store.BucketURL
 	for i := range stores {
 		ep := fmt.Sprintf(shardingURL, i)
@@ -30,11 +72,60 @@ func NewSharded(cfg PieceStoreConfig) (ObjectStorage, error) {
 			return nil, err
 		}
 	}
-	return &sharded{stores: stores}, nil
+	s := newSharded(stores, current, previous, ring.RebalanceConcurrency)
+	s.loadRebalanced(context.Background())
+	return s, nil
+}
+
+func newSharded(stores []ObjectStorage, current, previous shardPicker, concurrency int) *sharded {
+	if concurrency <= 0 {
+		concurrency = defaultRebalanceConcurrency
+	}
+	s := &sharded{stores: stores, current: current, previous: previous, concurrency: concurrency}
+	s.migrating.Store(previous != nil)
+	return s
+}
+
+// loadRebalanced stops falling back to the previous layout if its rebalance has finished before, it
+// keeps falling back if the marker can not be read, which only costs the extra reads of missing keys.
+func (s *sharded) loadRebalanced(ctx context.Context) {
+	if !s.migrating.Load() {
+		return
+	}
+	_, err := s.stores[0].HeadObject(ctx, s.rebalanceMarker())
+	if err == nil {
+		s.migrating.Store(false)
+		return
+	}
+	if !IsErrNoSuchObject(err) {
+		log.Warnw("failed to read rebalance marker", "marker", s.rebalanceMarker(), "error", err)
+	}
+}
+
+// rebalanceMarker returns the key of marker which records that the keys have been moved from the
+// previous layout to the current one.
+func (s *sharded) rebalanceMarker() string {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(s.layoutChange()))
+	return fmt.Sprintf("%s%016x", rebalanceMarkerPrefix, h.Sum64())
+}
+
+func (s *sharded) layoutChange() string {
+	return s.previous.String() + "->" + s.current.String()
 }
 
 func (s *sharded) String() string {
-	return fmt.Sprintf("shard%d://%s", len(s.stores), s.stores[0])
+	return fmt.Sprintf("shard%d://%s", s.current.size(), s.stores[0])
+}
+
+func (s *sharded) Close() error {
+	var err error
+	for _, o := range s.stores {
+		if closeErr := CloseObjectStorage(o); closeErr != nil {
+			err = closeErr
+		}
+	}
+	return err
 }
 
 func (s *sharded) CreateBucket(ctx context.Context) error {
@@ -47,14 +138,33 @@ func (s *sharded) CreateBucket(ctx context.Context) error {
 }
 
 func (s *sharded) pick(key string) ObjectStorage {
-	h := fnv.New32a()
-	_, _ = h.Write([]byte(key))
-	i := h.Sum32() % uint32(len(s.stores))
-	return s.stores[i]
+	return s.stores[s.current.pick(key)]
+}
+
+// pickPrevious returns the shard picked by the previous layout if it differs from the
+// current one, reads fall back to it until the key is moved by rebalance.
+func (s *sharded) pickPrevious(key string) (ObjectStorage, bool) {
+	if !s.migrating.Load() {
+		return nil, false
+	}
+	prev := s.previous.pick(key)
+	if prev == s.current.pick(key) {
+		return nil, false
+	}
+	return s.stores[prev], true
 }
 
 func (s *sharded) GetObject(ctx context.Context, key string, off, limit int64) (io.ReadCloser, error) {
-	return s.pick(key).GetObject(ctx, key, off, limit)
+	rc, err := s.pick(key).GetObject(ctx, key, off, limit)
+	if err != nil {
+		if prev, ok := s.pickPrevious(key); ok {
+			if prc, prevErr := prev.GetObject(ctx, key, off, limit); prevErr == nil {
+				return prc, nil
+			}
+		}
+		return nil, err
+	}
+	return rc, nil
 }
 
 func (s *sharded) PutObject(ctx context.Context, key string, body io.Reader) error {
@@ -62,7 +172,13 @@ func (s *sharded) PutObject(ctx context.Context, key string, body io.Reader) err
 }
 
 func (s *sharded) DeleteObject(ctx context.Context, key string) error {
-	return s.pick(key).DeleteObject(ctx, key)
+	if err := s.pick(key).DeleteObject(ctx, key); err != nil {
+		return err
+	}
+	if prev, ok := s.pickPrevious(key); ok {
+		return prev.DeleteObject(ctx, key)
+	}
+	return nil
 }
 
 func (s *sharded) DeleteObjectsByPrefix(ctx context.Context, key string) (uint64, error) {
@@ -107,5 +223,122 @@ func (s *sharded) HeadBucket(ctx context.Context) error {
 }
 
 func (s *sharded) HeadObject(ctx context.Context, key string) (Object, error) {
-	return s.pick(key).HeadObject(ctx, key)
+	obj, err := s.pick(key).HeadObject(ctx, key)
+	if err != nil {
+		if prev, ok := s.pickPrevious(key); ok {
+			if prevObj, prevErr := prev.HeadObject(ctx, key); prevErr == nil {
+				return prevObj, nil
+			}
+		}
+		return nil, err
+	}
+	return obj, nil
+}
+
+// ListObjects merges the objects listed from every shard in key order, a key which is being moved by
+// rebalance may be listed twice.
+func (s *sharded) ListObjects(ctx context.Context, prefix, marker, delimiter string, limit int64) ([]Object, error) {
+	var objs []Object
+	for _, store := range s.stores {
+		listed, err := store.ListObjects(ctx, prefix, marker, delimiter, limit)
+		if err != nil {
+			return nil, err
+		}
+		objs = append(objs, listed...)
+	}
+	sort.SliceStable(objs, func(i, j int) bool { return objs[i].Key() < objs[j].Key() })
+	if limit > 0 && int64(len(objs)) > limit {
+		objs = objs[:limit]
+	}
+	return objs, nil
+}
+
+// Rebalance walks every shard and moves the keys which are not on the shard picked by the
+// current layout, reads stop falling back to the previous layout once it succeeds. The completion
+// is persisted as a marker in the first shard, so the finished rebalance is not walked again and
+// the SP services started later do not fall back. It must only be run by one process at a time,
+// which is the piecestore.rebalance command, since the concurrent moves of the same key race on the
+// copy and delete.
+func (s *sharded) Rebalance(ctx context.Context) (uint64, error) {
+	if !s.migrating.Load() {
+		return 0, nil
+	}
+	var (
+		moved  atomic.Uint64
+		failed atomic.Uint64
+	)
+	for idx, store := range s.stores {
+		marker := ""
+		for {
+			objs, err := store.ListObjects(ctx, "", marker, "", rebalanceBatchSize)
+			if err != nil {
+				log.Errorw("failed to list objects for rebalance", "shard", store, "error", err)
+				return moved.Load(), err
+			}
+			var (
+				wg  sync.WaitGroup
+				sem = make(chan struct{}, s.concurrency)
+			)
+			for _, obj := range objs {
+				key := obj.Key()
+				target := s.current.pick(key)
+				if target == idx || obj.IsSymlink() || strings.HasPrefix(key, rebalanceMarkerPrefix) {
+					continue
+				}
+				sem <- struct{}{}
+				wg.Add(1)
+				go func(from, to int) {
+					defer func() {
+						<-sem
+						wg.Done()
+					}()
+					if err := s.move(ctx, key, from, to); err != nil {
+						log.Errorw("failed to move object to its new shard", "key", key, "from", from, "to", to, "error", err)
+						failed.Add(1)
+						return
+					}
+					moved.Add(1)
+				}(idx, target)
+			}
+			wg.Wait()
+			if ctx.Err() != nil {
+				return moved.Load(), ctx.Err()
+			}
+			if len(objs) < rebalanceBatchSize {
+				break
+			}
+			marker = objs[len(objs)-1].Key()
+		}
+	}
+	if n := failed.Load(); n > 0 {
+		return moved.Load(), fmt.Errorf("failed to move %d objects", n)
+	}
+	done := s.rebalanceMarker()
+	if err := s.stores[0].PutObject(ctx, done, strings.NewReader(s.layoutChange())); err != nil {
+		log.Errorw("failed to persist rebalance marker", "marker", done, "error", err)
+		return moved.Load(), err
+	}
+	s.migrating.Store(false)
+	return moved.Load(), nil
+}
+
+// move copies the key to the target shard if it is not there yet, and then deletes it from
+// the source shard.
+func (s *sharded) move(ctx context.Context, key string, from, to int) error {
+	src, dst := s.stores[from], s.stores[to]
+	if _, err := dst.HeadObject(ctx, key); err != nil {
+		rc, err := src.GetObject(ctx, key, 0, -1)
+		if err != nil {
+			return err
+		}
+		data, err := io.ReadAll(rc)
+		_ = rc.Close()
+		if err != nil {
+			return err
+		}
+		if err = dst.PutObject(ctx, key, bytes.NewReader(data)); err != nil {
+			return err
+		}
+	}
+	return src.DeleteObject(ctx, key)
 }
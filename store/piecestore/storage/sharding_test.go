@@ -307,3 +307,151 @@ func TestSharded_HeadObject(t *testing.T) {
 		})
 	}
 }
+
+func setupMigratingShardedTest(t *testing.T, previous, current shardPicker) *sharded {
+	n := previous.size()
+	if current.size() > n {
+		n = current.size()
+	}
+	stores := make([]ObjectStorage, n)
+	for i := range stores {
+		stores[i], _ = newMemoryStore(ObjectStorageConfig{BucketURL: "test" + string(rune('0'+i))})
+	}
+	return newSharded(stores, current, previous, 2)
+}
+
+func TestNewSharded_ShardRing(t *testing.T) {
+	cfg := PieceStoreConfig{
+		Shards: 3,
+		ShardRing: ShardRingConfig{
+			Enable:         true,
+			Weights:        []uint32{1, 2, 1},
+			PreviousShards: 4,
+		},
+		Store: ObjectStorageConfig{
+			Storage:   MemoryStore,
+			BucketURL: "test%d",
+			IAMType:   AKSKIAMType,
+		},
+	}
+	s, err := NewSharded(cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, "shard3://memory://test0/", s.String())
+	assert.Equal(t, 4, len(s.(*sharded).stores))
+	assert.True(t, s.(*sharded).migrating.Load())
+
+	cfg.ShardRing.Weights = []uint32{1, 2}
+	_, err = NewSharded(cfg)
+	assert.Equal(t, errors.New("mismatched shard weights: 2 weights for 3 shards"), err)
+}
+
+func TestSharded_MigrationFallbackAndRebalance(t *testing.T) {
+	previous := &moduloPicker{shards: 2}
+	current, err := newRendezvousPicker(3, nil)
+	assert.Nil(t, err)
+	s := setupMigratingShardedTest(t, previous, current)
+
+	// pieces written by the previous layout
+	keys := make([]string, 0, 200)
+	for i := 0; i < 200; i++ {
+		key := "s" + strings.Repeat("1", i%7) + "_" + string(rune('a'+i%26)) + string(rune('a'+i/26))
+		keys = append(keys, key)
+		err = s.stores[previous.pick(key)].PutObject(context.TODO(), key, strings.NewReader(key))
+		assert.Nil(t, err)
+	}
+
+	// reads fall back to the previous layout during migration
+	for _, key := range keys {
+		rc, err := s.GetObject(context.TODO(), key, 0, -1)
+		assert.Nil(t, err)
+		data, _ := io.ReadAll(rc)
+		assert.Equal(t, key, string(data))
+		_, err = s.HeadObject(context.TODO(), key)
+		assert.Nil(t, err)
+	}
+
+	moved, err := s.Rebalance(context.TODO())
+	assert.Nil(t, err)
+	assert.True(t, moved > 0)
+	assert.False(t, s.migrating.Load())
+
+	// every key is on its current shard only
+	for _, key := range keys {
+		for i, store := range s.stores {
+			_, err = store.HeadObject(context.TODO(), key)
+			if i == current.pick(key) {
+				assert.Nil(t, err)
+			} else {
+				assert.NotNil(t, err)
+			}
+		}
+		rc, err := s.GetObject(context.TODO(), key, 0, -1)
+		assert.Nil(t, err)
+		data, _ := io.ReadAll(rc)
+		assert.Equal(t, key, string(data))
+	}
+
+	// nothing to move once rebalance finished
+	moved, err = s.Rebalance(context.TODO())
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), moved)
+
+	// the completion is persisted, a restarted process does not fall back or walk the shards again
+	restarted := newSharded(s.stores, current, previous, 2)
+	assert.True(t, restarted.migrating.Load())
+	restarted.loadRebalanced(context.TODO())
+	assert.False(t, restarted.migrating.Load())
+
+	// the marker of another layout change is not treated as finished
+	other := newSharded(s.stores, current, &moduloPicker{shards: 3}, 2)
+	other.loadRebalanced(context.TODO())
+	assert.True(t, other.migrating.Load())
+	moved, err = other.Rebalance(context.TODO())
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), moved)
+	_, err = s.stores[0].HeadObject(context.TODO(), s.rebalanceMarker())
+	assert.Nil(t, err)
+}
+
+func TestSharded_ListObjects(t *testing.T) {
+	current, err := newRendezvousPicker(3, nil)
+	assert.Nil(t, err)
+	s := newSharded(setupMigratingShardedTest(t, current, current).stores, current, nil, 2)
+	keys := make([]string, 0, 26)
+	for i := 0; i < 26; i++ {
+		key := "k" + string(rune('a'+i))
+		keys = append(keys, key)
+		assert.Nil(t, s.PutObject(context.TODO(), key, strings.NewReader(key)))
+	}
+
+	var listed []string
+	marker := ""
+	for {
+		objs, err := s.ListObjects(context.TODO(), "", marker, "", 10)
+		assert.Nil(t, err)
+		for _, obj := range objs {
+			listed = append(listed, obj.Key())
+		}
+		if len(objs) < 10 {
+			break
+		}
+		marker = objs[len(objs)-1].Key()
+	}
+	assert.Equal(t, keys, listed)
+}
+
+func TestSharded_DeleteObjectDuringMigration(t *testing.T) {
+	previous := &moduloPicker{shards: 2}
+	current, err := newRendezvousPicker(3, nil)
+	assert.Nil(t, err)
+	s := setupMigratingShardedTest(t, previous, current)
+
+	key := mockKey
+	for s.current.pick(key) == previous.pick(key) {
+		key += "x"
+	}
+	assert.Nil(t, s.stores[previous.pick(key)].PutObject(context.TODO(), key, strings.NewReader("old")))
+	assert.Nil(t, s.DeleteObject(context.TODO(), key))
+	_, err = s.GetObject(context.TODO(), key, 0, -1)
+	assert.Equal(t, ErrNoSuchObject, err)
+}
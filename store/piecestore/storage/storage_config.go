@@ -4,8 +4,65 @@ package storage
 type PieceStoreConfig struct {
 	// Shards store the blocks into N buckets by hash of key
 	Shards int `comment:"required"`
+	// ShardRing config of consistent-hash shard ring, if it is not enabled, the shard is picked by fnv32 % Shards
+	ShardRing ShardRingConfig `comment:"optional"`
 	// Store config of object storage
 	Store ObjectStorageConfig
+	// Tiered config of local cache tier in front of Store
+	Tiered TieredStoreConfig `comment:"optional"`
+	// Encryption config of encryption-at-rest, the pieces are encrypted before they are written to Store
+	Encryption EncryptionConfig `comment:"optional"`
+}
+
+// ShardRingConfig consistent-hash shard ring config, it also describes the previous layout when
+// the number or weights of shards are being changed
+type ShardRingConfig struct {
+	// Enable whether use weighted rendezvous hashing to pick the shard of a key
+	Enable bool `comment:"optional"`
+	// Weights the weight of each shard, the length must be equal to Shards, default weight is 1
+	Weights []uint32 `comment:"optional"`
+	// PreviousShards the number of shards before resizing, reads fall back to the shard picked by
+	// the previous layout until rebalance finishes, 0 means no resizing is in progress
+	PreviousShards int `comment:"optional"`
+	// PreviousWeights the weight of each shard before resizing
+	PreviousWeights []uint32 `comment:"optional"`
+	// PreviousRing whether the previous layout used the shard ring, otherwise it used fnv32 % PreviousShards
+	PreviousRing bool `comment:"optional"`
+	// RebalanceConcurrency the number of keys which are moved concurrently by piecestore.rebalance command, default is 4
+	RebalanceConcurrency int `comment:"optional"`
+}
+
+// TieredStoreConfig local cache tier config, the local tier caches hot pieces of the remote
+// object storage configured by PieceStoreConfig.Store
+type TieredStoreConfig struct {
+	// Enable whether put a local cache tier in front of the remote object storage
+	Enable bool `comment:"optional"`
+	// Store config of local cache tier, only file and ldfs are supported
+	Store ObjectStorageConfig `comment:"optional"`
+	// CapacityBytes the max bytes of pieces that local cache tier holds
+	CapacityBytes int64 `comment:"optional"`
+	// HighWatermark the ratio of capacity that triggers eviction, default is 0.9
+	HighWatermark float64 `comment:"optional"`
+	// LowWatermark the ratio of capacity that eviction stops at, default is 0.7
+	LowWatermark float64 `comment:"optional"`
+}
+
+// EncryptionConfig encryption-at-rest config, the pieces are encrypted by per-piece data keys which are
+// wrapped by the master key
+type EncryptionConfig struct {
+	// Enable whether encrypt the pieces before they are written to object storage
+	Enable bool `comment:"optional"`
+	// KeyProvider the provider of master keys, file or kms
+	KeyProvider string `comment:"optional"`
+	// KeyFile the path of master key file which is used by file key provider, it is a json file such as
+	// {"ActiveKeyID": "key-2", "Keys": {"key-1": "<base64 key>", "key-2": "<base64 key>"}}
+	KeyFile string `comment:"optional"`
+	// KMSEndpoint the endpoint of KMS-compatible service which is used by kms key provider
+	KMSEndpoint string `comment:"optional"`
+	// KMSRegion the region of KMS-compatible service
+	KMSRegion string `comment:"optional"`
+	// KMSKeyID the id of master key in KMS which wraps the new data keys
+	KMSKeyID string `comment:"optional"`
 }
 
 // ObjectStorageConfig object storage config
@@ -22,4 +79,14 @@ type ObjectStorageConfig struct {
 	TLSInsecureSkipVerify bool `comment:"optional"`
 	// IAMType is identity and access management type which contains two types: AKSKIAMType/SAIAMType
 	IAMType string `comment:"required"`
+	// MultipartThreshold the object size from which PutObject uploads by multipart upload, only s3, oss, b2 and
+	// minio support multipart upload, 0 means multipart upload is disabled
+	MultipartThreshold int64 `comment:"optional"`
+	// MultipartPartSize the size of each part of multipart upload, default is 8MB, the min is 5MB
+	MultipartPartSize int64 `comment:"optional"`
+	// MultipartConcurrency the number of parts which are uploaded concurrently, default is 4
+	MultipartConcurrency int `comment:"optional"`
+	// MultipartOrphanTimeoutSecond the incomplete multipart uploads initiated earlier than the timeout are
+	// aborted at startup and periodically, default is 24 hours
+	MultipartOrphanTimeoutSecond int64 `comment:"optional"`
 }
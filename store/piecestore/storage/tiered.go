@@ -0,0 +1,549 @@
+package storage
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
+)
+
+const (
+	// TieredCacheHit defines the metrics label of piece served by local cache tier
+	TieredCacheHit = "tiered_cache_hit"
+	// TieredCacheMiss defines the metrics label of piece fetched from remote tier
+	TieredCacheMiss = "tiered_cache_miss"
+	// TieredCacheEvict defines the metrics label of piece evicted from local cache tier
+	TieredCacheEvict = "tiered_cache_evict"
+	// TieredCacheUsage defines the metrics label of bytes used by local cache tier
+	TieredCacheUsage = "tiered_cache_usage"
+
+	// DefaultTieredHighWatermark defines the default ratio of capacity that triggers eviction
+	DefaultTieredHighWatermark = 0.9
+	// DefaultTieredLowWatermark defines the default ratio of capacity that eviction stops at
+	DefaultTieredLowWatermark = 0.7
+
+	tieredListBatchSize = 1000
+)
+
+var (
+	_ ObjectStorage = &tiered{}
+	_ Rebalancer    = &tiered{}
+)
+
+// tiered puts a bounded local cache tier in front of a remote object storage. Writes go
+// through to the remote tier before being admitted to the local tier, reads are served
+// from the local tier when the piece is hot, and the local tier is evicted by LRU when
+// its usage exceeds the high watermark.
+type tiered struct {
+	local  ObjectStorage
+	remote ObjectStorage
+
+	capacity int64
+	high     int64
+	low      int64
+
+	mu      sync.Mutex
+	lru     *list.List
+	entries map[string]*list.Element
+	used    int64
+	// pending records the admissions in flight, and filling is the keys which are admitted in background
+	pending map[*tieredAdmission]struct{}
+	filling map[string]struct{}
+	fillWg  sync.WaitGroup
+	DefaultObjectStorage
+}
+
+type tieredEntry struct {
+	key  string
+	size int64
+}
+
+// tieredAdmission is an admission in flight, deleting the key before the admission finishes marks it
+// stale, so the deleted piece is not put back to local tier.
+type tieredAdmission struct {
+	key   string
+	stale bool
+}
+
+// NewTiered returns an object storage which uses cfg.Tiered.Store as the local cache tier
+// and cfg.Store (sharded if cfg.Shards > 1) as the remote tier.
+func NewTiered(cfg PieceStoreConfig) (ObjectStorage, error) {
+	tc := cfg.Tiered
+	switch strings.ToLower(tc.Store.Storage) {
+	case DiskFileStore, LdfsStore:
+	default:
+		return nil, fmt.Errorf("invalid tiered local storage: %s", tc.Store.Storage)
+	}
+	if tc.CapacityBytes <= 0 {
+		return nil, fmt.Errorf("invalid tiered capacity: %d", tc.CapacityBytes)
+	}
+	high, low := tc.HighWatermark, tc.LowWatermark
+	if high == 0 {
+		high = DefaultTieredHighWatermark
+	}
+	if low == 0 {
+		low = DefaultTieredLowWatermark
+	}
+	if high > 1 || low <= 0 || low >= high {
+		return nil, fmt.Errorf("invalid tiered watermark: high %v, low %v", high, low)
+	}
+
+	var (
+		remote ObjectStorage
+		err    error
+	)
+	if cfg.Shards > 1 || cfg.ShardRing.PreviousShards > 1 {
+		remote, err = NewSharded(cfg)
+	} else {
+		remote, err = NewObjectStorage(cfg.Store)
+	}
+	if err != nil {
+		return nil, err
+	}
+	local, err := NewObjectStorage(tc.Store)
+	if err != nil {
+		return nil, err
+	}
+	return newTiered(local, remote, tc.CapacityBytes, high, low), nil
+}
+
+func newTiered(local, remote ObjectStorage, capacity int64, high, low float64) *tiered {
+	return &tiered{
+		local:    local,
+		remote:   remote,
+		capacity: capacity,
+		high:     int64(float64(capacity) * high),
+		low:      int64(float64(capacity) * low),
+		lru:      list.New(),
+		entries:  make(map[string]*list.Element),
+		pending:  make(map[*tieredAdmission]struct{}),
+		filling:  make(map[string]struct{}),
+	}
+}
+
+func (t *tiered) String() string {
+	return fmt.Sprintf("tiered://%s|%s", t.local, t.remote)
+}
+
+func (t *tiered) Close() error {
+	if err := CloseObjectStorage(t.remote); err != nil {
+		return err
+	}
+	return CloseObjectStorage(t.local)
+}
+
+func (t *tiered) CreateBucket(ctx context.Context) error {
+	if err := t.remote.CreateBucket(ctx); err != nil {
+		return err
+	}
+	return t.local.CreateBucket(ctx)
+}
+
+// HeadBucket checks both tiers, and loads the pieces already cached in local tier into the
+// LRU index once the local bucket is reachable.
+func (t *tiered) HeadBucket(ctx context.Context) error {
+	if err := t.remote.HeadBucket(ctx); err != nil {
+		return err
+	}
+	if err := t.local.HeadBucket(ctx); err != nil {
+		if errors.Is(err, ErrNoSuchBucket) {
+			if err = t.local.CreateBucket(ctx); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+	}
+	t.warmUp(ctx)
+	return nil
+}
+
+func (t *tiered) GetObject(ctx context.Context, key string, offset, limit int64) (io.ReadCloser, error) {
+	if t.touch(key) {
+		rc, err := t.local.GetObject(ctx, key, offset, limit)
+		if err == nil {
+			metrics.PieceStoreCacheCounter.WithLabelValues(TieredCacheHit).Inc()
+			return rc, nil
+		}
+		log.Warnw("failed to get object from local tier, fall back to remote tier", "key", key, "error", err)
+		t.forget(key)
+	}
+	metrics.PieceStoreCacheCounter.WithLabelValues(TieredCacheMiss).Inc()
+
+	if offset > 0 || limit > 0 {
+		// serve the range from remote tier, and admit the whole piece in background
+		rc, err := t.remote.GetObject(ctx, key, offset, limit)
+		if err != nil {
+			return nil, err
+		}
+		t.fill(key)
+		return rc, nil
+	}
+
+	admission := t.beginAdmit(key)
+	rc, err := t.remote.GetObject(ctx, key, 0, -1)
+	if err != nil {
+		t.endAdmit(admission)
+		return nil, err
+	}
+	defer rc.Close()
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		t.endAdmit(admission)
+		return nil, err
+	}
+	t.admit(ctx, admission, data)
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+// fill admits the whole piece to local tier in background, the piece which is too large to be cached is
+// not downloaded.
+func (t *tiered) fill(key string) {
+	t.mu.Lock()
+	if _, ok := t.filling[key]; ok {
+		t.mu.Unlock()
+		return
+	}
+	t.filling[key] = struct{}{}
+	t.mu.Unlock()
+
+	admission := t.beginAdmit(key)
+	t.fillWg.Add(1)
+	go func() {
+		defer func() {
+			t.mu.Lock()
+			delete(t.filling, key)
+			t.mu.Unlock()
+			t.fillWg.Done()
+		}()
+		ctx := context.Background()
+		obj, err := t.remote.HeadObject(ctx, key)
+		if err != nil || obj.Size() > t.low {
+			t.endAdmit(admission)
+			return
+		}
+		rc, err := t.remote.GetObject(ctx, key, 0, -1)
+		if err != nil {
+			log.Warnw("failed to get object from remote tier to admit", "key", key, "error", err)
+			t.endAdmit(admission)
+			return
+		}
+		defer rc.Close()
+		data, err := io.ReadAll(rc)
+		if err != nil {
+			log.Warnw("failed to read object from remote tier to admit", "key", key, "error", err)
+			t.endAdmit(admission)
+			return
+		}
+		t.admit(ctx, admission, data)
+	}()
+}
+
+// PutObject writes the data to remote tier first, the local tier is only a cache and
+// failing to admit the data to it will not fail the put.
+func (t *tiered) PutObject(ctx context.Context, key string, reader io.Reader) error {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	admission := t.beginAdmit(key)
+	if err = t.remote.PutObject(ctx, key, bytes.NewReader(data)); err != nil {
+		t.endAdmit(admission)
+		return err
+	}
+	t.admit(ctx, admission, data)
+	return nil
+}
+
+func (t *tiered) DeleteObject(ctx context.Context, key string) error {
+	if err := t.remote.DeleteObject(ctx, key); err != nil {
+		return err
+	}
+	t.markStale(func(k string) bool { return k == key })
+	if t.forget(key) {
+		if err := t.local.DeleteObject(ctx, key); err != nil {
+			log.Errorw("failed to delete object from local tier", "key", key, "error", err)
+		}
+	}
+	return nil
+}
+
+func (t *tiered) DeleteObjectsByPrefix(ctx context.Context, key string) (uint64, error) {
+	size, err := t.remote.DeleteObjectsByPrefix(ctx, key)
+	if err != nil {
+		return size, err
+	}
+	t.markStale(func(k string) bool { return strings.HasPrefix(k, key) })
+	t.mu.Lock()
+	var keys []string
+	for k := range t.entries {
+		if strings.HasPrefix(k, key) {
+			keys = append(keys, k)
+		}
+	}
+	t.mu.Unlock()
+	for _, k := range keys {
+		t.forget(k)
+		if err = t.local.DeleteObject(ctx, k); err != nil {
+			log.Errorw("failed to delete object from local tier", "key", k, "error", err)
+		}
+	}
+	return size, nil
+}
+
+func (t *tiered) HeadObject(ctx context.Context, key string) (Object, error) {
+	if t.contains(key) {
+		if obj, err := t.local.HeadObject(ctx, key); err == nil {
+			return obj, nil
+		}
+	}
+	return t.remote.HeadObject(ctx, key)
+}
+
+func (t *tiered) ListObjects(ctx context.Context, prefix, marker, delimiter string, limit int64) ([]Object, error) {
+	return t.remote.ListObjects(ctx, prefix, marker, delimiter, limit)
+}
+
+func (t *tiered) ListAllObjects(ctx context.Context, prefix, marker string) (<-chan Object, error) {
+	return t.remote.ListAllObjects(ctx, prefix, marker)
+}
+
+// Rebalance rebalances the remote tier if it is sharded, the local tier is keyed by piece
+// key and is not affected by the shard layout.
+func (t *tiered) Rebalance(ctx context.Context) (uint64, error) {
+	if r, ok := t.remote.(Rebalancer); ok {
+		return r.Rebalance(ctx)
+	}
+	return 0, nil
+}
+
+// touch moves the key to the front of LRU list and returns whether it is cached.
+func (t *tiered) touch(key string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	e, ok := t.entries[key]
+	if ok {
+		t.lru.MoveToFront(e)
+	}
+	return ok
+}
+
+func (t *tiered) contains(key string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, ok := t.entries[key]
+	return ok
+}
+
+// forget removes the key from LRU index and returns whether it was cached.
+func (t *tiered) forget(key string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	e, ok := t.entries[key]
+	if !ok {
+		return false
+	}
+	t.removeElement(e)
+	return true
+}
+
+func (t *tiered) removeElement(e *list.Element) {
+	entry := t.lru.Remove(e).(*tieredEntry)
+	delete(t.entries, entry.key)
+	t.used -= entry.size
+	metrics.PieceStoreCacheUsageGauge.WithLabelValues(TieredCacheUsage).Set(float64(t.used))
+}
+
+// beginAdmit records an admission in flight, it must be ended by admit or endAdmit.
+func (t *tiered) beginAdmit(key string) *tieredAdmission {
+	admission := &tieredAdmission{key: key}
+	t.mu.Lock()
+	t.pending[admission] = struct{}{}
+	t.mu.Unlock()
+	return admission
+}
+
+// endAdmit removes the admission and returns whether the key has been deleted since it began.
+func (t *tiered) endAdmit(admission *tieredAdmission) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.pending, admission)
+	return admission.stale
+}
+
+// markStale marks the admissions in flight of the deleted keys as stale.
+func (t *tiered) markStale(deleted func(key string) bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for admission := range t.pending {
+		if deleted(admission.key) {
+			admission.stale = true
+		}
+	}
+}
+
+// admit puts the data to local tier and evicts the least recently used pieces if the
+// usage exceeds the high watermark. The data is dropped if the key has been deleted
+// since the admission began.
+func (t *tiered) admit(ctx context.Context, admission *tieredAdmission, data []byte) {
+	key, size := admission.key, int64(len(data))
+	if size > t.low {
+		t.endAdmit(admission)
+		// too large to be cached, drop the stale copy if any
+		if t.forget(key) {
+			_ = t.local.DeleteObject(ctx, key)
+		}
+		return
+	}
+	if err := t.local.PutObject(ctx, key, bytes.NewReader(data)); err != nil {
+		log.Errorw("failed to put object to local tier", "key", key, "error", err)
+		t.endAdmit(admission)
+		t.forget(key)
+		return
+	}
+	t.mu.Lock()
+	delete(t.pending, admission)
+	if !admission.stale {
+		t.trackLocked(key, size, true)
+	}
+	// the key may have been put again after the delete, keep the local copy of the newer put
+	_, tracked := t.entries[key]
+	t.mu.Unlock()
+	if admission.stale {
+		if tracked {
+			return
+		}
+		log.Infow("drop the admission of deleted object", "key", key)
+		if err := t.local.DeleteObject(ctx, key); err != nil {
+			log.Errorw("failed to delete object from local tier", "key", key, "error", err)
+		}
+		return
+	}
+	t.evict(ctx)
+}
+
+// track records the key in LRU index, front indicates the key is the most recently used one.
+func (t *tiered) track(key string, size int64, front bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.trackLocked(key, size, front)
+}
+
+func (t *tiered) trackLocked(key string, size int64, front bool) {
+	if e, ok := t.entries[key]; ok {
+		t.removeElement(e)
+	}
+	entry := &tieredEntry{key: key, size: size}
+	if front {
+		t.entries[key] = t.lru.PushFront(entry)
+	} else {
+		t.entries[key] = t.lru.PushBack(entry)
+	}
+	t.used += size
+	metrics.PieceStoreCacheUsageGauge.WithLabelValues(TieredCacheUsage).Set(float64(t.used))
+}
+
+func (t *tiered) evict(ctx context.Context) {
+	t.mu.Lock()
+	if t.used <= t.high {
+		t.mu.Unlock()
+		return
+	}
+	var victims []string
+	for t.used > t.low {
+		e := t.lru.Back()
+		if e == nil {
+			break
+		}
+		victims = append(victims, e.Value.(*tieredEntry).key)
+		t.removeElement(e)
+	}
+	t.mu.Unlock()
+
+	for _, key := range victims {
+		if err := t.local.DeleteObject(ctx, key); err != nil {
+			log.Errorw("failed to evict object from local tier", "key", key, "error", err)
+		}
+		metrics.PieceStoreCacheCounter.WithLabelValues(TieredCacheEvict).Inc()
+	}
+}
+
+// warmUp loads the pieces which are already in local tier into LRU index, the older
+// pieces are regarded as less recently used.
+func (t *tiered) warmUp(ctx context.Context) {
+	objs, err := t.listLocal(ctx)
+	if err != nil {
+		log.Warnw("failed to list local tier, start with a cold cache", "error", err)
+		return
+	}
+	sort.Slice(objs, func(i, j int) bool { return objs[i].ModTime().After(objs[j].ModTime()) })
+	for _, obj := range objs {
+		if obj.IsDir() || t.contains(obj.Key()) {
+			continue
+		}
+		t.track(obj.Key(), obj.Size(), false)
+	}
+	log.Infow("succeed to warm up local tier", "pieces", len(objs), "used", t.used)
+	t.evict(ctx)
+}
+
+type dirObject interface {
+	Object
+	IsDir() bool
+}
+
+func (t *tiered) listLocal(ctx context.Context) ([]dirObject, error) {
+	if d, ok := t.local.(*diskFileStore); ok {
+		return d.listRoot()
+	}
+	var (
+		marker string
+		objs   []dirObject
+	)
+	for {
+		batch, err := t.local.ListObjects(ctx, "", marker, "", tieredListBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range batch {
+			if o, ok := obj.(dirObject); ok {
+				objs = append(objs, o)
+			}
+		}
+		if len(batch) < tieredListBatchSize {
+			return objs, nil
+		}
+		marker = batch[len(batch)-1].Key()
+	}
+}
+
+// listRoot lists the regular files directly under the root directory, piece keys have no
+// directory component so the piece files are all placed there.
+func (d *diskFileStore) listRoot() ([]dirObject, error) {
+	dirEntries, err := os.ReadDir(d.root)
+	if err != nil {
+		return nil, err
+	}
+	objs := make([]dirObject, 0, len(dirEntries))
+	for _, dirEntry := range dirEntries {
+		// skip the temporary files left by interrupted PutObject
+		if dirEntry.IsDir() || strings.HasPrefix(dirEntry.Name(), ".") {
+			continue
+		}
+		info, err := dirEntry.Info()
+		if err != nil {
+			continue
+		}
+		objs = append(objs, &object{dirEntry.Name(), info.Size(), info.ModTime(), false})
+	}
+	return objs, nil
+}
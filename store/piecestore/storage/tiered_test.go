@@ -0,0 +1,247 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupTieredTest(t *testing.T, capacity int64) *tiered {
+	local := &diskFileStore{root: t.TempDir() + "/"}
+	remote, err := newMemoryStore(ObjectStorageConfig{BucketURL: "remote"})
+	assert.Nil(t, err)
+	return newTiered(local, remote, capacity, DefaultTieredHighWatermark, DefaultTieredLowWatermark)
+}
+
+func readAll(t *testing.T, rc io.ReadCloser) string {
+	defer rc.Close()
+	data, err := io.ReadAll(rc)
+	assert.Nil(t, err)
+	return string(data)
+}
+
+func TestNewTiered(t *testing.T) {
+	cases := []struct {
+		name      string
+		cfg       PieceStoreConfig
+		wantedErr error
+	}{
+		{
+			name: "correct tiered cfg",
+			cfg: PieceStoreConfig{
+				Store: ObjectStorageConfig{Storage: MemoryStore, BucketURL: "remote", IAMType: AKSKIAMType},
+				Tiered: TieredStoreConfig{
+					Enable:        true,
+					Store:         ObjectStorageConfig{Storage: DiskFileStore, BucketURL: t.TempDir()},
+					CapacityBytes: 1024,
+				},
+			},
+			wantedErr: nil,
+		},
+		{
+			name: "invalid local storage type",
+			cfg: PieceStoreConfig{
+				Store: ObjectStorageConfig{Storage: MemoryStore, BucketURL: "remote", IAMType: AKSKIAMType},
+				Tiered: TieredStoreConfig{
+					Enable:        true,
+					Store:         ObjectStorageConfig{Storage: S3Store},
+					CapacityBytes: 1024,
+				},
+			},
+			wantedErr: errors.New("invalid tiered local storage: s3"),
+		},
+		{
+			name: "invalid capacity",
+			cfg: PieceStoreConfig{
+				Store: ObjectStorageConfig{Storage: MemoryStore, BucketURL: "remote", IAMType: AKSKIAMType},
+				Tiered: TieredStoreConfig{
+					Enable: true,
+					Store:  ObjectStorageConfig{Storage: DiskFileStore, BucketURL: t.TempDir()},
+				},
+			},
+			wantedErr: errors.New("invalid tiered capacity: 0"),
+		},
+		{
+			name: "invalid watermark",
+			cfg: PieceStoreConfig{
+				Store: ObjectStorageConfig{Storage: MemoryStore, BucketURL: "remote", IAMType: AKSKIAMType},
+				Tiered: TieredStoreConfig{
+					Enable:        true,
+					Store:         ObjectStorageConfig{Storage: DiskFileStore, BucketURL: t.TempDir()},
+					CapacityBytes: 1024,
+					HighWatermark: 0.5,
+					LowWatermark:  0.6,
+				},
+			},
+			wantedErr: errors.New("invalid tiered watermark: high 0.5, low 0.6"),
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewTiered(tt.cfg)
+			assert.Equal(t, tt.wantedErr, err)
+			if tt.wantedErr != nil {
+				assert.Nil(t, result)
+			} else {
+				assert.NotNil(t, result)
+				assert.Nil(t, result.HeadBucket(context.TODO()))
+			}
+		})
+	}
+}
+
+func TestTiered_PutAndGetObject(t *testing.T) {
+	s := setupTieredTest(t, 1024)
+	err := s.PutObject(context.TODO(), mockKey, strings.NewReader("hello tiered"))
+	assert.Nil(t, err)
+
+	// written through to remote tier
+	rc, err := s.remote.GetObject(context.TODO(), mockKey, 0, -1)
+	assert.Nil(t, err)
+	assert.Equal(t, "hello tiered", readAll(t, rc))
+	// admitted to local tier
+	rc, err = s.local.GetObject(context.TODO(), mockKey, 0, -1)
+	assert.Nil(t, err)
+	assert.Equal(t, "hello tiered", readAll(t, rc))
+
+	rc, err = s.GetObject(context.TODO(), mockKey, 6, 4)
+	assert.Nil(t, err)
+	assert.Equal(t, "tier", readAll(t, rc))
+}
+
+func TestTiered_GetObjectMiss(t *testing.T) {
+	s := setupTieredTest(t, 1024)
+	err := s.remote.PutObject(context.TODO(), mockKey, strings.NewReader("cold piece"))
+	assert.Nil(t, err)
+	assert.False(t, s.contains(mockKey))
+
+	// the range is served from remote tier, the whole piece is admitted in background
+	rc, err := s.GetObject(context.TODO(), mockKey, 5, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, "piece", readAll(t, rc))
+	s.fillWg.Wait()
+	assert.True(t, s.contains(mockKey))
+	assert.Equal(t, int64(len("cold piece")), s.used)
+
+	_, err = s.GetObject(context.TODO(), "not_exist", 0, -1)
+	assert.Equal(t, ErrNoSuchObject, err)
+	_, err = s.GetObject(context.TODO(), "not_exist", 1, 2)
+	assert.Equal(t, ErrNoSuchObject, err)
+
+	// the piece which is too large to be cached is not admitted
+	assert.Nil(t, s.remote.PutObject(context.TODO(), "large", strings.NewReader(strings.Repeat("a", 800))))
+	rc, err = s.GetObject(context.TODO(), "large", 10, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, "aaaaa", readAll(t, rc))
+	s.fillWg.Wait()
+	assert.False(t, s.contains("large"))
+}
+
+func TestTiered_AdmitAfterDelete(t *testing.T) {
+	s := setupTieredTest(t, 1024)
+	assert.Nil(t, s.remote.PutObject(context.TODO(), mockKey, strings.NewReader("data")))
+
+	// the piece is deleted while it is being admitted
+	admission := s.beginAdmit(mockKey)
+	assert.Nil(t, s.DeleteObject(context.TODO(), mockKey))
+	s.admit(context.TODO(), admission, []byte("data"))
+	assert.False(t, s.contains(mockKey))
+	assert.Empty(t, s.pending)
+	_, err := os.Stat(filepath.Join(s.local.(*diskFileStore).root, mockKey))
+	assert.True(t, os.IsNotExist(err))
+
+	// the deletion by prefix marks the admissions stale as well
+	admission = s.beginAdmit("s1_s0")
+	_, err = s.DeleteObjectsByPrefix(context.TODO(), "s1_")
+	assert.Nil(t, err)
+	s.admit(context.TODO(), admission, []byte("data"))
+	assert.False(t, s.contains("s1_s0"))
+
+	// the admission which begins after the delete is not affected
+	admission = s.beginAdmit(mockKey)
+	s.admit(context.TODO(), admission, []byte("data"))
+	assert.True(t, s.contains(mockKey))
+}
+
+func TestTiered_GetObjectFallback(t *testing.T) {
+	s := setupTieredTest(t, 1024)
+	err := s.PutObject(context.TODO(), mockKey, strings.NewReader("hello"))
+	assert.Nil(t, err)
+	// remove the cached file behind the tiered store
+	assert.Nil(t, os.Remove(filepath.Join(s.local.(*diskFileStore).root, mockKey)))
+
+	rc, err := s.GetObject(context.TODO(), mockKey, 0, -1)
+	assert.Nil(t, err)
+	assert.Equal(t, "hello", readAll(t, rc))
+	assert.True(t, s.contains(mockKey))
+}
+
+func TestTiered_Evict(t *testing.T) {
+	s := setupTieredTest(t, 100)
+	keys := []string{"s1", "s2", "s3", "s4"}
+	for _, key := range keys {
+		err := s.PutObject(context.TODO(), key, strings.NewReader(strings.Repeat("a", 30)))
+		assert.Nil(t, err)
+	}
+	// 120 bytes exceeds high watermark 90, evict to low watermark 70
+	assert.Equal(t, int64(60), s.used)
+	assert.False(t, s.contains("s1"))
+	assert.False(t, s.contains("s2"))
+	assert.True(t, s.contains("s3"))
+	assert.True(t, s.contains("s4"))
+	_, err := os.Stat(filepath.Join(s.local.(*diskFileStore).root, "s1"))
+	assert.True(t, os.IsNotExist(err))
+
+	// evicted pieces are still readable from remote tier
+	rc, err := s.GetObject(context.TODO(), "s1", 0, -1)
+	assert.Nil(t, err)
+	assert.Equal(t, strings.Repeat("a", 30), readAll(t, rc))
+
+	// too large to be cached
+	err = s.PutObject(context.TODO(), "s5", strings.NewReader(strings.Repeat("a", 80)))
+	assert.Nil(t, err)
+	assert.False(t, s.contains("s5"))
+}
+
+func TestTiered_DeleteObject(t *testing.T) {
+	s := setupTieredTest(t, 1024)
+	for _, key := range []string{"s1_s0", "s1_s1", "s2_s0"} {
+		assert.Nil(t, s.PutObject(context.TODO(), key, strings.NewReader("data")))
+	}
+	assert.Nil(t, s.DeleteObject(context.TODO(), "s2_s0"))
+	assert.False(t, s.contains("s2_s0"))
+	_, err := s.remote.HeadObject(context.TODO(), "s2_s0")
+	assert.NotNil(t, err)
+
+	size, err := s.DeleteObjectsByPrefix(context.TODO(), "s1_")
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(8), size)
+	assert.Equal(t, int64(0), s.used)
+	_, err = os.Stat(filepath.Join(s.local.(*diskFileStore).root, "s1_s0"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestTiered_WarmUp(t *testing.T) {
+	root := t.TempDir() + "/"
+	local := &diskFileStore{root: root}
+	assert.Nil(t, local.PutObject(context.TODO(), "s1", strings.NewReader("cached")))
+	assert.Nil(t, os.WriteFile(filepath.Join(root, ".s2.tmp1"), []byte("tmp"), 0644))
+	remote, err := newMemoryStore(ObjectStorageConfig{BucketURL: "remote"})
+	assert.Nil(t, err)
+
+	s := newTiered(local, remote, 1024, DefaultTieredHighWatermark, DefaultTieredLowWatermark)
+	assert.Nil(t, s.HeadBucket(context.TODO()))
+	assert.True(t, s.contains("s1"))
+	assert.False(t, s.contains(".s2.tmp1"))
+	assert.Equal(t, int64(len("cached")), s.used)
+
+	rc, err := s.GetObject(context.TODO(), "s1", 0, -1)
+	assert.Nil(t, err)
+	assert.Equal(t, "cached", readAll(t, rc))
+}
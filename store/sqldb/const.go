@@ -26,6 +26,10 @@ const (
 	OffChainAuthKeyTableName = "off_chain_auth_key"
 	// OffChainAuthKeyV2TableName defines the off chain auth key table name v2 version.
 	OffChainAuthKeyV2TableName = "off_chain_auth_key_v2"
+	// S3AccessKeyTableName defines the access keys of the S3 compatible api.
+	S3AccessKeyTableName = "s3_access_key"
+	// RateLimitCounterTableName defines the fixed window counters of the api rate limiter.
+	RateLimitCounterTableName = "rate_limit_counter"
 	// PutObjectSuccessTableName  defines the event of successfully putting object
 	PutObjectSuccessTableName = "put_object_success_event_log"
 	// PutObjectEventTableName defines the event of putting object
@@ -54,6 +58,14 @@ const (
 	RecoverFailedObjectTableName = "recover_failed_object"
 	// MigrateBucketProgressTableName defines the progress of migrate bucket.
 	MigrateBucketProgressTableName = "migrate_bucket_progress"
+	// QueuedTaskTableName defines the tasks of the durable task queues.
+	QueuedTaskTableName = "queued_task"
+	// ScrubProgressTableName defines the checkpoints of the piece integrity scrubber.
+	ScrubProgressTableName = "scrub_progress"
+	// SignerTxTableName defines the lifecycle of the txs broadcast by the signer accounts.
+	SignerTxTableName = "signer_tx"
+	// InventoryProgressTableName defines the checkpoints of the bucket inventory reports.
+	InventoryProgressTableName = "inventory_progress"
 )
 
 // define error name constant.
@@ -0,0 +1,54 @@
+package sqldb
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	corespdb "github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+)
+
+// UpdateInventoryProgress is used to update the inventory progress, inserts a new one if it is not found in db.
+func (s *SpDBImpl) UpdateInventoryProgress(progress *corespdb.InventoryProgress) error {
+	updateRecord := &InventoryProgressTable{
+		BucketName:     progress.BucketName,
+		ReportTime:     progress.ReportTime,
+		StartAfter:     progress.StartAfter,
+		FileCount:      progress.FileCount,
+		ObjectCount:    progress.ObjectCount,
+		LastReportTime: progress.LastReportTime,
+		UpdateTime:     progress.UpdateTime,
+	}
+	err := s.db.Table(InventoryProgressTableName).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "bucket_name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"report_time", "start_after", "file_count", "object_count",
+			"last_report_time", "update_time"}),
+	}).Create(updateRecord).Error
+	if err != nil {
+		return fmt.Errorf("failed to update inventory progress: %s", err)
+	}
+	return nil
+}
+
+// QueryInventoryProgress returns the inventory progress, returns an empty progress if it is not found in db.
+func (s *SpDBImpl) QueryInventoryProgress(bucketName string) (*corespdb.InventoryProgress, error) {
+	queryReturn := &InventoryProgressTable{}
+	result := s.db.First(queryReturn, "bucket_name = ?", bucketName)
+	if result.Error != nil && errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return &corespdb.InventoryProgress{BucketName: bucketName}, nil
+	}
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &corespdb.InventoryProgress{
+		BucketName:     queryReturn.BucketName,
+		ReportTime:     queryReturn.ReportTime,
+		StartAfter:     queryReturn.StartAfter,
+		FileCount:      queryReturn.FileCount,
+		ObjectCount:    queryReturn.ObjectCount,
+		LastReportTime: queryReturn.LastReportTime,
+		UpdateTime:     queryReturn.UpdateTime,
+	}, nil
+}
@@ -0,0 +1,17 @@
+package sqldb
+
+// InventoryProgressTable table schema
+type InventoryProgressTable struct {
+	BucketName     string `gorm:"primary_key;type:varchar(64)"`
+	ReportTime     int64
+	StartAfter     string `gorm:"type:varchar(1024)"`
+	FileCount      uint32
+	ObjectCount    uint64
+	LastReportTime int64
+	UpdateTime     int64
+}
+
+// TableName is used to set InventoryProgressTable Schema's table name in database
+func (InventoryProgressTable) TableName() string {
+	return InventoryProgressTableName
+}
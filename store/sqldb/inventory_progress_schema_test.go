@@ -0,0 +1,13 @@
+package sqldb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInventoryProgressTable_TableName(t *testing.T) {
+	table := InventoryProgressTable{BucketName: "mockBucketName"}
+	result := table.TableName()
+	assert.Equal(t, InventoryProgressTableName, result)
+}
@@ -0,0 +1,83 @@
+package sqldb
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	corespdb "github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+)
+
+const (
+	mockInventoryBucketName        = "mock-bucket"
+	mockInventoryProgressUpdateSQL = "INSERT INTO `inventory_progress` (`bucket_name`,`report_time`,`start_after`,`file_count`,`object_count`,`last_report_time`,`update_time`) VALUES (?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `report_time`=VALUES(`report_time`),`start_after`=VALUES(`start_after`),`file_count`=VALUES(`file_count`),`object_count`=VALUES(`object_count`),`last_report_time`=VALUES(`last_report_time`),`update_time`=VALUES(`update_time`)"
+	mockInventoryProgressQuerySQL  = "SELECT * FROM `inventory_progress` WHERE bucket_name = ? ORDER BY `inventory_progress`.`bucket_name` LIMIT 1"
+)
+
+func TestSpDBImpl_UpdateInventoryProgressSuccess(t *testing.T) {
+	progress := &corespdb.InventoryProgress{
+		BucketName:     mockInventoryBucketName,
+		ReportTime:     1690000000,
+		StartAfter:     "a.jpg",
+		FileCount:      2,
+		ObjectCount:    20000,
+		LastReportTime: 1680000000,
+		UpdateTime:     1690000100,
+	}
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(mockInventoryProgressUpdateSQL).
+		WithArgs(progress.BucketName, progress.ReportTime, progress.StartAfter, progress.FileCount,
+			progress.ObjectCount, progress.LastReportTime, progress.UpdateTime).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	err := s.UpdateInventoryProgress(progress)
+	assert.Nil(t, err)
+}
+
+func TestSpDBImpl_UpdateInventoryProgressFailure(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(mockInventoryProgressUpdateSQL).WillReturnError(mockDBInternalError)
+	mock.ExpectRollback()
+	mock.ExpectCommit()
+	err := s.UpdateInventoryProgress(&corespdb.InventoryProgress{BucketName: mockInventoryBucketName})
+	assert.Contains(t, err.Error(), mockDBInternalError.Error())
+}
+
+func TestSpDBImpl_QueryInventoryProgressSuccess(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery(mockInventoryProgressQuerySQL).WithArgs(mockInventoryBucketName).
+		WillReturnRows(sqlmock.NewRows([]string{"bucket_name", "report_time", "start_after", "file_count",
+			"object_count", "last_report_time", "update_time"}).
+			AddRow(mockInventoryBucketName, 1690000000, "a.jpg", 2, 20000, 1680000000, 1690000100))
+	result, err := s.QueryInventoryProgress(mockInventoryBucketName)
+	assert.Nil(t, err)
+	assert.Equal(t, &corespdb.InventoryProgress{
+		BucketName:     mockInventoryBucketName,
+		ReportTime:     1690000000,
+		StartAfter:     "a.jpg",
+		FileCount:      2,
+		ObjectCount:    20000,
+		LastReportTime: 1680000000,
+		UpdateTime:     1690000100,
+	}, result)
+}
+
+func TestSpDBImpl_QueryInventoryProgressRecordNotFound(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery(mockInventoryProgressQuerySQL).WillReturnError(gorm.ErrRecordNotFound)
+	result, err := s.QueryInventoryProgress(mockInventoryBucketName)
+	assert.Nil(t, err)
+	assert.Equal(t, &corespdb.InventoryProgress{BucketName: mockInventoryBucketName}, result)
+}
+
+func TestSpDBImpl_QueryInventoryProgressFailure(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery(mockInventoryProgressQuerySQL).WillReturnError(mockDBInternalError)
+	result, err := s.QueryInventoryProgress(mockInventoryBucketName)
+	assert.Equal(t, mockDBInternalError, err)
+	assert.Nil(t, result)
+}
@@ -0,0 +1,58 @@
+package sqldb
+
+import (
+	"fmt"
+
+	"gorm.io/gorm/clause"
+
+	corespdb "github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+)
+
+// InsertQueuedTask inserts a task into the queue, overwrites it if the task already exists.
+func (s *SpDBImpl) InsertQueuedTask(task *corespdb.QueuedTask) error {
+	insertTask := &QueuedTaskTable{
+		QueueName:  task.QueueName,
+		TaskKey:    task.TaskKey,
+		TaskType:   task.TaskType,
+		Data:       task.Data,
+		CreateTime: task.CreateTime,
+	}
+	err := s.db.Table(QueuedTaskTableName).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "queue_name"}, {Name: "task_key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"task_type", "data", "create_time"}),
+	}).Create(insertTask).Error
+	if err != nil {
+		return fmt.Errorf("failed to insert queued task: %s", err)
+	}
+	return nil
+}
+
+// DeleteQueuedTask deletes the task from the queue.
+func (s *SpDBImpl) DeleteQueuedTask(queueName string, taskKey string) error {
+	err := s.db.Table(QueuedTaskTableName).Where("queue_name = ? and task_key = ?", queueName, taskKey).
+		Delete(&QueuedTaskTable{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to delete queued task: %s", err)
+	}
+	return nil
+}
+
+// ListQueuedTasks returns all tasks of the queue ordered by create time.
+func (s *SpDBImpl) ListQueuedTasks(queueName string) ([]*corespdb.QueuedTask, error) {
+	var queryReturns []*QueuedTaskTable
+	if err := s.db.Table(QueuedTaskTableName).Where("queue_name = ?", queueName).
+		Order("create_time asc").Find(&queryReturns).Error; err != nil {
+		return nil, fmt.Errorf("failed to list queued tasks: %s", err)
+	}
+	tasks := make([]*corespdb.QueuedTask, 0, len(queryReturns))
+	for _, task := range queryReturns {
+		tasks = append(tasks, &corespdb.QueuedTask{
+			QueueName:  task.QueueName,
+			TaskKey:    task.TaskKey,
+			TaskType:   task.TaskType,
+			Data:       task.Data,
+			CreateTime: task.CreateTime,
+		})
+	}
+	return tasks, nil
+}
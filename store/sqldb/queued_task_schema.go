@@ -0,0 +1,15 @@
+package sqldb
+
+// QueuedTaskTable table schema
+type QueuedTaskTable struct {
+	QueueName  string `gorm:"primary_key;type:varchar(64)"`
+	TaskKey    string `gorm:"primary_key;type:varchar(255)"`
+	TaskType   int32
+	Data       []byte `gorm:"type:mediumblob"`
+	CreateTime int64
+}
+
+// TableName is used to set QueuedTaskTable Schema's table name in database
+func (QueuedTaskTable) TableName() string {
+	return QueuedTaskTableName
+}
@@ -0,0 +1,13 @@
+package sqldb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueuedTaskTable_TableName(t *testing.T) {
+	table := QueuedTaskTable{QueueName: "mockQueueName"}
+	result := table.TableName()
+	assert.Equal(t, QueuedTaskTableName, result)
+}
@@ -0,0 +1,91 @@
+package sqldb
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	corespdb "github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+)
+
+const (
+	mockQueueName            = "manager-seal-object"
+	mockQueuedTaskInsertSQL  = "INSERT INTO `queued_task` (`queue_name`,`task_key`,`task_type`,`data`,`create_time`) VALUES (?,?,?,?,?) ON DUPLICATE KEY UPDATE `task_type`=VALUES(`task_type`),`data`=VALUES(`data`),`create_time`=VALUES(`create_time`)"
+	mockQueuedTaskDeleteSQL  = "DELETE FROM `queued_task` WHERE queue_name = ? and task_key = ?"
+	mockQueuedTaskListSQL    = "SELECT * FROM `queued_task` WHERE queue_name = ? ORDER BY create_time asc"
+	mockQueuedTaskCreateTime = 1690000000
+)
+
+func TestSpDBImpl_InsertQueuedTaskSuccess(t *testing.T) {
+	task := &corespdb.QueuedTask{
+		QueueName:  mockQueueName,
+		TaskKey:    mockTaskKey,
+		TaskType:   7,
+		Data:       []byte("mockData"),
+		CreateTime: mockQueuedTaskCreateTime,
+	}
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(mockQueuedTaskInsertSQL).
+		WithArgs(task.QueueName, task.TaskKey, task.TaskType, task.Data, task.CreateTime).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	err := s.InsertQueuedTask(task)
+	assert.Nil(t, err)
+}
+
+func TestSpDBImpl_InsertQueuedTaskFailure(t *testing.T) {
+	task := &corespdb.QueuedTask{QueueName: mockQueueName, TaskKey: mockTaskKey}
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(mockQueuedTaskInsertSQL).WillReturnError(mockDBInternalError)
+	mock.ExpectRollback()
+	mock.ExpectCommit()
+	err := s.InsertQueuedTask(task)
+	assert.Contains(t, err.Error(), mockDBInternalError.Error())
+}
+
+func TestSpDBImpl_DeleteQueuedTaskSuccess(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(mockQueuedTaskDeleteSQL).WithArgs(mockQueueName, mockTaskKey).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	err := s.DeleteQueuedTask(mockQueueName, mockTaskKey)
+	assert.Nil(t, err)
+}
+
+func TestSpDBImpl_DeleteQueuedTaskFailure(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(mockQueuedTaskDeleteSQL).WillReturnError(mockDBInternalError)
+	mock.ExpectRollback()
+	mock.ExpectCommit()
+	err := s.DeleteQueuedTask(mockQueueName, mockTaskKey)
+	assert.Contains(t, err.Error(), mockDBInternalError.Error())
+}
+
+func TestSpDBImpl_ListQueuedTasksSuccess(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery(mockQueuedTaskListSQL).WithArgs(mockQueueName).
+		WillReturnRows(sqlmock.NewRows([]string{"queue_name", "task_key", "task_type", "data", "create_time"}).
+			AddRow(mockQueueName, mockTaskKey, 7, []byte("mockData"), mockQueuedTaskCreateTime))
+	result, err := s.ListQueuedTasks(mockQueueName)
+	assert.Nil(t, err)
+	assert.Equal(t, []*corespdb.QueuedTask{{
+		QueueName:  mockQueueName,
+		TaskKey:    mockTaskKey,
+		TaskType:   7,
+		Data:       []byte("mockData"),
+		CreateTime: mockQueuedTaskCreateTime,
+	}}, result)
+}
+
+func TestSpDBImpl_ListQueuedTasksFailure(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery(mockQueuedTaskListSQL).WillReturnError(mockDBInternalError)
+	result, err := s.ListQueuedTasks(mockQueueName)
+	assert.Contains(t, err.Error(), mockDBInternalError.Error())
+	assert.Nil(t, result)
+}
@@ -0,0 +1,78 @@
+package sqldb
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// incrRateLimitCounterSQL increases the counter of the key by one statement, the counter is reset if it belongs to
+// the previous window. The counter is assigned before the window start, and the updated counter is returned as the
+// last insert id by LAST_INSERT_ID(expr), so it is not affected by the other gateway instances without a transaction.
+const incrRateLimitCounterSQL = "INSERT INTO `" + RateLimitCounterTableName + "` (`limiter_key`,`window_start`,`counter`,`expire_time`) " +
+	"VALUES (?,?,?,?) ON DUPLICATE KEY UPDATE `counter`=LAST_INSERT_ID(IF(`window_start` = ?, `counter` + ?, ?)),`window_start`=?,`expire_time`=?"
+
+// IncrRateLimitCounter increases the counter of the key in the window and returns the increased counter.
+func (s *SpDBImpl) IncrRateLimitCounter(key string, windowStart int64, count int64, expireTime int64) (int64, error) {
+	db, err := s.db.DB()
+	if err != nil {
+		return 0, fmt.Errorf("failed to increase rate limit counter: %s", err)
+	}
+	result, err := db.Exec(incrRateLimitCounterSQL, key, windowStart, count, expireTime, windowStart, count, count, windowStart, expireTime)
+	if err != nil {
+		return 0, fmt.Errorf("failed to increase rate limit counter: %s", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to increase rate limit counter: %s", err)
+	}
+	switch affected {
+	case 1:
+		// the row is inserted
+		return count, nil
+	case 2:
+		// the row is updated, the last insert id is the updated counter
+		counter, err := result.LastInsertId()
+		if err != nil {
+			return 0, fmt.Errorf("failed to increase rate limit counter: %s", err)
+		}
+		return counter, nil
+	default:
+		// the row is unchanged by increasing zero in the same window
+		return s.GetRateLimitCounter(key, windowStart)
+	}
+}
+
+// GetRateLimitCounter returns the counter of the key in the window, returns 0 if it is not found in db.
+func (s *SpDBImpl) GetRateLimitCounter(key string, windowStart int64) (int64, error) {
+	queryReturn := &RateLimitCounterTable{}
+	result := s.db.First(queryReturn, "limiter_key = ? and window_start = ?", key, windowStart)
+	if result.Error != nil && errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return 0, nil
+	}
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to query rate limit counter: %s", result.Error)
+	}
+	return queryReturn.Counter, nil
+}
+
+// DeleteRateLimitCounter deletes the counter of the key in the window.
+func (s *SpDBImpl) DeleteRateLimitCounter(key string, windowStart int64) error {
+	err := s.db.Table(RateLimitCounterTableName).Where("limiter_key = ? and window_start = ?", key, windowStart).
+		Delete(&RateLimitCounterTable{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to delete rate limit counter: %s", err)
+	}
+	return nil
+}
+
+// DeleteExpiredRateLimitCounters deletes the counters which are expired before the time.
+func (s *SpDBImpl) DeleteExpiredRateLimitCounters(expireTimeBefore int64) error {
+	err := s.db.Table(RateLimitCounterTableName).Where("expire_time < ?", expireTimeBefore).
+		Delete(&RateLimitCounterTable{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to delete expired rate limit counters: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package sqldb
+
+// RateLimitCounterTable table schema
+type RateLimitCounterTable struct {
+	LimiterKey  string `gorm:"primary_key;type:varchar(128)"`
+	WindowStart int64
+	Counter     int64
+	ExpireTime  int64 `gorm:"index:idx_expire_time"`
+}
+
+// TableName is used to set RateLimitCounterTable Schema's table name in database
+func (RateLimitCounterTable) TableName() string {
+	return RateLimitCounterTableName
+}
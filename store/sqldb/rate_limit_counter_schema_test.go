@@ -0,0 +1,13 @@
+package sqldb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimitCounterTable_TableName(t *testing.T) {
+	table := RateLimitCounterTable{LimiterKey: "mockLimiterKey"}
+	result := table.TableName()
+	assert.Equal(t, RateLimitCounterTableName, result)
+}
@@ -0,0 +1,111 @@
+package sqldb
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+const (
+	mockRateLimitKey         = "ip_127.0.0.1"
+	mockRateLimitWindowStart = int64(1000)
+	mockRateLimitExpireTime  = int64(2000)
+	mockRateLimitQuerySQL    = "SELECT * FROM `rate_limit_counter` WHERE limiter_key = ? and window_start = ? ORDER BY `rate_limit_counter`.`limiter_key` LIMIT 1"
+)
+
+func TestSpDBImpl_IncrRateLimitCounterInserted(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectExec(incrRateLimitCounterSQL).
+		WithArgs(mockRateLimitKey, mockRateLimitWindowStart, 2, mockRateLimitExpireTime, mockRateLimitWindowStart, 2, 2,
+			mockRateLimitWindowStart, mockRateLimitExpireTime).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	counter, err := s.IncrRateLimitCounter(mockRateLimitKey, mockRateLimitWindowStart, 2, mockRateLimitExpireTime)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), counter)
+}
+
+func TestSpDBImpl_IncrRateLimitCounterUpdated(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectExec(incrRateLimitCounterSQL).WillReturnResult(sqlmock.NewResult(5, 2))
+	counter, err := s.IncrRateLimitCounter(mockRateLimitKey, mockRateLimitWindowStart, 2, mockRateLimitExpireTime)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), counter)
+}
+
+func TestSpDBImpl_IncrRateLimitCounterUnchanged(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectExec(incrRateLimitCounterSQL).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(mockRateLimitQuerySQL).WithArgs(mockRateLimitKey, mockRateLimitWindowStart).
+		WillReturnRows(sqlmock.NewRows([]string{"limiter_key", "window_start", "counter", "expire_time"}).
+			AddRow(mockRateLimitKey, mockRateLimitWindowStart, 5, mockRateLimitExpireTime))
+	counter, err := s.IncrRateLimitCounter(mockRateLimitKey, mockRateLimitWindowStart, 0, mockRateLimitExpireTime)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), counter)
+}
+
+func TestSpDBImpl_IncrRateLimitCounterFailure(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectExec(incrRateLimitCounterSQL).WillReturnError(mockDBInternalError)
+	_, err := s.IncrRateLimitCounter(mockRateLimitKey, mockRateLimitWindowStart, 2, mockRateLimitExpireTime)
+	assert.Contains(t, err.Error(), mockDBInternalError.Error())
+}
+
+func TestSpDBImpl_GetRateLimitCounterSuccess(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery(mockRateLimitQuerySQL).
+		WithArgs(mockRateLimitKey, mockRateLimitWindowStart).
+		WillReturnRows(sqlmock.NewRows([]string{"limiter_key", "window_start", "counter", "expire_time"}).
+			AddRow(mockRateLimitKey, mockRateLimitWindowStart, 5, mockRateLimitExpireTime))
+	counter, err := s.GetRateLimitCounter(mockRateLimitKey, mockRateLimitWindowStart)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), counter)
+}
+
+func TestSpDBImpl_GetRateLimitCounterNotFound(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery(mockRateLimitQuerySQL).
+		WillReturnError(gorm.ErrRecordNotFound)
+	counter, err := s.GetRateLimitCounter(mockRateLimitKey, mockRateLimitWindowStart)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), counter)
+}
+
+func TestSpDBImpl_GetRateLimitCounterFailure(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery(mockRateLimitQuerySQL).
+		WillReturnError(mockDBInternalError)
+	_, err := s.GetRateLimitCounter(mockRateLimitKey, mockRateLimitWindowStart)
+	assert.Contains(t, err.Error(), mockDBInternalError.Error())
+}
+
+func TestSpDBImpl_DeleteRateLimitCounterSuccess(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `rate_limit_counter` WHERE limiter_key = ? and window_start = ?").
+		WithArgs(mockRateLimitKey, mockRateLimitWindowStart).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	err := s.DeleteRateLimitCounter(mockRateLimitKey, mockRateLimitWindowStart)
+	assert.Nil(t, err)
+}
+
+func TestSpDBImpl_DeleteExpiredRateLimitCountersSuccess(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `rate_limit_counter` WHERE expire_time < ?").
+		WithArgs(mockRateLimitExpireTime).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	err := s.DeleteExpiredRateLimitCounters(mockRateLimitExpireTime)
+	assert.Nil(t, err)
+}
+
+func TestSpDBImpl_DeleteExpiredRateLimitCountersFailure(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `rate_limit_counter` WHERE expire_time < ?").WillReturnError(mockDBInternalError)
+	mock.ExpectRollback()
+	mock.ExpectCommit()
+	err := s.DeleteExpiredRateLimitCounters(mockRateLimitExpireTime)
+	assert.Contains(t, err.Error(), mockDBInternalError.Error())
+}
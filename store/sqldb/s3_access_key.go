@@ -0,0 +1,43 @@
+package sqldb
+
+import (
+	"fmt"
+
+	corespdb "github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+)
+
+// InsertS3AccessKey insert a new record into S3AccessKeyTable
+func (s *SpDBImpl) InsertS3AccessKey(accessKey *corespdb.S3AccessKey) error {
+	result := s.db.Create(&S3AccessKeyTable{
+		AccessKeyID: accessKey.AccessKeyID,
+		SecretKey:   accessKey.SecretKey,
+		UserAddress: accessKey.UserAddress,
+		Domain:      accessKey.Domain,
+		PublicKey:   accessKey.PublicKey,
+		CreatedTime: accessKey.CreatedTime,
+	})
+	if result.Error != nil || result.RowsAffected != 1 {
+		return fmt.Errorf("failed to insert record in S3AccessKeyTable: %s", result.Error)
+	}
+	return nil
+}
+
+// GetS3AccessKey get S3AccessKey from S3AccessKeyTable
+func (s *SpDBImpl) GetS3AccessKey(accessKeyID string) (*corespdb.S3AccessKey, error) {
+	queryReturn := &S3AccessKeyTable{}
+	result := s.db.First(queryReturn, "access_key_id = ?", accessKeyID)
+	if result.Error != nil {
+		if errIsNotFound(result.Error) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to query S3AccessKeyTable: %s", result.Error)
+	}
+	return &corespdb.S3AccessKey{
+		AccessKeyID: queryReturn.AccessKeyID,
+		SecretKey:   queryReturn.SecretKey,
+		UserAddress: queryReturn.UserAddress,
+		Domain:      queryReturn.Domain,
+		PublicKey:   queryReturn.PublicKey,
+		CreatedTime: queryReturn.CreatedTime,
+	}, nil
+}
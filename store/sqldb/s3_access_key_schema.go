@@ -0,0 +1,20 @@
+package sqldb
+
+import (
+	"time"
+)
+
+// S3AccessKeyTable table schema
+type S3AccessKeyTable struct {
+	AccessKeyID string `gorm:"primary_key;type:varchar(64)"`
+	SecretKey   string `gorm:"type:varchar(128)"`
+	UserAddress string `gorm:"index:idx_user_address"`
+	Domain      string
+	PublicKey   string
+	CreatedTime time.Time
+}
+
+// TableName is used to set S3AccessKeyTable Schema's table name in database
+func (S3AccessKeyTable) TableName() string {
+	return S3AccessKeyTableName
+}
@@ -0,0 +1,13 @@
+package sqldb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestS3AccessKeyTable_TableName(t *testing.T) {
+	table := S3AccessKeyTable{AccessKeyID: "mockAccessKeyID"}
+	result := table.TableName()
+	assert.Equal(t, S3AccessKeyTableName, result)
+}
@@ -0,0 +1,75 @@
+package sqldb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	corespdb "github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+)
+
+func mockS3AccessKey() *corespdb.S3AccessKey {
+	return &corespdb.S3AccessKey{
+		AccessKeyID: "mockAccessKeyID",
+		SecretKey:   "mockSecretKey",
+		UserAddress: "mockUserAddress",
+		Domain:      "mockDomain",
+		PublicKey:   "mockPublicKey",
+		CreatedTime: time.Now(),
+	}
+}
+
+func TestSpDBImpl_InsertS3AccessKeySuccess(t *testing.T) {
+	k := mockS3AccessKey()
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `s3_access_key` (`access_key_id`,`secret_key`,`user_address`,`domain`,`public_key`,`created_time`) VALUES (?,?,?,?,?,?)").
+		WithArgs(k.AccessKeyID, k.SecretKey, k.UserAddress, k.Domain, k.PublicKey, k.CreatedTime).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	err := s.InsertS3AccessKey(k)
+	assert.Nil(t, err)
+}
+
+func TestSpDBImpl_InsertS3AccessKeyFailure(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `s3_access_key`").WillReturnError(mockDBInternalError)
+	mock.ExpectRollback()
+	mock.ExpectCommit()
+	err := s.InsertS3AccessKey(mockS3AccessKey())
+	assert.Contains(t, err.Error(), mockDBInternalError.Error())
+}
+
+func TestSpDBImpl_GetS3AccessKeySuccess(t *testing.T) {
+	k := mockS3AccessKey()
+	s, mock := setupDB(t)
+	mock.ExpectQuery("SELECT * FROM `s3_access_key` WHERE access_key_id = ? ORDER BY `s3_access_key`.`access_key_id` LIMIT 1").
+		WithArgs(k.AccessKeyID).
+		WillReturnRows(sqlmock.NewRows([]string{"access_key_id", "secret_key", "user_address", "domain", "public_key", "created_time"}).
+			AddRow(k.AccessKeyID, k.SecretKey, k.UserAddress, k.Domain, k.PublicKey, k.CreatedTime))
+	result, err := s.GetS3AccessKey(k.AccessKeyID)
+	assert.Nil(t, err)
+	assert.Equal(t, k, result)
+}
+
+func TestSpDBImpl_GetS3AccessKeyNotFound(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery("SELECT * FROM `s3_access_key` WHERE access_key_id = ? ORDER BY `s3_access_key`.`access_key_id` LIMIT 1").
+		WillReturnError(gorm.ErrRecordNotFound)
+	result, err := s.GetS3AccessKey("mockAccessKeyID")
+	assert.Nil(t, err)
+	assert.Nil(t, result)
+}
+
+func TestSpDBImpl_GetS3AccessKeyFailure(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery("SELECT * FROM `s3_access_key` WHERE access_key_id = ? ORDER BY `s3_access_key`.`access_key_id` LIMIT 1").
+		WillReturnError(mockDBInternalError)
+	result, err := s.GetS3AccessKey("mockAccessKeyID")
+	assert.Contains(t, err.Error(), mockDBInternalError.Error())
+	assert.Nil(t, result)
+}
@@ -0,0 +1,52 @@
+package sqldb
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	corespdb "github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+)
+
+// UpdateScrubProgress is used to update the scrub progress, inserts a new one if it is not found in db.
+func (s *SpDBImpl) UpdateScrubProgress(progress *corespdb.ScrubProgress) error {
+	updateRecord := &ScrubProgressTable{
+		ScrubKey:      progress.ScrubKey,
+		NextObjectID:  progress.NextObjectID,
+		Round:         progress.Round,
+		ScannedPieces: progress.ScannedPieces,
+		CorruptPieces: progress.CorruptPieces,
+		UpdateTime:    progress.UpdateTime,
+	}
+	err := s.db.Table(ScrubProgressTableName).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "scrub_key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"next_object_id", "round", "scanned_pieces",
+			"corrupt_pieces", "update_time"}),
+	}).Create(updateRecord).Error
+	if err != nil {
+		return fmt.Errorf("failed to update scrub progress: %s", err)
+	}
+	return nil
+}
+
+// QueryScrubProgress returns the scrub progress, returns an empty progress if it is not found in db.
+func (s *SpDBImpl) QueryScrubProgress(scrubKey string) (*corespdb.ScrubProgress, error) {
+	queryReturn := &ScrubProgressTable{}
+	result := s.db.First(queryReturn, "scrub_key = ?", scrubKey)
+	if result.Error != nil && errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return &corespdb.ScrubProgress{ScrubKey: scrubKey}, nil
+	}
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &corespdb.ScrubProgress{
+		ScrubKey:      queryReturn.ScrubKey,
+		NextObjectID:  queryReturn.NextObjectID,
+		Round:         queryReturn.Round,
+		ScannedPieces: queryReturn.ScannedPieces,
+		CorruptPieces: queryReturn.CorruptPieces,
+		UpdateTime:    queryReturn.UpdateTime,
+	}, nil
+}
@@ -0,0 +1,16 @@
+package sqldb
+
+// ScrubProgressTable table schema
+type ScrubProgressTable struct {
+	ScrubKey      string `gorm:"primary_key;type:varchar(64)"`
+	NextObjectID  uint64
+	Round         uint64
+	ScannedPieces uint64
+	CorruptPieces uint64
+	UpdateTime    int64
+}
+
+// TableName is used to set ScrubProgressTable Schema's table name in database
+func (ScrubProgressTable) TableName() string {
+	return ScrubProgressTableName
+}
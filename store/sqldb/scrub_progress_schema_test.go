@@ -0,0 +1,13 @@
+package sqldb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScrubProgressTable_TableName(t *testing.T) {
+	table := ScrubProgressTable{ScrubKey: "mockScrubKey"}
+	result := table.TableName()
+	assert.Equal(t, ScrubProgressTableName, result)
+}
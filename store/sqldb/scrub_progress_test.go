@@ -0,0 +1,81 @@
+package sqldb
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	corespdb "github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+)
+
+const (
+	mockScrubKey                = "piece_integrity"
+	mockScrubProgressUpdateSQL  = "INSERT INTO `scrub_progress` (`scrub_key`,`next_object_id`,`round`,`scanned_pieces`,`corrupt_pieces`,`update_time`) VALUES (?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `next_object_id`=VALUES(`next_object_id`),`round`=VALUES(`round`),`scanned_pieces`=VALUES(`scanned_pieces`),`corrupt_pieces`=VALUES(`corrupt_pieces`),`update_time`=VALUES(`update_time`)"
+	mockScrubProgressQuerySQL   = "SELECT * FROM `scrub_progress` WHERE scrub_key = ? ORDER BY `scrub_progress`.`scrub_key` LIMIT 1"
+	mockScrubProgressUpdateTime = 1690000000
+)
+
+func TestSpDBImpl_UpdateScrubProgressSuccess(t *testing.T) {
+	progress := &corespdb.ScrubProgress{
+		ScrubKey:      mockScrubKey,
+		NextObjectID:  100,
+		Round:         1,
+		ScannedPieces: 30,
+		CorruptPieces: 2,
+		UpdateTime:    mockScrubProgressUpdateTime,
+	}
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(mockScrubProgressUpdateSQL).
+		WithArgs(progress.ScrubKey, progress.NextObjectID, progress.Round, progress.ScannedPieces,
+			progress.CorruptPieces, progress.UpdateTime).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	err := s.UpdateScrubProgress(progress)
+	assert.Nil(t, err)
+}
+
+func TestSpDBImpl_UpdateScrubProgressFailure(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(mockScrubProgressUpdateSQL).WillReturnError(mockDBInternalError)
+	mock.ExpectRollback()
+	mock.ExpectCommit()
+	err := s.UpdateScrubProgress(&corespdb.ScrubProgress{ScrubKey: mockScrubKey})
+	assert.Contains(t, err.Error(), mockDBInternalError.Error())
+}
+
+func TestSpDBImpl_QueryScrubProgressSuccess(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery(mockScrubProgressQuerySQL).WithArgs(mockScrubKey).
+		WillReturnRows(sqlmock.NewRows([]string{"scrub_key", "next_object_id", "round", "scanned_pieces",
+			"corrupt_pieces", "update_time"}).AddRow(mockScrubKey, 100, 1, 30, 2, mockScrubProgressUpdateTime))
+	result, err := s.QueryScrubProgress(mockScrubKey)
+	assert.Nil(t, err)
+	assert.Equal(t, &corespdb.ScrubProgress{
+		ScrubKey:      mockScrubKey,
+		NextObjectID:  100,
+		Round:         1,
+		ScannedPieces: 30,
+		CorruptPieces: 2,
+		UpdateTime:    mockScrubProgressUpdateTime,
+	}, result)
+}
+
+func TestSpDBImpl_QueryScrubProgressRecordNotFound(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery(mockScrubProgressQuerySQL).WillReturnError(gorm.ErrRecordNotFound)
+	result, err := s.QueryScrubProgress(mockScrubKey)
+	assert.Nil(t, err)
+	assert.Equal(t, &corespdb.ScrubProgress{ScrubKey: mockScrubKey}, result)
+}
+
+func TestSpDBImpl_QueryScrubProgressFailure(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery(mockScrubProgressQuerySQL).WillReturnError(mockDBInternalError)
+	result, err := s.QueryScrubProgress(mockScrubKey)
+	assert.Equal(t, mockDBInternalError, err)
+	assert.Nil(t, result)
+}
@@ -0,0 +1,105 @@
+package sqldb
+
+import (
+	"fmt"
+
+	corespdb "github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+)
+
+// InsertTx inserts the broadcast tx.
+func (s *SpDBImpl) InsertTx(tx *corespdb.TxRecord) error {
+	insertTx := &SignerTxTable{
+		TxHash:         tx.TxHash,
+		Account:        tx.Account,
+		Address:        tx.Address,
+		Nonce:          tx.Nonce,
+		MsgTypes:       tx.MsgTypes,
+		TxBytes:        tx.TxBytes,
+		GasLimit:       tx.GasLimit,
+		FeeAmount:      tx.FeeAmount,
+		Status:         int32(tx.Status),
+		Height:         tx.Height,
+		GasUsed:        tx.GasUsed,
+		BroadcastCount: tx.BroadcastCount,
+		ErrorMsg:       tx.ErrorMsg,
+		BroadcastTime:  tx.BroadcastTime,
+		CreateTime:     tx.CreateTime,
+		UpdateTime:     tx.UpdateTime,
+	}
+	if err := s.db.Table(SignerTxTableName).Create(insertTx).Error; err != nil {
+		return fmt.Errorf("failed to insert signer tx: %s", err)
+	}
+	return nil
+}
+
+// UpdateTx updates the status, inclusion and broadcast info of the tx.
+func (s *SpDBImpl) UpdateTx(tx *corespdb.TxRecord) error {
+	err := s.db.Table(SignerTxTableName).Where("tx_hash = ?", tx.TxHash).Updates(map[string]interface{}{
+		"status":          int32(tx.Status),
+		"height":          tx.Height,
+		"gas_used":        tx.GasUsed,
+		"broadcast_count": tx.BroadcastCount,
+		"error_msg":       tx.ErrorMsg,
+		"broadcast_time":  tx.BroadcastTime,
+		"update_time":     tx.UpdateTime,
+	}).Error
+	if err != nil {
+		return fmt.Errorf("failed to update signer tx: %s", err)
+	}
+	return nil
+}
+
+// ListTxs returns the txs of the account with the statuses ordered by account and nonce, the txs of all
+// accounts or all statuses are returned if account or statuses is empty.
+func (s *SpDBImpl) ListTxs(account string, statuses []corespdb.TxStatus, limit int) ([]*corespdb.TxRecord, error) {
+	query := s.db.Table(SignerTxTableName)
+	if account != "" {
+		query = query.Where("account = ?", account)
+	}
+	if len(statuses) != 0 {
+		values := make([]int32, len(statuses))
+		for i, status := range statuses {
+			values[i] = int32(status)
+		}
+		query = query.Where("status IN ?", values)
+	}
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	var queryReturns []*SignerTxTable
+	if err := query.Order("account asc, nonce asc").Find(&queryReturns).Error; err != nil {
+		return nil, fmt.Errorf("failed to list signer txs: %s", err)
+	}
+	txs := make([]*corespdb.TxRecord, 0, len(queryReturns))
+	for _, tx := range queryReturns {
+		txs = append(txs, &corespdb.TxRecord{
+			TxHash:         tx.TxHash,
+			Account:        tx.Account,
+			Address:        tx.Address,
+			Nonce:          tx.Nonce,
+			MsgTypes:       tx.MsgTypes,
+			TxBytes:        tx.TxBytes,
+			GasLimit:       tx.GasLimit,
+			FeeAmount:      tx.FeeAmount,
+			Status:         corespdb.TxStatus(tx.Status),
+			Height:         tx.Height,
+			GasUsed:        tx.GasUsed,
+			BroadcastCount: tx.BroadcastCount,
+			ErrorMsg:       tx.ErrorMsg,
+			BroadcastTime:  tx.BroadcastTime,
+			CreateTime:     tx.CreateTime,
+			UpdateTime:     tx.UpdateTime,
+		})
+	}
+	return txs, nil
+}
+
+// DeleteFinishedTxs deletes the txs which are not pending and updated before the time.
+func (s *SpDBImpl) DeleteFinishedTxs(updateTimeBefore int64) error {
+	err := s.db.Table(SignerTxTableName).Where("status <> ? and update_time < ?",
+		int32(corespdb.TxStatusPending), updateTimeBefore).Delete(&SignerTxTable{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to delete finished signer txs: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package sqldb
+
+// SignerTxTable table schema
+type SignerTxTable struct {
+	TxHash         string `gorm:"primary_key;type:varchar(64)"`
+	Account        string `gorm:"index:account_status_index;type:varchar(32)"`
+	Address        string
+	Nonce          uint64
+	MsgTypes       string
+	TxBytes        []byte `gorm:"type:mediumblob"`
+	GasLimit       uint64
+	FeeAmount      string
+	Status         int32 `gorm:"index:account_status_index"`
+	Height         int64
+	GasUsed        int64
+	BroadcastCount uint32
+	ErrorMsg       string
+	BroadcastTime  int64
+	CreateTime     int64
+	UpdateTime     int64 `gorm:"index:update_time_index"`
+}
+
+// TableName is used to set SignerTxTable Schema's table name in database
+func (SignerTxTable) TableName() string {
+	return SignerTxTableName
+}
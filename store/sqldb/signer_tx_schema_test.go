@@ -0,0 +1,13 @@
+package sqldb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignerTxTable_TableName(t *testing.T) {
+	table := SignerTxTable{TxHash: "mockTxHash"}
+	result := table.TableName()
+	assert.Equal(t, SignerTxTableName, result)
+}
@@ -0,0 +1,132 @@
+package sqldb
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	corespdb "github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+)
+
+const (
+	mockSignerTxHash       = "8B8C7A3E5D2C1B0A9F8E7D6C5B4A39281706F5E4D3C2B1A09F8E7D6C5B4A3928"
+	mockSignerTxInsertSQL  = "INSERT INTO `signer_tx` (`tx_hash`,`account`,`address`,`nonce`,`msg_types`,`tx_bytes`,`gas_limit`,`fee_amount`,`status`,`height`,`gas_used`,`broadcast_count`,`error_msg`,`broadcast_time`,`create_time`,`update_time`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)"
+	mockSignerTxUpdateSQL  = "UPDATE `signer_tx` SET `broadcast_count`=?,`broadcast_time`=?,`error_msg`=?,`gas_used`=?,`height`=?,`status`=?,`update_time`=? WHERE tx_hash = ?"
+	mockSignerTxListSQL    = "SELECT * FROM `signer_tx` WHERE account = ? AND status IN (?,?) ORDER BY account asc, nonce asc LIMIT 10"
+	mockSignerTxListAllSQL = "SELECT * FROM `signer_tx` ORDER BY account asc, nonce asc"
+	mockSignerTxDeleteSQL  = "DELETE FROM `signer_tx` WHERE status <> ? and update_time < ?"
+	mockSignerTxTime       = 1690000000
+)
+
+func mockSignerTx() *corespdb.TxRecord {
+	return &corespdb.TxRecord{
+		TxHash:         mockSignerTxHash,
+		Account:        "seal",
+		Address:        "0x0000000000000000000000000000000000000001",
+		Nonce:          7,
+		MsgTypes:       "/greenfield.storage.MsgSealObject",
+		TxBytes:        []byte("mockTxBytes"),
+		GasLimit:       1200,
+		FeeAmount:      "6000000000000BNB",
+		Status:         corespdb.TxStatusPending,
+		BroadcastCount: 1,
+		BroadcastTime:  mockSignerTxTime,
+		CreateTime:     mockSignerTxTime,
+		UpdateTime:     mockSignerTxTime,
+	}
+}
+
+func TestSpDBImpl_InsertTxSuccess(t *testing.T) {
+	tx := mockSignerTx()
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(mockSignerTxInsertSQL).
+		WithArgs(tx.TxHash, tx.Account, tx.Address, tx.Nonce, tx.MsgTypes, tx.TxBytes, tx.GasLimit, tx.FeeAmount,
+			int32(tx.Status), tx.Height, tx.GasUsed, tx.BroadcastCount, tx.ErrorMsg, tx.BroadcastTime, tx.CreateTime,
+			tx.UpdateTime).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	err := s.InsertTx(tx)
+	assert.Nil(t, err)
+}
+
+func TestSpDBImpl_InsertTxFailure(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(mockSignerTxInsertSQL).WillReturnError(mockDBInternalError)
+	mock.ExpectRollback()
+	mock.ExpectCommit()
+	err := s.InsertTx(mockSignerTx())
+	assert.Contains(t, err.Error(), mockDBInternalError.Error())
+}
+
+func TestSpDBImpl_UpdateTxSuccess(t *testing.T) {
+	tx := mockSignerTx()
+	tx.Status = corespdb.TxStatusIncluded
+	tx.Height = 100
+	tx.GasUsed = 1000
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(mockSignerTxUpdateSQL).
+		WithArgs(tx.BroadcastCount, tx.BroadcastTime, tx.ErrorMsg, tx.GasUsed, tx.Height, int32(tx.Status),
+			tx.UpdateTime, tx.TxHash).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	err := s.UpdateTx(tx)
+	assert.Nil(t, err)
+}
+
+func TestSpDBImpl_UpdateTxFailure(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(mockSignerTxUpdateSQL).WillReturnError(mockDBInternalError)
+	mock.ExpectRollback()
+	mock.ExpectCommit()
+	err := s.UpdateTx(mockSignerTx())
+	assert.Contains(t, err.Error(), mockDBInternalError.Error())
+}
+
+func TestSpDBImpl_ListTxsSuccess(t *testing.T) {
+	tx := mockSignerTx()
+	s, mock := setupDB(t)
+	mock.ExpectQuery(mockSignerTxListSQL).
+		WithArgs("seal", int32(corespdb.TxStatusPending), int32(corespdb.TxStatusFailed)).
+		WillReturnRows(sqlmock.NewRows([]string{"tx_hash", "account", "address", "nonce", "msg_types", "tx_bytes",
+			"gas_limit", "fee_amount", "status", "height", "gas_used", "broadcast_count", "error_msg",
+			"broadcast_time", "create_time", "update_time"}).
+			AddRow(tx.TxHash, tx.Account, tx.Address, tx.Nonce, tx.MsgTypes, tx.TxBytes, tx.GasLimit, tx.FeeAmount,
+				int32(tx.Status), tx.Height, tx.GasUsed, tx.BroadcastCount, tx.ErrorMsg, tx.BroadcastTime,
+				tx.CreateTime, tx.UpdateTime))
+	result, err := s.ListTxs("seal", []corespdb.TxStatus{corespdb.TxStatusPending, corespdb.TxStatusFailed}, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []*corespdb.TxRecord{tx}, result)
+}
+
+func TestSpDBImpl_ListTxsFailure(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery(mockSignerTxListAllSQL).WillReturnError(mockDBInternalError)
+	result, err := s.ListTxs("", nil, 0)
+	assert.Contains(t, err.Error(), mockDBInternalError.Error())
+	assert.Nil(t, result)
+}
+
+func TestSpDBImpl_DeleteFinishedTxsSuccess(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(mockSignerTxDeleteSQL).WithArgs(int32(corespdb.TxStatusPending), mockSignerTxTime).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	err := s.DeleteFinishedTxs(mockSignerTxTime)
+	assert.Nil(t, err)
+}
+
+func TestSpDBImpl_DeleteFinishedTxsFailure(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(mockSignerTxDeleteSQL).WillReturnError(mockDBInternalError)
+	mock.ExpectRollback()
+	mock.ExpectCommit()
+	err := s.DeleteFinishedTxs(mockSignerTxTime)
+	assert.Contains(t, err.Error(), mockDBInternalError.Error())
+}
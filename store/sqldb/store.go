@@ -157,6 +157,14 @@ func InitDB(config *config.SQLDBConfig) (*gorm.DB, error) {
 		log.Errorw("failed to create off-chain authKey v2 table", "error", err)
 		return nil, err
 	}
+	if err = db.AutoMigrate(&S3AccessKeyTable{}); err != nil && !isAlreadyExists(err) {
+		log.Errorw("failed to create s3 access key table", "error", err)
+		return nil, err
+	}
+	if err = db.AutoMigrate(&RateLimitCounterTable{}); err != nil && !isAlreadyExists(err) {
+		log.Errorw("failed to create rate limit counter table", "error", err)
+		return nil, err
+	}
 	if err = db.AutoMigrate(&MigrateSubscribeProgressTable{}); err != nil && !isAlreadyExists(err) {
 		log.Errorw("failed to migrate subscribe progress table", "error", err)
 		return nil, err
@@ -184,6 +192,22 @@ func InitDB(config *config.SQLDBConfig) (*gorm.DB, error) {
 		log.Errorw("failed to create shadow integrity meta table", "error", err)
 		return nil, err
 	}
+	if err = db.AutoMigrate(&QueuedTaskTable{}); err != nil && !isAlreadyExists(err) {
+		log.Errorw("failed to create queued task table", "error", err)
+		return nil, err
+	}
+	if err = db.AutoMigrate(&ScrubProgressTable{}); err != nil && !isAlreadyExists(err) {
+		log.Errorw("failed to create scrub progress table", "error", err)
+		return nil, err
+	}
+	if err = db.AutoMigrate(&SignerTxTable{}); err != nil && !isAlreadyExists(err) {
+		log.Errorw("failed to create signer tx table", "error", err)
+		return nil, err
+	}
+	if err = db.AutoMigrate(&InventoryProgressTable{}); err != nil && !isAlreadyExists(err) {
+		log.Errorw("failed to create inventory progress table", "error", err)
+		return nil, err
+	}
 	return db, nil
 }
 
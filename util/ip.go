@@ -0,0 +1,56 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"syscall"
+)
+
+// ErrNonPublicIP defines the error of connecting to an address which is not public
+var ErrNonPublicIP = errors.New("non-public ip address")
+
+// nonPublicNetworks defines the reserved networks which are not covered by the net.IP methods, e.g. the carrier-grade
+// nat range which serves the metadata of some clouds
+var nonPublicNetworks = []*net.IPNet{
+	mustParseCIDR("0.0.0.0/8"),
+	mustParseCIDR("100.64.0.0/10"),
+	mustParseCIDR("198.18.0.0/15"),
+	mustParseCIDR("240.0.0.0/4"),
+}
+
+func mustParseCIDR(cidr string) *net.IPNet {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+	return network
+}
+
+// IsPublicIP returns whether the ip is a public unicast address, the loopback, private, link-local, multicast and
+// unspecified addresses are not.
+func IsPublicIP(ip net.IP) bool {
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsMulticast() ||
+		ip.IsUnspecified() {
+		return false
+	}
+	for _, network := range nonPublicNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// PublicIPControl is the net.Dialer Control which refuses to connect to the non-public addresses. It checks the
+// address after the host is resolved, so a DNS rebinding can't bypass it.
+func PublicIPControl(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if !IsPublicIP(net.ParseIP(host)) {
+		return fmt.Errorf("%w: %s", ErrNonPublicIP, host)
+	}
+	return nil
+}
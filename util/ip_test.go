@@ -0,0 +1,42 @@
+package util
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsPublicIP(t *testing.T) {
+	cases := []struct {
+		ip     string
+		wanted bool
+	}{
+		{"8.8.8.8", true},
+		{"2001:4860:4860::8888", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.0.0.1", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"fd00::1", false},
+		{"0.0.0.0", false},
+		{"::", false},
+		{"100.100.100.200", false},
+		{"::ffff:127.0.0.1", false},
+		{"224.0.0.1", false},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.wanted, IsPublicIP(net.ParseIP(c.ip)), c.ip)
+	}
+	assert.False(t, IsPublicIP(nil))
+}
+
+func TestPublicIPControl(t *testing.T) {
+	assert.NoError(t, PublicIPControl("tcp", "8.8.8.8:443", nil))
+	assert.ErrorIs(t, PublicIPControl("tcp", "127.0.0.1:80", nil), ErrNonPublicIP)
+	assert.ErrorIs(t, PublicIPControl("tcp6", "[::1]:80", nil), ErrNonPublicIP)
+	assert.Error(t, PublicIPControl("tcp", "127.0.0.1", nil))
+}